@@ -9,6 +9,8 @@ import (
 	"kakashi/chaos/internal/ent/migrate"
 	custommiddleware "kakashi/chaos/internal/middleware"
 	"kakashi/chaos/internal/services"
+	"kakashi/chaos/internal/storage"
+	"kakashi/chaos/internal/unfurl"
 	"kakashi/chaos/internal/ws"
 	"log"
 	"net/http"
@@ -29,6 +31,33 @@ type Config struct {
 	DatabaseDriver string `env:"DATABASE_DRIVER,default=postgres"`
 	DatabaseDSN    string `env:"DATABASE_DSN"`
 	JWTSecret      string `env:"JWT_SECRET"`
+	// MessageEditWindow limits how long messages stay editable, 0 disables the limit
+	MessageEditWindow time.Duration `env:"MESSAGE_EDIT_WINDOW,default=0s"`
+	// MessageDeleteWindow limits how long senders can delete messages for everyone, 0 disables the limit
+	MessageDeleteWindow time.Duration `env:"MESSAGE_DELETE_WINDOW,default=0s"`
+	// Attachment storage, STORAGE_BACKEND is either local or s3
+	StorageBackend    string `env:"STORAGE_BACKEND,default=local"`
+	StorageLocalDir   string `env:"STORAGE_LOCAL_DIR,default=./data/attachments"`
+	S3Endpoint        string `env:"S3_ENDPOINT"`
+	S3AccessKey       string `env:"S3_ACCESS_KEY"`
+	S3SecretKey       string `env:"S3_SECRET_KEY"`
+	S3Bucket          string `env:"S3_BUCKET,default=chaos-attachments"`
+	S3Region          string `env:"S3_REGION"`
+	S3UseSSL          bool   `env:"S3_USE_SSL,default=true"`
+	MaxAttachmentSize int64  `env:"MAX_ATTACHMENT_SIZE,default=26214400"`
+	// Link previews fetched for URLs in messages
+	LinkPreviewsEnabled bool          `env:"LINK_PREVIEWS_ENABLED,default=true"`
+	LinkPreviewTimeout  time.Duration `env:"LINK_PREVIEW_TIMEOUT,default=5s"`
+	LinkPreviewMaxBytes int64         `env:"LINK_PREVIEW_MAX_BYTES,default=1048576"`
+	LinkPreviewCacheTTL time.Duration `env:"LINK_PREVIEW_CACHE_TTL,default=1h"`
+	// Retention ceilings, MESSAGE_RETENTION_CEILING caps how long any message is kept and
+	// DELETED_MESSAGE_RETENTION how long soft deleted messages are kept, 0 keeps them indefinitely
+	MessageRetentionCeiling time.Duration `env:"MESSAGE_RETENTION_CEILING,default=0s"`
+	DeletedMessageRetention time.Duration `env:"DELETED_MESSAGE_RETENTION,default=0s"`
+	// DraftTTL is how long an untouched draft is kept, 0 keeps drafts indefinitely
+	DraftTTL time.Duration `env:"DRAFT_TTL,default=720h"`
+	// MessageNonceWindow is how long a retried send with the same nonce returns the original message
+	MessageNonceWindow time.Duration `env:"MESSAGE_NONCE_WINDOW,default=24h"`
 }
 type BasicValidator struct {
 	validator *validator.Validate
@@ -48,9 +77,15 @@ func main() {
 	if err != nil {
 		log.Fatalf("main: failed to open database: %v", err)
 	}
-	if err := entClient.Schema.Create(ctx, migrate.WithDropIndex(true), migrate.WithDropColumn(true)); !errors.Is(err, nil) {
+	if err := entClient.Schema.Create(ctx, migrate.WithDropIndex(true), migrate.WithDropColumn(true), ent.KeepMessageSearch(), ent.KeepLegacyMutes()); !errors.Is(err, nil) {
 		log.Fatal("main: failed to create schema:", err)
 	}
+	if err := ent.SetupMessageSearch(ctx, entClient); err != nil {
+		log.Fatalf("main: %v", err)
+	}
+	if err := ent.BackfillNotificationLevels(ctx, entClient); err != nil {
+		log.Fatalf("main: %v", err)
+	}
 
 	// Apply database optimizations
 	if err := setupDatabaseOptimizations(ctx, entClient); err != nil {
@@ -62,11 +97,38 @@ func main() {
 
 	router := echo.New()
 	svcs := services.New(entClient, cfg.JWTSecret, wsHub)
+	wsHub.SetReceiptHandler(svcs)
+	svcs.SetMessageEditWindow(cfg.MessageEditWindow)
+	svcs.SetMessageDeleteWindow(cfg.MessageDeleteWindow)
+	attachmentStorage, err := newAttachmentStorage(ctx, cfg)
+	if err != nil {
+		log.Fatalf("main: failed to set up attachment storage: %v", err)
+	}
+	svcs.SetAttachmentStorage(attachmentStorage, cfg.MaxAttachmentSize)
+	svcs.SetRetentionPolicy(cfg.MessageRetentionCeiling, cfg.DeletedMessageRetention)
+	svcs.SetDraftTTL(cfg.DraftTTL)
+	svcs.SetMessageNonceWindow(cfg.MessageNonceWindow)
+	if cfg.LinkPreviewsEnabled {
+		svcs.SetLinkPreviewFetcher(unfurl.New(unfurl.Config{
+			Timeout:     cfg.LinkPreviewTimeout,
+			MaxBodySize: cfg.LinkPreviewMaxBytes,
+			CacheTTL:    cfg.LinkPreviewCacheTTL,
+		}))
+	}
 	router.Validator = &BasicValidator{validator: validator.New()}
 	router.Use(middleware.CORS())
-	AttachRoutes(router.Group("/api/v1"), controller.New(svcs))
+	ctrl := controller.New(svcs)
+	wsHub.SetCommandHandler(ctrl)
+	AttachRoutes(router.Group("/api/v1"), ctrl)
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
 	defer stop()
+	// Background workers stop with the server
+	svcs.StartImageWorkers(ctx, 2)
+	svcs.StartReceiptWorker(ctx)
+	svcs.StartLinkPreviewWorkers(ctx, 4)
+	svcs.StartScheduledMessageWorker(ctx)
+	svcs.StartReminderWorker(ctx)
+	svcs.StartRetentionReaper(ctx)
 	log.Println("main: starting server at :", cfg.ServerAddr)
 	go func() {
 		if err := router.Start(cfg.ServerAddr); err != nil && err != http.ErrServerClosed {
@@ -88,11 +150,17 @@ func main() {
 func AttachRoutes(router *echo.Group, controller *controller.Controller) {
 	// Create validation middleware
 	validationMiddleware := custommiddleware.NewValidationMiddleware(controller.GetServices())
+	// WebSocket commands count against the same limits as their HTTP endpoints
+	controller.SetRateLimits(validationMiddleware)
 
 	router.POST("/auth/signup", controller.Signup)
 	router.GET("/auth/checkusername/:username", controller.CheckAvailabilityOfUsername)
 	router.POST("/auth/signin", controller.Signin)
 	router.POST("/auth/signout", controller.Signout)
+	// Attachment downloads are authorized by their signed URL
+	router.GET("/attachments/:attachmentID/download", controller.DownloadAttachment)
+	// Avatars and guild icons are public
+	router.GET("/media/*", controller.GetPublicMedia)
 	router.Use(controller.IsAuthenticated)
 
 	// Apply security middleware to authenticated routes
@@ -100,6 +168,7 @@ func AttachRoutes(router *echo.Group, controller *controller.Controller) {
 	router.Use(validationMiddleware.ValidateBlockStatus())
 	router.GET("/auth/me", controller.Me)
 	router.POST("/guild", controller.CreateGuild)
+	router.PUT("/guild/:guildID/icon", controller.UploadGuildIcon)
 
 	// Friend management routes
 	friendRoutes := router.Group("/friends")
@@ -111,6 +180,11 @@ func AttachRoutes(router *echo.Group, controller *controller.Controller) {
 	friendRoutes.GET("", controller.GetFriends)
 	friendRoutes.GET("/requests", controller.GetPendingRequests)
 	friendRoutes.GET("/search", controller.SearchFriends)
+	friendRoutes.POST("/invites", controller.CreateFriendInvite)
+	friendRoutes.GET("/invites", controller.GetFriendInvites)
+	friendRoutes.GET("/invites/:code", controller.GetFriendInvite)
+	friendRoutes.POST("/invites/:code/redeem", controller.RedeemFriendInvite)
+	friendRoutes.DELETE("/invites/:inviteID", controller.RevokeFriendInvite)
 
 	// Block management routes
 	router.POST("/blocks", controller.BlockUser)
@@ -119,6 +193,8 @@ func AttachRoutes(router *echo.Group, controller *controller.Controller) {
 
 	// User search routes
 	router.GET("/users/search", controller.SearchUsers)
+	router.PUT("/users/me/avatar", controller.UploadAvatar)
+	router.PUT("/users/me/read-receipts", controller.UpdateReadReceiptsSetting)
 
 	// Messaging routes
 	messagingRoutes := router.Group("")
@@ -127,7 +203,12 @@ func AttachRoutes(router *echo.Group, controller *controller.Controller) {
 	messagingRoutes.Use(validationMiddleware.ValidateConversationParticipant())
 
 	messagingRoutes.POST("/conversations/:conversationID/messages", controller.SendMessage)
+	messagingRoutes.POST("/conversations/:conversationID/messages/attachments", controller.SendAttachmentMessage)
+	messagingRoutes.POST("/conversations/:conversationID/messages/forward", controller.ForwardMessages)
+	messagingRoutes.POST("/conversations/:conversationID/messages/delete", controller.DeleteMessages)
 	messagingRoutes.GET("/conversations", controller.GetUserConversations)
+	messagingRoutes.POST("/conversations/direct", controller.StartDirectConversation)
+	messagingRoutes.POST("/conversations/group", controller.CreateGroupConversation)
 	messagingRoutes.GET("/conversations/search", controller.SearchConversations)
 	messagingRoutes.GET("/conversations/:conversationID", controller.GetConversationDetails)
 	messagingRoutes.GET("/conversations/:conversationID/messages", controller.GetConversationMessages)
@@ -136,14 +217,57 @@ func AttachRoutes(router *echo.Group, controller *controller.Controller) {
 	messagingRoutes.PUT("/conversations/:conversationID/unarchive", controller.UnarchiveConversation)
 	messagingRoutes.PUT("/conversations/:conversationID/mute", controller.MuteConversation)
 	messagingRoutes.PUT("/conversations/:conversationID/unmute", controller.UnmuteConversation)
+	messagingRoutes.PUT("/conversations/:conversationID/notification-settings", controller.UpdateNotificationSettings)
+	messagingRoutes.PUT("/conversations/:conversationID/pin", controller.PinConversation)
+	messagingRoutes.PUT("/conversations/:conversationID/unpin", controller.UnpinConversation)
+	messagingRoutes.PUT("/conversations/pinned/order", controller.ReorderPinnedConversations)
+	messagingRoutes.PUT("/conversations/:conversationID/name", controller.RenameGroupConversation)
+	messagingRoutes.PUT("/conversations/:conversationID/icon", controller.SetGroupConversationIcon)
+	messagingRoutes.PUT("/conversations/:conversationID/message-ttl", controller.SetConversationMessageTTL)
+	messagingRoutes.PUT("/conversations/:conversationID/draft", controller.SaveDraft)
+	messagingRoutes.DELETE("/conversations/:conversationID/draft", controller.DeleteDraft)
+	messagingRoutes.POST("/conversations/:conversationID/leave", controller.LeaveGroupConversation)
+	messagingRoutes.POST("/conversations/:conversationID/participants", controller.AddGroupParticipants)
+	messagingRoutes.DELETE("/conversations/:conversationID/participants/:userID", controller.RemoveGroupParticipant)
+	messagingRoutes.PUT("/conversations/:conversationID/participants/:userID/role", controller.UpdateGroupParticipantRole)
+	messagingRoutes.GET("/conversations/:conversationID/pins", controller.GetPinnedMessages)
+	messagingRoutes.POST("/conversations/:conversationID/pins", controller.PinMessage)
+	messagingRoutes.DELETE("/conversations/:conversationID/pins/:messageID", controller.UnpinMessage)
+	messagingRoutes.POST("/conversations/:conversationID/scheduled-messages", controller.ScheduleMessage)
+	messagingRoutes.GET("/scheduled-messages", controller.GetScheduledMessages)
+	messagingRoutes.PATCH("/scheduled-messages/:scheduledMessageID", controller.UpdateScheduledMessage)
+	messagingRoutes.DELETE("/scheduled-messages/:scheduledMessageID", controller.CancelScheduledMessage)
+	messagingRoutes.PATCH("/messages/:messageID", controller.EditMessage)
+	messagingRoutes.PUT("/messages/:messageID/embeds", controller.SetMessageEmbedsSuppressed)
+	messagingRoutes.GET("/messages/:messageID/revisions", controller.GetMessageRevisions)
+	messagingRoutes.GET("/messages/:messageID/thread", controller.GetThread)
+	messagingRoutes.PUT("/messages/:messageID/thread/read", controller.MarkThreadAsRead)
+	messagingRoutes.GET("/messages/:messageID/receipts", controller.GetMessageReceipts)
+	messagingRoutes.GET("/messages/:messageID/link", controller.GetMessageLink)
+	messagingRoutes.GET("/messages/:messageID/reactions", controller.GetMessageReactions)
+	messagingRoutes.POST("/messages/:messageID/reactions", controller.AddReaction)
+	messagingRoutes.DELETE("/messages/:messageID/reactions", controller.RemoveReaction)
 	messagingRoutes.DELETE("/messages/:messageID", controller.DeleteMessage)
 	messagingRoutes.GET("/messages/search", controller.SearchMessages)
+	messagingRoutes.GET("/attachments/:attachmentID/url", controller.GetAttachmentURL)
+	messagingRoutes.POST("/bookmarks", controller.SaveMessage)
+	messagingRoutes.GET("/bookmarks", controller.GetSavedMessages)
+	messagingRoutes.PATCH("/bookmarks/:bookmarkID", controller.UpdateBookmark)
+	messagingRoutes.DELETE("/bookmarks/:bookmarkID", controller.DeleteBookmark)
+	messagingRoutes.GET("/folders", controller.GetConversationFolders)
+	messagingRoutes.POST("/folders", controller.CreateConversationFolder)
+	messagingRoutes.PUT("/folders/order", controller.ReorderConversationFolders)
+	messagingRoutes.PATCH("/folders/:folderID", controller.RenameConversationFolder)
+	messagingRoutes.DELETE("/folders/:folderID", controller.DeleteConversationFolder)
+	messagingRoutes.PUT("/folders/:folderID/conversations/:conversationID", controller.AddConversationToFolder)
+	messagingRoutes.DELETE("/folders/:folderID/conversations/:conversationID", controller.RemoveConversationFromFolder)
 
 	// Notification routes
 	router.GET("/notifications", controller.GetNotifications)
 	router.PUT("/notifications/:notificationID/read", controller.MarkNotificationAsRead)
 	router.PUT("/notifications/read-all", controller.MarkAllNotificationsAsRead)
 	router.DELETE("/notifications/:notificationID", controller.DeleteNotification)
+	router.GET("/mentions", controller.GetMentions)
 
 	// Call management routes
 	callRoutes := router.Group("/calls")
@@ -159,6 +283,25 @@ func AttachRoutes(router *echo.Group, controller *controller.Controller) {
 	router.GET("/ws", controller.HandleWebSocket)
 }
 
+// newAttachmentStorage creates the attachment storage backend selected in the config
+func newAttachmentStorage(ctx context.Context, cfg Config) (storage.Storage, error) {
+	switch cfg.StorageBackend {
+	case "local":
+		return storage.NewLocal(cfg.StorageLocalDir)
+	case "s3":
+		return storage.NewS3(ctx, storage.S3Config{
+			Endpoint:  cfg.S3Endpoint,
+			AccessKey: cfg.S3AccessKey,
+			SecretKey: cfg.S3SecretKey,
+			Bucket:    cfg.S3Bucket,
+			Region:    cfg.S3Region,
+			UseSSL:    cfg.S3UseSSL,
+		})
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", cfg.StorageBackend)
+	}
+}
+
 // Thsi is a TODO:
 
 // setupDatabaseOptimizations applies database performance optimizations
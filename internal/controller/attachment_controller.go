@@ -0,0 +1,241 @@
+package controller
+
+import (
+	"kakashi/chaos/internal/services"
+	"kakashi/chaos/internal/utility"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// multipartOverhead leaves room for form fields and boundaries on top of the file size limit
+const multipartOverhead = 1 << 20
+
+// SendAttachmentMessage handles POST /conversations/:conversationID/messages/attachments
+func (c *Controller) SendAttachmentMessage(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	conversationID := e.Param("conversationID")
+	if conversationID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Conversation ID is required",
+		})
+	}
+
+	// Cap the request body before the multipart form is parsed
+	if maxSize := c.services.MaxAttachmentSize(); maxSize > 0 {
+		e.Request().Body = http.MaxBytesReader(e.Response(), e.Request().Body, maxSize+multipartOverhead)
+	}
+
+	fileHeader, err := e.FormFile("file")
+	if err != nil {
+		if strings.Contains(err.Error(), "request body too large") {
+			return e.JSON(http.StatusRequestEntityTooLarge, ErrorResponse{
+				Code:    http.StatusRequestEntityTooLarge,
+				Message: "Attachment is too large",
+			})
+		}
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "File is required",
+		})
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: utility.ErrInvalidInput,
+		})
+	}
+	defer file.Close()
+
+	message, err := c.services.SendAttachmentMessage(ctx, authUserID, conversationID, e.FormValue("caption"),
+		services.AttachmentUpload{
+			Filename: fileHeader.Filename,
+			Size:     fileHeader.Size,
+			Reader:   file,
+		},
+		services.SendMessageOptions{
+			ReplyToID:      e.FormValue("reply_to_id"),
+			ThreadRootID:   e.FormValue("thread_root_id"),
+			SuppressEmbeds: e.FormValue("suppress_embeds") == "true",
+		})
+	if err != nil {
+		if err.Error() == "sender not found: not found" || err.Error() == "conversation not found: not found" {
+			return e.JSON(http.StatusNotFound, ErrorResponse{
+				Code:    http.StatusNotFound,
+				Message: "Resource not found",
+			})
+		}
+		if err.Error() == "user is not a participant in this conversation" {
+			return e.JSON(http.StatusForbidden, ErrorResponse{
+				Code:    http.StatusForbidden,
+				Message: "Not authorized to send messages in this conversation",
+			})
+		}
+		if err.Error() == "can only send messages to friends" || err.Error() == "cannot send message to blocked user" {
+			return e.JSON(http.StatusForbidden, ErrorResponse{
+				Code:    http.StatusForbidden,
+				Message: "Cannot send message to this user",
+			})
+		}
+		if err.Error() == "attachment is too large" {
+			return e.JSON(http.StatusRequestEntityTooLarge, ErrorResponse{
+				Code:    http.StatusRequestEntityTooLarge,
+				Message: "Attachment is too large",
+			})
+		}
+		if err.Error() == "attachment is empty" || err.Error() == "reply target not found" ||
+			err.Error() == "thread root not found" || err.Error() == "cannot start a thread from a thread reply" ||
+			err.Error() == "invalid link url" || err.Error() == "too many formatting entities" || err.Error() == "message content is too long" {
+			return e.JSON(http.StatusBadRequest, ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: err.Error(),
+			})
+		}
+		if err.Error() == "attachment storage is not configured" {
+			return e.JSON(http.StatusServiceUnavailable, ErrorResponse{
+				Code:    http.StatusServiceUnavailable,
+				Message: "Attachments are not available",
+			})
+		}
+		c.log.Error("controller: send attachment message failed", "error", err.Error())
+		return e.JSON(http.StatusInternalServerError, ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: utility.ErrInternalError,
+		})
+	}
+
+	return e.JSON(http.StatusCreated, message)
+}
+
+// GetAttachmentURL handles GET /attachments/:attachmentID/url
+func (c *Controller) GetAttachmentURL(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	attachmentID := e.Param("attachmentID")
+	if attachmentID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Attachment ID is required",
+		})
+	}
+
+	signedURL, err := c.services.GetAttachmentURL(ctx, attachmentID, authUserID, e.QueryParam("variant"))
+	if err != nil {
+		if err.Error() == "attachment not found" || err.Error() == "thumbnail not available" {
+			return e.JSON(http.StatusNotFound, ErrorResponse{
+				Code:    http.StatusNotFound,
+				Message: "Attachment not found",
+			})
+		}
+		if err.Error() == "user is not a participant in this conversation" {
+			return e.JSON(http.StatusForbidden, ErrorResponse{
+				Code:    http.StatusForbidden,
+				Message: "Not authorized to access this attachment",
+			})
+		}
+		if err.Error() == "invalid attachment variant" {
+			return e.JSON(http.StatusBadRequest, ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: err.Error(),
+			})
+		}
+		if err.Error() == "attachment is still processing" {
+			return e.JSON(http.StatusConflict, ErrorResponse{
+				Code:    http.StatusConflict,
+				Message: "Attachment is still processing",
+			})
+		}
+		c.log.Error("controller: get attachment url failed", "error", err.Error())
+		return e.JSON(http.StatusInternalServerError, ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: utility.ErrInternalError,
+		})
+	}
+
+	return e.JSON(http.StatusOK, signedURL)
+}
+
+// DownloadAttachment handles GET /attachments/:attachmentID/download, authorized by the URL signature
+func (c *Controller) DownloadAttachment(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	attachmentID := e.Param("attachmentID")
+	if attachmentID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Attachment ID is required",
+		})
+	}
+
+	content, err := c.services.OpenSignedAttachment(ctx, attachmentID, e.QueryParam("uid"), e.QueryParam("variant"), e.QueryParam("expires"), e.QueryParam("signature"))
+	if err != nil {
+		if err.Error() == "invalid download signature" || err.Error() == "download link has expired" ||
+			err.Error() == "user is not a participant in this conversation" || err.Error() == "invalid attachment variant" {
+			return e.JSON(http.StatusForbidden, ErrorResponse{
+				Code:    http.StatusForbidden,
+				Message: "Download link is invalid or has expired",
+			})
+		}
+		if err.Error() == "attachment not found" || err.Error() == "thumbnail not available" {
+			return e.JSON(http.StatusNotFound, ErrorResponse{
+				Code:    http.StatusNotFound,
+				Message: "Attachment not found",
+			})
+		}
+		if err.Error() == "attachment is still processing" {
+			return e.JSON(http.StatusConflict, ErrorResponse{
+				Code:    http.StatusConflict,
+				Message: "Attachment is still processing",
+			})
+		}
+		c.log.Error("controller: download attachment failed", "error", err.Error())
+		return e.JSON(http.StatusInternalServerError, ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: utility.ErrInternalError,
+		})
+	}
+
+	// Only known image types are rendered inline, everything else is forced to download
+	disposition := "attachment"
+	if services.IsInlineAttachment(content.ContentType) {
+		disposition = "inline"
+	}
+	e.Response().Header().Set(echo.HeaderContentDisposition, mime.FormatMediaType(disposition, map[string]string{"filename": content.Filename}))
+
+	return c.streamContent(e, content, "private, max-age=900")
+}
+
+// streamContent writes opened storage content with headers that stop browsers from sniffing it
+func (c *Controller) streamContent(e echo.Context, content *services.AttachmentContent, cacheControl string) error {
+	defer content.Body.Close()
+
+	header := e.Response().Header()
+	if content.Size > 0 {
+		header.Set(echo.HeaderContentLength, strconv.FormatInt(content.Size, 10))
+	}
+	header.Set(echo.HeaderXContentTypeOptions, "nosniff")
+	header.Set("Cache-Control", cacheControl)
+
+	return e.Stream(http.StatusOK, content.ContentType, content.Body)
+}
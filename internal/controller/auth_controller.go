@@ -105,7 +105,7 @@ func (c *Controller) Signin(e echo.Context) error {
 	}
 	err = c.services.CreateSession(ctx, user.ID, token, e.RealIP(), e.Request().UserAgent())
 	if err != nil {
-		c.log.Error("controller: session creation failed", err.Error())
+		c.log.Error("controller: session creation failed", "error", err.Error())
 		return e.JSON(http.StatusInternalServerError, ErrorResponse{
 			Code:    http.StatusInternalServerError,
 			Message: utility.ErrInternalError,
@@ -129,7 +129,7 @@ func (c *Controller) Signout(e echo.Context) error {
 	}
 	err = c.services.DeleteSessionByToken(ctx, token)
 	if err != nil {
-		c.log.Error("controller: session deletion failed", err.Error())
+		c.log.Error("controller: session deletion failed", "error", err.Error())
 		return e.JSON(http.StatusInternalServerError, ErrorResponse{
 			Code:    http.StatusInternalServerError,
 			Message: utility.ErrInternalError,
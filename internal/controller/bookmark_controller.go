@@ -0,0 +1,202 @@
+package controller
+
+import (
+	"kakashi/chaos/internal/services"
+	"kakashi/chaos/internal/utility"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// bookmarkErrorResponse maps bookmark service errors to HTTP responses
+func (c *Controller) bookmarkErrorResponse(e echo.Context, err error, action string) error {
+	if isPageRequestError(err) {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	switch err.Error() {
+	case "message not found", "bookmark not found":
+		return e.JSON(http.StatusNotFound, ErrorResponse{
+			Code:    http.StatusNotFound,
+			Message: err.Error(),
+		})
+	case "message is already saved":
+		return e.JSON(http.StatusConflict, ErrorResponse{
+			Code:    http.StatusConflict,
+			Message: err.Error(),
+		})
+	case "note is too long", "reminder time must be in the future", "reminder time is too far in the future":
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	c.log.Error("controller: "+action+" failed", "error", err.Error())
+	return e.JSON(http.StatusInternalServerError, ErrorResponse{
+		Code:    http.StatusInternalServerError,
+		Message: utility.ErrInternalError,
+	})
+}
+
+// SaveMessage handles POST /bookmarks
+func (c *Controller) SaveMessage(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	type saveMessageInput struct {
+		MessageID string     `json:"message_id" validate:"required"`
+		Note      string     `json:"note,omitempty"`
+		RemindAt  *time.Time `json:"remind_at,omitempty"`
+	}
+
+	input := new(saveMessageInput)
+	if err := e.Bind(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: utility.ErrInvalidInput,
+		})
+	}
+
+	if err := e.Validate(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	bookmark := services.BookmarkInput{
+		MessageID: input.MessageID,
+		Note:      input.Note,
+	}
+	if input.RemindAt != nil {
+		bookmark.RemindAt = *input.RemindAt
+	}
+
+	saved, err := c.services.SaveMessage(ctx, authUserID, bookmark)
+	if err != nil {
+		return c.bookmarkErrorResponse(e, err, "save message")
+	}
+
+	return e.JSON(http.StatusCreated, saved)
+}
+
+// GetSavedMessages handles GET /bookmarks
+func (c *Controller) GetSavedMessages(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	filter := services.BookmarkFilter{
+		ConversationID:   e.QueryParam("conversation_id"),
+		PendingReminders: e.QueryParam("reminders") == "pending",
+	}
+
+	saved, err := c.services.GetSavedMessages(ctx, authUserID, filter, parsePageRequest(e, 20))
+	if err != nil {
+		return c.bookmarkErrorResponse(e, err, "get saved messages")
+	}
+
+	return e.JSON(http.StatusOK, saved)
+}
+
+// UpdateBookmark handles PATCH /bookmarks/:bookmarkID
+func (c *Controller) UpdateBookmark(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	bookmarkID := e.Param("bookmarkID")
+	if bookmarkID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Bookmark ID is required",
+		})
+	}
+
+	type updateBookmarkInput struct {
+		Note          *string    `json:"note,omitempty"`
+		RemindAt      *time.Time `json:"remind_at,omitempty"`
+		ClearReminder bool       `json:"clear_reminder,omitempty"`
+	}
+
+	input := new(updateBookmarkInput)
+	if err := e.Bind(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: utility.ErrInvalidInput,
+		})
+	}
+
+	if input.Note == nil && input.RemindAt == nil && !input.ClearReminder {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Note, remind_at or clear_reminder is required",
+		})
+	}
+	if input.RemindAt != nil && input.ClearReminder {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "remind_at and clear_reminder cannot be used together",
+		})
+	}
+
+	saved, err := c.services.UpdateBookmark(ctx, bookmarkID, authUserID, services.BookmarkUpdate{
+		Note:          input.Note,
+		RemindAt:      input.RemindAt,
+		ClearReminder: input.ClearReminder,
+	})
+	if err != nil {
+		return c.bookmarkErrorResponse(e, err, "update bookmark")
+	}
+
+	return e.JSON(http.StatusOK, saved)
+}
+
+// DeleteBookmark handles DELETE /bookmarks/:bookmarkID
+func (c *Controller) DeleteBookmark(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	bookmarkID := e.Param("bookmarkID")
+	if bookmarkID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Bookmark ID is required",
+		})
+	}
+
+	if err := c.services.DeleteBookmark(ctx, bookmarkID, authUserID); err != nil {
+		return c.bookmarkErrorResponse(e, err, "delete bookmark")
+	}
+
+	return e.JSON(http.StatusOK, echo.Map{
+		"message": "Bookmark removed successfully",
+	})
+}
@@ -3,7 +3,6 @@ package controller
 import (
 	"kakashi/chaos/internal/utility"
 	"net/http"
-	"strconv"
 	"strings"
 
 	"github.com/labstack/echo/v4"
@@ -285,25 +284,16 @@ func (c *Controller) GetCallHistory(e echo.Context) error {
 	}
 
 	// Parse pagination parameters
-	limitStr := e.QueryParam("limit")
-	offsetStr := e.QueryParam("offset")
+	page := parsePageRequest(e, 20)
 
-	limit := 20 // default limit
-	if limitStr != "" {
-		if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 && parsedLimit <= 100 {
-			limit = parsedLimit
-		}
-	}
-
-	offset := 0 // default offset
-	if offsetStr != "" {
-		if parsedOffset, err := strconv.Atoi(offsetStr); err == nil && parsedOffset >= 0 {
-			offset = parsedOffset
-		}
-	}
-
-	calls, err := c.services.GetCallHistory(ctx, authUserID, limit, offset)
+	calls, err := c.services.GetCallHistory(ctx, authUserID, page)
 	if err != nil {
+		if isPageRequestError(err) {
+			return e.JSON(http.StatusBadRequest, ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: err.Error(),
+			})
+		}
 		if strings.Contains(err.Error(), "user not found") {
 			return e.JSON(http.StatusNotFound, ErrorResponse{
 				Code:    http.StatusNotFound,
@@ -8,6 +8,13 @@ import (
 type Controller struct {
 	services *services.Services
 	log      *slog.Logger
+	limits   RateLimits
+}
+
+// RateLimits applies the HTTP rate limits to requests that arrive over WebSocket
+type RateLimits interface {
+	AllowRequest(userID string) bool
+	AllowMessage(userID string) bool
 }
 
 func New(services *services.Services) *Controller {
@@ -17,6 +24,11 @@ func New(services *services.Services) *Controller {
 	}
 }
 
+// SetRateLimits sets the limits WebSocket commands count against, commands are not limited without them
+func (c *Controller) SetRateLimits(limits RateLimits) {
+	c.limits = limits
+}
+
 // GetServices returns the services instance
 func (c *Controller) GetServices() *services.Services {
 	return c.services
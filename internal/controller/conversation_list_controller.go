@@ -0,0 +1,353 @@
+package controller
+
+import (
+	"kakashi/chaos/internal/utility"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// conversationListErrorResponse maps pin and folder service errors to HTTP responses
+func (c *Controller) conversationListErrorResponse(e echo.Context, err error, action string) error {
+	switch err.Error() {
+	case "folder not found", "conversation is not in this folder":
+		return e.JSON(http.StatusNotFound, ErrorResponse{
+			Code:    http.StatusNotFound,
+			Message: err.Error(),
+		})
+	case "user is not a participant in this conversation":
+		return e.JSON(http.StatusForbidden, ErrorResponse{
+			Code:    http.StatusForbidden,
+			Message: "Not authorized to access this conversation",
+		})
+	case "folder name is already used":
+		return e.JSON(http.StatusConflict, ErrorResponse{
+			Code:    http.StatusConflict,
+			Message: err.Error(),
+		})
+	case "too many pinned conversations", "too many folders", "folder name is required", "folder name is too long",
+		"order must list every pinned conversation once", "order must list every folder once":
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	c.log.Error("controller: "+action+" failed", "error", err.Error())
+	return e.JSON(http.StatusInternalServerError, ErrorResponse{
+		Code:    http.StatusInternalServerError,
+		Message: utility.ErrInternalError,
+	})
+}
+
+// PinConversation pins a conversation to the top of the authenticated user's list
+// PUT /conversations/:conversationID/pin
+func (c *Controller) PinConversation(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	conversationID := e.Param("conversationID")
+	if conversationID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Conversation ID is required",
+		})
+	}
+
+	if err := c.services.PinConversation(ctx, conversationID, authUserID); err != nil {
+		return c.conversationListErrorResponse(e, err, "pin conversation")
+	}
+
+	return e.JSON(http.StatusOK, echo.Map{
+		"message": "Conversation pinned successfully",
+	})
+}
+
+// UnpinConversation unpins a conversation from the authenticated user's list
+// PUT /conversations/:conversationID/unpin
+func (c *Controller) UnpinConversation(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	conversationID := e.Param("conversationID")
+	if conversationID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Conversation ID is required",
+		})
+	}
+
+	if err := c.services.UnpinConversation(ctx, conversationID, authUserID); err != nil {
+		return c.conversationListErrorResponse(e, err, "unpin conversation")
+	}
+
+	return e.JSON(http.StatusOK, echo.Map{
+		"message": "Conversation unpinned successfully",
+	})
+}
+
+// ReorderPinnedConversations sets the order of the authenticated user's pinned conversations
+// PUT /conversations/pinned/order
+func (c *Controller) ReorderPinnedConversations(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	type reorderPinsInput struct {
+		ConversationIDs []string `json:"conversation_ids"`
+	}
+
+	input := new(reorderPinsInput)
+	if err := e.Bind(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: utility.ErrInvalidInput,
+		})
+	}
+
+	if err := c.services.ReorderPinnedConversations(ctx, authUserID, input.ConversationIDs); err != nil {
+		return c.conversationListErrorResponse(e, err, "reorder pinned conversations")
+	}
+
+	return e.JSON(http.StatusOK, echo.Map{
+		"message": "Pinned conversations reordered successfully",
+	})
+}
+
+// GetConversationFolders handles GET /folders
+func (c *Controller) GetConversationFolders(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	folders, err := c.services.GetConversationFolders(ctx, authUserID)
+	if err != nil {
+		return c.conversationListErrorResponse(e, err, "get folders")
+	}
+
+	return e.JSON(http.StatusOK, folders)
+}
+
+// CreateConversationFolder handles POST /folders
+func (c *Controller) CreateConversationFolder(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	type createFolderInput struct {
+		Name string `json:"name" validate:"required"`
+	}
+
+	input := new(createFolderInput)
+	if err := e.Bind(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: utility.ErrInvalidInput,
+		})
+	}
+
+	if err := e.Validate(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	folder, err := c.services.CreateConversationFolder(ctx, authUserID, input.Name)
+	if err != nil {
+		return c.conversationListErrorResponse(e, err, "create folder")
+	}
+
+	return e.JSON(http.StatusCreated, folder)
+}
+
+// RenameConversationFolder handles PATCH /folders/:folderID
+func (c *Controller) RenameConversationFolder(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	folderID := e.Param("folderID")
+	if folderID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Folder ID is required",
+		})
+	}
+
+	type renameFolderInput struct {
+		Name string `json:"name" validate:"required"`
+	}
+
+	input := new(renameFolderInput)
+	if err := e.Bind(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: utility.ErrInvalidInput,
+		})
+	}
+
+	if err := e.Validate(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	folder, err := c.services.RenameConversationFolder(ctx, folderID, authUserID, input.Name)
+	if err != nil {
+		return c.conversationListErrorResponse(e, err, "rename folder")
+	}
+
+	return e.JSON(http.StatusOK, folder)
+}
+
+// DeleteConversationFolder handles DELETE /folders/:folderID
+func (c *Controller) DeleteConversationFolder(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	folderID := e.Param("folderID")
+	if folderID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Folder ID is required",
+		})
+	}
+
+	if err := c.services.DeleteConversationFolder(ctx, folderID, authUserID); err != nil {
+		return c.conversationListErrorResponse(e, err, "delete folder")
+	}
+
+	return e.JSON(http.StatusOK, echo.Map{
+		"message": "Folder deleted successfully",
+	})
+}
+
+// ReorderConversationFolders handles PUT /folders/order
+func (c *Controller) ReorderConversationFolders(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	type reorderFoldersInput struct {
+		FolderIDs []string `json:"folder_ids"`
+	}
+
+	input := new(reorderFoldersInput)
+	if err := e.Bind(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: utility.ErrInvalidInput,
+		})
+	}
+
+	if err := c.services.ReorderConversationFolders(ctx, authUserID, input.FolderIDs); err != nil {
+		return c.conversationListErrorResponse(e, err, "reorder folders")
+	}
+
+	return e.JSON(http.StatusOK, echo.Map{
+		"message": "Folders reordered successfully",
+	})
+}
+
+// AddConversationToFolder handles PUT /folders/:folderID/conversations/:conversationID
+func (c *Controller) AddConversationToFolder(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	folderID := e.Param("folderID")
+	conversationID := e.Param("conversationID")
+	if folderID == "" || conversationID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Folder ID and conversation ID are required",
+		})
+	}
+
+	if err := c.services.AddConversationToFolder(ctx, folderID, conversationID, authUserID); err != nil {
+		return c.conversationListErrorResponse(e, err, "add conversation to folder")
+	}
+
+	return e.JSON(http.StatusOK, echo.Map{
+		"message": "Conversation added to folder successfully",
+	})
+}
+
+// RemoveConversationFromFolder handles DELETE /folders/:folderID/conversations/:conversationID
+func (c *Controller) RemoveConversationFromFolder(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	folderID := e.Param("folderID")
+	conversationID := e.Param("conversationID")
+	if folderID == "" || conversationID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Folder ID and conversation ID are required",
+		})
+	}
+
+	if err := c.services.RemoveConversationFromFolder(ctx, folderID, conversationID, authUserID); err != nil {
+		return c.conversationListErrorResponse(e, err, "remove conversation from folder")
+	}
+
+	return e.JSON(http.StatusOK, echo.Map{
+		"message": "Conversation removed from folder successfully",
+	})
+}
@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"kakashi/chaos/internal/utility"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// draftErrorResponse maps draft service errors to HTTP responses
+func (c *Controller) draftErrorResponse(e echo.Context, err error, action string) error {
+	switch err.Error() {
+	case "user is not a participant in this conversation":
+		return e.JSON(http.StatusForbidden, ErrorResponse{
+			Code:    http.StatusForbidden,
+			Message: "Not authorized to access this conversation",
+		})
+	case "draft is too long", "reply target not found":
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	c.log.Error("controller: "+action+" failed", "error", err.Error())
+	return e.JSON(http.StatusInternalServerError, ErrorResponse{
+		Code:    http.StatusInternalServerError,
+		Message: utility.ErrInternalError,
+	})
+}
+
+// SaveDraft handles PUT /conversations/:conversationID/draft
+func (c *Controller) SaveDraft(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	conversationID := e.Param("conversationID")
+	if conversationID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Conversation ID is required",
+		})
+	}
+
+	type saveDraftInput struct {
+		Content   string `json:"content"`
+		ReplyToID string `json:"reply_to_id,omitempty"`
+	}
+
+	input := new(saveDraftInput)
+	if err := e.Bind(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: utility.ErrInvalidInput,
+		})
+	}
+
+	draft, err := c.services.SaveDraft(ctx, authUserID, conversationID, input.Content, input.ReplyToID)
+	if err != nil {
+		return c.draftErrorResponse(e, err, "save draft")
+	}
+	if draft == nil {
+		// An empty draft clears the stored one
+		return e.JSON(http.StatusOK, echo.Map{
+			"message": "Draft cleared successfully",
+		})
+	}
+
+	return e.JSON(http.StatusOK, draft)
+}
+
+// DeleteDraft handles DELETE /conversations/:conversationID/draft
+func (c *Controller) DeleteDraft(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	conversationID := e.Param("conversationID")
+	if conversationID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Conversation ID is required",
+		})
+	}
+
+	if err := c.services.DeleteDraft(ctx, authUserID, conversationID); err != nil {
+		return c.draftErrorResponse(e, err, "delete draft")
+	}
+
+	return e.JSON(http.StatusOK, echo.Map{
+		"message": "Draft cleared successfully",
+	})
+}
@@ -0,0 +1,121 @@
+package controller
+
+import (
+	"kakashi/chaos/internal/utility"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ForwardMessages handles POST /conversations/:conversationID/messages/forward
+func (c *Controller) ForwardMessages(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	conversationID := e.Param("conversationID")
+	if conversationID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Conversation ID is required",
+		})
+	}
+
+	type forwardMessagesInput struct {
+		MessageIDs []string `json:"message_ids" validate:"required,min=1"`
+	}
+
+	input := new(forwardMessagesInput)
+	if err := e.Bind(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: utility.ErrInvalidInput,
+		})
+	}
+
+	if err := e.Validate(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	messages, err := c.services.ForwardMessages(ctx, authUserID, conversationID, input.MessageIDs)
+	if err != nil {
+		switch err.Error() {
+		case "sender not found: not found", "conversation not found: not found", "message not found", "attachment not found":
+			return e.JSON(http.StatusNotFound, ErrorResponse{
+				Code:    http.StatusNotFound,
+				Message: "Resource not found",
+			})
+		case "user is not a participant in this conversation":
+			return e.JSON(http.StatusForbidden, ErrorResponse{
+				Code:    http.StatusForbidden,
+				Message: "Not authorized to send messages in this conversation",
+			})
+		case "can only send messages to friends", "cannot send message to blocked user":
+			return e.JSON(http.StatusForbidden, ErrorResponse{
+				Code:    http.StatusForbidden,
+				Message: "Cannot send message to this user",
+			})
+		case "no messages to forward", "too many messages to forward", "cannot forward this message":
+			return e.JSON(http.StatusBadRequest, ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: err.Error(),
+			})
+		case "attachment is still processing":
+			return e.JSON(http.StatusConflict, ErrorResponse{
+				Code:    http.StatusConflict,
+				Message: "Attachment is still processing",
+			})
+		case "attachment storage is not configured":
+			return e.JSON(http.StatusServiceUnavailable, ErrorResponse{
+				Code:    http.StatusServiceUnavailable,
+				Message: "Attachments are not available",
+			})
+		}
+		c.log.Error("controller: forward messages failed", "error", err.Error())
+		return e.JSON(http.StatusInternalServerError, ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: utility.ErrInternalError,
+		})
+	}
+
+	return e.JSON(http.StatusCreated, messages)
+}
+
+// GetMessageLink handles GET /messages/:messageID/link
+func (c *Controller) GetMessageLink(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	messageID := e.Param("messageID")
+	if messageID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Message ID is required",
+		})
+	}
+
+	link, err := c.services.ResolveMessageLink(ctx, messageID, authUserID)
+	if err != nil {
+		c.log.Error("controller: resolve message link failed", "error", err.Error())
+		return e.JSON(http.StatusInternalServerError, ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: utility.ErrInternalError,
+		})
+	}
+
+	return e.JSON(http.StatusOK, link)
+}
@@ -0,0 +1,237 @@
+package controller
+
+import (
+	"kakashi/chaos/internal/utility"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// CreateFriendInvite handles POST /friends/invites
+func (c *Controller) CreateFriendInvite(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	type createFriendInviteInput struct {
+		AutoAccept *bool      `json:"auto_accept,omitempty"`
+		MaxUses    int        `json:"max_uses,omitempty" validate:"min=0,max=1000"`
+		ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	}
+
+	input := new(createFriendInviteInput)
+	if err := e.Bind(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: utility.ErrInvalidInput,
+		})
+	}
+
+	if err := e.Validate(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	// Default to creating accepted friendships when not specified
+	autoAccept := true
+	if input.AutoAccept != nil {
+		autoAccept = *input.AutoAccept
+	}
+
+	invite, err := c.services.CreateFriendInvite(ctx, authUserID, autoAccept, input.MaxUses, input.ExpiresAt)
+	if err != nil {
+		if err.Error() == "max uses cannot be negative" || err.Error() == "expiry must be in the future" {
+			return e.JSON(http.StatusBadRequest, ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: err.Error(),
+			})
+		}
+		c.log.Error("controller: create friend invite failed", "error", err.Error())
+		return e.JSON(http.StatusInternalServerError, ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: utility.ErrInternalError,
+		})
+	}
+
+	return e.JSON(http.StatusCreated, invite)
+}
+
+// GetFriendInvites handles GET /friends/invites
+func (c *Controller) GetFriendInvites(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	invites, err := c.services.GetFriendInvites(ctx, authUserID)
+	if err != nil {
+		c.log.Error("controller: get friend invites failed", "error", err.Error())
+		return e.JSON(http.StatusInternalServerError, ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: utility.ErrInternalError,
+		})
+	}
+
+	return e.JSON(http.StatusOK, invites)
+}
+
+// GetFriendInvite handles GET /friends/invites/:code
+func (c *Controller) GetFriendInvite(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	code := e.Param("code")
+	if code == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Invite code is required",
+		})
+	}
+
+	invite, err := c.services.GetFriendInviteByCode(ctx, code)
+	if err != nil {
+		if err.Error() == "friend invite not found" {
+			return e.JSON(http.StatusNotFound, ErrorResponse{
+				Code:    http.StatusNotFound,
+				Message: "Friend invite not found",
+			})
+		}
+		if strings.HasPrefix(err.Error(), "friend invite has") {
+			return e.JSON(http.StatusGone, ErrorResponse{
+				Code:    http.StatusGone,
+				Message: err.Error(),
+			})
+		}
+		c.log.Error("controller: get friend invite failed", "error", err.Error())
+		return e.JSON(http.StatusInternalServerError, ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: utility.ErrInternalError,
+		})
+	}
+
+	return e.JSON(http.StatusOK, echo.Map{
+		"code":        invite.Code,
+		"owner":       invite.Edges.Owner,
+		"auto_accept": invite.AutoAccept,
+		"expires_at":  invite.ExpiresAt,
+	})
+}
+
+// RedeemFriendInvite handles POST /friends/invites/:code/redeem
+func (c *Controller) RedeemFriendInvite(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	code := e.Param("code")
+	if code == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Invite code is required",
+		})
+	}
+
+	redemption, err := c.services.RedeemFriendInvite(ctx, code, authUserID)
+	if err != nil {
+		if err.Error() == "friend invite not found" || err.Error() == "user not found: not found" {
+			return e.JSON(http.StatusNotFound, ErrorResponse{
+				Code:    http.StatusNotFound,
+				Message: "Friend invite not found",
+			})
+		}
+		if strings.HasPrefix(err.Error(), "friend invite has") {
+			return e.JSON(http.StatusGone, ErrorResponse{
+				Code:    http.StatusGone,
+				Message: err.Error(),
+			})
+		}
+		if err.Error() == "cannot redeem your own friend invite" {
+			return e.JSON(http.StatusBadRequest, ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Cannot redeem your own friend invite",
+			})
+		}
+		if err.Error() == "cannot redeem friend invite from blocked user" {
+			return e.JSON(http.StatusForbidden, ErrorResponse{
+				Code:    http.StatusForbidden,
+				Message: "Cannot redeem this friend invite",
+			})
+		}
+		if err.Error() == "users are already friends" || err.Error() == "friend request already exists" || err.Error() == "friend invite already redeemed" {
+			return e.JSON(http.StatusConflict, ErrorResponse{
+				Code:    http.StatusConflict,
+				Message: err.Error(),
+			})
+		}
+		c.log.Error("controller: redeem friend invite failed", "error", err.Error())
+		return e.JSON(http.StatusInternalServerError, ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: utility.ErrInternalError,
+		})
+	}
+
+	return e.JSON(http.StatusOK, redemption)
+}
+
+// RevokeFriendInvite handles DELETE /friends/invites/:inviteID
+func (c *Controller) RevokeFriendInvite(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	inviteID := e.Param("inviteID")
+	if inviteID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Invite ID is required",
+		})
+	}
+
+	err := c.services.RevokeFriendInvite(ctx, inviteID, authUserID)
+	if err != nil {
+		if err.Error() == "friend invite not found" {
+			return e.JSON(http.StatusNotFound, ErrorResponse{
+				Code:    http.StatusNotFound,
+				Message: "Friend invite not found",
+			})
+		}
+		c.log.Error("controller: revoke friend invite failed", "error", err.Error())
+		return e.JSON(http.StatusInternalServerError, ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: utility.ErrInternalError,
+		})
+	}
+
+	return e.JSON(http.StatusOK, echo.Map{
+		"message": "Friend invite revoked successfully",
+	})
+}
@@ -0,0 +1,332 @@
+package controller
+
+import (
+	"kakashi/chaos/internal/utility"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// groupErrorResponse maps group service errors to HTTP responses
+func (c *Controller) groupErrorResponse(e echo.Context, err error, action string) error {
+	switch err.Error() {
+	case "conversation not found: not found", "participant not found", "member not found", "creator not found: not found":
+		return e.JSON(http.StatusNotFound, ErrorResponse{
+			Code:    http.StatusNotFound,
+			Message: "Resource not found",
+		})
+	case "user is not a participant in this conversation", "insufficient permissions", "cannot remove the group owner",
+		"can only add friends to a group", "cannot add blocked user to group":
+		return e.JSON(http.StatusForbidden, ErrorResponse{
+			Code:    http.StatusForbidden,
+			Message: err.Error(),
+		})
+	case "user is already a participant":
+		return e.JSON(http.StatusConflict, ErrorResponse{
+			Code:    http.StatusConflict,
+			Message: err.Error(),
+		})
+	case "conversation is not a group", "group name is required", "group name is too long",
+		"group must include at least one other member", "group participant limit reached",
+		"no users to add", "invalid role", "cannot change your own role",
+		"invalid message ttl", "message ttl exceeds the retention ceiling":
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	c.log.Error("controller: "+action+" failed", "error", err.Error())
+	return e.JSON(http.StatusInternalServerError, ErrorResponse{
+		Code:    http.StatusInternalServerError,
+		Message: utility.ErrInternalError,
+	})
+}
+
+// CreateGroupConversation handles POST /conversations/group
+func (c *Controller) CreateGroupConversation(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	type createGroupInput struct {
+		Name      string   `json:"name" validate:"required,max=100"`
+		IconURL   string   `json:"icon_url,omitempty" validate:"omitempty,url"`
+		MemberIDs []string `json:"member_ids" validate:"required,min=1"`
+	}
+
+	input := new(createGroupInput)
+	if err := e.Bind(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: utility.ErrInvalidInput,
+		})
+	}
+
+	if err := e.Validate(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	conversation, err := c.services.CreateGroupConversation(ctx, authUserID, input.Name, input.IconURL, input.MemberIDs)
+	if err != nil {
+		return c.groupErrorResponse(e, err, "create group conversation")
+	}
+
+	return e.JSON(http.StatusCreated, conversation)
+}
+
+// AddGroupParticipants handles POST /conversations/:conversationID/participants
+func (c *Controller) AddGroupParticipants(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	conversationID := e.Param("conversationID")
+	if conversationID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Conversation ID is required",
+		})
+	}
+
+	type addParticipantsInput struct {
+		UserIDs []string `json:"user_ids" validate:"required,min=1"`
+	}
+
+	input := new(addParticipantsInput)
+	if err := e.Bind(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: utility.ErrInvalidInput,
+		})
+	}
+
+	if err := e.Validate(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	err := c.services.AddGroupParticipants(ctx, conversationID, authUserID, input.UserIDs)
+	if err != nil {
+		return c.groupErrorResponse(e, err, "add group participants")
+	}
+
+	return e.JSON(http.StatusOK, echo.Map{
+		"message": "Participants added successfully",
+	})
+}
+
+// RemoveGroupParticipant handles DELETE /conversations/:conversationID/participants/:userID
+func (c *Controller) RemoveGroupParticipant(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	conversationID := e.Param("conversationID")
+	userID := e.Param("userID")
+	if conversationID == "" || userID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Conversation ID and user ID are required",
+		})
+	}
+
+	err := c.services.RemoveGroupParticipant(ctx, conversationID, authUserID, userID)
+	if err != nil {
+		return c.groupErrorResponse(e, err, "remove group participant")
+	}
+
+	return e.JSON(http.StatusOK, echo.Map{
+		"message": "Participant removed successfully",
+	})
+}
+
+// UpdateGroupParticipantRole handles PUT /conversations/:conversationID/participants/:userID/role
+func (c *Controller) UpdateGroupParticipantRole(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	conversationID := e.Param("conversationID")
+	userID := e.Param("userID")
+	if conversationID == "" || userID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Conversation ID and user ID are required",
+		})
+	}
+
+	type updateRoleInput struct {
+		Role string `json:"role" validate:"required,oneof=owner admin member"`
+	}
+
+	input := new(updateRoleInput)
+	if err := e.Bind(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: utility.ErrInvalidInput,
+		})
+	}
+
+	if err := e.Validate(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	err := c.services.UpdateGroupParticipantRole(ctx, conversationID, authUserID, userID, input.Role)
+	if err != nil {
+		return c.groupErrorResponse(e, err, "update group participant role")
+	}
+
+	return e.JSON(http.StatusOK, echo.Map{
+		"message": "Participant role updated successfully",
+	})
+}
+
+// RenameGroupConversation handles PUT /conversations/:conversationID/name
+func (c *Controller) RenameGroupConversation(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	conversationID := e.Param("conversationID")
+	if conversationID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Conversation ID is required",
+		})
+	}
+
+	type renameGroupInput struct {
+		Name string `json:"name" validate:"required,max=100"`
+	}
+
+	input := new(renameGroupInput)
+	if err := e.Bind(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: utility.ErrInvalidInput,
+		})
+	}
+
+	if err := e.Validate(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	conversation, err := c.services.RenameGroupConversation(ctx, conversationID, authUserID, input.Name)
+	if err != nil {
+		return c.groupErrorResponse(e, err, "rename group conversation")
+	}
+
+	return e.JSON(http.StatusOK, conversation)
+}
+
+// SetGroupConversationIcon handles PUT /conversations/:conversationID/icon
+func (c *Controller) SetGroupConversationIcon(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	conversationID := e.Param("conversationID")
+	if conversationID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Conversation ID is required",
+		})
+	}
+
+	type setIconInput struct {
+		IconURL string `json:"icon_url" validate:"omitempty,url"`
+	}
+
+	input := new(setIconInput)
+	if err := e.Bind(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: utility.ErrInvalidInput,
+		})
+	}
+
+	if err := e.Validate(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	conversation, err := c.services.SetGroupConversationIcon(ctx, conversationID, authUserID, input.IconURL)
+	if err != nil {
+		return c.groupErrorResponse(e, err, "set group conversation icon")
+	}
+
+	return e.JSON(http.StatusOK, conversation)
+}
+
+// LeaveGroupConversation handles POST /conversations/:conversationID/leave
+func (c *Controller) LeaveGroupConversation(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	conversationID := e.Param("conversationID")
+	if conversationID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Conversation ID is required",
+		})
+	}
+
+	err := c.services.LeaveGroupConversation(ctx, conversationID, authUserID)
+	if err != nil {
+		return c.groupErrorResponse(e, err, "leave group conversation")
+	}
+
+	return e.JSON(http.StatusOK, echo.Map{
+		"message": "Left conversation successfully",
+	})
+}
@@ -36,7 +36,7 @@ func (c *Controller) CreateGuild(e echo.Context) error {
 	}
 	guild, err := c.services.CreateGuild(ctx, input.Name, authUser_id)
 	if err != nil {
-		c.log.Error("controller: guild creation failed", err.Error())
+		c.log.Error("controller: guild creation failed", "error", err.Error())
 		return e.JSON(http.StatusInternalServerError, ErrorResponse{
 			Code:    http.StatusInternalServerError,
 			Message: utility.ErrInternalError,
@@ -0,0 +1,163 @@
+package controller
+
+import (
+	"kakashi/chaos/internal/services"
+	"kakashi/chaos/internal/utility"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// readImageUpload opens the multipart "file" field; a nil close func means the error response was already written
+func (c *Controller) readImageUpload(e echo.Context) (services.AttachmentUpload, func(), error) {
+	if maxSize := c.services.MaxAttachmentSize(); maxSize > 0 {
+		e.Request().Body = http.MaxBytesReader(e.Response(), e.Request().Body, maxSize+multipartOverhead)
+	}
+
+	fileHeader, err := e.FormFile("file")
+	if err != nil {
+		if strings.Contains(err.Error(), "request body too large") {
+			return services.AttachmentUpload{}, nil, e.JSON(http.StatusRequestEntityTooLarge, ErrorResponse{
+				Code:    http.StatusRequestEntityTooLarge,
+				Message: "Image is too large",
+			})
+		}
+		return services.AttachmentUpload{}, nil, e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "File is required",
+		})
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return services.AttachmentUpload{}, nil, e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: utility.ErrInvalidInput,
+		})
+	}
+
+	return services.AttachmentUpload{
+		Filename: fileHeader.Filename,
+		Size:     fileHeader.Size,
+		Reader:   file,
+	}, func() { file.Close() }, nil
+}
+
+// imageUploadErrorResponse maps image upload service errors to HTTP responses
+func (c *Controller) imageUploadErrorResponse(e echo.Context, err error, action string) error {
+	switch err.Error() {
+	case "user not found", "guild not found":
+		return e.JSON(http.StatusNotFound, ErrorResponse{
+			Code:    http.StatusNotFound,
+			Message: "Resource not found",
+		})
+	case "insufficient permissions":
+		return e.JSON(http.StatusForbidden, ErrorResponse{
+			Code:    http.StatusForbidden,
+			Message: err.Error(),
+		})
+	case "image is too large":
+		return e.JSON(http.StatusRequestEntityTooLarge, ErrorResponse{
+			Code:    http.StatusRequestEntityTooLarge,
+			Message: "Image is too large",
+		})
+	case "image is empty", "unsupported image type":
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	case "attachment storage is not configured":
+		return e.JSON(http.StatusServiceUnavailable, ErrorResponse{
+			Code:    http.StatusServiceUnavailable,
+			Message: "Image uploads are not available",
+		})
+	}
+
+	c.log.Error("controller: "+action+" failed", "error", err.Error())
+	return e.JSON(http.StatusInternalServerError, ErrorResponse{
+		Code:    http.StatusInternalServerError,
+		Message: utility.ErrInternalError,
+	})
+}
+
+// UploadAvatar handles PUT /users/me/avatar
+func (c *Controller) UploadAvatar(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	upload, closeUpload, errResp := c.readImageUpload(e)
+	if closeUpload == nil {
+		return errResp
+	}
+	defer closeUpload()
+
+	user, err := c.services.SetUserAvatar(ctx, authUserID, upload)
+	if err != nil {
+		return c.imageUploadErrorResponse(e, err, "upload avatar")
+	}
+
+	return e.JSON(http.StatusOK, user)
+}
+
+// UploadGuildIcon handles PUT /guild/:guildID/icon
+func (c *Controller) UploadGuildIcon(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	guildID := e.Param("guildID")
+	if guildID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Guild ID is required",
+		})
+	}
+
+	upload, closeUpload, errResp := c.readImageUpload(e)
+	if closeUpload == nil {
+		return errResp
+	}
+	defer closeUpload()
+
+	guild, err := c.services.SetGuildIcon(ctx, guildID, authUserID, upload)
+	if err != nil {
+		return c.imageUploadErrorResponse(e, err, "upload guild icon")
+	}
+
+	return e.JSON(http.StatusOK, guild)
+}
+
+// GetPublicMedia handles GET /media/* for avatars and guild icons
+func (c *Controller) GetPublicMedia(e echo.Context) error {
+	ctx := e.Request().Context()
+
+	content, err := c.services.OpenPublicMedia(ctx, e.Param("*"))
+	if err != nil {
+		if err.Error() == "media not found" {
+			return e.JSON(http.StatusNotFound, ErrorResponse{
+				Code:    http.StatusNotFound,
+				Message: "Media not found",
+			})
+		}
+		c.log.Error("controller: get public media failed", "error", err.Error())
+		return e.JSON(http.StatusInternalServerError, ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: utility.ErrInternalError,
+		})
+	}
+
+	// Media keys are random and never rewritten, so they can be cached indefinitely
+	return c.streamContent(e, content, "public, max-age=31536000, immutable")
+}
@@ -5,6 +5,7 @@ import (
 	"kakashi/chaos/internal/utility"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/labstack/echo/v4"
 )
@@ -29,7 +30,11 @@ func (c *Controller) SendMessage(e echo.Context) error {
 	}
 
 	type sendMessageInput struct {
-		Content string `json:"content" validate:"required"`
+		Content        string `json:"content" validate:"required"`
+		ReplyToID      string `json:"reply_to_id,omitempty"`
+		ThreadRootID   string `json:"thread_root_id,omitempty"`
+		SuppressEmbeds bool   `json:"suppress_embeds,omitempty"`
+		Nonce          string `json:"nonce,omitempty"`
 	}
 
 	input := new(sendMessageInput)
@@ -47,7 +52,25 @@ func (c *Controller) SendMessage(e echo.Context) error {
 		})
 	}
 
-	message, err := c.services.SendMessage(ctx, authUserID, conversationID, input.Content)
+	// The nonce may come from the body or an Idempotency-Key header, both must agree when set
+	nonce := input.Nonce
+	if key := e.Request().Header.Get("Idempotency-Key"); key != "" {
+		if nonce != "" && nonce != key {
+			return e.JSON(http.StatusBadRequest, ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Nonce does not match the Idempotency-Key header",
+			})
+		}
+		nonce = key
+	}
+
+	// A repeated nonce returns the original message with the same response as the first attempt
+	message, err := c.services.SendMessageWithOptions(ctx, authUserID, conversationID, input.Content, services.SendMessageOptions{
+		ReplyToID:      input.ReplyToID,
+		ThreadRootID:   input.ThreadRootID,
+		SuppressEmbeds: input.SuppressEmbeds,
+		Nonce:          nonce,
+	})
 	if err != nil {
 		if err.Error() == "sender not found: not found" || err.Error() == "conversation not found: not found" {
 			return e.JSON(http.StatusNotFound, ErrorResponse{
@@ -67,6 +90,14 @@ func (c *Controller) SendMessage(e echo.Context) error {
 				Message: "Cannot send message to this user",
 			})
 		}
+		if err.Error() == "reply target not found" || err.Error() == "thread root not found" || err.Error() == "cannot start a thread from a thread reply" ||
+			err.Error() == "message content cannot be empty" || err.Error() == "invalid link url" || err.Error() == "too many formatting entities" || err.Error() == "message content is too long" ||
+			err.Error() == "invalid nonce" {
+			return e.JSON(http.StatusBadRequest, ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: err.Error(),
+			})
+		}
 		c.log.Error("controller: send message failed", "error", err.Error())
 		return e.JSON(http.StatusInternalServerError, ErrorResponse{
 			Code:    http.StatusInternalServerError,
@@ -77,8 +108,8 @@ func (c *Controller) SendMessage(e echo.Context) error {
 	return e.JSON(http.StatusCreated, message)
 }
 
-// GetUserConversations handles GET /conversations
-func (c *Controller) GetUserConversations(e echo.Context) error {
+// StartDirectConversation handles POST /conversations/direct
+func (c *Controller) StartDirectConversation(e echo.Context) error {
 	ctx := e.Request().Context()
 	authUserID := e.Get("user_id").(string)
 	if authUserID == "" {
@@ -88,32 +119,93 @@ func (c *Controller) GetUserConversations(e echo.Context) error {
 		})
 	}
 
-	// Parse pagination parameters
-	limitStr := e.QueryParam("limit")
-	offsetStr := e.QueryParam("offset")
+	type startDirectConversationInput struct {
+		UserID string `json:"user_id" validate:"required"`
+	}
 
-	limit := 20 // default limit
-	if limitStr != "" {
-		if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 && parsedLimit <= 100 {
-			limit = parsedLimit
-		}
+	input := new(startDirectConversationInput)
+	if err := e.Bind(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: utility.ErrInvalidInput,
+		})
 	}
 
-	offset := 0 // default offset
-	if offsetStr != "" {
-		if parsedOffset, err := strconv.Atoi(offsetStr); err == nil && parsedOffset >= 0 {
-			offset = parsedOffset
+	if err := e.Validate(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	conversation, err := c.services.StartDirectConversation(ctx, authUserID, input.UserID)
+	if err != nil {
+		if err.Error() == "cannot start a conversation with yourself" {
+			return e.JSON(http.StatusBadRequest, ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Cannot start a conversation with yourself",
+			})
+		}
+		if err.Error() == "user1 not found: not found" || err.Error() == "user2 not found: not found" {
+			return e.JSON(http.StatusNotFound, ErrorResponse{
+				Code:    http.StatusNotFound,
+				Message: "User not found",
+			})
 		}
+		if err.Error() == "can only create conversations with friends" || err.Error() == "cannot create conversation with blocked user" {
+			return e.JSON(http.StatusForbidden, ErrorResponse{
+				Code:    http.StatusForbidden,
+				Message: "Cannot start a conversation with this user",
+			})
+		}
+		c.log.Error("controller: start direct conversation failed", "error", err.Error())
+		return e.JSON(http.StatusInternalServerError, ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: utility.ErrInternalError,
+		})
+	}
+
+	return e.JSON(http.StatusOK, conversation)
+}
+
+// GetUserConversations handles GET /conversations
+func (c *Controller) GetUserConversations(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	// Parse pagination parameters
+	page := parsePageRequest(e, 20)
+
+	filter := services.ConversationFilter{
+		FolderID: e.QueryParam("folder_id"),
 	}
 
-	conversations, err := c.services.GetUserConversations(ctx, authUserID, limit, offset)
+	conversations, err := c.services.GetUserConversationsWithFilter(ctx, authUserID, page, filter)
 	if err != nil {
+		if isPageRequestError(err) {
+			return e.JSON(http.StatusBadRequest, ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: err.Error(),
+			})
+		}
 		if err.Error() == "user not found: not found" {
 			return e.JSON(http.StatusNotFound, ErrorResponse{
 				Code:    http.StatusNotFound,
 				Message: "User not found",
 			})
 		}
+		if err.Error() == "folder not found" {
+			return e.JSON(http.StatusNotFound, ErrorResponse{
+				Code:    http.StatusNotFound,
+				Message: err.Error(),
+			})
+		}
 		c.log.Error("controller: get user conversations failed", "error", err.Error())
 		return e.JSON(http.StatusInternalServerError, ErrorResponse{
 			Code:    http.StatusInternalServerError,
@@ -144,7 +236,7 @@ func (c *Controller) GetConversationDetails(e echo.Context) error {
 	}
 
 	// Get single conversation details by getting conversations with limit 1 and filtering by ID
-	conversations, err := c.services.GetUserConversations(ctx, authUserID, 1, 0)
+	conversations, err := c.services.GetUserConversations(ctx, authUserID, services.PageRequest{Limit: 1})
 	if err != nil {
 		if err.Error() == "user not found: not found" {
 			return e.JSON(http.StatusNotFound, ErrorResponse{
@@ -161,7 +253,7 @@ func (c *Controller) GetConversationDetails(e echo.Context) error {
 
 	// Find the specific conversation
 	var targetConversation *services.ConversationWithDetails
-	for _, conv := range conversations {
+	for _, conv := range conversations.Items {
 		if conv.ID == conversationID {
 			targetConversation = conv
 			break
@@ -200,11 +292,64 @@ func (c *Controller) GetConversationMessages(e echo.Context) error {
 		})
 	}
 
+	// Parse pagination parameters, around_message_id centers the page on a message instead of a cursor
+	page := parsePageRequest(e, 50)
+
+	messages, err := c.services.GetConversationMessages(ctx, conversationID, authUserID, page, e.QueryParam("around_message_id"))
+	if err != nil {
+		if isPageRequestError(err) || err.Error() == "around_message_id cannot be combined with a cursor" ||
+			err.Error() == "around message not found" {
+			return e.JSON(http.StatusBadRequest, ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: err.Error(),
+			})
+		}
+		if err.Error() == "user not found: not found" || err.Error() == "conversation not found: not found" {
+			return e.JSON(http.StatusNotFound, ErrorResponse{
+				Code:    http.StatusNotFound,
+				Message: "Resource not found",
+			})
+		}
+		if err.Error() == "user is not a participant in this conversation" {
+			return e.JSON(http.StatusForbidden, ErrorResponse{
+				Code:    http.StatusForbidden,
+				Message: "Not authorized to access this conversation",
+			})
+		}
+		c.log.Error("controller: get conversation messages failed", "error", err.Error())
+		return e.JSON(http.StatusInternalServerError, ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: utility.ErrInternalError,
+		})
+	}
+
+	return e.JSON(http.StatusOK, messages)
+}
+
+// GetThread handles GET /messages/:messageID/thread
+func (c *Controller) GetThread(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	messageID := e.Param("messageID")
+	if messageID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Message ID is required",
+		})
+	}
+
 	// Parse pagination parameters
 	limitStr := e.QueryParam("limit")
 	offsetStr := e.QueryParam("offset")
 
-	limit := 50 // default limit for messages
+	limit := 50 // default limit for thread messages
 	if limitStr != "" {
 		if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 && parsedLimit <= 100 {
 			limit = parsedLimit
@@ -218,12 +363,18 @@ func (c *Controller) GetConversationMessages(e echo.Context) error {
 		}
 	}
 
-	messages, err := c.services.GetConversationMessages(ctx, conversationID, authUserID, limit, offset)
+	thread, err := c.services.GetThread(ctx, messageID, authUserID, limit, offset)
 	if err != nil {
-		if err.Error() == "user not found: not found" || err.Error() == "conversation not found: not found" {
+		if err.Error() == "message not found" {
 			return e.JSON(http.StatusNotFound, ErrorResponse{
 				Code:    http.StatusNotFound,
-				Message: "Resource not found",
+				Message: "Message not found",
+			})
+		}
+		if err.Error() == "message is not a thread root" {
+			return e.JSON(http.StatusBadRequest, ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: err.Error(),
 			})
 		}
 		if err.Error() == "user is not a participant in this conversation" {
@@ -232,14 +383,65 @@ func (c *Controller) GetConversationMessages(e echo.Context) error {
 				Message: "Not authorized to access this conversation",
 			})
 		}
-		c.log.Error("controller: get conversation messages failed", "error", err.Error())
+		c.log.Error("controller: get thread failed", "error", err.Error())
 		return e.JSON(http.StatusInternalServerError, ErrorResponse{
 			Code:    http.StatusInternalServerError,
 			Message: utility.ErrInternalError,
 		})
 	}
 
-	return e.JSON(http.StatusOK, messages)
+	return e.JSON(http.StatusOK, thread)
+}
+
+// MarkThreadAsRead handles PUT /messages/:messageID/thread/read
+func (c *Controller) MarkThreadAsRead(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	messageID := e.Param("messageID")
+	if messageID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Message ID is required",
+		})
+	}
+
+	err := c.services.MarkThreadAsRead(ctx, messageID, authUserID)
+	if err != nil {
+		if err.Error() == "message not found" {
+			return e.JSON(http.StatusNotFound, ErrorResponse{
+				Code:    http.StatusNotFound,
+				Message: "Message not found",
+			})
+		}
+		if err.Error() == "message is not a thread root" {
+			return e.JSON(http.StatusBadRequest, ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: err.Error(),
+			})
+		}
+		if err.Error() == "user is not a participant in this conversation" {
+			return e.JSON(http.StatusForbidden, ErrorResponse{
+				Code:    http.StatusForbidden,
+				Message: "Not authorized to access this conversation",
+			})
+		}
+		c.log.Error("controller: mark thread as read failed", "error", err.Error())
+		return e.JSON(http.StatusInternalServerError, ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: utility.ErrInternalError,
+		})
+	}
+
+	return e.JSON(http.StatusOK, echo.Map{
+		"message": "Thread marked as read",
+	})
 }
 
 // MarkMessagesAsRead handles PUT /conversations/:conversationID/read
@@ -263,7 +465,7 @@ func (c *Controller) MarkMessagesAsRead(e echo.Context) error {
 
 	err := c.services.MarkMessagesAsRead(ctx, conversationID, authUserID)
 	if err != nil {
-		if err.Error() == "user not found: not found" || err.Error() == "conversation not found: not found" {
+		if err.Error() == "user not found: not found" || err.Error() == "conversation not found: not found" || err.Error() == "message not found" {
 			return e.JSON(http.StatusNotFound, ErrorResponse{
 				Code:    http.StatusNotFound,
 				Message: "Resource not found",
@@ -306,36 +508,309 @@ func (c *Controller) DeleteMessage(e echo.Context) error {
 		})
 	}
 
-	err := c.services.DeleteMessage(ctx, messageID, authUserID)
+	// scope=me hides the message for the caller only, the default deletes it for everyone
+	var err error
+	switch e.QueryParam("scope") {
+	case "", "everyone":
+		err = c.services.DeleteMessage(ctx, messageID, authUserID)
+	case "me":
+		err = c.services.DeleteMessageForMe(ctx, messageID, authUserID)
+	default:
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Scope must be me or everyone",
+		})
+	}
 	if err != nil {
-		if err.Error() == "user not found: not found" || err.Error() == "message not found" {
+		return c.deleteMessageErrorResponse(e, err)
+	}
+
+	return e.JSON(http.StatusOK, echo.Map{
+		"message": "Message deleted successfully",
+	})
+}
+
+// DeleteMessages handles POST /conversations/:conversationID/messages/delete
+func (c *Controller) DeleteMessages(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	conversationID := e.Param("conversationID")
+	if conversationID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Conversation ID is required",
+		})
+	}
+
+	type deleteMessagesInput struct {
+		MessageIDs []string `json:"message_ids" validate:"required"`
+		Scope      string   `json:"scope,omitempty"`
+	}
+
+	input := new(deleteMessagesInput)
+	if err := e.Bind(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: utility.ErrInvalidInput,
+		})
+	}
+
+	if err := e.Validate(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	if input.Scope != "" && input.Scope != "me" && input.Scope != "everyone" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Scope must be me or everyone",
+		})
+	}
+
+	if err := c.services.DeleteMessages(ctx, conversationID, authUserID, input.MessageIDs, input.Scope != "me"); err != nil {
+		return c.deleteMessageErrorResponse(e, err)
+	}
+
+	return e.JSON(http.StatusOK, echo.Map{
+		"message": "Messages deleted successfully",
+	})
+}
+
+// deleteMessageErrorResponse maps message deletion errors to HTTP responses
+func (c *Controller) deleteMessageErrorResponse(e echo.Context, err error) error {
+	switch err.Error() {
+	case "user not found: not found", "message not found":
+		return e.JSON(http.StatusNotFound, ErrorResponse{
+			Code:    http.StatusNotFound,
+			Message: "Resource not found",
+		})
+	case "can only delete own messages":
+		return e.JSON(http.StatusForbidden, ErrorResponse{
+			Code:    http.StatusForbidden,
+			Message: "Can only delete your own messages",
+		})
+	case "message delete window has expired":
+		return e.JSON(http.StatusForbidden, ErrorResponse{
+			Code:    http.StatusForbidden,
+			Message: "Message delete window has expired",
+		})
+	case "user is not a participant in this conversation":
+		return e.JSON(http.StatusForbidden, ErrorResponse{
+			Code:    http.StatusForbidden,
+			Message: "Not authorized to access this conversation",
+		})
+	case "message is already deleted":
+		return e.JSON(http.StatusConflict, ErrorResponse{
+			Code:    http.StatusConflict,
+			Message: "Message is already deleted",
+		})
+	case "no messages to delete", "too many messages to delete":
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	c.log.Error("controller: delete message failed", "error", err.Error())
+	return e.JSON(http.StatusInternalServerError, ErrorResponse{
+		Code:    http.StatusInternalServerError,
+		Message: utility.ErrInternalError,
+	})
+}
+
+// EditMessage handles PATCH /messages/:messageID
+func (c *Controller) EditMessage(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	messageID := e.Param("messageID")
+	if messageID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Message ID is required",
+		})
+	}
+
+	type editMessageInput struct {
+		Content string `json:"content" validate:"required"`
+	}
+
+	input := new(editMessageInput)
+	if err := e.Bind(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: utility.ErrInvalidInput,
+		})
+	}
+
+	if err := e.Validate(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	msg, err := c.services.EditMessage(ctx, messageID, authUserID, input.Content)
+	if err != nil {
+		if err.Error() == "message not found" {
 			return e.JSON(http.StatusNotFound, ErrorResponse{
 				Code:    http.StatusNotFound,
-				Message: "Resource not found",
+				Message: "Message not found",
 			})
 		}
-		if err.Error() == "can only delete own messages" {
+		if err.Error() == "can only edit own messages" {
 			return e.JSON(http.StatusForbidden, ErrorResponse{
 				Code:    http.StatusForbidden,
-				Message: "Can only delete your own messages",
+				Message: "Can only edit your own messages",
 			})
 		}
-		if err.Error() == "message is already deleted" {
+		if err.Error() == "message edit window has expired" {
+			return e.JSON(http.StatusForbidden, ErrorResponse{
+				Code:    http.StatusForbidden,
+				Message: "Message can no longer be edited",
+			})
+		}
+		if err.Error() == "cannot edit a deleted message" || err.Error() == "message was modified concurrently" {
 			return e.JSON(http.StatusConflict, ErrorResponse{
 				Code:    http.StatusConflict,
-				Message: "Message is already deleted",
+				Message: err.Error(),
 			})
 		}
-		c.log.Error("controller: delete message failed", "error", err.Error())
+		if err.Error() == "message content cannot be empty" || err.Error() == "only text messages can be edited" ||
+			err.Error() == "invalid link url" || err.Error() == "too many formatting entities" || err.Error() == "message content is too long" {
+			return e.JSON(http.StatusBadRequest, ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: err.Error(),
+			})
+		}
+		c.log.Error("controller: edit message failed", "error", err.Error())
 		return e.JSON(http.StatusInternalServerError, ErrorResponse{
 			Code:    http.StatusInternalServerError,
 			Message: utility.ErrInternalError,
 		})
 	}
 
-	return e.JSON(http.StatusOK, echo.Map{
-		"message": "Message deleted successfully",
-	})
+	return e.JSON(http.StatusOK, msg)
+}
+
+// SetMessageEmbedsSuppressed handles PUT /messages/:messageID/embeds
+func (c *Controller) SetMessageEmbedsSuppressed(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	messageID := e.Param("messageID")
+	if messageID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Message ID is required",
+		})
+	}
+
+	type suppressEmbedsInput struct {
+		Suppress *bool `json:"suppress" validate:"required"`
+	}
+
+	input := new(suppressEmbedsInput)
+	if err := e.Bind(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: utility.ErrInvalidInput,
+		})
+	}
+
+	if err := e.Validate(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	msg, err := c.services.SetMessageEmbedsSuppressed(ctx, messageID, authUserID, *input.Suppress)
+	if err != nil {
+		if err.Error() == "message not found" {
+			return e.JSON(http.StatusNotFound, ErrorResponse{
+				Code:    http.StatusNotFound,
+				Message: "Message not found",
+			})
+		}
+		if err.Error() == "can only change embeds on own messages" {
+			return e.JSON(http.StatusForbidden, ErrorResponse{
+				Code:    http.StatusForbidden,
+				Message: "Can only change embeds on your own messages",
+			})
+		}
+		c.log.Error("controller: set message embeds suppressed failed", "error", err.Error())
+		return e.JSON(http.StatusInternalServerError, ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: utility.ErrInternalError,
+		})
+	}
+
+	return e.JSON(http.StatusOK, msg)
+}
+
+// GetMessageRevisions handles GET /messages/:messageID/revisions
+func (c *Controller) GetMessageRevisions(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	messageID := e.Param("messageID")
+	if messageID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Message ID is required",
+		})
+	}
+
+	revisions, err := c.services.GetMessageRevisions(ctx, messageID, authUserID)
+	if err != nil {
+		if err.Error() == "message not found" {
+			return e.JSON(http.StatusNotFound, ErrorResponse{
+				Code:    http.StatusNotFound,
+				Message: "Message not found",
+			})
+		}
+		if err.Error() == "user is not a participant in this conversation" {
+			return e.JSON(http.StatusForbidden, ErrorResponse{
+				Code:    http.StatusForbidden,
+				Message: "Not authorized to view this message",
+			})
+		}
+		c.log.Error("controller: get message revisions failed", "error", err.Error())
+		return e.JSON(http.StatusInternalServerError, ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: utility.ErrInternalError,
+		})
+	}
+
+	return e.JSON(http.StatusOK, revisions)
 }
 
 // SearchMessages handles GET /messages/search
@@ -383,6 +858,12 @@ func (c *Controller) SearchMessages(e echo.Context) error {
 				Message: "User not found",
 			})
 		}
+		if err.Error() == "invalid search date" || err.Error() == "unknown search filter" {
+			return e.JSON(http.StatusBadRequest, ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: err.Error(),
+			})
+		}
 		c.log.Error("controller: search messages failed", "error", err.Error())
 		return e.JSON(http.StatusInternalServerError, ErrorResponse{
 			Code:    http.StatusInternalServerError,
@@ -415,7 +896,7 @@ func (c *Controller) ArchiveConversation(e echo.Context) error {
 
 	err := c.services.ArchiveConversation(ctx, conversationID, authUserID)
 	if err != nil {
-		if err.Error() == "user not found: not found" || err.Error() == "conversation not found: not found" {
+		if err.Error() == "user not found: not found" || err.Error() == "conversation not found: not found" || err.Error() == "message not found" {
 			return e.JSON(http.StatusNotFound, ErrorResponse{
 				Code:    http.StatusNotFound,
 				Message: "Resource not found",
@@ -461,7 +942,7 @@ func (c *Controller) UnarchiveConversation(e echo.Context) error {
 
 	err := c.services.UnarchiveConversation(ctx, conversationID, authUserID)
 	if err != nil {
-		if err.Error() == "user not found: not found" || err.Error() == "conversation not found: not found" {
+		if err.Error() == "user not found: not found" || err.Error() == "conversation not found: not found" || err.Error() == "message not found" {
 			return e.JSON(http.StatusNotFound, ErrorResponse{
 				Code:    http.StatusNotFound,
 				Message: "Resource not found",
@@ -507,7 +988,7 @@ func (c *Controller) MuteConversation(e echo.Context) error {
 
 	err := c.services.MuteConversation(ctx, conversationID, authUserID)
 	if err != nil {
-		if err.Error() == "user not found: not found" || err.Error() == "conversation not found: not found" {
+		if err.Error() == "user not found: not found" || err.Error() == "conversation not found: not found" || err.Error() == "message not found" {
 			return e.JSON(http.StatusNotFound, ErrorResponse{
 				Code:    http.StatusNotFound,
 				Message: "Resource not found",
@@ -553,7 +1034,7 @@ func (c *Controller) UnmuteConversation(e echo.Context) error {
 
 	err := c.services.UnmuteConversation(ctx, conversationID, authUserID)
 	if err != nil {
-		if err.Error() == "user not found: not found" || err.Error() == "conversation not found: not found" {
+		if err.Error() == "user not found: not found" || err.Error() == "conversation not found: not found" || err.Error() == "message not found" {
 			return e.JSON(http.StatusNotFound, ErrorResponse{
 				Code:    http.StatusNotFound,
 				Message: "Resource not found",
@@ -577,6 +1058,80 @@ func (c *Controller) UnmuteConversation(e echo.Context) error {
 	})
 }
 
+// UpdateNotificationSettings changes how the authenticated user is notified about a conversation
+// PUT /conversations/:conversationID/notification-settings
+func (c *Controller) UpdateNotificationSettings(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	conversationID := e.Param("conversationID")
+	if conversationID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Conversation ID is required",
+		})
+	}
+
+	type notificationSettingsInput struct {
+		Level         *string    `json:"level,omitempty"`
+		MutedUntil    *time.Time `json:"muted_until,omitempty"`
+		ShowWhenMuted *bool      `json:"show_when_muted,omitempty"`
+	}
+
+	input := new(notificationSettingsInput)
+	if err := e.Bind(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: utility.ErrInvalidInput,
+		})
+	}
+
+	if input.Level == nil && input.MutedUntil == nil && input.ShowWhenMuted == nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Level, muted_until or show_when_muted is required",
+		})
+	}
+
+	settings, err := c.services.UpdateNotificationSettings(ctx, conversationID, authUserID, services.NotificationSettingsUpdate{
+		Level:         input.Level,
+		MutedUntil:    input.MutedUntil,
+		ShowWhenMuted: input.ShowWhenMuted,
+	})
+	if err != nil {
+		switch err.Error() {
+		case "user not found: not found", "conversation not found: not found":
+			return e.JSON(http.StatusNotFound, ErrorResponse{
+				Code:    http.StatusNotFound,
+				Message: "Resource not found",
+			})
+		case "user is not a participant in this conversation":
+			return e.JSON(http.StatusForbidden, ErrorResponse{
+				Code:    http.StatusForbidden,
+				Message: "Not authorized to access this conversation",
+			})
+		case "invalid notification level", "mute end must be in the future", "mute end is too far in the future":
+			return e.JSON(http.StatusBadRequest, ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: err.Error(),
+			})
+		}
+		c.log.Error("controller: update notification settings failed", "error", err.Error())
+		return e.JSON(http.StatusInternalServerError, ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: utility.ErrInternalError,
+		})
+	}
+
+	return e.JSON(http.StatusOK, settings)
+}
+
 // SearchConversations searches conversations by participant names
 // GET /conversations/search?q=query&limit=20&offset=0
 func (c *Controller) SearchConversations(e echo.Context) error {
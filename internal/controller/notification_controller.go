@@ -19,6 +19,44 @@ func (c *Controller) GetNotifications(e echo.Context) error {
 		})
 	}
 
+	// Parse pagination parameters
+	page := parsePageRequest(e, 20)
+
+	notifications, err := c.services.GetUserNotifications(ctx, authUserID, page)
+	if err != nil {
+		if isPageRequestError(err) {
+			return e.JSON(http.StatusBadRequest, ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: err.Error(),
+			})
+		}
+		if err.Error() == "user not found: not found" {
+			return e.JSON(http.StatusNotFound, ErrorResponse{
+				Code:    http.StatusNotFound,
+				Message: "User not found",
+			})
+		}
+		c.log.Error("controller: get notifications failed", "error", err.Error())
+		return e.JSON(http.StatusInternalServerError, ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: utility.ErrInternalError,
+		})
+	}
+
+	return e.JSON(http.StatusOK, notifications)
+}
+
+// GetMentions handles GET /mentions
+func (c *Controller) GetMentions(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
 	// Parse pagination parameters
 	limitStr := e.QueryParam("limit")
 	offsetStr := e.QueryParam("offset")
@@ -37,22 +75,16 @@ func (c *Controller) GetNotifications(e echo.Context) error {
 		}
 	}
 
-	notifications, err := c.services.GetUserNotifications(ctx, authUserID, limit, offset)
+	mentions, err := c.services.GetMentions(ctx, authUserID, limit, offset)
 	if err != nil {
-		if err.Error() == "user not found: not found" {
-			return e.JSON(http.StatusNotFound, ErrorResponse{
-				Code:    http.StatusNotFound,
-				Message: "User not found",
-			})
-		}
-		c.log.Error("controller: get notifications failed", "error", err.Error())
+		c.log.Error("controller: get mentions failed", "error", err.Error())
 		return e.JSON(http.StatusInternalServerError, ErrorResponse{
 			Code:    http.StatusInternalServerError,
 			Message: utility.ErrInternalError,
 		})
 	}
 
-	return e.JSON(http.StatusOK, notifications)
+	return e.JSON(http.StatusOK, mentions)
 }
 
 // MarkNotificationAsRead handles PUT /notifications/:notificationID/read
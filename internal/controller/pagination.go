@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"kakashi/chaos/internal/services"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// parsePageRequest reads the limit, before, after and around query parameters
+func parsePageRequest(e echo.Context, defaultLimit int) services.PageRequest {
+	limit := defaultLimit
+	if limitStr := e.QueryParam("limit"); limitStr != "" {
+		if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 && parsedLimit <= 100 {
+			limit = parsedLimit
+		}
+	}
+
+	return services.PageRequest{
+		Limit:  limit,
+		Before: e.QueryParam("before"),
+		After:  e.QueryParam("after"),
+		Around: e.QueryParam("around"),
+	}
+}
+
+// isPageRequestError reports whether a service error was caused by bad pagination parameters
+func isPageRequestError(err error) bool {
+	return err.Error() == "invalid cursor" || err.Error() == "only one of before, after and around can be used"
+}
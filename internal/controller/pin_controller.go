@@ -0,0 +1,143 @@
+package controller
+
+import (
+	"kakashi/chaos/internal/utility"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// pinErrorResponse maps pin service errors to HTTP responses
+func (c *Controller) pinErrorResponse(e echo.Context, err error, action string) error {
+	switch err.Error() {
+	case "message not found", "message is not pinned":
+		return e.JSON(http.StatusNotFound, ErrorResponse{
+			Code:    http.StatusNotFound,
+			Message: err.Error(),
+		})
+	case "user is not a participant in this conversation":
+		return e.JSON(http.StatusForbidden, ErrorResponse{
+			Code:    http.StatusForbidden,
+			Message: "Not authorized to access this conversation",
+		})
+	case "message is already pinned":
+		return e.JSON(http.StatusConflict, ErrorResponse{
+			Code:    http.StatusConflict,
+			Message: err.Error(),
+		})
+	case "pin limit reached", "cannot pin this message":
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	c.log.Error("controller: "+action+" failed", "error", err.Error())
+	return e.JSON(http.StatusInternalServerError, ErrorResponse{
+		Code:    http.StatusInternalServerError,
+		Message: utility.ErrInternalError,
+	})
+}
+
+// GetPinnedMessages handles GET /conversations/:conversationID/pins
+func (c *Controller) GetPinnedMessages(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	conversationID := e.Param("conversationID")
+	if conversationID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Conversation ID is required",
+		})
+	}
+
+	pins, err := c.services.GetPinnedMessages(ctx, conversationID, authUserID)
+	if err != nil {
+		return c.pinErrorResponse(e, err, "get pinned messages")
+	}
+
+	return e.JSON(http.StatusOK, pins)
+}
+
+// PinMessage handles POST /conversations/:conversationID/pins
+func (c *Controller) PinMessage(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	conversationID := e.Param("conversationID")
+	if conversationID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Conversation ID is required",
+		})
+	}
+
+	type pinMessageInput struct {
+		MessageID string `json:"message_id" validate:"required"`
+	}
+
+	input := new(pinMessageInput)
+	if err := e.Bind(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: utility.ErrInvalidInput,
+		})
+	}
+
+	if err := e.Validate(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	pin, err := c.services.PinMessage(ctx, conversationID, input.MessageID, authUserID)
+	if err != nil {
+		return c.pinErrorResponse(e, err, "pin message")
+	}
+
+	return e.JSON(http.StatusCreated, pin)
+}
+
+// UnpinMessage handles DELETE /conversations/:conversationID/pins/:messageID
+func (c *Controller) UnpinMessage(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	conversationID := e.Param("conversationID")
+	messageID := e.Param("messageID")
+	if conversationID == "" || messageID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Conversation ID and message ID are required",
+		})
+	}
+
+	err := c.services.UnpinMessage(ctx, conversationID, messageID, authUserID)
+	if err != nil {
+		return c.pinErrorResponse(e, err, "unpin message")
+	}
+
+	return e.JSON(http.StatusOK, echo.Map{
+		"message": "Message unpinned successfully",
+	})
+}
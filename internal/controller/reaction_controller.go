@@ -0,0 +1,167 @@
+package controller
+
+import (
+	"kakashi/chaos/internal/utility"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AddReaction handles POST /messages/:messageID/reactions
+func (c *Controller) AddReaction(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	messageID := e.Param("messageID")
+	if messageID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Message ID is required",
+		})
+	}
+
+	type addReactionInput struct {
+		Emoji string `json:"emoji" validate:"required,max=64"`
+	}
+
+	input := new(addReactionInput)
+	if err := e.Bind(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: utility.ErrInvalidInput,
+		})
+	}
+
+	if err := e.Validate(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	err := c.services.AddReaction(ctx, messageID, authUserID, input.Emoji)
+	if err != nil {
+		if err.Error() == "message not found" {
+			return e.JSON(http.StatusNotFound, ErrorResponse{
+				Code:    http.StatusNotFound,
+				Message: "Message not found",
+			})
+		}
+		if err.Error() == "user is not a participant in this conversation" {
+			return e.JSON(http.StatusForbidden, ErrorResponse{
+				Code:    http.StatusForbidden,
+				Message: "Not authorized to access this conversation",
+			})
+		}
+		if err.Error() == "invalid emoji" || err.Error() == "reaction limit reached" {
+			return e.JSON(http.StatusBadRequest, ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: err.Error(),
+			})
+		}
+		c.log.Error("controller: add reaction failed", "error", err.Error())
+		return e.JSON(http.StatusInternalServerError, ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: utility.ErrInternalError,
+		})
+	}
+
+	return e.JSON(http.StatusOK, echo.Map{
+		"message": "Reaction added successfully",
+	})
+}
+
+// RemoveReaction handles DELETE /messages/:messageID/reactions?emoji=
+func (c *Controller) RemoveReaction(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	messageID := e.Param("messageID")
+	emoji := e.QueryParam("emoji")
+	if messageID == "" || emoji == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Message ID and emoji are required",
+		})
+	}
+
+	err := c.services.RemoveReaction(ctx, messageID, authUserID, emoji)
+	if err != nil {
+		if err.Error() == "message not found" || err.Error() == "reaction not found" {
+			return e.JSON(http.StatusNotFound, ErrorResponse{
+				Code:    http.StatusNotFound,
+				Message: "Resource not found",
+			})
+		}
+		if err.Error() == "user is not a participant in this conversation" {
+			return e.JSON(http.StatusForbidden, ErrorResponse{
+				Code:    http.StatusForbidden,
+				Message: "Not authorized to access this conversation",
+			})
+		}
+		c.log.Error("controller: remove reaction failed", "error", err.Error())
+		return e.JSON(http.StatusInternalServerError, ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: utility.ErrInternalError,
+		})
+	}
+
+	return e.JSON(http.StatusOK, echo.Map{
+		"message": "Reaction removed successfully",
+	})
+}
+
+// GetMessageReactions handles GET /messages/:messageID/reactions
+func (c *Controller) GetMessageReactions(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	messageID := e.Param("messageID")
+	if messageID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Message ID is required",
+		})
+	}
+
+	reactions, err := c.services.GetMessageReactions(ctx, messageID, authUserID, e.QueryParam("emoji"))
+	if err != nil {
+		if err.Error() == "message not found" {
+			return e.JSON(http.StatusNotFound, ErrorResponse{
+				Code:    http.StatusNotFound,
+				Message: "Message not found",
+			})
+		}
+		if err.Error() == "user is not a participant in this conversation" {
+			return e.JSON(http.StatusForbidden, ErrorResponse{
+				Code:    http.StatusForbidden,
+				Message: "Not authorized to access this conversation",
+			})
+		}
+		c.log.Error("controller: get message reactions failed", "error", err.Error())
+		return e.JSON(http.StatusInternalServerError, ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: utility.ErrInternalError,
+		})
+	}
+
+	return e.JSON(http.StatusOK, reactions)
+}
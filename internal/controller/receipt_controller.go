@@ -0,0 +1,99 @@
+package controller
+
+import (
+	"kakashi/chaos/internal/utility"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetMessageReceipts handles GET /messages/:messageID/receipts
+func (c *Controller) GetMessageReceipts(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	messageID := e.Param("messageID")
+	if messageID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Message ID is required",
+		})
+	}
+
+	receipts, err := c.services.GetMessageReceipts(ctx, messageID, authUserID)
+	if err != nil {
+		if err.Error() == "message not found" {
+			return e.JSON(http.StatusNotFound, ErrorResponse{
+				Code:    http.StatusNotFound,
+				Message: "Message not found",
+			})
+		}
+		if err.Error() == "user is not a participant in this conversation" {
+			return e.JSON(http.StatusForbidden, ErrorResponse{
+				Code:    http.StatusForbidden,
+				Message: "Not authorized to access this conversation",
+			})
+		}
+		c.log.Error("controller: get message receipts failed", "error", err.Error())
+		return e.JSON(http.StatusInternalServerError, ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: utility.ErrInternalError,
+		})
+	}
+
+	return e.JSON(http.StatusOK, receipts)
+}
+
+// UpdateReadReceiptsSetting handles PUT /users/me/read-receipts
+func (c *Controller) UpdateReadReceiptsSetting(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	type readReceiptsInput struct {
+		Enabled *bool `json:"enabled" validate:"required"`
+	}
+
+	input := new(readReceiptsInput)
+	if err := e.Bind(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: utility.ErrInvalidInput,
+		})
+	}
+
+	if err := e.Validate(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	user, err := c.services.SetReadReceiptsEnabled(ctx, authUserID, *input.Enabled)
+	if err != nil {
+		if err.Error() == "user not found" {
+			return e.JSON(http.StatusNotFound, ErrorResponse{
+				Code:    http.StatusNotFound,
+				Message: "User not found",
+			})
+		}
+		c.log.Error("controller: update read receipts setting failed", "error", err.Error())
+		return e.JSON(http.StatusInternalServerError, ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: utility.ErrInternalError,
+		})
+	}
+
+	return e.JSON(http.StatusOK, user)
+}
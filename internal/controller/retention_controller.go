@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"kakashi/chaos/internal/utility"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// SetConversationMessageTTL handles PUT /conversations/:conversationID/message-ttl
+func (c *Controller) SetConversationMessageTTL(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	conversationID := e.Param("conversationID")
+	if conversationID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Conversation ID is required",
+		})
+	}
+
+	type setMessageTTLInput struct {
+		// TTLSeconds is how long messages are kept, 0 turns disappearing messages off
+		TTLSeconds *int `json:"ttl_seconds" validate:"required,min=0"`
+	}
+
+	input := new(setMessageTTLInput)
+	if err := e.Bind(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: utility.ErrInvalidInput,
+		})
+	}
+
+	if err := e.Validate(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	conversation, err := c.services.SetConversationMessageTTL(ctx, conversationID, authUserID, time.Duration(*input.TTLSeconds)*time.Second)
+	if err != nil {
+		return c.groupErrorResponse(e, err, "set conversation message ttl")
+	}
+
+	return e.JSON(http.StatusOK, conversation)
+}
@@ -0,0 +1,204 @@
+package controller
+
+import (
+	"kakashi/chaos/internal/services"
+	"kakashi/chaos/internal/utility"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// scheduledMessageErrorResponse maps scheduled message service errors to HTTP responses
+func (c *Controller) scheduledMessageErrorResponse(e echo.Context, err error, action string) error {
+	switch err.Error() {
+	case "scheduled message not found":
+		return e.JSON(http.StatusNotFound, ErrorResponse{
+			Code:    http.StatusNotFound,
+			Message: "Scheduled message not found",
+		})
+	case "sender not found: not found", "conversation not found: not found":
+		return e.JSON(http.StatusNotFound, ErrorResponse{
+			Code:    http.StatusNotFound,
+			Message: "Resource not found",
+		})
+	case "user is not a participant in this conversation":
+		return e.JSON(http.StatusForbidden, ErrorResponse{
+			Code:    http.StatusForbidden,
+			Message: "Not authorized to send messages in this conversation",
+		})
+	case "can only send messages to friends", "cannot send message to blocked user":
+		return e.JSON(http.StatusForbidden, ErrorResponse{
+			Code:    http.StatusForbidden,
+			Message: "Cannot send message to this user",
+		})
+	case "scheduled message is no longer pending":
+		return e.JSON(http.StatusConflict, ErrorResponse{
+			Code:    http.StatusConflict,
+			Message: err.Error(),
+		})
+	case "content is required", "send time must be in the future", "send time is too far in the future", "reply target not found",
+		"invalid link url", "too many formatting entities", "message content is too long":
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	c.log.Error("controller: "+action+" failed", "error", err.Error())
+	return e.JSON(http.StatusInternalServerError, ErrorResponse{
+		Code:    http.StatusInternalServerError,
+		Message: utility.ErrInternalError,
+	})
+}
+
+// ScheduleMessage handles POST /conversations/:conversationID/scheduled-messages
+func (c *Controller) ScheduleMessage(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	conversationID := e.Param("conversationID")
+	if conversationID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Conversation ID is required",
+		})
+	}
+
+	type scheduleMessageInput struct {
+		Content        string    `json:"content" validate:"required"`
+		SendAt         time.Time `json:"send_at" validate:"required"`
+		ReplyToID      string    `json:"reply_to_id,omitempty"`
+		SuppressEmbeds bool      `json:"suppress_embeds,omitempty"`
+	}
+
+	input := new(scheduleMessageInput)
+	if err := e.Bind(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: utility.ErrInvalidInput,
+		})
+	}
+
+	if err := e.Validate(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+
+	scheduled, err := c.services.ScheduleMessage(ctx, authUserID, conversationID, services.ScheduledMessageInput{
+		Content:        input.Content,
+		SendAt:         input.SendAt,
+		ReplyToID:      input.ReplyToID,
+		SuppressEmbeds: input.SuppressEmbeds,
+	})
+	if err != nil {
+		return c.scheduledMessageErrorResponse(e, err, "schedule message")
+	}
+
+	return e.JSON(http.StatusCreated, scheduled)
+}
+
+// GetScheduledMessages handles GET /scheduled-messages
+func (c *Controller) GetScheduledMessages(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	scheduled, err := c.services.GetScheduledMessages(ctx, authUserID, e.QueryParam("conversation_id"))
+	if err != nil {
+		return c.scheduledMessageErrorResponse(e, err, "get scheduled messages")
+	}
+
+	return e.JSON(http.StatusOK, scheduled)
+}
+
+// UpdateScheduledMessage handles PATCH /scheduled-messages/:scheduledMessageID
+func (c *Controller) UpdateScheduledMessage(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	scheduledMessageID := e.Param("scheduledMessageID")
+	if scheduledMessageID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Scheduled message ID is required",
+		})
+	}
+
+	type updateScheduledMessageInput struct {
+		Content *string    `json:"content,omitempty"`
+		SendAt  *time.Time `json:"send_at,omitempty"`
+	}
+
+	input := new(updateScheduledMessageInput)
+	if err := e.Bind(input); err != nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: utility.ErrInvalidInput,
+		})
+	}
+
+	if input.Content == nil && input.SendAt == nil {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Content or send_at is required",
+		})
+	}
+
+	scheduled, err := c.services.UpdateScheduledMessage(ctx, scheduledMessageID, authUserID, services.ScheduledMessageUpdate{
+		Content: input.Content,
+		SendAt:  input.SendAt,
+	})
+	if err != nil {
+		return c.scheduledMessageErrorResponse(e, err, "update scheduled message")
+	}
+
+	return e.JSON(http.StatusOK, scheduled)
+}
+
+// CancelScheduledMessage handles DELETE /scheduled-messages/:scheduledMessageID
+func (c *Controller) CancelScheduledMessage(e echo.Context) error {
+	ctx := e.Request().Context()
+	authUserID := e.Get("user_id").(string)
+	if authUserID == "" {
+		return e.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: utility.ErrUnauthorized,
+		})
+	}
+
+	scheduledMessageID := e.Param("scheduledMessageID")
+	if scheduledMessageID == "" {
+		return e.JSON(http.StatusBadRequest, ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Scheduled message ID is required",
+		})
+	}
+
+	if err := c.services.CancelScheduledMessage(ctx, scheduledMessageID, authUserID); err != nil {
+		return c.scheduledMessageErrorResponse(e, err, "cancel scheduled message")
+	}
+
+	return e.JSON(http.StatusOK, echo.Map{
+		"message": "Scheduled message cancelled successfully",
+	})
+}
@@ -0,0 +1,219 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"kakashi/chaos/internal/services"
+	"kakashi/chaos/internal/ws"
+	"net/http"
+)
+
+// commandErrorCodes maps service errors to the status their HTTP endpoints answer with
+var commandErrorCodes = map[string]int{
+	"sender not found: not found":                    http.StatusNotFound,
+	"user not found: not found":                      http.StatusNotFound,
+	"conversation not found: not found":              http.StatusNotFound,
+	"message not found":                              http.StatusNotFound,
+	"reaction not found":                             http.StatusNotFound,
+	"user is not a participant in this conversation": http.StatusForbidden,
+	"can only send messages to friends":              http.StatusForbidden,
+	"cannot send message to blocked user":            http.StatusForbidden,
+	"can only edit own messages":                     http.StatusForbidden,
+	"can only delete own messages":                   http.StatusForbidden,
+	"message edit window has expired":                http.StatusForbidden,
+	"message delete window has expired":              http.StatusForbidden,
+	"cannot edit a deleted message":                  http.StatusConflict,
+	"message was modified concurrently":              http.StatusConflict,
+	"message is already deleted":                     http.StatusConflict,
+	"reply target not found":                         http.StatusBadRequest,
+	"thread root not found":                          http.StatusBadRequest,
+	"cannot start a thread from a thread reply":      http.StatusBadRequest,
+	"message content cannot be empty":                http.StatusBadRequest,
+	"only text messages can be edited":               http.StatusBadRequest,
+	"invalid link url":                               http.StatusBadRequest,
+	"too many formatting entities":                   http.StatusBadRequest,
+	"message content is too long":                    http.StatusBadRequest,
+	"invalid nonce":                                  http.StatusBadRequest,
+	"invalid emoji":                                  http.StatusBadRequest,
+	"reaction limit reached":                         http.StatusBadRequest,
+}
+
+// HandleCommand runs a WebSocket command through the same services and rate limits as its HTTP endpoint
+func (c *Controller) HandleCommand(ctx context.Context, userID string, command ws.Command) (interface{}, error) {
+	if c.limits != nil && !c.limits.AllowRequest(userID) {
+		return nil, &ws.CommandError{
+			Code:    http.StatusTooManyRequests,
+			Message: "Rate limit exceeded. Please wait before making more requests.",
+		}
+	}
+
+	switch command.Type {
+	case ws.CommandSendMessage:
+		return c.sendMessageCommand(ctx, userID, command.Data)
+	case ws.CommandEditMessage:
+		return c.editMessageCommand(ctx, userID, command.Data)
+	case ws.CommandDeleteMessage:
+		return c.deleteMessageCommand(ctx, userID, command.Data)
+	case ws.CommandMarkRead:
+		return c.markReadCommand(ctx, userID, command.Data)
+	case ws.CommandReact:
+		return c.reactCommand(ctx, userID, command.Data)
+	}
+
+	return nil, &ws.CommandError{Code: http.StatusBadRequest, Message: "unknown command"}
+}
+
+// sendMessageCommand handles send_message, the command form of POST /conversations/:conversationID/messages
+func (c *Controller) sendMessageCommand(ctx context.Context, userID string, data json.RawMessage) (interface{}, error) {
+	var input struct {
+		ConversationID string `json:"conversation_id"`
+		Content        string `json:"content"`
+		ReplyToID      string `json:"reply_to_id,omitempty"`
+		ThreadRootID   string `json:"thread_root_id,omitempty"`
+		SuppressEmbeds bool   `json:"suppress_embeds,omitempty"`
+		Nonce          string `json:"nonce,omitempty"`
+	}
+	if err := decodeCommand(data, &input); err != nil {
+		return nil, err
+	}
+	if input.ConversationID == "" || input.Content == "" {
+		return nil, &ws.CommandError{Code: http.StatusBadRequest, Message: "conversation_id and content are required"}
+	}
+
+	if c.limits != nil && !c.limits.AllowMessage(userID) {
+		return nil, &ws.CommandError{
+			Code:    http.StatusTooManyRequests,
+			Message: "Message rate limit exceeded. Please wait before sending more messages.",
+		}
+	}
+
+	message, err := c.services.SendMessageWithOptions(ctx, userID, input.ConversationID, input.Content, services.SendMessageOptions{
+		ReplyToID:      input.ReplyToID,
+		ThreadRootID:   input.ThreadRootID,
+		SuppressEmbeds: input.SuppressEmbeds,
+		Nonce:          input.Nonce,
+	})
+	if err != nil {
+		return nil, commandError(err)
+	}
+	return message, nil
+}
+
+// editMessageCommand handles edit_message, the command form of PATCH /messages/:messageID
+func (c *Controller) editMessageCommand(ctx context.Context, userID string, data json.RawMessage) (interface{}, error) {
+	var input struct {
+		MessageID string `json:"message_id"`
+		Content   string `json:"content"`
+	}
+	if err := decodeCommand(data, &input); err != nil {
+		return nil, err
+	}
+	if input.MessageID == "" || input.Content == "" {
+		return nil, &ws.CommandError{Code: http.StatusBadRequest, Message: "message_id and content are required"}
+	}
+
+	message, err := c.services.EditMessage(ctx, input.MessageID, userID, input.Content)
+	if err != nil {
+		return nil, commandError(err)
+	}
+	return message, nil
+}
+
+// deleteMessageCommand handles delete_message, the command form of DELETE /messages/:messageID
+func (c *Controller) deleteMessageCommand(ctx context.Context, userID string, data json.RawMessage) (interface{}, error) {
+	var input struct {
+		MessageID string `json:"message_id"`
+		Scope     string `json:"scope,omitempty"`
+	}
+	if err := decodeCommand(data, &input); err != nil {
+		return nil, err
+	}
+	if input.MessageID == "" {
+		return nil, &ws.CommandError{Code: http.StatusBadRequest, Message: "message_id is required"}
+	}
+
+	var err error
+	switch input.Scope {
+	case "", "everyone":
+		err = c.services.DeleteMessage(ctx, input.MessageID, userID)
+	case "me":
+		err = c.services.DeleteMessageForMe(ctx, input.MessageID, userID)
+	default:
+		return nil, &ws.CommandError{Code: http.StatusBadRequest, Message: "scope must be me or everyone"}
+	}
+	if err != nil {
+		return nil, commandError(err)
+	}
+	return map[string]string{"message_id": input.MessageID}, nil
+}
+
+// markReadCommand handles mark_read, the command form of PUT /conversations/:conversationID/read
+func (c *Controller) markReadCommand(ctx context.Context, userID string, data json.RawMessage) (interface{}, error) {
+	var input struct {
+		ConversationID string `json:"conversation_id"`
+	}
+	if err := decodeCommand(data, &input); err != nil {
+		return nil, err
+	}
+	if input.ConversationID == "" {
+		return nil, &ws.CommandError{Code: http.StatusBadRequest, Message: "conversation_id is required"}
+	}
+
+	if err := c.services.MarkMessagesAsRead(ctx, input.ConversationID, userID); err != nil {
+		return nil, commandError(err)
+	}
+	return map[string]string{"conversation_id": input.ConversationID}, nil
+}
+
+// reactCommand handles react, the command form of POST and DELETE /messages/:messageID/reactions
+func (c *Controller) reactCommand(ctx context.Context, userID string, data json.RawMessage) (interface{}, error) {
+	var input struct {
+		MessageID string `json:"message_id"`
+		Emoji     string `json:"emoji"`
+		Remove    bool   `json:"remove,omitempty"`
+	}
+	if err := decodeCommand(data, &input); err != nil {
+		return nil, err
+	}
+	if input.MessageID == "" || input.Emoji == "" || len(input.Emoji) > 64 {
+		return nil, &ws.CommandError{Code: http.StatusBadRequest, Message: "message_id and emoji are required"}
+	}
+
+	var err error
+	if input.Remove {
+		err = c.services.RemoveReaction(ctx, input.MessageID, userID, input.Emoji)
+	} else {
+		if c.limits != nil && !c.limits.AllowMessage(userID) {
+			return nil, &ws.CommandError{
+				Code:    http.StatusTooManyRequests,
+				Message: "Message rate limit exceeded. Please wait before sending more messages.",
+			}
+		}
+		err = c.services.AddReaction(ctx, input.MessageID, userID, input.Emoji)
+	}
+	if err != nil {
+		return nil, commandError(err)
+	}
+	return map[string]interface{}{
+		"message_id": input.MessageID,
+		"emoji":      input.Emoji,
+		"removed":    input.Remove,
+	}, nil
+}
+
+// decodeCommand reads the data of a command into its input struct
+func decodeCommand(data json.RawMessage, input interface{}) error {
+	if err := json.Unmarshal(data, input); err != nil {
+		return &ws.CommandError{Code: http.StatusBadRequest, Message: "invalid command data"}
+	}
+	return nil
+}
+
+// commandError converts a service error for a command reply, unknown errors stay internal
+func commandError(err error) error {
+	message := err.Error()
+	if code, ok := commandErrorCodes[message]; ok {
+		return &ws.CommandError{Code: code, Message: message}
+	}
+	return err
+}
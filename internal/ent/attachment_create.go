@@ -0,0 +1,522 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/attachment"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttachmentCreate is the builder for creating a Attachment entity.
+type AttachmentCreate struct {
+	config
+	mutation *AttachmentMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ac *AttachmentCreate) SetCreatedAt(t time.Time) *AttachmentCreate {
+	ac.mutation.SetCreatedAt(t)
+	return ac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableCreatedAt(t *time.Time) *AttachmentCreate {
+	if t != nil {
+		ac.SetCreatedAt(*t)
+	}
+	return ac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ac *AttachmentCreate) SetUpdatedAt(t time.Time) *AttachmentCreate {
+	ac.mutation.SetUpdatedAt(t)
+	return ac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableUpdatedAt(t *time.Time) *AttachmentCreate {
+	if t != nil {
+		ac.SetUpdatedAt(*t)
+	}
+	return ac
+}
+
+// SetMessageID sets the "message_id" field.
+func (ac *AttachmentCreate) SetMessageID(s string) *AttachmentCreate {
+	ac.mutation.SetMessageID(s)
+	return ac
+}
+
+// SetUploaderID sets the "uploader_id" field.
+func (ac *AttachmentCreate) SetUploaderID(s string) *AttachmentCreate {
+	ac.mutation.SetUploaderID(s)
+	return ac
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (ac *AttachmentCreate) SetStorageKey(s string) *AttachmentCreate {
+	ac.mutation.SetStorageKey(s)
+	return ac
+}
+
+// SetFilename sets the "filename" field.
+func (ac *AttachmentCreate) SetFilename(s string) *AttachmentCreate {
+	ac.mutation.SetFilename(s)
+	return ac
+}
+
+// SetContentType sets the "content_type" field.
+func (ac *AttachmentCreate) SetContentType(s string) *AttachmentCreate {
+	ac.mutation.SetContentType(s)
+	return ac
+}
+
+// SetSize sets the "size" field.
+func (ac *AttachmentCreate) SetSize(i int64) *AttachmentCreate {
+	ac.mutation.SetSize(i)
+	return ac
+}
+
+// SetProcessingStatus sets the "processing_status" field.
+func (ac *AttachmentCreate) SetProcessingStatus(as attachment.ProcessingStatus) *AttachmentCreate {
+	ac.mutation.SetProcessingStatus(as)
+	return ac
+}
+
+// SetNillableProcessingStatus sets the "processing_status" field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableProcessingStatus(as *attachment.ProcessingStatus) *AttachmentCreate {
+	if as != nil {
+		ac.SetProcessingStatus(*as)
+	}
+	return ac
+}
+
+// SetWidth sets the "width" field.
+func (ac *AttachmentCreate) SetWidth(i int) *AttachmentCreate {
+	ac.mutation.SetWidth(i)
+	return ac
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableWidth(i *int) *AttachmentCreate {
+	if i != nil {
+		ac.SetWidth(*i)
+	}
+	return ac
+}
+
+// SetHeight sets the "height" field.
+func (ac *AttachmentCreate) SetHeight(i int) *AttachmentCreate {
+	ac.mutation.SetHeight(i)
+	return ac
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableHeight(i *int) *AttachmentCreate {
+	if i != nil {
+		ac.SetHeight(*i)
+	}
+	return ac
+}
+
+// SetBlurhash sets the "blurhash" field.
+func (ac *AttachmentCreate) SetBlurhash(s string) *AttachmentCreate {
+	ac.mutation.SetBlurhash(s)
+	return ac
+}
+
+// SetNillableBlurhash sets the "blurhash" field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableBlurhash(s *string) *AttachmentCreate {
+	if s != nil {
+		ac.SetBlurhash(*s)
+	}
+	return ac
+}
+
+// SetThumbnailKey sets the "thumbnail_key" field.
+func (ac *AttachmentCreate) SetThumbnailKey(s string) *AttachmentCreate {
+	ac.mutation.SetThumbnailKey(s)
+	return ac
+}
+
+// SetNillableThumbnailKey sets the "thumbnail_key" field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableThumbnailKey(s *string) *AttachmentCreate {
+	if s != nil {
+		ac.SetThumbnailKey(*s)
+	}
+	return ac
+}
+
+// SetThumbnailContentType sets the "thumbnail_content_type" field.
+func (ac *AttachmentCreate) SetThumbnailContentType(s string) *AttachmentCreate {
+	ac.mutation.SetThumbnailContentType(s)
+	return ac
+}
+
+// SetNillableThumbnailContentType sets the "thumbnail_content_type" field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableThumbnailContentType(s *string) *AttachmentCreate {
+	if s != nil {
+		ac.SetThumbnailContentType(*s)
+	}
+	return ac
+}
+
+// SetID sets the "id" field.
+func (ac *AttachmentCreate) SetID(s string) *AttachmentCreate {
+	ac.mutation.SetID(s)
+	return ac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ac *AttachmentCreate) SetNillableID(s *string) *AttachmentCreate {
+	if s != nil {
+		ac.SetID(*s)
+	}
+	return ac
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (ac *AttachmentCreate) SetMessage(m *Message) *AttachmentCreate {
+	return ac.SetMessageID(m.ID)
+}
+
+// SetUploader sets the "uploader" edge to the User entity.
+func (ac *AttachmentCreate) SetUploader(u *User) *AttachmentCreate {
+	return ac.SetUploaderID(u.ID)
+}
+
+// Mutation returns the AttachmentMutation object of the builder.
+func (ac *AttachmentCreate) Mutation() *AttachmentMutation {
+	return ac.mutation
+}
+
+// Save creates the Attachment in the database.
+func (ac *AttachmentCreate) Save(ctx context.Context) (*Attachment, error) {
+	ac.defaults()
+	return withHooks(ctx, ac.sqlSave, ac.mutation, ac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ac *AttachmentCreate) SaveX(ctx context.Context) *Attachment {
+	v, err := ac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ac *AttachmentCreate) Exec(ctx context.Context) error {
+	_, err := ac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ac *AttachmentCreate) ExecX(ctx context.Context) {
+	if err := ac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ac *AttachmentCreate) defaults() {
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		v := attachment.DefaultCreatedAt()
+		ac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ac.mutation.UpdatedAt(); !ok {
+		v := attachment.DefaultUpdatedAt()
+		ac.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ac.mutation.ProcessingStatus(); !ok {
+		v := attachment.DefaultProcessingStatus
+		ac.mutation.SetProcessingStatus(v)
+	}
+	if _, ok := ac.mutation.ID(); !ok {
+		v := attachment.DefaultID()
+		ac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ac *AttachmentCreate) check() error {
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Attachment.created_at"`)}
+	}
+	if _, ok := ac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Attachment.updated_at"`)}
+	}
+	if _, ok := ac.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "Attachment.message_id"`)}
+	}
+	if v, ok := ac.mutation.MessageID(); ok {
+		if err := attachment.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "Attachment.message_id": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.UploaderID(); !ok {
+		return &ValidationError{Name: "uploader_id", err: errors.New(`ent: missing required field "Attachment.uploader_id"`)}
+	}
+	if v, ok := ac.mutation.UploaderID(); ok {
+		if err := attachment.UploaderIDValidator(v); err != nil {
+			return &ValidationError{Name: "uploader_id", err: fmt.Errorf(`ent: validator failed for field "Attachment.uploader_id": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.StorageKey(); !ok {
+		return &ValidationError{Name: "storage_key", err: errors.New(`ent: missing required field "Attachment.storage_key"`)}
+	}
+	if v, ok := ac.mutation.StorageKey(); ok {
+		if err := attachment.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`ent: validator failed for field "Attachment.storage_key": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.Filename(); !ok {
+		return &ValidationError{Name: "filename", err: errors.New(`ent: missing required field "Attachment.filename"`)}
+	}
+	if v, ok := ac.mutation.Filename(); ok {
+		if err := attachment.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "Attachment.filename": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.ContentType(); !ok {
+		return &ValidationError{Name: "content_type", err: errors.New(`ent: missing required field "Attachment.content_type"`)}
+	}
+	if v, ok := ac.mutation.ContentType(); ok {
+		if err := attachment.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "Attachment.content_type": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "Attachment.size"`)}
+	}
+	if v, ok := ac.mutation.Size(); ok {
+		if err := attachment.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Attachment.size": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.ProcessingStatus(); !ok {
+		return &ValidationError{Name: "processing_status", err: errors.New(`ent: missing required field "Attachment.processing_status"`)}
+	}
+	if v, ok := ac.mutation.ProcessingStatus(); ok {
+		if err := attachment.ProcessingStatusValidator(v); err != nil {
+			return &ValidationError{Name: "processing_status", err: fmt.Errorf(`ent: validator failed for field "Attachment.processing_status": %w`, err)}
+		}
+	}
+	if len(ac.mutation.MessageIDs()) == 0 {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required edge "Attachment.message"`)}
+	}
+	if len(ac.mutation.UploaderIDs()) == 0 {
+		return &ValidationError{Name: "uploader", err: errors.New(`ent: missing required edge "Attachment.uploader"`)}
+	}
+	return nil
+}
+
+func (ac *AttachmentCreate) sqlSave(ctx context.Context) (*Attachment, error) {
+	if err := ac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Attachment.ID type: %T", _spec.ID.Value)
+		}
+	}
+	ac.mutation.id = &_node.ID
+	ac.mutation.done = true
+	return _node, nil
+}
+
+func (ac *AttachmentCreate) createSpec() (*Attachment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Attachment{config: ac.config}
+		_spec = sqlgraph.NewCreateSpec(attachment.Table, sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeString))
+	)
+	if id, ok := ac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ac.mutation.CreatedAt(); ok {
+		_spec.SetField(attachment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ac.mutation.UpdatedAt(); ok {
+		_spec.SetField(attachment.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := ac.mutation.StorageKey(); ok {
+		_spec.SetField(attachment.FieldStorageKey, field.TypeString, value)
+		_node.StorageKey = value
+	}
+	if value, ok := ac.mutation.Filename(); ok {
+		_spec.SetField(attachment.FieldFilename, field.TypeString, value)
+		_node.Filename = value
+	}
+	if value, ok := ac.mutation.ContentType(); ok {
+		_spec.SetField(attachment.FieldContentType, field.TypeString, value)
+		_node.ContentType = value
+	}
+	if value, ok := ac.mutation.Size(); ok {
+		_spec.SetField(attachment.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := ac.mutation.ProcessingStatus(); ok {
+		_spec.SetField(attachment.FieldProcessingStatus, field.TypeEnum, value)
+		_node.ProcessingStatus = value
+	}
+	if value, ok := ac.mutation.Width(); ok {
+		_spec.SetField(attachment.FieldWidth, field.TypeInt, value)
+		_node.Width = value
+	}
+	if value, ok := ac.mutation.Height(); ok {
+		_spec.SetField(attachment.FieldHeight, field.TypeInt, value)
+		_node.Height = value
+	}
+	if value, ok := ac.mutation.Blurhash(); ok {
+		_spec.SetField(attachment.FieldBlurhash, field.TypeString, value)
+		_node.Blurhash = value
+	}
+	if value, ok := ac.mutation.ThumbnailKey(); ok {
+		_spec.SetField(attachment.FieldThumbnailKey, field.TypeString, value)
+		_node.ThumbnailKey = value
+	}
+	if value, ok := ac.mutation.ThumbnailContentType(); ok {
+		_spec.SetField(attachment.FieldThumbnailContentType, field.TypeString, value)
+		_node.ThumbnailContentType = value
+	}
+	if nodes := ac.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.MessageTable,
+			Columns: []string{attachment.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.UploaderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.UploaderTable,
+			Columns: []string{attachment.UploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UploaderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AttachmentCreateBulk is the builder for creating many Attachment entities in bulk.
+type AttachmentCreateBulk struct {
+	config
+	err      error
+	builders []*AttachmentCreate
+}
+
+// Save creates the Attachment entities in the database.
+func (acb *AttachmentCreateBulk) Save(ctx context.Context) ([]*Attachment, error) {
+	if acb.err != nil {
+		return nil, acb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(acb.builders))
+	nodes := make([]*Attachment, len(acb.builders))
+	mutators := make([]Mutator, len(acb.builders))
+	for i := range acb.builders {
+		func(i int, root context.Context) {
+			builder := acb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AttachmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, acb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, acb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, acb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acb *AttachmentCreateBulk) SaveX(ctx context.Context) []*Attachment {
+	v, err := acb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (acb *AttachmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := acb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acb *AttachmentCreateBulk) ExecX(ctx context.Context) {
+	if err := acb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
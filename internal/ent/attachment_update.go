@@ -0,0 +1,1052 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/attachment"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttachmentUpdate is the builder for updating Attachment entities.
+type AttachmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AttachmentMutation
+}
+
+// Where appends a list predicates to the AttachmentUpdate builder.
+func (au *AttachmentUpdate) Where(ps ...predicate.Attachment) *AttachmentUpdate {
+	au.mutation.Where(ps...)
+	return au
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (au *AttachmentUpdate) SetCreatedAt(t time.Time) *AttachmentUpdate {
+	au.mutation.SetCreatedAt(t)
+	return au
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableCreatedAt(t *time.Time) *AttachmentUpdate {
+	if t != nil {
+		au.SetCreatedAt(*t)
+	}
+	return au
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (au *AttachmentUpdate) SetUpdatedAt(t time.Time) *AttachmentUpdate {
+	au.mutation.SetUpdatedAt(t)
+	return au
+}
+
+// SetMessageID sets the "message_id" field.
+func (au *AttachmentUpdate) SetMessageID(s string) *AttachmentUpdate {
+	au.mutation.SetMessageID(s)
+	return au
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableMessageID(s *string) *AttachmentUpdate {
+	if s != nil {
+		au.SetMessageID(*s)
+	}
+	return au
+}
+
+// SetUploaderID sets the "uploader_id" field.
+func (au *AttachmentUpdate) SetUploaderID(s string) *AttachmentUpdate {
+	au.mutation.SetUploaderID(s)
+	return au
+}
+
+// SetNillableUploaderID sets the "uploader_id" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableUploaderID(s *string) *AttachmentUpdate {
+	if s != nil {
+		au.SetUploaderID(*s)
+	}
+	return au
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (au *AttachmentUpdate) SetStorageKey(s string) *AttachmentUpdate {
+	au.mutation.SetStorageKey(s)
+	return au
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableStorageKey(s *string) *AttachmentUpdate {
+	if s != nil {
+		au.SetStorageKey(*s)
+	}
+	return au
+}
+
+// SetFilename sets the "filename" field.
+func (au *AttachmentUpdate) SetFilename(s string) *AttachmentUpdate {
+	au.mutation.SetFilename(s)
+	return au
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableFilename(s *string) *AttachmentUpdate {
+	if s != nil {
+		au.SetFilename(*s)
+	}
+	return au
+}
+
+// SetContentType sets the "content_type" field.
+func (au *AttachmentUpdate) SetContentType(s string) *AttachmentUpdate {
+	au.mutation.SetContentType(s)
+	return au
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableContentType(s *string) *AttachmentUpdate {
+	if s != nil {
+		au.SetContentType(*s)
+	}
+	return au
+}
+
+// SetSize sets the "size" field.
+func (au *AttachmentUpdate) SetSize(i int64) *AttachmentUpdate {
+	au.mutation.ResetSize()
+	au.mutation.SetSize(i)
+	return au
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableSize(i *int64) *AttachmentUpdate {
+	if i != nil {
+		au.SetSize(*i)
+	}
+	return au
+}
+
+// AddSize adds i to the "size" field.
+func (au *AttachmentUpdate) AddSize(i int64) *AttachmentUpdate {
+	au.mutation.AddSize(i)
+	return au
+}
+
+// SetProcessingStatus sets the "processing_status" field.
+func (au *AttachmentUpdate) SetProcessingStatus(as attachment.ProcessingStatus) *AttachmentUpdate {
+	au.mutation.SetProcessingStatus(as)
+	return au
+}
+
+// SetNillableProcessingStatus sets the "processing_status" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableProcessingStatus(as *attachment.ProcessingStatus) *AttachmentUpdate {
+	if as != nil {
+		au.SetProcessingStatus(*as)
+	}
+	return au
+}
+
+// SetWidth sets the "width" field.
+func (au *AttachmentUpdate) SetWidth(i int) *AttachmentUpdate {
+	au.mutation.ResetWidth()
+	au.mutation.SetWidth(i)
+	return au
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableWidth(i *int) *AttachmentUpdate {
+	if i != nil {
+		au.SetWidth(*i)
+	}
+	return au
+}
+
+// AddWidth adds i to the "width" field.
+func (au *AttachmentUpdate) AddWidth(i int) *AttachmentUpdate {
+	au.mutation.AddWidth(i)
+	return au
+}
+
+// ClearWidth clears the value of the "width" field.
+func (au *AttachmentUpdate) ClearWidth() *AttachmentUpdate {
+	au.mutation.ClearWidth()
+	return au
+}
+
+// SetHeight sets the "height" field.
+func (au *AttachmentUpdate) SetHeight(i int) *AttachmentUpdate {
+	au.mutation.ResetHeight()
+	au.mutation.SetHeight(i)
+	return au
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableHeight(i *int) *AttachmentUpdate {
+	if i != nil {
+		au.SetHeight(*i)
+	}
+	return au
+}
+
+// AddHeight adds i to the "height" field.
+func (au *AttachmentUpdate) AddHeight(i int) *AttachmentUpdate {
+	au.mutation.AddHeight(i)
+	return au
+}
+
+// ClearHeight clears the value of the "height" field.
+func (au *AttachmentUpdate) ClearHeight() *AttachmentUpdate {
+	au.mutation.ClearHeight()
+	return au
+}
+
+// SetBlurhash sets the "blurhash" field.
+func (au *AttachmentUpdate) SetBlurhash(s string) *AttachmentUpdate {
+	au.mutation.SetBlurhash(s)
+	return au
+}
+
+// SetNillableBlurhash sets the "blurhash" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableBlurhash(s *string) *AttachmentUpdate {
+	if s != nil {
+		au.SetBlurhash(*s)
+	}
+	return au
+}
+
+// ClearBlurhash clears the value of the "blurhash" field.
+func (au *AttachmentUpdate) ClearBlurhash() *AttachmentUpdate {
+	au.mutation.ClearBlurhash()
+	return au
+}
+
+// SetThumbnailKey sets the "thumbnail_key" field.
+func (au *AttachmentUpdate) SetThumbnailKey(s string) *AttachmentUpdate {
+	au.mutation.SetThumbnailKey(s)
+	return au
+}
+
+// SetNillableThumbnailKey sets the "thumbnail_key" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableThumbnailKey(s *string) *AttachmentUpdate {
+	if s != nil {
+		au.SetThumbnailKey(*s)
+	}
+	return au
+}
+
+// ClearThumbnailKey clears the value of the "thumbnail_key" field.
+func (au *AttachmentUpdate) ClearThumbnailKey() *AttachmentUpdate {
+	au.mutation.ClearThumbnailKey()
+	return au
+}
+
+// SetThumbnailContentType sets the "thumbnail_content_type" field.
+func (au *AttachmentUpdate) SetThumbnailContentType(s string) *AttachmentUpdate {
+	au.mutation.SetThumbnailContentType(s)
+	return au
+}
+
+// SetNillableThumbnailContentType sets the "thumbnail_content_type" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableThumbnailContentType(s *string) *AttachmentUpdate {
+	if s != nil {
+		au.SetThumbnailContentType(*s)
+	}
+	return au
+}
+
+// ClearThumbnailContentType clears the value of the "thumbnail_content_type" field.
+func (au *AttachmentUpdate) ClearThumbnailContentType() *AttachmentUpdate {
+	au.mutation.ClearThumbnailContentType()
+	return au
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (au *AttachmentUpdate) SetMessage(m *Message) *AttachmentUpdate {
+	return au.SetMessageID(m.ID)
+}
+
+// SetUploader sets the "uploader" edge to the User entity.
+func (au *AttachmentUpdate) SetUploader(u *User) *AttachmentUpdate {
+	return au.SetUploaderID(u.ID)
+}
+
+// Mutation returns the AttachmentMutation object of the builder.
+func (au *AttachmentUpdate) Mutation() *AttachmentMutation {
+	return au.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (au *AttachmentUpdate) ClearMessage() *AttachmentUpdate {
+	au.mutation.ClearMessage()
+	return au
+}
+
+// ClearUploader clears the "uploader" edge to the User entity.
+func (au *AttachmentUpdate) ClearUploader() *AttachmentUpdate {
+	au.mutation.ClearUploader()
+	return au
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (au *AttachmentUpdate) Save(ctx context.Context) (int, error) {
+	au.defaults()
+	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *AttachmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *AttachmentUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *AttachmentUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (au *AttachmentUpdate) defaults() {
+	if _, ok := au.mutation.UpdatedAt(); !ok {
+		v := attachment.UpdateDefaultUpdatedAt()
+		au.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (au *AttachmentUpdate) check() error {
+	if v, ok := au.mutation.MessageID(); ok {
+		if err := attachment.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "Attachment.message_id": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.UploaderID(); ok {
+		if err := attachment.UploaderIDValidator(v); err != nil {
+			return &ValidationError{Name: "uploader_id", err: fmt.Errorf(`ent: validator failed for field "Attachment.uploader_id": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.StorageKey(); ok {
+		if err := attachment.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`ent: validator failed for field "Attachment.storage_key": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.Filename(); ok {
+		if err := attachment.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "Attachment.filename": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.ContentType(); ok {
+		if err := attachment.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "Attachment.content_type": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.Size(); ok {
+		if err := attachment.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Attachment.size": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.ProcessingStatus(); ok {
+		if err := attachment.ProcessingStatusValidator(v); err != nil {
+			return &ValidationError{Name: "processing_status", err: fmt.Errorf(`ent: validator failed for field "Attachment.processing_status": %w`, err)}
+		}
+	}
+	if au.mutation.MessageCleared() && len(au.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Attachment.message"`)
+	}
+	if au.mutation.UploaderCleared() && len(au.mutation.UploaderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Attachment.uploader"`)
+	}
+	return nil
+}
+
+func (au *AttachmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := au.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attachment.Table, attachment.Columns, sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeString))
+	if ps := au.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := au.mutation.CreatedAt(); ok {
+		_spec.SetField(attachment.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := au.mutation.UpdatedAt(); ok {
+		_spec.SetField(attachment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := au.mutation.StorageKey(); ok {
+		_spec.SetField(attachment.FieldStorageKey, field.TypeString, value)
+	}
+	if value, ok := au.mutation.Filename(); ok {
+		_spec.SetField(attachment.FieldFilename, field.TypeString, value)
+	}
+	if value, ok := au.mutation.ContentType(); ok {
+		_spec.SetField(attachment.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := au.mutation.Size(); ok {
+		_spec.SetField(attachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := au.mutation.AddedSize(); ok {
+		_spec.AddField(attachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := au.mutation.ProcessingStatus(); ok {
+		_spec.SetField(attachment.FieldProcessingStatus, field.TypeEnum, value)
+	}
+	if value, ok := au.mutation.Width(); ok {
+		_spec.SetField(attachment.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedWidth(); ok {
+		_spec.AddField(attachment.FieldWidth, field.TypeInt, value)
+	}
+	if au.mutation.WidthCleared() {
+		_spec.ClearField(attachment.FieldWidth, field.TypeInt)
+	}
+	if value, ok := au.mutation.Height(); ok {
+		_spec.SetField(attachment.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedHeight(); ok {
+		_spec.AddField(attachment.FieldHeight, field.TypeInt, value)
+	}
+	if au.mutation.HeightCleared() {
+		_spec.ClearField(attachment.FieldHeight, field.TypeInt)
+	}
+	if value, ok := au.mutation.Blurhash(); ok {
+		_spec.SetField(attachment.FieldBlurhash, field.TypeString, value)
+	}
+	if au.mutation.BlurhashCleared() {
+		_spec.ClearField(attachment.FieldBlurhash, field.TypeString)
+	}
+	if value, ok := au.mutation.ThumbnailKey(); ok {
+		_spec.SetField(attachment.FieldThumbnailKey, field.TypeString, value)
+	}
+	if au.mutation.ThumbnailKeyCleared() {
+		_spec.ClearField(attachment.FieldThumbnailKey, field.TypeString)
+	}
+	if value, ok := au.mutation.ThumbnailContentType(); ok {
+		_spec.SetField(attachment.FieldThumbnailContentType, field.TypeString, value)
+	}
+	if au.mutation.ThumbnailContentTypeCleared() {
+		_spec.ClearField(attachment.FieldThumbnailContentType, field.TypeString)
+	}
+	if au.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.MessageTable,
+			Columns: []string{attachment.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.MessageTable,
+			Columns: []string{attachment.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.UploaderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.UploaderTable,
+			Columns: []string{attachment.UploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.UploaderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.UploaderTable,
+			Columns: []string{attachment.UploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attachment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	au.mutation.done = true
+	return n, nil
+}
+
+// AttachmentUpdateOne is the builder for updating a single Attachment entity.
+type AttachmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AttachmentMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (auo *AttachmentUpdateOne) SetCreatedAt(t time.Time) *AttachmentUpdateOne {
+	auo.mutation.SetCreatedAt(t)
+	return auo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableCreatedAt(t *time.Time) *AttachmentUpdateOne {
+	if t != nil {
+		auo.SetCreatedAt(*t)
+	}
+	return auo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (auo *AttachmentUpdateOne) SetUpdatedAt(t time.Time) *AttachmentUpdateOne {
+	auo.mutation.SetUpdatedAt(t)
+	return auo
+}
+
+// SetMessageID sets the "message_id" field.
+func (auo *AttachmentUpdateOne) SetMessageID(s string) *AttachmentUpdateOne {
+	auo.mutation.SetMessageID(s)
+	return auo
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableMessageID(s *string) *AttachmentUpdateOne {
+	if s != nil {
+		auo.SetMessageID(*s)
+	}
+	return auo
+}
+
+// SetUploaderID sets the "uploader_id" field.
+func (auo *AttachmentUpdateOne) SetUploaderID(s string) *AttachmentUpdateOne {
+	auo.mutation.SetUploaderID(s)
+	return auo
+}
+
+// SetNillableUploaderID sets the "uploader_id" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableUploaderID(s *string) *AttachmentUpdateOne {
+	if s != nil {
+		auo.SetUploaderID(*s)
+	}
+	return auo
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (auo *AttachmentUpdateOne) SetStorageKey(s string) *AttachmentUpdateOne {
+	auo.mutation.SetStorageKey(s)
+	return auo
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableStorageKey(s *string) *AttachmentUpdateOne {
+	if s != nil {
+		auo.SetStorageKey(*s)
+	}
+	return auo
+}
+
+// SetFilename sets the "filename" field.
+func (auo *AttachmentUpdateOne) SetFilename(s string) *AttachmentUpdateOne {
+	auo.mutation.SetFilename(s)
+	return auo
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableFilename(s *string) *AttachmentUpdateOne {
+	if s != nil {
+		auo.SetFilename(*s)
+	}
+	return auo
+}
+
+// SetContentType sets the "content_type" field.
+func (auo *AttachmentUpdateOne) SetContentType(s string) *AttachmentUpdateOne {
+	auo.mutation.SetContentType(s)
+	return auo
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableContentType(s *string) *AttachmentUpdateOne {
+	if s != nil {
+		auo.SetContentType(*s)
+	}
+	return auo
+}
+
+// SetSize sets the "size" field.
+func (auo *AttachmentUpdateOne) SetSize(i int64) *AttachmentUpdateOne {
+	auo.mutation.ResetSize()
+	auo.mutation.SetSize(i)
+	return auo
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableSize(i *int64) *AttachmentUpdateOne {
+	if i != nil {
+		auo.SetSize(*i)
+	}
+	return auo
+}
+
+// AddSize adds i to the "size" field.
+func (auo *AttachmentUpdateOne) AddSize(i int64) *AttachmentUpdateOne {
+	auo.mutation.AddSize(i)
+	return auo
+}
+
+// SetProcessingStatus sets the "processing_status" field.
+func (auo *AttachmentUpdateOne) SetProcessingStatus(as attachment.ProcessingStatus) *AttachmentUpdateOne {
+	auo.mutation.SetProcessingStatus(as)
+	return auo
+}
+
+// SetNillableProcessingStatus sets the "processing_status" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableProcessingStatus(as *attachment.ProcessingStatus) *AttachmentUpdateOne {
+	if as != nil {
+		auo.SetProcessingStatus(*as)
+	}
+	return auo
+}
+
+// SetWidth sets the "width" field.
+func (auo *AttachmentUpdateOne) SetWidth(i int) *AttachmentUpdateOne {
+	auo.mutation.ResetWidth()
+	auo.mutation.SetWidth(i)
+	return auo
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableWidth(i *int) *AttachmentUpdateOne {
+	if i != nil {
+		auo.SetWidth(*i)
+	}
+	return auo
+}
+
+// AddWidth adds i to the "width" field.
+func (auo *AttachmentUpdateOne) AddWidth(i int) *AttachmentUpdateOne {
+	auo.mutation.AddWidth(i)
+	return auo
+}
+
+// ClearWidth clears the value of the "width" field.
+func (auo *AttachmentUpdateOne) ClearWidth() *AttachmentUpdateOne {
+	auo.mutation.ClearWidth()
+	return auo
+}
+
+// SetHeight sets the "height" field.
+func (auo *AttachmentUpdateOne) SetHeight(i int) *AttachmentUpdateOne {
+	auo.mutation.ResetHeight()
+	auo.mutation.SetHeight(i)
+	return auo
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableHeight(i *int) *AttachmentUpdateOne {
+	if i != nil {
+		auo.SetHeight(*i)
+	}
+	return auo
+}
+
+// AddHeight adds i to the "height" field.
+func (auo *AttachmentUpdateOne) AddHeight(i int) *AttachmentUpdateOne {
+	auo.mutation.AddHeight(i)
+	return auo
+}
+
+// ClearHeight clears the value of the "height" field.
+func (auo *AttachmentUpdateOne) ClearHeight() *AttachmentUpdateOne {
+	auo.mutation.ClearHeight()
+	return auo
+}
+
+// SetBlurhash sets the "blurhash" field.
+func (auo *AttachmentUpdateOne) SetBlurhash(s string) *AttachmentUpdateOne {
+	auo.mutation.SetBlurhash(s)
+	return auo
+}
+
+// SetNillableBlurhash sets the "blurhash" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableBlurhash(s *string) *AttachmentUpdateOne {
+	if s != nil {
+		auo.SetBlurhash(*s)
+	}
+	return auo
+}
+
+// ClearBlurhash clears the value of the "blurhash" field.
+func (auo *AttachmentUpdateOne) ClearBlurhash() *AttachmentUpdateOne {
+	auo.mutation.ClearBlurhash()
+	return auo
+}
+
+// SetThumbnailKey sets the "thumbnail_key" field.
+func (auo *AttachmentUpdateOne) SetThumbnailKey(s string) *AttachmentUpdateOne {
+	auo.mutation.SetThumbnailKey(s)
+	return auo
+}
+
+// SetNillableThumbnailKey sets the "thumbnail_key" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableThumbnailKey(s *string) *AttachmentUpdateOne {
+	if s != nil {
+		auo.SetThumbnailKey(*s)
+	}
+	return auo
+}
+
+// ClearThumbnailKey clears the value of the "thumbnail_key" field.
+func (auo *AttachmentUpdateOne) ClearThumbnailKey() *AttachmentUpdateOne {
+	auo.mutation.ClearThumbnailKey()
+	return auo
+}
+
+// SetThumbnailContentType sets the "thumbnail_content_type" field.
+func (auo *AttachmentUpdateOne) SetThumbnailContentType(s string) *AttachmentUpdateOne {
+	auo.mutation.SetThumbnailContentType(s)
+	return auo
+}
+
+// SetNillableThumbnailContentType sets the "thumbnail_content_type" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableThumbnailContentType(s *string) *AttachmentUpdateOne {
+	if s != nil {
+		auo.SetThumbnailContentType(*s)
+	}
+	return auo
+}
+
+// ClearThumbnailContentType clears the value of the "thumbnail_content_type" field.
+func (auo *AttachmentUpdateOne) ClearThumbnailContentType() *AttachmentUpdateOne {
+	auo.mutation.ClearThumbnailContentType()
+	return auo
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (auo *AttachmentUpdateOne) SetMessage(m *Message) *AttachmentUpdateOne {
+	return auo.SetMessageID(m.ID)
+}
+
+// SetUploader sets the "uploader" edge to the User entity.
+func (auo *AttachmentUpdateOne) SetUploader(u *User) *AttachmentUpdateOne {
+	return auo.SetUploaderID(u.ID)
+}
+
+// Mutation returns the AttachmentMutation object of the builder.
+func (auo *AttachmentUpdateOne) Mutation() *AttachmentMutation {
+	return auo.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (auo *AttachmentUpdateOne) ClearMessage() *AttachmentUpdateOne {
+	auo.mutation.ClearMessage()
+	return auo
+}
+
+// ClearUploader clears the "uploader" edge to the User entity.
+func (auo *AttachmentUpdateOne) ClearUploader() *AttachmentUpdateOne {
+	auo.mutation.ClearUploader()
+	return auo
+}
+
+// Where appends a list predicates to the AttachmentUpdate builder.
+func (auo *AttachmentUpdateOne) Where(ps ...predicate.Attachment) *AttachmentUpdateOne {
+	auo.mutation.Where(ps...)
+	return auo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (auo *AttachmentUpdateOne) Select(field string, fields ...string) *AttachmentUpdateOne {
+	auo.fields = append([]string{field}, fields...)
+	return auo
+}
+
+// Save executes the query and returns the updated Attachment entity.
+func (auo *AttachmentUpdateOne) Save(ctx context.Context) (*Attachment, error) {
+	auo.defaults()
+	return withHooks(ctx, auo.sqlSave, auo.mutation, auo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *AttachmentUpdateOne) SaveX(ctx context.Context) *Attachment {
+	node, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (auo *AttachmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *AttachmentUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (auo *AttachmentUpdateOne) defaults() {
+	if _, ok := auo.mutation.UpdatedAt(); !ok {
+		v := attachment.UpdateDefaultUpdatedAt()
+		auo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (auo *AttachmentUpdateOne) check() error {
+	if v, ok := auo.mutation.MessageID(); ok {
+		if err := attachment.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "Attachment.message_id": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.UploaderID(); ok {
+		if err := attachment.UploaderIDValidator(v); err != nil {
+			return &ValidationError{Name: "uploader_id", err: fmt.Errorf(`ent: validator failed for field "Attachment.uploader_id": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.StorageKey(); ok {
+		if err := attachment.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`ent: validator failed for field "Attachment.storage_key": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.Filename(); ok {
+		if err := attachment.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "Attachment.filename": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.ContentType(); ok {
+		if err := attachment.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "Attachment.content_type": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.Size(); ok {
+		if err := attachment.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Attachment.size": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.ProcessingStatus(); ok {
+		if err := attachment.ProcessingStatusValidator(v); err != nil {
+			return &ValidationError{Name: "processing_status", err: fmt.Errorf(`ent: validator failed for field "Attachment.processing_status": %w`, err)}
+		}
+	}
+	if auo.mutation.MessageCleared() && len(auo.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Attachment.message"`)
+	}
+	if auo.mutation.UploaderCleared() && len(auo.mutation.UploaderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Attachment.uploader"`)
+	}
+	return nil
+}
+
+func (auo *AttachmentUpdateOne) sqlSave(ctx context.Context) (_node *Attachment, err error) {
+	if err := auo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attachment.Table, attachment.Columns, sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeString))
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Attachment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := auo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attachment.FieldID)
+		for _, f := range fields {
+			if !attachment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != attachment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := auo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := auo.mutation.CreatedAt(); ok {
+		_spec.SetField(attachment.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := auo.mutation.UpdatedAt(); ok {
+		_spec.SetField(attachment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := auo.mutation.StorageKey(); ok {
+		_spec.SetField(attachment.FieldStorageKey, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.Filename(); ok {
+		_spec.SetField(attachment.FieldFilename, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.ContentType(); ok {
+		_spec.SetField(attachment.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.Size(); ok {
+		_spec.SetField(attachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := auo.mutation.AddedSize(); ok {
+		_spec.AddField(attachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := auo.mutation.ProcessingStatus(); ok {
+		_spec.SetField(attachment.FieldProcessingStatus, field.TypeEnum, value)
+	}
+	if value, ok := auo.mutation.Width(); ok {
+		_spec.SetField(attachment.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedWidth(); ok {
+		_spec.AddField(attachment.FieldWidth, field.TypeInt, value)
+	}
+	if auo.mutation.WidthCleared() {
+		_spec.ClearField(attachment.FieldWidth, field.TypeInt)
+	}
+	if value, ok := auo.mutation.Height(); ok {
+		_spec.SetField(attachment.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedHeight(); ok {
+		_spec.AddField(attachment.FieldHeight, field.TypeInt, value)
+	}
+	if auo.mutation.HeightCleared() {
+		_spec.ClearField(attachment.FieldHeight, field.TypeInt)
+	}
+	if value, ok := auo.mutation.Blurhash(); ok {
+		_spec.SetField(attachment.FieldBlurhash, field.TypeString, value)
+	}
+	if auo.mutation.BlurhashCleared() {
+		_spec.ClearField(attachment.FieldBlurhash, field.TypeString)
+	}
+	if value, ok := auo.mutation.ThumbnailKey(); ok {
+		_spec.SetField(attachment.FieldThumbnailKey, field.TypeString, value)
+	}
+	if auo.mutation.ThumbnailKeyCleared() {
+		_spec.ClearField(attachment.FieldThumbnailKey, field.TypeString)
+	}
+	if value, ok := auo.mutation.ThumbnailContentType(); ok {
+		_spec.SetField(attachment.FieldThumbnailContentType, field.TypeString, value)
+	}
+	if auo.mutation.ThumbnailContentTypeCleared() {
+		_spec.ClearField(attachment.FieldThumbnailContentType, field.TypeString)
+	}
+	if auo.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.MessageTable,
+			Columns: []string{attachment.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.MessageTable,
+			Columns: []string{attachment.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.UploaderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.UploaderTable,
+			Columns: []string{attachment.UploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.UploaderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   attachment.UploaderTable,
+			Columns: []string{attachment.UploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Attachment{config: auo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attachment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	auo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"kakashi/chaos/internal/ent/bookmark"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Bookmark is the model entity for the Bookmark schema.
+type Bookmark struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// Saved message, cleared when the message is hard deleted so the bookmark stays as a tombstone
+	MessageID string `json:"message_id,omitempty"`
+	// Conversation of the saved message, kept for filtering after the message is gone
+	ConversationID string `json:"conversation_id,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// When to remind the user of the message, cleared once the reminder is sent
+	RemindAt time.Time `json:"remind_at,omitempty"`
+	// RemindedAt holds the value of the "reminded_at" field.
+	RemindedAt time.Time `json:"reminded_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BookmarkQuery when eager-loading is set.
+	Edges        BookmarkEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BookmarkEdges holds the relations/edges for other nodes in the graph.
+type BookmarkEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Message holds the value of the message edge.
+	Message *Message `json:"message,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BookmarkEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// MessageOrErr returns the Message value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BookmarkEdges) MessageOrErr() (*Message, error) {
+	if e.Message != nil {
+		return e.Message, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "message"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Bookmark) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bookmark.FieldID, bookmark.FieldUserID, bookmark.FieldMessageID, bookmark.FieldConversationID, bookmark.FieldNote:
+			values[i] = new(sql.NullString)
+		case bookmark.FieldCreatedAt, bookmark.FieldUpdatedAt, bookmark.FieldRemindAt, bookmark.FieldRemindedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Bookmark fields.
+func (b *Bookmark) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bookmark.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				b.ID = value.String
+			}
+		case bookmark.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				b.CreatedAt = value.Time
+			}
+		case bookmark.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				b.UpdatedAt = value.Time
+			}
+		case bookmark.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				b.UserID = value.String
+			}
+		case bookmark.FieldMessageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value.Valid {
+				b.MessageID = value.String
+			}
+		case bookmark.FieldConversationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field conversation_id", values[i])
+			} else if value.Valid {
+				b.ConversationID = value.String
+			}
+		case bookmark.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				b.Note = value.String
+			}
+		case bookmark.FieldRemindAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field remind_at", values[i])
+			} else if value.Valid {
+				b.RemindAt = value.Time
+			}
+		case bookmark.FieldRemindedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reminded_at", values[i])
+			} else if value.Valid {
+				b.RemindedAt = value.Time
+			}
+		default:
+			b.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Bookmark.
+// This includes values selected through modifiers, order, etc.
+func (b *Bookmark) Value(name string) (ent.Value, error) {
+	return b.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Bookmark entity.
+func (b *Bookmark) QueryUser() *UserQuery {
+	return NewBookmarkClient(b.config).QueryUser(b)
+}
+
+// QueryMessage queries the "message" edge of the Bookmark entity.
+func (b *Bookmark) QueryMessage() *MessageQuery {
+	return NewBookmarkClient(b.config).QueryMessage(b)
+}
+
+// Update returns a builder for updating this Bookmark.
+// Note that you need to call Bookmark.Unwrap() before calling this method if this Bookmark
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (b *Bookmark) Update() *BookmarkUpdateOne {
+	return NewBookmarkClient(b.config).UpdateOne(b)
+}
+
+// Unwrap unwraps the Bookmark entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (b *Bookmark) Unwrap() *Bookmark {
+	_tx, ok := b.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Bookmark is not a transactional entity")
+	}
+	b.config.driver = _tx.drv
+	return b
+}
+
+// String implements the fmt.Stringer.
+func (b *Bookmark) String() string {
+	var builder strings.Builder
+	builder.WriteString("Bookmark(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", b.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(b.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(b.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(b.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("message_id=")
+	builder.WriteString(b.MessageID)
+	builder.WriteString(", ")
+	builder.WriteString("conversation_id=")
+	builder.WriteString(b.ConversationID)
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(b.Note)
+	builder.WriteString(", ")
+	builder.WriteString("remind_at=")
+	builder.WriteString(b.RemindAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("reminded_at=")
+	builder.WriteString(b.RemindedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Bookmarks is a parsable slice of Bookmark.
+type Bookmarks []*Bookmark
@@ -0,0 +1,399 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/bookmark"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BookmarkCreate is the builder for creating a Bookmark entity.
+type BookmarkCreate struct {
+	config
+	mutation *BookmarkMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bc *BookmarkCreate) SetCreatedAt(t time.Time) *BookmarkCreate {
+	bc.mutation.SetCreatedAt(t)
+	return bc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bc *BookmarkCreate) SetNillableCreatedAt(t *time.Time) *BookmarkCreate {
+	if t != nil {
+		bc.SetCreatedAt(*t)
+	}
+	return bc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bc *BookmarkCreate) SetUpdatedAt(t time.Time) *BookmarkCreate {
+	bc.mutation.SetUpdatedAt(t)
+	return bc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (bc *BookmarkCreate) SetNillableUpdatedAt(t *time.Time) *BookmarkCreate {
+	if t != nil {
+		bc.SetUpdatedAt(*t)
+	}
+	return bc
+}
+
+// SetUserID sets the "user_id" field.
+func (bc *BookmarkCreate) SetUserID(s string) *BookmarkCreate {
+	bc.mutation.SetUserID(s)
+	return bc
+}
+
+// SetMessageID sets the "message_id" field.
+func (bc *BookmarkCreate) SetMessageID(s string) *BookmarkCreate {
+	bc.mutation.SetMessageID(s)
+	return bc
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (bc *BookmarkCreate) SetNillableMessageID(s *string) *BookmarkCreate {
+	if s != nil {
+		bc.SetMessageID(*s)
+	}
+	return bc
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (bc *BookmarkCreate) SetConversationID(s string) *BookmarkCreate {
+	bc.mutation.SetConversationID(s)
+	return bc
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (bc *BookmarkCreate) SetNillableConversationID(s *string) *BookmarkCreate {
+	if s != nil {
+		bc.SetConversationID(*s)
+	}
+	return bc
+}
+
+// SetNote sets the "note" field.
+func (bc *BookmarkCreate) SetNote(s string) *BookmarkCreate {
+	bc.mutation.SetNote(s)
+	return bc
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (bc *BookmarkCreate) SetNillableNote(s *string) *BookmarkCreate {
+	if s != nil {
+		bc.SetNote(*s)
+	}
+	return bc
+}
+
+// SetRemindAt sets the "remind_at" field.
+func (bc *BookmarkCreate) SetRemindAt(t time.Time) *BookmarkCreate {
+	bc.mutation.SetRemindAt(t)
+	return bc
+}
+
+// SetNillableRemindAt sets the "remind_at" field if the given value is not nil.
+func (bc *BookmarkCreate) SetNillableRemindAt(t *time.Time) *BookmarkCreate {
+	if t != nil {
+		bc.SetRemindAt(*t)
+	}
+	return bc
+}
+
+// SetRemindedAt sets the "reminded_at" field.
+func (bc *BookmarkCreate) SetRemindedAt(t time.Time) *BookmarkCreate {
+	bc.mutation.SetRemindedAt(t)
+	return bc
+}
+
+// SetNillableRemindedAt sets the "reminded_at" field if the given value is not nil.
+func (bc *BookmarkCreate) SetNillableRemindedAt(t *time.Time) *BookmarkCreate {
+	if t != nil {
+		bc.SetRemindedAt(*t)
+	}
+	return bc
+}
+
+// SetID sets the "id" field.
+func (bc *BookmarkCreate) SetID(s string) *BookmarkCreate {
+	bc.mutation.SetID(s)
+	return bc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (bc *BookmarkCreate) SetNillableID(s *string) *BookmarkCreate {
+	if s != nil {
+		bc.SetID(*s)
+	}
+	return bc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (bc *BookmarkCreate) SetUser(u *User) *BookmarkCreate {
+	return bc.SetUserID(u.ID)
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (bc *BookmarkCreate) SetMessage(m *Message) *BookmarkCreate {
+	return bc.SetMessageID(m.ID)
+}
+
+// Mutation returns the BookmarkMutation object of the builder.
+func (bc *BookmarkCreate) Mutation() *BookmarkMutation {
+	return bc.mutation
+}
+
+// Save creates the Bookmark in the database.
+func (bc *BookmarkCreate) Save(ctx context.Context) (*Bookmark, error) {
+	bc.defaults()
+	return withHooks(ctx, bc.sqlSave, bc.mutation, bc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bc *BookmarkCreate) SaveX(ctx context.Context) *Bookmark {
+	v, err := bc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bc *BookmarkCreate) Exec(ctx context.Context) error {
+	_, err := bc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bc *BookmarkCreate) ExecX(ctx context.Context) {
+	if err := bc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bc *BookmarkCreate) defaults() {
+	if _, ok := bc.mutation.CreatedAt(); !ok {
+		v := bookmark.DefaultCreatedAt()
+		bc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := bc.mutation.UpdatedAt(); !ok {
+		v := bookmark.DefaultUpdatedAt()
+		bc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := bc.mutation.ID(); !ok {
+		v := bookmark.DefaultID()
+		bc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bc *BookmarkCreate) check() error {
+	if _, ok := bc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Bookmark.created_at"`)}
+	}
+	if _, ok := bc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Bookmark.updated_at"`)}
+	}
+	if _, ok := bc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Bookmark.user_id"`)}
+	}
+	if v, ok := bc.mutation.UserID(); ok {
+		if err := bookmark.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Bookmark.user_id": %w`, err)}
+		}
+	}
+	if len(bc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Bookmark.user"`)}
+	}
+	return nil
+}
+
+func (bc *BookmarkCreate) sqlSave(ctx context.Context) (*Bookmark, error) {
+	if err := bc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := bc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Bookmark.ID type: %T", _spec.ID.Value)
+		}
+	}
+	bc.mutation.id = &_node.ID
+	bc.mutation.done = true
+	return _node, nil
+}
+
+func (bc *BookmarkCreate) createSpec() (*Bookmark, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Bookmark{config: bc.config}
+		_spec = sqlgraph.NewCreateSpec(bookmark.Table, sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeString))
+	)
+	if id, ok := bc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := bc.mutation.CreatedAt(); ok {
+		_spec.SetField(bookmark.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := bc.mutation.UpdatedAt(); ok {
+		_spec.SetField(bookmark.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := bc.mutation.ConversationID(); ok {
+		_spec.SetField(bookmark.FieldConversationID, field.TypeString, value)
+		_node.ConversationID = value
+	}
+	if value, ok := bc.mutation.Note(); ok {
+		_spec.SetField(bookmark.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := bc.mutation.RemindAt(); ok {
+		_spec.SetField(bookmark.FieldRemindAt, field.TypeTime, value)
+		_node.RemindAt = value
+	}
+	if value, ok := bc.mutation.RemindedAt(); ok {
+		_spec.SetField(bookmark.FieldRemindedAt, field.TypeTime, value)
+		_node.RemindedAt = value
+	}
+	if nodes := bc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bookmark.UserTable,
+			Columns: []string{bookmark.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bookmark.MessageTable,
+			Columns: []string{bookmark.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BookmarkCreateBulk is the builder for creating many Bookmark entities in bulk.
+type BookmarkCreateBulk struct {
+	config
+	err      error
+	builders []*BookmarkCreate
+}
+
+// Save creates the Bookmark entities in the database.
+func (bcb *BookmarkCreateBulk) Save(ctx context.Context) ([]*Bookmark, error) {
+	if bcb.err != nil {
+		return nil, bcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(bcb.builders))
+	nodes := make([]*Bookmark, len(bcb.builders))
+	mutators := make([]Mutator, len(bcb.builders))
+	for i := range bcb.builders {
+		func(i int, root context.Context) {
+			builder := bcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BookmarkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bcb *BookmarkCreateBulk) SaveX(ctx context.Context) []*Bookmark {
+	v, err := bcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bcb *BookmarkCreateBulk) Exec(ctx context.Context) error {
+	_, err := bcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcb *BookmarkCreateBulk) ExecX(ctx context.Context) {
+	if err := bcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,716 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/bookmark"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BookmarkUpdate is the builder for updating Bookmark entities.
+type BookmarkUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BookmarkMutation
+}
+
+// Where appends a list predicates to the BookmarkUpdate builder.
+func (bu *BookmarkUpdate) Where(ps ...predicate.Bookmark) *BookmarkUpdate {
+	bu.mutation.Where(ps...)
+	return bu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bu *BookmarkUpdate) SetCreatedAt(t time.Time) *BookmarkUpdate {
+	bu.mutation.SetCreatedAt(t)
+	return bu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bu *BookmarkUpdate) SetNillableCreatedAt(t *time.Time) *BookmarkUpdate {
+	if t != nil {
+		bu.SetCreatedAt(*t)
+	}
+	return bu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bu *BookmarkUpdate) SetUpdatedAt(t time.Time) *BookmarkUpdate {
+	bu.mutation.SetUpdatedAt(t)
+	return bu
+}
+
+// SetUserID sets the "user_id" field.
+func (bu *BookmarkUpdate) SetUserID(s string) *BookmarkUpdate {
+	bu.mutation.SetUserID(s)
+	return bu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (bu *BookmarkUpdate) SetNillableUserID(s *string) *BookmarkUpdate {
+	if s != nil {
+		bu.SetUserID(*s)
+	}
+	return bu
+}
+
+// SetMessageID sets the "message_id" field.
+func (bu *BookmarkUpdate) SetMessageID(s string) *BookmarkUpdate {
+	bu.mutation.SetMessageID(s)
+	return bu
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (bu *BookmarkUpdate) SetNillableMessageID(s *string) *BookmarkUpdate {
+	if s != nil {
+		bu.SetMessageID(*s)
+	}
+	return bu
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (bu *BookmarkUpdate) ClearMessageID() *BookmarkUpdate {
+	bu.mutation.ClearMessageID()
+	return bu
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (bu *BookmarkUpdate) SetConversationID(s string) *BookmarkUpdate {
+	bu.mutation.SetConversationID(s)
+	return bu
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (bu *BookmarkUpdate) SetNillableConversationID(s *string) *BookmarkUpdate {
+	if s != nil {
+		bu.SetConversationID(*s)
+	}
+	return bu
+}
+
+// ClearConversationID clears the value of the "conversation_id" field.
+func (bu *BookmarkUpdate) ClearConversationID() *BookmarkUpdate {
+	bu.mutation.ClearConversationID()
+	return bu
+}
+
+// SetNote sets the "note" field.
+func (bu *BookmarkUpdate) SetNote(s string) *BookmarkUpdate {
+	bu.mutation.SetNote(s)
+	return bu
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (bu *BookmarkUpdate) SetNillableNote(s *string) *BookmarkUpdate {
+	if s != nil {
+		bu.SetNote(*s)
+	}
+	return bu
+}
+
+// ClearNote clears the value of the "note" field.
+func (bu *BookmarkUpdate) ClearNote() *BookmarkUpdate {
+	bu.mutation.ClearNote()
+	return bu
+}
+
+// SetRemindAt sets the "remind_at" field.
+func (bu *BookmarkUpdate) SetRemindAt(t time.Time) *BookmarkUpdate {
+	bu.mutation.SetRemindAt(t)
+	return bu
+}
+
+// SetNillableRemindAt sets the "remind_at" field if the given value is not nil.
+func (bu *BookmarkUpdate) SetNillableRemindAt(t *time.Time) *BookmarkUpdate {
+	if t != nil {
+		bu.SetRemindAt(*t)
+	}
+	return bu
+}
+
+// ClearRemindAt clears the value of the "remind_at" field.
+func (bu *BookmarkUpdate) ClearRemindAt() *BookmarkUpdate {
+	bu.mutation.ClearRemindAt()
+	return bu
+}
+
+// SetRemindedAt sets the "reminded_at" field.
+func (bu *BookmarkUpdate) SetRemindedAt(t time.Time) *BookmarkUpdate {
+	bu.mutation.SetRemindedAt(t)
+	return bu
+}
+
+// SetNillableRemindedAt sets the "reminded_at" field if the given value is not nil.
+func (bu *BookmarkUpdate) SetNillableRemindedAt(t *time.Time) *BookmarkUpdate {
+	if t != nil {
+		bu.SetRemindedAt(*t)
+	}
+	return bu
+}
+
+// ClearRemindedAt clears the value of the "reminded_at" field.
+func (bu *BookmarkUpdate) ClearRemindedAt() *BookmarkUpdate {
+	bu.mutation.ClearRemindedAt()
+	return bu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (bu *BookmarkUpdate) SetUser(u *User) *BookmarkUpdate {
+	return bu.SetUserID(u.ID)
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (bu *BookmarkUpdate) SetMessage(m *Message) *BookmarkUpdate {
+	return bu.SetMessageID(m.ID)
+}
+
+// Mutation returns the BookmarkMutation object of the builder.
+func (bu *BookmarkUpdate) Mutation() *BookmarkMutation {
+	return bu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (bu *BookmarkUpdate) ClearUser() *BookmarkUpdate {
+	bu.mutation.ClearUser()
+	return bu
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (bu *BookmarkUpdate) ClearMessage() *BookmarkUpdate {
+	bu.mutation.ClearMessage()
+	return bu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bu *BookmarkUpdate) Save(ctx context.Context) (int, error) {
+	bu.defaults()
+	return withHooks(ctx, bu.sqlSave, bu.mutation, bu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bu *BookmarkUpdate) SaveX(ctx context.Context) int {
+	affected, err := bu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bu *BookmarkUpdate) Exec(ctx context.Context) error {
+	_, err := bu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bu *BookmarkUpdate) ExecX(ctx context.Context) {
+	if err := bu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bu *BookmarkUpdate) defaults() {
+	if _, ok := bu.mutation.UpdatedAt(); !ok {
+		v := bookmark.UpdateDefaultUpdatedAt()
+		bu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bu *BookmarkUpdate) check() error {
+	if v, ok := bu.mutation.UserID(); ok {
+		if err := bookmark.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Bookmark.user_id": %w`, err)}
+		}
+	}
+	if bu.mutation.UserCleared() && len(bu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Bookmark.user"`)
+	}
+	return nil
+}
+
+func (bu *BookmarkUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := bu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bookmark.Table, bookmark.Columns, sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeString))
+	if ps := bu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bu.mutation.CreatedAt(); ok {
+		_spec.SetField(bookmark.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := bu.mutation.UpdatedAt(); ok {
+		_spec.SetField(bookmark.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := bu.mutation.ConversationID(); ok {
+		_spec.SetField(bookmark.FieldConversationID, field.TypeString, value)
+	}
+	if bu.mutation.ConversationIDCleared() {
+		_spec.ClearField(bookmark.FieldConversationID, field.TypeString)
+	}
+	if value, ok := bu.mutation.Note(); ok {
+		_spec.SetField(bookmark.FieldNote, field.TypeString, value)
+	}
+	if bu.mutation.NoteCleared() {
+		_spec.ClearField(bookmark.FieldNote, field.TypeString)
+	}
+	if value, ok := bu.mutation.RemindAt(); ok {
+		_spec.SetField(bookmark.FieldRemindAt, field.TypeTime, value)
+	}
+	if bu.mutation.RemindAtCleared() {
+		_spec.ClearField(bookmark.FieldRemindAt, field.TypeTime)
+	}
+	if value, ok := bu.mutation.RemindedAt(); ok {
+		_spec.SetField(bookmark.FieldRemindedAt, field.TypeTime, value)
+	}
+	if bu.mutation.RemindedAtCleared() {
+		_spec.ClearField(bookmark.FieldRemindedAt, field.TypeTime)
+	}
+	if bu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bookmark.UserTable,
+			Columns: []string{bookmark.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bookmark.UserTable,
+			Columns: []string{bookmark.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bookmark.MessageTable,
+			Columns: []string{bookmark.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bookmark.MessageTable,
+			Columns: []string{bookmark.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bookmark.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bu.mutation.done = true
+	return n, nil
+}
+
+// BookmarkUpdateOne is the builder for updating a single Bookmark entity.
+type BookmarkUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BookmarkMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (buo *BookmarkUpdateOne) SetCreatedAt(t time.Time) *BookmarkUpdateOne {
+	buo.mutation.SetCreatedAt(t)
+	return buo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (buo *BookmarkUpdateOne) SetNillableCreatedAt(t *time.Time) *BookmarkUpdateOne {
+	if t != nil {
+		buo.SetCreatedAt(*t)
+	}
+	return buo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (buo *BookmarkUpdateOne) SetUpdatedAt(t time.Time) *BookmarkUpdateOne {
+	buo.mutation.SetUpdatedAt(t)
+	return buo
+}
+
+// SetUserID sets the "user_id" field.
+func (buo *BookmarkUpdateOne) SetUserID(s string) *BookmarkUpdateOne {
+	buo.mutation.SetUserID(s)
+	return buo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (buo *BookmarkUpdateOne) SetNillableUserID(s *string) *BookmarkUpdateOne {
+	if s != nil {
+		buo.SetUserID(*s)
+	}
+	return buo
+}
+
+// SetMessageID sets the "message_id" field.
+func (buo *BookmarkUpdateOne) SetMessageID(s string) *BookmarkUpdateOne {
+	buo.mutation.SetMessageID(s)
+	return buo
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (buo *BookmarkUpdateOne) SetNillableMessageID(s *string) *BookmarkUpdateOne {
+	if s != nil {
+		buo.SetMessageID(*s)
+	}
+	return buo
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (buo *BookmarkUpdateOne) ClearMessageID() *BookmarkUpdateOne {
+	buo.mutation.ClearMessageID()
+	return buo
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (buo *BookmarkUpdateOne) SetConversationID(s string) *BookmarkUpdateOne {
+	buo.mutation.SetConversationID(s)
+	return buo
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (buo *BookmarkUpdateOne) SetNillableConversationID(s *string) *BookmarkUpdateOne {
+	if s != nil {
+		buo.SetConversationID(*s)
+	}
+	return buo
+}
+
+// ClearConversationID clears the value of the "conversation_id" field.
+func (buo *BookmarkUpdateOne) ClearConversationID() *BookmarkUpdateOne {
+	buo.mutation.ClearConversationID()
+	return buo
+}
+
+// SetNote sets the "note" field.
+func (buo *BookmarkUpdateOne) SetNote(s string) *BookmarkUpdateOne {
+	buo.mutation.SetNote(s)
+	return buo
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (buo *BookmarkUpdateOne) SetNillableNote(s *string) *BookmarkUpdateOne {
+	if s != nil {
+		buo.SetNote(*s)
+	}
+	return buo
+}
+
+// ClearNote clears the value of the "note" field.
+func (buo *BookmarkUpdateOne) ClearNote() *BookmarkUpdateOne {
+	buo.mutation.ClearNote()
+	return buo
+}
+
+// SetRemindAt sets the "remind_at" field.
+func (buo *BookmarkUpdateOne) SetRemindAt(t time.Time) *BookmarkUpdateOne {
+	buo.mutation.SetRemindAt(t)
+	return buo
+}
+
+// SetNillableRemindAt sets the "remind_at" field if the given value is not nil.
+func (buo *BookmarkUpdateOne) SetNillableRemindAt(t *time.Time) *BookmarkUpdateOne {
+	if t != nil {
+		buo.SetRemindAt(*t)
+	}
+	return buo
+}
+
+// ClearRemindAt clears the value of the "remind_at" field.
+func (buo *BookmarkUpdateOne) ClearRemindAt() *BookmarkUpdateOne {
+	buo.mutation.ClearRemindAt()
+	return buo
+}
+
+// SetRemindedAt sets the "reminded_at" field.
+func (buo *BookmarkUpdateOne) SetRemindedAt(t time.Time) *BookmarkUpdateOne {
+	buo.mutation.SetRemindedAt(t)
+	return buo
+}
+
+// SetNillableRemindedAt sets the "reminded_at" field if the given value is not nil.
+func (buo *BookmarkUpdateOne) SetNillableRemindedAt(t *time.Time) *BookmarkUpdateOne {
+	if t != nil {
+		buo.SetRemindedAt(*t)
+	}
+	return buo
+}
+
+// ClearRemindedAt clears the value of the "reminded_at" field.
+func (buo *BookmarkUpdateOne) ClearRemindedAt() *BookmarkUpdateOne {
+	buo.mutation.ClearRemindedAt()
+	return buo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (buo *BookmarkUpdateOne) SetUser(u *User) *BookmarkUpdateOne {
+	return buo.SetUserID(u.ID)
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (buo *BookmarkUpdateOne) SetMessage(m *Message) *BookmarkUpdateOne {
+	return buo.SetMessageID(m.ID)
+}
+
+// Mutation returns the BookmarkMutation object of the builder.
+func (buo *BookmarkUpdateOne) Mutation() *BookmarkMutation {
+	return buo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (buo *BookmarkUpdateOne) ClearUser() *BookmarkUpdateOne {
+	buo.mutation.ClearUser()
+	return buo
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (buo *BookmarkUpdateOne) ClearMessage() *BookmarkUpdateOne {
+	buo.mutation.ClearMessage()
+	return buo
+}
+
+// Where appends a list predicates to the BookmarkUpdate builder.
+func (buo *BookmarkUpdateOne) Where(ps ...predicate.Bookmark) *BookmarkUpdateOne {
+	buo.mutation.Where(ps...)
+	return buo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (buo *BookmarkUpdateOne) Select(field string, fields ...string) *BookmarkUpdateOne {
+	buo.fields = append([]string{field}, fields...)
+	return buo
+}
+
+// Save executes the query and returns the updated Bookmark entity.
+func (buo *BookmarkUpdateOne) Save(ctx context.Context) (*Bookmark, error) {
+	buo.defaults()
+	return withHooks(ctx, buo.sqlSave, buo.mutation, buo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (buo *BookmarkUpdateOne) SaveX(ctx context.Context) *Bookmark {
+	node, err := buo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (buo *BookmarkUpdateOne) Exec(ctx context.Context) error {
+	_, err := buo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (buo *BookmarkUpdateOne) ExecX(ctx context.Context) {
+	if err := buo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (buo *BookmarkUpdateOne) defaults() {
+	if _, ok := buo.mutation.UpdatedAt(); !ok {
+		v := bookmark.UpdateDefaultUpdatedAt()
+		buo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (buo *BookmarkUpdateOne) check() error {
+	if v, ok := buo.mutation.UserID(); ok {
+		if err := bookmark.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Bookmark.user_id": %w`, err)}
+		}
+	}
+	if buo.mutation.UserCleared() && len(buo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Bookmark.user"`)
+	}
+	return nil
+}
+
+func (buo *BookmarkUpdateOne) sqlSave(ctx context.Context) (_node *Bookmark, err error) {
+	if err := buo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bookmark.Table, bookmark.Columns, sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeString))
+	id, ok := buo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Bookmark.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := buo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bookmark.FieldID)
+		for _, f := range fields {
+			if !bookmark.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != bookmark.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := buo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := buo.mutation.CreatedAt(); ok {
+		_spec.SetField(bookmark.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := buo.mutation.UpdatedAt(); ok {
+		_spec.SetField(bookmark.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := buo.mutation.ConversationID(); ok {
+		_spec.SetField(bookmark.FieldConversationID, field.TypeString, value)
+	}
+	if buo.mutation.ConversationIDCleared() {
+		_spec.ClearField(bookmark.FieldConversationID, field.TypeString)
+	}
+	if value, ok := buo.mutation.Note(); ok {
+		_spec.SetField(bookmark.FieldNote, field.TypeString, value)
+	}
+	if buo.mutation.NoteCleared() {
+		_spec.ClearField(bookmark.FieldNote, field.TypeString)
+	}
+	if value, ok := buo.mutation.RemindAt(); ok {
+		_spec.SetField(bookmark.FieldRemindAt, field.TypeTime, value)
+	}
+	if buo.mutation.RemindAtCleared() {
+		_spec.ClearField(bookmark.FieldRemindAt, field.TypeTime)
+	}
+	if value, ok := buo.mutation.RemindedAt(); ok {
+		_spec.SetField(bookmark.FieldRemindedAt, field.TypeTime, value)
+	}
+	if buo.mutation.RemindedAtCleared() {
+		_spec.ClearField(bookmark.FieldRemindedAt, field.TypeTime)
+	}
+	if buo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bookmark.UserTable,
+			Columns: []string{bookmark.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bookmark.UserTable,
+			Columns: []string{bookmark.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bookmark.MessageTable,
+			Columns: []string{bookmark.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   bookmark.MessageTable,
+			Columns: []string{bookmark.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Bookmark{config: buo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, buo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bookmark.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	buo.mutation.done = true
+	return _node, nil
+}
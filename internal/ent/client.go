@@ -11,17 +11,33 @@ import (
 
 	"kakashi/chaos/internal/ent/migrate"
 
+	"kakashi/chaos/internal/ent/attachment"
 	"kakashi/chaos/internal/ent/block"
+	"kakashi/chaos/internal/ent/bookmark"
 	"kakashi/chaos/internal/ent/call"
 	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/conversationfolder"
 	"kakashi/chaos/internal/ent/conversationparticipant"
+	"kakashi/chaos/internal/ent/draft"
+	"kakashi/chaos/internal/ent/folderconversation"
 	"kakashi/chaos/internal/ent/friend"
+	"kakashi/chaos/internal/ent/friendinvite"
+	"kakashi/chaos/internal/ent/friendinviteuse"
 	"kakashi/chaos/internal/ent/guild"
+	"kakashi/chaos/internal/ent/hiddenmessage"
 	"kakashi/chaos/internal/ent/invitation"
+	"kakashi/chaos/internal/ent/linkpreview"
 	"kakashi/chaos/internal/ent/member"
 	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/messagemention"
+	"kakashi/chaos/internal/ent/messagereaction"
+	"kakashi/chaos/internal/ent/messagereceipt"
+	"kakashi/chaos/internal/ent/messagerevision"
 	"kakashi/chaos/internal/ent/notification"
+	"kakashi/chaos/internal/ent/pinnedmessage"
+	"kakashi/chaos/internal/ent/scheduledmessage"
 	"kakashi/chaos/internal/ent/session"
+	"kakashi/chaos/internal/ent/threadparticipant"
 	"kakashi/chaos/internal/ent/user"
 
 	"entgo.io/ent"
@@ -35,28 +51,60 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Attachment is the client for interacting with the Attachment builders.
+	Attachment *AttachmentClient
 	// Block is the client for interacting with the Block builders.
 	Block *BlockClient
+	// Bookmark is the client for interacting with the Bookmark builders.
+	Bookmark *BookmarkClient
 	// Call is the client for interacting with the Call builders.
 	Call *CallClient
 	// Conversation is the client for interacting with the Conversation builders.
 	Conversation *ConversationClient
+	// ConversationFolder is the client for interacting with the ConversationFolder builders.
+	ConversationFolder *ConversationFolderClient
 	// ConversationParticipant is the client for interacting with the ConversationParticipant builders.
 	ConversationParticipant *ConversationParticipantClient
+	// Draft is the client for interacting with the Draft builders.
+	Draft *DraftClient
+	// FolderConversation is the client for interacting with the FolderConversation builders.
+	FolderConversation *FolderConversationClient
 	// Friend is the client for interacting with the Friend builders.
 	Friend *FriendClient
+	// FriendInvite is the client for interacting with the FriendInvite builders.
+	FriendInvite *FriendInviteClient
+	// FriendInviteUse is the client for interacting with the FriendInviteUse builders.
+	FriendInviteUse *FriendInviteUseClient
 	// Guild is the client for interacting with the Guild builders.
 	Guild *GuildClient
+	// HiddenMessage is the client for interacting with the HiddenMessage builders.
+	HiddenMessage *HiddenMessageClient
 	// Invitation is the client for interacting with the Invitation builders.
 	Invitation *InvitationClient
+	// LinkPreview is the client for interacting with the LinkPreview builders.
+	LinkPreview *LinkPreviewClient
 	// Member is the client for interacting with the Member builders.
 	Member *MemberClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
+	// MessageMention is the client for interacting with the MessageMention builders.
+	MessageMention *MessageMentionClient
+	// MessageReaction is the client for interacting with the MessageReaction builders.
+	MessageReaction *MessageReactionClient
+	// MessageReceipt is the client for interacting with the MessageReceipt builders.
+	MessageReceipt *MessageReceiptClient
+	// MessageRevision is the client for interacting with the MessageRevision builders.
+	MessageRevision *MessageRevisionClient
 	// Notification is the client for interacting with the Notification builders.
 	Notification *NotificationClient
+	// PinnedMessage is the client for interacting with the PinnedMessage builders.
+	PinnedMessage *PinnedMessageClient
+	// ScheduledMessage is the client for interacting with the ScheduledMessage builders.
+	ScheduledMessage *ScheduledMessageClient
 	// Session is the client for interacting with the Session builders.
 	Session *SessionClient
+	// ThreadParticipant is the client for interacting with the ThreadParticipant builders.
+	ThreadParticipant *ThreadParticipantClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 }
@@ -70,17 +118,33 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Attachment = NewAttachmentClient(c.config)
 	c.Block = NewBlockClient(c.config)
+	c.Bookmark = NewBookmarkClient(c.config)
 	c.Call = NewCallClient(c.config)
 	c.Conversation = NewConversationClient(c.config)
+	c.ConversationFolder = NewConversationFolderClient(c.config)
 	c.ConversationParticipant = NewConversationParticipantClient(c.config)
+	c.Draft = NewDraftClient(c.config)
+	c.FolderConversation = NewFolderConversationClient(c.config)
 	c.Friend = NewFriendClient(c.config)
+	c.FriendInvite = NewFriendInviteClient(c.config)
+	c.FriendInviteUse = NewFriendInviteUseClient(c.config)
 	c.Guild = NewGuildClient(c.config)
+	c.HiddenMessage = NewHiddenMessageClient(c.config)
 	c.Invitation = NewInvitationClient(c.config)
+	c.LinkPreview = NewLinkPreviewClient(c.config)
 	c.Member = NewMemberClient(c.config)
 	c.Message = NewMessageClient(c.config)
+	c.MessageMention = NewMessageMentionClient(c.config)
+	c.MessageReaction = NewMessageReactionClient(c.config)
+	c.MessageReceipt = NewMessageReceiptClient(c.config)
+	c.MessageRevision = NewMessageRevisionClient(c.config)
 	c.Notification = NewNotificationClient(c.config)
+	c.PinnedMessage = NewPinnedMessageClient(c.config)
+	c.ScheduledMessage = NewScheduledMessageClient(c.config)
 	c.Session = NewSessionClient(c.config)
+	c.ThreadParticipant = NewThreadParticipantClient(c.config)
 	c.User = NewUserClient(c.config)
 }
 
@@ -174,17 +238,33 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:                     ctx,
 		config:                  cfg,
+		Attachment:              NewAttachmentClient(cfg),
 		Block:                   NewBlockClient(cfg),
+		Bookmark:                NewBookmarkClient(cfg),
 		Call:                    NewCallClient(cfg),
 		Conversation:            NewConversationClient(cfg),
+		ConversationFolder:      NewConversationFolderClient(cfg),
 		ConversationParticipant: NewConversationParticipantClient(cfg),
+		Draft:                   NewDraftClient(cfg),
+		FolderConversation:      NewFolderConversationClient(cfg),
 		Friend:                  NewFriendClient(cfg),
+		FriendInvite:            NewFriendInviteClient(cfg),
+		FriendInviteUse:         NewFriendInviteUseClient(cfg),
 		Guild:                   NewGuildClient(cfg),
+		HiddenMessage:           NewHiddenMessageClient(cfg),
 		Invitation:              NewInvitationClient(cfg),
+		LinkPreview:             NewLinkPreviewClient(cfg),
 		Member:                  NewMemberClient(cfg),
 		Message:                 NewMessageClient(cfg),
+		MessageMention:          NewMessageMentionClient(cfg),
+		MessageReaction:         NewMessageReactionClient(cfg),
+		MessageReceipt:          NewMessageReceiptClient(cfg),
+		MessageRevision:         NewMessageRevisionClient(cfg),
 		Notification:            NewNotificationClient(cfg),
+		PinnedMessage:           NewPinnedMessageClient(cfg),
+		ScheduledMessage:        NewScheduledMessageClient(cfg),
 		Session:                 NewSessionClient(cfg),
+		ThreadParticipant:       NewThreadParticipantClient(cfg),
 		User:                    NewUserClient(cfg),
 	}, nil
 }
@@ -205,17 +285,33 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:                     ctx,
 		config:                  cfg,
+		Attachment:              NewAttachmentClient(cfg),
 		Block:                   NewBlockClient(cfg),
+		Bookmark:                NewBookmarkClient(cfg),
 		Call:                    NewCallClient(cfg),
 		Conversation:            NewConversationClient(cfg),
+		ConversationFolder:      NewConversationFolderClient(cfg),
 		ConversationParticipant: NewConversationParticipantClient(cfg),
+		Draft:                   NewDraftClient(cfg),
+		FolderConversation:      NewFolderConversationClient(cfg),
 		Friend:                  NewFriendClient(cfg),
+		FriendInvite:            NewFriendInviteClient(cfg),
+		FriendInviteUse:         NewFriendInviteUseClient(cfg),
 		Guild:                   NewGuildClient(cfg),
+		HiddenMessage:           NewHiddenMessageClient(cfg),
 		Invitation:              NewInvitationClient(cfg),
+		LinkPreview:             NewLinkPreviewClient(cfg),
 		Member:                  NewMemberClient(cfg),
 		Message:                 NewMessageClient(cfg),
+		MessageMention:          NewMessageMentionClient(cfg),
+		MessageReaction:         NewMessageReactionClient(cfg),
+		MessageReceipt:          NewMessageReceiptClient(cfg),
+		MessageRevision:         NewMessageRevisionClient(cfg),
 		Notification:            NewNotificationClient(cfg),
+		PinnedMessage:           NewPinnedMessageClient(cfg),
+		ScheduledMessage:        NewScheduledMessageClient(cfg),
 		Session:                 NewSessionClient(cfg),
+		ThreadParticipant:       NewThreadParticipantClient(cfg),
 		User:                    NewUserClient(cfg),
 	}, nil
 }
@@ -223,7 +319,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Block.
+//		Attachment.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -246,8 +342,12 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Block, c.Call, c.Conversation, c.ConversationParticipant, c.Friend, c.Guild,
-		c.Invitation, c.Member, c.Message, c.Notification, c.Session, c.User,
+		c.Attachment, c.Block, c.Bookmark, c.Call, c.Conversation, c.ConversationFolder,
+		c.ConversationParticipant, c.Draft, c.FolderConversation, c.Friend,
+		c.FriendInvite, c.FriendInviteUse, c.Guild, c.HiddenMessage, c.Invitation,
+		c.LinkPreview, c.Member, c.Message, c.MessageMention, c.MessageReaction,
+		c.MessageReceipt, c.MessageRevision, c.Notification, c.PinnedMessage,
+		c.ScheduledMessage, c.Session, c.ThreadParticipant, c.User,
 	} {
 		n.Use(hooks...)
 	}
@@ -257,8 +357,12 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Block, c.Call, c.Conversation, c.ConversationParticipant, c.Friend, c.Guild,
-		c.Invitation, c.Member, c.Message, c.Notification, c.Session, c.User,
+		c.Attachment, c.Block, c.Bookmark, c.Call, c.Conversation, c.ConversationFolder,
+		c.ConversationParticipant, c.Draft, c.FolderConversation, c.Friend,
+		c.FriendInvite, c.FriendInviteUse, c.Guild, c.HiddenMessage, c.Invitation,
+		c.LinkPreview, c.Member, c.Message, c.MessageMention, c.MessageReaction,
+		c.MessageReceipt, c.MessageRevision, c.Notification, c.PinnedMessage,
+		c.ScheduledMessage, c.Session, c.ThreadParticipant, c.User,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -267,28 +371,60 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AttachmentMutation:
+		return c.Attachment.mutate(ctx, m)
 	case *BlockMutation:
 		return c.Block.mutate(ctx, m)
+	case *BookmarkMutation:
+		return c.Bookmark.mutate(ctx, m)
 	case *CallMutation:
 		return c.Call.mutate(ctx, m)
 	case *ConversationMutation:
 		return c.Conversation.mutate(ctx, m)
+	case *ConversationFolderMutation:
+		return c.ConversationFolder.mutate(ctx, m)
 	case *ConversationParticipantMutation:
 		return c.ConversationParticipant.mutate(ctx, m)
+	case *DraftMutation:
+		return c.Draft.mutate(ctx, m)
+	case *FolderConversationMutation:
+		return c.FolderConversation.mutate(ctx, m)
 	case *FriendMutation:
 		return c.Friend.mutate(ctx, m)
+	case *FriendInviteMutation:
+		return c.FriendInvite.mutate(ctx, m)
+	case *FriendInviteUseMutation:
+		return c.FriendInviteUse.mutate(ctx, m)
 	case *GuildMutation:
 		return c.Guild.mutate(ctx, m)
+	case *HiddenMessageMutation:
+		return c.HiddenMessage.mutate(ctx, m)
 	case *InvitationMutation:
 		return c.Invitation.mutate(ctx, m)
+	case *LinkPreviewMutation:
+		return c.LinkPreview.mutate(ctx, m)
 	case *MemberMutation:
 		return c.Member.mutate(ctx, m)
 	case *MessageMutation:
 		return c.Message.mutate(ctx, m)
+	case *MessageMentionMutation:
+		return c.MessageMention.mutate(ctx, m)
+	case *MessageReactionMutation:
+		return c.MessageReaction.mutate(ctx, m)
+	case *MessageReceiptMutation:
+		return c.MessageReceipt.mutate(ctx, m)
+	case *MessageRevisionMutation:
+		return c.MessageRevision.mutate(ctx, m)
 	case *NotificationMutation:
 		return c.Notification.mutate(ctx, m)
+	case *PinnedMessageMutation:
+		return c.PinnedMessage.mutate(ctx, m)
+	case *ScheduledMessageMutation:
+		return c.ScheduledMessage.mutate(ctx, m)
 	case *SessionMutation:
 		return c.Session.mutate(ctx, m)
+	case *ThreadParticipantMutation:
+		return c.ThreadParticipant.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	default:
@@ -296,6 +432,171 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// AttachmentClient is a client for the Attachment schema.
+type AttachmentClient struct {
+	config
+}
+
+// NewAttachmentClient returns a client for the Attachment from the given config.
+func NewAttachmentClient(c config) *AttachmentClient {
+	return &AttachmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `attachment.Hooks(f(g(h())))`.
+func (c *AttachmentClient) Use(hooks ...Hook) {
+	c.hooks.Attachment = append(c.hooks.Attachment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `attachment.Intercept(f(g(h())))`.
+func (c *AttachmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Attachment = append(c.inters.Attachment, interceptors...)
+}
+
+// Create returns a builder for creating a Attachment entity.
+func (c *AttachmentClient) Create() *AttachmentCreate {
+	mutation := newAttachmentMutation(c.config, OpCreate)
+	return &AttachmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Attachment entities.
+func (c *AttachmentClient) CreateBulk(builders ...*AttachmentCreate) *AttachmentCreateBulk {
+	return &AttachmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AttachmentClient) MapCreateBulk(slice any, setFunc func(*AttachmentCreate, int)) *AttachmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AttachmentCreateBulk{err: fmt.Errorf("calling to AttachmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AttachmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AttachmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Attachment.
+func (c *AttachmentClient) Update() *AttachmentUpdate {
+	mutation := newAttachmentMutation(c.config, OpUpdate)
+	return &AttachmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AttachmentClient) UpdateOne(a *Attachment) *AttachmentUpdateOne {
+	mutation := newAttachmentMutation(c.config, OpUpdateOne, withAttachment(a))
+	return &AttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AttachmentClient) UpdateOneID(id string) *AttachmentUpdateOne {
+	mutation := newAttachmentMutation(c.config, OpUpdateOne, withAttachmentID(id))
+	return &AttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Attachment.
+func (c *AttachmentClient) Delete() *AttachmentDelete {
+	mutation := newAttachmentMutation(c.config, OpDelete)
+	return &AttachmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AttachmentClient) DeleteOne(a *Attachment) *AttachmentDeleteOne {
+	return c.DeleteOneID(a.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AttachmentClient) DeleteOneID(id string) *AttachmentDeleteOne {
+	builder := c.Delete().Where(attachment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AttachmentDeleteOne{builder}
+}
+
+// Query returns a query builder for Attachment.
+func (c *AttachmentClient) Query() *AttachmentQuery {
+	return &AttachmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAttachment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Attachment entity by its id.
+func (c *AttachmentClient) Get(ctx context.Context, id string) (*Attachment, error) {
+	return c.Query().Where(attachment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AttachmentClient) GetX(ctx context.Context, id string) *Attachment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMessage queries the message edge of a Attachment.
+func (c *AttachmentClient) QueryMessage(a *Attachment) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attachment.Table, attachment.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, attachment.MessageTable, attachment.MessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUploader queries the uploader edge of a Attachment.
+func (c *AttachmentClient) QueryUploader(a *Attachment) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(attachment.Table, attachment.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, attachment.UploaderTable, attachment.UploaderColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AttachmentClient) Hooks() []Hook {
+	return c.hooks.Attachment
+}
+
+// Interceptors returns the client interceptors.
+func (c *AttachmentClient) Interceptors() []Interceptor {
+	return c.inters.Attachment
+}
+
+func (c *AttachmentClient) mutate(ctx context.Context, m *AttachmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AttachmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AttachmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AttachmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Attachment mutation op: %q", m.Op())
+	}
+}
+
 // BlockClient is a client for the Block schema.
 type BlockClient struct {
 	config
@@ -461,6 +762,171 @@ func (c *BlockClient) mutate(ctx context.Context, m *BlockMutation) (Value, erro
 	}
 }
 
+// BookmarkClient is a client for the Bookmark schema.
+type BookmarkClient struct {
+	config
+}
+
+// NewBookmarkClient returns a client for the Bookmark from the given config.
+func NewBookmarkClient(c config) *BookmarkClient {
+	return &BookmarkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bookmark.Hooks(f(g(h())))`.
+func (c *BookmarkClient) Use(hooks ...Hook) {
+	c.hooks.Bookmark = append(c.hooks.Bookmark, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bookmark.Intercept(f(g(h())))`.
+func (c *BookmarkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Bookmark = append(c.inters.Bookmark, interceptors...)
+}
+
+// Create returns a builder for creating a Bookmark entity.
+func (c *BookmarkClient) Create() *BookmarkCreate {
+	mutation := newBookmarkMutation(c.config, OpCreate)
+	return &BookmarkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Bookmark entities.
+func (c *BookmarkClient) CreateBulk(builders ...*BookmarkCreate) *BookmarkCreateBulk {
+	return &BookmarkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BookmarkClient) MapCreateBulk(slice any, setFunc func(*BookmarkCreate, int)) *BookmarkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BookmarkCreateBulk{err: fmt.Errorf("calling to BookmarkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BookmarkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BookmarkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Bookmark.
+func (c *BookmarkClient) Update() *BookmarkUpdate {
+	mutation := newBookmarkMutation(c.config, OpUpdate)
+	return &BookmarkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BookmarkClient) UpdateOne(b *Bookmark) *BookmarkUpdateOne {
+	mutation := newBookmarkMutation(c.config, OpUpdateOne, withBookmark(b))
+	return &BookmarkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BookmarkClient) UpdateOneID(id string) *BookmarkUpdateOne {
+	mutation := newBookmarkMutation(c.config, OpUpdateOne, withBookmarkID(id))
+	return &BookmarkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Bookmark.
+func (c *BookmarkClient) Delete() *BookmarkDelete {
+	mutation := newBookmarkMutation(c.config, OpDelete)
+	return &BookmarkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BookmarkClient) DeleteOne(b *Bookmark) *BookmarkDeleteOne {
+	return c.DeleteOneID(b.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BookmarkClient) DeleteOneID(id string) *BookmarkDeleteOne {
+	builder := c.Delete().Where(bookmark.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BookmarkDeleteOne{builder}
+}
+
+// Query returns a query builder for Bookmark.
+func (c *BookmarkClient) Query() *BookmarkQuery {
+	return &BookmarkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBookmark},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Bookmark entity by its id.
+func (c *BookmarkClient) Get(ctx context.Context, id string) (*Bookmark, error) {
+	return c.Query().Where(bookmark.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BookmarkClient) GetX(ctx context.Context, id string) *Bookmark {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Bookmark.
+func (c *BookmarkClient) QueryUser(b *Bookmark) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := b.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bookmark.Table, bookmark.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, bookmark.UserTable, bookmark.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMessage queries the message edge of a Bookmark.
+func (c *BookmarkClient) QueryMessage(b *Bookmark) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := b.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bookmark.Table, bookmark.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, bookmark.MessageTable, bookmark.MessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BookmarkClient) Hooks() []Hook {
+	return c.hooks.Bookmark
+}
+
+// Interceptors returns the client interceptors.
+func (c *BookmarkClient) Interceptors() []Interceptor {
+	return c.inters.Bookmark
+}
+
+func (c *BookmarkClient) mutate(ctx context.Context, m *BookmarkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BookmarkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BookmarkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BookmarkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BookmarkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Bookmark mutation op: %q", m.Op())
+	}
+}
+
 // CallClient is a client for the Call schema.
 type CallClient struct {
 	config
@@ -766,6 +1232,70 @@ func (c *ConversationClient) QueryParticipants(co *Conversation) *ConversationPa
 	return query
 }
 
+// QueryPins queries the pins edge of a Conversation.
+func (c *ConversationClient) QueryPins(co *Conversation) *PinnedMessageQuery {
+	query := (&PinnedMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(conversation.Table, conversation.FieldID, id),
+			sqlgraph.To(pinnedmessage.Table, pinnedmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, conversation.PinsTable, conversation.PinsColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryScheduledMessages queries the scheduled_messages edge of a Conversation.
+func (c *ConversationClient) QueryScheduledMessages(co *Conversation) *ScheduledMessageQuery {
+	query := (&ScheduledMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(conversation.Table, conversation.FieldID, id),
+			sqlgraph.To(scheduledmessage.Table, scheduledmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, conversation.ScheduledMessagesTable, conversation.ScheduledMessagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDrafts queries the drafts edge of a Conversation.
+func (c *ConversationClient) QueryDrafts(co *Conversation) *DraftQuery {
+	query := (&DraftClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(conversation.Table, conversation.FieldID, id),
+			sqlgraph.To(draft.Table, draft.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, conversation.DraftsTable, conversation.DraftsColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFolderEntries queries the folder_entries edge of a Conversation.
+func (c *ConversationClient) QueryFolderEntries(co *Conversation) *FolderConversationQuery {
+	query := (&FolderConversationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(conversation.Table, conversation.FieldID, id),
+			sqlgraph.To(folderconversation.Table, folderconversation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, conversation.FolderEntriesTable, conversation.FolderEntriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ConversationClient) Hooks() []Hook {
 	return c.hooks.Conversation
@@ -791,42 +1321,207 @@ func (c *ConversationClient) mutate(ctx context.Context, m *ConversationMutation
 	}
 }
 
-// ConversationParticipantClient is a client for the ConversationParticipant schema.
-type ConversationParticipantClient struct {
+// ConversationFolderClient is a client for the ConversationFolder schema.
+type ConversationFolderClient struct {
 	config
 }
 
-// NewConversationParticipantClient returns a client for the ConversationParticipant from the given config.
-func NewConversationParticipantClient(c config) *ConversationParticipantClient {
-	return &ConversationParticipantClient{config: c}
+// NewConversationFolderClient returns a client for the ConversationFolder from the given config.
+func NewConversationFolderClient(c config) *ConversationFolderClient {
+	return &ConversationFolderClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `conversationparticipant.Hooks(f(g(h())))`.
-func (c *ConversationParticipantClient) Use(hooks ...Hook) {
-	c.hooks.ConversationParticipant = append(c.hooks.ConversationParticipant, hooks...)
+// A call to `Use(f, g, h)` equals to `conversationfolder.Hooks(f(g(h())))`.
+func (c *ConversationFolderClient) Use(hooks ...Hook) {
+	c.hooks.ConversationFolder = append(c.hooks.ConversationFolder, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `conversationparticipant.Intercept(f(g(h())))`.
-func (c *ConversationParticipantClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ConversationParticipant = append(c.inters.ConversationParticipant, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `conversationfolder.Intercept(f(g(h())))`.
+func (c *ConversationFolderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ConversationFolder = append(c.inters.ConversationFolder, interceptors...)
 }
 
-// Create returns a builder for creating a ConversationParticipant entity.
-func (c *ConversationParticipantClient) Create() *ConversationParticipantCreate {
-	mutation := newConversationParticipantMutation(c.config, OpCreate)
-	return &ConversationParticipantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ConversationFolder entity.
+func (c *ConversationFolderClient) Create() *ConversationFolderCreate {
+	mutation := newConversationFolderMutation(c.config, OpCreate)
+	return &ConversationFolderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ConversationParticipant entities.
-func (c *ConversationParticipantClient) CreateBulk(builders ...*ConversationParticipantCreate) *ConversationParticipantCreateBulk {
-	return &ConversationParticipantCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ConversationFolder entities.
+func (c *ConversationFolderClient) CreateBulk(builders ...*ConversationFolderCreate) *ConversationFolderCreateBulk {
+	return &ConversationFolderCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ConversationParticipantClient) MapCreateBulk(slice any, setFunc func(*ConversationParticipantCreate, int)) *ConversationParticipantCreateBulk {
+func (c *ConversationFolderClient) MapCreateBulk(slice any, setFunc func(*ConversationFolderCreate, int)) *ConversationFolderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ConversationFolderCreateBulk{err: fmt.Errorf("calling to ConversationFolderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ConversationFolderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ConversationFolderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ConversationFolder.
+func (c *ConversationFolderClient) Update() *ConversationFolderUpdate {
+	mutation := newConversationFolderMutation(c.config, OpUpdate)
+	return &ConversationFolderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ConversationFolderClient) UpdateOne(cf *ConversationFolder) *ConversationFolderUpdateOne {
+	mutation := newConversationFolderMutation(c.config, OpUpdateOne, withConversationFolder(cf))
+	return &ConversationFolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ConversationFolderClient) UpdateOneID(id string) *ConversationFolderUpdateOne {
+	mutation := newConversationFolderMutation(c.config, OpUpdateOne, withConversationFolderID(id))
+	return &ConversationFolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ConversationFolder.
+func (c *ConversationFolderClient) Delete() *ConversationFolderDelete {
+	mutation := newConversationFolderMutation(c.config, OpDelete)
+	return &ConversationFolderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ConversationFolderClient) DeleteOne(cf *ConversationFolder) *ConversationFolderDeleteOne {
+	return c.DeleteOneID(cf.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ConversationFolderClient) DeleteOneID(id string) *ConversationFolderDeleteOne {
+	builder := c.Delete().Where(conversationfolder.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ConversationFolderDeleteOne{builder}
+}
+
+// Query returns a query builder for ConversationFolder.
+func (c *ConversationFolderClient) Query() *ConversationFolderQuery {
+	return &ConversationFolderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeConversationFolder},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ConversationFolder entity by its id.
+func (c *ConversationFolderClient) Get(ctx context.Context, id string) (*ConversationFolder, error) {
+	return c.Query().Where(conversationfolder.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ConversationFolderClient) GetX(ctx context.Context, id string) *ConversationFolder {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a ConversationFolder.
+func (c *ConversationFolderClient) QueryUser(cf *ConversationFolder) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cf.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(conversationfolder.Table, conversationfolder.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, conversationfolder.UserTable, conversationfolder.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(cf.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEntries queries the entries edge of a ConversationFolder.
+func (c *ConversationFolderClient) QueryEntries(cf *ConversationFolder) *FolderConversationQuery {
+	query := (&FolderConversationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cf.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(conversationfolder.Table, conversationfolder.FieldID, id),
+			sqlgraph.To(folderconversation.Table, folderconversation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, conversationfolder.EntriesTable, conversationfolder.EntriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(cf.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ConversationFolderClient) Hooks() []Hook {
+	return c.hooks.ConversationFolder
+}
+
+// Interceptors returns the client interceptors.
+func (c *ConversationFolderClient) Interceptors() []Interceptor {
+	return c.inters.ConversationFolder
+}
+
+func (c *ConversationFolderClient) mutate(ctx context.Context, m *ConversationFolderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ConversationFolderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ConversationFolderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ConversationFolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ConversationFolderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ConversationFolder mutation op: %q", m.Op())
+	}
+}
+
+// ConversationParticipantClient is a client for the ConversationParticipant schema.
+type ConversationParticipantClient struct {
+	config
+}
+
+// NewConversationParticipantClient returns a client for the ConversationParticipant from the given config.
+func NewConversationParticipantClient(c config) *ConversationParticipantClient {
+	return &ConversationParticipantClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `conversationparticipant.Hooks(f(g(h())))`.
+func (c *ConversationParticipantClient) Use(hooks ...Hook) {
+	c.hooks.ConversationParticipant = append(c.hooks.ConversationParticipant, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `conversationparticipant.Intercept(f(g(h())))`.
+func (c *ConversationParticipantClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ConversationParticipant = append(c.inters.ConversationParticipant, interceptors...)
+}
+
+// Create returns a builder for creating a ConversationParticipant entity.
+func (c *ConversationParticipantClient) Create() *ConversationParticipantCreate {
+	mutation := newConversationParticipantMutation(c.config, OpCreate)
+	return &ConversationParticipantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ConversationParticipant entities.
+func (c *ConversationParticipantClient) CreateBulk(builders ...*ConversationParticipantCreate) *ConversationParticipantCreateBulk {
+	return &ConversationParticipantCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ConversationParticipantClient) MapCreateBulk(slice any, setFunc func(*ConversationParticipantCreate, int)) *ConversationParticipantCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
 		return &ConversationParticipantCreateBulk{err: fmt.Errorf("calling to ConversationParticipantClient.MapCreateBulk with wrong type %T, need slice", slice)}
@@ -956,107 +1651,107 @@ func (c *ConversationParticipantClient) mutate(ctx context.Context, m *Conversat
 	}
 }
 
-// FriendClient is a client for the Friend schema.
-type FriendClient struct {
+// DraftClient is a client for the Draft schema.
+type DraftClient struct {
 	config
 }
 
-// NewFriendClient returns a client for the Friend from the given config.
-func NewFriendClient(c config) *FriendClient {
-	return &FriendClient{config: c}
+// NewDraftClient returns a client for the Draft from the given config.
+func NewDraftClient(c config) *DraftClient {
+	return &DraftClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `friend.Hooks(f(g(h())))`.
-func (c *FriendClient) Use(hooks ...Hook) {
-	c.hooks.Friend = append(c.hooks.Friend, hooks...)
+// A call to `Use(f, g, h)` equals to `draft.Hooks(f(g(h())))`.
+func (c *DraftClient) Use(hooks ...Hook) {
+	c.hooks.Draft = append(c.hooks.Draft, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `friend.Intercept(f(g(h())))`.
-func (c *FriendClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Friend = append(c.inters.Friend, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `draft.Intercept(f(g(h())))`.
+func (c *DraftClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Draft = append(c.inters.Draft, interceptors...)
 }
 
-// Create returns a builder for creating a Friend entity.
-func (c *FriendClient) Create() *FriendCreate {
-	mutation := newFriendMutation(c.config, OpCreate)
-	return &FriendCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Draft entity.
+func (c *DraftClient) Create() *DraftCreate {
+	mutation := newDraftMutation(c.config, OpCreate)
+	return &DraftCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Friend entities.
-func (c *FriendClient) CreateBulk(builders ...*FriendCreate) *FriendCreateBulk {
-	return &FriendCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Draft entities.
+func (c *DraftClient) CreateBulk(builders ...*DraftCreate) *DraftCreateBulk {
+	return &DraftCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *FriendClient) MapCreateBulk(slice any, setFunc func(*FriendCreate, int)) *FriendCreateBulk {
+func (c *DraftClient) MapCreateBulk(slice any, setFunc func(*DraftCreate, int)) *DraftCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &FriendCreateBulk{err: fmt.Errorf("calling to FriendClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DraftCreateBulk{err: fmt.Errorf("calling to DraftClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*FriendCreate, rv.Len())
+	builders := make([]*DraftCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &FriendCreateBulk{config: c.config, builders: builders}
+	return &DraftCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Friend.
-func (c *FriendClient) Update() *FriendUpdate {
-	mutation := newFriendMutation(c.config, OpUpdate)
-	return &FriendUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Draft.
+func (c *DraftClient) Update() *DraftUpdate {
+	mutation := newDraftMutation(c.config, OpUpdate)
+	return &DraftUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *FriendClient) UpdateOne(f *Friend) *FriendUpdateOne {
-	mutation := newFriendMutation(c.config, OpUpdateOne, withFriend(f))
-	return &FriendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DraftClient) UpdateOne(d *Draft) *DraftUpdateOne {
+	mutation := newDraftMutation(c.config, OpUpdateOne, withDraft(d))
+	return &DraftUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *FriendClient) UpdateOneID(id string) *FriendUpdateOne {
-	mutation := newFriendMutation(c.config, OpUpdateOne, withFriendID(id))
-	return &FriendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DraftClient) UpdateOneID(id string) *DraftUpdateOne {
+	mutation := newDraftMutation(c.config, OpUpdateOne, withDraftID(id))
+	return &DraftUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Friend.
-func (c *FriendClient) Delete() *FriendDelete {
-	mutation := newFriendMutation(c.config, OpDelete)
-	return &FriendDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Draft.
+func (c *DraftClient) Delete() *DraftDelete {
+	mutation := newDraftMutation(c.config, OpDelete)
+	return &DraftDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *FriendClient) DeleteOne(f *Friend) *FriendDeleteOne {
-	return c.DeleteOneID(f.ID)
+func (c *DraftClient) DeleteOne(d *Draft) *DraftDeleteOne {
+	return c.DeleteOneID(d.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *FriendClient) DeleteOneID(id string) *FriendDeleteOne {
-	builder := c.Delete().Where(friend.ID(id))
+func (c *DraftClient) DeleteOneID(id string) *DraftDeleteOne {
+	builder := c.Delete().Where(draft.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &FriendDeleteOne{builder}
+	return &DraftDeleteOne{builder}
 }
 
-// Query returns a query builder for Friend.
-func (c *FriendClient) Query() *FriendQuery {
-	return &FriendQuery{
+// Query returns a query builder for Draft.
+func (c *DraftClient) Query() *DraftQuery {
+	return &DraftQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeFriend},
+		ctx:    &QueryContext{Type: TypeDraft},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Friend entity by its id.
-func (c *FriendClient) Get(ctx context.Context, id string) (*Friend, error) {
-	return c.Query().Where(friend.ID(id)).Only(ctx)
+// Get returns a Draft entity by its id.
+func (c *DraftClient) Get(ctx context.Context, id string) (*Draft, error) {
+	return c.Query().Where(draft.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *FriendClient) GetX(ctx context.Context, id string) *Friend {
+func (c *DraftClient) GetX(ctx context.Context, id string) *Draft {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1064,164 +1759,164 @@ func (c *FriendClient) GetX(ctx context.Context, id string) *Friend {
 	return obj
 }
 
-// QueryRequester queries the requester edge of a Friend.
-func (c *FriendClient) QueryRequester(f *Friend) *UserQuery {
+// QueryUser queries the user edge of a Draft.
+func (c *DraftClient) QueryUser(d *Draft) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := f.ID
+		id := d.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(friend.Table, friend.FieldID, id),
+			sqlgraph.From(draft.Table, draft.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, friend.RequesterTable, friend.RequesterColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, draft.UserTable, draft.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryAddressee queries the addressee edge of a Friend.
-func (c *FriendClient) QueryAddressee(f *Friend) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryConversation queries the conversation edge of a Draft.
+func (c *DraftClient) QueryConversation(d *Draft) *ConversationQuery {
+	query := (&ConversationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := f.ID
+		id := d.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(friend.Table, friend.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, friend.AddresseeTable, friend.AddresseeColumn),
+			sqlgraph.From(draft.Table, draft.FieldID, id),
+			sqlgraph.To(conversation.Table, conversation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, draft.ConversationTable, draft.ConversationColumn),
 		)
-		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *FriendClient) Hooks() []Hook {
-	return c.hooks.Friend
+func (c *DraftClient) Hooks() []Hook {
+	return c.hooks.Draft
 }
 
 // Interceptors returns the client interceptors.
-func (c *FriendClient) Interceptors() []Interceptor {
-	return c.inters.Friend
+func (c *DraftClient) Interceptors() []Interceptor {
+	return c.inters.Draft
 }
 
-func (c *FriendClient) mutate(ctx context.Context, m *FriendMutation) (Value, error) {
+func (c *DraftClient) mutate(ctx context.Context, m *DraftMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&FriendCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DraftCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&FriendUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DraftUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&FriendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DraftUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&FriendDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DraftDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Friend mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Draft mutation op: %q", m.Op())
 	}
 }
 
-// GuildClient is a client for the Guild schema.
-type GuildClient struct {
+// FolderConversationClient is a client for the FolderConversation schema.
+type FolderConversationClient struct {
 	config
 }
 
-// NewGuildClient returns a client for the Guild from the given config.
-func NewGuildClient(c config) *GuildClient {
-	return &GuildClient{config: c}
+// NewFolderConversationClient returns a client for the FolderConversation from the given config.
+func NewFolderConversationClient(c config) *FolderConversationClient {
+	return &FolderConversationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `guild.Hooks(f(g(h())))`.
-func (c *GuildClient) Use(hooks ...Hook) {
-	c.hooks.Guild = append(c.hooks.Guild, hooks...)
+// A call to `Use(f, g, h)` equals to `folderconversation.Hooks(f(g(h())))`.
+func (c *FolderConversationClient) Use(hooks ...Hook) {
+	c.hooks.FolderConversation = append(c.hooks.FolderConversation, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `guild.Intercept(f(g(h())))`.
-func (c *GuildClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Guild = append(c.inters.Guild, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `folderconversation.Intercept(f(g(h())))`.
+func (c *FolderConversationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FolderConversation = append(c.inters.FolderConversation, interceptors...)
 }
 
-// Create returns a builder for creating a Guild entity.
-func (c *GuildClient) Create() *GuildCreate {
-	mutation := newGuildMutation(c.config, OpCreate)
-	return &GuildCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a FolderConversation entity.
+func (c *FolderConversationClient) Create() *FolderConversationCreate {
+	mutation := newFolderConversationMutation(c.config, OpCreate)
+	return &FolderConversationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Guild entities.
-func (c *GuildClient) CreateBulk(builders ...*GuildCreate) *GuildCreateBulk {
-	return &GuildCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of FolderConversation entities.
+func (c *FolderConversationClient) CreateBulk(builders ...*FolderConversationCreate) *FolderConversationCreateBulk {
+	return &FolderConversationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *GuildClient) MapCreateBulk(slice any, setFunc func(*GuildCreate, int)) *GuildCreateBulk {
+func (c *FolderConversationClient) MapCreateBulk(slice any, setFunc func(*FolderConversationCreate, int)) *FolderConversationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &GuildCreateBulk{err: fmt.Errorf("calling to GuildClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &FolderConversationCreateBulk{err: fmt.Errorf("calling to FolderConversationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*GuildCreate, rv.Len())
+	builders := make([]*FolderConversationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &GuildCreateBulk{config: c.config, builders: builders}
+	return &FolderConversationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Guild.
-func (c *GuildClient) Update() *GuildUpdate {
-	mutation := newGuildMutation(c.config, OpUpdate)
-	return &GuildUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for FolderConversation.
+func (c *FolderConversationClient) Update() *FolderConversationUpdate {
+	mutation := newFolderConversationMutation(c.config, OpUpdate)
+	return &FolderConversationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *GuildClient) UpdateOne(gu *Guild) *GuildUpdateOne {
-	mutation := newGuildMutation(c.config, OpUpdateOne, withGuild(gu))
-	return &GuildUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FolderConversationClient) UpdateOne(fc *FolderConversation) *FolderConversationUpdateOne {
+	mutation := newFolderConversationMutation(c.config, OpUpdateOne, withFolderConversation(fc))
+	return &FolderConversationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *GuildClient) UpdateOneID(id string) *GuildUpdateOne {
-	mutation := newGuildMutation(c.config, OpUpdateOne, withGuildID(id))
-	return &GuildUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FolderConversationClient) UpdateOneID(id string) *FolderConversationUpdateOne {
+	mutation := newFolderConversationMutation(c.config, OpUpdateOne, withFolderConversationID(id))
+	return &FolderConversationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Guild.
-func (c *GuildClient) Delete() *GuildDelete {
-	mutation := newGuildMutation(c.config, OpDelete)
-	return &GuildDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for FolderConversation.
+func (c *FolderConversationClient) Delete() *FolderConversationDelete {
+	mutation := newFolderConversationMutation(c.config, OpDelete)
+	return &FolderConversationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *GuildClient) DeleteOne(gu *Guild) *GuildDeleteOne {
-	return c.DeleteOneID(gu.ID)
+func (c *FolderConversationClient) DeleteOne(fc *FolderConversation) *FolderConversationDeleteOne {
+	return c.DeleteOneID(fc.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *GuildClient) DeleteOneID(id string) *GuildDeleteOne {
-	builder := c.Delete().Where(guild.ID(id))
+func (c *FolderConversationClient) DeleteOneID(id string) *FolderConversationDeleteOne {
+	builder := c.Delete().Where(folderconversation.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &GuildDeleteOne{builder}
+	return &FolderConversationDeleteOne{builder}
 }
 
-// Query returns a query builder for Guild.
-func (c *GuildClient) Query() *GuildQuery {
-	return &GuildQuery{
+// Query returns a query builder for FolderConversation.
+func (c *FolderConversationClient) Query() *FolderConversationQuery {
+	return &FolderConversationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeGuild},
+		ctx:    &QueryContext{Type: TypeFolderConversation},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Guild entity by its id.
-func (c *GuildClient) Get(ctx context.Context, id string) (*Guild, error) {
-	return c.Query().Where(guild.ID(id)).Only(ctx)
+// Get returns a FolderConversation entity by its id.
+func (c *FolderConversationClient) Get(ctx context.Context, id string) (*FolderConversation, error) {
+	return c.Query().Where(folderconversation.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *GuildClient) GetX(ctx context.Context, id string) *Guild {
+func (c *FolderConversationClient) GetX(ctx context.Context, id string) *FolderConversation {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1229,180 +1924,2581 @@ func (c *GuildClient) GetX(ctx context.Context, id string) *Guild {
 	return obj
 }
 
-// QueryOwner queries the owner edge of a Guild.
-func (c *GuildClient) QueryOwner(gu *Guild) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryFolder queries the folder edge of a FolderConversation.
+func (c *FolderConversationClient) QueryFolder(fc *FolderConversation) *ConversationFolderQuery {
+	query := (&ConversationFolderClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := gu.ID
+		id := fc.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(guild.Table, guild.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, guild.OwnerTable, guild.OwnerColumn),
+			sqlgraph.From(folderconversation.Table, folderconversation.FieldID, id),
+			sqlgraph.To(conversationfolder.Table, conversationfolder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, folderconversation.FolderTable, folderconversation.FolderColumn),
 		)
-		fromV = sqlgraph.Neighbors(gu.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(fc.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryInvitations queries the invitations edge of a Guild.
-func (c *GuildClient) QueryInvitations(gu *Guild) *InvitationQuery {
-	query := (&InvitationClient{config: c.config}).Query()
+// QueryConversation queries the conversation edge of a FolderConversation.
+func (c *FolderConversationClient) QueryConversation(fc *FolderConversation) *ConversationQuery {
+	query := (&ConversationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := gu.ID
+		id := fc.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(guild.Table, guild.FieldID, id),
-			sqlgraph.To(invitation.Table, invitation.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, guild.InvitationsTable, guild.InvitationsColumn),
+			sqlgraph.From(folderconversation.Table, folderconversation.FieldID, id),
+			sqlgraph.To(conversation.Table, conversation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, folderconversation.ConversationTable, folderconversation.ConversationColumn),
 		)
-		fromV = sqlgraph.Neighbors(gu.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(fc.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryGuildMembers queries the guild_members edge of a Guild.
-func (c *GuildClient) QueryGuildMembers(gu *Guild) *MemberQuery {
-	query := (&MemberClient{config: c.config}).Query()
+// Hooks returns the client hooks.
+func (c *FolderConversationClient) Hooks() []Hook {
+	return c.hooks.FolderConversation
+}
+
+// Interceptors returns the client interceptors.
+func (c *FolderConversationClient) Interceptors() []Interceptor {
+	return c.inters.FolderConversation
+}
+
+func (c *FolderConversationClient) mutate(ctx context.Context, m *FolderConversationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FolderConversationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FolderConversationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FolderConversationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FolderConversationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FolderConversation mutation op: %q", m.Op())
+	}
+}
+
+// FriendClient is a client for the Friend schema.
+type FriendClient struct {
+	config
+}
+
+// NewFriendClient returns a client for the Friend from the given config.
+func NewFriendClient(c config) *FriendClient {
+	return &FriendClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `friend.Hooks(f(g(h())))`.
+func (c *FriendClient) Use(hooks ...Hook) {
+	c.hooks.Friend = append(c.hooks.Friend, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `friend.Intercept(f(g(h())))`.
+func (c *FriendClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Friend = append(c.inters.Friend, interceptors...)
+}
+
+// Create returns a builder for creating a Friend entity.
+func (c *FriendClient) Create() *FriendCreate {
+	mutation := newFriendMutation(c.config, OpCreate)
+	return &FriendCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Friend entities.
+func (c *FriendClient) CreateBulk(builders ...*FriendCreate) *FriendCreateBulk {
+	return &FriendCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FriendClient) MapCreateBulk(slice any, setFunc func(*FriendCreate, int)) *FriendCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FriendCreateBulk{err: fmt.Errorf("calling to FriendClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FriendCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FriendCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Friend.
+func (c *FriendClient) Update() *FriendUpdate {
+	mutation := newFriendMutation(c.config, OpUpdate)
+	return &FriendUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FriendClient) UpdateOne(f *Friend) *FriendUpdateOne {
+	mutation := newFriendMutation(c.config, OpUpdateOne, withFriend(f))
+	return &FriendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FriendClient) UpdateOneID(id string) *FriendUpdateOne {
+	mutation := newFriendMutation(c.config, OpUpdateOne, withFriendID(id))
+	return &FriendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Friend.
+func (c *FriendClient) Delete() *FriendDelete {
+	mutation := newFriendMutation(c.config, OpDelete)
+	return &FriendDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FriendClient) DeleteOne(f *Friend) *FriendDeleteOne {
+	return c.DeleteOneID(f.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FriendClient) DeleteOneID(id string) *FriendDeleteOne {
+	builder := c.Delete().Where(friend.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FriendDeleteOne{builder}
+}
+
+// Query returns a query builder for Friend.
+func (c *FriendClient) Query() *FriendQuery {
+	return &FriendQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFriend},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Friend entity by its id.
+func (c *FriendClient) Get(ctx context.Context, id string) (*Friend, error) {
+	return c.Query().Where(friend.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FriendClient) GetX(ctx context.Context, id string) *Friend {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRequester queries the requester edge of a Friend.
+func (c *FriendClient) QueryRequester(f *Friend) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(friend.Table, friend.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, friend.RequesterTable, friend.RequesterColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAddressee queries the addressee edge of a Friend.
+func (c *FriendClient) QueryAddressee(f *Friend) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(friend.Table, friend.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, friend.AddresseeTable, friend.AddresseeColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FriendClient) Hooks() []Hook {
+	return c.hooks.Friend
+}
+
+// Interceptors returns the client interceptors.
+func (c *FriendClient) Interceptors() []Interceptor {
+	return c.inters.Friend
+}
+
+func (c *FriendClient) mutate(ctx context.Context, m *FriendMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FriendCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FriendUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FriendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FriendDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Friend mutation op: %q", m.Op())
+	}
+}
+
+// FriendInviteClient is a client for the FriendInvite schema.
+type FriendInviteClient struct {
+	config
+}
+
+// NewFriendInviteClient returns a client for the FriendInvite from the given config.
+func NewFriendInviteClient(c config) *FriendInviteClient {
+	return &FriendInviteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `friendinvite.Hooks(f(g(h())))`.
+func (c *FriendInviteClient) Use(hooks ...Hook) {
+	c.hooks.FriendInvite = append(c.hooks.FriendInvite, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `friendinvite.Intercept(f(g(h())))`.
+func (c *FriendInviteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FriendInvite = append(c.inters.FriendInvite, interceptors...)
+}
+
+// Create returns a builder for creating a FriendInvite entity.
+func (c *FriendInviteClient) Create() *FriendInviteCreate {
+	mutation := newFriendInviteMutation(c.config, OpCreate)
+	return &FriendInviteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FriendInvite entities.
+func (c *FriendInviteClient) CreateBulk(builders ...*FriendInviteCreate) *FriendInviteCreateBulk {
+	return &FriendInviteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FriendInviteClient) MapCreateBulk(slice any, setFunc func(*FriendInviteCreate, int)) *FriendInviteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FriendInviteCreateBulk{err: fmt.Errorf("calling to FriendInviteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FriendInviteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FriendInviteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FriendInvite.
+func (c *FriendInviteClient) Update() *FriendInviteUpdate {
+	mutation := newFriendInviteMutation(c.config, OpUpdate)
+	return &FriendInviteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FriendInviteClient) UpdateOne(fi *FriendInvite) *FriendInviteUpdateOne {
+	mutation := newFriendInviteMutation(c.config, OpUpdateOne, withFriendInvite(fi))
+	return &FriendInviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FriendInviteClient) UpdateOneID(id string) *FriendInviteUpdateOne {
+	mutation := newFriendInviteMutation(c.config, OpUpdateOne, withFriendInviteID(id))
+	return &FriendInviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FriendInvite.
+func (c *FriendInviteClient) Delete() *FriendInviteDelete {
+	mutation := newFriendInviteMutation(c.config, OpDelete)
+	return &FriendInviteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FriendInviteClient) DeleteOne(fi *FriendInvite) *FriendInviteDeleteOne {
+	return c.DeleteOneID(fi.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FriendInviteClient) DeleteOneID(id string) *FriendInviteDeleteOne {
+	builder := c.Delete().Where(friendinvite.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FriendInviteDeleteOne{builder}
+}
+
+// Query returns a query builder for FriendInvite.
+func (c *FriendInviteClient) Query() *FriendInviteQuery {
+	return &FriendInviteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFriendInvite},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FriendInvite entity by its id.
+func (c *FriendInviteClient) Get(ctx context.Context, id string) (*FriendInvite, error) {
+	return c.Query().Where(friendinvite.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FriendInviteClient) GetX(ctx context.Context, id string) *FriendInvite {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a FriendInvite.
+func (c *FriendInviteClient) QueryOwner(fi *FriendInvite) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fi.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(friendinvite.Table, friendinvite.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, friendinvite.OwnerTable, friendinvite.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(fi.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRedemptions queries the redemptions edge of a FriendInvite.
+func (c *FriendInviteClient) QueryRedemptions(fi *FriendInvite) *FriendInviteUseQuery {
+	query := (&FriendInviteUseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fi.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(friendinvite.Table, friendinvite.FieldID, id),
+			sqlgraph.To(friendinviteuse.Table, friendinviteuse.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, friendinvite.RedemptionsTable, friendinvite.RedemptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(fi.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FriendInviteClient) Hooks() []Hook {
+	return c.hooks.FriendInvite
+}
+
+// Interceptors returns the client interceptors.
+func (c *FriendInviteClient) Interceptors() []Interceptor {
+	return c.inters.FriendInvite
+}
+
+func (c *FriendInviteClient) mutate(ctx context.Context, m *FriendInviteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FriendInviteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FriendInviteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FriendInviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FriendInviteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FriendInvite mutation op: %q", m.Op())
+	}
+}
+
+// FriendInviteUseClient is a client for the FriendInviteUse schema.
+type FriendInviteUseClient struct {
+	config
+}
+
+// NewFriendInviteUseClient returns a client for the FriendInviteUse from the given config.
+func NewFriendInviteUseClient(c config) *FriendInviteUseClient {
+	return &FriendInviteUseClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `friendinviteuse.Hooks(f(g(h())))`.
+func (c *FriendInviteUseClient) Use(hooks ...Hook) {
+	c.hooks.FriendInviteUse = append(c.hooks.FriendInviteUse, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `friendinviteuse.Intercept(f(g(h())))`.
+func (c *FriendInviteUseClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FriendInviteUse = append(c.inters.FriendInviteUse, interceptors...)
+}
+
+// Create returns a builder for creating a FriendInviteUse entity.
+func (c *FriendInviteUseClient) Create() *FriendInviteUseCreate {
+	mutation := newFriendInviteUseMutation(c.config, OpCreate)
+	return &FriendInviteUseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FriendInviteUse entities.
+func (c *FriendInviteUseClient) CreateBulk(builders ...*FriendInviteUseCreate) *FriendInviteUseCreateBulk {
+	return &FriendInviteUseCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FriendInviteUseClient) MapCreateBulk(slice any, setFunc func(*FriendInviteUseCreate, int)) *FriendInviteUseCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FriendInviteUseCreateBulk{err: fmt.Errorf("calling to FriendInviteUseClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FriendInviteUseCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FriendInviteUseCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FriendInviteUse.
+func (c *FriendInviteUseClient) Update() *FriendInviteUseUpdate {
+	mutation := newFriendInviteUseMutation(c.config, OpUpdate)
+	return &FriendInviteUseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FriendInviteUseClient) UpdateOne(fiu *FriendInviteUse) *FriendInviteUseUpdateOne {
+	mutation := newFriendInviteUseMutation(c.config, OpUpdateOne, withFriendInviteUse(fiu))
+	return &FriendInviteUseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FriendInviteUseClient) UpdateOneID(id string) *FriendInviteUseUpdateOne {
+	mutation := newFriendInviteUseMutation(c.config, OpUpdateOne, withFriendInviteUseID(id))
+	return &FriendInviteUseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FriendInviteUse.
+func (c *FriendInviteUseClient) Delete() *FriendInviteUseDelete {
+	mutation := newFriendInviteUseMutation(c.config, OpDelete)
+	return &FriendInviteUseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FriendInviteUseClient) DeleteOne(fiu *FriendInviteUse) *FriendInviteUseDeleteOne {
+	return c.DeleteOneID(fiu.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FriendInviteUseClient) DeleteOneID(id string) *FriendInviteUseDeleteOne {
+	builder := c.Delete().Where(friendinviteuse.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FriendInviteUseDeleteOne{builder}
+}
+
+// Query returns a query builder for FriendInviteUse.
+func (c *FriendInviteUseClient) Query() *FriendInviteUseQuery {
+	return &FriendInviteUseQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFriendInviteUse},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FriendInviteUse entity by its id.
+func (c *FriendInviteUseClient) Get(ctx context.Context, id string) (*FriendInviteUse, error) {
+	return c.Query().Where(friendinviteuse.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FriendInviteUseClient) GetX(ctx context.Context, id string) *FriendInviteUse {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryInvite queries the invite edge of a FriendInviteUse.
+func (c *FriendInviteUseClient) QueryInvite(fiu *FriendInviteUse) *FriendInviteQuery {
+	query := (&FriendInviteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fiu.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(friendinviteuse.Table, friendinviteuse.FieldID, id),
+			sqlgraph.To(friendinvite.Table, friendinvite.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, friendinviteuse.InviteTable, friendinviteuse.InviteColumn),
+		)
+		fromV = sqlgraph.Neighbors(fiu.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a FriendInviteUse.
+func (c *FriendInviteUseClient) QueryUser(fiu *FriendInviteUse) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fiu.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(friendinviteuse.Table, friendinviteuse.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, friendinviteuse.UserTable, friendinviteuse.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(fiu.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FriendInviteUseClient) Hooks() []Hook {
+	return c.hooks.FriendInviteUse
+}
+
+// Interceptors returns the client interceptors.
+func (c *FriendInviteUseClient) Interceptors() []Interceptor {
+	return c.inters.FriendInviteUse
+}
+
+func (c *FriendInviteUseClient) mutate(ctx context.Context, m *FriendInviteUseMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FriendInviteUseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FriendInviteUseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FriendInviteUseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FriendInviteUseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FriendInviteUse mutation op: %q", m.Op())
+	}
+}
+
+// GuildClient is a client for the Guild schema.
+type GuildClient struct {
+	config
+}
+
+// NewGuildClient returns a client for the Guild from the given config.
+func NewGuildClient(c config) *GuildClient {
+	return &GuildClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `guild.Hooks(f(g(h())))`.
+func (c *GuildClient) Use(hooks ...Hook) {
+	c.hooks.Guild = append(c.hooks.Guild, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `guild.Intercept(f(g(h())))`.
+func (c *GuildClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Guild = append(c.inters.Guild, interceptors...)
+}
+
+// Create returns a builder for creating a Guild entity.
+func (c *GuildClient) Create() *GuildCreate {
+	mutation := newGuildMutation(c.config, OpCreate)
+	return &GuildCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Guild entities.
+func (c *GuildClient) CreateBulk(builders ...*GuildCreate) *GuildCreateBulk {
+	return &GuildCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GuildClient) MapCreateBulk(slice any, setFunc func(*GuildCreate, int)) *GuildCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GuildCreateBulk{err: fmt.Errorf("calling to GuildClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GuildCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GuildCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Guild.
+func (c *GuildClient) Update() *GuildUpdate {
+	mutation := newGuildMutation(c.config, OpUpdate)
+	return &GuildUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GuildClient) UpdateOne(gu *Guild) *GuildUpdateOne {
+	mutation := newGuildMutation(c.config, OpUpdateOne, withGuild(gu))
+	return &GuildUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GuildClient) UpdateOneID(id string) *GuildUpdateOne {
+	mutation := newGuildMutation(c.config, OpUpdateOne, withGuildID(id))
+	return &GuildUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Guild.
+func (c *GuildClient) Delete() *GuildDelete {
+	mutation := newGuildMutation(c.config, OpDelete)
+	return &GuildDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GuildClient) DeleteOne(gu *Guild) *GuildDeleteOne {
+	return c.DeleteOneID(gu.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GuildClient) DeleteOneID(id string) *GuildDeleteOne {
+	builder := c.Delete().Where(guild.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GuildDeleteOne{builder}
+}
+
+// Query returns a query builder for Guild.
+func (c *GuildClient) Query() *GuildQuery {
+	return &GuildQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGuild},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Guild entity by its id.
+func (c *GuildClient) Get(ctx context.Context, id string) (*Guild, error) {
+	return c.Query().Where(guild.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GuildClient) GetX(ctx context.Context, id string) *Guild {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a Guild.
+func (c *GuildClient) QueryOwner(gu *Guild) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gu.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(guild.Table, guild.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, guild.OwnerTable, guild.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(gu.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvitations queries the invitations edge of a Guild.
+func (c *GuildClient) QueryInvitations(gu *Guild) *InvitationQuery {
+	query := (&InvitationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gu.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(guild.Table, guild.FieldID, id),
+			sqlgraph.To(invitation.Table, invitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, guild.InvitationsTable, guild.InvitationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(gu.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGuildMembers queries the guild_members edge of a Guild.
+func (c *GuildClient) QueryGuildMembers(gu *Guild) *MemberQuery {
+	query := (&MemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gu.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(guild.Table, guild.FieldID, id),
+			sqlgraph.To(member.Table, member.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, guild.GuildMembersTable, guild.GuildMembersColumn),
+		)
+		fromV = sqlgraph.Neighbors(gu.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GuildClient) Hooks() []Hook {
+	return c.hooks.Guild
+}
+
+// Interceptors returns the client interceptors.
+func (c *GuildClient) Interceptors() []Interceptor {
+	return c.inters.Guild
+}
+
+func (c *GuildClient) mutate(ctx context.Context, m *GuildMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GuildCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GuildUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GuildUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GuildDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Guild mutation op: %q", m.Op())
+	}
+}
+
+// HiddenMessageClient is a client for the HiddenMessage schema.
+type HiddenMessageClient struct {
+	config
+}
+
+// NewHiddenMessageClient returns a client for the HiddenMessage from the given config.
+func NewHiddenMessageClient(c config) *HiddenMessageClient {
+	return &HiddenMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `hiddenmessage.Hooks(f(g(h())))`.
+func (c *HiddenMessageClient) Use(hooks ...Hook) {
+	c.hooks.HiddenMessage = append(c.hooks.HiddenMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `hiddenmessage.Intercept(f(g(h())))`.
+func (c *HiddenMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.HiddenMessage = append(c.inters.HiddenMessage, interceptors...)
+}
+
+// Create returns a builder for creating a HiddenMessage entity.
+func (c *HiddenMessageClient) Create() *HiddenMessageCreate {
+	mutation := newHiddenMessageMutation(c.config, OpCreate)
+	return &HiddenMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of HiddenMessage entities.
+func (c *HiddenMessageClient) CreateBulk(builders ...*HiddenMessageCreate) *HiddenMessageCreateBulk {
+	return &HiddenMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *HiddenMessageClient) MapCreateBulk(slice any, setFunc func(*HiddenMessageCreate, int)) *HiddenMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &HiddenMessageCreateBulk{err: fmt.Errorf("calling to HiddenMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*HiddenMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &HiddenMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for HiddenMessage.
+func (c *HiddenMessageClient) Update() *HiddenMessageUpdate {
+	mutation := newHiddenMessageMutation(c.config, OpUpdate)
+	return &HiddenMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HiddenMessageClient) UpdateOne(hm *HiddenMessage) *HiddenMessageUpdateOne {
+	mutation := newHiddenMessageMutation(c.config, OpUpdateOne, withHiddenMessage(hm))
+	return &HiddenMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HiddenMessageClient) UpdateOneID(id string) *HiddenMessageUpdateOne {
+	mutation := newHiddenMessageMutation(c.config, OpUpdateOne, withHiddenMessageID(id))
+	return &HiddenMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for HiddenMessage.
+func (c *HiddenMessageClient) Delete() *HiddenMessageDelete {
+	mutation := newHiddenMessageMutation(c.config, OpDelete)
+	return &HiddenMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HiddenMessageClient) DeleteOne(hm *HiddenMessage) *HiddenMessageDeleteOne {
+	return c.DeleteOneID(hm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HiddenMessageClient) DeleteOneID(id string) *HiddenMessageDeleteOne {
+	builder := c.Delete().Where(hiddenmessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HiddenMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for HiddenMessage.
+func (c *HiddenMessageClient) Query() *HiddenMessageQuery {
+	return &HiddenMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHiddenMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a HiddenMessage entity by its id.
+func (c *HiddenMessageClient) Get(ctx context.Context, id string) (*HiddenMessage, error) {
+	return c.Query().Where(hiddenmessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HiddenMessageClient) GetX(ctx context.Context, id string) *HiddenMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a HiddenMessage.
+func (c *HiddenMessageClient) QueryUser(hm *HiddenMessage) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := hm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hiddenmessage.Table, hiddenmessage.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, hiddenmessage.UserTable, hiddenmessage.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(hm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMessage queries the message edge of a HiddenMessage.
+func (c *HiddenMessageClient) QueryMessage(hm *HiddenMessage) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := hm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hiddenmessage.Table, hiddenmessage.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, hiddenmessage.MessageTable, hiddenmessage.MessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(hm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *HiddenMessageClient) Hooks() []Hook {
+	return c.hooks.HiddenMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *HiddenMessageClient) Interceptors() []Interceptor {
+	return c.inters.HiddenMessage
+}
+
+func (c *HiddenMessageClient) mutate(ctx context.Context, m *HiddenMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HiddenMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HiddenMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HiddenMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HiddenMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown HiddenMessage mutation op: %q", m.Op())
+	}
+}
+
+// InvitationClient is a client for the Invitation schema.
+type InvitationClient struct {
+	config
+}
+
+// NewInvitationClient returns a client for the Invitation from the given config.
+func NewInvitationClient(c config) *InvitationClient {
+	return &InvitationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invitation.Hooks(f(g(h())))`.
+func (c *InvitationClient) Use(hooks ...Hook) {
+	c.hooks.Invitation = append(c.hooks.Invitation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `invitation.Intercept(f(g(h())))`.
+func (c *InvitationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Invitation = append(c.inters.Invitation, interceptors...)
+}
+
+// Create returns a builder for creating a Invitation entity.
+func (c *InvitationClient) Create() *InvitationCreate {
+	mutation := newInvitationMutation(c.config, OpCreate)
+	return &InvitationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Invitation entities.
+func (c *InvitationClient) CreateBulk(builders ...*InvitationCreate) *InvitationCreateBulk {
+	return &InvitationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InvitationClient) MapCreateBulk(slice any, setFunc func(*InvitationCreate, int)) *InvitationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InvitationCreateBulk{err: fmt.Errorf("calling to InvitationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InvitationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InvitationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Invitation.
+func (c *InvitationClient) Update() *InvitationUpdate {
+	mutation := newInvitationMutation(c.config, OpUpdate)
+	return &InvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvitationClient) UpdateOne(i *Invitation) *InvitationUpdateOne {
+	mutation := newInvitationMutation(c.config, OpUpdateOne, withInvitation(i))
+	return &InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InvitationClient) UpdateOneID(id string) *InvitationUpdateOne {
+	mutation := newInvitationMutation(c.config, OpUpdateOne, withInvitationID(id))
+	return &InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Invitation.
+func (c *InvitationClient) Delete() *InvitationDelete {
+	mutation := newInvitationMutation(c.config, OpDelete)
+	return &InvitationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InvitationClient) DeleteOne(i *Invitation) *InvitationDeleteOne {
+	return c.DeleteOneID(i.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InvitationClient) DeleteOneID(id string) *InvitationDeleteOne {
+	builder := c.Delete().Where(invitation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InvitationDeleteOne{builder}
+}
+
+// Query returns a query builder for Invitation.
+func (c *InvitationClient) Query() *InvitationQuery {
+	return &InvitationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInvitation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Invitation entity by its id.
+func (c *InvitationClient) Get(ctx context.Context, id string) (*Invitation, error) {
+	return c.Query().Where(invitation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvitationClient) GetX(ctx context.Context, id string) *Invitation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGuild queries the guild edge of a Invitation.
+func (c *InvitationClient) QueryGuild(i *Invitation) *GuildQuery {
+	query := (&GuildClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invitation.Table, invitation.FieldID, id),
+			sqlgraph.To(guild.Table, guild.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, invitation.GuildTable, invitation.GuildColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvitedBy queries the invited_by edge of a Invitation.
+func (c *InvitationClient) QueryInvitedBy(i *Invitation) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invitation.Table, invitation.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, invitation.InvitedByTable, invitation.InvitedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *InvitationClient) Hooks() []Hook {
+	return c.hooks.Invitation
+}
+
+// Interceptors returns the client interceptors.
+func (c *InvitationClient) Interceptors() []Interceptor {
+	return c.inters.Invitation
+}
+
+func (c *InvitationClient) mutate(ctx context.Context, m *InvitationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InvitationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InvitationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Invitation mutation op: %q", m.Op())
+	}
+}
+
+// LinkPreviewClient is a client for the LinkPreview schema.
+type LinkPreviewClient struct {
+	config
+}
+
+// NewLinkPreviewClient returns a client for the LinkPreview from the given config.
+func NewLinkPreviewClient(c config) *LinkPreviewClient {
+	return &LinkPreviewClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `linkpreview.Hooks(f(g(h())))`.
+func (c *LinkPreviewClient) Use(hooks ...Hook) {
+	c.hooks.LinkPreview = append(c.hooks.LinkPreview, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `linkpreview.Intercept(f(g(h())))`.
+func (c *LinkPreviewClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LinkPreview = append(c.inters.LinkPreview, interceptors...)
+}
+
+// Create returns a builder for creating a LinkPreview entity.
+func (c *LinkPreviewClient) Create() *LinkPreviewCreate {
+	mutation := newLinkPreviewMutation(c.config, OpCreate)
+	return &LinkPreviewCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LinkPreview entities.
+func (c *LinkPreviewClient) CreateBulk(builders ...*LinkPreviewCreate) *LinkPreviewCreateBulk {
+	return &LinkPreviewCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LinkPreviewClient) MapCreateBulk(slice any, setFunc func(*LinkPreviewCreate, int)) *LinkPreviewCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LinkPreviewCreateBulk{err: fmt.Errorf("calling to LinkPreviewClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LinkPreviewCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LinkPreviewCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LinkPreview.
+func (c *LinkPreviewClient) Update() *LinkPreviewUpdate {
+	mutation := newLinkPreviewMutation(c.config, OpUpdate)
+	return &LinkPreviewUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LinkPreviewClient) UpdateOne(lp *LinkPreview) *LinkPreviewUpdateOne {
+	mutation := newLinkPreviewMutation(c.config, OpUpdateOne, withLinkPreview(lp))
+	return &LinkPreviewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LinkPreviewClient) UpdateOneID(id string) *LinkPreviewUpdateOne {
+	mutation := newLinkPreviewMutation(c.config, OpUpdateOne, withLinkPreviewID(id))
+	return &LinkPreviewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LinkPreview.
+func (c *LinkPreviewClient) Delete() *LinkPreviewDelete {
+	mutation := newLinkPreviewMutation(c.config, OpDelete)
+	return &LinkPreviewDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LinkPreviewClient) DeleteOne(lp *LinkPreview) *LinkPreviewDeleteOne {
+	return c.DeleteOneID(lp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LinkPreviewClient) DeleteOneID(id string) *LinkPreviewDeleteOne {
+	builder := c.Delete().Where(linkpreview.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LinkPreviewDeleteOne{builder}
+}
+
+// Query returns a query builder for LinkPreview.
+func (c *LinkPreviewClient) Query() *LinkPreviewQuery {
+	return &LinkPreviewQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLinkPreview},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LinkPreview entity by its id.
+func (c *LinkPreviewClient) Get(ctx context.Context, id string) (*LinkPreview, error) {
+	return c.Query().Where(linkpreview.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LinkPreviewClient) GetX(ctx context.Context, id string) *LinkPreview {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMessage queries the message edge of a LinkPreview.
+func (c *LinkPreviewClient) QueryMessage(lp *LinkPreview) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(linkpreview.Table, linkpreview.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, linkpreview.MessageTable, linkpreview.MessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(lp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LinkPreviewClient) Hooks() []Hook {
+	return c.hooks.LinkPreview
+}
+
+// Interceptors returns the client interceptors.
+func (c *LinkPreviewClient) Interceptors() []Interceptor {
+	return c.inters.LinkPreview
+}
+
+func (c *LinkPreviewClient) mutate(ctx context.Context, m *LinkPreviewMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LinkPreviewCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LinkPreviewUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LinkPreviewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LinkPreviewDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LinkPreview mutation op: %q", m.Op())
+	}
+}
+
+// MemberClient is a client for the Member schema.
+type MemberClient struct {
+	config
+}
+
+// NewMemberClient returns a client for the Member from the given config.
+func NewMemberClient(c config) *MemberClient {
+	return &MemberClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `member.Hooks(f(g(h())))`.
+func (c *MemberClient) Use(hooks ...Hook) {
+	c.hooks.Member = append(c.hooks.Member, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `member.Intercept(f(g(h())))`.
+func (c *MemberClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Member = append(c.inters.Member, interceptors...)
+}
+
+// Create returns a builder for creating a Member entity.
+func (c *MemberClient) Create() *MemberCreate {
+	mutation := newMemberMutation(c.config, OpCreate)
+	return &MemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Member entities.
+func (c *MemberClient) CreateBulk(builders ...*MemberCreate) *MemberCreateBulk {
+	return &MemberCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MemberClient) MapCreateBulk(slice any, setFunc func(*MemberCreate, int)) *MemberCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MemberCreateBulk{err: fmt.Errorf("calling to MemberClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MemberCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MemberCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Member.
+func (c *MemberClient) Update() *MemberUpdate {
+	mutation := newMemberMutation(c.config, OpUpdate)
+	return &MemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MemberClient) UpdateOne(m *Member) *MemberUpdateOne {
+	mutation := newMemberMutation(c.config, OpUpdateOne, withMember(m))
+	return &MemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MemberClient) UpdateOneID(id string) *MemberUpdateOne {
+	mutation := newMemberMutation(c.config, OpUpdateOne, withMemberID(id))
+	return &MemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Member.
+func (c *MemberClient) Delete() *MemberDelete {
+	mutation := newMemberMutation(c.config, OpDelete)
+	return &MemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MemberClient) DeleteOne(m *Member) *MemberDeleteOne {
+	return c.DeleteOneID(m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MemberClient) DeleteOneID(id string) *MemberDeleteOne {
+	builder := c.Delete().Where(member.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MemberDeleteOne{builder}
+}
+
+// Query returns a query builder for Member.
+func (c *MemberClient) Query() *MemberQuery {
+	return &MemberQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMember},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Member entity by its id.
+func (c *MemberClient) Get(ctx context.Context, id string) (*Member, error) {
+	return c.Query().Where(member.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MemberClient) GetX(ctx context.Context, id string) *Member {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGuild queries the guild edge of a Member.
+func (c *MemberClient) QueryGuild(m *Member) *GuildQuery {
+	query := (&GuildClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(member.Table, member.FieldID, id),
+			sqlgraph.To(guild.Table, guild.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, member.GuildTable, member.GuildColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a Member.
+func (c *MemberClient) QueryUser(m *Member) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(member.Table, member.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, member.UserTable, member.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MemberClient) Hooks() []Hook {
+	return c.hooks.Member
+}
+
+// Interceptors returns the client interceptors.
+func (c *MemberClient) Interceptors() []Interceptor {
+	return c.inters.Member
+}
+
+func (c *MemberClient) mutate(ctx context.Context, m *MemberMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MemberCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MemberUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MemberDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Member mutation op: %q", m.Op())
+	}
+}
+
+// MessageClient is a client for the Message schema.
+type MessageClient struct {
+	config
+}
+
+// NewMessageClient returns a client for the Message from the given config.
+func NewMessageClient(c config) *MessageClient {
+	return &MessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `message.Hooks(f(g(h())))`.
+func (c *MessageClient) Use(hooks ...Hook) {
+	c.hooks.Message = append(c.hooks.Message, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `message.Intercept(f(g(h())))`.
+func (c *MessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Message = append(c.inters.Message, interceptors...)
+}
+
+// Create returns a builder for creating a Message entity.
+func (c *MessageClient) Create() *MessageCreate {
+	mutation := newMessageMutation(c.config, OpCreate)
+	return &MessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Message entities.
+func (c *MessageClient) CreateBulk(builders ...*MessageCreate) *MessageCreateBulk {
+	return &MessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MessageClient) MapCreateBulk(slice any, setFunc func(*MessageCreate, int)) *MessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MessageCreateBulk{err: fmt.Errorf("calling to MessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Message.
+func (c *MessageClient) Update() *MessageUpdate {
+	mutation := newMessageMutation(c.config, OpUpdate)
+	return &MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MessageClient) UpdateOne(m *Message) *MessageUpdateOne {
+	mutation := newMessageMutation(c.config, OpUpdateOne, withMessage(m))
+	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MessageClient) UpdateOneID(id string) *MessageUpdateOne {
+	mutation := newMessageMutation(c.config, OpUpdateOne, withMessageID(id))
+	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Message.
+func (c *MessageClient) Delete() *MessageDelete {
+	mutation := newMessageMutation(c.config, OpDelete)
+	return &MessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MessageClient) DeleteOne(m *Message) *MessageDeleteOne {
+	return c.DeleteOneID(m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MessageClient) DeleteOneID(id string) *MessageDeleteOne {
+	builder := c.Delete().Where(message.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MessageDeleteOne{builder}
+}
+
+// Query returns a query builder for Message.
+func (c *MessageClient) Query() *MessageQuery {
+	return &MessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Message entity by its id.
+func (c *MessageClient) Get(ctx context.Context, id string) (*Message, error) {
+	return c.Query().Where(message.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MessageClient) GetX(ctx context.Context, id string) *Message {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryConversation queries the conversation edge of a Message.
+func (c *MessageClient) QueryConversation(m *Message) *ConversationQuery {
+	query := (&ConversationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(conversation.Table, conversation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, message.ConversationTable, message.ConversationColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySender queries the sender edge of a Message.
+func (c *MessageClient) QuerySender(m *Message) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, message.SenderTable, message.SenderColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCall queries the call edge of a Message.
+func (c *MessageClient) QueryCall(m *Message) *CallQuery {
+	query := (&CallClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(call.Table, call.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, message.CallTable, message.CallColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryForwardedFromUser queries the forwarded_from_user edge of a Message.
+func (c *MessageClient) QueryForwardedFromUser(m *Message) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, message.ForwardedFromUserTable, message.ForwardedFromUserColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRevisions queries the revisions edge of a Message.
+func (c *MessageClient) QueryRevisions(m *Message) *MessageRevisionQuery {
+	query := (&MessageRevisionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(messagerevision.Table, messagerevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.RevisionsTable, message.RevisionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReactions queries the reactions edge of a Message.
+func (c *MessageClient) QueryReactions(m *Message) *MessageReactionQuery {
+	query := (&MessageReactionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(messagereaction.Table, messagereaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.ReactionsTable, message.ReactionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttachments queries the attachments edge of a Message.
+func (c *MessageClient) QueryAttachments(m *Message) *AttachmentQuery {
+	query := (&AttachmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(attachment.Table, attachment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.AttachmentsTable, message.AttachmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLinkPreviews queries the link_previews edge of a Message.
+func (c *MessageClient) QueryLinkPreviews(m *Message) *LinkPreviewQuery {
+	query := (&LinkPreviewClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(linkpreview.Table, linkpreview.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.LinkPreviewsTable, message.LinkPreviewsColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMentions queries the mentions edge of a Message.
+func (c *MessageClient) QueryMentions(m *Message) *MessageMentionQuery {
+	query := (&MessageMentionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(messagemention.Table, messagemention.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.MentionsTable, message.MentionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPins queries the pins edge of a Message.
+func (c *MessageClient) QueryPins(m *Message) *PinnedMessageQuery {
+	query := (&PinnedMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(pinnedmessage.Table, pinnedmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.PinsTable, message.PinsColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReceipts queries the receipts edge of a Message.
+func (c *MessageClient) QueryReceipts(m *Message) *MessageReceiptQuery {
+	query := (&MessageReceiptClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(messagereceipt.Table, messagereceipt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.ReceiptsTable, message.ReceiptsColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNotifications queries the notifications edge of a Message.
+func (c *MessageClient) QueryNotifications(m *Message) *NotificationQuery {
+	query := (&NotificationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(notification.Table, notification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.NotificationsTable, message.NotificationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReplyTo queries the reply_to edge of a Message.
+func (c *MessageClient) QueryReplyTo(m *Message) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, message.ReplyToTable, message.ReplyToColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReplies queries the replies edge of a Message.
+func (c *MessageClient) QueryReplies(m *Message) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.RepliesTable, message.RepliesColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryThreadRoot queries the thread_root edge of a Message.
+func (c *MessageClient) QueryThreadRoot(m *Message) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, message.ThreadRootTable, message.ThreadRootColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryThreadReplies queries the thread_replies edge of a Message.
+func (c *MessageClient) QueryThreadReplies(m *Message) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.ThreadRepliesTable, message.ThreadRepliesColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryThreadParticipants queries the thread_participants edge of a Message.
+func (c *MessageClient) QueryThreadParticipants(m *Message) *ThreadParticipantQuery {
+	query := (&ThreadParticipantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(threadparticipant.Table, threadparticipant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.ThreadParticipantsTable, message.ThreadParticipantsColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBookmarks queries the bookmarks edge of a Message.
+func (c *MessageClient) QueryBookmarks(m *Message) *BookmarkQuery {
+	query := (&BookmarkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(bookmark.Table, bookmark.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.BookmarksTable, message.BookmarksColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryHiddenFor queries the hidden_for edge of a Message.
+func (c *MessageClient) QueryHiddenFor(m *Message) *HiddenMessageQuery {
+	query := (&HiddenMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(hiddenmessage.Table, hiddenmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.HiddenForTable, message.HiddenForColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MessageClient) Hooks() []Hook {
+	return c.hooks.Message
+}
+
+// Interceptors returns the client interceptors.
+func (c *MessageClient) Interceptors() []Interceptor {
+	return c.inters.Message
+}
+
+func (c *MessageClient) mutate(ctx context.Context, m *MessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Message mutation op: %q", m.Op())
+	}
+}
+
+// MessageMentionClient is a client for the MessageMention schema.
+type MessageMentionClient struct {
+	config
+}
+
+// NewMessageMentionClient returns a client for the MessageMention from the given config.
+func NewMessageMentionClient(c config) *MessageMentionClient {
+	return &MessageMentionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `messagemention.Hooks(f(g(h())))`.
+func (c *MessageMentionClient) Use(hooks ...Hook) {
+	c.hooks.MessageMention = append(c.hooks.MessageMention, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `messagemention.Intercept(f(g(h())))`.
+func (c *MessageMentionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MessageMention = append(c.inters.MessageMention, interceptors...)
+}
+
+// Create returns a builder for creating a MessageMention entity.
+func (c *MessageMentionClient) Create() *MessageMentionCreate {
+	mutation := newMessageMentionMutation(c.config, OpCreate)
+	return &MessageMentionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MessageMention entities.
+func (c *MessageMentionClient) CreateBulk(builders ...*MessageMentionCreate) *MessageMentionCreateBulk {
+	return &MessageMentionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MessageMentionClient) MapCreateBulk(slice any, setFunc func(*MessageMentionCreate, int)) *MessageMentionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MessageMentionCreateBulk{err: fmt.Errorf("calling to MessageMentionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MessageMentionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MessageMentionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MessageMention.
+func (c *MessageMentionClient) Update() *MessageMentionUpdate {
+	mutation := newMessageMentionMutation(c.config, OpUpdate)
+	return &MessageMentionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MessageMentionClient) UpdateOne(mm *MessageMention) *MessageMentionUpdateOne {
+	mutation := newMessageMentionMutation(c.config, OpUpdateOne, withMessageMention(mm))
+	return &MessageMentionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MessageMentionClient) UpdateOneID(id string) *MessageMentionUpdateOne {
+	mutation := newMessageMentionMutation(c.config, OpUpdateOne, withMessageMentionID(id))
+	return &MessageMentionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MessageMention.
+func (c *MessageMentionClient) Delete() *MessageMentionDelete {
+	mutation := newMessageMentionMutation(c.config, OpDelete)
+	return &MessageMentionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MessageMentionClient) DeleteOne(mm *MessageMention) *MessageMentionDeleteOne {
+	return c.DeleteOneID(mm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MessageMentionClient) DeleteOneID(id string) *MessageMentionDeleteOne {
+	builder := c.Delete().Where(messagemention.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MessageMentionDeleteOne{builder}
+}
+
+// Query returns a query builder for MessageMention.
+func (c *MessageMentionClient) Query() *MessageMentionQuery {
+	return &MessageMentionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMessageMention},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MessageMention entity by its id.
+func (c *MessageMentionClient) Get(ctx context.Context, id string) (*MessageMention, error) {
+	return c.Query().Where(messagemention.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MessageMentionClient) GetX(ctx context.Context, id string) *MessageMention {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMessage queries the message edge of a MessageMention.
+func (c *MessageMentionClient) QueryMessage(mm *MessageMention) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(messagemention.Table, messagemention.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, messagemention.MessageTable, messagemention.MessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(mm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a MessageMention.
+func (c *MessageMentionClient) QueryUser(mm *MessageMention) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(messagemention.Table, messagemention.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, messagemention.UserTable, messagemention.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(mm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MessageMentionClient) Hooks() []Hook {
+	return c.hooks.MessageMention
+}
+
+// Interceptors returns the client interceptors.
+func (c *MessageMentionClient) Interceptors() []Interceptor {
+	return c.inters.MessageMention
+}
+
+func (c *MessageMentionClient) mutate(ctx context.Context, m *MessageMentionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MessageMentionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MessageMentionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MessageMentionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MessageMentionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MessageMention mutation op: %q", m.Op())
+	}
+}
+
+// MessageReactionClient is a client for the MessageReaction schema.
+type MessageReactionClient struct {
+	config
+}
+
+// NewMessageReactionClient returns a client for the MessageReaction from the given config.
+func NewMessageReactionClient(c config) *MessageReactionClient {
+	return &MessageReactionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `messagereaction.Hooks(f(g(h())))`.
+func (c *MessageReactionClient) Use(hooks ...Hook) {
+	c.hooks.MessageReaction = append(c.hooks.MessageReaction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `messagereaction.Intercept(f(g(h())))`.
+func (c *MessageReactionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MessageReaction = append(c.inters.MessageReaction, interceptors...)
+}
+
+// Create returns a builder for creating a MessageReaction entity.
+func (c *MessageReactionClient) Create() *MessageReactionCreate {
+	mutation := newMessageReactionMutation(c.config, OpCreate)
+	return &MessageReactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MessageReaction entities.
+func (c *MessageReactionClient) CreateBulk(builders ...*MessageReactionCreate) *MessageReactionCreateBulk {
+	return &MessageReactionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MessageReactionClient) MapCreateBulk(slice any, setFunc func(*MessageReactionCreate, int)) *MessageReactionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MessageReactionCreateBulk{err: fmt.Errorf("calling to MessageReactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MessageReactionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MessageReactionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MessageReaction.
+func (c *MessageReactionClient) Update() *MessageReactionUpdate {
+	mutation := newMessageReactionMutation(c.config, OpUpdate)
+	return &MessageReactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MessageReactionClient) UpdateOne(mr *MessageReaction) *MessageReactionUpdateOne {
+	mutation := newMessageReactionMutation(c.config, OpUpdateOne, withMessageReaction(mr))
+	return &MessageReactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MessageReactionClient) UpdateOneID(id string) *MessageReactionUpdateOne {
+	mutation := newMessageReactionMutation(c.config, OpUpdateOne, withMessageReactionID(id))
+	return &MessageReactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MessageReaction.
+func (c *MessageReactionClient) Delete() *MessageReactionDelete {
+	mutation := newMessageReactionMutation(c.config, OpDelete)
+	return &MessageReactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MessageReactionClient) DeleteOne(mr *MessageReaction) *MessageReactionDeleteOne {
+	return c.DeleteOneID(mr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MessageReactionClient) DeleteOneID(id string) *MessageReactionDeleteOne {
+	builder := c.Delete().Where(messagereaction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MessageReactionDeleteOne{builder}
+}
+
+// Query returns a query builder for MessageReaction.
+func (c *MessageReactionClient) Query() *MessageReactionQuery {
+	return &MessageReactionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMessageReaction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MessageReaction entity by its id.
+func (c *MessageReactionClient) Get(ctx context.Context, id string) (*MessageReaction, error) {
+	return c.Query().Where(messagereaction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MessageReactionClient) GetX(ctx context.Context, id string) *MessageReaction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMessage queries the message edge of a MessageReaction.
+func (c *MessageReactionClient) QueryMessage(mr *MessageReaction) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(messagereaction.Table, messagereaction.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, messagereaction.MessageTable, messagereaction.MessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(mr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a MessageReaction.
+func (c *MessageReactionClient) QueryUser(mr *MessageReaction) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(messagereaction.Table, messagereaction.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, messagereaction.UserTable, messagereaction.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(mr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MessageReactionClient) Hooks() []Hook {
+	return c.hooks.MessageReaction
+}
+
+// Interceptors returns the client interceptors.
+func (c *MessageReactionClient) Interceptors() []Interceptor {
+	return c.inters.MessageReaction
+}
+
+func (c *MessageReactionClient) mutate(ctx context.Context, m *MessageReactionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MessageReactionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MessageReactionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MessageReactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MessageReactionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MessageReaction mutation op: %q", m.Op())
+	}
+}
+
+// MessageReceiptClient is a client for the MessageReceipt schema.
+type MessageReceiptClient struct {
+	config
+}
+
+// NewMessageReceiptClient returns a client for the MessageReceipt from the given config.
+func NewMessageReceiptClient(c config) *MessageReceiptClient {
+	return &MessageReceiptClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `messagereceipt.Hooks(f(g(h())))`.
+func (c *MessageReceiptClient) Use(hooks ...Hook) {
+	c.hooks.MessageReceipt = append(c.hooks.MessageReceipt, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `messagereceipt.Intercept(f(g(h())))`.
+func (c *MessageReceiptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MessageReceipt = append(c.inters.MessageReceipt, interceptors...)
+}
+
+// Create returns a builder for creating a MessageReceipt entity.
+func (c *MessageReceiptClient) Create() *MessageReceiptCreate {
+	mutation := newMessageReceiptMutation(c.config, OpCreate)
+	return &MessageReceiptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MessageReceipt entities.
+func (c *MessageReceiptClient) CreateBulk(builders ...*MessageReceiptCreate) *MessageReceiptCreateBulk {
+	return &MessageReceiptCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MessageReceiptClient) MapCreateBulk(slice any, setFunc func(*MessageReceiptCreate, int)) *MessageReceiptCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MessageReceiptCreateBulk{err: fmt.Errorf("calling to MessageReceiptClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MessageReceiptCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MessageReceiptCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MessageReceipt.
+func (c *MessageReceiptClient) Update() *MessageReceiptUpdate {
+	mutation := newMessageReceiptMutation(c.config, OpUpdate)
+	return &MessageReceiptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MessageReceiptClient) UpdateOne(mr *MessageReceipt) *MessageReceiptUpdateOne {
+	mutation := newMessageReceiptMutation(c.config, OpUpdateOne, withMessageReceipt(mr))
+	return &MessageReceiptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MessageReceiptClient) UpdateOneID(id string) *MessageReceiptUpdateOne {
+	mutation := newMessageReceiptMutation(c.config, OpUpdateOne, withMessageReceiptID(id))
+	return &MessageReceiptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MessageReceipt.
+func (c *MessageReceiptClient) Delete() *MessageReceiptDelete {
+	mutation := newMessageReceiptMutation(c.config, OpDelete)
+	return &MessageReceiptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MessageReceiptClient) DeleteOne(mr *MessageReceipt) *MessageReceiptDeleteOne {
+	return c.DeleteOneID(mr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MessageReceiptClient) DeleteOneID(id string) *MessageReceiptDeleteOne {
+	builder := c.Delete().Where(messagereceipt.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MessageReceiptDeleteOne{builder}
+}
+
+// Query returns a query builder for MessageReceipt.
+func (c *MessageReceiptClient) Query() *MessageReceiptQuery {
+	return &MessageReceiptQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMessageReceipt},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MessageReceipt entity by its id.
+func (c *MessageReceiptClient) Get(ctx context.Context, id string) (*MessageReceipt, error) {
+	return c.Query().Where(messagereceipt.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MessageReceiptClient) GetX(ctx context.Context, id string) *MessageReceipt {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMessage queries the message edge of a MessageReceipt.
+func (c *MessageReceiptClient) QueryMessage(mr *MessageReceipt) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(messagereceipt.Table, messagereceipt.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, messagereceipt.MessageTable, messagereceipt.MessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(mr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a MessageReceipt.
+func (c *MessageReceiptClient) QueryUser(mr *MessageReceipt) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(messagereceipt.Table, messagereceipt.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, messagereceipt.UserTable, messagereceipt.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(mr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MessageReceiptClient) Hooks() []Hook {
+	return c.hooks.MessageReceipt
+}
+
+// Interceptors returns the client interceptors.
+func (c *MessageReceiptClient) Interceptors() []Interceptor {
+	return c.inters.MessageReceipt
+}
+
+func (c *MessageReceiptClient) mutate(ctx context.Context, m *MessageReceiptMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MessageReceiptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MessageReceiptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MessageReceiptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MessageReceiptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MessageReceipt mutation op: %q", m.Op())
+	}
+}
+
+// MessageRevisionClient is a client for the MessageRevision schema.
+type MessageRevisionClient struct {
+	config
+}
+
+// NewMessageRevisionClient returns a client for the MessageRevision from the given config.
+func NewMessageRevisionClient(c config) *MessageRevisionClient {
+	return &MessageRevisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `messagerevision.Hooks(f(g(h())))`.
+func (c *MessageRevisionClient) Use(hooks ...Hook) {
+	c.hooks.MessageRevision = append(c.hooks.MessageRevision, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `messagerevision.Intercept(f(g(h())))`.
+func (c *MessageRevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MessageRevision = append(c.inters.MessageRevision, interceptors...)
+}
+
+// Create returns a builder for creating a MessageRevision entity.
+func (c *MessageRevisionClient) Create() *MessageRevisionCreate {
+	mutation := newMessageRevisionMutation(c.config, OpCreate)
+	return &MessageRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MessageRevision entities.
+func (c *MessageRevisionClient) CreateBulk(builders ...*MessageRevisionCreate) *MessageRevisionCreateBulk {
+	return &MessageRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MessageRevisionClient) MapCreateBulk(slice any, setFunc func(*MessageRevisionCreate, int)) *MessageRevisionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MessageRevisionCreateBulk{err: fmt.Errorf("calling to MessageRevisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MessageRevisionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MessageRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MessageRevision.
+func (c *MessageRevisionClient) Update() *MessageRevisionUpdate {
+	mutation := newMessageRevisionMutation(c.config, OpUpdate)
+	return &MessageRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MessageRevisionClient) UpdateOne(mr *MessageRevision) *MessageRevisionUpdateOne {
+	mutation := newMessageRevisionMutation(c.config, OpUpdateOne, withMessageRevision(mr))
+	return &MessageRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MessageRevisionClient) UpdateOneID(id string) *MessageRevisionUpdateOne {
+	mutation := newMessageRevisionMutation(c.config, OpUpdateOne, withMessageRevisionID(id))
+	return &MessageRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MessageRevision.
+func (c *MessageRevisionClient) Delete() *MessageRevisionDelete {
+	mutation := newMessageRevisionMutation(c.config, OpDelete)
+	return &MessageRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MessageRevisionClient) DeleteOne(mr *MessageRevision) *MessageRevisionDeleteOne {
+	return c.DeleteOneID(mr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MessageRevisionClient) DeleteOneID(id string) *MessageRevisionDeleteOne {
+	builder := c.Delete().Where(messagerevision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MessageRevisionDeleteOne{builder}
+}
+
+// Query returns a query builder for MessageRevision.
+func (c *MessageRevisionClient) Query() *MessageRevisionQuery {
+	return &MessageRevisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMessageRevision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MessageRevision entity by its id.
+func (c *MessageRevisionClient) Get(ctx context.Context, id string) (*MessageRevision, error) {
+	return c.Query().Where(messagerevision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MessageRevisionClient) GetX(ctx context.Context, id string) *MessageRevision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMessage queries the message edge of a MessageRevision.
+func (c *MessageRevisionClient) QueryMessage(mr *MessageRevision) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(messagerevision.Table, messagerevision.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, messagerevision.MessageTable, messagerevision.MessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(mr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEditor queries the editor edge of a MessageRevision.
+func (c *MessageRevisionClient) QueryEditor(mr *MessageRevision) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := gu.ID
+		id := mr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(guild.Table, guild.FieldID, id),
-			sqlgraph.To(member.Table, member.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, guild.GuildMembersTable, guild.GuildMembersColumn),
+			sqlgraph.From(messagerevision.Table, messagerevision.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, messagerevision.EditorTable, messagerevision.EditorColumn),
 		)
-		fromV = sqlgraph.Neighbors(gu.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(mr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *GuildClient) Hooks() []Hook {
-	return c.hooks.Guild
+func (c *MessageRevisionClient) Hooks() []Hook {
+	return c.hooks.MessageRevision
 }
 
 // Interceptors returns the client interceptors.
-func (c *GuildClient) Interceptors() []Interceptor {
-	return c.inters.Guild
+func (c *MessageRevisionClient) Interceptors() []Interceptor {
+	return c.inters.MessageRevision
 }
 
-func (c *GuildClient) mutate(ctx context.Context, m *GuildMutation) (Value, error) {
+func (c *MessageRevisionClient) mutate(ctx context.Context, m *MessageRevisionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&GuildCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MessageRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&GuildUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MessageRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&GuildUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&MessageRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&GuildDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&MessageRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Guild mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown MessageRevision mutation op: %q", m.Op())
 	}
 }
 
-// InvitationClient is a client for the Invitation schema.
-type InvitationClient struct {
+// NotificationClient is a client for the Notification schema.
+type NotificationClient struct {
 	config
 }
 
-// NewInvitationClient returns a client for the Invitation from the given config.
-func NewInvitationClient(c config) *InvitationClient {
-	return &InvitationClient{config: c}
+// NewNotificationClient returns a client for the Notification from the given config.
+func NewNotificationClient(c config) *NotificationClient {
+	return &NotificationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `invitation.Hooks(f(g(h())))`.
-func (c *InvitationClient) Use(hooks ...Hook) {
-	c.hooks.Invitation = append(c.hooks.Invitation, hooks...)
+// A call to `Use(f, g, h)` equals to `notification.Hooks(f(g(h())))`.
+func (c *NotificationClient) Use(hooks ...Hook) {
+	c.hooks.Notification = append(c.hooks.Notification, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `invitation.Intercept(f(g(h())))`.
-func (c *InvitationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Invitation = append(c.inters.Invitation, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `notification.Intercept(f(g(h())))`.
+func (c *NotificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Notification = append(c.inters.Notification, interceptors...)
 }
 
-// Create returns a builder for creating a Invitation entity.
-func (c *InvitationClient) Create() *InvitationCreate {
-	mutation := newInvitationMutation(c.config, OpCreate)
-	return &InvitationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Notification entity.
+func (c *NotificationClient) Create() *NotificationCreate {
+	mutation := newNotificationMutation(c.config, OpCreate)
+	return &NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Invitation entities.
-func (c *InvitationClient) CreateBulk(builders ...*InvitationCreate) *InvitationCreateBulk {
-	return &InvitationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Notification entities.
+func (c *NotificationClient) CreateBulk(builders ...*NotificationCreate) *NotificationCreateBulk {
+	return &NotificationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *InvitationClient) MapCreateBulk(slice any, setFunc func(*InvitationCreate, int)) *InvitationCreateBulk {
+func (c *NotificationClient) MapCreateBulk(slice any, setFunc func(*NotificationCreate, int)) *NotificationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &InvitationCreateBulk{err: fmt.Errorf("calling to InvitationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &NotificationCreateBulk{err: fmt.Errorf("calling to NotificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*InvitationCreate, rv.Len())
+	builders := make([]*NotificationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &InvitationCreateBulk{config: c.config, builders: builders}
+	return &NotificationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Invitation.
-func (c *InvitationClient) Update() *InvitationUpdate {
-	mutation := newInvitationMutation(c.config, OpUpdate)
-	return &InvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Notification.
+func (c *NotificationClient) Update() *NotificationUpdate {
+	mutation := newNotificationMutation(c.config, OpUpdate)
+	return &NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *InvitationClient) UpdateOne(i *Invitation) *InvitationUpdateOne {
-	mutation := newInvitationMutation(c.config, OpUpdateOne, withInvitation(i))
-	return &InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NotificationClient) UpdateOne(n *Notification) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotification(n))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *InvitationClient) UpdateOneID(id string) *InvitationUpdateOne {
-	mutation := newInvitationMutation(c.config, OpUpdateOne, withInvitationID(id))
-	return &InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NotificationClient) UpdateOneID(id string) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotificationID(id))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Invitation.
-func (c *InvitationClient) Delete() *InvitationDelete {
-	mutation := newInvitationMutation(c.config, OpDelete)
-	return &InvitationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Notification.
+func (c *NotificationClient) Delete() *NotificationDelete {
+	mutation := newNotificationMutation(c.config, OpDelete)
+	return &NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *InvitationClient) DeleteOne(i *Invitation) *InvitationDeleteOne {
-	return c.DeleteOneID(i.ID)
+func (c *NotificationClient) DeleteOne(n *Notification) *NotificationDeleteOne {
+	return c.DeleteOneID(n.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *InvitationClient) DeleteOneID(id string) *InvitationDeleteOne {
-	builder := c.Delete().Where(invitation.ID(id))
+func (c *NotificationClient) DeleteOneID(id string) *NotificationDeleteOne {
+	builder := c.Delete().Where(notification.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &InvitationDeleteOne{builder}
+	return &NotificationDeleteOne{builder}
 }
 
-// Query returns a query builder for Invitation.
-func (c *InvitationClient) Query() *InvitationQuery {
-	return &InvitationQuery{
+// Query returns a query builder for Notification.
+func (c *NotificationClient) Query() *NotificationQuery {
+	return &NotificationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeInvitation},
+		ctx:    &QueryContext{Type: TypeNotification},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Invitation entity by its id.
-func (c *InvitationClient) Get(ctx context.Context, id string) (*Invitation, error) {
-	return c.Query().Where(invitation.ID(id)).Only(ctx)
+// Get returns a Notification entity by its id.
+func (c *NotificationClient) Get(ctx context.Context, id string) (*Notification, error) {
+	return c.Query().Where(notification.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *InvitationClient) GetX(ctx context.Context, id string) *Invitation {
+func (c *NotificationClient) GetX(ctx context.Context, id string) *Notification {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1410,164 +4506,196 @@ func (c *InvitationClient) GetX(ctx context.Context, id string) *Invitation {
 	return obj
 }
 
-// QueryGuild queries the guild edge of a Invitation.
-func (c *InvitationClient) QueryGuild(i *Invitation) *GuildQuery {
-	query := (&GuildClient{config: c.config}).Query()
+// QueryUser queries the user edge of a Notification.
+func (c *NotificationClient) QueryUser(n *Notification) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := i.ID
+		id := n.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(invitation.Table, invitation.FieldID, id),
-			sqlgraph.To(guild.Table, guild.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, invitation.GuildTable, invitation.GuildColumn),
+			sqlgraph.From(notification.Table, notification.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, notification.UserTable, notification.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryInvitedBy queries the invited_by edge of a Invitation.
-func (c *InvitationClient) QueryInvitedBy(i *Invitation) *UserQuery {
+// QueryRelatedUser queries the related_user edge of a Notification.
+func (c *NotificationClient) QueryRelatedUser(n *Notification) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := i.ID
+		id := n.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(invitation.Table, invitation.FieldID, id),
+			sqlgraph.From(notification.Table, notification.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, invitation.InvitedByTable, invitation.InvitedByColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, notification.RelatedUserTable, notification.RelatedUserColumn),
 		)
-		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRelatedConversation queries the related_conversation edge of a Notification.
+func (c *NotificationClient) QueryRelatedConversation(n *Notification) *ConversationQuery {
+	query := (&ConversationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notification.Table, notification.FieldID, id),
+			sqlgraph.To(conversation.Table, conversation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, notification.RelatedConversationTable, notification.RelatedConversationColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRelatedMessage queries the related_message edge of a Notification.
+func (c *NotificationClient) QueryRelatedMessage(n *Notification) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notification.Table, notification.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, notification.RelatedMessageTable, notification.RelatedMessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *InvitationClient) Hooks() []Hook {
-	return c.hooks.Invitation
+func (c *NotificationClient) Hooks() []Hook {
+	return c.hooks.Notification
 }
 
 // Interceptors returns the client interceptors.
-func (c *InvitationClient) Interceptors() []Interceptor {
-	return c.inters.Invitation
+func (c *NotificationClient) Interceptors() []Interceptor {
+	return c.inters.Notification
 }
 
-func (c *InvitationClient) mutate(ctx context.Context, m *InvitationMutation) (Value, error) {
+func (c *NotificationClient) mutate(ctx context.Context, m *NotificationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&InvitationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&InvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&InvitationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Invitation mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Notification mutation op: %q", m.Op())
 	}
 }
 
-// MemberClient is a client for the Member schema.
-type MemberClient struct {
+// PinnedMessageClient is a client for the PinnedMessage schema.
+type PinnedMessageClient struct {
 	config
 }
 
-// NewMemberClient returns a client for the Member from the given config.
-func NewMemberClient(c config) *MemberClient {
-	return &MemberClient{config: c}
+// NewPinnedMessageClient returns a client for the PinnedMessage from the given config.
+func NewPinnedMessageClient(c config) *PinnedMessageClient {
+	return &PinnedMessageClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `member.Hooks(f(g(h())))`.
-func (c *MemberClient) Use(hooks ...Hook) {
-	c.hooks.Member = append(c.hooks.Member, hooks...)
+// A call to `Use(f, g, h)` equals to `pinnedmessage.Hooks(f(g(h())))`.
+func (c *PinnedMessageClient) Use(hooks ...Hook) {
+	c.hooks.PinnedMessage = append(c.hooks.PinnedMessage, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `member.Intercept(f(g(h())))`.
-func (c *MemberClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Member = append(c.inters.Member, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `pinnedmessage.Intercept(f(g(h())))`.
+func (c *PinnedMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PinnedMessage = append(c.inters.PinnedMessage, interceptors...)
 }
 
-// Create returns a builder for creating a Member entity.
-func (c *MemberClient) Create() *MemberCreate {
-	mutation := newMemberMutation(c.config, OpCreate)
-	return &MemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a PinnedMessage entity.
+func (c *PinnedMessageClient) Create() *PinnedMessageCreate {
+	mutation := newPinnedMessageMutation(c.config, OpCreate)
+	return &PinnedMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Member entities.
-func (c *MemberClient) CreateBulk(builders ...*MemberCreate) *MemberCreateBulk {
-	return &MemberCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of PinnedMessage entities.
+func (c *PinnedMessageClient) CreateBulk(builders ...*PinnedMessageCreate) *PinnedMessageCreateBulk {
+	return &PinnedMessageCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MemberClient) MapCreateBulk(slice any, setFunc func(*MemberCreate, int)) *MemberCreateBulk {
+func (c *PinnedMessageClient) MapCreateBulk(slice any, setFunc func(*PinnedMessageCreate, int)) *PinnedMessageCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MemberCreateBulk{err: fmt.Errorf("calling to MemberClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PinnedMessageCreateBulk{err: fmt.Errorf("calling to PinnedMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MemberCreate, rv.Len())
+	builders := make([]*PinnedMessageCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MemberCreateBulk{config: c.config, builders: builders}
+	return &PinnedMessageCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Member.
-func (c *MemberClient) Update() *MemberUpdate {
-	mutation := newMemberMutation(c.config, OpUpdate)
-	return &MemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for PinnedMessage.
+func (c *PinnedMessageClient) Update() *PinnedMessageUpdate {
+	mutation := newPinnedMessageMutation(c.config, OpUpdate)
+	return &PinnedMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MemberClient) UpdateOne(m *Member) *MemberUpdateOne {
-	mutation := newMemberMutation(c.config, OpUpdateOne, withMember(m))
-	return &MemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PinnedMessageClient) UpdateOne(pm *PinnedMessage) *PinnedMessageUpdateOne {
+	mutation := newPinnedMessageMutation(c.config, OpUpdateOne, withPinnedMessage(pm))
+	return &PinnedMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MemberClient) UpdateOneID(id string) *MemberUpdateOne {
-	mutation := newMemberMutation(c.config, OpUpdateOne, withMemberID(id))
-	return &MemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PinnedMessageClient) UpdateOneID(id string) *PinnedMessageUpdateOne {
+	mutation := newPinnedMessageMutation(c.config, OpUpdateOne, withPinnedMessageID(id))
+	return &PinnedMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Member.
-func (c *MemberClient) Delete() *MemberDelete {
-	mutation := newMemberMutation(c.config, OpDelete)
-	return &MemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for PinnedMessage.
+func (c *PinnedMessageClient) Delete() *PinnedMessageDelete {
+	mutation := newPinnedMessageMutation(c.config, OpDelete)
+	return &PinnedMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MemberClient) DeleteOne(m *Member) *MemberDeleteOne {
-	return c.DeleteOneID(m.ID)
+func (c *PinnedMessageClient) DeleteOne(pm *PinnedMessage) *PinnedMessageDeleteOne {
+	return c.DeleteOneID(pm.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MemberClient) DeleteOneID(id string) *MemberDeleteOne {
-	builder := c.Delete().Where(member.ID(id))
+func (c *PinnedMessageClient) DeleteOneID(id string) *PinnedMessageDeleteOne {
+	builder := c.Delete().Where(pinnedmessage.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MemberDeleteOne{builder}
+	return &PinnedMessageDeleteOne{builder}
 }
 
-// Query returns a query builder for Member.
-func (c *MemberClient) Query() *MemberQuery {
-	return &MemberQuery{
+// Query returns a query builder for PinnedMessage.
+func (c *PinnedMessageClient) Query() *PinnedMessageQuery {
+	return &PinnedMessageQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMember},
+		ctx:    &QueryContext{Type: TypePinnedMessage},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Member entity by its id.
-func (c *MemberClient) Get(ctx context.Context, id string) (*Member, error) {
-	return c.Query().Where(member.ID(id)).Only(ctx)
+// Get returns a PinnedMessage entity by its id.
+func (c *PinnedMessageClient) Get(ctx context.Context, id string) (*PinnedMessage, error) {
+	return c.Query().Where(pinnedmessage.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MemberClient) GetX(ctx context.Context, id string) *Member {
+func (c *PinnedMessageClient) GetX(ctx context.Context, id string) *PinnedMessage {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1575,164 +4703,180 @@ func (c *MemberClient) GetX(ctx context.Context, id string) *Member {
 	return obj
 }
 
-// QueryGuild queries the guild edge of a Member.
-func (c *MemberClient) QueryGuild(m *Member) *GuildQuery {
-	query := (&GuildClient{config: c.config}).Query()
+// QueryConversation queries the conversation edge of a PinnedMessage.
+func (c *PinnedMessageClient) QueryConversation(pm *PinnedMessage) *ConversationQuery {
+	query := (&ConversationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pinnedmessage.Table, pinnedmessage.FieldID, id),
+			sqlgraph.To(conversation.Table, conversation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, pinnedmessage.ConversationTable, pinnedmessage.ConversationColumn),
+		)
+		fromV = sqlgraph.Neighbors(pm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMessage queries the message edge of a PinnedMessage.
+func (c *PinnedMessageClient) QueryMessage(pm *PinnedMessage) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
+		id := pm.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(member.Table, member.FieldID, id),
-			sqlgraph.To(guild.Table, guild.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, member.GuildTable, member.GuildColumn),
+			sqlgraph.From(pinnedmessage.Table, pinnedmessage.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, pinnedmessage.MessageTable, pinnedmessage.MessageColumn),
 		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pm.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryUser queries the user edge of a Member.
-func (c *MemberClient) QueryUser(m *Member) *UserQuery {
+// QueryPinnedBy queries the pinned_by edge of a PinnedMessage.
+func (c *PinnedMessageClient) QueryPinnedBy(pm *PinnedMessage) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
+		id := pm.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(member.Table, member.FieldID, id),
+			sqlgraph.From(pinnedmessage.Table, pinnedmessage.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, member.UserTable, member.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, pinnedmessage.PinnedByTable, pinnedmessage.PinnedByColumn),
 		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pm.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *MemberClient) Hooks() []Hook {
-	return c.hooks.Member
+func (c *PinnedMessageClient) Hooks() []Hook {
+	return c.hooks.PinnedMessage
 }
 
 // Interceptors returns the client interceptors.
-func (c *MemberClient) Interceptors() []Interceptor {
-	return c.inters.Member
+func (c *PinnedMessageClient) Interceptors() []Interceptor {
+	return c.inters.PinnedMessage
 }
 
-func (c *MemberClient) mutate(ctx context.Context, m *MemberMutation) (Value, error) {
+func (c *PinnedMessageClient) mutate(ctx context.Context, m *PinnedMessageMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MemberCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PinnedMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MemberUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PinnedMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PinnedMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MemberDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PinnedMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Member mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown PinnedMessage mutation op: %q", m.Op())
 	}
 }
 
-// MessageClient is a client for the Message schema.
-type MessageClient struct {
+// ScheduledMessageClient is a client for the ScheduledMessage schema.
+type ScheduledMessageClient struct {
 	config
 }
 
-// NewMessageClient returns a client for the Message from the given config.
-func NewMessageClient(c config) *MessageClient {
-	return &MessageClient{config: c}
+// NewScheduledMessageClient returns a client for the ScheduledMessage from the given config.
+func NewScheduledMessageClient(c config) *ScheduledMessageClient {
+	return &ScheduledMessageClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `message.Hooks(f(g(h())))`.
-func (c *MessageClient) Use(hooks ...Hook) {
-	c.hooks.Message = append(c.hooks.Message, hooks...)
+// A call to `Use(f, g, h)` equals to `scheduledmessage.Hooks(f(g(h())))`.
+func (c *ScheduledMessageClient) Use(hooks ...Hook) {
+	c.hooks.ScheduledMessage = append(c.hooks.ScheduledMessage, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `message.Intercept(f(g(h())))`.
-func (c *MessageClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Message = append(c.inters.Message, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `scheduledmessage.Intercept(f(g(h())))`.
+func (c *ScheduledMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScheduledMessage = append(c.inters.ScheduledMessage, interceptors...)
 }
 
-// Create returns a builder for creating a Message entity.
-func (c *MessageClient) Create() *MessageCreate {
-	mutation := newMessageMutation(c.config, OpCreate)
-	return &MessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ScheduledMessage entity.
+func (c *ScheduledMessageClient) Create() *ScheduledMessageCreate {
+	mutation := newScheduledMessageMutation(c.config, OpCreate)
+	return &ScheduledMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Message entities.
-func (c *MessageClient) CreateBulk(builders ...*MessageCreate) *MessageCreateBulk {
-	return &MessageCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ScheduledMessage entities.
+func (c *ScheduledMessageClient) CreateBulk(builders ...*ScheduledMessageCreate) *ScheduledMessageCreateBulk {
+	return &ScheduledMessageCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MessageClient) MapCreateBulk(slice any, setFunc func(*MessageCreate, int)) *MessageCreateBulk {
+func (c *ScheduledMessageClient) MapCreateBulk(slice any, setFunc func(*ScheduledMessageCreate, int)) *ScheduledMessageCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MessageCreateBulk{err: fmt.Errorf("calling to MessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ScheduledMessageCreateBulk{err: fmt.Errorf("calling to ScheduledMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MessageCreate, rv.Len())
+	builders := make([]*ScheduledMessageCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MessageCreateBulk{config: c.config, builders: builders}
+	return &ScheduledMessageCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Message.
-func (c *MessageClient) Update() *MessageUpdate {
-	mutation := newMessageMutation(c.config, OpUpdate)
-	return &MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ScheduledMessage.
+func (c *ScheduledMessageClient) Update() *ScheduledMessageUpdate {
+	mutation := newScheduledMessageMutation(c.config, OpUpdate)
+	return &ScheduledMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MessageClient) UpdateOne(m *Message) *MessageUpdateOne {
-	mutation := newMessageMutation(c.config, OpUpdateOne, withMessage(m))
-	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ScheduledMessageClient) UpdateOne(sm *ScheduledMessage) *ScheduledMessageUpdateOne {
+	mutation := newScheduledMessageMutation(c.config, OpUpdateOne, withScheduledMessage(sm))
+	return &ScheduledMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MessageClient) UpdateOneID(id string) *MessageUpdateOne {
-	mutation := newMessageMutation(c.config, OpUpdateOne, withMessageID(id))
-	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ScheduledMessageClient) UpdateOneID(id string) *ScheduledMessageUpdateOne {
+	mutation := newScheduledMessageMutation(c.config, OpUpdateOne, withScheduledMessageID(id))
+	return &ScheduledMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Message.
-func (c *MessageClient) Delete() *MessageDelete {
-	mutation := newMessageMutation(c.config, OpDelete)
-	return &MessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ScheduledMessage.
+func (c *ScheduledMessageClient) Delete() *ScheduledMessageDelete {
+	mutation := newScheduledMessageMutation(c.config, OpDelete)
+	return &ScheduledMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MessageClient) DeleteOne(m *Message) *MessageDeleteOne {
-	return c.DeleteOneID(m.ID)
+func (c *ScheduledMessageClient) DeleteOne(sm *ScheduledMessage) *ScheduledMessageDeleteOne {
+	return c.DeleteOneID(sm.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MessageClient) DeleteOneID(id string) *MessageDeleteOne {
-	builder := c.Delete().Where(message.ID(id))
+func (c *ScheduledMessageClient) DeleteOneID(id string) *ScheduledMessageDeleteOne {
+	builder := c.Delete().Where(scheduledmessage.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MessageDeleteOne{builder}
+	return &ScheduledMessageDeleteOne{builder}
 }
 
-// Query returns a query builder for Message.
-func (c *MessageClient) Query() *MessageQuery {
-	return &MessageQuery{
+// Query returns a query builder for ScheduledMessage.
+func (c *ScheduledMessageClient) Query() *ScheduledMessageQuery {
+	return &ScheduledMessageQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMessage},
+		ctx:    &QueryContext{Type: TypeScheduledMessage},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Message entity by its id.
-func (c *MessageClient) Get(ctx context.Context, id string) (*Message, error) {
-	return c.Query().Where(message.ID(id)).Only(ctx)
+// Get returns a ScheduledMessage entity by its id.
+func (c *ScheduledMessageClient) Get(ctx context.Context, id string) (*ScheduledMessage, error) {
+	return c.Query().Where(scheduledmessage.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MessageClient) GetX(ctx context.Context, id string) *Message {
+func (c *ScheduledMessageClient) GetX(ctx context.Context, id string) *ScheduledMessage {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1740,180 +4884,180 @@ func (c *MessageClient) GetX(ctx context.Context, id string) *Message {
 	return obj
 }
 
-// QueryConversation queries the conversation edge of a Message.
-func (c *MessageClient) QueryConversation(m *Message) *ConversationQuery {
+// QueryConversation queries the conversation edge of a ScheduledMessage.
+func (c *ScheduledMessageClient) QueryConversation(sm *ScheduledMessage) *ConversationQuery {
 	query := (&ConversationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
+		id := sm.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.From(scheduledmessage.Table, scheduledmessage.FieldID, id),
 			sqlgraph.To(conversation.Table, conversation.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, message.ConversationTable, message.ConversationColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, scheduledmessage.ConversationTable, scheduledmessage.ConversationColumn),
 		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(sm.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QuerySender queries the sender edge of a Message.
-func (c *MessageClient) QuerySender(m *Message) *UserQuery {
+// QuerySender queries the sender edge of a ScheduledMessage.
+func (c *ScheduledMessageClient) QuerySender(sm *ScheduledMessage) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
+		id := sm.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.From(scheduledmessage.Table, scheduledmessage.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, message.SenderTable, message.SenderColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, scheduledmessage.SenderTable, scheduledmessage.SenderColumn),
 		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(sm.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryCall queries the call edge of a Message.
-func (c *MessageClient) QueryCall(m *Message) *CallQuery {
-	query := (&CallClient{config: c.config}).Query()
+// QueryMessage queries the message edge of a ScheduledMessage.
+func (c *ScheduledMessageClient) QueryMessage(sm *ScheduledMessage) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
+		id := sm.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(message.Table, message.FieldID, id),
-			sqlgraph.To(call.Table, call.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, message.CallTable, message.CallColumn),
+			sqlgraph.From(scheduledmessage.Table, scheduledmessage.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, scheduledmessage.MessageTable, scheduledmessage.MessageColumn),
 		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(sm.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *MessageClient) Hooks() []Hook {
-	return c.hooks.Message
+func (c *ScheduledMessageClient) Hooks() []Hook {
+	return c.hooks.ScheduledMessage
 }
 
 // Interceptors returns the client interceptors.
-func (c *MessageClient) Interceptors() []Interceptor {
-	return c.inters.Message
+func (c *ScheduledMessageClient) Interceptors() []Interceptor {
+	return c.inters.ScheduledMessage
 }
 
-func (c *MessageClient) mutate(ctx context.Context, m *MessageMutation) (Value, error) {
+func (c *ScheduledMessageClient) mutate(ctx context.Context, m *ScheduledMessageMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScheduledMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScheduledMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScheduledMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ScheduledMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Message mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ScheduledMessage mutation op: %q", m.Op())
 	}
 }
 
-// NotificationClient is a client for the Notification schema.
-type NotificationClient struct {
+// SessionClient is a client for the Session schema.
+type SessionClient struct {
 	config
 }
 
-// NewNotificationClient returns a client for the Notification from the given config.
-func NewNotificationClient(c config) *NotificationClient {
-	return &NotificationClient{config: c}
+// NewSessionClient returns a client for the Session from the given config.
+func NewSessionClient(c config) *SessionClient {
+	return &SessionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `notification.Hooks(f(g(h())))`.
-func (c *NotificationClient) Use(hooks ...Hook) {
-	c.hooks.Notification = append(c.hooks.Notification, hooks...)
+// A call to `Use(f, g, h)` equals to `session.Hooks(f(g(h())))`.
+func (c *SessionClient) Use(hooks ...Hook) {
+	c.hooks.Session = append(c.hooks.Session, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `notification.Intercept(f(g(h())))`.
-func (c *NotificationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Notification = append(c.inters.Notification, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `session.Intercept(f(g(h())))`.
+func (c *SessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Session = append(c.inters.Session, interceptors...)
 }
 
-// Create returns a builder for creating a Notification entity.
-func (c *NotificationClient) Create() *NotificationCreate {
-	mutation := newNotificationMutation(c.config, OpCreate)
-	return &NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Session entity.
+func (c *SessionClient) Create() *SessionCreate {
+	mutation := newSessionMutation(c.config, OpCreate)
+	return &SessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Notification entities.
-func (c *NotificationClient) CreateBulk(builders ...*NotificationCreate) *NotificationCreateBulk {
-	return &NotificationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Session entities.
+func (c *SessionClient) CreateBulk(builders ...*SessionCreate) *SessionCreateBulk {
+	return &SessionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *NotificationClient) MapCreateBulk(slice any, setFunc func(*NotificationCreate, int)) *NotificationCreateBulk {
+func (c *SessionClient) MapCreateBulk(slice any, setFunc func(*SessionCreate, int)) *SessionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &NotificationCreateBulk{err: fmt.Errorf("calling to NotificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SessionCreateBulk{err: fmt.Errorf("calling to SessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*NotificationCreate, rv.Len())
+	builders := make([]*SessionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &NotificationCreateBulk{config: c.config, builders: builders}
+	return &SessionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Notification.
-func (c *NotificationClient) Update() *NotificationUpdate {
-	mutation := newNotificationMutation(c.config, OpUpdate)
-	return &NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Session.
+func (c *SessionClient) Update() *SessionUpdate {
+	mutation := newSessionMutation(c.config, OpUpdate)
+	return &SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NotificationClient) UpdateOne(n *Notification) *NotificationUpdateOne {
-	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotification(n))
-	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SessionClient) UpdateOne(s *Session) *SessionUpdateOne {
+	mutation := newSessionMutation(c.config, OpUpdateOne, withSession(s))
+	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NotificationClient) UpdateOneID(id string) *NotificationUpdateOne {
-	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotificationID(id))
-	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SessionClient) UpdateOneID(id string) *SessionUpdateOne {
+	mutation := newSessionMutation(c.config, OpUpdateOne, withSessionID(id))
+	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Notification.
-func (c *NotificationClient) Delete() *NotificationDelete {
-	mutation := newNotificationMutation(c.config, OpDelete)
-	return &NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Session.
+func (c *SessionClient) Delete() *SessionDelete {
+	mutation := newSessionMutation(c.config, OpDelete)
+	return &SessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NotificationClient) DeleteOne(n *Notification) *NotificationDeleteOne {
-	return c.DeleteOneID(n.ID)
+func (c *SessionClient) DeleteOne(s *Session) *SessionDeleteOne {
+	return c.DeleteOneID(s.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *NotificationClient) DeleteOneID(id string) *NotificationDeleteOne {
-	builder := c.Delete().Where(notification.ID(id))
+func (c *SessionClient) DeleteOneID(id string) *SessionDeleteOne {
+	builder := c.Delete().Where(session.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NotificationDeleteOne{builder}
+	return &SessionDeleteOne{builder}
 }
 
-// Query returns a query builder for Notification.
-func (c *NotificationClient) Query() *NotificationQuery {
-	return &NotificationQuery{
+// Query returns a query builder for Session.
+func (c *SessionClient) Query() *SessionQuery {
+	return &SessionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeNotification},
+		ctx:    &QueryContext{Type: TypeSession},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Notification entity by its id.
-func (c *NotificationClient) Get(ctx context.Context, id string) (*Notification, error) {
-	return c.Query().Where(notification.ID(id)).Only(ctx)
+// Get returns a Session entity by its id.
+func (c *SessionClient) Get(ctx context.Context, id string) (*Session, error) {
+	return c.Query().Where(session.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *NotificationClient) GetX(ctx context.Context, id string) *Notification {
+func (c *SessionClient) GetX(ctx context.Context, id string) *Session {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1921,180 +5065,148 @@ func (c *NotificationClient) GetX(ctx context.Context, id string) *Notification
 	return obj
 }
 
-// QueryUser queries the user edge of a Notification.
-func (c *NotificationClient) QueryUser(n *Notification) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := n.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(notification.Table, notification.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, notification.UserTable, notification.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryRelatedUser queries the related_user edge of a Notification.
-func (c *NotificationClient) QueryRelatedUser(n *Notification) *UserQuery {
+// QueryUser queries the user edge of a Session.
+func (c *SessionClient) QueryUser(s *Session) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := n.ID
+		id := s.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(notification.Table, notification.FieldID, id),
+			sqlgraph.From(session.Table, session.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, notification.RelatedUserTable, notification.RelatedUserColumn),
-		)
-		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryRelatedConversation queries the related_conversation edge of a Notification.
-func (c *NotificationClient) QueryRelatedConversation(n *Notification) *ConversationQuery {
-	query := (&ConversationClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := n.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(notification.Table, notification.FieldID, id),
-			sqlgraph.To(conversation.Table, conversation.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, notification.RelatedConversationTable, notification.RelatedConversationColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, session.UserTable, session.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *NotificationClient) Hooks() []Hook {
-	return c.hooks.Notification
+func (c *SessionClient) Hooks() []Hook {
+	return c.hooks.Session
 }
 
 // Interceptors returns the client interceptors.
-func (c *NotificationClient) Interceptors() []Interceptor {
-	return c.inters.Notification
+func (c *SessionClient) Interceptors() []Interceptor {
+	return c.inters.Session
 }
 
-func (c *NotificationClient) mutate(ctx context.Context, m *NotificationMutation) (Value, error) {
+func (c *SessionClient) mutate(ctx context.Context, m *SessionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Notification mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Session mutation op: %q", m.Op())
 	}
 }
 
-// SessionClient is a client for the Session schema.
-type SessionClient struct {
+// ThreadParticipantClient is a client for the ThreadParticipant schema.
+type ThreadParticipantClient struct {
 	config
 }
 
-// NewSessionClient returns a client for the Session from the given config.
-func NewSessionClient(c config) *SessionClient {
-	return &SessionClient{config: c}
+// NewThreadParticipantClient returns a client for the ThreadParticipant from the given config.
+func NewThreadParticipantClient(c config) *ThreadParticipantClient {
+	return &ThreadParticipantClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `session.Hooks(f(g(h())))`.
-func (c *SessionClient) Use(hooks ...Hook) {
-	c.hooks.Session = append(c.hooks.Session, hooks...)
+// A call to `Use(f, g, h)` equals to `threadparticipant.Hooks(f(g(h())))`.
+func (c *ThreadParticipantClient) Use(hooks ...Hook) {
+	c.hooks.ThreadParticipant = append(c.hooks.ThreadParticipant, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `session.Intercept(f(g(h())))`.
-func (c *SessionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Session = append(c.inters.Session, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `threadparticipant.Intercept(f(g(h())))`.
+func (c *ThreadParticipantClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ThreadParticipant = append(c.inters.ThreadParticipant, interceptors...)
 }
 
-// Create returns a builder for creating a Session entity.
-func (c *SessionClient) Create() *SessionCreate {
-	mutation := newSessionMutation(c.config, OpCreate)
-	return &SessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ThreadParticipant entity.
+func (c *ThreadParticipantClient) Create() *ThreadParticipantCreate {
+	mutation := newThreadParticipantMutation(c.config, OpCreate)
+	return &ThreadParticipantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Session entities.
-func (c *SessionClient) CreateBulk(builders ...*SessionCreate) *SessionCreateBulk {
-	return &SessionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ThreadParticipant entities.
+func (c *ThreadParticipantClient) CreateBulk(builders ...*ThreadParticipantCreate) *ThreadParticipantCreateBulk {
+	return &ThreadParticipantCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SessionClient) MapCreateBulk(slice any, setFunc func(*SessionCreate, int)) *SessionCreateBulk {
+func (c *ThreadParticipantClient) MapCreateBulk(slice any, setFunc func(*ThreadParticipantCreate, int)) *ThreadParticipantCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SessionCreateBulk{err: fmt.Errorf("calling to SessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ThreadParticipantCreateBulk{err: fmt.Errorf("calling to ThreadParticipantClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SessionCreate, rv.Len())
+	builders := make([]*ThreadParticipantCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SessionCreateBulk{config: c.config, builders: builders}
+	return &ThreadParticipantCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Session.
-func (c *SessionClient) Update() *SessionUpdate {
-	mutation := newSessionMutation(c.config, OpUpdate)
-	return &SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ThreadParticipant.
+func (c *ThreadParticipantClient) Update() *ThreadParticipantUpdate {
+	mutation := newThreadParticipantMutation(c.config, OpUpdate)
+	return &ThreadParticipantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SessionClient) UpdateOne(s *Session) *SessionUpdateOne {
-	mutation := newSessionMutation(c.config, OpUpdateOne, withSession(s))
-	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ThreadParticipantClient) UpdateOne(tp *ThreadParticipant) *ThreadParticipantUpdateOne {
+	mutation := newThreadParticipantMutation(c.config, OpUpdateOne, withThreadParticipant(tp))
+	return &ThreadParticipantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SessionClient) UpdateOneID(id string) *SessionUpdateOne {
-	mutation := newSessionMutation(c.config, OpUpdateOne, withSessionID(id))
-	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ThreadParticipantClient) UpdateOneID(id string) *ThreadParticipantUpdateOne {
+	mutation := newThreadParticipantMutation(c.config, OpUpdateOne, withThreadParticipantID(id))
+	return &ThreadParticipantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Session.
-func (c *SessionClient) Delete() *SessionDelete {
-	mutation := newSessionMutation(c.config, OpDelete)
-	return &SessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ThreadParticipant.
+func (c *ThreadParticipantClient) Delete() *ThreadParticipantDelete {
+	mutation := newThreadParticipantMutation(c.config, OpDelete)
+	return &ThreadParticipantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SessionClient) DeleteOne(s *Session) *SessionDeleteOne {
-	return c.DeleteOneID(s.ID)
+func (c *ThreadParticipantClient) DeleteOne(tp *ThreadParticipant) *ThreadParticipantDeleteOne {
+	return c.DeleteOneID(tp.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SessionClient) DeleteOneID(id string) *SessionDeleteOne {
-	builder := c.Delete().Where(session.ID(id))
+func (c *ThreadParticipantClient) DeleteOneID(id string) *ThreadParticipantDeleteOne {
+	builder := c.Delete().Where(threadparticipant.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SessionDeleteOne{builder}
+	return &ThreadParticipantDeleteOne{builder}
 }
 
-// Query returns a query builder for Session.
-func (c *SessionClient) Query() *SessionQuery {
-	return &SessionQuery{
+// Query returns a query builder for ThreadParticipant.
+func (c *ThreadParticipantClient) Query() *ThreadParticipantQuery {
+	return &ThreadParticipantQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSession},
+		ctx:    &QueryContext{Type: TypeThreadParticipant},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Session entity by its id.
-func (c *SessionClient) Get(ctx context.Context, id string) (*Session, error) {
-	return c.Query().Where(session.ID(id)).Only(ctx)
+// Get returns a ThreadParticipant entity by its id.
+func (c *ThreadParticipantClient) Get(ctx context.Context, id string) (*ThreadParticipant, error) {
+	return c.Query().Where(threadparticipant.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SessionClient) GetX(ctx context.Context, id string) *Session {
+func (c *ThreadParticipantClient) GetX(ctx context.Context, id string) *ThreadParticipant {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2102,44 +5214,60 @@ func (c *SessionClient) GetX(ctx context.Context, id string) *Session {
 	return obj
 }
 
-// QueryUser queries the user edge of a Session.
-func (c *SessionClient) QueryUser(s *Session) *UserQuery {
+// QueryRootMessage queries the root_message edge of a ThreadParticipant.
+func (c *ThreadParticipantClient) QueryRootMessage(tp *ThreadParticipant) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(threadparticipant.Table, threadparticipant.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, threadparticipant.RootMessageTable, threadparticipant.RootMessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(tp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a ThreadParticipant.
+func (c *ThreadParticipantClient) QueryUser(tp *ThreadParticipant) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := s.ID
+		id := tp.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(session.Table, session.FieldID, id),
+			sqlgraph.From(threadparticipant.Table, threadparticipant.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, session.UserTable, session.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, threadparticipant.UserTable, threadparticipant.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(tp.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *SessionClient) Hooks() []Hook {
-	return c.hooks.Session
+func (c *ThreadParticipantClient) Hooks() []Hook {
+	return c.hooks.ThreadParticipant
 }
 
 // Interceptors returns the client interceptors.
-func (c *SessionClient) Interceptors() []Interceptor {
-	return c.inters.Session
+func (c *ThreadParticipantClient) Interceptors() []Interceptor {
+	return c.inters.ThreadParticipant
 }
 
-func (c *SessionClient) mutate(ctx context.Context, m *SessionMutation) (Value, error) {
+func (c *ThreadParticipantClient) mutate(ctx context.Context, m *ThreadParticipantMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ThreadParticipantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ThreadParticipantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ThreadParticipantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ThreadParticipantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Session mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ThreadParticipant mutation op: %q", m.Op())
 	}
 }
 
@@ -2347,6 +5475,38 @@ func (c *UserClient) QueryFriendRequestsReceived(u *User) *FriendQuery {
 	return query
 }
 
+// QueryFriendInvites queries the friend_invites edge of a User.
+func (c *UserClient) QueryFriendInvites(u *User) *FriendInviteQuery {
+	query := (&FriendInviteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(friendinvite.Table, friendinvite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.FriendInvitesTable, user.FriendInvitesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFriendInviteUses queries the friend_invite_uses edge of a User.
+func (c *UserClient) QueryFriendInviteUses(u *User) *FriendInviteUseQuery {
+	query := (&FriendInviteUseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(friendinviteuse.Table, friendinviteuse.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.FriendInviteUsesTable, user.FriendInviteUsesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QuerySentMessages queries the sent_messages edge of a User.
 func (c *UserClient) QuerySentMessages(u *User) *MessageQuery {
 	query := (&MessageClient{config: c.config}).Query()
@@ -2363,6 +5523,198 @@ func (c *UserClient) QuerySentMessages(u *User) *MessageQuery {
 	return query
 }
 
+// QueryForwardedMessages queries the forwarded_messages edge of a User.
+func (c *UserClient) QueryForwardedMessages(u *User) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.ForwardedMessagesTable, user.ForwardedMessagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMessageRevisions queries the message_revisions edge of a User.
+func (c *UserClient) QueryMessageRevisions(u *User) *MessageRevisionQuery {
+	query := (&MessageRevisionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(messagerevision.Table, messagerevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.MessageRevisionsTable, user.MessageRevisionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMessageReactions queries the message_reactions edge of a User.
+func (c *UserClient) QueryMessageReactions(u *User) *MessageReactionQuery {
+	query := (&MessageReactionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(messagereaction.Table, messagereaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.MessageReactionsTable, user.MessageReactionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttachments queries the attachments edge of a User.
+func (c *UserClient) QueryAttachments(u *User) *AttachmentQuery {
+	query := (&AttachmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(attachment.Table, attachment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.AttachmentsTable, user.AttachmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMentions queries the mentions edge of a User.
+func (c *UserClient) QueryMentions(u *User) *MessageMentionQuery {
+	query := (&MessageMentionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(messagemention.Table, messagemention.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.MentionsTable, user.MentionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPinnedMessages queries the pinned_messages edge of a User.
+func (c *UserClient) QueryPinnedMessages(u *User) *PinnedMessageQuery {
+	query := (&PinnedMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(pinnedmessage.Table, pinnedmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.PinnedMessagesTable, user.PinnedMessagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMessageReceipts queries the message_receipts edge of a User.
+func (c *UserClient) QueryMessageReceipts(u *User) *MessageReceiptQuery {
+	query := (&MessageReceiptClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(messagereceipt.Table, messagereceipt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.MessageReceiptsTable, user.MessageReceiptsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryScheduledMessages queries the scheduled_messages edge of a User.
+func (c *UserClient) QueryScheduledMessages(u *User) *ScheduledMessageQuery {
+	query := (&ScheduledMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(scheduledmessage.Table, scheduledmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.ScheduledMessagesTable, user.ScheduledMessagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDrafts queries the drafts edge of a User.
+func (c *UserClient) QueryDrafts(u *User) *DraftQuery {
+	query := (&DraftClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(draft.Table, draft.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.DraftsTable, user.DraftsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBookmarks queries the bookmarks edge of a User.
+func (c *UserClient) QueryBookmarks(u *User) *BookmarkQuery {
+	query := (&BookmarkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(bookmark.Table, bookmark.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.BookmarksTable, user.BookmarksColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryHiddenMessages queries the hidden_messages edge of a User.
+func (c *UserClient) QueryHiddenMessages(u *User) *HiddenMessageQuery {
+	query := (&HiddenMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(hiddenmessage.Table, hiddenmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.HiddenMessagesTable, user.HiddenMessagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryConversationFolders queries the conversation_folders edge of a User.
+func (c *UserClient) QueryConversationFolders(u *User) *ConversationFolderQuery {
+	query := (&ConversationFolderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(conversationfolder.Table, conversationfolder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.ConversationFoldersTable, user.ConversationFoldersColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryNotifications queries the notifications edge of a User.
 func (c *UserClient) QueryNotifications(u *User) *NotificationQuery {
 	query := (&NotificationClient{config: c.config}).Query()
@@ -2411,6 +5763,22 @@ func (c *UserClient) QueryConversationParticipations(u *User) *ConversationParti
 	return query
 }
 
+// QueryThreadParticipations queries the thread_participations edge of a User.
+func (c *UserClient) QueryThreadParticipations(u *User) *ThreadParticipantQuery {
+	query := (&ThreadParticipantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(threadparticipant.Table, threadparticipant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.ThreadParticipationsTable, user.ThreadParticipationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryBlockedUsers queries the blocked_users edge of a User.
 func (c *UserClient) QueryBlockedUsers(u *User) *BlockQuery {
 	query := (&BlockClient{config: c.config}).Query()
@@ -2503,11 +5871,19 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Block, Call, Conversation, ConversationParticipant, Friend, Guild, Invitation,
-		Member, Message, Notification, Session, User []ent.Hook
+		Attachment, Block, Bookmark, Call, Conversation, ConversationFolder,
+		ConversationParticipant, Draft, FolderConversation, Friend, FriendInvite,
+		FriendInviteUse, Guild, HiddenMessage, Invitation, LinkPreview, Member,
+		Message, MessageMention, MessageReaction, MessageReceipt, MessageRevision,
+		Notification, PinnedMessage, ScheduledMessage, Session, ThreadParticipant,
+		User []ent.Hook
 	}
 	inters struct {
-		Block, Call, Conversation, ConversationParticipant, Friend, Guild, Invitation,
-		Member, Message, Notification, Session, User []ent.Interceptor
+		Attachment, Block, Bookmark, Call, Conversation, ConversationFolder,
+		ConversationParticipant, Draft, FolderConversation, Friend, FriendInvite,
+		FriendInviteUse, Guild, HiddenMessage, Invitation, LinkPreview, Member,
+		Message, MessageMention, MessageReaction, MessageReceipt, MessageRevision,
+		Notification, PinnedMessage, ScheduledMessage, Session, ThreadParticipant,
+		User []ent.Interceptor
 	}
 )
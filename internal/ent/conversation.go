@@ -25,8 +25,16 @@ type Conversation struct {
 	Type conversation.Type `json:"type,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// IconURL holds the value of the "icon_url" field.
+	IconURL string `json:"icon_url,omitempty"`
+	// Sorted participant pair for direct conversations, guarantees one conversation per pair
+	DirectKey string `json:"direct_key,omitempty"`
 	// LastMessageAt holds the value of the "last_message_at" field.
 	LastMessageAt time.Time `json:"last_message_at,omitempty"`
+	// Seconds after which messages disappear, 0 keeps messages
+	MessageTTL int `json:"message_ttl,omitempty"`
+	// When disappearing messages were turned on, only messages sent since then disappear
+	MessageTTLSetAt time.Time `json:"message_ttl_set_at,omitempty"`
 	// IsArchived holds the value of the "is_archived" field.
 	IsArchived bool `json:"is_archived,omitempty"`
 	// IsMuted holds the value of the "is_muted" field.
@@ -43,9 +51,17 @@ type ConversationEdges struct {
 	Messages []*Message `json:"messages,omitempty"`
 	// Participants holds the value of the participants edge.
 	Participants []*ConversationParticipant `json:"participants,omitempty"`
+	// Pins holds the value of the pins edge.
+	Pins []*PinnedMessage `json:"pins,omitempty"`
+	// ScheduledMessages holds the value of the scheduled_messages edge.
+	ScheduledMessages []*ScheduledMessage `json:"scheduled_messages,omitempty"`
+	// Drafts holds the value of the drafts edge.
+	Drafts []*Draft `json:"drafts,omitempty"`
+	// FolderEntries holds the value of the folder_entries edge.
+	FolderEntries []*FolderConversation `json:"folder_entries,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [6]bool
 }
 
 // MessagesOrErr returns the Messages value or an error if the edge
@@ -66,6 +82,42 @@ func (e ConversationEdges) ParticipantsOrErr() ([]*ConversationParticipant, erro
 	return nil, &NotLoadedError{edge: "participants"}
 }
 
+// PinsOrErr returns the Pins value or an error if the edge
+// was not loaded in eager-loading.
+func (e ConversationEdges) PinsOrErr() ([]*PinnedMessage, error) {
+	if e.loadedTypes[2] {
+		return e.Pins, nil
+	}
+	return nil, &NotLoadedError{edge: "pins"}
+}
+
+// ScheduledMessagesOrErr returns the ScheduledMessages value or an error if the edge
+// was not loaded in eager-loading.
+func (e ConversationEdges) ScheduledMessagesOrErr() ([]*ScheduledMessage, error) {
+	if e.loadedTypes[3] {
+		return e.ScheduledMessages, nil
+	}
+	return nil, &NotLoadedError{edge: "scheduled_messages"}
+}
+
+// DraftsOrErr returns the Drafts value or an error if the edge
+// was not loaded in eager-loading.
+func (e ConversationEdges) DraftsOrErr() ([]*Draft, error) {
+	if e.loadedTypes[4] {
+		return e.Drafts, nil
+	}
+	return nil, &NotLoadedError{edge: "drafts"}
+}
+
+// FolderEntriesOrErr returns the FolderEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e ConversationEdges) FolderEntriesOrErr() ([]*FolderConversation, error) {
+	if e.loadedTypes[5] {
+		return e.FolderEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "folder_entries"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Conversation) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -73,9 +125,11 @@ func (*Conversation) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case conversation.FieldIsArchived, conversation.FieldIsMuted:
 			values[i] = new(sql.NullBool)
-		case conversation.FieldID, conversation.FieldType, conversation.FieldName:
+		case conversation.FieldMessageTTL:
+			values[i] = new(sql.NullInt64)
+		case conversation.FieldID, conversation.FieldType, conversation.FieldName, conversation.FieldIconURL, conversation.FieldDirectKey:
 			values[i] = new(sql.NullString)
-		case conversation.FieldCreatedAt, conversation.FieldUpdatedAt, conversation.FieldLastMessageAt:
+		case conversation.FieldCreatedAt, conversation.FieldUpdatedAt, conversation.FieldLastMessageAt, conversation.FieldMessageTTLSetAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -122,12 +176,36 @@ func (c *Conversation) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.Name = value.String
 			}
+		case conversation.FieldIconURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field icon_url", values[i])
+			} else if value.Valid {
+				c.IconURL = value.String
+			}
+		case conversation.FieldDirectKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field direct_key", values[i])
+			} else if value.Valid {
+				c.DirectKey = value.String
+			}
 		case conversation.FieldLastMessageAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field last_message_at", values[i])
 			} else if value.Valid {
 				c.LastMessageAt = value.Time
 			}
+		case conversation.FieldMessageTTL:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field message_ttl", values[i])
+			} else if value.Valid {
+				c.MessageTTL = int(value.Int64)
+			}
+		case conversation.FieldMessageTTLSetAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field message_ttl_set_at", values[i])
+			} else if value.Valid {
+				c.MessageTTLSetAt = value.Time
+			}
 		case conversation.FieldIsArchived:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_archived", values[i])
@@ -163,6 +241,26 @@ func (c *Conversation) QueryParticipants() *ConversationParticipantQuery {
 	return NewConversationClient(c.config).QueryParticipants(c)
 }
 
+// QueryPins queries the "pins" edge of the Conversation entity.
+func (c *Conversation) QueryPins() *PinnedMessageQuery {
+	return NewConversationClient(c.config).QueryPins(c)
+}
+
+// QueryScheduledMessages queries the "scheduled_messages" edge of the Conversation entity.
+func (c *Conversation) QueryScheduledMessages() *ScheduledMessageQuery {
+	return NewConversationClient(c.config).QueryScheduledMessages(c)
+}
+
+// QueryDrafts queries the "drafts" edge of the Conversation entity.
+func (c *Conversation) QueryDrafts() *DraftQuery {
+	return NewConversationClient(c.config).QueryDrafts(c)
+}
+
+// QueryFolderEntries queries the "folder_entries" edge of the Conversation entity.
+func (c *Conversation) QueryFolderEntries() *FolderConversationQuery {
+	return NewConversationClient(c.config).QueryFolderEntries(c)
+}
+
 // Update returns a builder for updating this Conversation.
 // Note that you need to call Conversation.Unwrap() before calling this method if this Conversation
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -198,9 +296,21 @@ func (c *Conversation) String() string {
 	builder.WriteString("name=")
 	builder.WriteString(c.Name)
 	builder.WriteString(", ")
+	builder.WriteString("icon_url=")
+	builder.WriteString(c.IconURL)
+	builder.WriteString(", ")
+	builder.WriteString("direct_key=")
+	builder.WriteString(c.DirectKey)
+	builder.WriteString(", ")
 	builder.WriteString("last_message_at=")
 	builder.WriteString(c.LastMessageAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("message_ttl=")
+	builder.WriteString(fmt.Sprintf("%v", c.MessageTTL))
+	builder.WriteString(", ")
+	builder.WriteString("message_ttl_set_at=")
+	builder.WriteString(c.MessageTTLSetAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("is_archived=")
 	builder.WriteString(fmt.Sprintf("%v", c.IsArchived))
 	builder.WriteString(", ")
@@ -23,8 +23,16 @@ const (
 	FieldType = "type"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldIconURL holds the string denoting the icon_url field in the database.
+	FieldIconURL = "icon_url"
+	// FieldDirectKey holds the string denoting the direct_key field in the database.
+	FieldDirectKey = "direct_key"
 	// FieldLastMessageAt holds the string denoting the last_message_at field in the database.
 	FieldLastMessageAt = "last_message_at"
+	// FieldMessageTTL holds the string denoting the message_ttl field in the database.
+	FieldMessageTTL = "message_ttl"
+	// FieldMessageTTLSetAt holds the string denoting the message_ttl_set_at field in the database.
+	FieldMessageTTLSetAt = "message_ttl_set_at"
 	// FieldIsArchived holds the string denoting the is_archived field in the database.
 	FieldIsArchived = "is_archived"
 	// FieldIsMuted holds the string denoting the is_muted field in the database.
@@ -33,6 +41,14 @@ const (
 	EdgeMessages = "messages"
 	// EdgeParticipants holds the string denoting the participants edge name in mutations.
 	EdgeParticipants = "participants"
+	// EdgePins holds the string denoting the pins edge name in mutations.
+	EdgePins = "pins"
+	// EdgeScheduledMessages holds the string denoting the scheduled_messages edge name in mutations.
+	EdgeScheduledMessages = "scheduled_messages"
+	// EdgeDrafts holds the string denoting the drafts edge name in mutations.
+	EdgeDrafts = "drafts"
+	// EdgeFolderEntries holds the string denoting the folder_entries edge name in mutations.
+	EdgeFolderEntries = "folder_entries"
 	// Table holds the table name of the conversation in the database.
 	Table = "conversations"
 	// MessagesTable is the table that holds the messages relation/edge.
@@ -49,6 +65,34 @@ const (
 	ParticipantsInverseTable = "conversation_participants"
 	// ParticipantsColumn is the table column denoting the participants relation/edge.
 	ParticipantsColumn = "conversation_participants"
+	// PinsTable is the table that holds the pins relation/edge.
+	PinsTable = "pinned_messages"
+	// PinsInverseTable is the table name for the PinnedMessage entity.
+	// It exists in this package in order to avoid circular dependency with the "pinnedmessage" package.
+	PinsInverseTable = "pinned_messages"
+	// PinsColumn is the table column denoting the pins relation/edge.
+	PinsColumn = "conversation_id"
+	// ScheduledMessagesTable is the table that holds the scheduled_messages relation/edge.
+	ScheduledMessagesTable = "scheduled_messages"
+	// ScheduledMessagesInverseTable is the table name for the ScheduledMessage entity.
+	// It exists in this package in order to avoid circular dependency with the "scheduledmessage" package.
+	ScheduledMessagesInverseTable = "scheduled_messages"
+	// ScheduledMessagesColumn is the table column denoting the scheduled_messages relation/edge.
+	ScheduledMessagesColumn = "conversation_id"
+	// DraftsTable is the table that holds the drafts relation/edge.
+	DraftsTable = "drafts"
+	// DraftsInverseTable is the table name for the Draft entity.
+	// It exists in this package in order to avoid circular dependency with the "draft" package.
+	DraftsInverseTable = "drafts"
+	// DraftsColumn is the table column denoting the drafts relation/edge.
+	DraftsColumn = "conversation_id"
+	// FolderEntriesTable is the table that holds the folder_entries relation/edge.
+	FolderEntriesTable = "folder_conversations"
+	// FolderEntriesInverseTable is the table name for the FolderConversation entity.
+	// It exists in this package in order to avoid circular dependency with the "folderconversation" package.
+	FolderEntriesInverseTable = "folder_conversations"
+	// FolderEntriesColumn is the table column denoting the folder_entries relation/edge.
+	FolderEntriesColumn = "conversation_id"
 )
 
 // Columns holds all SQL columns for conversation fields.
@@ -58,7 +102,11 @@ var Columns = []string{
 	FieldUpdatedAt,
 	FieldType,
 	FieldName,
+	FieldIconURL,
+	FieldDirectKey,
 	FieldLastMessageAt,
+	FieldMessageTTL,
+	FieldMessageTTLSetAt,
 	FieldIsArchived,
 	FieldIsMuted,
 }
@@ -82,6 +130,10 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// DefaultMessageTTL holds the default value on creation for the "message_ttl" field.
+	DefaultMessageTTL int
+	// MessageTTLValidator is a validator for the "message_ttl" field. It is called by the builders before save.
+	MessageTTLValidator func(int) error
 	// DefaultIsArchived holds the default value on creation for the "is_archived" field.
 	DefaultIsArchived bool
 	// DefaultIsMuted holds the default value on creation for the "is_muted" field.
@@ -144,11 +196,31 @@ func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
 
+// ByIconURL orders the results by the icon_url field.
+func ByIconURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIconURL, opts...).ToFunc()
+}
+
+// ByDirectKey orders the results by the direct_key field.
+func ByDirectKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDirectKey, opts...).ToFunc()
+}
+
 // ByLastMessageAt orders the results by the last_message_at field.
 func ByLastMessageAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastMessageAt, opts...).ToFunc()
 }
 
+// ByMessageTTL orders the results by the message_ttl field.
+func ByMessageTTL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageTTL, opts...).ToFunc()
+}
+
+// ByMessageTTLSetAt orders the results by the message_ttl_set_at field.
+func ByMessageTTLSetAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageTTLSetAt, opts...).ToFunc()
+}
+
 // ByIsArchived orders the results by the is_archived field.
 func ByIsArchived(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsArchived, opts...).ToFunc()
@@ -186,6 +258,62 @@ func ByParticipants(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newParticipantsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByPinsCount orders the results by pins count.
+func ByPinsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPinsStep(), opts...)
+	}
+}
+
+// ByPins orders the results by pins terms.
+func ByPins(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPinsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByScheduledMessagesCount orders the results by scheduled_messages count.
+func ByScheduledMessagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newScheduledMessagesStep(), opts...)
+	}
+}
+
+// ByScheduledMessages orders the results by scheduled_messages terms.
+func ByScheduledMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newScheduledMessagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDraftsCount orders the results by drafts count.
+func ByDraftsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDraftsStep(), opts...)
+	}
+}
+
+// ByDrafts orders the results by drafts terms.
+func ByDrafts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDraftsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByFolderEntriesCount orders the results by folder_entries count.
+func ByFolderEntriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFolderEntriesStep(), opts...)
+	}
+}
+
+// ByFolderEntries orders the results by folder_entries terms.
+func ByFolderEntries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFolderEntriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newMessagesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -200,3 +328,31 @@ func newParticipantsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ParticipantsTable, ParticipantsColumn),
 	)
 }
+func newPinsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PinsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, PinsTable, PinsColumn),
+	)
+}
+func newScheduledMessagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ScheduledMessagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, ScheduledMessagesTable, ScheduledMessagesColumn),
+	)
+}
+func newDraftsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DraftsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, DraftsTable, DraftsColumn),
+	)
+}
+func newFolderEntriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FolderEntriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, FolderEntriesTable, FolderEntriesColumn),
+	)
+}
@@ -80,11 +80,31 @@ func Name(v string) predicate.Conversation {
 	return predicate.Conversation(sql.FieldEQ(FieldName, v))
 }
 
+// IconURL applies equality check predicate on the "icon_url" field. It's identical to IconURLEQ.
+func IconURL(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldIconURL, v))
+}
+
+// DirectKey applies equality check predicate on the "direct_key" field. It's identical to DirectKeyEQ.
+func DirectKey(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldDirectKey, v))
+}
+
 // LastMessageAt applies equality check predicate on the "last_message_at" field. It's identical to LastMessageAtEQ.
 func LastMessageAt(v time.Time) predicate.Conversation {
 	return predicate.Conversation(sql.FieldEQ(FieldLastMessageAt, v))
 }
 
+// MessageTTL applies equality check predicate on the "message_ttl" field. It's identical to MessageTTLEQ.
+func MessageTTL(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldMessageTTL, v))
+}
+
+// MessageTTLSetAt applies equality check predicate on the "message_ttl_set_at" field. It's identical to MessageTTLSetAtEQ.
+func MessageTTLSetAt(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldMessageTTLSetAt, v))
+}
+
 // IsArchived applies equality check predicate on the "is_archived" field. It's identical to IsArchivedEQ.
 func IsArchived(v bool) predicate.Conversation {
 	return predicate.Conversation(sql.FieldEQ(FieldIsArchived, v))
@@ -270,6 +290,156 @@ func NameContainsFold(v string) predicate.Conversation {
 	return predicate.Conversation(sql.FieldContainsFold(FieldName, v))
 }
 
+// IconURLEQ applies the EQ predicate on the "icon_url" field.
+func IconURLEQ(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldIconURL, v))
+}
+
+// IconURLNEQ applies the NEQ predicate on the "icon_url" field.
+func IconURLNEQ(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldIconURL, v))
+}
+
+// IconURLIn applies the In predicate on the "icon_url" field.
+func IconURLIn(vs ...string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldIconURL, vs...))
+}
+
+// IconURLNotIn applies the NotIn predicate on the "icon_url" field.
+func IconURLNotIn(vs ...string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldIconURL, vs...))
+}
+
+// IconURLGT applies the GT predicate on the "icon_url" field.
+func IconURLGT(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldIconURL, v))
+}
+
+// IconURLGTE applies the GTE predicate on the "icon_url" field.
+func IconURLGTE(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldIconURL, v))
+}
+
+// IconURLLT applies the LT predicate on the "icon_url" field.
+func IconURLLT(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldIconURL, v))
+}
+
+// IconURLLTE applies the LTE predicate on the "icon_url" field.
+func IconURLLTE(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldIconURL, v))
+}
+
+// IconURLContains applies the Contains predicate on the "icon_url" field.
+func IconURLContains(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldContains(FieldIconURL, v))
+}
+
+// IconURLHasPrefix applies the HasPrefix predicate on the "icon_url" field.
+func IconURLHasPrefix(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldHasPrefix(FieldIconURL, v))
+}
+
+// IconURLHasSuffix applies the HasSuffix predicate on the "icon_url" field.
+func IconURLHasSuffix(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldHasSuffix(FieldIconURL, v))
+}
+
+// IconURLIsNil applies the IsNil predicate on the "icon_url" field.
+func IconURLIsNil() predicate.Conversation {
+	return predicate.Conversation(sql.FieldIsNull(FieldIconURL))
+}
+
+// IconURLNotNil applies the NotNil predicate on the "icon_url" field.
+func IconURLNotNil() predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotNull(FieldIconURL))
+}
+
+// IconURLEqualFold applies the EqualFold predicate on the "icon_url" field.
+func IconURLEqualFold(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEqualFold(FieldIconURL, v))
+}
+
+// IconURLContainsFold applies the ContainsFold predicate on the "icon_url" field.
+func IconURLContainsFold(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldContainsFold(FieldIconURL, v))
+}
+
+// DirectKeyEQ applies the EQ predicate on the "direct_key" field.
+func DirectKeyEQ(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldDirectKey, v))
+}
+
+// DirectKeyNEQ applies the NEQ predicate on the "direct_key" field.
+func DirectKeyNEQ(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldDirectKey, v))
+}
+
+// DirectKeyIn applies the In predicate on the "direct_key" field.
+func DirectKeyIn(vs ...string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldDirectKey, vs...))
+}
+
+// DirectKeyNotIn applies the NotIn predicate on the "direct_key" field.
+func DirectKeyNotIn(vs ...string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldDirectKey, vs...))
+}
+
+// DirectKeyGT applies the GT predicate on the "direct_key" field.
+func DirectKeyGT(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldDirectKey, v))
+}
+
+// DirectKeyGTE applies the GTE predicate on the "direct_key" field.
+func DirectKeyGTE(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldDirectKey, v))
+}
+
+// DirectKeyLT applies the LT predicate on the "direct_key" field.
+func DirectKeyLT(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldDirectKey, v))
+}
+
+// DirectKeyLTE applies the LTE predicate on the "direct_key" field.
+func DirectKeyLTE(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldDirectKey, v))
+}
+
+// DirectKeyContains applies the Contains predicate on the "direct_key" field.
+func DirectKeyContains(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldContains(FieldDirectKey, v))
+}
+
+// DirectKeyHasPrefix applies the HasPrefix predicate on the "direct_key" field.
+func DirectKeyHasPrefix(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldHasPrefix(FieldDirectKey, v))
+}
+
+// DirectKeyHasSuffix applies the HasSuffix predicate on the "direct_key" field.
+func DirectKeyHasSuffix(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldHasSuffix(FieldDirectKey, v))
+}
+
+// DirectKeyIsNil applies the IsNil predicate on the "direct_key" field.
+func DirectKeyIsNil() predicate.Conversation {
+	return predicate.Conversation(sql.FieldIsNull(FieldDirectKey))
+}
+
+// DirectKeyNotNil applies the NotNil predicate on the "direct_key" field.
+func DirectKeyNotNil() predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotNull(FieldDirectKey))
+}
+
+// DirectKeyEqualFold applies the EqualFold predicate on the "direct_key" field.
+func DirectKeyEqualFold(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEqualFold(FieldDirectKey, v))
+}
+
+// DirectKeyContainsFold applies the ContainsFold predicate on the "direct_key" field.
+func DirectKeyContainsFold(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldContainsFold(FieldDirectKey, v))
+}
+
 // LastMessageAtEQ applies the EQ predicate on the "last_message_at" field.
 func LastMessageAtEQ(v time.Time) predicate.Conversation {
 	return predicate.Conversation(sql.FieldEQ(FieldLastMessageAt, v))
@@ -320,6 +490,96 @@ func LastMessageAtNotNil() predicate.Conversation {
 	return predicate.Conversation(sql.FieldNotNull(FieldLastMessageAt))
 }
 
+// MessageTTLEQ applies the EQ predicate on the "message_ttl" field.
+func MessageTTLEQ(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldMessageTTL, v))
+}
+
+// MessageTTLNEQ applies the NEQ predicate on the "message_ttl" field.
+func MessageTTLNEQ(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldMessageTTL, v))
+}
+
+// MessageTTLIn applies the In predicate on the "message_ttl" field.
+func MessageTTLIn(vs ...int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldMessageTTL, vs...))
+}
+
+// MessageTTLNotIn applies the NotIn predicate on the "message_ttl" field.
+func MessageTTLNotIn(vs ...int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldMessageTTL, vs...))
+}
+
+// MessageTTLGT applies the GT predicate on the "message_ttl" field.
+func MessageTTLGT(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldMessageTTL, v))
+}
+
+// MessageTTLGTE applies the GTE predicate on the "message_ttl" field.
+func MessageTTLGTE(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldMessageTTL, v))
+}
+
+// MessageTTLLT applies the LT predicate on the "message_ttl" field.
+func MessageTTLLT(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldMessageTTL, v))
+}
+
+// MessageTTLLTE applies the LTE predicate on the "message_ttl" field.
+func MessageTTLLTE(v int) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldMessageTTL, v))
+}
+
+// MessageTTLSetAtEQ applies the EQ predicate on the "message_ttl_set_at" field.
+func MessageTTLSetAtEQ(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldMessageTTLSetAt, v))
+}
+
+// MessageTTLSetAtNEQ applies the NEQ predicate on the "message_ttl_set_at" field.
+func MessageTTLSetAtNEQ(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldMessageTTLSetAt, v))
+}
+
+// MessageTTLSetAtIn applies the In predicate on the "message_ttl_set_at" field.
+func MessageTTLSetAtIn(vs ...time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldMessageTTLSetAt, vs...))
+}
+
+// MessageTTLSetAtNotIn applies the NotIn predicate on the "message_ttl_set_at" field.
+func MessageTTLSetAtNotIn(vs ...time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldMessageTTLSetAt, vs...))
+}
+
+// MessageTTLSetAtGT applies the GT predicate on the "message_ttl_set_at" field.
+func MessageTTLSetAtGT(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldMessageTTLSetAt, v))
+}
+
+// MessageTTLSetAtGTE applies the GTE predicate on the "message_ttl_set_at" field.
+func MessageTTLSetAtGTE(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldMessageTTLSetAt, v))
+}
+
+// MessageTTLSetAtLT applies the LT predicate on the "message_ttl_set_at" field.
+func MessageTTLSetAtLT(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldMessageTTLSetAt, v))
+}
+
+// MessageTTLSetAtLTE applies the LTE predicate on the "message_ttl_set_at" field.
+func MessageTTLSetAtLTE(v time.Time) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldMessageTTLSetAt, v))
+}
+
+// MessageTTLSetAtIsNil applies the IsNil predicate on the "message_ttl_set_at" field.
+func MessageTTLSetAtIsNil() predicate.Conversation {
+	return predicate.Conversation(sql.FieldIsNull(FieldMessageTTLSetAt))
+}
+
+// MessageTTLSetAtNotNil applies the NotNil predicate on the "message_ttl_set_at" field.
+func MessageTTLSetAtNotNil() predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotNull(FieldMessageTTLSetAt))
+}
+
 // IsArchivedEQ applies the EQ predicate on the "is_archived" field.
 func IsArchivedEQ(v bool) predicate.Conversation {
 	return predicate.Conversation(sql.FieldEQ(FieldIsArchived, v))
@@ -386,6 +646,98 @@ func HasParticipantsWith(preds ...predicate.ConversationParticipant) predicate.C
 	})
 }
 
+// HasPins applies the HasEdge predicate on the "pins" edge.
+func HasPins() predicate.Conversation {
+	return predicate.Conversation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, PinsTable, PinsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPinsWith applies the HasEdge predicate on the "pins" edge with a given conditions (other predicates).
+func HasPinsWith(preds ...predicate.PinnedMessage) predicate.Conversation {
+	return predicate.Conversation(func(s *sql.Selector) {
+		step := newPinsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasScheduledMessages applies the HasEdge predicate on the "scheduled_messages" edge.
+func HasScheduledMessages() predicate.Conversation {
+	return predicate.Conversation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, ScheduledMessagesTable, ScheduledMessagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasScheduledMessagesWith applies the HasEdge predicate on the "scheduled_messages" edge with a given conditions (other predicates).
+func HasScheduledMessagesWith(preds ...predicate.ScheduledMessage) predicate.Conversation {
+	return predicate.Conversation(func(s *sql.Selector) {
+		step := newScheduledMessagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDrafts applies the HasEdge predicate on the "drafts" edge.
+func HasDrafts() predicate.Conversation {
+	return predicate.Conversation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, DraftsTable, DraftsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDraftsWith applies the HasEdge predicate on the "drafts" edge with a given conditions (other predicates).
+func HasDraftsWith(preds ...predicate.Draft) predicate.Conversation {
+	return predicate.Conversation(func(s *sql.Selector) {
+		step := newDraftsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFolderEntries applies the HasEdge predicate on the "folder_entries" edge.
+func HasFolderEntries() predicate.Conversation {
+	return predicate.Conversation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, FolderEntriesTable, FolderEntriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFolderEntriesWith applies the HasEdge predicate on the "folder_entries" edge with a given conditions (other predicates).
+func HasFolderEntriesWith(preds ...predicate.FolderConversation) predicate.Conversation {
+	return predicate.Conversation(func(s *sql.Selector) {
+		step := newFolderEntriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Conversation) predicate.Conversation {
 	return predicate.Conversation(sql.AndPredicates(predicates...))
@@ -8,7 +8,11 @@ import (
 	"fmt"
 	"kakashi/chaos/internal/ent/conversation"
 	"kakashi/chaos/internal/ent/conversationparticipant"
+	"kakashi/chaos/internal/ent/draft"
+	"kakashi/chaos/internal/ent/folderconversation"
 	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/pinnedmessage"
+	"kakashi/chaos/internal/ent/scheduledmessage"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -78,6 +82,34 @@ func (cc *ConversationCreate) SetNillableName(s *string) *ConversationCreate {
 	return cc
 }
 
+// SetIconURL sets the "icon_url" field.
+func (cc *ConversationCreate) SetIconURL(s string) *ConversationCreate {
+	cc.mutation.SetIconURL(s)
+	return cc
+}
+
+// SetNillableIconURL sets the "icon_url" field if the given value is not nil.
+func (cc *ConversationCreate) SetNillableIconURL(s *string) *ConversationCreate {
+	if s != nil {
+		cc.SetIconURL(*s)
+	}
+	return cc
+}
+
+// SetDirectKey sets the "direct_key" field.
+func (cc *ConversationCreate) SetDirectKey(s string) *ConversationCreate {
+	cc.mutation.SetDirectKey(s)
+	return cc
+}
+
+// SetNillableDirectKey sets the "direct_key" field if the given value is not nil.
+func (cc *ConversationCreate) SetNillableDirectKey(s *string) *ConversationCreate {
+	if s != nil {
+		cc.SetDirectKey(*s)
+	}
+	return cc
+}
+
 // SetLastMessageAt sets the "last_message_at" field.
 func (cc *ConversationCreate) SetLastMessageAt(t time.Time) *ConversationCreate {
 	cc.mutation.SetLastMessageAt(t)
@@ -92,6 +124,34 @@ func (cc *ConversationCreate) SetNillableLastMessageAt(t *time.Time) *Conversati
 	return cc
 }
 
+// SetMessageTTL sets the "message_ttl" field.
+func (cc *ConversationCreate) SetMessageTTL(i int) *ConversationCreate {
+	cc.mutation.SetMessageTTL(i)
+	return cc
+}
+
+// SetNillableMessageTTL sets the "message_ttl" field if the given value is not nil.
+func (cc *ConversationCreate) SetNillableMessageTTL(i *int) *ConversationCreate {
+	if i != nil {
+		cc.SetMessageTTL(*i)
+	}
+	return cc
+}
+
+// SetMessageTTLSetAt sets the "message_ttl_set_at" field.
+func (cc *ConversationCreate) SetMessageTTLSetAt(t time.Time) *ConversationCreate {
+	cc.mutation.SetMessageTTLSetAt(t)
+	return cc
+}
+
+// SetNillableMessageTTLSetAt sets the "message_ttl_set_at" field if the given value is not nil.
+func (cc *ConversationCreate) SetNillableMessageTTLSetAt(t *time.Time) *ConversationCreate {
+	if t != nil {
+		cc.SetMessageTTLSetAt(*t)
+	}
+	return cc
+}
+
 // SetIsArchived sets the "is_archived" field.
 func (cc *ConversationCreate) SetIsArchived(b bool) *ConversationCreate {
 	cc.mutation.SetIsArchived(b)
@@ -164,6 +224,66 @@ func (cc *ConversationCreate) AddParticipants(c ...*ConversationParticipant) *Co
 	return cc.AddParticipantIDs(ids...)
 }
 
+// AddPinIDs adds the "pins" edge to the PinnedMessage entity by IDs.
+func (cc *ConversationCreate) AddPinIDs(ids ...string) *ConversationCreate {
+	cc.mutation.AddPinIDs(ids...)
+	return cc
+}
+
+// AddPins adds the "pins" edges to the PinnedMessage entity.
+func (cc *ConversationCreate) AddPins(p ...*PinnedMessage) *ConversationCreate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cc.AddPinIDs(ids...)
+}
+
+// AddScheduledMessageIDs adds the "scheduled_messages" edge to the ScheduledMessage entity by IDs.
+func (cc *ConversationCreate) AddScheduledMessageIDs(ids ...string) *ConversationCreate {
+	cc.mutation.AddScheduledMessageIDs(ids...)
+	return cc
+}
+
+// AddScheduledMessages adds the "scheduled_messages" edges to the ScheduledMessage entity.
+func (cc *ConversationCreate) AddScheduledMessages(s ...*ScheduledMessage) *ConversationCreate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return cc.AddScheduledMessageIDs(ids...)
+}
+
+// AddDraftIDs adds the "drafts" edge to the Draft entity by IDs.
+func (cc *ConversationCreate) AddDraftIDs(ids ...string) *ConversationCreate {
+	cc.mutation.AddDraftIDs(ids...)
+	return cc
+}
+
+// AddDrafts adds the "drafts" edges to the Draft entity.
+func (cc *ConversationCreate) AddDrafts(d ...*Draft) *ConversationCreate {
+	ids := make([]string, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return cc.AddDraftIDs(ids...)
+}
+
+// AddFolderEntryIDs adds the "folder_entries" edge to the FolderConversation entity by IDs.
+func (cc *ConversationCreate) AddFolderEntryIDs(ids ...string) *ConversationCreate {
+	cc.mutation.AddFolderEntryIDs(ids...)
+	return cc
+}
+
+// AddFolderEntries adds the "folder_entries" edges to the FolderConversation entity.
+func (cc *ConversationCreate) AddFolderEntries(f ...*FolderConversation) *ConversationCreate {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return cc.AddFolderEntryIDs(ids...)
+}
+
 // Mutation returns the ConversationMutation object of the builder.
 func (cc *ConversationCreate) Mutation() *ConversationMutation {
 	return cc.mutation
@@ -211,6 +331,10 @@ func (cc *ConversationCreate) defaults() {
 		v := conversation.DefaultType
 		cc.mutation.SetType(v)
 	}
+	if _, ok := cc.mutation.MessageTTL(); !ok {
+		v := conversation.DefaultMessageTTL
+		cc.mutation.SetMessageTTL(v)
+	}
 	if _, ok := cc.mutation.IsArchived(); !ok {
 		v := conversation.DefaultIsArchived
 		cc.mutation.SetIsArchived(v)
@@ -246,6 +370,14 @@ func (cc *ConversationCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Conversation.name": %w`, err)}
 		}
 	}
+	if _, ok := cc.mutation.MessageTTL(); !ok {
+		return &ValidationError{Name: "message_ttl", err: errors.New(`ent: missing required field "Conversation.message_ttl"`)}
+	}
+	if v, ok := cc.mutation.MessageTTL(); ok {
+		if err := conversation.MessageTTLValidator(v); err != nil {
+			return &ValidationError{Name: "message_ttl", err: fmt.Errorf(`ent: validator failed for field "Conversation.message_ttl": %w`, err)}
+		}
+	}
 	if _, ok := cc.mutation.IsArchived(); !ok {
 		return &ValidationError{Name: "is_archived", err: errors.New(`ent: missing required field "Conversation.is_archived"`)}
 	}
@@ -303,10 +435,26 @@ func (cc *ConversationCreate) createSpec() (*Conversation, *sqlgraph.CreateSpec)
 		_spec.SetField(conversation.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := cc.mutation.IconURL(); ok {
+		_spec.SetField(conversation.FieldIconURL, field.TypeString, value)
+		_node.IconURL = value
+	}
+	if value, ok := cc.mutation.DirectKey(); ok {
+		_spec.SetField(conversation.FieldDirectKey, field.TypeString, value)
+		_node.DirectKey = value
+	}
 	if value, ok := cc.mutation.LastMessageAt(); ok {
 		_spec.SetField(conversation.FieldLastMessageAt, field.TypeTime, value)
 		_node.LastMessageAt = value
 	}
+	if value, ok := cc.mutation.MessageTTL(); ok {
+		_spec.SetField(conversation.FieldMessageTTL, field.TypeInt, value)
+		_node.MessageTTL = value
+	}
+	if value, ok := cc.mutation.MessageTTLSetAt(); ok {
+		_spec.SetField(conversation.FieldMessageTTLSetAt, field.TypeTime, value)
+		_node.MessageTTLSetAt = value
+	}
 	if value, ok := cc.mutation.IsArchived(); ok {
 		_spec.SetField(conversation.FieldIsArchived, field.TypeBool, value)
 		_node.IsArchived = value
@@ -347,6 +495,70 @@ func (cc *ConversationCreate) createSpec() (*Conversation, *sqlgraph.CreateSpec)
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := cc.mutation.PinsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversation.PinsTable,
+			Columns: []string{conversation.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.ScheduledMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversation.ScheduledMessagesTable,
+			Columns: []string{conversation.ScheduledMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.DraftsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversation.DraftsTable,
+			Columns: []string{conversation.DraftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(draft.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.FolderEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversation.FolderEntriesTable,
+			Columns: []string{conversation.FolderEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folderconversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -8,8 +8,12 @@ import (
 	"fmt"
 	"kakashi/chaos/internal/ent/conversation"
 	"kakashi/chaos/internal/ent/conversationparticipant"
+	"kakashi/chaos/internal/ent/draft"
+	"kakashi/chaos/internal/ent/folderconversation"
 	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/pinnedmessage"
 	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/scheduledmessage"
 	"math"
 
 	"entgo.io/ent"
@@ -21,12 +25,16 @@ import (
 // ConversationQuery is the builder for querying Conversation entities.
 type ConversationQuery struct {
 	config
-	ctx              *QueryContext
-	order            []conversation.OrderOption
-	inters           []Interceptor
-	predicates       []predicate.Conversation
-	withMessages     *MessageQuery
-	withParticipants *ConversationParticipantQuery
+	ctx                   *QueryContext
+	order                 []conversation.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.Conversation
+	withMessages          *MessageQuery
+	withParticipants      *ConversationParticipantQuery
+	withPins              *PinnedMessageQuery
+	withScheduledMessages *ScheduledMessageQuery
+	withDrafts            *DraftQuery
+	withFolderEntries     *FolderConversationQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +115,94 @@ func (cq *ConversationQuery) QueryParticipants() *ConversationParticipantQuery {
 	return query
 }
 
+// QueryPins chains the current query on the "pins" edge.
+func (cq *ConversationQuery) QueryPins() *PinnedMessageQuery {
+	query := (&PinnedMessageClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(conversation.Table, conversation.FieldID, selector),
+			sqlgraph.To(pinnedmessage.Table, pinnedmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, conversation.PinsTable, conversation.PinsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryScheduledMessages chains the current query on the "scheduled_messages" edge.
+func (cq *ConversationQuery) QueryScheduledMessages() *ScheduledMessageQuery {
+	query := (&ScheduledMessageClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(conversation.Table, conversation.FieldID, selector),
+			sqlgraph.To(scheduledmessage.Table, scheduledmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, conversation.ScheduledMessagesTable, conversation.ScheduledMessagesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDrafts chains the current query on the "drafts" edge.
+func (cq *ConversationQuery) QueryDrafts() *DraftQuery {
+	query := (&DraftClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(conversation.Table, conversation.FieldID, selector),
+			sqlgraph.To(draft.Table, draft.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, conversation.DraftsTable, conversation.DraftsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryFolderEntries chains the current query on the "folder_entries" edge.
+func (cq *ConversationQuery) QueryFolderEntries() *FolderConversationQuery {
+	query := (&FolderConversationClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(conversation.Table, conversation.FieldID, selector),
+			sqlgraph.To(folderconversation.Table, folderconversation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, conversation.FolderEntriesTable, conversation.FolderEntriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Conversation entity from the query.
 // Returns a *NotFoundError when no Conversation was found.
 func (cq *ConversationQuery) First(ctx context.Context) (*Conversation, error) {
@@ -294,13 +390,17 @@ func (cq *ConversationQuery) Clone() *ConversationQuery {
 		return nil
 	}
 	return &ConversationQuery{
-		config:           cq.config,
-		ctx:              cq.ctx.Clone(),
-		order:            append([]conversation.OrderOption{}, cq.order...),
-		inters:           append([]Interceptor{}, cq.inters...),
-		predicates:       append([]predicate.Conversation{}, cq.predicates...),
-		withMessages:     cq.withMessages.Clone(),
-		withParticipants: cq.withParticipants.Clone(),
+		config:                cq.config,
+		ctx:                   cq.ctx.Clone(),
+		order:                 append([]conversation.OrderOption{}, cq.order...),
+		inters:                append([]Interceptor{}, cq.inters...),
+		predicates:            append([]predicate.Conversation{}, cq.predicates...),
+		withMessages:          cq.withMessages.Clone(),
+		withParticipants:      cq.withParticipants.Clone(),
+		withPins:              cq.withPins.Clone(),
+		withScheduledMessages: cq.withScheduledMessages.Clone(),
+		withDrafts:            cq.withDrafts.Clone(),
+		withFolderEntries:     cq.withFolderEntries.Clone(),
 		// clone intermediate query.
 		sql:  cq.sql.Clone(),
 		path: cq.path,
@@ -329,6 +429,50 @@ func (cq *ConversationQuery) WithParticipants(opts ...func(*ConversationParticip
 	return cq
 }
 
+// WithPins tells the query-builder to eager-load the nodes that are connected to
+// the "pins" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *ConversationQuery) WithPins(opts ...func(*PinnedMessageQuery)) *ConversationQuery {
+	query := (&PinnedMessageClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withPins = query
+	return cq
+}
+
+// WithScheduledMessages tells the query-builder to eager-load the nodes that are connected to
+// the "scheduled_messages" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *ConversationQuery) WithScheduledMessages(opts ...func(*ScheduledMessageQuery)) *ConversationQuery {
+	query := (&ScheduledMessageClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withScheduledMessages = query
+	return cq
+}
+
+// WithDrafts tells the query-builder to eager-load the nodes that are connected to
+// the "drafts" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *ConversationQuery) WithDrafts(opts ...func(*DraftQuery)) *ConversationQuery {
+	query := (&DraftClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withDrafts = query
+	return cq
+}
+
+// WithFolderEntries tells the query-builder to eager-load the nodes that are connected to
+// the "folder_entries" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *ConversationQuery) WithFolderEntries(opts ...func(*FolderConversationQuery)) *ConversationQuery {
+	query := (&FolderConversationClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withFolderEntries = query
+	return cq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +551,13 @@ func (cq *ConversationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 	var (
 		nodes       = []*Conversation{}
 		_spec       = cq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [6]bool{
 			cq.withMessages != nil,
 			cq.withParticipants != nil,
+			cq.withPins != nil,
+			cq.withScheduledMessages != nil,
+			cq.withDrafts != nil,
+			cq.withFolderEntries != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -446,6 +594,36 @@ func (cq *ConversationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	if query := cq.withPins; query != nil {
+		if err := cq.loadPins(ctx, query, nodes,
+			func(n *Conversation) { n.Edges.Pins = []*PinnedMessage{} },
+			func(n *Conversation, e *PinnedMessage) { n.Edges.Pins = append(n.Edges.Pins, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cq.withScheduledMessages; query != nil {
+		if err := cq.loadScheduledMessages(ctx, query, nodes,
+			func(n *Conversation) { n.Edges.ScheduledMessages = []*ScheduledMessage{} },
+			func(n *Conversation, e *ScheduledMessage) {
+				n.Edges.ScheduledMessages = append(n.Edges.ScheduledMessages, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := cq.withDrafts; query != nil {
+		if err := cq.loadDrafts(ctx, query, nodes,
+			func(n *Conversation) { n.Edges.Drafts = []*Draft{} },
+			func(n *Conversation, e *Draft) { n.Edges.Drafts = append(n.Edges.Drafts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cq.withFolderEntries; query != nil {
+		if err := cq.loadFolderEntries(ctx, query, nodes,
+			func(n *Conversation) { n.Edges.FolderEntries = []*FolderConversation{} },
+			func(n *Conversation, e *FolderConversation) { n.Edges.FolderEntries = append(n.Edges.FolderEntries, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -511,6 +689,126 @@ func (cq *ConversationQuery) loadParticipants(ctx context.Context, query *Conver
 	}
 	return nil
 }
+func (cq *ConversationQuery) loadPins(ctx context.Context, query *PinnedMessageQuery, nodes []*Conversation, init func(*Conversation), assign func(*Conversation, *PinnedMessage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Conversation)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(pinnedmessage.FieldConversationID)
+	}
+	query.Where(predicate.PinnedMessage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(conversation.PinsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ConversationID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "conversation_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (cq *ConversationQuery) loadScheduledMessages(ctx context.Context, query *ScheduledMessageQuery, nodes []*Conversation, init func(*Conversation), assign func(*Conversation, *ScheduledMessage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Conversation)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(scheduledmessage.FieldConversationID)
+	}
+	query.Where(predicate.ScheduledMessage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(conversation.ScheduledMessagesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ConversationID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "conversation_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (cq *ConversationQuery) loadDrafts(ctx context.Context, query *DraftQuery, nodes []*Conversation, init func(*Conversation), assign func(*Conversation, *Draft)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Conversation)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(draft.FieldConversationID)
+	}
+	query.Where(predicate.Draft(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(conversation.DraftsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ConversationID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "conversation_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (cq *ConversationQuery) loadFolderEntries(ctx context.Context, query *FolderConversationQuery, nodes []*Conversation, init func(*Conversation), assign func(*Conversation, *FolderConversation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Conversation)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(folderconversation.FieldConversationID)
+	}
+	query.Where(predicate.FolderConversation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(conversation.FolderEntriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ConversationID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "conversation_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (cq *ConversationQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := cq.querySpec()
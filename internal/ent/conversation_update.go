@@ -8,8 +8,12 @@ import (
 	"fmt"
 	"kakashi/chaos/internal/ent/conversation"
 	"kakashi/chaos/internal/ent/conversationparticipant"
+	"kakashi/chaos/internal/ent/draft"
+	"kakashi/chaos/internal/ent/folderconversation"
 	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/pinnedmessage"
 	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/scheduledmessage"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -84,6 +88,46 @@ func (cu *ConversationUpdate) ClearName() *ConversationUpdate {
 	return cu
 }
 
+// SetIconURL sets the "icon_url" field.
+func (cu *ConversationUpdate) SetIconURL(s string) *ConversationUpdate {
+	cu.mutation.SetIconURL(s)
+	return cu
+}
+
+// SetNillableIconURL sets the "icon_url" field if the given value is not nil.
+func (cu *ConversationUpdate) SetNillableIconURL(s *string) *ConversationUpdate {
+	if s != nil {
+		cu.SetIconURL(*s)
+	}
+	return cu
+}
+
+// ClearIconURL clears the value of the "icon_url" field.
+func (cu *ConversationUpdate) ClearIconURL() *ConversationUpdate {
+	cu.mutation.ClearIconURL()
+	return cu
+}
+
+// SetDirectKey sets the "direct_key" field.
+func (cu *ConversationUpdate) SetDirectKey(s string) *ConversationUpdate {
+	cu.mutation.SetDirectKey(s)
+	return cu
+}
+
+// SetNillableDirectKey sets the "direct_key" field if the given value is not nil.
+func (cu *ConversationUpdate) SetNillableDirectKey(s *string) *ConversationUpdate {
+	if s != nil {
+		cu.SetDirectKey(*s)
+	}
+	return cu
+}
+
+// ClearDirectKey clears the value of the "direct_key" field.
+func (cu *ConversationUpdate) ClearDirectKey() *ConversationUpdate {
+	cu.mutation.ClearDirectKey()
+	return cu
+}
+
 // SetLastMessageAt sets the "last_message_at" field.
 func (cu *ConversationUpdate) SetLastMessageAt(t time.Time) *ConversationUpdate {
 	cu.mutation.SetLastMessageAt(t)
@@ -104,6 +148,47 @@ func (cu *ConversationUpdate) ClearLastMessageAt() *ConversationUpdate {
 	return cu
 }
 
+// SetMessageTTL sets the "message_ttl" field.
+func (cu *ConversationUpdate) SetMessageTTL(i int) *ConversationUpdate {
+	cu.mutation.ResetMessageTTL()
+	cu.mutation.SetMessageTTL(i)
+	return cu
+}
+
+// SetNillableMessageTTL sets the "message_ttl" field if the given value is not nil.
+func (cu *ConversationUpdate) SetNillableMessageTTL(i *int) *ConversationUpdate {
+	if i != nil {
+		cu.SetMessageTTL(*i)
+	}
+	return cu
+}
+
+// AddMessageTTL adds i to the "message_ttl" field.
+func (cu *ConversationUpdate) AddMessageTTL(i int) *ConversationUpdate {
+	cu.mutation.AddMessageTTL(i)
+	return cu
+}
+
+// SetMessageTTLSetAt sets the "message_ttl_set_at" field.
+func (cu *ConversationUpdate) SetMessageTTLSetAt(t time.Time) *ConversationUpdate {
+	cu.mutation.SetMessageTTLSetAt(t)
+	return cu
+}
+
+// SetNillableMessageTTLSetAt sets the "message_ttl_set_at" field if the given value is not nil.
+func (cu *ConversationUpdate) SetNillableMessageTTLSetAt(t *time.Time) *ConversationUpdate {
+	if t != nil {
+		cu.SetMessageTTLSetAt(*t)
+	}
+	return cu
+}
+
+// ClearMessageTTLSetAt clears the value of the "message_ttl_set_at" field.
+func (cu *ConversationUpdate) ClearMessageTTLSetAt() *ConversationUpdate {
+	cu.mutation.ClearMessageTTLSetAt()
+	return cu
+}
+
 // SetIsArchived sets the "is_archived" field.
 func (cu *ConversationUpdate) SetIsArchived(b bool) *ConversationUpdate {
 	cu.mutation.SetIsArchived(b)
@@ -162,6 +247,66 @@ func (cu *ConversationUpdate) AddParticipants(c ...*ConversationParticipant) *Co
 	return cu.AddParticipantIDs(ids...)
 }
 
+// AddPinIDs adds the "pins" edge to the PinnedMessage entity by IDs.
+func (cu *ConversationUpdate) AddPinIDs(ids ...string) *ConversationUpdate {
+	cu.mutation.AddPinIDs(ids...)
+	return cu
+}
+
+// AddPins adds the "pins" edges to the PinnedMessage entity.
+func (cu *ConversationUpdate) AddPins(p ...*PinnedMessage) *ConversationUpdate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cu.AddPinIDs(ids...)
+}
+
+// AddScheduledMessageIDs adds the "scheduled_messages" edge to the ScheduledMessage entity by IDs.
+func (cu *ConversationUpdate) AddScheduledMessageIDs(ids ...string) *ConversationUpdate {
+	cu.mutation.AddScheduledMessageIDs(ids...)
+	return cu
+}
+
+// AddScheduledMessages adds the "scheduled_messages" edges to the ScheduledMessage entity.
+func (cu *ConversationUpdate) AddScheduledMessages(s ...*ScheduledMessage) *ConversationUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return cu.AddScheduledMessageIDs(ids...)
+}
+
+// AddDraftIDs adds the "drafts" edge to the Draft entity by IDs.
+func (cu *ConversationUpdate) AddDraftIDs(ids ...string) *ConversationUpdate {
+	cu.mutation.AddDraftIDs(ids...)
+	return cu
+}
+
+// AddDrafts adds the "drafts" edges to the Draft entity.
+func (cu *ConversationUpdate) AddDrafts(d ...*Draft) *ConversationUpdate {
+	ids := make([]string, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return cu.AddDraftIDs(ids...)
+}
+
+// AddFolderEntryIDs adds the "folder_entries" edge to the FolderConversation entity by IDs.
+func (cu *ConversationUpdate) AddFolderEntryIDs(ids ...string) *ConversationUpdate {
+	cu.mutation.AddFolderEntryIDs(ids...)
+	return cu
+}
+
+// AddFolderEntries adds the "folder_entries" edges to the FolderConversation entity.
+func (cu *ConversationUpdate) AddFolderEntries(f ...*FolderConversation) *ConversationUpdate {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return cu.AddFolderEntryIDs(ids...)
+}
+
 // Mutation returns the ConversationMutation object of the builder.
 func (cu *ConversationUpdate) Mutation() *ConversationMutation {
 	return cu.mutation
@@ -209,6 +354,90 @@ func (cu *ConversationUpdate) RemoveParticipants(c ...*ConversationParticipant)
 	return cu.RemoveParticipantIDs(ids...)
 }
 
+// ClearPins clears all "pins" edges to the PinnedMessage entity.
+func (cu *ConversationUpdate) ClearPins() *ConversationUpdate {
+	cu.mutation.ClearPins()
+	return cu
+}
+
+// RemovePinIDs removes the "pins" edge to PinnedMessage entities by IDs.
+func (cu *ConversationUpdate) RemovePinIDs(ids ...string) *ConversationUpdate {
+	cu.mutation.RemovePinIDs(ids...)
+	return cu
+}
+
+// RemovePins removes "pins" edges to PinnedMessage entities.
+func (cu *ConversationUpdate) RemovePins(p ...*PinnedMessage) *ConversationUpdate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cu.RemovePinIDs(ids...)
+}
+
+// ClearScheduledMessages clears all "scheduled_messages" edges to the ScheduledMessage entity.
+func (cu *ConversationUpdate) ClearScheduledMessages() *ConversationUpdate {
+	cu.mutation.ClearScheduledMessages()
+	return cu
+}
+
+// RemoveScheduledMessageIDs removes the "scheduled_messages" edge to ScheduledMessage entities by IDs.
+func (cu *ConversationUpdate) RemoveScheduledMessageIDs(ids ...string) *ConversationUpdate {
+	cu.mutation.RemoveScheduledMessageIDs(ids...)
+	return cu
+}
+
+// RemoveScheduledMessages removes "scheduled_messages" edges to ScheduledMessage entities.
+func (cu *ConversationUpdate) RemoveScheduledMessages(s ...*ScheduledMessage) *ConversationUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return cu.RemoveScheduledMessageIDs(ids...)
+}
+
+// ClearDrafts clears all "drafts" edges to the Draft entity.
+func (cu *ConversationUpdate) ClearDrafts() *ConversationUpdate {
+	cu.mutation.ClearDrafts()
+	return cu
+}
+
+// RemoveDraftIDs removes the "drafts" edge to Draft entities by IDs.
+func (cu *ConversationUpdate) RemoveDraftIDs(ids ...string) *ConversationUpdate {
+	cu.mutation.RemoveDraftIDs(ids...)
+	return cu
+}
+
+// RemoveDrafts removes "drafts" edges to Draft entities.
+func (cu *ConversationUpdate) RemoveDrafts(d ...*Draft) *ConversationUpdate {
+	ids := make([]string, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return cu.RemoveDraftIDs(ids...)
+}
+
+// ClearFolderEntries clears all "folder_entries" edges to the FolderConversation entity.
+func (cu *ConversationUpdate) ClearFolderEntries() *ConversationUpdate {
+	cu.mutation.ClearFolderEntries()
+	return cu
+}
+
+// RemoveFolderEntryIDs removes the "folder_entries" edge to FolderConversation entities by IDs.
+func (cu *ConversationUpdate) RemoveFolderEntryIDs(ids ...string) *ConversationUpdate {
+	cu.mutation.RemoveFolderEntryIDs(ids...)
+	return cu
+}
+
+// RemoveFolderEntries removes "folder_entries" edges to FolderConversation entities.
+func (cu *ConversationUpdate) RemoveFolderEntries(f ...*FolderConversation) *ConversationUpdate {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return cu.RemoveFolderEntryIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (cu *ConversationUpdate) Save(ctx context.Context) (int, error) {
 	cu.defaults()
@@ -257,6 +486,11 @@ func (cu *ConversationUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Conversation.name": %w`, err)}
 		}
 	}
+	if v, ok := cu.mutation.MessageTTL(); ok {
+		if err := conversation.MessageTTLValidator(v); err != nil {
+			return &ValidationError{Name: "message_ttl", err: fmt.Errorf(`ent: validator failed for field "Conversation.message_ttl": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -287,12 +521,36 @@ func (cu *ConversationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if cu.mutation.NameCleared() {
 		_spec.ClearField(conversation.FieldName, field.TypeString)
 	}
+	if value, ok := cu.mutation.IconURL(); ok {
+		_spec.SetField(conversation.FieldIconURL, field.TypeString, value)
+	}
+	if cu.mutation.IconURLCleared() {
+		_spec.ClearField(conversation.FieldIconURL, field.TypeString)
+	}
+	if value, ok := cu.mutation.DirectKey(); ok {
+		_spec.SetField(conversation.FieldDirectKey, field.TypeString, value)
+	}
+	if cu.mutation.DirectKeyCleared() {
+		_spec.ClearField(conversation.FieldDirectKey, field.TypeString)
+	}
 	if value, ok := cu.mutation.LastMessageAt(); ok {
 		_spec.SetField(conversation.FieldLastMessageAt, field.TypeTime, value)
 	}
 	if cu.mutation.LastMessageAtCleared() {
 		_spec.ClearField(conversation.FieldLastMessageAt, field.TypeTime)
 	}
+	if value, ok := cu.mutation.MessageTTL(); ok {
+		_spec.SetField(conversation.FieldMessageTTL, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedMessageTTL(); ok {
+		_spec.AddField(conversation.FieldMessageTTL, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.MessageTTLSetAt(); ok {
+		_spec.SetField(conversation.FieldMessageTTLSetAt, field.TypeTime, value)
+	}
+	if cu.mutation.MessageTTLSetAtCleared() {
+		_spec.ClearField(conversation.FieldMessageTTLSetAt, field.TypeTime)
+	}
 	if value, ok := cu.mutation.IsArchived(); ok {
 		_spec.SetField(conversation.FieldIsArchived, field.TypeBool, value)
 	}
@@ -389,6 +647,186 @@ func (cu *ConversationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cu.mutation.PinsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversation.PinsTable,
+			Columns: []string{conversation.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedPinsIDs(); len(nodes) > 0 && !cu.mutation.PinsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversation.PinsTable,
+			Columns: []string{conversation.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.PinsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversation.PinsTable,
+			Columns: []string{conversation.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.ScheduledMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversation.ScheduledMessagesTable,
+			Columns: []string{conversation.ScheduledMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedScheduledMessagesIDs(); len(nodes) > 0 && !cu.mutation.ScheduledMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversation.ScheduledMessagesTable,
+			Columns: []string{conversation.ScheduledMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.ScheduledMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversation.ScheduledMessagesTable,
+			Columns: []string{conversation.ScheduledMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.DraftsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversation.DraftsTable,
+			Columns: []string{conversation.DraftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(draft.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedDraftsIDs(); len(nodes) > 0 && !cu.mutation.DraftsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversation.DraftsTable,
+			Columns: []string{conversation.DraftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(draft.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.DraftsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversation.DraftsTable,
+			Columns: []string{conversation.DraftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(draft.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.FolderEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversation.FolderEntriesTable,
+			Columns: []string{conversation.FolderEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folderconversation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedFolderEntriesIDs(); len(nodes) > 0 && !cu.mutation.FolderEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversation.FolderEntriesTable,
+			Columns: []string{conversation.FolderEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folderconversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.FolderEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversation.FolderEntriesTable,
+			Columns: []string{conversation.FolderEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folderconversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{conversation.Label}
@@ -463,6 +901,46 @@ func (cuo *ConversationUpdateOne) ClearName() *ConversationUpdateOne {
 	return cuo
 }
 
+// SetIconURL sets the "icon_url" field.
+func (cuo *ConversationUpdateOne) SetIconURL(s string) *ConversationUpdateOne {
+	cuo.mutation.SetIconURL(s)
+	return cuo
+}
+
+// SetNillableIconURL sets the "icon_url" field if the given value is not nil.
+func (cuo *ConversationUpdateOne) SetNillableIconURL(s *string) *ConversationUpdateOne {
+	if s != nil {
+		cuo.SetIconURL(*s)
+	}
+	return cuo
+}
+
+// ClearIconURL clears the value of the "icon_url" field.
+func (cuo *ConversationUpdateOne) ClearIconURL() *ConversationUpdateOne {
+	cuo.mutation.ClearIconURL()
+	return cuo
+}
+
+// SetDirectKey sets the "direct_key" field.
+func (cuo *ConversationUpdateOne) SetDirectKey(s string) *ConversationUpdateOne {
+	cuo.mutation.SetDirectKey(s)
+	return cuo
+}
+
+// SetNillableDirectKey sets the "direct_key" field if the given value is not nil.
+func (cuo *ConversationUpdateOne) SetNillableDirectKey(s *string) *ConversationUpdateOne {
+	if s != nil {
+		cuo.SetDirectKey(*s)
+	}
+	return cuo
+}
+
+// ClearDirectKey clears the value of the "direct_key" field.
+func (cuo *ConversationUpdateOne) ClearDirectKey() *ConversationUpdateOne {
+	cuo.mutation.ClearDirectKey()
+	return cuo
+}
+
 // SetLastMessageAt sets the "last_message_at" field.
 func (cuo *ConversationUpdateOne) SetLastMessageAt(t time.Time) *ConversationUpdateOne {
 	cuo.mutation.SetLastMessageAt(t)
@@ -483,6 +961,47 @@ func (cuo *ConversationUpdateOne) ClearLastMessageAt() *ConversationUpdateOne {
 	return cuo
 }
 
+// SetMessageTTL sets the "message_ttl" field.
+func (cuo *ConversationUpdateOne) SetMessageTTL(i int) *ConversationUpdateOne {
+	cuo.mutation.ResetMessageTTL()
+	cuo.mutation.SetMessageTTL(i)
+	return cuo
+}
+
+// SetNillableMessageTTL sets the "message_ttl" field if the given value is not nil.
+func (cuo *ConversationUpdateOne) SetNillableMessageTTL(i *int) *ConversationUpdateOne {
+	if i != nil {
+		cuo.SetMessageTTL(*i)
+	}
+	return cuo
+}
+
+// AddMessageTTL adds i to the "message_ttl" field.
+func (cuo *ConversationUpdateOne) AddMessageTTL(i int) *ConversationUpdateOne {
+	cuo.mutation.AddMessageTTL(i)
+	return cuo
+}
+
+// SetMessageTTLSetAt sets the "message_ttl_set_at" field.
+func (cuo *ConversationUpdateOne) SetMessageTTLSetAt(t time.Time) *ConversationUpdateOne {
+	cuo.mutation.SetMessageTTLSetAt(t)
+	return cuo
+}
+
+// SetNillableMessageTTLSetAt sets the "message_ttl_set_at" field if the given value is not nil.
+func (cuo *ConversationUpdateOne) SetNillableMessageTTLSetAt(t *time.Time) *ConversationUpdateOne {
+	if t != nil {
+		cuo.SetMessageTTLSetAt(*t)
+	}
+	return cuo
+}
+
+// ClearMessageTTLSetAt clears the value of the "message_ttl_set_at" field.
+func (cuo *ConversationUpdateOne) ClearMessageTTLSetAt() *ConversationUpdateOne {
+	cuo.mutation.ClearMessageTTLSetAt()
+	return cuo
+}
+
 // SetIsArchived sets the "is_archived" field.
 func (cuo *ConversationUpdateOne) SetIsArchived(b bool) *ConversationUpdateOne {
 	cuo.mutation.SetIsArchived(b)
@@ -541,6 +1060,66 @@ func (cuo *ConversationUpdateOne) AddParticipants(c ...*ConversationParticipant)
 	return cuo.AddParticipantIDs(ids...)
 }
 
+// AddPinIDs adds the "pins" edge to the PinnedMessage entity by IDs.
+func (cuo *ConversationUpdateOne) AddPinIDs(ids ...string) *ConversationUpdateOne {
+	cuo.mutation.AddPinIDs(ids...)
+	return cuo
+}
+
+// AddPins adds the "pins" edges to the PinnedMessage entity.
+func (cuo *ConversationUpdateOne) AddPins(p ...*PinnedMessage) *ConversationUpdateOne {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cuo.AddPinIDs(ids...)
+}
+
+// AddScheduledMessageIDs adds the "scheduled_messages" edge to the ScheduledMessage entity by IDs.
+func (cuo *ConversationUpdateOne) AddScheduledMessageIDs(ids ...string) *ConversationUpdateOne {
+	cuo.mutation.AddScheduledMessageIDs(ids...)
+	return cuo
+}
+
+// AddScheduledMessages adds the "scheduled_messages" edges to the ScheduledMessage entity.
+func (cuo *ConversationUpdateOne) AddScheduledMessages(s ...*ScheduledMessage) *ConversationUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return cuo.AddScheduledMessageIDs(ids...)
+}
+
+// AddDraftIDs adds the "drafts" edge to the Draft entity by IDs.
+func (cuo *ConversationUpdateOne) AddDraftIDs(ids ...string) *ConversationUpdateOne {
+	cuo.mutation.AddDraftIDs(ids...)
+	return cuo
+}
+
+// AddDrafts adds the "drafts" edges to the Draft entity.
+func (cuo *ConversationUpdateOne) AddDrafts(d ...*Draft) *ConversationUpdateOne {
+	ids := make([]string, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return cuo.AddDraftIDs(ids...)
+}
+
+// AddFolderEntryIDs adds the "folder_entries" edge to the FolderConversation entity by IDs.
+func (cuo *ConversationUpdateOne) AddFolderEntryIDs(ids ...string) *ConversationUpdateOne {
+	cuo.mutation.AddFolderEntryIDs(ids...)
+	return cuo
+}
+
+// AddFolderEntries adds the "folder_entries" edges to the FolderConversation entity.
+func (cuo *ConversationUpdateOne) AddFolderEntries(f ...*FolderConversation) *ConversationUpdateOne {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return cuo.AddFolderEntryIDs(ids...)
+}
+
 // Mutation returns the ConversationMutation object of the builder.
 func (cuo *ConversationUpdateOne) Mutation() *ConversationMutation {
 	return cuo.mutation
@@ -588,6 +1167,90 @@ func (cuo *ConversationUpdateOne) RemoveParticipants(c ...*ConversationParticipa
 	return cuo.RemoveParticipantIDs(ids...)
 }
 
+// ClearPins clears all "pins" edges to the PinnedMessage entity.
+func (cuo *ConversationUpdateOne) ClearPins() *ConversationUpdateOne {
+	cuo.mutation.ClearPins()
+	return cuo
+}
+
+// RemovePinIDs removes the "pins" edge to PinnedMessage entities by IDs.
+func (cuo *ConversationUpdateOne) RemovePinIDs(ids ...string) *ConversationUpdateOne {
+	cuo.mutation.RemovePinIDs(ids...)
+	return cuo
+}
+
+// RemovePins removes "pins" edges to PinnedMessage entities.
+func (cuo *ConversationUpdateOne) RemovePins(p ...*PinnedMessage) *ConversationUpdateOne {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cuo.RemovePinIDs(ids...)
+}
+
+// ClearScheduledMessages clears all "scheduled_messages" edges to the ScheduledMessage entity.
+func (cuo *ConversationUpdateOne) ClearScheduledMessages() *ConversationUpdateOne {
+	cuo.mutation.ClearScheduledMessages()
+	return cuo
+}
+
+// RemoveScheduledMessageIDs removes the "scheduled_messages" edge to ScheduledMessage entities by IDs.
+func (cuo *ConversationUpdateOne) RemoveScheduledMessageIDs(ids ...string) *ConversationUpdateOne {
+	cuo.mutation.RemoveScheduledMessageIDs(ids...)
+	return cuo
+}
+
+// RemoveScheduledMessages removes "scheduled_messages" edges to ScheduledMessage entities.
+func (cuo *ConversationUpdateOne) RemoveScheduledMessages(s ...*ScheduledMessage) *ConversationUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return cuo.RemoveScheduledMessageIDs(ids...)
+}
+
+// ClearDrafts clears all "drafts" edges to the Draft entity.
+func (cuo *ConversationUpdateOne) ClearDrafts() *ConversationUpdateOne {
+	cuo.mutation.ClearDrafts()
+	return cuo
+}
+
+// RemoveDraftIDs removes the "drafts" edge to Draft entities by IDs.
+func (cuo *ConversationUpdateOne) RemoveDraftIDs(ids ...string) *ConversationUpdateOne {
+	cuo.mutation.RemoveDraftIDs(ids...)
+	return cuo
+}
+
+// RemoveDrafts removes "drafts" edges to Draft entities.
+func (cuo *ConversationUpdateOne) RemoveDrafts(d ...*Draft) *ConversationUpdateOne {
+	ids := make([]string, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return cuo.RemoveDraftIDs(ids...)
+}
+
+// ClearFolderEntries clears all "folder_entries" edges to the FolderConversation entity.
+func (cuo *ConversationUpdateOne) ClearFolderEntries() *ConversationUpdateOne {
+	cuo.mutation.ClearFolderEntries()
+	return cuo
+}
+
+// RemoveFolderEntryIDs removes the "folder_entries" edge to FolderConversation entities by IDs.
+func (cuo *ConversationUpdateOne) RemoveFolderEntryIDs(ids ...string) *ConversationUpdateOne {
+	cuo.mutation.RemoveFolderEntryIDs(ids...)
+	return cuo
+}
+
+// RemoveFolderEntries removes "folder_entries" edges to FolderConversation entities.
+func (cuo *ConversationUpdateOne) RemoveFolderEntries(f ...*FolderConversation) *ConversationUpdateOne {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return cuo.RemoveFolderEntryIDs(ids...)
+}
+
 // Where appends a list predicates to the ConversationUpdate builder.
 func (cuo *ConversationUpdateOne) Where(ps ...predicate.Conversation) *ConversationUpdateOne {
 	cuo.mutation.Where(ps...)
@@ -649,6 +1312,11 @@ func (cuo *ConversationUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Conversation.name": %w`, err)}
 		}
 	}
+	if v, ok := cuo.mutation.MessageTTL(); ok {
+		if err := conversation.MessageTTLValidator(v); err != nil {
+			return &ValidationError{Name: "message_ttl", err: fmt.Errorf(`ent: validator failed for field "Conversation.message_ttl": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -696,12 +1364,36 @@ func (cuo *ConversationUpdateOne) sqlSave(ctx context.Context) (_node *Conversat
 	if cuo.mutation.NameCleared() {
 		_spec.ClearField(conversation.FieldName, field.TypeString)
 	}
+	if value, ok := cuo.mutation.IconURL(); ok {
+		_spec.SetField(conversation.FieldIconURL, field.TypeString, value)
+	}
+	if cuo.mutation.IconURLCleared() {
+		_spec.ClearField(conversation.FieldIconURL, field.TypeString)
+	}
+	if value, ok := cuo.mutation.DirectKey(); ok {
+		_spec.SetField(conversation.FieldDirectKey, field.TypeString, value)
+	}
+	if cuo.mutation.DirectKeyCleared() {
+		_spec.ClearField(conversation.FieldDirectKey, field.TypeString)
+	}
 	if value, ok := cuo.mutation.LastMessageAt(); ok {
 		_spec.SetField(conversation.FieldLastMessageAt, field.TypeTime, value)
 	}
 	if cuo.mutation.LastMessageAtCleared() {
 		_spec.ClearField(conversation.FieldLastMessageAt, field.TypeTime)
 	}
+	if value, ok := cuo.mutation.MessageTTL(); ok {
+		_spec.SetField(conversation.FieldMessageTTL, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedMessageTTL(); ok {
+		_spec.AddField(conversation.FieldMessageTTL, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.MessageTTLSetAt(); ok {
+		_spec.SetField(conversation.FieldMessageTTLSetAt, field.TypeTime, value)
+	}
+	if cuo.mutation.MessageTTLSetAtCleared() {
+		_spec.ClearField(conversation.FieldMessageTTLSetAt, field.TypeTime)
+	}
 	if value, ok := cuo.mutation.IsArchived(); ok {
 		_spec.SetField(conversation.FieldIsArchived, field.TypeBool, value)
 	}
@@ -798,6 +1490,186 @@ func (cuo *ConversationUpdateOne) sqlSave(ctx context.Context) (_node *Conversat
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cuo.mutation.PinsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversation.PinsTable,
+			Columns: []string{conversation.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedPinsIDs(); len(nodes) > 0 && !cuo.mutation.PinsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversation.PinsTable,
+			Columns: []string{conversation.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.PinsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversation.PinsTable,
+			Columns: []string{conversation.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.ScheduledMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversation.ScheduledMessagesTable,
+			Columns: []string{conversation.ScheduledMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedScheduledMessagesIDs(); len(nodes) > 0 && !cuo.mutation.ScheduledMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversation.ScheduledMessagesTable,
+			Columns: []string{conversation.ScheduledMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.ScheduledMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversation.ScheduledMessagesTable,
+			Columns: []string{conversation.ScheduledMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.DraftsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversation.DraftsTable,
+			Columns: []string{conversation.DraftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(draft.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedDraftsIDs(); len(nodes) > 0 && !cuo.mutation.DraftsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversation.DraftsTable,
+			Columns: []string{conversation.DraftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(draft.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.DraftsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversation.DraftsTable,
+			Columns: []string{conversation.DraftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(draft.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.FolderEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversation.FolderEntriesTable,
+			Columns: []string{conversation.FolderEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folderconversation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedFolderEntriesIDs(); len(nodes) > 0 && !cuo.mutation.FolderEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversation.FolderEntriesTable,
+			Columns: []string{conversation.FolderEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folderconversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.FolderEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversation.FolderEntriesTable,
+			Columns: []string{conversation.FolderEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folderconversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Conversation{config: cuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"kakashi/chaos/internal/ent/conversationfolder"
+	"kakashi/chaos/internal/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ConversationFolder is the model entity for the ConversationFolder schema.
+type ConversationFolder struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Place of the folder in the user's folder list, lowest first
+	Position int `json:"position,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ConversationFolderQuery when eager-loading is set.
+	Edges        ConversationFolderEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ConversationFolderEdges holds the relations/edges for other nodes in the graph.
+type ConversationFolderEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Entries holds the value of the entries edge.
+	Entries []*FolderConversation `json:"entries,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ConversationFolderEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// EntriesOrErr returns the Entries value or an error if the edge
+// was not loaded in eager-loading.
+func (e ConversationFolderEdges) EntriesOrErr() ([]*FolderConversation, error) {
+	if e.loadedTypes[1] {
+		return e.Entries, nil
+	}
+	return nil, &NotLoadedError{edge: "entries"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ConversationFolder) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case conversationfolder.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case conversationfolder.FieldID, conversationfolder.FieldUserID, conversationfolder.FieldName:
+			values[i] = new(sql.NullString)
+		case conversationfolder.FieldCreatedAt, conversationfolder.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ConversationFolder fields.
+func (cf *ConversationFolder) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case conversationfolder.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				cf.ID = value.String
+			}
+		case conversationfolder.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cf.CreatedAt = value.Time
+			}
+		case conversationfolder.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				cf.UpdatedAt = value.Time
+			}
+		case conversationfolder.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				cf.UserID = value.String
+			}
+		case conversationfolder.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				cf.Name = value.String
+			}
+		case conversationfolder.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				cf.Position = int(value.Int64)
+			}
+		default:
+			cf.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ConversationFolder.
+// This includes values selected through modifiers, order, etc.
+func (cf *ConversationFolder) Value(name string) (ent.Value, error) {
+	return cf.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the ConversationFolder entity.
+func (cf *ConversationFolder) QueryUser() *UserQuery {
+	return NewConversationFolderClient(cf.config).QueryUser(cf)
+}
+
+// QueryEntries queries the "entries" edge of the ConversationFolder entity.
+func (cf *ConversationFolder) QueryEntries() *FolderConversationQuery {
+	return NewConversationFolderClient(cf.config).QueryEntries(cf)
+}
+
+// Update returns a builder for updating this ConversationFolder.
+// Note that you need to call ConversationFolder.Unwrap() before calling this method if this ConversationFolder
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cf *ConversationFolder) Update() *ConversationFolderUpdateOne {
+	return NewConversationFolderClient(cf.config).UpdateOne(cf)
+}
+
+// Unwrap unwraps the ConversationFolder entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cf *ConversationFolder) Unwrap() *ConversationFolder {
+	_tx, ok := cf.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ConversationFolder is not a transactional entity")
+	}
+	cf.config.driver = _tx.drv
+	return cf
+}
+
+// String implements the fmt.Stringer.
+func (cf *ConversationFolder) String() string {
+	var builder strings.Builder
+	builder.WriteString("ConversationFolder(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cf.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(cf.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(cf.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(cf.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(cf.Name)
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", cf.Position))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ConversationFolders is a parsable slice of ConversationFolder.
+type ConversationFolders []*ConversationFolder
@@ -0,0 +1,370 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/conversationfolder"
+	"kakashi/chaos/internal/ent/folderconversation"
+	"kakashi/chaos/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConversationFolderCreate is the builder for creating a ConversationFolder entity.
+type ConversationFolderCreate struct {
+	config
+	mutation *ConversationFolderMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cfc *ConversationFolderCreate) SetCreatedAt(t time.Time) *ConversationFolderCreate {
+	cfc.mutation.SetCreatedAt(t)
+	return cfc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cfc *ConversationFolderCreate) SetNillableCreatedAt(t *time.Time) *ConversationFolderCreate {
+	if t != nil {
+		cfc.SetCreatedAt(*t)
+	}
+	return cfc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cfc *ConversationFolderCreate) SetUpdatedAt(t time.Time) *ConversationFolderCreate {
+	cfc.mutation.SetUpdatedAt(t)
+	return cfc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cfc *ConversationFolderCreate) SetNillableUpdatedAt(t *time.Time) *ConversationFolderCreate {
+	if t != nil {
+		cfc.SetUpdatedAt(*t)
+	}
+	return cfc
+}
+
+// SetUserID sets the "user_id" field.
+func (cfc *ConversationFolderCreate) SetUserID(s string) *ConversationFolderCreate {
+	cfc.mutation.SetUserID(s)
+	return cfc
+}
+
+// SetName sets the "name" field.
+func (cfc *ConversationFolderCreate) SetName(s string) *ConversationFolderCreate {
+	cfc.mutation.SetName(s)
+	return cfc
+}
+
+// SetPosition sets the "position" field.
+func (cfc *ConversationFolderCreate) SetPosition(i int) *ConversationFolderCreate {
+	cfc.mutation.SetPosition(i)
+	return cfc
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (cfc *ConversationFolderCreate) SetNillablePosition(i *int) *ConversationFolderCreate {
+	if i != nil {
+		cfc.SetPosition(*i)
+	}
+	return cfc
+}
+
+// SetID sets the "id" field.
+func (cfc *ConversationFolderCreate) SetID(s string) *ConversationFolderCreate {
+	cfc.mutation.SetID(s)
+	return cfc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cfc *ConversationFolderCreate) SetNillableID(s *string) *ConversationFolderCreate {
+	if s != nil {
+		cfc.SetID(*s)
+	}
+	return cfc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (cfc *ConversationFolderCreate) SetUser(u *User) *ConversationFolderCreate {
+	return cfc.SetUserID(u.ID)
+}
+
+// AddEntryIDs adds the "entries" edge to the FolderConversation entity by IDs.
+func (cfc *ConversationFolderCreate) AddEntryIDs(ids ...string) *ConversationFolderCreate {
+	cfc.mutation.AddEntryIDs(ids...)
+	return cfc
+}
+
+// AddEntries adds the "entries" edges to the FolderConversation entity.
+func (cfc *ConversationFolderCreate) AddEntries(f ...*FolderConversation) *ConversationFolderCreate {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return cfc.AddEntryIDs(ids...)
+}
+
+// Mutation returns the ConversationFolderMutation object of the builder.
+func (cfc *ConversationFolderCreate) Mutation() *ConversationFolderMutation {
+	return cfc.mutation
+}
+
+// Save creates the ConversationFolder in the database.
+func (cfc *ConversationFolderCreate) Save(ctx context.Context) (*ConversationFolder, error) {
+	cfc.defaults()
+	return withHooks(ctx, cfc.sqlSave, cfc.mutation, cfc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cfc *ConversationFolderCreate) SaveX(ctx context.Context) *ConversationFolder {
+	v, err := cfc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cfc *ConversationFolderCreate) Exec(ctx context.Context) error {
+	_, err := cfc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfc *ConversationFolderCreate) ExecX(ctx context.Context) {
+	if err := cfc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cfc *ConversationFolderCreate) defaults() {
+	if _, ok := cfc.mutation.CreatedAt(); !ok {
+		v := conversationfolder.DefaultCreatedAt()
+		cfc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cfc.mutation.UpdatedAt(); !ok {
+		v := conversationfolder.DefaultUpdatedAt()
+		cfc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := cfc.mutation.Position(); !ok {
+		v := conversationfolder.DefaultPosition
+		cfc.mutation.SetPosition(v)
+	}
+	if _, ok := cfc.mutation.ID(); !ok {
+		v := conversationfolder.DefaultID()
+		cfc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cfc *ConversationFolderCreate) check() error {
+	if _, ok := cfc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ConversationFolder.created_at"`)}
+	}
+	if _, ok := cfc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ConversationFolder.updated_at"`)}
+	}
+	if _, ok := cfc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ConversationFolder.user_id"`)}
+	}
+	if v, ok := cfc.mutation.UserID(); ok {
+		if err := conversationfolder.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "ConversationFolder.user_id": %w`, err)}
+		}
+	}
+	if _, ok := cfc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ConversationFolder.name"`)}
+	}
+	if v, ok := cfc.mutation.Name(); ok {
+		if err := conversationfolder.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ConversationFolder.name": %w`, err)}
+		}
+	}
+	if _, ok := cfc.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "ConversationFolder.position"`)}
+	}
+	if v, ok := cfc.mutation.Position(); ok {
+		if err := conversationfolder.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "ConversationFolder.position": %w`, err)}
+		}
+	}
+	if len(cfc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ConversationFolder.user"`)}
+	}
+	return nil
+}
+
+func (cfc *ConversationFolderCreate) sqlSave(ctx context.Context) (*ConversationFolder, error) {
+	if err := cfc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cfc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cfc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ConversationFolder.ID type: %T", _spec.ID.Value)
+		}
+	}
+	cfc.mutation.id = &_node.ID
+	cfc.mutation.done = true
+	return _node, nil
+}
+
+func (cfc *ConversationFolderCreate) createSpec() (*ConversationFolder, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ConversationFolder{config: cfc.config}
+		_spec = sqlgraph.NewCreateSpec(conversationfolder.Table, sqlgraph.NewFieldSpec(conversationfolder.FieldID, field.TypeString))
+	)
+	if id, ok := cfc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := cfc.mutation.CreatedAt(); ok {
+		_spec.SetField(conversationfolder.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := cfc.mutation.UpdatedAt(); ok {
+		_spec.SetField(conversationfolder.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := cfc.mutation.Name(); ok {
+		_spec.SetField(conversationfolder.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := cfc.mutation.Position(); ok {
+		_spec.SetField(conversationfolder.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if nodes := cfc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   conversationfolder.UserTable,
+			Columns: []string{conversationfolder.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cfc.mutation.EntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversationfolder.EntriesTable,
+			Columns: []string{conversationfolder.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folderconversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ConversationFolderCreateBulk is the builder for creating many ConversationFolder entities in bulk.
+type ConversationFolderCreateBulk struct {
+	config
+	err      error
+	builders []*ConversationFolderCreate
+}
+
+// Save creates the ConversationFolder entities in the database.
+func (cfcb *ConversationFolderCreateBulk) Save(ctx context.Context) ([]*ConversationFolder, error) {
+	if cfcb.err != nil {
+		return nil, cfcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cfcb.builders))
+	nodes := make([]*ConversationFolder, len(cfcb.builders))
+	mutators := make([]Mutator, len(cfcb.builders))
+	for i := range cfcb.builders {
+		func(i int, root context.Context) {
+			builder := cfcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConversationFolderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cfcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cfcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cfcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cfcb *ConversationFolderCreateBulk) SaveX(ctx context.Context) []*ConversationFolder {
+	v, err := cfcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cfcb *ConversationFolderCreateBulk) Exec(ctx context.Context) error {
+	_, err := cfcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfcb *ConversationFolderCreateBulk) ExecX(ctx context.Context) {
+	if err := cfcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"kakashi/chaos/internal/ent/conversationfolder"
+	"kakashi/chaos/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConversationFolderDelete is the builder for deleting a ConversationFolder entity.
+type ConversationFolderDelete struct {
+	config
+	hooks    []Hook
+	mutation *ConversationFolderMutation
+}
+
+// Where appends a list predicates to the ConversationFolderDelete builder.
+func (cfd *ConversationFolderDelete) Where(ps ...predicate.ConversationFolder) *ConversationFolderDelete {
+	cfd.mutation.Where(ps...)
+	return cfd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cfd *ConversationFolderDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cfd.sqlExec, cfd.mutation, cfd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfd *ConversationFolderDelete) ExecX(ctx context.Context) int {
+	n, err := cfd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cfd *ConversationFolderDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(conversationfolder.Table, sqlgraph.NewFieldSpec(conversationfolder.FieldID, field.TypeString))
+	if ps := cfd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cfd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cfd.mutation.done = true
+	return affected, err
+}
+
+// ConversationFolderDeleteOne is the builder for deleting a single ConversationFolder entity.
+type ConversationFolderDeleteOne struct {
+	cfd *ConversationFolderDelete
+}
+
+// Where appends a list predicates to the ConversationFolderDelete builder.
+func (cfdo *ConversationFolderDeleteOne) Where(ps ...predicate.ConversationFolder) *ConversationFolderDeleteOne {
+	cfdo.cfd.mutation.Where(ps...)
+	return cfdo
+}
+
+// Exec executes the deletion query.
+func (cfdo *ConversationFolderDeleteOne) Exec(ctx context.Context) error {
+	n, err := cfdo.cfd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{conversationfolder.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfdo *ConversationFolderDeleteOne) ExecX(ctx context.Context) {
+	if err := cfdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
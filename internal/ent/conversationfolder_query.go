@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"kakashi/chaos/internal/ent/conversationfolder"
+	"kakashi/chaos/internal/ent/folderconversation"
+	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/user"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConversationFolderQuery is the builder for querying ConversationFolder entities.
+type ConversationFolderQuery struct {
+	config
+	ctx         *QueryContext
+	order       []conversationfolder.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.ConversationFolder
+	withUser    *UserQuery
+	withEntries *FolderConversationQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ConversationFolderQuery builder.
+func (cfq *ConversationFolderQuery) Where(ps ...predicate.ConversationFolder) *ConversationFolderQuery {
+	cfq.predicates = append(cfq.predicates, ps...)
+	return cfq
+}
+
+// Limit the number of records to be returned by this query.
+func (cfq *ConversationFolderQuery) Limit(limit int) *ConversationFolderQuery {
+	cfq.ctx.Limit = &limit
+	return cfq
+}
+
+// Offset to start from.
+func (cfq *ConversationFolderQuery) Offset(offset int) *ConversationFolderQuery {
+	cfq.ctx.Offset = &offset
+	return cfq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cfq *ConversationFolderQuery) Unique(unique bool) *ConversationFolderQuery {
+	cfq.ctx.Unique = &unique
+	return cfq
+}
+
+// Order specifies how the records should be ordered.
+func (cfq *ConversationFolderQuery) Order(o ...conversationfolder.OrderOption) *ConversationFolderQuery {
+	cfq.order = append(cfq.order, o...)
+	return cfq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (cfq *ConversationFolderQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: cfq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cfq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cfq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(conversationfolder.Table, conversationfolder.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, conversationfolder.UserTable, conversationfolder.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cfq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEntries chains the current query on the "entries" edge.
+func (cfq *ConversationFolderQuery) QueryEntries() *FolderConversationQuery {
+	query := (&FolderConversationClient{config: cfq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cfq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cfq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(conversationfolder.Table, conversationfolder.FieldID, selector),
+			sqlgraph.To(folderconversation.Table, folderconversation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, conversationfolder.EntriesTable, conversationfolder.EntriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cfq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ConversationFolder entity from the query.
+// Returns a *NotFoundError when no ConversationFolder was found.
+func (cfq *ConversationFolderQuery) First(ctx context.Context) (*ConversationFolder, error) {
+	nodes, err := cfq.Limit(1).All(setContextOp(ctx, cfq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{conversationfolder.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cfq *ConversationFolderQuery) FirstX(ctx context.Context) *ConversationFolder {
+	node, err := cfq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ConversationFolder ID from the query.
+// Returns a *NotFoundError when no ConversationFolder ID was found.
+func (cfq *ConversationFolderQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = cfq.Limit(1).IDs(setContextOp(ctx, cfq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{conversationfolder.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cfq *ConversationFolderQuery) FirstIDX(ctx context.Context) string {
+	id, err := cfq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ConversationFolder entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ConversationFolder entity is found.
+// Returns a *NotFoundError when no ConversationFolder entities are found.
+func (cfq *ConversationFolderQuery) Only(ctx context.Context) (*ConversationFolder, error) {
+	nodes, err := cfq.Limit(2).All(setContextOp(ctx, cfq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{conversationfolder.Label}
+	default:
+		return nil, &NotSingularError{conversationfolder.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cfq *ConversationFolderQuery) OnlyX(ctx context.Context) *ConversationFolder {
+	node, err := cfq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ConversationFolder ID in the query.
+// Returns a *NotSingularError when more than one ConversationFolder ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cfq *ConversationFolderQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = cfq.Limit(2).IDs(setContextOp(ctx, cfq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{conversationfolder.Label}
+	default:
+		err = &NotSingularError{conversationfolder.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cfq *ConversationFolderQuery) OnlyIDX(ctx context.Context) string {
+	id, err := cfq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ConversationFolders.
+func (cfq *ConversationFolderQuery) All(ctx context.Context) ([]*ConversationFolder, error) {
+	ctx = setContextOp(ctx, cfq.ctx, ent.OpQueryAll)
+	if err := cfq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ConversationFolder, *ConversationFolderQuery]()
+	return withInterceptors[[]*ConversationFolder](ctx, cfq, qr, cfq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cfq *ConversationFolderQuery) AllX(ctx context.Context) []*ConversationFolder {
+	nodes, err := cfq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ConversationFolder IDs.
+func (cfq *ConversationFolderQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if cfq.ctx.Unique == nil && cfq.path != nil {
+		cfq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cfq.ctx, ent.OpQueryIDs)
+	if err = cfq.Select(conversationfolder.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cfq *ConversationFolderQuery) IDsX(ctx context.Context) []string {
+	ids, err := cfq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cfq *ConversationFolderQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cfq.ctx, ent.OpQueryCount)
+	if err := cfq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cfq, querierCount[*ConversationFolderQuery](), cfq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cfq *ConversationFolderQuery) CountX(ctx context.Context) int {
+	count, err := cfq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cfq *ConversationFolderQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cfq.ctx, ent.OpQueryExist)
+	switch _, err := cfq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cfq *ConversationFolderQuery) ExistX(ctx context.Context) bool {
+	exist, err := cfq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ConversationFolderQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cfq *ConversationFolderQuery) Clone() *ConversationFolderQuery {
+	if cfq == nil {
+		return nil
+	}
+	return &ConversationFolderQuery{
+		config:      cfq.config,
+		ctx:         cfq.ctx.Clone(),
+		order:       append([]conversationfolder.OrderOption{}, cfq.order...),
+		inters:      append([]Interceptor{}, cfq.inters...),
+		predicates:  append([]predicate.ConversationFolder{}, cfq.predicates...),
+		withUser:    cfq.withUser.Clone(),
+		withEntries: cfq.withEntries.Clone(),
+		// clone intermediate query.
+		sql:  cfq.sql.Clone(),
+		path: cfq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (cfq *ConversationFolderQuery) WithUser(opts ...func(*UserQuery)) *ConversationFolderQuery {
+	query := (&UserClient{config: cfq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cfq.withUser = query
+	return cfq
+}
+
+// WithEntries tells the query-builder to eager-load the nodes that are connected to
+// the "entries" edge. The optional arguments are used to configure the query builder of the edge.
+func (cfq *ConversationFolderQuery) WithEntries(opts ...func(*FolderConversationQuery)) *ConversationFolderQuery {
+	query := (&FolderConversationClient{config: cfq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cfq.withEntries = query
+	return cfq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ConversationFolder.Query().
+//		GroupBy(conversationfolder.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (cfq *ConversationFolderQuery) GroupBy(field string, fields ...string) *ConversationFolderGroupBy {
+	cfq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ConversationFolderGroupBy{build: cfq}
+	grbuild.flds = &cfq.ctx.Fields
+	grbuild.label = conversationfolder.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.ConversationFolder.Query().
+//		Select(conversationfolder.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (cfq *ConversationFolderQuery) Select(fields ...string) *ConversationFolderSelect {
+	cfq.ctx.Fields = append(cfq.ctx.Fields, fields...)
+	sbuild := &ConversationFolderSelect{ConversationFolderQuery: cfq}
+	sbuild.label = conversationfolder.Label
+	sbuild.flds, sbuild.scan = &cfq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ConversationFolderSelect configured with the given aggregations.
+func (cfq *ConversationFolderQuery) Aggregate(fns ...AggregateFunc) *ConversationFolderSelect {
+	return cfq.Select().Aggregate(fns...)
+}
+
+func (cfq *ConversationFolderQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cfq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cfq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cfq.ctx.Fields {
+		if !conversationfolder.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cfq.path != nil {
+		prev, err := cfq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cfq.sql = prev
+	}
+	return nil
+}
+
+func (cfq *ConversationFolderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ConversationFolder, error) {
+	var (
+		nodes       = []*ConversationFolder{}
+		_spec       = cfq.querySpec()
+		loadedTypes = [2]bool{
+			cfq.withUser != nil,
+			cfq.withEntries != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ConversationFolder).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ConversationFolder{config: cfq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cfq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := cfq.withUser; query != nil {
+		if err := cfq.loadUser(ctx, query, nodes, nil,
+			func(n *ConversationFolder, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cfq.withEntries; query != nil {
+		if err := cfq.loadEntries(ctx, query, nodes,
+			func(n *ConversationFolder) { n.Edges.Entries = []*FolderConversation{} },
+			func(n *ConversationFolder, e *FolderConversation) { n.Edges.Entries = append(n.Edges.Entries, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (cfq *ConversationFolderQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*ConversationFolder, init func(*ConversationFolder), assign func(*ConversationFolder, *User)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*ConversationFolder)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (cfq *ConversationFolderQuery) loadEntries(ctx context.Context, query *FolderConversationQuery, nodes []*ConversationFolder, init func(*ConversationFolder), assign func(*ConversationFolder, *FolderConversation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*ConversationFolder)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(folderconversation.FieldFolderID)
+	}
+	query.Where(predicate.FolderConversation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(conversationfolder.EntriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.FolderID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "folder_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (cfq *ConversationFolderQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cfq.querySpec()
+	_spec.Node.Columns = cfq.ctx.Fields
+	if len(cfq.ctx.Fields) > 0 {
+		_spec.Unique = cfq.ctx.Unique != nil && *cfq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cfq.driver, _spec)
+}
+
+func (cfq *ConversationFolderQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(conversationfolder.Table, conversationfolder.Columns, sqlgraph.NewFieldSpec(conversationfolder.FieldID, field.TypeString))
+	_spec.From = cfq.sql
+	if unique := cfq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cfq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cfq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, conversationfolder.FieldID)
+		for i := range fields {
+			if fields[i] != conversationfolder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if cfq.withUser != nil {
+			_spec.Node.AddColumnOnce(conversationfolder.FieldUserID)
+		}
+	}
+	if ps := cfq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cfq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cfq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cfq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cfq *ConversationFolderQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cfq.driver.Dialect())
+	t1 := builder.Table(conversationfolder.Table)
+	columns := cfq.ctx.Fields
+	if len(columns) == 0 {
+		columns = conversationfolder.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cfq.sql != nil {
+		selector = cfq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cfq.ctx.Unique != nil && *cfq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range cfq.predicates {
+		p(selector)
+	}
+	for _, p := range cfq.order {
+		p(selector)
+	}
+	if offset := cfq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cfq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ConversationFolderGroupBy is the group-by builder for ConversationFolder entities.
+type ConversationFolderGroupBy struct {
+	selector
+	build *ConversationFolderQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cfgb *ConversationFolderGroupBy) Aggregate(fns ...AggregateFunc) *ConversationFolderGroupBy {
+	cfgb.fns = append(cfgb.fns, fns...)
+	return cfgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cfgb *ConversationFolderGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cfgb.build.ctx, ent.OpQueryGroupBy)
+	if err := cfgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ConversationFolderQuery, *ConversationFolderGroupBy](ctx, cfgb.build, cfgb, cfgb.build.inters, v)
+}
+
+func (cfgb *ConversationFolderGroupBy) sqlScan(ctx context.Context, root *ConversationFolderQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cfgb.fns))
+	for _, fn := range cfgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cfgb.flds)+len(cfgb.fns))
+		for _, f := range *cfgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cfgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cfgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ConversationFolderSelect is the builder for selecting fields of ConversationFolder entities.
+type ConversationFolderSelect struct {
+	*ConversationFolderQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cfs *ConversationFolderSelect) Aggregate(fns ...AggregateFunc) *ConversationFolderSelect {
+	cfs.fns = append(cfs.fns, fns...)
+	return cfs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cfs *ConversationFolderSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cfs.ctx, ent.OpQuerySelect)
+	if err := cfs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ConversationFolderQuery, *ConversationFolderSelect](ctx, cfs.ConversationFolderQuery, cfs, cfs.inters, v)
+}
+
+func (cfs *ConversationFolderSelect) sqlScan(ctx context.Context, root *ConversationFolderQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cfs.fns))
+	for _, fn := range cfs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cfs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cfs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,658 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/conversationfolder"
+	"kakashi/chaos/internal/ent/folderconversation"
+	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConversationFolderUpdate is the builder for updating ConversationFolder entities.
+type ConversationFolderUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConversationFolderMutation
+}
+
+// Where appends a list predicates to the ConversationFolderUpdate builder.
+func (cfu *ConversationFolderUpdate) Where(ps ...predicate.ConversationFolder) *ConversationFolderUpdate {
+	cfu.mutation.Where(ps...)
+	return cfu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cfu *ConversationFolderUpdate) SetCreatedAt(t time.Time) *ConversationFolderUpdate {
+	cfu.mutation.SetCreatedAt(t)
+	return cfu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cfu *ConversationFolderUpdate) SetNillableCreatedAt(t *time.Time) *ConversationFolderUpdate {
+	if t != nil {
+		cfu.SetCreatedAt(*t)
+	}
+	return cfu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cfu *ConversationFolderUpdate) SetUpdatedAt(t time.Time) *ConversationFolderUpdate {
+	cfu.mutation.SetUpdatedAt(t)
+	return cfu
+}
+
+// SetUserID sets the "user_id" field.
+func (cfu *ConversationFolderUpdate) SetUserID(s string) *ConversationFolderUpdate {
+	cfu.mutation.SetUserID(s)
+	return cfu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (cfu *ConversationFolderUpdate) SetNillableUserID(s *string) *ConversationFolderUpdate {
+	if s != nil {
+		cfu.SetUserID(*s)
+	}
+	return cfu
+}
+
+// SetName sets the "name" field.
+func (cfu *ConversationFolderUpdate) SetName(s string) *ConversationFolderUpdate {
+	cfu.mutation.SetName(s)
+	return cfu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cfu *ConversationFolderUpdate) SetNillableName(s *string) *ConversationFolderUpdate {
+	if s != nil {
+		cfu.SetName(*s)
+	}
+	return cfu
+}
+
+// SetPosition sets the "position" field.
+func (cfu *ConversationFolderUpdate) SetPosition(i int) *ConversationFolderUpdate {
+	cfu.mutation.ResetPosition()
+	cfu.mutation.SetPosition(i)
+	return cfu
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (cfu *ConversationFolderUpdate) SetNillablePosition(i *int) *ConversationFolderUpdate {
+	if i != nil {
+		cfu.SetPosition(*i)
+	}
+	return cfu
+}
+
+// AddPosition adds i to the "position" field.
+func (cfu *ConversationFolderUpdate) AddPosition(i int) *ConversationFolderUpdate {
+	cfu.mutation.AddPosition(i)
+	return cfu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (cfu *ConversationFolderUpdate) SetUser(u *User) *ConversationFolderUpdate {
+	return cfu.SetUserID(u.ID)
+}
+
+// AddEntryIDs adds the "entries" edge to the FolderConversation entity by IDs.
+func (cfu *ConversationFolderUpdate) AddEntryIDs(ids ...string) *ConversationFolderUpdate {
+	cfu.mutation.AddEntryIDs(ids...)
+	return cfu
+}
+
+// AddEntries adds the "entries" edges to the FolderConversation entity.
+func (cfu *ConversationFolderUpdate) AddEntries(f ...*FolderConversation) *ConversationFolderUpdate {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return cfu.AddEntryIDs(ids...)
+}
+
+// Mutation returns the ConversationFolderMutation object of the builder.
+func (cfu *ConversationFolderUpdate) Mutation() *ConversationFolderMutation {
+	return cfu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (cfu *ConversationFolderUpdate) ClearUser() *ConversationFolderUpdate {
+	cfu.mutation.ClearUser()
+	return cfu
+}
+
+// ClearEntries clears all "entries" edges to the FolderConversation entity.
+func (cfu *ConversationFolderUpdate) ClearEntries() *ConversationFolderUpdate {
+	cfu.mutation.ClearEntries()
+	return cfu
+}
+
+// RemoveEntryIDs removes the "entries" edge to FolderConversation entities by IDs.
+func (cfu *ConversationFolderUpdate) RemoveEntryIDs(ids ...string) *ConversationFolderUpdate {
+	cfu.mutation.RemoveEntryIDs(ids...)
+	return cfu
+}
+
+// RemoveEntries removes "entries" edges to FolderConversation entities.
+func (cfu *ConversationFolderUpdate) RemoveEntries(f ...*FolderConversation) *ConversationFolderUpdate {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return cfu.RemoveEntryIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cfu *ConversationFolderUpdate) Save(ctx context.Context) (int, error) {
+	cfu.defaults()
+	return withHooks(ctx, cfu.sqlSave, cfu.mutation, cfu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cfu *ConversationFolderUpdate) SaveX(ctx context.Context) int {
+	affected, err := cfu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cfu *ConversationFolderUpdate) Exec(ctx context.Context) error {
+	_, err := cfu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfu *ConversationFolderUpdate) ExecX(ctx context.Context) {
+	if err := cfu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cfu *ConversationFolderUpdate) defaults() {
+	if _, ok := cfu.mutation.UpdatedAt(); !ok {
+		v := conversationfolder.UpdateDefaultUpdatedAt()
+		cfu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cfu *ConversationFolderUpdate) check() error {
+	if v, ok := cfu.mutation.UserID(); ok {
+		if err := conversationfolder.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "ConversationFolder.user_id": %w`, err)}
+		}
+	}
+	if v, ok := cfu.mutation.Name(); ok {
+		if err := conversationfolder.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ConversationFolder.name": %w`, err)}
+		}
+	}
+	if v, ok := cfu.mutation.Position(); ok {
+		if err := conversationfolder.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "ConversationFolder.position": %w`, err)}
+		}
+	}
+	if cfu.mutation.UserCleared() && len(cfu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ConversationFolder.user"`)
+	}
+	return nil
+}
+
+func (cfu *ConversationFolderUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cfu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(conversationfolder.Table, conversationfolder.Columns, sqlgraph.NewFieldSpec(conversationfolder.FieldID, field.TypeString))
+	if ps := cfu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cfu.mutation.CreatedAt(); ok {
+		_spec.SetField(conversationfolder.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := cfu.mutation.UpdatedAt(); ok {
+		_spec.SetField(conversationfolder.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cfu.mutation.Name(); ok {
+		_spec.SetField(conversationfolder.FieldName, field.TypeString, value)
+	}
+	if value, ok := cfu.mutation.Position(); ok {
+		_spec.SetField(conversationfolder.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := cfu.mutation.AddedPosition(); ok {
+		_spec.AddField(conversationfolder.FieldPosition, field.TypeInt, value)
+	}
+	if cfu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   conversationfolder.UserTable,
+			Columns: []string{conversationfolder.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cfu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   conversationfolder.UserTable,
+			Columns: []string{conversationfolder.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cfu.mutation.EntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversationfolder.EntriesTable,
+			Columns: []string{conversationfolder.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folderconversation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cfu.mutation.RemovedEntriesIDs(); len(nodes) > 0 && !cfu.mutation.EntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversationfolder.EntriesTable,
+			Columns: []string{conversationfolder.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folderconversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cfu.mutation.EntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversationfolder.EntriesTable,
+			Columns: []string{conversationfolder.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folderconversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cfu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{conversationfolder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cfu.mutation.done = true
+	return n, nil
+}
+
+// ConversationFolderUpdateOne is the builder for updating a single ConversationFolder entity.
+type ConversationFolderUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConversationFolderMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cfuo *ConversationFolderUpdateOne) SetCreatedAt(t time.Time) *ConversationFolderUpdateOne {
+	cfuo.mutation.SetCreatedAt(t)
+	return cfuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cfuo *ConversationFolderUpdateOne) SetNillableCreatedAt(t *time.Time) *ConversationFolderUpdateOne {
+	if t != nil {
+		cfuo.SetCreatedAt(*t)
+	}
+	return cfuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cfuo *ConversationFolderUpdateOne) SetUpdatedAt(t time.Time) *ConversationFolderUpdateOne {
+	cfuo.mutation.SetUpdatedAt(t)
+	return cfuo
+}
+
+// SetUserID sets the "user_id" field.
+func (cfuo *ConversationFolderUpdateOne) SetUserID(s string) *ConversationFolderUpdateOne {
+	cfuo.mutation.SetUserID(s)
+	return cfuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (cfuo *ConversationFolderUpdateOne) SetNillableUserID(s *string) *ConversationFolderUpdateOne {
+	if s != nil {
+		cfuo.SetUserID(*s)
+	}
+	return cfuo
+}
+
+// SetName sets the "name" field.
+func (cfuo *ConversationFolderUpdateOne) SetName(s string) *ConversationFolderUpdateOne {
+	cfuo.mutation.SetName(s)
+	return cfuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cfuo *ConversationFolderUpdateOne) SetNillableName(s *string) *ConversationFolderUpdateOne {
+	if s != nil {
+		cfuo.SetName(*s)
+	}
+	return cfuo
+}
+
+// SetPosition sets the "position" field.
+func (cfuo *ConversationFolderUpdateOne) SetPosition(i int) *ConversationFolderUpdateOne {
+	cfuo.mutation.ResetPosition()
+	cfuo.mutation.SetPosition(i)
+	return cfuo
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (cfuo *ConversationFolderUpdateOne) SetNillablePosition(i *int) *ConversationFolderUpdateOne {
+	if i != nil {
+		cfuo.SetPosition(*i)
+	}
+	return cfuo
+}
+
+// AddPosition adds i to the "position" field.
+func (cfuo *ConversationFolderUpdateOne) AddPosition(i int) *ConversationFolderUpdateOne {
+	cfuo.mutation.AddPosition(i)
+	return cfuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (cfuo *ConversationFolderUpdateOne) SetUser(u *User) *ConversationFolderUpdateOne {
+	return cfuo.SetUserID(u.ID)
+}
+
+// AddEntryIDs adds the "entries" edge to the FolderConversation entity by IDs.
+func (cfuo *ConversationFolderUpdateOne) AddEntryIDs(ids ...string) *ConversationFolderUpdateOne {
+	cfuo.mutation.AddEntryIDs(ids...)
+	return cfuo
+}
+
+// AddEntries adds the "entries" edges to the FolderConversation entity.
+func (cfuo *ConversationFolderUpdateOne) AddEntries(f ...*FolderConversation) *ConversationFolderUpdateOne {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return cfuo.AddEntryIDs(ids...)
+}
+
+// Mutation returns the ConversationFolderMutation object of the builder.
+func (cfuo *ConversationFolderUpdateOne) Mutation() *ConversationFolderMutation {
+	return cfuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (cfuo *ConversationFolderUpdateOne) ClearUser() *ConversationFolderUpdateOne {
+	cfuo.mutation.ClearUser()
+	return cfuo
+}
+
+// ClearEntries clears all "entries" edges to the FolderConversation entity.
+func (cfuo *ConversationFolderUpdateOne) ClearEntries() *ConversationFolderUpdateOne {
+	cfuo.mutation.ClearEntries()
+	return cfuo
+}
+
+// RemoveEntryIDs removes the "entries" edge to FolderConversation entities by IDs.
+func (cfuo *ConversationFolderUpdateOne) RemoveEntryIDs(ids ...string) *ConversationFolderUpdateOne {
+	cfuo.mutation.RemoveEntryIDs(ids...)
+	return cfuo
+}
+
+// RemoveEntries removes "entries" edges to FolderConversation entities.
+func (cfuo *ConversationFolderUpdateOne) RemoveEntries(f ...*FolderConversation) *ConversationFolderUpdateOne {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return cfuo.RemoveEntryIDs(ids...)
+}
+
+// Where appends a list predicates to the ConversationFolderUpdate builder.
+func (cfuo *ConversationFolderUpdateOne) Where(ps ...predicate.ConversationFolder) *ConversationFolderUpdateOne {
+	cfuo.mutation.Where(ps...)
+	return cfuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cfuo *ConversationFolderUpdateOne) Select(field string, fields ...string) *ConversationFolderUpdateOne {
+	cfuo.fields = append([]string{field}, fields...)
+	return cfuo
+}
+
+// Save executes the query and returns the updated ConversationFolder entity.
+func (cfuo *ConversationFolderUpdateOne) Save(ctx context.Context) (*ConversationFolder, error) {
+	cfuo.defaults()
+	return withHooks(ctx, cfuo.sqlSave, cfuo.mutation, cfuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cfuo *ConversationFolderUpdateOne) SaveX(ctx context.Context) *ConversationFolder {
+	node, err := cfuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cfuo *ConversationFolderUpdateOne) Exec(ctx context.Context) error {
+	_, err := cfuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfuo *ConversationFolderUpdateOne) ExecX(ctx context.Context) {
+	if err := cfuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cfuo *ConversationFolderUpdateOne) defaults() {
+	if _, ok := cfuo.mutation.UpdatedAt(); !ok {
+		v := conversationfolder.UpdateDefaultUpdatedAt()
+		cfuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cfuo *ConversationFolderUpdateOne) check() error {
+	if v, ok := cfuo.mutation.UserID(); ok {
+		if err := conversationfolder.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "ConversationFolder.user_id": %w`, err)}
+		}
+	}
+	if v, ok := cfuo.mutation.Name(); ok {
+		if err := conversationfolder.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ConversationFolder.name": %w`, err)}
+		}
+	}
+	if v, ok := cfuo.mutation.Position(); ok {
+		if err := conversationfolder.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "ConversationFolder.position": %w`, err)}
+		}
+	}
+	if cfuo.mutation.UserCleared() && len(cfuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ConversationFolder.user"`)
+	}
+	return nil
+}
+
+func (cfuo *ConversationFolderUpdateOne) sqlSave(ctx context.Context) (_node *ConversationFolder, err error) {
+	if err := cfuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(conversationfolder.Table, conversationfolder.Columns, sqlgraph.NewFieldSpec(conversationfolder.FieldID, field.TypeString))
+	id, ok := cfuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ConversationFolder.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cfuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, conversationfolder.FieldID)
+		for _, f := range fields {
+			if !conversationfolder.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != conversationfolder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cfuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cfuo.mutation.CreatedAt(); ok {
+		_spec.SetField(conversationfolder.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := cfuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(conversationfolder.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cfuo.mutation.Name(); ok {
+		_spec.SetField(conversationfolder.FieldName, field.TypeString, value)
+	}
+	if value, ok := cfuo.mutation.Position(); ok {
+		_spec.SetField(conversationfolder.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := cfuo.mutation.AddedPosition(); ok {
+		_spec.AddField(conversationfolder.FieldPosition, field.TypeInt, value)
+	}
+	if cfuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   conversationfolder.UserTable,
+			Columns: []string{conversationfolder.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cfuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   conversationfolder.UserTable,
+			Columns: []string{conversationfolder.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cfuo.mutation.EntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversationfolder.EntriesTable,
+			Columns: []string{conversationfolder.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folderconversation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cfuo.mutation.RemovedEntriesIDs(); len(nodes) > 0 && !cfuo.mutation.EntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversationfolder.EntriesTable,
+			Columns: []string{conversationfolder.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folderconversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cfuo.mutation.EntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   conversationfolder.EntriesTable,
+			Columns: []string{conversationfolder.EntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folderconversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ConversationFolder{config: cfuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cfuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{conversationfolder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cfuo.mutation.done = true
+	return _node, nil
+}
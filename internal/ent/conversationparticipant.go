@@ -27,14 +27,24 @@ type ConversationParticipant struct {
 	ConversationID string `json:"conversation_id,omitempty"`
 	// UserID holds the value of the "user_id" field.
 	UserID string `json:"user_id,omitempty"`
+	// Role holds the value of the "role" field.
+	Role conversationparticipant.Role `json:"role,omitempty"`
 	// JoinedAt holds the value of the "joined_at" field.
 	JoinedAt time.Time `json:"joined_at,omitempty"`
 	// LastReadAt holds the value of the "last_read_at" field.
 	LastReadAt time.Time `json:"last_read_at,omitempty"`
 	// IsArchived holds the value of the "is_archived" field.
 	IsArchived bool `json:"is_archived,omitempty"`
-	// IsMuted holds the value of the "is_muted" field.
-	IsMuted bool `json:"is_muted,omitempty"`
+	// NotificationLevel holds the value of the "notification_level" field.
+	NotificationLevel conversationparticipant.NotificationLevel `json:"notification_level,omitempty"`
+	// When a mute ends and the level reverts to all, unset keeps it until changed
+	MutedUntil time.Time `json:"muted_until,omitempty"`
+	// Keeps the conversation in the default conversation list while muted
+	ShowWhenMuted bool `json:"show_when_muted,omitempty"`
+	// When the user pinned the conversation to the top of their list, unset when not pinned
+	PinnedAt time.Time `json:"pinned_at,omitempty"`
+	// Place among the user's pinned conversations, lowest first
+	PinPosition int `json:"pin_position,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ConversationParticipantQuery when eager-loading is set.
 	Edges                     ConversationParticipantEdges `json:"edges"`
@@ -80,11 +90,13 @@ func (*ConversationParticipant) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case conversationparticipant.FieldIsArchived, conversationparticipant.FieldIsMuted:
+		case conversationparticipant.FieldIsArchived, conversationparticipant.FieldShowWhenMuted:
 			values[i] = new(sql.NullBool)
-		case conversationparticipant.FieldID, conversationparticipant.FieldConversationID, conversationparticipant.FieldUserID:
+		case conversationparticipant.FieldPinPosition:
+			values[i] = new(sql.NullInt64)
+		case conversationparticipant.FieldID, conversationparticipant.FieldConversationID, conversationparticipant.FieldUserID, conversationparticipant.FieldRole, conversationparticipant.FieldNotificationLevel:
 			values[i] = new(sql.NullString)
-		case conversationparticipant.FieldCreatedAt, conversationparticipant.FieldUpdatedAt, conversationparticipant.FieldJoinedAt, conversationparticipant.FieldLastReadAt:
+		case conversationparticipant.FieldCreatedAt, conversationparticipant.FieldUpdatedAt, conversationparticipant.FieldJoinedAt, conversationparticipant.FieldLastReadAt, conversationparticipant.FieldMutedUntil, conversationparticipant.FieldPinnedAt:
 			values[i] = new(sql.NullTime)
 		case conversationparticipant.ForeignKeys[0]: // conversation_participants
 			values[i] = new(sql.NullString)
@@ -133,6 +145,12 @@ func (cp *ConversationParticipant) assignValues(columns []string, values []any)
 			} else if value.Valid {
 				cp.UserID = value.String
 			}
+		case conversationparticipant.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				cp.Role = conversationparticipant.Role(value.String)
+			}
 		case conversationparticipant.FieldJoinedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field joined_at", values[i])
@@ -151,11 +169,35 @@ func (cp *ConversationParticipant) assignValues(columns []string, values []any)
 			} else if value.Valid {
 				cp.IsArchived = value.Bool
 			}
-		case conversationparticipant.FieldIsMuted:
+		case conversationparticipant.FieldNotificationLevel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notification_level", values[i])
+			} else if value.Valid {
+				cp.NotificationLevel = conversationparticipant.NotificationLevel(value.String)
+			}
+		case conversationparticipant.FieldMutedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field muted_until", values[i])
+			} else if value.Valid {
+				cp.MutedUntil = value.Time
+			}
+		case conversationparticipant.FieldShowWhenMuted:
 			if value, ok := values[i].(*sql.NullBool); !ok {
-				return fmt.Errorf("unexpected type %T for field is_muted", values[i])
+				return fmt.Errorf("unexpected type %T for field show_when_muted", values[i])
 			} else if value.Valid {
-				cp.IsMuted = value.Bool
+				cp.ShowWhenMuted = value.Bool
+			}
+		case conversationparticipant.FieldPinnedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field pinned_at", values[i])
+			} else if value.Valid {
+				cp.PinnedAt = value.Time
+			}
+		case conversationparticipant.FieldPinPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field pin_position", values[i])
+			} else if value.Valid {
+				cp.PinPosition = int(value.Int64)
 			}
 		case conversationparticipant.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -222,6 +264,9 @@ func (cp *ConversationParticipant) String() string {
 	builder.WriteString("user_id=")
 	builder.WriteString(cp.UserID)
 	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", cp.Role))
+	builder.WriteString(", ")
 	builder.WriteString("joined_at=")
 	builder.WriteString(cp.JoinedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -231,8 +276,20 @@ func (cp *ConversationParticipant) String() string {
 	builder.WriteString("is_archived=")
 	builder.WriteString(fmt.Sprintf("%v", cp.IsArchived))
 	builder.WriteString(", ")
-	builder.WriteString("is_muted=")
-	builder.WriteString(fmt.Sprintf("%v", cp.IsMuted))
+	builder.WriteString("notification_level=")
+	builder.WriteString(fmt.Sprintf("%v", cp.NotificationLevel))
+	builder.WriteString(", ")
+	builder.WriteString("muted_until=")
+	builder.WriteString(cp.MutedUntil.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("show_when_muted=")
+	builder.WriteString(fmt.Sprintf("%v", cp.ShowWhenMuted))
+	builder.WriteString(", ")
+	builder.WriteString("pinned_at=")
+	builder.WriteString(cp.PinnedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("pin_position=")
+	builder.WriteString(fmt.Sprintf("%v", cp.PinPosition))
 	builder.WriteByte(')')
 	return builder.String()
 }
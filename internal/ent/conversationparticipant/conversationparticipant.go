@@ -3,6 +3,7 @@
 package conversationparticipant
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -22,14 +23,24 @@ const (
 	FieldConversationID = "conversation_id"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
 	// FieldJoinedAt holds the string denoting the joined_at field in the database.
 	FieldJoinedAt = "joined_at"
 	// FieldLastReadAt holds the string denoting the last_read_at field in the database.
 	FieldLastReadAt = "last_read_at"
 	// FieldIsArchived holds the string denoting the is_archived field in the database.
 	FieldIsArchived = "is_archived"
-	// FieldIsMuted holds the string denoting the is_muted field in the database.
-	FieldIsMuted = "is_muted"
+	// FieldNotificationLevel holds the string denoting the notification_level field in the database.
+	FieldNotificationLevel = "notification_level"
+	// FieldMutedUntil holds the string denoting the muted_until field in the database.
+	FieldMutedUntil = "muted_until"
+	// FieldShowWhenMuted holds the string denoting the show_when_muted field in the database.
+	FieldShowWhenMuted = "show_when_muted"
+	// FieldPinnedAt holds the string denoting the pinned_at field in the database.
+	FieldPinnedAt = "pinned_at"
+	// FieldPinPosition holds the string denoting the pin_position field in the database.
+	FieldPinPosition = "pin_position"
 	// EdgeConversation holds the string denoting the conversation edge name in mutations.
 	EdgeConversation = "conversation"
 	// EdgeUser holds the string denoting the user edge name in mutations.
@@ -59,10 +70,15 @@ var Columns = []string{
 	FieldUpdatedAt,
 	FieldConversationID,
 	FieldUserID,
+	FieldRole,
 	FieldJoinedAt,
 	FieldLastReadAt,
 	FieldIsArchived,
-	FieldIsMuted,
+	FieldNotificationLevel,
+	FieldMutedUntil,
+	FieldShowWhenMuted,
+	FieldPinnedAt,
+	FieldPinPosition,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "conversation_participants"
@@ -101,12 +117,70 @@ var (
 	DefaultJoinedAt func() time.Time
 	// DefaultIsArchived holds the default value on creation for the "is_archived" field.
 	DefaultIsArchived bool
-	// DefaultIsMuted holds the default value on creation for the "is_muted" field.
-	DefaultIsMuted bool
+	// DefaultShowWhenMuted holds the default value on creation for the "show_when_muted" field.
+	DefaultShowWhenMuted bool
+	// DefaultPinPosition holds the default value on creation for the "pin_position" field.
+	DefaultPinPosition int
+	// PinPositionValidator is a validator for the "pin_position" field. It is called by the builders before save.
+	PinPositionValidator func(int) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() string
 )
 
+// Role defines the type for the "role" enum field.
+type Role string
+
+// RoleMember is the default value of the Role enum.
+const DefaultRole = RoleMember
+
+// Role values.
+const (
+	RoleOwner  Role = "owner"
+	RoleAdmin  Role = "admin"
+	RoleMember Role = "member"
+)
+
+func (r Role) String() string {
+	return string(r)
+}
+
+// RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
+func RoleValidator(r Role) error {
+	switch r {
+	case RoleOwner, RoleAdmin, RoleMember:
+		return nil
+	default:
+		return fmt.Errorf("conversationparticipant: invalid enum value for role field: %q", r)
+	}
+}
+
+// NotificationLevel defines the type for the "notification_level" enum field.
+type NotificationLevel string
+
+// NotificationLevelAll is the default value of the NotificationLevel enum.
+const DefaultNotificationLevel = NotificationLevelAll
+
+// NotificationLevel values.
+const (
+	NotificationLevelAll      NotificationLevel = "all"
+	NotificationLevelMentions NotificationLevel = "mentions"
+	NotificationLevelNothing  NotificationLevel = "nothing"
+)
+
+func (nl NotificationLevel) String() string {
+	return string(nl)
+}
+
+// NotificationLevelValidator is a validator for the "notification_level" field enum values. It is called by the builders before save.
+func NotificationLevelValidator(nl NotificationLevel) error {
+	switch nl {
+	case NotificationLevelAll, NotificationLevelMentions, NotificationLevelNothing:
+		return nil
+	default:
+		return fmt.Errorf("conversationparticipant: invalid enum value for notification_level field: %q", nl)
+	}
+}
+
 // OrderOption defines the ordering options for the ConversationParticipant queries.
 type OrderOption func(*sql.Selector)
 
@@ -135,6 +209,11 @@ func ByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserID, opts...).ToFunc()
 }
 
+// ByRole orders the results by the role field.
+func ByRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRole, opts...).ToFunc()
+}
+
 // ByJoinedAt orders the results by the joined_at field.
 func ByJoinedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldJoinedAt, opts...).ToFunc()
@@ -150,9 +229,29 @@ func ByIsArchived(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsArchived, opts...).ToFunc()
 }
 
-// ByIsMuted orders the results by the is_muted field.
-func ByIsMuted(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldIsMuted, opts...).ToFunc()
+// ByNotificationLevel orders the results by the notification_level field.
+func ByNotificationLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotificationLevel, opts...).ToFunc()
+}
+
+// ByMutedUntil orders the results by the muted_until field.
+func ByMutedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMutedUntil, opts...).ToFunc()
+}
+
+// ByShowWhenMuted orders the results by the show_when_muted field.
+func ByShowWhenMuted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShowWhenMuted, opts...).ToFunc()
+}
+
+// ByPinnedAt orders the results by the pinned_at field.
+func ByPinnedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPinnedAt, opts...).ToFunc()
+}
+
+// ByPinPosition orders the results by the pin_position field.
+func ByPinPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPinPosition, opts...).ToFunc()
 }
 
 // ByConversationField orders the results by conversation field.
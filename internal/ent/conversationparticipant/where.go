@@ -100,9 +100,24 @@ func IsArchived(v bool) predicate.ConversationParticipant {
 	return predicate.ConversationParticipant(sql.FieldEQ(FieldIsArchived, v))
 }
 
-// IsMuted applies equality check predicate on the "is_muted" field. It's identical to IsMutedEQ.
-func IsMuted(v bool) predicate.ConversationParticipant {
-	return predicate.ConversationParticipant(sql.FieldEQ(FieldIsMuted, v))
+// MutedUntil applies equality check predicate on the "muted_until" field. It's identical to MutedUntilEQ.
+func MutedUntil(v time.Time) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldEQ(FieldMutedUntil, v))
+}
+
+// ShowWhenMuted applies equality check predicate on the "show_when_muted" field. It's identical to ShowWhenMutedEQ.
+func ShowWhenMuted(v bool) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldEQ(FieldShowWhenMuted, v))
+}
+
+// PinnedAt applies equality check predicate on the "pinned_at" field. It's identical to PinnedAtEQ.
+func PinnedAt(v time.Time) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldEQ(FieldPinnedAt, v))
+}
+
+// PinPosition applies equality check predicate on the "pin_position" field. It's identical to PinPositionEQ.
+func PinPosition(v int) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldEQ(FieldPinPosition, v))
 }
 
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
@@ -315,6 +330,26 @@ func UserIDContainsFold(v string) predicate.ConversationParticipant {
 	return predicate.ConversationParticipant(sql.FieldContainsFold(FieldUserID, v))
 }
 
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v Role) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v Role) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...Role) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...Role) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldNotIn(FieldRole, vs...))
+}
+
 // JoinedAtEQ applies the EQ predicate on the "joined_at" field.
 func JoinedAtEQ(v time.Time) predicate.ConversationParticipant {
 	return predicate.ConversationParticipant(sql.FieldEQ(FieldJoinedAt, v))
@@ -415,14 +450,174 @@ func IsArchivedNEQ(v bool) predicate.ConversationParticipant {
 	return predicate.ConversationParticipant(sql.FieldNEQ(FieldIsArchived, v))
 }
 
-// IsMutedEQ applies the EQ predicate on the "is_muted" field.
-func IsMutedEQ(v bool) predicate.ConversationParticipant {
-	return predicate.ConversationParticipant(sql.FieldEQ(FieldIsMuted, v))
+// NotificationLevelEQ applies the EQ predicate on the "notification_level" field.
+func NotificationLevelEQ(v NotificationLevel) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldEQ(FieldNotificationLevel, v))
+}
+
+// NotificationLevelNEQ applies the NEQ predicate on the "notification_level" field.
+func NotificationLevelNEQ(v NotificationLevel) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldNEQ(FieldNotificationLevel, v))
+}
+
+// NotificationLevelIn applies the In predicate on the "notification_level" field.
+func NotificationLevelIn(vs ...NotificationLevel) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldIn(FieldNotificationLevel, vs...))
+}
+
+// NotificationLevelNotIn applies the NotIn predicate on the "notification_level" field.
+func NotificationLevelNotIn(vs ...NotificationLevel) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldNotIn(FieldNotificationLevel, vs...))
+}
+
+// MutedUntilEQ applies the EQ predicate on the "muted_until" field.
+func MutedUntilEQ(v time.Time) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldEQ(FieldMutedUntil, v))
+}
+
+// MutedUntilNEQ applies the NEQ predicate on the "muted_until" field.
+func MutedUntilNEQ(v time.Time) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldNEQ(FieldMutedUntil, v))
+}
+
+// MutedUntilIn applies the In predicate on the "muted_until" field.
+func MutedUntilIn(vs ...time.Time) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldIn(FieldMutedUntil, vs...))
+}
+
+// MutedUntilNotIn applies the NotIn predicate on the "muted_until" field.
+func MutedUntilNotIn(vs ...time.Time) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldNotIn(FieldMutedUntil, vs...))
+}
+
+// MutedUntilGT applies the GT predicate on the "muted_until" field.
+func MutedUntilGT(v time.Time) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldGT(FieldMutedUntil, v))
+}
+
+// MutedUntilGTE applies the GTE predicate on the "muted_until" field.
+func MutedUntilGTE(v time.Time) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldGTE(FieldMutedUntil, v))
+}
+
+// MutedUntilLT applies the LT predicate on the "muted_until" field.
+func MutedUntilLT(v time.Time) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldLT(FieldMutedUntil, v))
+}
+
+// MutedUntilLTE applies the LTE predicate on the "muted_until" field.
+func MutedUntilLTE(v time.Time) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldLTE(FieldMutedUntil, v))
+}
+
+// MutedUntilIsNil applies the IsNil predicate on the "muted_until" field.
+func MutedUntilIsNil() predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldIsNull(FieldMutedUntil))
+}
+
+// MutedUntilNotNil applies the NotNil predicate on the "muted_until" field.
+func MutedUntilNotNil() predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldNotNull(FieldMutedUntil))
+}
+
+// ShowWhenMutedEQ applies the EQ predicate on the "show_when_muted" field.
+func ShowWhenMutedEQ(v bool) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldEQ(FieldShowWhenMuted, v))
+}
+
+// ShowWhenMutedNEQ applies the NEQ predicate on the "show_when_muted" field.
+func ShowWhenMutedNEQ(v bool) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldNEQ(FieldShowWhenMuted, v))
+}
+
+// PinnedAtEQ applies the EQ predicate on the "pinned_at" field.
+func PinnedAtEQ(v time.Time) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldEQ(FieldPinnedAt, v))
+}
+
+// PinnedAtNEQ applies the NEQ predicate on the "pinned_at" field.
+func PinnedAtNEQ(v time.Time) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldNEQ(FieldPinnedAt, v))
+}
+
+// PinnedAtIn applies the In predicate on the "pinned_at" field.
+func PinnedAtIn(vs ...time.Time) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldIn(FieldPinnedAt, vs...))
+}
+
+// PinnedAtNotIn applies the NotIn predicate on the "pinned_at" field.
+func PinnedAtNotIn(vs ...time.Time) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldNotIn(FieldPinnedAt, vs...))
+}
+
+// PinnedAtGT applies the GT predicate on the "pinned_at" field.
+func PinnedAtGT(v time.Time) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldGT(FieldPinnedAt, v))
+}
+
+// PinnedAtGTE applies the GTE predicate on the "pinned_at" field.
+func PinnedAtGTE(v time.Time) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldGTE(FieldPinnedAt, v))
+}
+
+// PinnedAtLT applies the LT predicate on the "pinned_at" field.
+func PinnedAtLT(v time.Time) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldLT(FieldPinnedAt, v))
+}
+
+// PinnedAtLTE applies the LTE predicate on the "pinned_at" field.
+func PinnedAtLTE(v time.Time) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldLTE(FieldPinnedAt, v))
+}
+
+// PinnedAtIsNil applies the IsNil predicate on the "pinned_at" field.
+func PinnedAtIsNil() predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldIsNull(FieldPinnedAt))
+}
+
+// PinnedAtNotNil applies the NotNil predicate on the "pinned_at" field.
+func PinnedAtNotNil() predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldNotNull(FieldPinnedAt))
+}
+
+// PinPositionEQ applies the EQ predicate on the "pin_position" field.
+func PinPositionEQ(v int) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldEQ(FieldPinPosition, v))
+}
+
+// PinPositionNEQ applies the NEQ predicate on the "pin_position" field.
+func PinPositionNEQ(v int) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldNEQ(FieldPinPosition, v))
+}
+
+// PinPositionIn applies the In predicate on the "pin_position" field.
+func PinPositionIn(vs ...int) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldIn(FieldPinPosition, vs...))
+}
+
+// PinPositionNotIn applies the NotIn predicate on the "pin_position" field.
+func PinPositionNotIn(vs ...int) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldNotIn(FieldPinPosition, vs...))
+}
+
+// PinPositionGT applies the GT predicate on the "pin_position" field.
+func PinPositionGT(v int) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldGT(FieldPinPosition, v))
+}
+
+// PinPositionGTE applies the GTE predicate on the "pin_position" field.
+func PinPositionGTE(v int) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldGTE(FieldPinPosition, v))
+}
+
+// PinPositionLT applies the LT predicate on the "pin_position" field.
+func PinPositionLT(v int) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldLT(FieldPinPosition, v))
 }
 
-// IsMutedNEQ applies the NEQ predicate on the "is_muted" field.
-func IsMutedNEQ(v bool) predicate.ConversationParticipant {
-	return predicate.ConversationParticipant(sql.FieldNEQ(FieldIsMuted, v))
+// PinPositionLTE applies the LTE predicate on the "pin_position" field.
+func PinPositionLTE(v int) predicate.ConversationParticipant {
+	return predicate.ConversationParticipant(sql.FieldLTE(FieldPinPosition, v))
 }
 
 // HasConversation applies the HasEdge predicate on the "conversation" edge.
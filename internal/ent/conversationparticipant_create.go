@@ -62,6 +62,20 @@ func (cpc *ConversationParticipantCreate) SetUserID(s string) *ConversationParti
 	return cpc
 }
 
+// SetRole sets the "role" field.
+func (cpc *ConversationParticipantCreate) SetRole(c conversationparticipant.Role) *ConversationParticipantCreate {
+	cpc.mutation.SetRole(c)
+	return cpc
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (cpc *ConversationParticipantCreate) SetNillableRole(c *conversationparticipant.Role) *ConversationParticipantCreate {
+	if c != nil {
+		cpc.SetRole(*c)
+	}
+	return cpc
+}
+
 // SetJoinedAt sets the "joined_at" field.
 func (cpc *ConversationParticipantCreate) SetJoinedAt(t time.Time) *ConversationParticipantCreate {
 	cpc.mutation.SetJoinedAt(t)
@@ -104,16 +118,72 @@ func (cpc *ConversationParticipantCreate) SetNillableIsArchived(b *bool) *Conver
 	return cpc
 }
 
-// SetIsMuted sets the "is_muted" field.
-func (cpc *ConversationParticipantCreate) SetIsMuted(b bool) *ConversationParticipantCreate {
-	cpc.mutation.SetIsMuted(b)
+// SetNotificationLevel sets the "notification_level" field.
+func (cpc *ConversationParticipantCreate) SetNotificationLevel(cl conversationparticipant.NotificationLevel) *ConversationParticipantCreate {
+	cpc.mutation.SetNotificationLevel(cl)
+	return cpc
+}
+
+// SetNillableNotificationLevel sets the "notification_level" field if the given value is not nil.
+func (cpc *ConversationParticipantCreate) SetNillableNotificationLevel(cl *conversationparticipant.NotificationLevel) *ConversationParticipantCreate {
+	if cl != nil {
+		cpc.SetNotificationLevel(*cl)
+	}
+	return cpc
+}
+
+// SetMutedUntil sets the "muted_until" field.
+func (cpc *ConversationParticipantCreate) SetMutedUntil(t time.Time) *ConversationParticipantCreate {
+	cpc.mutation.SetMutedUntil(t)
 	return cpc
 }
 
-// SetNillableIsMuted sets the "is_muted" field if the given value is not nil.
-func (cpc *ConversationParticipantCreate) SetNillableIsMuted(b *bool) *ConversationParticipantCreate {
+// SetNillableMutedUntil sets the "muted_until" field if the given value is not nil.
+func (cpc *ConversationParticipantCreate) SetNillableMutedUntil(t *time.Time) *ConversationParticipantCreate {
+	if t != nil {
+		cpc.SetMutedUntil(*t)
+	}
+	return cpc
+}
+
+// SetShowWhenMuted sets the "show_when_muted" field.
+func (cpc *ConversationParticipantCreate) SetShowWhenMuted(b bool) *ConversationParticipantCreate {
+	cpc.mutation.SetShowWhenMuted(b)
+	return cpc
+}
+
+// SetNillableShowWhenMuted sets the "show_when_muted" field if the given value is not nil.
+func (cpc *ConversationParticipantCreate) SetNillableShowWhenMuted(b *bool) *ConversationParticipantCreate {
 	if b != nil {
-		cpc.SetIsMuted(*b)
+		cpc.SetShowWhenMuted(*b)
+	}
+	return cpc
+}
+
+// SetPinnedAt sets the "pinned_at" field.
+func (cpc *ConversationParticipantCreate) SetPinnedAt(t time.Time) *ConversationParticipantCreate {
+	cpc.mutation.SetPinnedAt(t)
+	return cpc
+}
+
+// SetNillablePinnedAt sets the "pinned_at" field if the given value is not nil.
+func (cpc *ConversationParticipantCreate) SetNillablePinnedAt(t *time.Time) *ConversationParticipantCreate {
+	if t != nil {
+		cpc.SetPinnedAt(*t)
+	}
+	return cpc
+}
+
+// SetPinPosition sets the "pin_position" field.
+func (cpc *ConversationParticipantCreate) SetPinPosition(i int) *ConversationParticipantCreate {
+	cpc.mutation.SetPinPosition(i)
+	return cpc
+}
+
+// SetNillablePinPosition sets the "pin_position" field if the given value is not nil.
+func (cpc *ConversationParticipantCreate) SetNillablePinPosition(i *int) *ConversationParticipantCreate {
+	if i != nil {
+		cpc.SetPinPosition(*i)
 	}
 	return cpc
 }
@@ -185,6 +255,10 @@ func (cpc *ConversationParticipantCreate) defaults() {
 		v := conversationparticipant.DefaultUpdatedAt()
 		cpc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := cpc.mutation.Role(); !ok {
+		v := conversationparticipant.DefaultRole
+		cpc.mutation.SetRole(v)
+	}
 	if _, ok := cpc.mutation.JoinedAt(); !ok {
 		v := conversationparticipant.DefaultJoinedAt()
 		cpc.mutation.SetJoinedAt(v)
@@ -193,9 +267,17 @@ func (cpc *ConversationParticipantCreate) defaults() {
 		v := conversationparticipant.DefaultIsArchived
 		cpc.mutation.SetIsArchived(v)
 	}
-	if _, ok := cpc.mutation.IsMuted(); !ok {
-		v := conversationparticipant.DefaultIsMuted
-		cpc.mutation.SetIsMuted(v)
+	if _, ok := cpc.mutation.NotificationLevel(); !ok {
+		v := conversationparticipant.DefaultNotificationLevel
+		cpc.mutation.SetNotificationLevel(v)
+	}
+	if _, ok := cpc.mutation.ShowWhenMuted(); !ok {
+		v := conversationparticipant.DefaultShowWhenMuted
+		cpc.mutation.SetShowWhenMuted(v)
+	}
+	if _, ok := cpc.mutation.PinPosition(); !ok {
+		v := conversationparticipant.DefaultPinPosition
+		cpc.mutation.SetPinPosition(v)
 	}
 	if _, ok := cpc.mutation.ID(); !ok {
 		v := conversationparticipant.DefaultID()
@@ -227,14 +309,38 @@ func (cpc *ConversationParticipantCreate) check() error {
 			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "ConversationParticipant.user_id": %w`, err)}
 		}
 	}
+	if _, ok := cpc.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "ConversationParticipant.role"`)}
+	}
+	if v, ok := cpc.mutation.Role(); ok {
+		if err := conversationparticipant.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "ConversationParticipant.role": %w`, err)}
+		}
+	}
 	if _, ok := cpc.mutation.JoinedAt(); !ok {
 		return &ValidationError{Name: "joined_at", err: errors.New(`ent: missing required field "ConversationParticipant.joined_at"`)}
 	}
 	if _, ok := cpc.mutation.IsArchived(); !ok {
 		return &ValidationError{Name: "is_archived", err: errors.New(`ent: missing required field "ConversationParticipant.is_archived"`)}
 	}
-	if _, ok := cpc.mutation.IsMuted(); !ok {
-		return &ValidationError{Name: "is_muted", err: errors.New(`ent: missing required field "ConversationParticipant.is_muted"`)}
+	if _, ok := cpc.mutation.NotificationLevel(); !ok {
+		return &ValidationError{Name: "notification_level", err: errors.New(`ent: missing required field "ConversationParticipant.notification_level"`)}
+	}
+	if v, ok := cpc.mutation.NotificationLevel(); ok {
+		if err := conversationparticipant.NotificationLevelValidator(v); err != nil {
+			return &ValidationError{Name: "notification_level", err: fmt.Errorf(`ent: validator failed for field "ConversationParticipant.notification_level": %w`, err)}
+		}
+	}
+	if _, ok := cpc.mutation.ShowWhenMuted(); !ok {
+		return &ValidationError{Name: "show_when_muted", err: errors.New(`ent: missing required field "ConversationParticipant.show_when_muted"`)}
+	}
+	if _, ok := cpc.mutation.PinPosition(); !ok {
+		return &ValidationError{Name: "pin_position", err: errors.New(`ent: missing required field "ConversationParticipant.pin_position"`)}
+	}
+	if v, ok := cpc.mutation.PinPosition(); ok {
+		if err := conversationparticipant.PinPositionValidator(v); err != nil {
+			return &ValidationError{Name: "pin_position", err: fmt.Errorf(`ent: validator failed for field "ConversationParticipant.pin_position": %w`, err)}
+		}
 	}
 	if len(cpc.mutation.ConversationIDs()) == 0 {
 		return &ValidationError{Name: "conversation", err: errors.New(`ent: missing required edge "ConversationParticipant.conversation"`)}
@@ -285,6 +391,10 @@ func (cpc *ConversationParticipantCreate) createSpec() (*ConversationParticipant
 		_spec.SetField(conversationparticipant.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := cpc.mutation.Role(); ok {
+		_spec.SetField(conversationparticipant.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
 	if value, ok := cpc.mutation.JoinedAt(); ok {
 		_spec.SetField(conversationparticipant.FieldJoinedAt, field.TypeTime, value)
 		_node.JoinedAt = value
@@ -297,9 +407,25 @@ func (cpc *ConversationParticipantCreate) createSpec() (*ConversationParticipant
 		_spec.SetField(conversationparticipant.FieldIsArchived, field.TypeBool, value)
 		_node.IsArchived = value
 	}
-	if value, ok := cpc.mutation.IsMuted(); ok {
-		_spec.SetField(conversationparticipant.FieldIsMuted, field.TypeBool, value)
-		_node.IsMuted = value
+	if value, ok := cpc.mutation.NotificationLevel(); ok {
+		_spec.SetField(conversationparticipant.FieldNotificationLevel, field.TypeEnum, value)
+		_node.NotificationLevel = value
+	}
+	if value, ok := cpc.mutation.MutedUntil(); ok {
+		_spec.SetField(conversationparticipant.FieldMutedUntil, field.TypeTime, value)
+		_node.MutedUntil = value
+	}
+	if value, ok := cpc.mutation.ShowWhenMuted(); ok {
+		_spec.SetField(conversationparticipant.FieldShowWhenMuted, field.TypeBool, value)
+		_node.ShowWhenMuted = value
+	}
+	if value, ok := cpc.mutation.PinnedAt(); ok {
+		_spec.SetField(conversationparticipant.FieldPinnedAt, field.TypeTime, value)
+		_node.PinnedAt = value
+	}
+	if value, ok := cpc.mutation.PinPosition(); ok {
+		_spec.SetField(conversationparticipant.FieldPinPosition, field.TypeInt, value)
+		_node.PinPosition = value
 	}
 	if nodes := cpc.mutation.ConversationIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
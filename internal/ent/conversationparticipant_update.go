@@ -78,6 +78,20 @@ func (cpu *ConversationParticipantUpdate) SetNillableUserID(s *string) *Conversa
 	return cpu
 }
 
+// SetRole sets the "role" field.
+func (cpu *ConversationParticipantUpdate) SetRole(c conversationparticipant.Role) *ConversationParticipantUpdate {
+	cpu.mutation.SetRole(c)
+	return cpu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (cpu *ConversationParticipantUpdate) SetNillableRole(c *conversationparticipant.Role) *ConversationParticipantUpdate {
+	if c != nil {
+		cpu.SetRole(*c)
+	}
+	return cpu
+}
+
 // SetJoinedAt sets the "joined_at" field.
 func (cpu *ConversationParticipantUpdate) SetJoinedAt(t time.Time) *ConversationParticipantUpdate {
 	cpu.mutation.SetJoinedAt(t)
@@ -126,20 +140,95 @@ func (cpu *ConversationParticipantUpdate) SetNillableIsArchived(b *bool) *Conver
 	return cpu
 }
 
-// SetIsMuted sets the "is_muted" field.
-func (cpu *ConversationParticipantUpdate) SetIsMuted(b bool) *ConversationParticipantUpdate {
-	cpu.mutation.SetIsMuted(b)
+// SetNotificationLevel sets the "notification_level" field.
+func (cpu *ConversationParticipantUpdate) SetNotificationLevel(cl conversationparticipant.NotificationLevel) *ConversationParticipantUpdate {
+	cpu.mutation.SetNotificationLevel(cl)
+	return cpu
+}
+
+// SetNillableNotificationLevel sets the "notification_level" field if the given value is not nil.
+func (cpu *ConversationParticipantUpdate) SetNillableNotificationLevel(cl *conversationparticipant.NotificationLevel) *ConversationParticipantUpdate {
+	if cl != nil {
+		cpu.SetNotificationLevel(*cl)
+	}
+	return cpu
+}
+
+// SetMutedUntil sets the "muted_until" field.
+func (cpu *ConversationParticipantUpdate) SetMutedUntil(t time.Time) *ConversationParticipantUpdate {
+	cpu.mutation.SetMutedUntil(t)
+	return cpu
+}
+
+// SetNillableMutedUntil sets the "muted_until" field if the given value is not nil.
+func (cpu *ConversationParticipantUpdate) SetNillableMutedUntil(t *time.Time) *ConversationParticipantUpdate {
+	if t != nil {
+		cpu.SetMutedUntil(*t)
+	}
+	return cpu
+}
+
+// ClearMutedUntil clears the value of the "muted_until" field.
+func (cpu *ConversationParticipantUpdate) ClearMutedUntil() *ConversationParticipantUpdate {
+	cpu.mutation.ClearMutedUntil()
 	return cpu
 }
 
-// SetNillableIsMuted sets the "is_muted" field if the given value is not nil.
-func (cpu *ConversationParticipantUpdate) SetNillableIsMuted(b *bool) *ConversationParticipantUpdate {
+// SetShowWhenMuted sets the "show_when_muted" field.
+func (cpu *ConversationParticipantUpdate) SetShowWhenMuted(b bool) *ConversationParticipantUpdate {
+	cpu.mutation.SetShowWhenMuted(b)
+	return cpu
+}
+
+// SetNillableShowWhenMuted sets the "show_when_muted" field if the given value is not nil.
+func (cpu *ConversationParticipantUpdate) SetNillableShowWhenMuted(b *bool) *ConversationParticipantUpdate {
 	if b != nil {
-		cpu.SetIsMuted(*b)
+		cpu.SetShowWhenMuted(*b)
+	}
+	return cpu
+}
+
+// SetPinnedAt sets the "pinned_at" field.
+func (cpu *ConversationParticipantUpdate) SetPinnedAt(t time.Time) *ConversationParticipantUpdate {
+	cpu.mutation.SetPinnedAt(t)
+	return cpu
+}
+
+// SetNillablePinnedAt sets the "pinned_at" field if the given value is not nil.
+func (cpu *ConversationParticipantUpdate) SetNillablePinnedAt(t *time.Time) *ConversationParticipantUpdate {
+	if t != nil {
+		cpu.SetPinnedAt(*t)
 	}
 	return cpu
 }
 
+// ClearPinnedAt clears the value of the "pinned_at" field.
+func (cpu *ConversationParticipantUpdate) ClearPinnedAt() *ConversationParticipantUpdate {
+	cpu.mutation.ClearPinnedAt()
+	return cpu
+}
+
+// SetPinPosition sets the "pin_position" field.
+func (cpu *ConversationParticipantUpdate) SetPinPosition(i int) *ConversationParticipantUpdate {
+	cpu.mutation.ResetPinPosition()
+	cpu.mutation.SetPinPosition(i)
+	return cpu
+}
+
+// SetNillablePinPosition sets the "pin_position" field if the given value is not nil.
+func (cpu *ConversationParticipantUpdate) SetNillablePinPosition(i *int) *ConversationParticipantUpdate {
+	if i != nil {
+		cpu.SetPinPosition(*i)
+	}
+	return cpu
+}
+
+// AddPinPosition adds i to the "pin_position" field.
+func (cpu *ConversationParticipantUpdate) AddPinPosition(i int) *ConversationParticipantUpdate {
+	cpu.mutation.AddPinPosition(i)
+	return cpu
+}
+
 // SetConversation sets the "conversation" edge to the Conversation entity.
 func (cpu *ConversationParticipantUpdate) SetConversation(c *Conversation) *ConversationParticipantUpdate {
 	return cpu.SetConversationID(c.ID)
@@ -215,6 +304,21 @@ func (cpu *ConversationParticipantUpdate) check() error {
 			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "ConversationParticipant.user_id": %w`, err)}
 		}
 	}
+	if v, ok := cpu.mutation.Role(); ok {
+		if err := conversationparticipant.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "ConversationParticipant.role": %w`, err)}
+		}
+	}
+	if v, ok := cpu.mutation.NotificationLevel(); ok {
+		if err := conversationparticipant.NotificationLevelValidator(v); err != nil {
+			return &ValidationError{Name: "notification_level", err: fmt.Errorf(`ent: validator failed for field "ConversationParticipant.notification_level": %w`, err)}
+		}
+	}
+	if v, ok := cpu.mutation.PinPosition(); ok {
+		if err := conversationparticipant.PinPositionValidator(v); err != nil {
+			return &ValidationError{Name: "pin_position", err: fmt.Errorf(`ent: validator failed for field "ConversationParticipant.pin_position": %w`, err)}
+		}
+	}
 	if cpu.mutation.ConversationCleared() && len(cpu.mutation.ConversationIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "ConversationParticipant.conversation"`)
 	}
@@ -242,6 +346,9 @@ func (cpu *ConversationParticipantUpdate) sqlSave(ctx context.Context) (n int, e
 	if value, ok := cpu.mutation.UpdatedAt(); ok {
 		_spec.SetField(conversationparticipant.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := cpu.mutation.Role(); ok {
+		_spec.SetField(conversationparticipant.FieldRole, field.TypeEnum, value)
+	}
 	if value, ok := cpu.mutation.JoinedAt(); ok {
 		_spec.SetField(conversationparticipant.FieldJoinedAt, field.TypeTime, value)
 	}
@@ -254,8 +361,29 @@ func (cpu *ConversationParticipantUpdate) sqlSave(ctx context.Context) (n int, e
 	if value, ok := cpu.mutation.IsArchived(); ok {
 		_spec.SetField(conversationparticipant.FieldIsArchived, field.TypeBool, value)
 	}
-	if value, ok := cpu.mutation.IsMuted(); ok {
-		_spec.SetField(conversationparticipant.FieldIsMuted, field.TypeBool, value)
+	if value, ok := cpu.mutation.NotificationLevel(); ok {
+		_spec.SetField(conversationparticipant.FieldNotificationLevel, field.TypeEnum, value)
+	}
+	if value, ok := cpu.mutation.MutedUntil(); ok {
+		_spec.SetField(conversationparticipant.FieldMutedUntil, field.TypeTime, value)
+	}
+	if cpu.mutation.MutedUntilCleared() {
+		_spec.ClearField(conversationparticipant.FieldMutedUntil, field.TypeTime)
+	}
+	if value, ok := cpu.mutation.ShowWhenMuted(); ok {
+		_spec.SetField(conversationparticipant.FieldShowWhenMuted, field.TypeBool, value)
+	}
+	if value, ok := cpu.mutation.PinnedAt(); ok {
+		_spec.SetField(conversationparticipant.FieldPinnedAt, field.TypeTime, value)
+	}
+	if cpu.mutation.PinnedAtCleared() {
+		_spec.ClearField(conversationparticipant.FieldPinnedAt, field.TypeTime)
+	}
+	if value, ok := cpu.mutation.PinPosition(); ok {
+		_spec.SetField(conversationparticipant.FieldPinPosition, field.TypeInt, value)
+	}
+	if value, ok := cpu.mutation.AddedPinPosition(); ok {
+		_spec.AddField(conversationparticipant.FieldPinPosition, field.TypeInt, value)
 	}
 	if cpu.mutation.ConversationCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -383,6 +511,20 @@ func (cpuo *ConversationParticipantUpdateOne) SetNillableUserID(s *string) *Conv
 	return cpuo
 }
 
+// SetRole sets the "role" field.
+func (cpuo *ConversationParticipantUpdateOne) SetRole(c conversationparticipant.Role) *ConversationParticipantUpdateOne {
+	cpuo.mutation.SetRole(c)
+	return cpuo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (cpuo *ConversationParticipantUpdateOne) SetNillableRole(c *conversationparticipant.Role) *ConversationParticipantUpdateOne {
+	if c != nil {
+		cpuo.SetRole(*c)
+	}
+	return cpuo
+}
+
 // SetJoinedAt sets the "joined_at" field.
 func (cpuo *ConversationParticipantUpdateOne) SetJoinedAt(t time.Time) *ConversationParticipantUpdateOne {
 	cpuo.mutation.SetJoinedAt(t)
@@ -431,20 +573,95 @@ func (cpuo *ConversationParticipantUpdateOne) SetNillableIsArchived(b *bool) *Co
 	return cpuo
 }
 
-// SetIsMuted sets the "is_muted" field.
-func (cpuo *ConversationParticipantUpdateOne) SetIsMuted(b bool) *ConversationParticipantUpdateOne {
-	cpuo.mutation.SetIsMuted(b)
+// SetNotificationLevel sets the "notification_level" field.
+func (cpuo *ConversationParticipantUpdateOne) SetNotificationLevel(cl conversationparticipant.NotificationLevel) *ConversationParticipantUpdateOne {
+	cpuo.mutation.SetNotificationLevel(cl)
+	return cpuo
+}
+
+// SetNillableNotificationLevel sets the "notification_level" field if the given value is not nil.
+func (cpuo *ConversationParticipantUpdateOne) SetNillableNotificationLevel(cl *conversationparticipant.NotificationLevel) *ConversationParticipantUpdateOne {
+	if cl != nil {
+		cpuo.SetNotificationLevel(*cl)
+	}
+	return cpuo
+}
+
+// SetMutedUntil sets the "muted_until" field.
+func (cpuo *ConversationParticipantUpdateOne) SetMutedUntil(t time.Time) *ConversationParticipantUpdateOne {
+	cpuo.mutation.SetMutedUntil(t)
+	return cpuo
+}
+
+// SetNillableMutedUntil sets the "muted_until" field if the given value is not nil.
+func (cpuo *ConversationParticipantUpdateOne) SetNillableMutedUntil(t *time.Time) *ConversationParticipantUpdateOne {
+	if t != nil {
+		cpuo.SetMutedUntil(*t)
+	}
+	return cpuo
+}
+
+// ClearMutedUntil clears the value of the "muted_until" field.
+func (cpuo *ConversationParticipantUpdateOne) ClearMutedUntil() *ConversationParticipantUpdateOne {
+	cpuo.mutation.ClearMutedUntil()
 	return cpuo
 }
 
-// SetNillableIsMuted sets the "is_muted" field if the given value is not nil.
-func (cpuo *ConversationParticipantUpdateOne) SetNillableIsMuted(b *bool) *ConversationParticipantUpdateOne {
+// SetShowWhenMuted sets the "show_when_muted" field.
+func (cpuo *ConversationParticipantUpdateOne) SetShowWhenMuted(b bool) *ConversationParticipantUpdateOne {
+	cpuo.mutation.SetShowWhenMuted(b)
+	return cpuo
+}
+
+// SetNillableShowWhenMuted sets the "show_when_muted" field if the given value is not nil.
+func (cpuo *ConversationParticipantUpdateOne) SetNillableShowWhenMuted(b *bool) *ConversationParticipantUpdateOne {
 	if b != nil {
-		cpuo.SetIsMuted(*b)
+		cpuo.SetShowWhenMuted(*b)
+	}
+	return cpuo
+}
+
+// SetPinnedAt sets the "pinned_at" field.
+func (cpuo *ConversationParticipantUpdateOne) SetPinnedAt(t time.Time) *ConversationParticipantUpdateOne {
+	cpuo.mutation.SetPinnedAt(t)
+	return cpuo
+}
+
+// SetNillablePinnedAt sets the "pinned_at" field if the given value is not nil.
+func (cpuo *ConversationParticipantUpdateOne) SetNillablePinnedAt(t *time.Time) *ConversationParticipantUpdateOne {
+	if t != nil {
+		cpuo.SetPinnedAt(*t)
 	}
 	return cpuo
 }
 
+// ClearPinnedAt clears the value of the "pinned_at" field.
+func (cpuo *ConversationParticipantUpdateOne) ClearPinnedAt() *ConversationParticipantUpdateOne {
+	cpuo.mutation.ClearPinnedAt()
+	return cpuo
+}
+
+// SetPinPosition sets the "pin_position" field.
+func (cpuo *ConversationParticipantUpdateOne) SetPinPosition(i int) *ConversationParticipantUpdateOne {
+	cpuo.mutation.ResetPinPosition()
+	cpuo.mutation.SetPinPosition(i)
+	return cpuo
+}
+
+// SetNillablePinPosition sets the "pin_position" field if the given value is not nil.
+func (cpuo *ConversationParticipantUpdateOne) SetNillablePinPosition(i *int) *ConversationParticipantUpdateOne {
+	if i != nil {
+		cpuo.SetPinPosition(*i)
+	}
+	return cpuo
+}
+
+// AddPinPosition adds i to the "pin_position" field.
+func (cpuo *ConversationParticipantUpdateOne) AddPinPosition(i int) *ConversationParticipantUpdateOne {
+	cpuo.mutation.AddPinPosition(i)
+	return cpuo
+}
+
 // SetConversation sets the "conversation" edge to the Conversation entity.
 func (cpuo *ConversationParticipantUpdateOne) SetConversation(c *Conversation) *ConversationParticipantUpdateOne {
 	return cpuo.SetConversationID(c.ID)
@@ -533,6 +750,21 @@ func (cpuo *ConversationParticipantUpdateOne) check() error {
 			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "ConversationParticipant.user_id": %w`, err)}
 		}
 	}
+	if v, ok := cpuo.mutation.Role(); ok {
+		if err := conversationparticipant.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "ConversationParticipant.role": %w`, err)}
+		}
+	}
+	if v, ok := cpuo.mutation.NotificationLevel(); ok {
+		if err := conversationparticipant.NotificationLevelValidator(v); err != nil {
+			return &ValidationError{Name: "notification_level", err: fmt.Errorf(`ent: validator failed for field "ConversationParticipant.notification_level": %w`, err)}
+		}
+	}
+	if v, ok := cpuo.mutation.PinPosition(); ok {
+		if err := conversationparticipant.PinPositionValidator(v); err != nil {
+			return &ValidationError{Name: "pin_position", err: fmt.Errorf(`ent: validator failed for field "ConversationParticipant.pin_position": %w`, err)}
+		}
+	}
 	if cpuo.mutation.ConversationCleared() && len(cpuo.mutation.ConversationIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "ConversationParticipant.conversation"`)
 	}
@@ -577,6 +809,9 @@ func (cpuo *ConversationParticipantUpdateOne) sqlSave(ctx context.Context) (_nod
 	if value, ok := cpuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(conversationparticipant.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := cpuo.mutation.Role(); ok {
+		_spec.SetField(conversationparticipant.FieldRole, field.TypeEnum, value)
+	}
 	if value, ok := cpuo.mutation.JoinedAt(); ok {
 		_spec.SetField(conversationparticipant.FieldJoinedAt, field.TypeTime, value)
 	}
@@ -589,8 +824,29 @@ func (cpuo *ConversationParticipantUpdateOne) sqlSave(ctx context.Context) (_nod
 	if value, ok := cpuo.mutation.IsArchived(); ok {
 		_spec.SetField(conversationparticipant.FieldIsArchived, field.TypeBool, value)
 	}
-	if value, ok := cpuo.mutation.IsMuted(); ok {
-		_spec.SetField(conversationparticipant.FieldIsMuted, field.TypeBool, value)
+	if value, ok := cpuo.mutation.NotificationLevel(); ok {
+		_spec.SetField(conversationparticipant.FieldNotificationLevel, field.TypeEnum, value)
+	}
+	if value, ok := cpuo.mutation.MutedUntil(); ok {
+		_spec.SetField(conversationparticipant.FieldMutedUntil, field.TypeTime, value)
+	}
+	if cpuo.mutation.MutedUntilCleared() {
+		_spec.ClearField(conversationparticipant.FieldMutedUntil, field.TypeTime)
+	}
+	if value, ok := cpuo.mutation.ShowWhenMuted(); ok {
+		_spec.SetField(conversationparticipant.FieldShowWhenMuted, field.TypeBool, value)
+	}
+	if value, ok := cpuo.mutation.PinnedAt(); ok {
+		_spec.SetField(conversationparticipant.FieldPinnedAt, field.TypeTime, value)
+	}
+	if cpuo.mutation.PinnedAtCleared() {
+		_spec.ClearField(conversationparticipant.FieldPinnedAt, field.TypeTime)
+	}
+	if value, ok := cpuo.mutation.PinPosition(); ok {
+		_spec.SetField(conversationparticipant.FieldPinPosition, field.TypeInt, value)
+	}
+	if value, ok := cpuo.mutation.AddedPinPosition(); ok {
+		_spec.AddField(conversationparticipant.FieldPinPosition, field.TypeInt, value)
 	}
 	if cpuo.mutation.ConversationCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -0,0 +1,207 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/draft"
+	"kakashi/chaos/internal/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Draft is the model entity for the Draft schema.
+type Draft struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// ConversationID holds the value of the "conversation_id" field.
+	ConversationID string `json:"conversation_id,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// Message the draft replies to, not enforced as a reference so drafts outlive deleted targets
+	ReplyToID string `json:"reply_to_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DraftQuery when eager-loading is set.
+	Edges        DraftEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DraftEdges holds the relations/edges for other nodes in the graph.
+type DraftEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Conversation holds the value of the conversation edge.
+	Conversation *Conversation `json:"conversation,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DraftEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// ConversationOrErr returns the Conversation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DraftEdges) ConversationOrErr() (*Conversation, error) {
+	if e.Conversation != nil {
+		return e.Conversation, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: conversation.Label}
+	}
+	return nil, &NotLoadedError{edge: "conversation"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Draft) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case draft.FieldID, draft.FieldUserID, draft.FieldConversationID, draft.FieldContent, draft.FieldReplyToID:
+			values[i] = new(sql.NullString)
+		case draft.FieldCreatedAt, draft.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Draft fields.
+func (d *Draft) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case draft.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				d.ID = value.String
+			}
+		case draft.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				d.CreatedAt = value.Time
+			}
+		case draft.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				d.UpdatedAt = value.Time
+			}
+		case draft.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				d.UserID = value.String
+			}
+		case draft.FieldConversationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field conversation_id", values[i])
+			} else if value.Valid {
+				d.ConversationID = value.String
+			}
+		case draft.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				d.Content = value.String
+			}
+		case draft.FieldReplyToID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reply_to_id", values[i])
+			} else if value.Valid {
+				d.ReplyToID = value.String
+			}
+		default:
+			d.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Draft.
+// This includes values selected through modifiers, order, etc.
+func (d *Draft) Value(name string) (ent.Value, error) {
+	return d.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Draft entity.
+func (d *Draft) QueryUser() *UserQuery {
+	return NewDraftClient(d.config).QueryUser(d)
+}
+
+// QueryConversation queries the "conversation" edge of the Draft entity.
+func (d *Draft) QueryConversation() *ConversationQuery {
+	return NewDraftClient(d.config).QueryConversation(d)
+}
+
+// Update returns a builder for updating this Draft.
+// Note that you need to call Draft.Unwrap() before calling this method if this Draft
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (d *Draft) Update() *DraftUpdateOne {
+	return NewDraftClient(d.config).UpdateOne(d)
+}
+
+// Unwrap unwraps the Draft entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (d *Draft) Unwrap() *Draft {
+	_tx, ok := d.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Draft is not a transactional entity")
+	}
+	d.config.driver = _tx.drv
+	return d
+}
+
+// String implements the fmt.Stringer.
+func (d *Draft) String() string {
+	var builder strings.Builder
+	builder.WriteString("Draft(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", d.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(d.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(d.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(d.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("conversation_id=")
+	builder.WriteString(d.ConversationID)
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(d.Content)
+	builder.WriteString(", ")
+	builder.WriteString("reply_to_id=")
+	builder.WriteString(d.ReplyToID)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Drafts is a parsable slice of Draft.
+type Drafts []*Draft
@@ -0,0 +1,366 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/draft"
+	"kakashi/chaos/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DraftCreate is the builder for creating a Draft entity.
+type DraftCreate struct {
+	config
+	mutation *DraftMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dc *DraftCreate) SetCreatedAt(t time.Time) *DraftCreate {
+	dc.mutation.SetCreatedAt(t)
+	return dc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dc *DraftCreate) SetNillableCreatedAt(t *time.Time) *DraftCreate {
+	if t != nil {
+		dc.SetCreatedAt(*t)
+	}
+	return dc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dc *DraftCreate) SetUpdatedAt(t time.Time) *DraftCreate {
+	dc.mutation.SetUpdatedAt(t)
+	return dc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dc *DraftCreate) SetNillableUpdatedAt(t *time.Time) *DraftCreate {
+	if t != nil {
+		dc.SetUpdatedAt(*t)
+	}
+	return dc
+}
+
+// SetUserID sets the "user_id" field.
+func (dc *DraftCreate) SetUserID(s string) *DraftCreate {
+	dc.mutation.SetUserID(s)
+	return dc
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (dc *DraftCreate) SetConversationID(s string) *DraftCreate {
+	dc.mutation.SetConversationID(s)
+	return dc
+}
+
+// SetContent sets the "content" field.
+func (dc *DraftCreate) SetContent(s string) *DraftCreate {
+	dc.mutation.SetContent(s)
+	return dc
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (dc *DraftCreate) SetNillableContent(s *string) *DraftCreate {
+	if s != nil {
+		dc.SetContent(*s)
+	}
+	return dc
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (dc *DraftCreate) SetReplyToID(s string) *DraftCreate {
+	dc.mutation.SetReplyToID(s)
+	return dc
+}
+
+// SetNillableReplyToID sets the "reply_to_id" field if the given value is not nil.
+func (dc *DraftCreate) SetNillableReplyToID(s *string) *DraftCreate {
+	if s != nil {
+		dc.SetReplyToID(*s)
+	}
+	return dc
+}
+
+// SetID sets the "id" field.
+func (dc *DraftCreate) SetID(s string) *DraftCreate {
+	dc.mutation.SetID(s)
+	return dc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (dc *DraftCreate) SetNillableID(s *string) *DraftCreate {
+	if s != nil {
+		dc.SetID(*s)
+	}
+	return dc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (dc *DraftCreate) SetUser(u *User) *DraftCreate {
+	return dc.SetUserID(u.ID)
+}
+
+// SetConversation sets the "conversation" edge to the Conversation entity.
+func (dc *DraftCreate) SetConversation(c *Conversation) *DraftCreate {
+	return dc.SetConversationID(c.ID)
+}
+
+// Mutation returns the DraftMutation object of the builder.
+func (dc *DraftCreate) Mutation() *DraftMutation {
+	return dc.mutation
+}
+
+// Save creates the Draft in the database.
+func (dc *DraftCreate) Save(ctx context.Context) (*Draft, error) {
+	dc.defaults()
+	return withHooks(ctx, dc.sqlSave, dc.mutation, dc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dc *DraftCreate) SaveX(ctx context.Context) *Draft {
+	v, err := dc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dc *DraftCreate) Exec(ctx context.Context) error {
+	_, err := dc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dc *DraftCreate) ExecX(ctx context.Context) {
+	if err := dc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dc *DraftCreate) defaults() {
+	if _, ok := dc.mutation.CreatedAt(); !ok {
+		v := draft.DefaultCreatedAt()
+		dc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dc.mutation.UpdatedAt(); !ok {
+		v := draft.DefaultUpdatedAt()
+		dc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := dc.mutation.ID(); !ok {
+		v := draft.DefaultID()
+		dc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dc *DraftCreate) check() error {
+	if _, ok := dc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Draft.created_at"`)}
+	}
+	if _, ok := dc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Draft.updated_at"`)}
+	}
+	if _, ok := dc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Draft.user_id"`)}
+	}
+	if v, ok := dc.mutation.UserID(); ok {
+		if err := draft.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Draft.user_id": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.ConversationID(); !ok {
+		return &ValidationError{Name: "conversation_id", err: errors.New(`ent: missing required field "Draft.conversation_id"`)}
+	}
+	if v, ok := dc.mutation.ConversationID(); ok {
+		if err := draft.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "Draft.conversation_id": %w`, err)}
+		}
+	}
+	if len(dc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Draft.user"`)}
+	}
+	if len(dc.mutation.ConversationIDs()) == 0 {
+		return &ValidationError{Name: "conversation", err: errors.New(`ent: missing required edge "Draft.conversation"`)}
+	}
+	return nil
+}
+
+func (dc *DraftCreate) sqlSave(ctx context.Context) (*Draft, error) {
+	if err := dc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Draft.ID type: %T", _spec.ID.Value)
+		}
+	}
+	dc.mutation.id = &_node.ID
+	dc.mutation.done = true
+	return _node, nil
+}
+
+func (dc *DraftCreate) createSpec() (*Draft, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Draft{config: dc.config}
+		_spec = sqlgraph.NewCreateSpec(draft.Table, sqlgraph.NewFieldSpec(draft.FieldID, field.TypeString))
+	)
+	if id, ok := dc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := dc.mutation.CreatedAt(); ok {
+		_spec.SetField(draft.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dc.mutation.UpdatedAt(); ok {
+		_spec.SetField(draft.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := dc.mutation.Content(); ok {
+		_spec.SetField(draft.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := dc.mutation.ReplyToID(); ok {
+		_spec.SetField(draft.FieldReplyToID, field.TypeString, value)
+		_node.ReplyToID = value
+	}
+	if nodes := dc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   draft.UserTable,
+			Columns: []string{draft.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   draft.ConversationTable,
+			Columns: []string{draft.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ConversationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DraftCreateBulk is the builder for creating many Draft entities in bulk.
+type DraftCreateBulk struct {
+	config
+	err      error
+	builders []*DraftCreate
+}
+
+// Save creates the Draft entities in the database.
+func (dcb *DraftCreateBulk) Save(ctx context.Context) ([]*Draft, error) {
+	if dcb.err != nil {
+		return nil, dcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dcb.builders))
+	nodes := make([]*Draft, len(dcb.builders))
+	mutators := make([]Mutator, len(dcb.builders))
+	for i := range dcb.builders {
+		func(i int, root context.Context) {
+			builder := dcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DraftMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dcb *DraftCreateBulk) SaveX(ctx context.Context) []*Draft {
+	v, err := dcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dcb *DraftCreateBulk) Exec(ctx context.Context) error {
+	_, err := dcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcb *DraftCreateBulk) ExecX(ctx context.Context) {
+	if err := dcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"kakashi/chaos/internal/ent/draft"
+	"kakashi/chaos/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DraftDelete is the builder for deleting a Draft entity.
+type DraftDelete struct {
+	config
+	hooks    []Hook
+	mutation *DraftMutation
+}
+
+// Where appends a list predicates to the DraftDelete builder.
+func (dd *DraftDelete) Where(ps ...predicate.Draft) *DraftDelete {
+	dd.mutation.Where(ps...)
+	return dd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (dd *DraftDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, dd.sqlExec, dd.mutation, dd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dd *DraftDelete) ExecX(ctx context.Context) int {
+	n, err := dd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (dd *DraftDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(draft.Table, sqlgraph.NewFieldSpec(draft.FieldID, field.TypeString))
+	if ps := dd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, dd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	dd.mutation.done = true
+	return affected, err
+}
+
+// DraftDeleteOne is the builder for deleting a single Draft entity.
+type DraftDeleteOne struct {
+	dd *DraftDelete
+}
+
+// Where appends a list predicates to the DraftDelete builder.
+func (ddo *DraftDeleteOne) Where(ps ...predicate.Draft) *DraftDeleteOne {
+	ddo.dd.mutation.Where(ps...)
+	return ddo
+}
+
+// Exec executes the deletion query.
+func (ddo *DraftDeleteOne) Exec(ctx context.Context) error {
+	n, err := ddo.dd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{draft.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ddo *DraftDeleteOne) ExecX(ctx context.Context) {
+	if err := ddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,616 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/draft"
+	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DraftUpdate is the builder for updating Draft entities.
+type DraftUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DraftMutation
+}
+
+// Where appends a list predicates to the DraftUpdate builder.
+func (du *DraftUpdate) Where(ps ...predicate.Draft) *DraftUpdate {
+	du.mutation.Where(ps...)
+	return du
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (du *DraftUpdate) SetCreatedAt(t time.Time) *DraftUpdate {
+	du.mutation.SetCreatedAt(t)
+	return du
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (du *DraftUpdate) SetNillableCreatedAt(t *time.Time) *DraftUpdate {
+	if t != nil {
+		du.SetCreatedAt(*t)
+	}
+	return du
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (du *DraftUpdate) SetUpdatedAt(t time.Time) *DraftUpdate {
+	du.mutation.SetUpdatedAt(t)
+	return du
+}
+
+// SetUserID sets the "user_id" field.
+func (du *DraftUpdate) SetUserID(s string) *DraftUpdate {
+	du.mutation.SetUserID(s)
+	return du
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (du *DraftUpdate) SetNillableUserID(s *string) *DraftUpdate {
+	if s != nil {
+		du.SetUserID(*s)
+	}
+	return du
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (du *DraftUpdate) SetConversationID(s string) *DraftUpdate {
+	du.mutation.SetConversationID(s)
+	return du
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (du *DraftUpdate) SetNillableConversationID(s *string) *DraftUpdate {
+	if s != nil {
+		du.SetConversationID(*s)
+	}
+	return du
+}
+
+// SetContent sets the "content" field.
+func (du *DraftUpdate) SetContent(s string) *DraftUpdate {
+	du.mutation.SetContent(s)
+	return du
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (du *DraftUpdate) SetNillableContent(s *string) *DraftUpdate {
+	if s != nil {
+		du.SetContent(*s)
+	}
+	return du
+}
+
+// ClearContent clears the value of the "content" field.
+func (du *DraftUpdate) ClearContent() *DraftUpdate {
+	du.mutation.ClearContent()
+	return du
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (du *DraftUpdate) SetReplyToID(s string) *DraftUpdate {
+	du.mutation.SetReplyToID(s)
+	return du
+}
+
+// SetNillableReplyToID sets the "reply_to_id" field if the given value is not nil.
+func (du *DraftUpdate) SetNillableReplyToID(s *string) *DraftUpdate {
+	if s != nil {
+		du.SetReplyToID(*s)
+	}
+	return du
+}
+
+// ClearReplyToID clears the value of the "reply_to_id" field.
+func (du *DraftUpdate) ClearReplyToID() *DraftUpdate {
+	du.mutation.ClearReplyToID()
+	return du
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (du *DraftUpdate) SetUser(u *User) *DraftUpdate {
+	return du.SetUserID(u.ID)
+}
+
+// SetConversation sets the "conversation" edge to the Conversation entity.
+func (du *DraftUpdate) SetConversation(c *Conversation) *DraftUpdate {
+	return du.SetConversationID(c.ID)
+}
+
+// Mutation returns the DraftMutation object of the builder.
+func (du *DraftUpdate) Mutation() *DraftMutation {
+	return du.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (du *DraftUpdate) ClearUser() *DraftUpdate {
+	du.mutation.ClearUser()
+	return du
+}
+
+// ClearConversation clears the "conversation" edge to the Conversation entity.
+func (du *DraftUpdate) ClearConversation() *DraftUpdate {
+	du.mutation.ClearConversation()
+	return du
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (du *DraftUpdate) Save(ctx context.Context) (int, error) {
+	du.defaults()
+	return withHooks(ctx, du.sqlSave, du.mutation, du.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (du *DraftUpdate) SaveX(ctx context.Context) int {
+	affected, err := du.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (du *DraftUpdate) Exec(ctx context.Context) error {
+	_, err := du.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (du *DraftUpdate) ExecX(ctx context.Context) {
+	if err := du.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (du *DraftUpdate) defaults() {
+	if _, ok := du.mutation.UpdatedAt(); !ok {
+		v := draft.UpdateDefaultUpdatedAt()
+		du.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (du *DraftUpdate) check() error {
+	if v, ok := du.mutation.UserID(); ok {
+		if err := draft.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Draft.user_id": %w`, err)}
+		}
+	}
+	if v, ok := du.mutation.ConversationID(); ok {
+		if err := draft.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "Draft.conversation_id": %w`, err)}
+		}
+	}
+	if du.mutation.UserCleared() && len(du.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Draft.user"`)
+	}
+	if du.mutation.ConversationCleared() && len(du.mutation.ConversationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Draft.conversation"`)
+	}
+	return nil
+}
+
+func (du *DraftUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := du.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(draft.Table, draft.Columns, sqlgraph.NewFieldSpec(draft.FieldID, field.TypeString))
+	if ps := du.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := du.mutation.CreatedAt(); ok {
+		_spec.SetField(draft.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := du.mutation.UpdatedAt(); ok {
+		_spec.SetField(draft.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := du.mutation.Content(); ok {
+		_spec.SetField(draft.FieldContent, field.TypeString, value)
+	}
+	if du.mutation.ContentCleared() {
+		_spec.ClearField(draft.FieldContent, field.TypeString)
+	}
+	if value, ok := du.mutation.ReplyToID(); ok {
+		_spec.SetField(draft.FieldReplyToID, field.TypeString, value)
+	}
+	if du.mutation.ReplyToIDCleared() {
+		_spec.ClearField(draft.FieldReplyToID, field.TypeString)
+	}
+	if du.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   draft.UserTable,
+			Columns: []string{draft.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   draft.UserTable,
+			Columns: []string{draft.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if du.mutation.ConversationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   draft.ConversationTable,
+			Columns: []string{draft.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   draft.ConversationTable,
+			Columns: []string{draft.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{draft.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	du.mutation.done = true
+	return n, nil
+}
+
+// DraftUpdateOne is the builder for updating a single Draft entity.
+type DraftUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DraftMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (duo *DraftUpdateOne) SetCreatedAt(t time.Time) *DraftUpdateOne {
+	duo.mutation.SetCreatedAt(t)
+	return duo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (duo *DraftUpdateOne) SetNillableCreatedAt(t *time.Time) *DraftUpdateOne {
+	if t != nil {
+		duo.SetCreatedAt(*t)
+	}
+	return duo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (duo *DraftUpdateOne) SetUpdatedAt(t time.Time) *DraftUpdateOne {
+	duo.mutation.SetUpdatedAt(t)
+	return duo
+}
+
+// SetUserID sets the "user_id" field.
+func (duo *DraftUpdateOne) SetUserID(s string) *DraftUpdateOne {
+	duo.mutation.SetUserID(s)
+	return duo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (duo *DraftUpdateOne) SetNillableUserID(s *string) *DraftUpdateOne {
+	if s != nil {
+		duo.SetUserID(*s)
+	}
+	return duo
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (duo *DraftUpdateOne) SetConversationID(s string) *DraftUpdateOne {
+	duo.mutation.SetConversationID(s)
+	return duo
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (duo *DraftUpdateOne) SetNillableConversationID(s *string) *DraftUpdateOne {
+	if s != nil {
+		duo.SetConversationID(*s)
+	}
+	return duo
+}
+
+// SetContent sets the "content" field.
+func (duo *DraftUpdateOne) SetContent(s string) *DraftUpdateOne {
+	duo.mutation.SetContent(s)
+	return duo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (duo *DraftUpdateOne) SetNillableContent(s *string) *DraftUpdateOne {
+	if s != nil {
+		duo.SetContent(*s)
+	}
+	return duo
+}
+
+// ClearContent clears the value of the "content" field.
+func (duo *DraftUpdateOne) ClearContent() *DraftUpdateOne {
+	duo.mutation.ClearContent()
+	return duo
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (duo *DraftUpdateOne) SetReplyToID(s string) *DraftUpdateOne {
+	duo.mutation.SetReplyToID(s)
+	return duo
+}
+
+// SetNillableReplyToID sets the "reply_to_id" field if the given value is not nil.
+func (duo *DraftUpdateOne) SetNillableReplyToID(s *string) *DraftUpdateOne {
+	if s != nil {
+		duo.SetReplyToID(*s)
+	}
+	return duo
+}
+
+// ClearReplyToID clears the value of the "reply_to_id" field.
+func (duo *DraftUpdateOne) ClearReplyToID() *DraftUpdateOne {
+	duo.mutation.ClearReplyToID()
+	return duo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (duo *DraftUpdateOne) SetUser(u *User) *DraftUpdateOne {
+	return duo.SetUserID(u.ID)
+}
+
+// SetConversation sets the "conversation" edge to the Conversation entity.
+func (duo *DraftUpdateOne) SetConversation(c *Conversation) *DraftUpdateOne {
+	return duo.SetConversationID(c.ID)
+}
+
+// Mutation returns the DraftMutation object of the builder.
+func (duo *DraftUpdateOne) Mutation() *DraftMutation {
+	return duo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (duo *DraftUpdateOne) ClearUser() *DraftUpdateOne {
+	duo.mutation.ClearUser()
+	return duo
+}
+
+// ClearConversation clears the "conversation" edge to the Conversation entity.
+func (duo *DraftUpdateOne) ClearConversation() *DraftUpdateOne {
+	duo.mutation.ClearConversation()
+	return duo
+}
+
+// Where appends a list predicates to the DraftUpdate builder.
+func (duo *DraftUpdateOne) Where(ps ...predicate.Draft) *DraftUpdateOne {
+	duo.mutation.Where(ps...)
+	return duo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (duo *DraftUpdateOne) Select(field string, fields ...string) *DraftUpdateOne {
+	duo.fields = append([]string{field}, fields...)
+	return duo
+}
+
+// Save executes the query and returns the updated Draft entity.
+func (duo *DraftUpdateOne) Save(ctx context.Context) (*Draft, error) {
+	duo.defaults()
+	return withHooks(ctx, duo.sqlSave, duo.mutation, duo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (duo *DraftUpdateOne) SaveX(ctx context.Context) *Draft {
+	node, err := duo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (duo *DraftUpdateOne) Exec(ctx context.Context) error {
+	_, err := duo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (duo *DraftUpdateOne) ExecX(ctx context.Context) {
+	if err := duo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (duo *DraftUpdateOne) defaults() {
+	if _, ok := duo.mutation.UpdatedAt(); !ok {
+		v := draft.UpdateDefaultUpdatedAt()
+		duo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (duo *DraftUpdateOne) check() error {
+	if v, ok := duo.mutation.UserID(); ok {
+		if err := draft.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Draft.user_id": %w`, err)}
+		}
+	}
+	if v, ok := duo.mutation.ConversationID(); ok {
+		if err := draft.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "Draft.conversation_id": %w`, err)}
+		}
+	}
+	if duo.mutation.UserCleared() && len(duo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Draft.user"`)
+	}
+	if duo.mutation.ConversationCleared() && len(duo.mutation.ConversationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Draft.conversation"`)
+	}
+	return nil
+}
+
+func (duo *DraftUpdateOne) sqlSave(ctx context.Context) (_node *Draft, err error) {
+	if err := duo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(draft.Table, draft.Columns, sqlgraph.NewFieldSpec(draft.FieldID, field.TypeString))
+	id, ok := duo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Draft.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := duo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, draft.FieldID)
+		for _, f := range fields {
+			if !draft.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != draft.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := duo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := duo.mutation.CreatedAt(); ok {
+		_spec.SetField(draft.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := duo.mutation.UpdatedAt(); ok {
+		_spec.SetField(draft.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := duo.mutation.Content(); ok {
+		_spec.SetField(draft.FieldContent, field.TypeString, value)
+	}
+	if duo.mutation.ContentCleared() {
+		_spec.ClearField(draft.FieldContent, field.TypeString)
+	}
+	if value, ok := duo.mutation.ReplyToID(); ok {
+		_spec.SetField(draft.FieldReplyToID, field.TypeString, value)
+	}
+	if duo.mutation.ReplyToIDCleared() {
+		_spec.ClearField(draft.FieldReplyToID, field.TypeString)
+	}
+	if duo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   draft.UserTable,
+			Columns: []string{draft.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   draft.UserTable,
+			Columns: []string{draft.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.ConversationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   draft.ConversationTable,
+			Columns: []string{draft.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   draft.ConversationTable,
+			Columns: []string{draft.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Draft{config: duo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, duo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{draft.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	duo.mutation.done = true
+	return _node, nil
+}
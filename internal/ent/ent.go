@@ -6,17 +6,33 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"kakashi/chaos/internal/ent/attachment"
 	"kakashi/chaos/internal/ent/block"
+	"kakashi/chaos/internal/ent/bookmark"
 	"kakashi/chaos/internal/ent/call"
 	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/conversationfolder"
 	"kakashi/chaos/internal/ent/conversationparticipant"
+	"kakashi/chaos/internal/ent/draft"
+	"kakashi/chaos/internal/ent/folderconversation"
 	"kakashi/chaos/internal/ent/friend"
+	"kakashi/chaos/internal/ent/friendinvite"
+	"kakashi/chaos/internal/ent/friendinviteuse"
 	"kakashi/chaos/internal/ent/guild"
+	"kakashi/chaos/internal/ent/hiddenmessage"
 	"kakashi/chaos/internal/ent/invitation"
+	"kakashi/chaos/internal/ent/linkpreview"
 	"kakashi/chaos/internal/ent/member"
 	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/messagemention"
+	"kakashi/chaos/internal/ent/messagereaction"
+	"kakashi/chaos/internal/ent/messagereceipt"
+	"kakashi/chaos/internal/ent/messagerevision"
 	"kakashi/chaos/internal/ent/notification"
+	"kakashi/chaos/internal/ent/pinnedmessage"
+	"kakashi/chaos/internal/ent/scheduledmessage"
 	"kakashi/chaos/internal/ent/session"
+	"kakashi/chaos/internal/ent/threadparticipant"
 	"kakashi/chaos/internal/ent/user"
 	"reflect"
 	"sync"
@@ -84,17 +100,33 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			attachment.Table:              attachment.ValidColumn,
 			block.Table:                   block.ValidColumn,
+			bookmark.Table:                bookmark.ValidColumn,
 			call.Table:                    call.ValidColumn,
 			conversation.Table:            conversation.ValidColumn,
+			conversationfolder.Table:      conversationfolder.ValidColumn,
 			conversationparticipant.Table: conversationparticipant.ValidColumn,
+			draft.Table:                   draft.ValidColumn,
+			folderconversation.Table:      folderconversation.ValidColumn,
 			friend.Table:                  friend.ValidColumn,
+			friendinvite.Table:            friendinvite.ValidColumn,
+			friendinviteuse.Table:         friendinviteuse.ValidColumn,
 			guild.Table:                   guild.ValidColumn,
+			hiddenmessage.Table:           hiddenmessage.ValidColumn,
 			invitation.Table:              invitation.ValidColumn,
+			linkpreview.Table:             linkpreview.ValidColumn,
 			member.Table:                  member.ValidColumn,
 			message.Table:                 message.ValidColumn,
+			messagemention.Table:          messagemention.ValidColumn,
+			messagereaction.Table:         messagereaction.ValidColumn,
+			messagereceipt.Table:          messagereceipt.ValidColumn,
+			messagerevision.Table:         messagerevision.ValidColumn,
 			notification.Table:            notification.ValidColumn,
+			pinnedmessage.Table:           pinnedmessage.ValidColumn,
+			scheduledmessage.Table:        scheduledmessage.ValidColumn,
 			session.Table:                 session.ValidColumn,
+			threadparticipant.Table:       threadparticipant.ValidColumn,
 			user.Table:                    user.ValidColumn,
 		})
 	})
@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/conversationfolder"
+	"kakashi/chaos/internal/ent/folderconversation"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// FolderConversation is the model entity for the FolderConversation schema.
+type FolderConversation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// FolderID holds the value of the "folder_id" field.
+	FolderID string `json:"folder_id,omitempty"`
+	// ConversationID holds the value of the "conversation_id" field.
+	ConversationID string `json:"conversation_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FolderConversationQuery when eager-loading is set.
+	Edges        FolderConversationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// FolderConversationEdges holds the relations/edges for other nodes in the graph.
+type FolderConversationEdges struct {
+	// Folder holds the value of the folder edge.
+	Folder *ConversationFolder `json:"folder,omitempty"`
+	// Conversation holds the value of the conversation edge.
+	Conversation *Conversation `json:"conversation,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// FolderOrErr returns the Folder value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FolderConversationEdges) FolderOrErr() (*ConversationFolder, error) {
+	if e.Folder != nil {
+		return e.Folder, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: conversationfolder.Label}
+	}
+	return nil, &NotLoadedError{edge: "folder"}
+}
+
+// ConversationOrErr returns the Conversation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FolderConversationEdges) ConversationOrErr() (*Conversation, error) {
+	if e.Conversation != nil {
+		return e.Conversation, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: conversation.Label}
+	}
+	return nil, &NotLoadedError{edge: "conversation"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FolderConversation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case folderconversation.FieldID, folderconversation.FieldFolderID, folderconversation.FieldConversationID:
+			values[i] = new(sql.NullString)
+		case folderconversation.FieldCreatedAt, folderconversation.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FolderConversation fields.
+func (fc *FolderConversation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case folderconversation.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				fc.ID = value.String
+			}
+		case folderconversation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				fc.CreatedAt = value.Time
+			}
+		case folderconversation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				fc.UpdatedAt = value.Time
+			}
+		case folderconversation.FieldFolderID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field folder_id", values[i])
+			} else if value.Valid {
+				fc.FolderID = value.String
+			}
+		case folderconversation.FieldConversationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field conversation_id", values[i])
+			} else if value.Valid {
+				fc.ConversationID = value.String
+			}
+		default:
+			fc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FolderConversation.
+// This includes values selected through modifiers, order, etc.
+func (fc *FolderConversation) Value(name string) (ent.Value, error) {
+	return fc.selectValues.Get(name)
+}
+
+// QueryFolder queries the "folder" edge of the FolderConversation entity.
+func (fc *FolderConversation) QueryFolder() *ConversationFolderQuery {
+	return NewFolderConversationClient(fc.config).QueryFolder(fc)
+}
+
+// QueryConversation queries the "conversation" edge of the FolderConversation entity.
+func (fc *FolderConversation) QueryConversation() *ConversationQuery {
+	return NewFolderConversationClient(fc.config).QueryConversation(fc)
+}
+
+// Update returns a builder for updating this FolderConversation.
+// Note that you need to call FolderConversation.Unwrap() before calling this method if this FolderConversation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (fc *FolderConversation) Update() *FolderConversationUpdateOne {
+	return NewFolderConversationClient(fc.config).UpdateOne(fc)
+}
+
+// Unwrap unwraps the FolderConversation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (fc *FolderConversation) Unwrap() *FolderConversation {
+	_tx, ok := fc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FolderConversation is not a transactional entity")
+	}
+	fc.config.driver = _tx.drv
+	return fc
+}
+
+// String implements the fmt.Stringer.
+func (fc *FolderConversation) String() string {
+	var builder strings.Builder
+	builder.WriteString("FolderConversation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", fc.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("folder_id=")
+	builder.WriteString(fc.FolderID)
+	builder.WriteString(", ")
+	builder.WriteString("conversation_id=")
+	builder.WriteString(fc.ConversationID)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FolderConversations is a parsable slice of FolderConversation.
+type FolderConversations []*FolderConversation
@@ -0,0 +1,357 @@
+// Code generated by ent, DO NOT EDIT.
+
+package folderconversation
+
+import (
+	"kakashi/chaos/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// FolderID applies equality check predicate on the "folder_id" field. It's identical to FolderIDEQ.
+func FolderID(v string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldEQ(FieldFolderID, v))
+}
+
+// ConversationID applies equality check predicate on the "conversation_id" field. It's identical to ConversationIDEQ.
+func ConversationID(v string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldEQ(FieldConversationID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// FolderIDEQ applies the EQ predicate on the "folder_id" field.
+func FolderIDEQ(v string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldEQ(FieldFolderID, v))
+}
+
+// FolderIDNEQ applies the NEQ predicate on the "folder_id" field.
+func FolderIDNEQ(v string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldNEQ(FieldFolderID, v))
+}
+
+// FolderIDIn applies the In predicate on the "folder_id" field.
+func FolderIDIn(vs ...string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldIn(FieldFolderID, vs...))
+}
+
+// FolderIDNotIn applies the NotIn predicate on the "folder_id" field.
+func FolderIDNotIn(vs ...string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldNotIn(FieldFolderID, vs...))
+}
+
+// FolderIDGT applies the GT predicate on the "folder_id" field.
+func FolderIDGT(v string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldGT(FieldFolderID, v))
+}
+
+// FolderIDGTE applies the GTE predicate on the "folder_id" field.
+func FolderIDGTE(v string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldGTE(FieldFolderID, v))
+}
+
+// FolderIDLT applies the LT predicate on the "folder_id" field.
+func FolderIDLT(v string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldLT(FieldFolderID, v))
+}
+
+// FolderIDLTE applies the LTE predicate on the "folder_id" field.
+func FolderIDLTE(v string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldLTE(FieldFolderID, v))
+}
+
+// FolderIDContains applies the Contains predicate on the "folder_id" field.
+func FolderIDContains(v string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldContains(FieldFolderID, v))
+}
+
+// FolderIDHasPrefix applies the HasPrefix predicate on the "folder_id" field.
+func FolderIDHasPrefix(v string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldHasPrefix(FieldFolderID, v))
+}
+
+// FolderIDHasSuffix applies the HasSuffix predicate on the "folder_id" field.
+func FolderIDHasSuffix(v string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldHasSuffix(FieldFolderID, v))
+}
+
+// FolderIDEqualFold applies the EqualFold predicate on the "folder_id" field.
+func FolderIDEqualFold(v string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldEqualFold(FieldFolderID, v))
+}
+
+// FolderIDContainsFold applies the ContainsFold predicate on the "folder_id" field.
+func FolderIDContainsFold(v string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldContainsFold(FieldFolderID, v))
+}
+
+// ConversationIDEQ applies the EQ predicate on the "conversation_id" field.
+func ConversationIDEQ(v string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldEQ(FieldConversationID, v))
+}
+
+// ConversationIDNEQ applies the NEQ predicate on the "conversation_id" field.
+func ConversationIDNEQ(v string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldNEQ(FieldConversationID, v))
+}
+
+// ConversationIDIn applies the In predicate on the "conversation_id" field.
+func ConversationIDIn(vs ...string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldIn(FieldConversationID, vs...))
+}
+
+// ConversationIDNotIn applies the NotIn predicate on the "conversation_id" field.
+func ConversationIDNotIn(vs ...string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldNotIn(FieldConversationID, vs...))
+}
+
+// ConversationIDGT applies the GT predicate on the "conversation_id" field.
+func ConversationIDGT(v string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldGT(FieldConversationID, v))
+}
+
+// ConversationIDGTE applies the GTE predicate on the "conversation_id" field.
+func ConversationIDGTE(v string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldGTE(FieldConversationID, v))
+}
+
+// ConversationIDLT applies the LT predicate on the "conversation_id" field.
+func ConversationIDLT(v string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldLT(FieldConversationID, v))
+}
+
+// ConversationIDLTE applies the LTE predicate on the "conversation_id" field.
+func ConversationIDLTE(v string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldLTE(FieldConversationID, v))
+}
+
+// ConversationIDContains applies the Contains predicate on the "conversation_id" field.
+func ConversationIDContains(v string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldContains(FieldConversationID, v))
+}
+
+// ConversationIDHasPrefix applies the HasPrefix predicate on the "conversation_id" field.
+func ConversationIDHasPrefix(v string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldHasPrefix(FieldConversationID, v))
+}
+
+// ConversationIDHasSuffix applies the HasSuffix predicate on the "conversation_id" field.
+func ConversationIDHasSuffix(v string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldHasSuffix(FieldConversationID, v))
+}
+
+// ConversationIDEqualFold applies the EqualFold predicate on the "conversation_id" field.
+func ConversationIDEqualFold(v string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldEqualFold(FieldConversationID, v))
+}
+
+// ConversationIDContainsFold applies the ContainsFold predicate on the "conversation_id" field.
+func ConversationIDContainsFold(v string) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.FieldContainsFold(FieldConversationID, v))
+}
+
+// HasFolder applies the HasEdge predicate on the "folder" edge.
+func HasFolder() predicate.FolderConversation {
+	return predicate.FolderConversation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, FolderTable, FolderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFolderWith applies the HasEdge predicate on the "folder" edge with a given conditions (other predicates).
+func HasFolderWith(preds ...predicate.ConversationFolder) predicate.FolderConversation {
+	return predicate.FolderConversation(func(s *sql.Selector) {
+		step := newFolderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasConversation applies the HasEdge predicate on the "conversation" edge.
+func HasConversation() predicate.FolderConversation {
+	return predicate.FolderConversation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ConversationTable, ConversationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConversationWith applies the HasEdge predicate on the "conversation" edge with a given conditions (other predicates).
+func HasConversationWith(preds ...predicate.Conversation) predicate.FolderConversation {
+	return predicate.FolderConversation(func(s *sql.Selector) {
+		step := newConversationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FolderConversation) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FolderConversation) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FolderConversation) predicate.FolderConversation {
+	return predicate.FolderConversation(sql.NotPredicates(p))
+}
@@ -0,0 +1,330 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/conversationfolder"
+	"kakashi/chaos/internal/ent/folderconversation"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FolderConversationCreate is the builder for creating a FolderConversation entity.
+type FolderConversationCreate struct {
+	config
+	mutation *FolderConversationMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (fcc *FolderConversationCreate) SetCreatedAt(t time.Time) *FolderConversationCreate {
+	fcc.mutation.SetCreatedAt(t)
+	return fcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (fcc *FolderConversationCreate) SetNillableCreatedAt(t *time.Time) *FolderConversationCreate {
+	if t != nil {
+		fcc.SetCreatedAt(*t)
+	}
+	return fcc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fcc *FolderConversationCreate) SetUpdatedAt(t time.Time) *FolderConversationCreate {
+	fcc.mutation.SetUpdatedAt(t)
+	return fcc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (fcc *FolderConversationCreate) SetNillableUpdatedAt(t *time.Time) *FolderConversationCreate {
+	if t != nil {
+		fcc.SetUpdatedAt(*t)
+	}
+	return fcc
+}
+
+// SetFolderID sets the "folder_id" field.
+func (fcc *FolderConversationCreate) SetFolderID(s string) *FolderConversationCreate {
+	fcc.mutation.SetFolderID(s)
+	return fcc
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (fcc *FolderConversationCreate) SetConversationID(s string) *FolderConversationCreate {
+	fcc.mutation.SetConversationID(s)
+	return fcc
+}
+
+// SetID sets the "id" field.
+func (fcc *FolderConversationCreate) SetID(s string) *FolderConversationCreate {
+	fcc.mutation.SetID(s)
+	return fcc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (fcc *FolderConversationCreate) SetNillableID(s *string) *FolderConversationCreate {
+	if s != nil {
+		fcc.SetID(*s)
+	}
+	return fcc
+}
+
+// SetFolder sets the "folder" edge to the ConversationFolder entity.
+func (fcc *FolderConversationCreate) SetFolder(c *ConversationFolder) *FolderConversationCreate {
+	return fcc.SetFolderID(c.ID)
+}
+
+// SetConversation sets the "conversation" edge to the Conversation entity.
+func (fcc *FolderConversationCreate) SetConversation(c *Conversation) *FolderConversationCreate {
+	return fcc.SetConversationID(c.ID)
+}
+
+// Mutation returns the FolderConversationMutation object of the builder.
+func (fcc *FolderConversationCreate) Mutation() *FolderConversationMutation {
+	return fcc.mutation
+}
+
+// Save creates the FolderConversation in the database.
+func (fcc *FolderConversationCreate) Save(ctx context.Context) (*FolderConversation, error) {
+	fcc.defaults()
+	return withHooks(ctx, fcc.sqlSave, fcc.mutation, fcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (fcc *FolderConversationCreate) SaveX(ctx context.Context) *FolderConversation {
+	v, err := fcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fcc *FolderConversationCreate) Exec(ctx context.Context) error {
+	_, err := fcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fcc *FolderConversationCreate) ExecX(ctx context.Context) {
+	if err := fcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fcc *FolderConversationCreate) defaults() {
+	if _, ok := fcc.mutation.CreatedAt(); !ok {
+		v := folderconversation.DefaultCreatedAt()
+		fcc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := fcc.mutation.UpdatedAt(); !ok {
+		v := folderconversation.DefaultUpdatedAt()
+		fcc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := fcc.mutation.ID(); !ok {
+		v := folderconversation.DefaultID()
+		fcc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fcc *FolderConversationCreate) check() error {
+	if _, ok := fcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FolderConversation.created_at"`)}
+	}
+	if _, ok := fcc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "FolderConversation.updated_at"`)}
+	}
+	if _, ok := fcc.mutation.FolderID(); !ok {
+		return &ValidationError{Name: "folder_id", err: errors.New(`ent: missing required field "FolderConversation.folder_id"`)}
+	}
+	if v, ok := fcc.mutation.FolderID(); ok {
+		if err := folderconversation.FolderIDValidator(v); err != nil {
+			return &ValidationError{Name: "folder_id", err: fmt.Errorf(`ent: validator failed for field "FolderConversation.folder_id": %w`, err)}
+		}
+	}
+	if _, ok := fcc.mutation.ConversationID(); !ok {
+		return &ValidationError{Name: "conversation_id", err: errors.New(`ent: missing required field "FolderConversation.conversation_id"`)}
+	}
+	if v, ok := fcc.mutation.ConversationID(); ok {
+		if err := folderconversation.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "FolderConversation.conversation_id": %w`, err)}
+		}
+	}
+	if len(fcc.mutation.FolderIDs()) == 0 {
+		return &ValidationError{Name: "folder", err: errors.New(`ent: missing required edge "FolderConversation.folder"`)}
+	}
+	if len(fcc.mutation.ConversationIDs()) == 0 {
+		return &ValidationError{Name: "conversation", err: errors.New(`ent: missing required edge "FolderConversation.conversation"`)}
+	}
+	return nil
+}
+
+func (fcc *FolderConversationCreate) sqlSave(ctx context.Context) (*FolderConversation, error) {
+	if err := fcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := fcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, fcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected FolderConversation.ID type: %T", _spec.ID.Value)
+		}
+	}
+	fcc.mutation.id = &_node.ID
+	fcc.mutation.done = true
+	return _node, nil
+}
+
+func (fcc *FolderConversationCreate) createSpec() (*FolderConversation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FolderConversation{config: fcc.config}
+		_spec = sqlgraph.NewCreateSpec(folderconversation.Table, sqlgraph.NewFieldSpec(folderconversation.FieldID, field.TypeString))
+	)
+	if id, ok := fcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := fcc.mutation.CreatedAt(); ok {
+		_spec.SetField(folderconversation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := fcc.mutation.UpdatedAt(); ok {
+		_spec.SetField(folderconversation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := fcc.mutation.FolderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   folderconversation.FolderTable,
+			Columns: []string{folderconversation.FolderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationfolder.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FolderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fcc.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   folderconversation.ConversationTable,
+			Columns: []string{folderconversation.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ConversationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// FolderConversationCreateBulk is the builder for creating many FolderConversation entities in bulk.
+type FolderConversationCreateBulk struct {
+	config
+	err      error
+	builders []*FolderConversationCreate
+}
+
+// Save creates the FolderConversation entities in the database.
+func (fccb *FolderConversationCreateBulk) Save(ctx context.Context) ([]*FolderConversation, error) {
+	if fccb.err != nil {
+		return nil, fccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(fccb.builders))
+	nodes := make([]*FolderConversation, len(fccb.builders))
+	mutators := make([]Mutator, len(fccb.builders))
+	for i := range fccb.builders {
+		func(i int, root context.Context) {
+			builder := fccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FolderConversationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, fccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, fccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, fccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fccb *FolderConversationCreateBulk) SaveX(ctx context.Context) []*FolderConversation {
+	v, err := fccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fccb *FolderConversationCreateBulk) Exec(ctx context.Context) error {
+	_, err := fccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fccb *FolderConversationCreateBulk) ExecX(ctx context.Context) {
+	if err := fccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
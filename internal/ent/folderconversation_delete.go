@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"kakashi/chaos/internal/ent/folderconversation"
+	"kakashi/chaos/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FolderConversationDelete is the builder for deleting a FolderConversation entity.
+type FolderConversationDelete struct {
+	config
+	hooks    []Hook
+	mutation *FolderConversationMutation
+}
+
+// Where appends a list predicates to the FolderConversationDelete builder.
+func (fcd *FolderConversationDelete) Where(ps ...predicate.FolderConversation) *FolderConversationDelete {
+	fcd.mutation.Where(ps...)
+	return fcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (fcd *FolderConversationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, fcd.sqlExec, fcd.mutation, fcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fcd *FolderConversationDelete) ExecX(ctx context.Context) int {
+	n, err := fcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (fcd *FolderConversationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(folderconversation.Table, sqlgraph.NewFieldSpec(folderconversation.FieldID, field.TypeString))
+	if ps := fcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, fcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	fcd.mutation.done = true
+	return affected, err
+}
+
+// FolderConversationDeleteOne is the builder for deleting a single FolderConversation entity.
+type FolderConversationDeleteOne struct {
+	fcd *FolderConversationDelete
+}
+
+// Where appends a list predicates to the FolderConversationDelete builder.
+func (fcdo *FolderConversationDeleteOne) Where(ps ...predicate.FolderConversation) *FolderConversationDeleteOne {
+	fcdo.fcd.mutation.Where(ps...)
+	return fcdo
+}
+
+// Exec executes the deletion query.
+func (fcdo *FolderConversationDeleteOne) Exec(ctx context.Context) error {
+	n, err := fcdo.fcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{folderconversation.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fcdo *FolderConversationDeleteOne) ExecX(ctx context.Context) {
+	if err := fcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/conversationfolder"
+	"kakashi/chaos/internal/ent/folderconversation"
+	"kakashi/chaos/internal/ent/predicate"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FolderConversationQuery is the builder for querying FolderConversation entities.
+type FolderConversationQuery struct {
+	config
+	ctx              *QueryContext
+	order            []folderconversation.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.FolderConversation
+	withFolder       *ConversationFolderQuery
+	withConversation *ConversationQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the FolderConversationQuery builder.
+func (fcq *FolderConversationQuery) Where(ps ...predicate.FolderConversation) *FolderConversationQuery {
+	fcq.predicates = append(fcq.predicates, ps...)
+	return fcq
+}
+
+// Limit the number of records to be returned by this query.
+func (fcq *FolderConversationQuery) Limit(limit int) *FolderConversationQuery {
+	fcq.ctx.Limit = &limit
+	return fcq
+}
+
+// Offset to start from.
+func (fcq *FolderConversationQuery) Offset(offset int) *FolderConversationQuery {
+	fcq.ctx.Offset = &offset
+	return fcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (fcq *FolderConversationQuery) Unique(unique bool) *FolderConversationQuery {
+	fcq.ctx.Unique = &unique
+	return fcq
+}
+
+// Order specifies how the records should be ordered.
+func (fcq *FolderConversationQuery) Order(o ...folderconversation.OrderOption) *FolderConversationQuery {
+	fcq.order = append(fcq.order, o...)
+	return fcq
+}
+
+// QueryFolder chains the current query on the "folder" edge.
+func (fcq *FolderConversationQuery) QueryFolder() *ConversationFolderQuery {
+	query := (&ConversationFolderClient{config: fcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := fcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(folderconversation.Table, folderconversation.FieldID, selector),
+			sqlgraph.To(conversationfolder.Table, conversationfolder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, folderconversation.FolderTable, folderconversation.FolderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryConversation chains the current query on the "conversation" edge.
+func (fcq *FolderConversationQuery) QueryConversation() *ConversationQuery {
+	query := (&ConversationClient{config: fcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := fcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(folderconversation.Table, folderconversation.FieldID, selector),
+			sqlgraph.To(conversation.Table, conversation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, folderconversation.ConversationTable, folderconversation.ConversationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first FolderConversation entity from the query.
+// Returns a *NotFoundError when no FolderConversation was found.
+func (fcq *FolderConversationQuery) First(ctx context.Context) (*FolderConversation, error) {
+	nodes, err := fcq.Limit(1).All(setContextOp(ctx, fcq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{folderconversation.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (fcq *FolderConversationQuery) FirstX(ctx context.Context) *FolderConversation {
+	node, err := fcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first FolderConversation ID from the query.
+// Returns a *NotFoundError when no FolderConversation ID was found.
+func (fcq *FolderConversationQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = fcq.Limit(1).IDs(setContextOp(ctx, fcq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{folderconversation.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (fcq *FolderConversationQuery) FirstIDX(ctx context.Context) string {
+	id, err := fcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single FolderConversation entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one FolderConversation entity is found.
+// Returns a *NotFoundError when no FolderConversation entities are found.
+func (fcq *FolderConversationQuery) Only(ctx context.Context) (*FolderConversation, error) {
+	nodes, err := fcq.Limit(2).All(setContextOp(ctx, fcq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{folderconversation.Label}
+	default:
+		return nil, &NotSingularError{folderconversation.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (fcq *FolderConversationQuery) OnlyX(ctx context.Context) *FolderConversation {
+	node, err := fcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only FolderConversation ID in the query.
+// Returns a *NotSingularError when more than one FolderConversation ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (fcq *FolderConversationQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = fcq.Limit(2).IDs(setContextOp(ctx, fcq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{folderconversation.Label}
+	default:
+		err = &NotSingularError{folderconversation.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (fcq *FolderConversationQuery) OnlyIDX(ctx context.Context) string {
+	id, err := fcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of FolderConversations.
+func (fcq *FolderConversationQuery) All(ctx context.Context) ([]*FolderConversation, error) {
+	ctx = setContextOp(ctx, fcq.ctx, ent.OpQueryAll)
+	if err := fcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*FolderConversation, *FolderConversationQuery]()
+	return withInterceptors[[]*FolderConversation](ctx, fcq, qr, fcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (fcq *FolderConversationQuery) AllX(ctx context.Context) []*FolderConversation {
+	nodes, err := fcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of FolderConversation IDs.
+func (fcq *FolderConversationQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if fcq.ctx.Unique == nil && fcq.path != nil {
+		fcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, fcq.ctx, ent.OpQueryIDs)
+	if err = fcq.Select(folderconversation.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (fcq *FolderConversationQuery) IDsX(ctx context.Context) []string {
+	ids, err := fcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (fcq *FolderConversationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, fcq.ctx, ent.OpQueryCount)
+	if err := fcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, fcq, querierCount[*FolderConversationQuery](), fcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (fcq *FolderConversationQuery) CountX(ctx context.Context) int {
+	count, err := fcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (fcq *FolderConversationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, fcq.ctx, ent.OpQueryExist)
+	switch _, err := fcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (fcq *FolderConversationQuery) ExistX(ctx context.Context) bool {
+	exist, err := fcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the FolderConversationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (fcq *FolderConversationQuery) Clone() *FolderConversationQuery {
+	if fcq == nil {
+		return nil
+	}
+	return &FolderConversationQuery{
+		config:           fcq.config,
+		ctx:              fcq.ctx.Clone(),
+		order:            append([]folderconversation.OrderOption{}, fcq.order...),
+		inters:           append([]Interceptor{}, fcq.inters...),
+		predicates:       append([]predicate.FolderConversation{}, fcq.predicates...),
+		withFolder:       fcq.withFolder.Clone(),
+		withConversation: fcq.withConversation.Clone(),
+		// clone intermediate query.
+		sql:  fcq.sql.Clone(),
+		path: fcq.path,
+	}
+}
+
+// WithFolder tells the query-builder to eager-load the nodes that are connected to
+// the "folder" edge. The optional arguments are used to configure the query builder of the edge.
+func (fcq *FolderConversationQuery) WithFolder(opts ...func(*ConversationFolderQuery)) *FolderConversationQuery {
+	query := (&ConversationFolderClient{config: fcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	fcq.withFolder = query
+	return fcq
+}
+
+// WithConversation tells the query-builder to eager-load the nodes that are connected to
+// the "conversation" edge. The optional arguments are used to configure the query builder of the edge.
+func (fcq *FolderConversationQuery) WithConversation(opts ...func(*ConversationQuery)) *FolderConversationQuery {
+	query := (&ConversationClient{config: fcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	fcq.withConversation = query
+	return fcq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.FolderConversation.Query().
+//		GroupBy(folderconversation.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (fcq *FolderConversationQuery) GroupBy(field string, fields ...string) *FolderConversationGroupBy {
+	fcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &FolderConversationGroupBy{build: fcq}
+	grbuild.flds = &fcq.ctx.Fields
+	grbuild.label = folderconversation.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.FolderConversation.Query().
+//		Select(folderconversation.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (fcq *FolderConversationQuery) Select(fields ...string) *FolderConversationSelect {
+	fcq.ctx.Fields = append(fcq.ctx.Fields, fields...)
+	sbuild := &FolderConversationSelect{FolderConversationQuery: fcq}
+	sbuild.label = folderconversation.Label
+	sbuild.flds, sbuild.scan = &fcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a FolderConversationSelect configured with the given aggregations.
+func (fcq *FolderConversationQuery) Aggregate(fns ...AggregateFunc) *FolderConversationSelect {
+	return fcq.Select().Aggregate(fns...)
+}
+
+func (fcq *FolderConversationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range fcq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, fcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range fcq.ctx.Fields {
+		if !folderconversation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if fcq.path != nil {
+		prev, err := fcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		fcq.sql = prev
+	}
+	return nil
+}
+
+func (fcq *FolderConversationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*FolderConversation, error) {
+	var (
+		nodes       = []*FolderConversation{}
+		_spec       = fcq.querySpec()
+		loadedTypes = [2]bool{
+			fcq.withFolder != nil,
+			fcq.withConversation != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*FolderConversation).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &FolderConversation{config: fcq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, fcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := fcq.withFolder; query != nil {
+		if err := fcq.loadFolder(ctx, query, nodes, nil,
+			func(n *FolderConversation, e *ConversationFolder) { n.Edges.Folder = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := fcq.withConversation; query != nil {
+		if err := fcq.loadConversation(ctx, query, nodes, nil,
+			func(n *FolderConversation, e *Conversation) { n.Edges.Conversation = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (fcq *FolderConversationQuery) loadFolder(ctx context.Context, query *ConversationFolderQuery, nodes []*FolderConversation, init func(*FolderConversation), assign func(*FolderConversation, *ConversationFolder)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*FolderConversation)
+	for i := range nodes {
+		fk := nodes[i].FolderID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(conversationfolder.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "folder_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (fcq *FolderConversationQuery) loadConversation(ctx context.Context, query *ConversationQuery, nodes []*FolderConversation, init func(*FolderConversation), assign func(*FolderConversation, *Conversation)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*FolderConversation)
+	for i := range nodes {
+		fk := nodes[i].ConversationID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(conversation.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "conversation_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (fcq *FolderConversationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := fcq.querySpec()
+	_spec.Node.Columns = fcq.ctx.Fields
+	if len(fcq.ctx.Fields) > 0 {
+		_spec.Unique = fcq.ctx.Unique != nil && *fcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, fcq.driver, _spec)
+}
+
+func (fcq *FolderConversationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(folderconversation.Table, folderconversation.Columns, sqlgraph.NewFieldSpec(folderconversation.FieldID, field.TypeString))
+	_spec.From = fcq.sql
+	if unique := fcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if fcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := fcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, folderconversation.FieldID)
+		for i := range fields {
+			if fields[i] != folderconversation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if fcq.withFolder != nil {
+			_spec.Node.AddColumnOnce(folderconversation.FieldFolderID)
+		}
+		if fcq.withConversation != nil {
+			_spec.Node.AddColumnOnce(folderconversation.FieldConversationID)
+		}
+	}
+	if ps := fcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := fcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := fcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := fcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (fcq *FolderConversationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(fcq.driver.Dialect())
+	t1 := builder.Table(folderconversation.Table)
+	columns := fcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = folderconversation.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if fcq.sql != nil {
+		selector = fcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if fcq.ctx.Unique != nil && *fcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range fcq.predicates {
+		p(selector)
+	}
+	for _, p := range fcq.order {
+		p(selector)
+	}
+	if offset := fcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := fcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// FolderConversationGroupBy is the group-by builder for FolderConversation entities.
+type FolderConversationGroupBy struct {
+	selector
+	build *FolderConversationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (fcgb *FolderConversationGroupBy) Aggregate(fns ...AggregateFunc) *FolderConversationGroupBy {
+	fcgb.fns = append(fcgb.fns, fns...)
+	return fcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (fcgb *FolderConversationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, fcgb.build.ctx, ent.OpQueryGroupBy)
+	if err := fcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FolderConversationQuery, *FolderConversationGroupBy](ctx, fcgb.build, fcgb, fcgb.build.inters, v)
+}
+
+func (fcgb *FolderConversationGroupBy) sqlScan(ctx context.Context, root *FolderConversationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(fcgb.fns))
+	for _, fn := range fcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*fcgb.flds)+len(fcgb.fns))
+		for _, f := range *fcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*fcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := fcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// FolderConversationSelect is the builder for selecting fields of FolderConversation entities.
+type FolderConversationSelect struct {
+	*FolderConversationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (fcs *FolderConversationSelect) Aggregate(fns ...AggregateFunc) *FolderConversationSelect {
+	fcs.fns = append(fcs.fns, fns...)
+	return fcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (fcs *FolderConversationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, fcs.ctx, ent.OpQuerySelect)
+	if err := fcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FolderConversationQuery, *FolderConversationSelect](ctx, fcs.FolderConversationQuery, fcs, fcs.inters, v)
+}
+
+func (fcs *FolderConversationSelect) sqlScan(ctx context.Context, root *FolderConversationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(fcs.fns))
+	for _, fn := range fcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*fcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := fcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
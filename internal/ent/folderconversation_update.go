@@ -0,0 +1,512 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/conversationfolder"
+	"kakashi/chaos/internal/ent/folderconversation"
+	"kakashi/chaos/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FolderConversationUpdate is the builder for updating FolderConversation entities.
+type FolderConversationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FolderConversationMutation
+}
+
+// Where appends a list predicates to the FolderConversationUpdate builder.
+func (fcu *FolderConversationUpdate) Where(ps ...predicate.FolderConversation) *FolderConversationUpdate {
+	fcu.mutation.Where(ps...)
+	return fcu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (fcu *FolderConversationUpdate) SetCreatedAt(t time.Time) *FolderConversationUpdate {
+	fcu.mutation.SetCreatedAt(t)
+	return fcu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (fcu *FolderConversationUpdate) SetNillableCreatedAt(t *time.Time) *FolderConversationUpdate {
+	if t != nil {
+		fcu.SetCreatedAt(*t)
+	}
+	return fcu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fcu *FolderConversationUpdate) SetUpdatedAt(t time.Time) *FolderConversationUpdate {
+	fcu.mutation.SetUpdatedAt(t)
+	return fcu
+}
+
+// SetFolderID sets the "folder_id" field.
+func (fcu *FolderConversationUpdate) SetFolderID(s string) *FolderConversationUpdate {
+	fcu.mutation.SetFolderID(s)
+	return fcu
+}
+
+// SetNillableFolderID sets the "folder_id" field if the given value is not nil.
+func (fcu *FolderConversationUpdate) SetNillableFolderID(s *string) *FolderConversationUpdate {
+	if s != nil {
+		fcu.SetFolderID(*s)
+	}
+	return fcu
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (fcu *FolderConversationUpdate) SetConversationID(s string) *FolderConversationUpdate {
+	fcu.mutation.SetConversationID(s)
+	return fcu
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (fcu *FolderConversationUpdate) SetNillableConversationID(s *string) *FolderConversationUpdate {
+	if s != nil {
+		fcu.SetConversationID(*s)
+	}
+	return fcu
+}
+
+// SetFolder sets the "folder" edge to the ConversationFolder entity.
+func (fcu *FolderConversationUpdate) SetFolder(c *ConversationFolder) *FolderConversationUpdate {
+	return fcu.SetFolderID(c.ID)
+}
+
+// SetConversation sets the "conversation" edge to the Conversation entity.
+func (fcu *FolderConversationUpdate) SetConversation(c *Conversation) *FolderConversationUpdate {
+	return fcu.SetConversationID(c.ID)
+}
+
+// Mutation returns the FolderConversationMutation object of the builder.
+func (fcu *FolderConversationUpdate) Mutation() *FolderConversationMutation {
+	return fcu.mutation
+}
+
+// ClearFolder clears the "folder" edge to the ConversationFolder entity.
+func (fcu *FolderConversationUpdate) ClearFolder() *FolderConversationUpdate {
+	fcu.mutation.ClearFolder()
+	return fcu
+}
+
+// ClearConversation clears the "conversation" edge to the Conversation entity.
+func (fcu *FolderConversationUpdate) ClearConversation() *FolderConversationUpdate {
+	fcu.mutation.ClearConversation()
+	return fcu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (fcu *FolderConversationUpdate) Save(ctx context.Context) (int, error) {
+	fcu.defaults()
+	return withHooks(ctx, fcu.sqlSave, fcu.mutation, fcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fcu *FolderConversationUpdate) SaveX(ctx context.Context) int {
+	affected, err := fcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (fcu *FolderConversationUpdate) Exec(ctx context.Context) error {
+	_, err := fcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fcu *FolderConversationUpdate) ExecX(ctx context.Context) {
+	if err := fcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fcu *FolderConversationUpdate) defaults() {
+	if _, ok := fcu.mutation.UpdatedAt(); !ok {
+		v := folderconversation.UpdateDefaultUpdatedAt()
+		fcu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fcu *FolderConversationUpdate) check() error {
+	if v, ok := fcu.mutation.FolderID(); ok {
+		if err := folderconversation.FolderIDValidator(v); err != nil {
+			return &ValidationError{Name: "folder_id", err: fmt.Errorf(`ent: validator failed for field "FolderConversation.folder_id": %w`, err)}
+		}
+	}
+	if v, ok := fcu.mutation.ConversationID(); ok {
+		if err := folderconversation.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "FolderConversation.conversation_id": %w`, err)}
+		}
+	}
+	if fcu.mutation.FolderCleared() && len(fcu.mutation.FolderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FolderConversation.folder"`)
+	}
+	if fcu.mutation.ConversationCleared() && len(fcu.mutation.ConversationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FolderConversation.conversation"`)
+	}
+	return nil
+}
+
+func (fcu *FolderConversationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := fcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(folderconversation.Table, folderconversation.Columns, sqlgraph.NewFieldSpec(folderconversation.FieldID, field.TypeString))
+	if ps := fcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fcu.mutation.CreatedAt(); ok {
+		_spec.SetField(folderconversation.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := fcu.mutation.UpdatedAt(); ok {
+		_spec.SetField(folderconversation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if fcu.mutation.FolderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   folderconversation.FolderTable,
+			Columns: []string{folderconversation.FolderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationfolder.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcu.mutation.FolderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   folderconversation.FolderTable,
+			Columns: []string{folderconversation.FolderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationfolder.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fcu.mutation.ConversationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   folderconversation.ConversationTable,
+			Columns: []string{folderconversation.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcu.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   folderconversation.ConversationTable,
+			Columns: []string{folderconversation.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, fcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{folderconversation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	fcu.mutation.done = true
+	return n, nil
+}
+
+// FolderConversationUpdateOne is the builder for updating a single FolderConversation entity.
+type FolderConversationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FolderConversationMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (fcuo *FolderConversationUpdateOne) SetCreatedAt(t time.Time) *FolderConversationUpdateOne {
+	fcuo.mutation.SetCreatedAt(t)
+	return fcuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (fcuo *FolderConversationUpdateOne) SetNillableCreatedAt(t *time.Time) *FolderConversationUpdateOne {
+	if t != nil {
+		fcuo.SetCreatedAt(*t)
+	}
+	return fcuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fcuo *FolderConversationUpdateOne) SetUpdatedAt(t time.Time) *FolderConversationUpdateOne {
+	fcuo.mutation.SetUpdatedAt(t)
+	return fcuo
+}
+
+// SetFolderID sets the "folder_id" field.
+func (fcuo *FolderConversationUpdateOne) SetFolderID(s string) *FolderConversationUpdateOne {
+	fcuo.mutation.SetFolderID(s)
+	return fcuo
+}
+
+// SetNillableFolderID sets the "folder_id" field if the given value is not nil.
+func (fcuo *FolderConversationUpdateOne) SetNillableFolderID(s *string) *FolderConversationUpdateOne {
+	if s != nil {
+		fcuo.SetFolderID(*s)
+	}
+	return fcuo
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (fcuo *FolderConversationUpdateOne) SetConversationID(s string) *FolderConversationUpdateOne {
+	fcuo.mutation.SetConversationID(s)
+	return fcuo
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (fcuo *FolderConversationUpdateOne) SetNillableConversationID(s *string) *FolderConversationUpdateOne {
+	if s != nil {
+		fcuo.SetConversationID(*s)
+	}
+	return fcuo
+}
+
+// SetFolder sets the "folder" edge to the ConversationFolder entity.
+func (fcuo *FolderConversationUpdateOne) SetFolder(c *ConversationFolder) *FolderConversationUpdateOne {
+	return fcuo.SetFolderID(c.ID)
+}
+
+// SetConversation sets the "conversation" edge to the Conversation entity.
+func (fcuo *FolderConversationUpdateOne) SetConversation(c *Conversation) *FolderConversationUpdateOne {
+	return fcuo.SetConversationID(c.ID)
+}
+
+// Mutation returns the FolderConversationMutation object of the builder.
+func (fcuo *FolderConversationUpdateOne) Mutation() *FolderConversationMutation {
+	return fcuo.mutation
+}
+
+// ClearFolder clears the "folder" edge to the ConversationFolder entity.
+func (fcuo *FolderConversationUpdateOne) ClearFolder() *FolderConversationUpdateOne {
+	fcuo.mutation.ClearFolder()
+	return fcuo
+}
+
+// ClearConversation clears the "conversation" edge to the Conversation entity.
+func (fcuo *FolderConversationUpdateOne) ClearConversation() *FolderConversationUpdateOne {
+	fcuo.mutation.ClearConversation()
+	return fcuo
+}
+
+// Where appends a list predicates to the FolderConversationUpdate builder.
+func (fcuo *FolderConversationUpdateOne) Where(ps ...predicate.FolderConversation) *FolderConversationUpdateOne {
+	fcuo.mutation.Where(ps...)
+	return fcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (fcuo *FolderConversationUpdateOne) Select(field string, fields ...string) *FolderConversationUpdateOne {
+	fcuo.fields = append([]string{field}, fields...)
+	return fcuo
+}
+
+// Save executes the query and returns the updated FolderConversation entity.
+func (fcuo *FolderConversationUpdateOne) Save(ctx context.Context) (*FolderConversation, error) {
+	fcuo.defaults()
+	return withHooks(ctx, fcuo.sqlSave, fcuo.mutation, fcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fcuo *FolderConversationUpdateOne) SaveX(ctx context.Context) *FolderConversation {
+	node, err := fcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (fcuo *FolderConversationUpdateOne) Exec(ctx context.Context) error {
+	_, err := fcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fcuo *FolderConversationUpdateOne) ExecX(ctx context.Context) {
+	if err := fcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fcuo *FolderConversationUpdateOne) defaults() {
+	if _, ok := fcuo.mutation.UpdatedAt(); !ok {
+		v := folderconversation.UpdateDefaultUpdatedAt()
+		fcuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fcuo *FolderConversationUpdateOne) check() error {
+	if v, ok := fcuo.mutation.FolderID(); ok {
+		if err := folderconversation.FolderIDValidator(v); err != nil {
+			return &ValidationError{Name: "folder_id", err: fmt.Errorf(`ent: validator failed for field "FolderConversation.folder_id": %w`, err)}
+		}
+	}
+	if v, ok := fcuo.mutation.ConversationID(); ok {
+		if err := folderconversation.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "FolderConversation.conversation_id": %w`, err)}
+		}
+	}
+	if fcuo.mutation.FolderCleared() && len(fcuo.mutation.FolderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FolderConversation.folder"`)
+	}
+	if fcuo.mutation.ConversationCleared() && len(fcuo.mutation.ConversationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FolderConversation.conversation"`)
+	}
+	return nil
+}
+
+func (fcuo *FolderConversationUpdateOne) sqlSave(ctx context.Context) (_node *FolderConversation, err error) {
+	if err := fcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(folderconversation.Table, folderconversation.Columns, sqlgraph.NewFieldSpec(folderconversation.FieldID, field.TypeString))
+	id, ok := fcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FolderConversation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := fcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, folderconversation.FieldID)
+		for _, f := range fields {
+			if !folderconversation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != folderconversation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := fcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fcuo.mutation.CreatedAt(); ok {
+		_spec.SetField(folderconversation.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := fcuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(folderconversation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if fcuo.mutation.FolderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   folderconversation.FolderTable,
+			Columns: []string{folderconversation.FolderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationfolder.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcuo.mutation.FolderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   folderconversation.FolderTable,
+			Columns: []string{folderconversation.FolderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationfolder.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fcuo.mutation.ConversationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   folderconversation.ConversationTable,
+			Columns: []string{folderconversation.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcuo.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   folderconversation.ConversationTable,
+			Columns: []string{folderconversation.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &FolderConversation{config: fcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, fcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{folderconversation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	fcuo.mutation.done = true
+	return _node, nil
+}
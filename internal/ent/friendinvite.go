@@ -0,0 +1,241 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"kakashi/chaos/internal/ent/friendinvite"
+	"kakashi/chaos/internal/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// FriendInvite is the model entity for the FriendInvite schema.
+type FriendInvite struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID string `json:"owner_id,omitempty"`
+	// Code holds the value of the "code" field.
+	Code string `json:"code,omitempty"`
+	// Create an accepted friendship instead of a pending request on redeem
+	AutoAccept bool `json:"auto_accept,omitempty"`
+	// Maximum number of redemptions, 0 means unlimited
+	MaxUses int `json:"max_uses,omitempty"`
+	// Uses holds the value of the "uses" field.
+	Uses int `json:"uses,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// RevokedAt holds the value of the "revoked_at" field.
+	RevokedAt time.Time `json:"revoked_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FriendInviteQuery when eager-loading is set.
+	Edges        FriendInviteEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// FriendInviteEdges holds the relations/edges for other nodes in the graph.
+type FriendInviteEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// Redemptions holds the value of the redemptions edge.
+	Redemptions []*FriendInviteUse `json:"redemptions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FriendInviteEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// RedemptionsOrErr returns the Redemptions value or an error if the edge
+// was not loaded in eager-loading.
+func (e FriendInviteEdges) RedemptionsOrErr() ([]*FriendInviteUse, error) {
+	if e.loadedTypes[1] {
+		return e.Redemptions, nil
+	}
+	return nil, &NotLoadedError{edge: "redemptions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FriendInvite) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case friendinvite.FieldAutoAccept:
+			values[i] = new(sql.NullBool)
+		case friendinvite.FieldMaxUses, friendinvite.FieldUses:
+			values[i] = new(sql.NullInt64)
+		case friendinvite.FieldID, friendinvite.FieldOwnerID, friendinvite.FieldCode:
+			values[i] = new(sql.NullString)
+		case friendinvite.FieldCreatedAt, friendinvite.FieldUpdatedAt, friendinvite.FieldExpiresAt, friendinvite.FieldRevokedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FriendInvite fields.
+func (fi *FriendInvite) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case friendinvite.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				fi.ID = value.String
+			}
+		case friendinvite.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				fi.CreatedAt = value.Time
+			}
+		case friendinvite.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				fi.UpdatedAt = value.Time
+			}
+		case friendinvite.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				fi.OwnerID = value.String
+			}
+		case friendinvite.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				fi.Code = value.String
+			}
+		case friendinvite.FieldAutoAccept:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_accept", values[i])
+			} else if value.Valid {
+				fi.AutoAccept = value.Bool
+			}
+		case friendinvite.FieldMaxUses:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_uses", values[i])
+			} else if value.Valid {
+				fi.MaxUses = int(value.Int64)
+			}
+		case friendinvite.FieldUses:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field uses", values[i])
+			} else if value.Valid {
+				fi.Uses = int(value.Int64)
+			}
+		case friendinvite.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				fi.ExpiresAt = value.Time
+			}
+		case friendinvite.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				fi.RevokedAt = value.Time
+			}
+		default:
+			fi.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FriendInvite.
+// This includes values selected through modifiers, order, etc.
+func (fi *FriendInvite) Value(name string) (ent.Value, error) {
+	return fi.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the FriendInvite entity.
+func (fi *FriendInvite) QueryOwner() *UserQuery {
+	return NewFriendInviteClient(fi.config).QueryOwner(fi)
+}
+
+// QueryRedemptions queries the "redemptions" edge of the FriendInvite entity.
+func (fi *FriendInvite) QueryRedemptions() *FriendInviteUseQuery {
+	return NewFriendInviteClient(fi.config).QueryRedemptions(fi)
+}
+
+// Update returns a builder for updating this FriendInvite.
+// Note that you need to call FriendInvite.Unwrap() before calling this method if this FriendInvite
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (fi *FriendInvite) Update() *FriendInviteUpdateOne {
+	return NewFriendInviteClient(fi.config).UpdateOne(fi)
+}
+
+// Unwrap unwraps the FriendInvite entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (fi *FriendInvite) Unwrap() *FriendInvite {
+	_tx, ok := fi.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FriendInvite is not a transactional entity")
+	}
+	fi.config.driver = _tx.drv
+	return fi
+}
+
+// String implements the fmt.Stringer.
+func (fi *FriendInvite) String() string {
+	var builder strings.Builder
+	builder.WriteString("FriendInvite(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", fi.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fi.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fi.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(fi.OwnerID)
+	builder.WriteString(", ")
+	builder.WriteString("code=")
+	builder.WriteString(fi.Code)
+	builder.WriteString(", ")
+	builder.WriteString("auto_accept=")
+	builder.WriteString(fmt.Sprintf("%v", fi.AutoAccept))
+	builder.WriteString(", ")
+	builder.WriteString("max_uses=")
+	builder.WriteString(fmt.Sprintf("%v", fi.MaxUses))
+	builder.WriteString(", ")
+	builder.WriteString("uses=")
+	builder.WriteString(fmt.Sprintf("%v", fi.Uses))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(fi.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("revoked_at=")
+	builder.WriteString(fi.RevokedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FriendInvites is a parsable slice of FriendInvite.
+type FriendInvites []*FriendInvite
@@ -0,0 +1,461 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/friendinvite"
+	"kakashi/chaos/internal/ent/friendinviteuse"
+	"kakashi/chaos/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FriendInviteCreate is the builder for creating a FriendInvite entity.
+type FriendInviteCreate struct {
+	config
+	mutation *FriendInviteMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (fic *FriendInviteCreate) SetCreatedAt(t time.Time) *FriendInviteCreate {
+	fic.mutation.SetCreatedAt(t)
+	return fic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (fic *FriendInviteCreate) SetNillableCreatedAt(t *time.Time) *FriendInviteCreate {
+	if t != nil {
+		fic.SetCreatedAt(*t)
+	}
+	return fic
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fic *FriendInviteCreate) SetUpdatedAt(t time.Time) *FriendInviteCreate {
+	fic.mutation.SetUpdatedAt(t)
+	return fic
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (fic *FriendInviteCreate) SetNillableUpdatedAt(t *time.Time) *FriendInviteCreate {
+	if t != nil {
+		fic.SetUpdatedAt(*t)
+	}
+	return fic
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (fic *FriendInviteCreate) SetOwnerID(s string) *FriendInviteCreate {
+	fic.mutation.SetOwnerID(s)
+	return fic
+}
+
+// SetCode sets the "code" field.
+func (fic *FriendInviteCreate) SetCode(s string) *FriendInviteCreate {
+	fic.mutation.SetCode(s)
+	return fic
+}
+
+// SetAutoAccept sets the "auto_accept" field.
+func (fic *FriendInviteCreate) SetAutoAccept(b bool) *FriendInviteCreate {
+	fic.mutation.SetAutoAccept(b)
+	return fic
+}
+
+// SetNillableAutoAccept sets the "auto_accept" field if the given value is not nil.
+func (fic *FriendInviteCreate) SetNillableAutoAccept(b *bool) *FriendInviteCreate {
+	if b != nil {
+		fic.SetAutoAccept(*b)
+	}
+	return fic
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (fic *FriendInviteCreate) SetMaxUses(i int) *FriendInviteCreate {
+	fic.mutation.SetMaxUses(i)
+	return fic
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (fic *FriendInviteCreate) SetNillableMaxUses(i *int) *FriendInviteCreate {
+	if i != nil {
+		fic.SetMaxUses(*i)
+	}
+	return fic
+}
+
+// SetUses sets the "uses" field.
+func (fic *FriendInviteCreate) SetUses(i int) *FriendInviteCreate {
+	fic.mutation.SetUses(i)
+	return fic
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (fic *FriendInviteCreate) SetNillableUses(i *int) *FriendInviteCreate {
+	if i != nil {
+		fic.SetUses(*i)
+	}
+	return fic
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (fic *FriendInviteCreate) SetExpiresAt(t time.Time) *FriendInviteCreate {
+	fic.mutation.SetExpiresAt(t)
+	return fic
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (fic *FriendInviteCreate) SetNillableExpiresAt(t *time.Time) *FriendInviteCreate {
+	if t != nil {
+		fic.SetExpiresAt(*t)
+	}
+	return fic
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (fic *FriendInviteCreate) SetRevokedAt(t time.Time) *FriendInviteCreate {
+	fic.mutation.SetRevokedAt(t)
+	return fic
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (fic *FriendInviteCreate) SetNillableRevokedAt(t *time.Time) *FriendInviteCreate {
+	if t != nil {
+		fic.SetRevokedAt(*t)
+	}
+	return fic
+}
+
+// SetID sets the "id" field.
+func (fic *FriendInviteCreate) SetID(s string) *FriendInviteCreate {
+	fic.mutation.SetID(s)
+	return fic
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (fic *FriendInviteCreate) SetNillableID(s *string) *FriendInviteCreate {
+	if s != nil {
+		fic.SetID(*s)
+	}
+	return fic
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (fic *FriendInviteCreate) SetOwner(u *User) *FriendInviteCreate {
+	return fic.SetOwnerID(u.ID)
+}
+
+// AddRedemptionIDs adds the "redemptions" edge to the FriendInviteUse entity by IDs.
+func (fic *FriendInviteCreate) AddRedemptionIDs(ids ...string) *FriendInviteCreate {
+	fic.mutation.AddRedemptionIDs(ids...)
+	return fic
+}
+
+// AddRedemptions adds the "redemptions" edges to the FriendInviteUse entity.
+func (fic *FriendInviteCreate) AddRedemptions(f ...*FriendInviteUse) *FriendInviteCreate {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fic.AddRedemptionIDs(ids...)
+}
+
+// Mutation returns the FriendInviteMutation object of the builder.
+func (fic *FriendInviteCreate) Mutation() *FriendInviteMutation {
+	return fic.mutation
+}
+
+// Save creates the FriendInvite in the database.
+func (fic *FriendInviteCreate) Save(ctx context.Context) (*FriendInvite, error) {
+	fic.defaults()
+	return withHooks(ctx, fic.sqlSave, fic.mutation, fic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (fic *FriendInviteCreate) SaveX(ctx context.Context) *FriendInvite {
+	v, err := fic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fic *FriendInviteCreate) Exec(ctx context.Context) error {
+	_, err := fic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fic *FriendInviteCreate) ExecX(ctx context.Context) {
+	if err := fic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fic *FriendInviteCreate) defaults() {
+	if _, ok := fic.mutation.CreatedAt(); !ok {
+		v := friendinvite.DefaultCreatedAt()
+		fic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := fic.mutation.UpdatedAt(); !ok {
+		v := friendinvite.DefaultUpdatedAt()
+		fic.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := fic.mutation.AutoAccept(); !ok {
+		v := friendinvite.DefaultAutoAccept
+		fic.mutation.SetAutoAccept(v)
+	}
+	if _, ok := fic.mutation.MaxUses(); !ok {
+		v := friendinvite.DefaultMaxUses
+		fic.mutation.SetMaxUses(v)
+	}
+	if _, ok := fic.mutation.Uses(); !ok {
+		v := friendinvite.DefaultUses
+		fic.mutation.SetUses(v)
+	}
+	if _, ok := fic.mutation.ID(); !ok {
+		v := friendinvite.DefaultID()
+		fic.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fic *FriendInviteCreate) check() error {
+	if _, ok := fic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FriendInvite.created_at"`)}
+	}
+	if _, ok := fic.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "FriendInvite.updated_at"`)}
+	}
+	if _, ok := fic.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`ent: missing required field "FriendInvite.owner_id"`)}
+	}
+	if v, ok := fic.mutation.OwnerID(); ok {
+		if err := friendinvite.OwnerIDValidator(v); err != nil {
+			return &ValidationError{Name: "owner_id", err: fmt.Errorf(`ent: validator failed for field "FriendInvite.owner_id": %w`, err)}
+		}
+	}
+	if _, ok := fic.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "FriendInvite.code"`)}
+	}
+	if v, ok := fic.mutation.Code(); ok {
+		if err := friendinvite.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "FriendInvite.code": %w`, err)}
+		}
+	}
+	if _, ok := fic.mutation.AutoAccept(); !ok {
+		return &ValidationError{Name: "auto_accept", err: errors.New(`ent: missing required field "FriendInvite.auto_accept"`)}
+	}
+	if _, ok := fic.mutation.MaxUses(); !ok {
+		return &ValidationError{Name: "max_uses", err: errors.New(`ent: missing required field "FriendInvite.max_uses"`)}
+	}
+	if v, ok := fic.mutation.MaxUses(); ok {
+		if err := friendinvite.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "FriendInvite.max_uses": %w`, err)}
+		}
+	}
+	if _, ok := fic.mutation.Uses(); !ok {
+		return &ValidationError{Name: "uses", err: errors.New(`ent: missing required field "FriendInvite.uses"`)}
+	}
+	if v, ok := fic.mutation.Uses(); ok {
+		if err := friendinvite.UsesValidator(v); err != nil {
+			return &ValidationError{Name: "uses", err: fmt.Errorf(`ent: validator failed for field "FriendInvite.uses": %w`, err)}
+		}
+	}
+	if len(fic.mutation.OwnerIDs()) == 0 {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "FriendInvite.owner"`)}
+	}
+	return nil
+}
+
+func (fic *FriendInviteCreate) sqlSave(ctx context.Context) (*FriendInvite, error) {
+	if err := fic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := fic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, fic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected FriendInvite.ID type: %T", _spec.ID.Value)
+		}
+	}
+	fic.mutation.id = &_node.ID
+	fic.mutation.done = true
+	return _node, nil
+}
+
+func (fic *FriendInviteCreate) createSpec() (*FriendInvite, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FriendInvite{config: fic.config}
+		_spec = sqlgraph.NewCreateSpec(friendinvite.Table, sqlgraph.NewFieldSpec(friendinvite.FieldID, field.TypeString))
+	)
+	if id, ok := fic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := fic.mutation.CreatedAt(); ok {
+		_spec.SetField(friendinvite.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := fic.mutation.UpdatedAt(); ok {
+		_spec.SetField(friendinvite.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := fic.mutation.Code(); ok {
+		_spec.SetField(friendinvite.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := fic.mutation.AutoAccept(); ok {
+		_spec.SetField(friendinvite.FieldAutoAccept, field.TypeBool, value)
+		_node.AutoAccept = value
+	}
+	if value, ok := fic.mutation.MaxUses(); ok {
+		_spec.SetField(friendinvite.FieldMaxUses, field.TypeInt, value)
+		_node.MaxUses = value
+	}
+	if value, ok := fic.mutation.Uses(); ok {
+		_spec.SetField(friendinvite.FieldUses, field.TypeInt, value)
+		_node.Uses = value
+	}
+	if value, ok := fic.mutation.ExpiresAt(); ok {
+		_spec.SetField(friendinvite.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := fic.mutation.RevokedAt(); ok {
+		_spec.SetField(friendinvite.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = value
+	}
+	if nodes := fic.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   friendinvite.OwnerTable,
+			Columns: []string{friendinvite.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OwnerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fic.mutation.RedemptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   friendinvite.RedemptionsTable,
+			Columns: []string{friendinvite.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendinviteuse.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// FriendInviteCreateBulk is the builder for creating many FriendInvite entities in bulk.
+type FriendInviteCreateBulk struct {
+	config
+	err      error
+	builders []*FriendInviteCreate
+}
+
+// Save creates the FriendInvite entities in the database.
+func (ficb *FriendInviteCreateBulk) Save(ctx context.Context) ([]*FriendInvite, error) {
+	if ficb.err != nil {
+		return nil, ficb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ficb.builders))
+	nodes := make([]*FriendInvite, len(ficb.builders))
+	mutators := make([]Mutator, len(ficb.builders))
+	for i := range ficb.builders {
+		func(i int, root context.Context) {
+			builder := ficb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FriendInviteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ficb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ficb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ficb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ficb *FriendInviteCreateBulk) SaveX(ctx context.Context) []*FriendInvite {
+	v, err := ficb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ficb *FriendInviteCreateBulk) Exec(ctx context.Context) error {
+	_, err := ficb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ficb *FriendInviteCreateBulk) ExecX(ctx context.Context) {
+	if err := ficb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"kakashi/chaos/internal/ent/friendinvite"
+	"kakashi/chaos/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FriendInviteDelete is the builder for deleting a FriendInvite entity.
+type FriendInviteDelete struct {
+	config
+	hooks    []Hook
+	mutation *FriendInviteMutation
+}
+
+// Where appends a list predicates to the FriendInviteDelete builder.
+func (fid *FriendInviteDelete) Where(ps ...predicate.FriendInvite) *FriendInviteDelete {
+	fid.mutation.Where(ps...)
+	return fid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (fid *FriendInviteDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, fid.sqlExec, fid.mutation, fid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fid *FriendInviteDelete) ExecX(ctx context.Context) int {
+	n, err := fid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (fid *FriendInviteDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(friendinvite.Table, sqlgraph.NewFieldSpec(friendinvite.FieldID, field.TypeString))
+	if ps := fid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, fid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	fid.mutation.done = true
+	return affected, err
+}
+
+// FriendInviteDeleteOne is the builder for deleting a single FriendInvite entity.
+type FriendInviteDeleteOne struct {
+	fid *FriendInviteDelete
+}
+
+// Where appends a list predicates to the FriendInviteDelete builder.
+func (fido *FriendInviteDeleteOne) Where(ps ...predicate.FriendInvite) *FriendInviteDeleteOne {
+	fido.fid.mutation.Where(ps...)
+	return fido
+}
+
+// Exec executes the deletion query.
+func (fido *FriendInviteDeleteOne) Exec(ctx context.Context) error {
+	n, err := fido.fid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{friendinvite.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fido *FriendInviteDeleteOne) ExecX(ctx context.Context) {
+	if err := fido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"kakashi/chaos/internal/ent/friendinvite"
+	"kakashi/chaos/internal/ent/friendinviteuse"
+	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/user"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FriendInviteQuery is the builder for querying FriendInvite entities.
+type FriendInviteQuery struct {
+	config
+	ctx             *QueryContext
+	order           []friendinvite.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.FriendInvite
+	withOwner       *UserQuery
+	withRedemptions *FriendInviteUseQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the FriendInviteQuery builder.
+func (fiq *FriendInviteQuery) Where(ps ...predicate.FriendInvite) *FriendInviteQuery {
+	fiq.predicates = append(fiq.predicates, ps...)
+	return fiq
+}
+
+// Limit the number of records to be returned by this query.
+func (fiq *FriendInviteQuery) Limit(limit int) *FriendInviteQuery {
+	fiq.ctx.Limit = &limit
+	return fiq
+}
+
+// Offset to start from.
+func (fiq *FriendInviteQuery) Offset(offset int) *FriendInviteQuery {
+	fiq.ctx.Offset = &offset
+	return fiq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (fiq *FriendInviteQuery) Unique(unique bool) *FriendInviteQuery {
+	fiq.ctx.Unique = &unique
+	return fiq
+}
+
+// Order specifies how the records should be ordered.
+func (fiq *FriendInviteQuery) Order(o ...friendinvite.OrderOption) *FriendInviteQuery {
+	fiq.order = append(fiq.order, o...)
+	return fiq
+}
+
+// QueryOwner chains the current query on the "owner" edge.
+func (fiq *FriendInviteQuery) QueryOwner() *UserQuery {
+	query := (&UserClient{config: fiq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := fiq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(friendinvite.Table, friendinvite.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, friendinvite.OwnerTable, friendinvite.OwnerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fiq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRedemptions chains the current query on the "redemptions" edge.
+func (fiq *FriendInviteQuery) QueryRedemptions() *FriendInviteUseQuery {
+	query := (&FriendInviteUseClient{config: fiq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := fiq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(friendinvite.Table, friendinvite.FieldID, selector),
+			sqlgraph.To(friendinviteuse.Table, friendinviteuse.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, friendinvite.RedemptionsTable, friendinvite.RedemptionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fiq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first FriendInvite entity from the query.
+// Returns a *NotFoundError when no FriendInvite was found.
+func (fiq *FriendInviteQuery) First(ctx context.Context) (*FriendInvite, error) {
+	nodes, err := fiq.Limit(1).All(setContextOp(ctx, fiq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{friendinvite.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (fiq *FriendInviteQuery) FirstX(ctx context.Context) *FriendInvite {
+	node, err := fiq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first FriendInvite ID from the query.
+// Returns a *NotFoundError when no FriendInvite ID was found.
+func (fiq *FriendInviteQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = fiq.Limit(1).IDs(setContextOp(ctx, fiq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{friendinvite.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (fiq *FriendInviteQuery) FirstIDX(ctx context.Context) string {
+	id, err := fiq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single FriendInvite entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one FriendInvite entity is found.
+// Returns a *NotFoundError when no FriendInvite entities are found.
+func (fiq *FriendInviteQuery) Only(ctx context.Context) (*FriendInvite, error) {
+	nodes, err := fiq.Limit(2).All(setContextOp(ctx, fiq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{friendinvite.Label}
+	default:
+		return nil, &NotSingularError{friendinvite.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (fiq *FriendInviteQuery) OnlyX(ctx context.Context) *FriendInvite {
+	node, err := fiq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only FriendInvite ID in the query.
+// Returns a *NotSingularError when more than one FriendInvite ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (fiq *FriendInviteQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = fiq.Limit(2).IDs(setContextOp(ctx, fiq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{friendinvite.Label}
+	default:
+		err = &NotSingularError{friendinvite.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (fiq *FriendInviteQuery) OnlyIDX(ctx context.Context) string {
+	id, err := fiq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of FriendInvites.
+func (fiq *FriendInviteQuery) All(ctx context.Context) ([]*FriendInvite, error) {
+	ctx = setContextOp(ctx, fiq.ctx, ent.OpQueryAll)
+	if err := fiq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*FriendInvite, *FriendInviteQuery]()
+	return withInterceptors[[]*FriendInvite](ctx, fiq, qr, fiq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (fiq *FriendInviteQuery) AllX(ctx context.Context) []*FriendInvite {
+	nodes, err := fiq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of FriendInvite IDs.
+func (fiq *FriendInviteQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if fiq.ctx.Unique == nil && fiq.path != nil {
+		fiq.Unique(true)
+	}
+	ctx = setContextOp(ctx, fiq.ctx, ent.OpQueryIDs)
+	if err = fiq.Select(friendinvite.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (fiq *FriendInviteQuery) IDsX(ctx context.Context) []string {
+	ids, err := fiq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (fiq *FriendInviteQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, fiq.ctx, ent.OpQueryCount)
+	if err := fiq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, fiq, querierCount[*FriendInviteQuery](), fiq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (fiq *FriendInviteQuery) CountX(ctx context.Context) int {
+	count, err := fiq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (fiq *FriendInviteQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, fiq.ctx, ent.OpQueryExist)
+	switch _, err := fiq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (fiq *FriendInviteQuery) ExistX(ctx context.Context) bool {
+	exist, err := fiq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the FriendInviteQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (fiq *FriendInviteQuery) Clone() *FriendInviteQuery {
+	if fiq == nil {
+		return nil
+	}
+	return &FriendInviteQuery{
+		config:          fiq.config,
+		ctx:             fiq.ctx.Clone(),
+		order:           append([]friendinvite.OrderOption{}, fiq.order...),
+		inters:          append([]Interceptor{}, fiq.inters...),
+		predicates:      append([]predicate.FriendInvite{}, fiq.predicates...),
+		withOwner:       fiq.withOwner.Clone(),
+		withRedemptions: fiq.withRedemptions.Clone(),
+		// clone intermediate query.
+		sql:  fiq.sql.Clone(),
+		path: fiq.path,
+	}
+}
+
+// WithOwner tells the query-builder to eager-load the nodes that are connected to
+// the "owner" edge. The optional arguments are used to configure the query builder of the edge.
+func (fiq *FriendInviteQuery) WithOwner(opts ...func(*UserQuery)) *FriendInviteQuery {
+	query := (&UserClient{config: fiq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	fiq.withOwner = query
+	return fiq
+}
+
+// WithRedemptions tells the query-builder to eager-load the nodes that are connected to
+// the "redemptions" edge. The optional arguments are used to configure the query builder of the edge.
+func (fiq *FriendInviteQuery) WithRedemptions(opts ...func(*FriendInviteUseQuery)) *FriendInviteQuery {
+	query := (&FriendInviteUseClient{config: fiq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	fiq.withRedemptions = query
+	return fiq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.FriendInvite.Query().
+//		GroupBy(friendinvite.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (fiq *FriendInviteQuery) GroupBy(field string, fields ...string) *FriendInviteGroupBy {
+	fiq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &FriendInviteGroupBy{build: fiq}
+	grbuild.flds = &fiq.ctx.Fields
+	grbuild.label = friendinvite.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.FriendInvite.Query().
+//		Select(friendinvite.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (fiq *FriendInviteQuery) Select(fields ...string) *FriendInviteSelect {
+	fiq.ctx.Fields = append(fiq.ctx.Fields, fields...)
+	sbuild := &FriendInviteSelect{FriendInviteQuery: fiq}
+	sbuild.label = friendinvite.Label
+	sbuild.flds, sbuild.scan = &fiq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a FriendInviteSelect configured with the given aggregations.
+func (fiq *FriendInviteQuery) Aggregate(fns ...AggregateFunc) *FriendInviteSelect {
+	return fiq.Select().Aggregate(fns...)
+}
+
+func (fiq *FriendInviteQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range fiq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, fiq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range fiq.ctx.Fields {
+		if !friendinvite.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if fiq.path != nil {
+		prev, err := fiq.path(ctx)
+		if err != nil {
+			return err
+		}
+		fiq.sql = prev
+	}
+	return nil
+}
+
+func (fiq *FriendInviteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*FriendInvite, error) {
+	var (
+		nodes       = []*FriendInvite{}
+		_spec       = fiq.querySpec()
+		loadedTypes = [2]bool{
+			fiq.withOwner != nil,
+			fiq.withRedemptions != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*FriendInvite).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &FriendInvite{config: fiq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, fiq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := fiq.withOwner; query != nil {
+		if err := fiq.loadOwner(ctx, query, nodes, nil,
+			func(n *FriendInvite, e *User) { n.Edges.Owner = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := fiq.withRedemptions; query != nil {
+		if err := fiq.loadRedemptions(ctx, query, nodes,
+			func(n *FriendInvite) { n.Edges.Redemptions = []*FriendInviteUse{} },
+			func(n *FriendInvite, e *FriendInviteUse) { n.Edges.Redemptions = append(n.Edges.Redemptions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (fiq *FriendInviteQuery) loadOwner(ctx context.Context, query *UserQuery, nodes []*FriendInvite, init func(*FriendInvite), assign func(*FriendInvite, *User)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*FriendInvite)
+	for i := range nodes {
+		fk := nodes[i].OwnerID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "owner_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (fiq *FriendInviteQuery) loadRedemptions(ctx context.Context, query *FriendInviteUseQuery, nodes []*FriendInvite, init func(*FriendInvite), assign func(*FriendInvite, *FriendInviteUse)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*FriendInvite)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(friendinviteuse.FieldInviteID)
+	}
+	query.Where(predicate.FriendInviteUse(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(friendinvite.RedemptionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.InviteID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "invite_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (fiq *FriendInviteQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := fiq.querySpec()
+	_spec.Node.Columns = fiq.ctx.Fields
+	if len(fiq.ctx.Fields) > 0 {
+		_spec.Unique = fiq.ctx.Unique != nil && *fiq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, fiq.driver, _spec)
+}
+
+func (fiq *FriendInviteQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(friendinvite.Table, friendinvite.Columns, sqlgraph.NewFieldSpec(friendinvite.FieldID, field.TypeString))
+	_spec.From = fiq.sql
+	if unique := fiq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if fiq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := fiq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, friendinvite.FieldID)
+		for i := range fields {
+			if fields[i] != friendinvite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if fiq.withOwner != nil {
+			_spec.Node.AddColumnOnce(friendinvite.FieldOwnerID)
+		}
+	}
+	if ps := fiq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := fiq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := fiq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := fiq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (fiq *FriendInviteQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(fiq.driver.Dialect())
+	t1 := builder.Table(friendinvite.Table)
+	columns := fiq.ctx.Fields
+	if len(columns) == 0 {
+		columns = friendinvite.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if fiq.sql != nil {
+		selector = fiq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if fiq.ctx.Unique != nil && *fiq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range fiq.predicates {
+		p(selector)
+	}
+	for _, p := range fiq.order {
+		p(selector)
+	}
+	if offset := fiq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := fiq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// FriendInviteGroupBy is the group-by builder for FriendInvite entities.
+type FriendInviteGroupBy struct {
+	selector
+	build *FriendInviteQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (figb *FriendInviteGroupBy) Aggregate(fns ...AggregateFunc) *FriendInviteGroupBy {
+	figb.fns = append(figb.fns, fns...)
+	return figb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (figb *FriendInviteGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, figb.build.ctx, ent.OpQueryGroupBy)
+	if err := figb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FriendInviteQuery, *FriendInviteGroupBy](ctx, figb.build, figb, figb.build.inters, v)
+}
+
+func (figb *FriendInviteGroupBy) sqlScan(ctx context.Context, root *FriendInviteQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(figb.fns))
+	for _, fn := range figb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*figb.flds)+len(figb.fns))
+		for _, f := range *figb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*figb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := figb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// FriendInviteSelect is the builder for selecting fields of FriendInvite entities.
+type FriendInviteSelect struct {
+	*FriendInviteQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (fis *FriendInviteSelect) Aggregate(fns ...AggregateFunc) *FriendInviteSelect {
+	fis.fns = append(fis.fns, fns...)
+	return fis
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (fis *FriendInviteSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, fis.ctx, ent.OpQuerySelect)
+	if err := fis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FriendInviteQuery, *FriendInviteSelect](ctx, fis.FriendInviteQuery, fis, fis.inters, v)
+}
+
+func (fis *FriendInviteSelect) sqlScan(ctx context.Context, root *FriendInviteQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(fis.fns))
+	for _, fn := range fis.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*fis.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := fis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
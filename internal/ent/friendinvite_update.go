@@ -0,0 +1,860 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/friendinvite"
+	"kakashi/chaos/internal/ent/friendinviteuse"
+	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FriendInviteUpdate is the builder for updating FriendInvite entities.
+type FriendInviteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FriendInviteMutation
+}
+
+// Where appends a list predicates to the FriendInviteUpdate builder.
+func (fiu *FriendInviteUpdate) Where(ps ...predicate.FriendInvite) *FriendInviteUpdate {
+	fiu.mutation.Where(ps...)
+	return fiu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (fiu *FriendInviteUpdate) SetCreatedAt(t time.Time) *FriendInviteUpdate {
+	fiu.mutation.SetCreatedAt(t)
+	return fiu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (fiu *FriendInviteUpdate) SetNillableCreatedAt(t *time.Time) *FriendInviteUpdate {
+	if t != nil {
+		fiu.SetCreatedAt(*t)
+	}
+	return fiu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fiu *FriendInviteUpdate) SetUpdatedAt(t time.Time) *FriendInviteUpdate {
+	fiu.mutation.SetUpdatedAt(t)
+	return fiu
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (fiu *FriendInviteUpdate) SetOwnerID(s string) *FriendInviteUpdate {
+	fiu.mutation.SetOwnerID(s)
+	return fiu
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (fiu *FriendInviteUpdate) SetNillableOwnerID(s *string) *FriendInviteUpdate {
+	if s != nil {
+		fiu.SetOwnerID(*s)
+	}
+	return fiu
+}
+
+// SetCode sets the "code" field.
+func (fiu *FriendInviteUpdate) SetCode(s string) *FriendInviteUpdate {
+	fiu.mutation.SetCode(s)
+	return fiu
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (fiu *FriendInviteUpdate) SetNillableCode(s *string) *FriendInviteUpdate {
+	if s != nil {
+		fiu.SetCode(*s)
+	}
+	return fiu
+}
+
+// SetAutoAccept sets the "auto_accept" field.
+func (fiu *FriendInviteUpdate) SetAutoAccept(b bool) *FriendInviteUpdate {
+	fiu.mutation.SetAutoAccept(b)
+	return fiu
+}
+
+// SetNillableAutoAccept sets the "auto_accept" field if the given value is not nil.
+func (fiu *FriendInviteUpdate) SetNillableAutoAccept(b *bool) *FriendInviteUpdate {
+	if b != nil {
+		fiu.SetAutoAccept(*b)
+	}
+	return fiu
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (fiu *FriendInviteUpdate) SetMaxUses(i int) *FriendInviteUpdate {
+	fiu.mutation.ResetMaxUses()
+	fiu.mutation.SetMaxUses(i)
+	return fiu
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (fiu *FriendInviteUpdate) SetNillableMaxUses(i *int) *FriendInviteUpdate {
+	if i != nil {
+		fiu.SetMaxUses(*i)
+	}
+	return fiu
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (fiu *FriendInviteUpdate) AddMaxUses(i int) *FriendInviteUpdate {
+	fiu.mutation.AddMaxUses(i)
+	return fiu
+}
+
+// SetUses sets the "uses" field.
+func (fiu *FriendInviteUpdate) SetUses(i int) *FriendInviteUpdate {
+	fiu.mutation.ResetUses()
+	fiu.mutation.SetUses(i)
+	return fiu
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (fiu *FriendInviteUpdate) SetNillableUses(i *int) *FriendInviteUpdate {
+	if i != nil {
+		fiu.SetUses(*i)
+	}
+	return fiu
+}
+
+// AddUses adds i to the "uses" field.
+func (fiu *FriendInviteUpdate) AddUses(i int) *FriendInviteUpdate {
+	fiu.mutation.AddUses(i)
+	return fiu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (fiu *FriendInviteUpdate) SetExpiresAt(t time.Time) *FriendInviteUpdate {
+	fiu.mutation.SetExpiresAt(t)
+	return fiu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (fiu *FriendInviteUpdate) SetNillableExpiresAt(t *time.Time) *FriendInviteUpdate {
+	if t != nil {
+		fiu.SetExpiresAt(*t)
+	}
+	return fiu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (fiu *FriendInviteUpdate) ClearExpiresAt() *FriendInviteUpdate {
+	fiu.mutation.ClearExpiresAt()
+	return fiu
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (fiu *FriendInviteUpdate) SetRevokedAt(t time.Time) *FriendInviteUpdate {
+	fiu.mutation.SetRevokedAt(t)
+	return fiu
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (fiu *FriendInviteUpdate) SetNillableRevokedAt(t *time.Time) *FriendInviteUpdate {
+	if t != nil {
+		fiu.SetRevokedAt(*t)
+	}
+	return fiu
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (fiu *FriendInviteUpdate) ClearRevokedAt() *FriendInviteUpdate {
+	fiu.mutation.ClearRevokedAt()
+	return fiu
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (fiu *FriendInviteUpdate) SetOwner(u *User) *FriendInviteUpdate {
+	return fiu.SetOwnerID(u.ID)
+}
+
+// AddRedemptionIDs adds the "redemptions" edge to the FriendInviteUse entity by IDs.
+func (fiu *FriendInviteUpdate) AddRedemptionIDs(ids ...string) *FriendInviteUpdate {
+	fiu.mutation.AddRedemptionIDs(ids...)
+	return fiu
+}
+
+// AddRedemptions adds the "redemptions" edges to the FriendInviteUse entity.
+func (fiu *FriendInviteUpdate) AddRedemptions(f ...*FriendInviteUse) *FriendInviteUpdate {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fiu.AddRedemptionIDs(ids...)
+}
+
+// Mutation returns the FriendInviteMutation object of the builder.
+func (fiu *FriendInviteUpdate) Mutation() *FriendInviteMutation {
+	return fiu.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (fiu *FriendInviteUpdate) ClearOwner() *FriendInviteUpdate {
+	fiu.mutation.ClearOwner()
+	return fiu
+}
+
+// ClearRedemptions clears all "redemptions" edges to the FriendInviteUse entity.
+func (fiu *FriendInviteUpdate) ClearRedemptions() *FriendInviteUpdate {
+	fiu.mutation.ClearRedemptions()
+	return fiu
+}
+
+// RemoveRedemptionIDs removes the "redemptions" edge to FriendInviteUse entities by IDs.
+func (fiu *FriendInviteUpdate) RemoveRedemptionIDs(ids ...string) *FriendInviteUpdate {
+	fiu.mutation.RemoveRedemptionIDs(ids...)
+	return fiu
+}
+
+// RemoveRedemptions removes "redemptions" edges to FriendInviteUse entities.
+func (fiu *FriendInviteUpdate) RemoveRedemptions(f ...*FriendInviteUse) *FriendInviteUpdate {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fiu.RemoveRedemptionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (fiu *FriendInviteUpdate) Save(ctx context.Context) (int, error) {
+	fiu.defaults()
+	return withHooks(ctx, fiu.sqlSave, fiu.mutation, fiu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fiu *FriendInviteUpdate) SaveX(ctx context.Context) int {
+	affected, err := fiu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (fiu *FriendInviteUpdate) Exec(ctx context.Context) error {
+	_, err := fiu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fiu *FriendInviteUpdate) ExecX(ctx context.Context) {
+	if err := fiu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fiu *FriendInviteUpdate) defaults() {
+	if _, ok := fiu.mutation.UpdatedAt(); !ok {
+		v := friendinvite.UpdateDefaultUpdatedAt()
+		fiu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fiu *FriendInviteUpdate) check() error {
+	if v, ok := fiu.mutation.OwnerID(); ok {
+		if err := friendinvite.OwnerIDValidator(v); err != nil {
+			return &ValidationError{Name: "owner_id", err: fmt.Errorf(`ent: validator failed for field "FriendInvite.owner_id": %w`, err)}
+		}
+	}
+	if v, ok := fiu.mutation.Code(); ok {
+		if err := friendinvite.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "FriendInvite.code": %w`, err)}
+		}
+	}
+	if v, ok := fiu.mutation.MaxUses(); ok {
+		if err := friendinvite.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "FriendInvite.max_uses": %w`, err)}
+		}
+	}
+	if v, ok := fiu.mutation.Uses(); ok {
+		if err := friendinvite.UsesValidator(v); err != nil {
+			return &ValidationError{Name: "uses", err: fmt.Errorf(`ent: validator failed for field "FriendInvite.uses": %w`, err)}
+		}
+	}
+	if fiu.mutation.OwnerCleared() && len(fiu.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FriendInvite.owner"`)
+	}
+	return nil
+}
+
+func (fiu *FriendInviteUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := fiu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(friendinvite.Table, friendinvite.Columns, sqlgraph.NewFieldSpec(friendinvite.FieldID, field.TypeString))
+	if ps := fiu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fiu.mutation.CreatedAt(); ok {
+		_spec.SetField(friendinvite.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := fiu.mutation.UpdatedAt(); ok {
+		_spec.SetField(friendinvite.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := fiu.mutation.Code(); ok {
+		_spec.SetField(friendinvite.FieldCode, field.TypeString, value)
+	}
+	if value, ok := fiu.mutation.AutoAccept(); ok {
+		_spec.SetField(friendinvite.FieldAutoAccept, field.TypeBool, value)
+	}
+	if value, ok := fiu.mutation.MaxUses(); ok {
+		_spec.SetField(friendinvite.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := fiu.mutation.AddedMaxUses(); ok {
+		_spec.AddField(friendinvite.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := fiu.mutation.Uses(); ok {
+		_spec.SetField(friendinvite.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := fiu.mutation.AddedUses(); ok {
+		_spec.AddField(friendinvite.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := fiu.mutation.ExpiresAt(); ok {
+		_spec.SetField(friendinvite.FieldExpiresAt, field.TypeTime, value)
+	}
+	if fiu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(friendinvite.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := fiu.mutation.RevokedAt(); ok {
+		_spec.SetField(friendinvite.FieldRevokedAt, field.TypeTime, value)
+	}
+	if fiu.mutation.RevokedAtCleared() {
+		_spec.ClearField(friendinvite.FieldRevokedAt, field.TypeTime)
+	}
+	if fiu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   friendinvite.OwnerTable,
+			Columns: []string{friendinvite.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fiu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   friendinvite.OwnerTable,
+			Columns: []string{friendinvite.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fiu.mutation.RedemptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   friendinvite.RedemptionsTable,
+			Columns: []string{friendinvite.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendinviteuse.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fiu.mutation.RemovedRedemptionsIDs(); len(nodes) > 0 && !fiu.mutation.RedemptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   friendinvite.RedemptionsTable,
+			Columns: []string{friendinvite.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendinviteuse.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fiu.mutation.RedemptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   friendinvite.RedemptionsTable,
+			Columns: []string{friendinvite.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendinviteuse.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, fiu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{friendinvite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	fiu.mutation.done = true
+	return n, nil
+}
+
+// FriendInviteUpdateOne is the builder for updating a single FriendInvite entity.
+type FriendInviteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FriendInviteMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (fiuo *FriendInviteUpdateOne) SetCreatedAt(t time.Time) *FriendInviteUpdateOne {
+	fiuo.mutation.SetCreatedAt(t)
+	return fiuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (fiuo *FriendInviteUpdateOne) SetNillableCreatedAt(t *time.Time) *FriendInviteUpdateOne {
+	if t != nil {
+		fiuo.SetCreatedAt(*t)
+	}
+	return fiuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fiuo *FriendInviteUpdateOne) SetUpdatedAt(t time.Time) *FriendInviteUpdateOne {
+	fiuo.mutation.SetUpdatedAt(t)
+	return fiuo
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (fiuo *FriendInviteUpdateOne) SetOwnerID(s string) *FriendInviteUpdateOne {
+	fiuo.mutation.SetOwnerID(s)
+	return fiuo
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (fiuo *FriendInviteUpdateOne) SetNillableOwnerID(s *string) *FriendInviteUpdateOne {
+	if s != nil {
+		fiuo.SetOwnerID(*s)
+	}
+	return fiuo
+}
+
+// SetCode sets the "code" field.
+func (fiuo *FriendInviteUpdateOne) SetCode(s string) *FriendInviteUpdateOne {
+	fiuo.mutation.SetCode(s)
+	return fiuo
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (fiuo *FriendInviteUpdateOne) SetNillableCode(s *string) *FriendInviteUpdateOne {
+	if s != nil {
+		fiuo.SetCode(*s)
+	}
+	return fiuo
+}
+
+// SetAutoAccept sets the "auto_accept" field.
+func (fiuo *FriendInviteUpdateOne) SetAutoAccept(b bool) *FriendInviteUpdateOne {
+	fiuo.mutation.SetAutoAccept(b)
+	return fiuo
+}
+
+// SetNillableAutoAccept sets the "auto_accept" field if the given value is not nil.
+func (fiuo *FriendInviteUpdateOne) SetNillableAutoAccept(b *bool) *FriendInviteUpdateOne {
+	if b != nil {
+		fiuo.SetAutoAccept(*b)
+	}
+	return fiuo
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (fiuo *FriendInviteUpdateOne) SetMaxUses(i int) *FriendInviteUpdateOne {
+	fiuo.mutation.ResetMaxUses()
+	fiuo.mutation.SetMaxUses(i)
+	return fiuo
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (fiuo *FriendInviteUpdateOne) SetNillableMaxUses(i *int) *FriendInviteUpdateOne {
+	if i != nil {
+		fiuo.SetMaxUses(*i)
+	}
+	return fiuo
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (fiuo *FriendInviteUpdateOne) AddMaxUses(i int) *FriendInviteUpdateOne {
+	fiuo.mutation.AddMaxUses(i)
+	return fiuo
+}
+
+// SetUses sets the "uses" field.
+func (fiuo *FriendInviteUpdateOne) SetUses(i int) *FriendInviteUpdateOne {
+	fiuo.mutation.ResetUses()
+	fiuo.mutation.SetUses(i)
+	return fiuo
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (fiuo *FriendInviteUpdateOne) SetNillableUses(i *int) *FriendInviteUpdateOne {
+	if i != nil {
+		fiuo.SetUses(*i)
+	}
+	return fiuo
+}
+
+// AddUses adds i to the "uses" field.
+func (fiuo *FriendInviteUpdateOne) AddUses(i int) *FriendInviteUpdateOne {
+	fiuo.mutation.AddUses(i)
+	return fiuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (fiuo *FriendInviteUpdateOne) SetExpiresAt(t time.Time) *FriendInviteUpdateOne {
+	fiuo.mutation.SetExpiresAt(t)
+	return fiuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (fiuo *FriendInviteUpdateOne) SetNillableExpiresAt(t *time.Time) *FriendInviteUpdateOne {
+	if t != nil {
+		fiuo.SetExpiresAt(*t)
+	}
+	return fiuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (fiuo *FriendInviteUpdateOne) ClearExpiresAt() *FriendInviteUpdateOne {
+	fiuo.mutation.ClearExpiresAt()
+	return fiuo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (fiuo *FriendInviteUpdateOne) SetRevokedAt(t time.Time) *FriendInviteUpdateOne {
+	fiuo.mutation.SetRevokedAt(t)
+	return fiuo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (fiuo *FriendInviteUpdateOne) SetNillableRevokedAt(t *time.Time) *FriendInviteUpdateOne {
+	if t != nil {
+		fiuo.SetRevokedAt(*t)
+	}
+	return fiuo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (fiuo *FriendInviteUpdateOne) ClearRevokedAt() *FriendInviteUpdateOne {
+	fiuo.mutation.ClearRevokedAt()
+	return fiuo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (fiuo *FriendInviteUpdateOne) SetOwner(u *User) *FriendInviteUpdateOne {
+	return fiuo.SetOwnerID(u.ID)
+}
+
+// AddRedemptionIDs adds the "redemptions" edge to the FriendInviteUse entity by IDs.
+func (fiuo *FriendInviteUpdateOne) AddRedemptionIDs(ids ...string) *FriendInviteUpdateOne {
+	fiuo.mutation.AddRedemptionIDs(ids...)
+	return fiuo
+}
+
+// AddRedemptions adds the "redemptions" edges to the FriendInviteUse entity.
+func (fiuo *FriendInviteUpdateOne) AddRedemptions(f ...*FriendInviteUse) *FriendInviteUpdateOne {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fiuo.AddRedemptionIDs(ids...)
+}
+
+// Mutation returns the FriendInviteMutation object of the builder.
+func (fiuo *FriendInviteUpdateOne) Mutation() *FriendInviteMutation {
+	return fiuo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (fiuo *FriendInviteUpdateOne) ClearOwner() *FriendInviteUpdateOne {
+	fiuo.mutation.ClearOwner()
+	return fiuo
+}
+
+// ClearRedemptions clears all "redemptions" edges to the FriendInviteUse entity.
+func (fiuo *FriendInviteUpdateOne) ClearRedemptions() *FriendInviteUpdateOne {
+	fiuo.mutation.ClearRedemptions()
+	return fiuo
+}
+
+// RemoveRedemptionIDs removes the "redemptions" edge to FriendInviteUse entities by IDs.
+func (fiuo *FriendInviteUpdateOne) RemoveRedemptionIDs(ids ...string) *FriendInviteUpdateOne {
+	fiuo.mutation.RemoveRedemptionIDs(ids...)
+	return fiuo
+}
+
+// RemoveRedemptions removes "redemptions" edges to FriendInviteUse entities.
+func (fiuo *FriendInviteUpdateOne) RemoveRedemptions(f ...*FriendInviteUse) *FriendInviteUpdateOne {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fiuo.RemoveRedemptionIDs(ids...)
+}
+
+// Where appends a list predicates to the FriendInviteUpdate builder.
+func (fiuo *FriendInviteUpdateOne) Where(ps ...predicate.FriendInvite) *FriendInviteUpdateOne {
+	fiuo.mutation.Where(ps...)
+	return fiuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (fiuo *FriendInviteUpdateOne) Select(field string, fields ...string) *FriendInviteUpdateOne {
+	fiuo.fields = append([]string{field}, fields...)
+	return fiuo
+}
+
+// Save executes the query and returns the updated FriendInvite entity.
+func (fiuo *FriendInviteUpdateOne) Save(ctx context.Context) (*FriendInvite, error) {
+	fiuo.defaults()
+	return withHooks(ctx, fiuo.sqlSave, fiuo.mutation, fiuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fiuo *FriendInviteUpdateOne) SaveX(ctx context.Context) *FriendInvite {
+	node, err := fiuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (fiuo *FriendInviteUpdateOne) Exec(ctx context.Context) error {
+	_, err := fiuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fiuo *FriendInviteUpdateOne) ExecX(ctx context.Context) {
+	if err := fiuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fiuo *FriendInviteUpdateOne) defaults() {
+	if _, ok := fiuo.mutation.UpdatedAt(); !ok {
+		v := friendinvite.UpdateDefaultUpdatedAt()
+		fiuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fiuo *FriendInviteUpdateOne) check() error {
+	if v, ok := fiuo.mutation.OwnerID(); ok {
+		if err := friendinvite.OwnerIDValidator(v); err != nil {
+			return &ValidationError{Name: "owner_id", err: fmt.Errorf(`ent: validator failed for field "FriendInvite.owner_id": %w`, err)}
+		}
+	}
+	if v, ok := fiuo.mutation.Code(); ok {
+		if err := friendinvite.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "FriendInvite.code": %w`, err)}
+		}
+	}
+	if v, ok := fiuo.mutation.MaxUses(); ok {
+		if err := friendinvite.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "FriendInvite.max_uses": %w`, err)}
+		}
+	}
+	if v, ok := fiuo.mutation.Uses(); ok {
+		if err := friendinvite.UsesValidator(v); err != nil {
+			return &ValidationError{Name: "uses", err: fmt.Errorf(`ent: validator failed for field "FriendInvite.uses": %w`, err)}
+		}
+	}
+	if fiuo.mutation.OwnerCleared() && len(fiuo.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FriendInvite.owner"`)
+	}
+	return nil
+}
+
+func (fiuo *FriendInviteUpdateOne) sqlSave(ctx context.Context) (_node *FriendInvite, err error) {
+	if err := fiuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(friendinvite.Table, friendinvite.Columns, sqlgraph.NewFieldSpec(friendinvite.FieldID, field.TypeString))
+	id, ok := fiuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FriendInvite.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := fiuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, friendinvite.FieldID)
+		for _, f := range fields {
+			if !friendinvite.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != friendinvite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := fiuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fiuo.mutation.CreatedAt(); ok {
+		_spec.SetField(friendinvite.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := fiuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(friendinvite.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := fiuo.mutation.Code(); ok {
+		_spec.SetField(friendinvite.FieldCode, field.TypeString, value)
+	}
+	if value, ok := fiuo.mutation.AutoAccept(); ok {
+		_spec.SetField(friendinvite.FieldAutoAccept, field.TypeBool, value)
+	}
+	if value, ok := fiuo.mutation.MaxUses(); ok {
+		_spec.SetField(friendinvite.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := fiuo.mutation.AddedMaxUses(); ok {
+		_spec.AddField(friendinvite.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := fiuo.mutation.Uses(); ok {
+		_spec.SetField(friendinvite.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := fiuo.mutation.AddedUses(); ok {
+		_spec.AddField(friendinvite.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := fiuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(friendinvite.FieldExpiresAt, field.TypeTime, value)
+	}
+	if fiuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(friendinvite.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := fiuo.mutation.RevokedAt(); ok {
+		_spec.SetField(friendinvite.FieldRevokedAt, field.TypeTime, value)
+	}
+	if fiuo.mutation.RevokedAtCleared() {
+		_spec.ClearField(friendinvite.FieldRevokedAt, field.TypeTime)
+	}
+	if fiuo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   friendinvite.OwnerTable,
+			Columns: []string{friendinvite.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fiuo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   friendinvite.OwnerTable,
+			Columns: []string{friendinvite.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fiuo.mutation.RedemptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   friendinvite.RedemptionsTable,
+			Columns: []string{friendinvite.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendinviteuse.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fiuo.mutation.RemovedRedemptionsIDs(); len(nodes) > 0 && !fiuo.mutation.RedemptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   friendinvite.RedemptionsTable,
+			Columns: []string{friendinvite.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendinviteuse.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fiuo.mutation.RedemptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   friendinvite.RedemptionsTable,
+			Columns: []string{friendinvite.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendinviteuse.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &FriendInvite{config: fiuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, fiuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{friendinvite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	fiuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"kakashi/chaos/internal/ent/friendinvite"
+	"kakashi/chaos/internal/ent/friendinviteuse"
+	"kakashi/chaos/internal/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// FriendInviteUse is the model entity for the FriendInviteUse schema.
+type FriendInviteUse struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// InviteID holds the value of the "invite_id" field.
+	InviteID string `json:"invite_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// Result holds the value of the "result" field.
+	Result friendinviteuse.Result `json:"result,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FriendInviteUseQuery when eager-loading is set.
+	Edges        FriendInviteUseEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// FriendInviteUseEdges holds the relations/edges for other nodes in the graph.
+type FriendInviteUseEdges struct {
+	// Invite holds the value of the invite edge.
+	Invite *FriendInvite `json:"invite,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// InviteOrErr returns the Invite value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FriendInviteUseEdges) InviteOrErr() (*FriendInvite, error) {
+	if e.Invite != nil {
+		return e.Invite, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: friendinvite.Label}
+	}
+	return nil, &NotLoadedError{edge: "invite"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FriendInviteUseEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FriendInviteUse) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case friendinviteuse.FieldID, friendinviteuse.FieldInviteID, friendinviteuse.FieldUserID, friendinviteuse.FieldResult:
+			values[i] = new(sql.NullString)
+		case friendinviteuse.FieldCreatedAt, friendinviteuse.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FriendInviteUse fields.
+func (fiu *FriendInviteUse) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case friendinviteuse.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				fiu.ID = value.String
+			}
+		case friendinviteuse.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				fiu.CreatedAt = value.Time
+			}
+		case friendinviteuse.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				fiu.UpdatedAt = value.Time
+			}
+		case friendinviteuse.FieldInviteID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field invite_id", values[i])
+			} else if value.Valid {
+				fiu.InviteID = value.String
+			}
+		case friendinviteuse.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				fiu.UserID = value.String
+			}
+		case friendinviteuse.FieldResult:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field result", values[i])
+			} else if value.Valid {
+				fiu.Result = friendinviteuse.Result(value.String)
+			}
+		default:
+			fiu.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FriendInviteUse.
+// This includes values selected through modifiers, order, etc.
+func (fiu *FriendInviteUse) Value(name string) (ent.Value, error) {
+	return fiu.selectValues.Get(name)
+}
+
+// QueryInvite queries the "invite" edge of the FriendInviteUse entity.
+func (fiu *FriendInviteUse) QueryInvite() *FriendInviteQuery {
+	return NewFriendInviteUseClient(fiu.config).QueryInvite(fiu)
+}
+
+// QueryUser queries the "user" edge of the FriendInviteUse entity.
+func (fiu *FriendInviteUse) QueryUser() *UserQuery {
+	return NewFriendInviteUseClient(fiu.config).QueryUser(fiu)
+}
+
+// Update returns a builder for updating this FriendInviteUse.
+// Note that you need to call FriendInviteUse.Unwrap() before calling this method if this FriendInviteUse
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (fiu *FriendInviteUse) Update() *FriendInviteUseUpdateOne {
+	return NewFriendInviteUseClient(fiu.config).UpdateOne(fiu)
+}
+
+// Unwrap unwraps the FriendInviteUse entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (fiu *FriendInviteUse) Unwrap() *FriendInviteUse {
+	_tx, ok := fiu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FriendInviteUse is not a transactional entity")
+	}
+	fiu.config.driver = _tx.drv
+	return fiu
+}
+
+// String implements the fmt.Stringer.
+func (fiu *FriendInviteUse) String() string {
+	var builder strings.Builder
+	builder.WriteString("FriendInviteUse(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", fiu.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fiu.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fiu.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("invite_id=")
+	builder.WriteString(fiu.InviteID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fiu.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("result=")
+	builder.WriteString(fmt.Sprintf("%v", fiu.Result))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FriendInviteUses is a parsable slice of FriendInviteUse.
+type FriendInviteUses []*FriendInviteUse
@@ -0,0 +1,377 @@
+// Code generated by ent, DO NOT EDIT.
+
+package friendinviteuse
+
+import (
+	"kakashi/chaos/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// InviteID applies equality check predicate on the "invite_id" field. It's identical to InviteIDEQ.
+func InviteID(v string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldEQ(FieldInviteID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldEQ(FieldUserID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// InviteIDEQ applies the EQ predicate on the "invite_id" field.
+func InviteIDEQ(v string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldEQ(FieldInviteID, v))
+}
+
+// InviteIDNEQ applies the NEQ predicate on the "invite_id" field.
+func InviteIDNEQ(v string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldNEQ(FieldInviteID, v))
+}
+
+// InviteIDIn applies the In predicate on the "invite_id" field.
+func InviteIDIn(vs ...string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldIn(FieldInviteID, vs...))
+}
+
+// InviteIDNotIn applies the NotIn predicate on the "invite_id" field.
+func InviteIDNotIn(vs ...string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldNotIn(FieldInviteID, vs...))
+}
+
+// InviteIDGT applies the GT predicate on the "invite_id" field.
+func InviteIDGT(v string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldGT(FieldInviteID, v))
+}
+
+// InviteIDGTE applies the GTE predicate on the "invite_id" field.
+func InviteIDGTE(v string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldGTE(FieldInviteID, v))
+}
+
+// InviteIDLT applies the LT predicate on the "invite_id" field.
+func InviteIDLT(v string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldLT(FieldInviteID, v))
+}
+
+// InviteIDLTE applies the LTE predicate on the "invite_id" field.
+func InviteIDLTE(v string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldLTE(FieldInviteID, v))
+}
+
+// InviteIDContains applies the Contains predicate on the "invite_id" field.
+func InviteIDContains(v string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldContains(FieldInviteID, v))
+}
+
+// InviteIDHasPrefix applies the HasPrefix predicate on the "invite_id" field.
+func InviteIDHasPrefix(v string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldHasPrefix(FieldInviteID, v))
+}
+
+// InviteIDHasSuffix applies the HasSuffix predicate on the "invite_id" field.
+func InviteIDHasSuffix(v string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldHasSuffix(FieldInviteID, v))
+}
+
+// InviteIDEqualFold applies the EqualFold predicate on the "invite_id" field.
+func InviteIDEqualFold(v string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldEqualFold(FieldInviteID, v))
+}
+
+// InviteIDContainsFold applies the ContainsFold predicate on the "invite_id" field.
+func InviteIDContainsFold(v string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldContainsFold(FieldInviteID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// ResultEQ applies the EQ predicate on the "result" field.
+func ResultEQ(v Result) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldEQ(FieldResult, v))
+}
+
+// ResultNEQ applies the NEQ predicate on the "result" field.
+func ResultNEQ(v Result) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldNEQ(FieldResult, v))
+}
+
+// ResultIn applies the In predicate on the "result" field.
+func ResultIn(vs ...Result) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldIn(FieldResult, vs...))
+}
+
+// ResultNotIn applies the NotIn predicate on the "result" field.
+func ResultNotIn(vs ...Result) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.FieldNotIn(FieldResult, vs...))
+}
+
+// HasInvite applies the HasEdge predicate on the "invite" edge.
+func HasInvite() predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, InviteTable, InviteColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInviteWith applies the HasEdge predicate on the "invite" edge with a given conditions (other predicates).
+func HasInviteWith(preds ...predicate.FriendInvite) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(func(s *sql.Selector) {
+		step := newInviteStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FriendInviteUse) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FriendInviteUse) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FriendInviteUse) predicate.FriendInviteUse {
+	return predicate.FriendInviteUse(sql.NotPredicates(p))
+}
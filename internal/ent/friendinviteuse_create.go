@@ -0,0 +1,348 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/friendinvite"
+	"kakashi/chaos/internal/ent/friendinviteuse"
+	"kakashi/chaos/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FriendInviteUseCreate is the builder for creating a FriendInviteUse entity.
+type FriendInviteUseCreate struct {
+	config
+	mutation *FriendInviteUseMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (fiuc *FriendInviteUseCreate) SetCreatedAt(t time.Time) *FriendInviteUseCreate {
+	fiuc.mutation.SetCreatedAt(t)
+	return fiuc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (fiuc *FriendInviteUseCreate) SetNillableCreatedAt(t *time.Time) *FriendInviteUseCreate {
+	if t != nil {
+		fiuc.SetCreatedAt(*t)
+	}
+	return fiuc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fiuc *FriendInviteUseCreate) SetUpdatedAt(t time.Time) *FriendInviteUseCreate {
+	fiuc.mutation.SetUpdatedAt(t)
+	return fiuc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (fiuc *FriendInviteUseCreate) SetNillableUpdatedAt(t *time.Time) *FriendInviteUseCreate {
+	if t != nil {
+		fiuc.SetUpdatedAt(*t)
+	}
+	return fiuc
+}
+
+// SetInviteID sets the "invite_id" field.
+func (fiuc *FriendInviteUseCreate) SetInviteID(s string) *FriendInviteUseCreate {
+	fiuc.mutation.SetInviteID(s)
+	return fiuc
+}
+
+// SetUserID sets the "user_id" field.
+func (fiuc *FriendInviteUseCreate) SetUserID(s string) *FriendInviteUseCreate {
+	fiuc.mutation.SetUserID(s)
+	return fiuc
+}
+
+// SetResult sets the "result" field.
+func (fiuc *FriendInviteUseCreate) SetResult(f friendinviteuse.Result) *FriendInviteUseCreate {
+	fiuc.mutation.SetResult(f)
+	return fiuc
+}
+
+// SetID sets the "id" field.
+func (fiuc *FriendInviteUseCreate) SetID(s string) *FriendInviteUseCreate {
+	fiuc.mutation.SetID(s)
+	return fiuc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (fiuc *FriendInviteUseCreate) SetNillableID(s *string) *FriendInviteUseCreate {
+	if s != nil {
+		fiuc.SetID(*s)
+	}
+	return fiuc
+}
+
+// SetInvite sets the "invite" edge to the FriendInvite entity.
+func (fiuc *FriendInviteUseCreate) SetInvite(f *FriendInvite) *FriendInviteUseCreate {
+	return fiuc.SetInviteID(f.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (fiuc *FriendInviteUseCreate) SetUser(u *User) *FriendInviteUseCreate {
+	return fiuc.SetUserID(u.ID)
+}
+
+// Mutation returns the FriendInviteUseMutation object of the builder.
+func (fiuc *FriendInviteUseCreate) Mutation() *FriendInviteUseMutation {
+	return fiuc.mutation
+}
+
+// Save creates the FriendInviteUse in the database.
+func (fiuc *FriendInviteUseCreate) Save(ctx context.Context) (*FriendInviteUse, error) {
+	fiuc.defaults()
+	return withHooks(ctx, fiuc.sqlSave, fiuc.mutation, fiuc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (fiuc *FriendInviteUseCreate) SaveX(ctx context.Context) *FriendInviteUse {
+	v, err := fiuc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fiuc *FriendInviteUseCreate) Exec(ctx context.Context) error {
+	_, err := fiuc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fiuc *FriendInviteUseCreate) ExecX(ctx context.Context) {
+	if err := fiuc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fiuc *FriendInviteUseCreate) defaults() {
+	if _, ok := fiuc.mutation.CreatedAt(); !ok {
+		v := friendinviteuse.DefaultCreatedAt()
+		fiuc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := fiuc.mutation.UpdatedAt(); !ok {
+		v := friendinviteuse.DefaultUpdatedAt()
+		fiuc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := fiuc.mutation.ID(); !ok {
+		v := friendinviteuse.DefaultID()
+		fiuc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fiuc *FriendInviteUseCreate) check() error {
+	if _, ok := fiuc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FriendInviteUse.created_at"`)}
+	}
+	if _, ok := fiuc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "FriendInviteUse.updated_at"`)}
+	}
+	if _, ok := fiuc.mutation.InviteID(); !ok {
+		return &ValidationError{Name: "invite_id", err: errors.New(`ent: missing required field "FriendInviteUse.invite_id"`)}
+	}
+	if v, ok := fiuc.mutation.InviteID(); ok {
+		if err := friendinviteuse.InviteIDValidator(v); err != nil {
+			return &ValidationError{Name: "invite_id", err: fmt.Errorf(`ent: validator failed for field "FriendInviteUse.invite_id": %w`, err)}
+		}
+	}
+	if _, ok := fiuc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "FriendInviteUse.user_id"`)}
+	}
+	if v, ok := fiuc.mutation.UserID(); ok {
+		if err := friendinviteuse.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "FriendInviteUse.user_id": %w`, err)}
+		}
+	}
+	if _, ok := fiuc.mutation.Result(); !ok {
+		return &ValidationError{Name: "result", err: errors.New(`ent: missing required field "FriendInviteUse.result"`)}
+	}
+	if v, ok := fiuc.mutation.Result(); ok {
+		if err := friendinviteuse.ResultValidator(v); err != nil {
+			return &ValidationError{Name: "result", err: fmt.Errorf(`ent: validator failed for field "FriendInviteUse.result": %w`, err)}
+		}
+	}
+	if len(fiuc.mutation.InviteIDs()) == 0 {
+		return &ValidationError{Name: "invite", err: errors.New(`ent: missing required edge "FriendInviteUse.invite"`)}
+	}
+	if len(fiuc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "FriendInviteUse.user"`)}
+	}
+	return nil
+}
+
+func (fiuc *FriendInviteUseCreate) sqlSave(ctx context.Context) (*FriendInviteUse, error) {
+	if err := fiuc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := fiuc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, fiuc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected FriendInviteUse.ID type: %T", _spec.ID.Value)
+		}
+	}
+	fiuc.mutation.id = &_node.ID
+	fiuc.mutation.done = true
+	return _node, nil
+}
+
+func (fiuc *FriendInviteUseCreate) createSpec() (*FriendInviteUse, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FriendInviteUse{config: fiuc.config}
+		_spec = sqlgraph.NewCreateSpec(friendinviteuse.Table, sqlgraph.NewFieldSpec(friendinviteuse.FieldID, field.TypeString))
+	)
+	if id, ok := fiuc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := fiuc.mutation.CreatedAt(); ok {
+		_spec.SetField(friendinviteuse.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := fiuc.mutation.UpdatedAt(); ok {
+		_spec.SetField(friendinviteuse.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := fiuc.mutation.Result(); ok {
+		_spec.SetField(friendinviteuse.FieldResult, field.TypeEnum, value)
+		_node.Result = value
+	}
+	if nodes := fiuc.mutation.InviteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   friendinviteuse.InviteTable,
+			Columns: []string{friendinviteuse.InviteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendinvite.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.InviteID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fiuc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   friendinviteuse.UserTable,
+			Columns: []string{friendinviteuse.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// FriendInviteUseCreateBulk is the builder for creating many FriendInviteUse entities in bulk.
+type FriendInviteUseCreateBulk struct {
+	config
+	err      error
+	builders []*FriendInviteUseCreate
+}
+
+// Save creates the FriendInviteUse entities in the database.
+func (fiucb *FriendInviteUseCreateBulk) Save(ctx context.Context) ([]*FriendInviteUse, error) {
+	if fiucb.err != nil {
+		return nil, fiucb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(fiucb.builders))
+	nodes := make([]*FriendInviteUse, len(fiucb.builders))
+	mutators := make([]Mutator, len(fiucb.builders))
+	for i := range fiucb.builders {
+		func(i int, root context.Context) {
+			builder := fiucb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FriendInviteUseMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, fiucb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, fiucb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, fiucb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fiucb *FriendInviteUseCreateBulk) SaveX(ctx context.Context) []*FriendInviteUse {
+	v, err := fiucb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fiucb *FriendInviteUseCreateBulk) Exec(ctx context.Context) error {
+	_, err := fiucb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fiucb *FriendInviteUseCreateBulk) ExecX(ctx context.Context) {
+	if err := fiucb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"kakashi/chaos/internal/ent/friendinviteuse"
+	"kakashi/chaos/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FriendInviteUseDelete is the builder for deleting a FriendInviteUse entity.
+type FriendInviteUseDelete struct {
+	config
+	hooks    []Hook
+	mutation *FriendInviteUseMutation
+}
+
+// Where appends a list predicates to the FriendInviteUseDelete builder.
+func (fiud *FriendInviteUseDelete) Where(ps ...predicate.FriendInviteUse) *FriendInviteUseDelete {
+	fiud.mutation.Where(ps...)
+	return fiud
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (fiud *FriendInviteUseDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, fiud.sqlExec, fiud.mutation, fiud.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fiud *FriendInviteUseDelete) ExecX(ctx context.Context) int {
+	n, err := fiud.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (fiud *FriendInviteUseDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(friendinviteuse.Table, sqlgraph.NewFieldSpec(friendinviteuse.FieldID, field.TypeString))
+	if ps := fiud.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, fiud.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	fiud.mutation.done = true
+	return affected, err
+}
+
+// FriendInviteUseDeleteOne is the builder for deleting a single FriendInviteUse entity.
+type FriendInviteUseDeleteOne struct {
+	fiud *FriendInviteUseDelete
+}
+
+// Where appends a list predicates to the FriendInviteUseDelete builder.
+func (fiudo *FriendInviteUseDeleteOne) Where(ps ...predicate.FriendInviteUse) *FriendInviteUseDeleteOne {
+	fiudo.fiud.mutation.Where(ps...)
+	return fiudo
+}
+
+// Exec executes the deletion query.
+func (fiudo *FriendInviteUseDeleteOne) Exec(ctx context.Context) error {
+	n, err := fiudo.fiud.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{friendinviteuse.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fiudo *FriendInviteUseDeleteOne) ExecX(ctx context.Context) {
+	if err := fiudo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"kakashi/chaos/internal/ent/friendinvite"
+	"kakashi/chaos/internal/ent/friendinviteuse"
+	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/user"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FriendInviteUseQuery is the builder for querying FriendInviteUse entities.
+type FriendInviteUseQuery struct {
+	config
+	ctx        *QueryContext
+	order      []friendinviteuse.OrderOption
+	inters     []Interceptor
+	predicates []predicate.FriendInviteUse
+	withInvite *FriendInviteQuery
+	withUser   *UserQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the FriendInviteUseQuery builder.
+func (fiuq *FriendInviteUseQuery) Where(ps ...predicate.FriendInviteUse) *FriendInviteUseQuery {
+	fiuq.predicates = append(fiuq.predicates, ps...)
+	return fiuq
+}
+
+// Limit the number of records to be returned by this query.
+func (fiuq *FriendInviteUseQuery) Limit(limit int) *FriendInviteUseQuery {
+	fiuq.ctx.Limit = &limit
+	return fiuq
+}
+
+// Offset to start from.
+func (fiuq *FriendInviteUseQuery) Offset(offset int) *FriendInviteUseQuery {
+	fiuq.ctx.Offset = &offset
+	return fiuq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (fiuq *FriendInviteUseQuery) Unique(unique bool) *FriendInviteUseQuery {
+	fiuq.ctx.Unique = &unique
+	return fiuq
+}
+
+// Order specifies how the records should be ordered.
+func (fiuq *FriendInviteUseQuery) Order(o ...friendinviteuse.OrderOption) *FriendInviteUseQuery {
+	fiuq.order = append(fiuq.order, o...)
+	return fiuq
+}
+
+// QueryInvite chains the current query on the "invite" edge.
+func (fiuq *FriendInviteUseQuery) QueryInvite() *FriendInviteQuery {
+	query := (&FriendInviteClient{config: fiuq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fiuq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := fiuq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(friendinviteuse.Table, friendinviteuse.FieldID, selector),
+			sqlgraph.To(friendinvite.Table, friendinvite.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, friendinviteuse.InviteTable, friendinviteuse.InviteColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fiuq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (fiuq *FriendInviteUseQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: fiuq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fiuq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := fiuq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(friendinviteuse.Table, friendinviteuse.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, friendinviteuse.UserTable, friendinviteuse.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fiuq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first FriendInviteUse entity from the query.
+// Returns a *NotFoundError when no FriendInviteUse was found.
+func (fiuq *FriendInviteUseQuery) First(ctx context.Context) (*FriendInviteUse, error) {
+	nodes, err := fiuq.Limit(1).All(setContextOp(ctx, fiuq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{friendinviteuse.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (fiuq *FriendInviteUseQuery) FirstX(ctx context.Context) *FriendInviteUse {
+	node, err := fiuq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first FriendInviteUse ID from the query.
+// Returns a *NotFoundError when no FriendInviteUse ID was found.
+func (fiuq *FriendInviteUseQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = fiuq.Limit(1).IDs(setContextOp(ctx, fiuq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{friendinviteuse.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (fiuq *FriendInviteUseQuery) FirstIDX(ctx context.Context) string {
+	id, err := fiuq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single FriendInviteUse entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one FriendInviteUse entity is found.
+// Returns a *NotFoundError when no FriendInviteUse entities are found.
+func (fiuq *FriendInviteUseQuery) Only(ctx context.Context) (*FriendInviteUse, error) {
+	nodes, err := fiuq.Limit(2).All(setContextOp(ctx, fiuq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{friendinviteuse.Label}
+	default:
+		return nil, &NotSingularError{friendinviteuse.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (fiuq *FriendInviteUseQuery) OnlyX(ctx context.Context) *FriendInviteUse {
+	node, err := fiuq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only FriendInviteUse ID in the query.
+// Returns a *NotSingularError when more than one FriendInviteUse ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (fiuq *FriendInviteUseQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = fiuq.Limit(2).IDs(setContextOp(ctx, fiuq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{friendinviteuse.Label}
+	default:
+		err = &NotSingularError{friendinviteuse.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (fiuq *FriendInviteUseQuery) OnlyIDX(ctx context.Context) string {
+	id, err := fiuq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of FriendInviteUses.
+func (fiuq *FriendInviteUseQuery) All(ctx context.Context) ([]*FriendInviteUse, error) {
+	ctx = setContextOp(ctx, fiuq.ctx, ent.OpQueryAll)
+	if err := fiuq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*FriendInviteUse, *FriendInviteUseQuery]()
+	return withInterceptors[[]*FriendInviteUse](ctx, fiuq, qr, fiuq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (fiuq *FriendInviteUseQuery) AllX(ctx context.Context) []*FriendInviteUse {
+	nodes, err := fiuq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of FriendInviteUse IDs.
+func (fiuq *FriendInviteUseQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if fiuq.ctx.Unique == nil && fiuq.path != nil {
+		fiuq.Unique(true)
+	}
+	ctx = setContextOp(ctx, fiuq.ctx, ent.OpQueryIDs)
+	if err = fiuq.Select(friendinviteuse.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (fiuq *FriendInviteUseQuery) IDsX(ctx context.Context) []string {
+	ids, err := fiuq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (fiuq *FriendInviteUseQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, fiuq.ctx, ent.OpQueryCount)
+	if err := fiuq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, fiuq, querierCount[*FriendInviteUseQuery](), fiuq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (fiuq *FriendInviteUseQuery) CountX(ctx context.Context) int {
+	count, err := fiuq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (fiuq *FriendInviteUseQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, fiuq.ctx, ent.OpQueryExist)
+	switch _, err := fiuq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (fiuq *FriendInviteUseQuery) ExistX(ctx context.Context) bool {
+	exist, err := fiuq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the FriendInviteUseQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (fiuq *FriendInviteUseQuery) Clone() *FriendInviteUseQuery {
+	if fiuq == nil {
+		return nil
+	}
+	return &FriendInviteUseQuery{
+		config:     fiuq.config,
+		ctx:        fiuq.ctx.Clone(),
+		order:      append([]friendinviteuse.OrderOption{}, fiuq.order...),
+		inters:     append([]Interceptor{}, fiuq.inters...),
+		predicates: append([]predicate.FriendInviteUse{}, fiuq.predicates...),
+		withInvite: fiuq.withInvite.Clone(),
+		withUser:   fiuq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  fiuq.sql.Clone(),
+		path: fiuq.path,
+	}
+}
+
+// WithInvite tells the query-builder to eager-load the nodes that are connected to
+// the "invite" edge. The optional arguments are used to configure the query builder of the edge.
+func (fiuq *FriendInviteUseQuery) WithInvite(opts ...func(*FriendInviteQuery)) *FriendInviteUseQuery {
+	query := (&FriendInviteClient{config: fiuq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	fiuq.withInvite = query
+	return fiuq
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (fiuq *FriendInviteUseQuery) WithUser(opts ...func(*UserQuery)) *FriendInviteUseQuery {
+	query := (&UserClient{config: fiuq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	fiuq.withUser = query
+	return fiuq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.FriendInviteUse.Query().
+//		GroupBy(friendinviteuse.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (fiuq *FriendInviteUseQuery) GroupBy(field string, fields ...string) *FriendInviteUseGroupBy {
+	fiuq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &FriendInviteUseGroupBy{build: fiuq}
+	grbuild.flds = &fiuq.ctx.Fields
+	grbuild.label = friendinviteuse.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.FriendInviteUse.Query().
+//		Select(friendinviteuse.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (fiuq *FriendInviteUseQuery) Select(fields ...string) *FriendInviteUseSelect {
+	fiuq.ctx.Fields = append(fiuq.ctx.Fields, fields...)
+	sbuild := &FriendInviteUseSelect{FriendInviteUseQuery: fiuq}
+	sbuild.label = friendinviteuse.Label
+	sbuild.flds, sbuild.scan = &fiuq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a FriendInviteUseSelect configured with the given aggregations.
+func (fiuq *FriendInviteUseQuery) Aggregate(fns ...AggregateFunc) *FriendInviteUseSelect {
+	return fiuq.Select().Aggregate(fns...)
+}
+
+func (fiuq *FriendInviteUseQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range fiuq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, fiuq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range fiuq.ctx.Fields {
+		if !friendinviteuse.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if fiuq.path != nil {
+		prev, err := fiuq.path(ctx)
+		if err != nil {
+			return err
+		}
+		fiuq.sql = prev
+	}
+	return nil
+}
+
+func (fiuq *FriendInviteUseQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*FriendInviteUse, error) {
+	var (
+		nodes       = []*FriendInviteUse{}
+		_spec       = fiuq.querySpec()
+		loadedTypes = [2]bool{
+			fiuq.withInvite != nil,
+			fiuq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*FriendInviteUse).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &FriendInviteUse{config: fiuq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, fiuq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := fiuq.withInvite; query != nil {
+		if err := fiuq.loadInvite(ctx, query, nodes, nil,
+			func(n *FriendInviteUse, e *FriendInvite) { n.Edges.Invite = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := fiuq.withUser; query != nil {
+		if err := fiuq.loadUser(ctx, query, nodes, nil,
+			func(n *FriendInviteUse, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (fiuq *FriendInviteUseQuery) loadInvite(ctx context.Context, query *FriendInviteQuery, nodes []*FriendInviteUse, init func(*FriendInviteUse), assign func(*FriendInviteUse, *FriendInvite)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*FriendInviteUse)
+	for i := range nodes {
+		fk := nodes[i].InviteID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(friendinvite.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "invite_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (fiuq *FriendInviteUseQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*FriendInviteUse, init func(*FriendInviteUse), assign func(*FriendInviteUse, *User)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*FriendInviteUse)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (fiuq *FriendInviteUseQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := fiuq.querySpec()
+	_spec.Node.Columns = fiuq.ctx.Fields
+	if len(fiuq.ctx.Fields) > 0 {
+		_spec.Unique = fiuq.ctx.Unique != nil && *fiuq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, fiuq.driver, _spec)
+}
+
+func (fiuq *FriendInviteUseQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(friendinviteuse.Table, friendinviteuse.Columns, sqlgraph.NewFieldSpec(friendinviteuse.FieldID, field.TypeString))
+	_spec.From = fiuq.sql
+	if unique := fiuq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if fiuq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := fiuq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, friendinviteuse.FieldID)
+		for i := range fields {
+			if fields[i] != friendinviteuse.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if fiuq.withInvite != nil {
+			_spec.Node.AddColumnOnce(friendinviteuse.FieldInviteID)
+		}
+		if fiuq.withUser != nil {
+			_spec.Node.AddColumnOnce(friendinviteuse.FieldUserID)
+		}
+	}
+	if ps := fiuq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := fiuq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := fiuq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := fiuq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (fiuq *FriendInviteUseQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(fiuq.driver.Dialect())
+	t1 := builder.Table(friendinviteuse.Table)
+	columns := fiuq.ctx.Fields
+	if len(columns) == 0 {
+		columns = friendinviteuse.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if fiuq.sql != nil {
+		selector = fiuq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if fiuq.ctx.Unique != nil && *fiuq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range fiuq.predicates {
+		p(selector)
+	}
+	for _, p := range fiuq.order {
+		p(selector)
+	}
+	if offset := fiuq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := fiuq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// FriendInviteUseGroupBy is the group-by builder for FriendInviteUse entities.
+type FriendInviteUseGroupBy struct {
+	selector
+	build *FriendInviteUseQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (fiugb *FriendInviteUseGroupBy) Aggregate(fns ...AggregateFunc) *FriendInviteUseGroupBy {
+	fiugb.fns = append(fiugb.fns, fns...)
+	return fiugb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (fiugb *FriendInviteUseGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, fiugb.build.ctx, ent.OpQueryGroupBy)
+	if err := fiugb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FriendInviteUseQuery, *FriendInviteUseGroupBy](ctx, fiugb.build, fiugb, fiugb.build.inters, v)
+}
+
+func (fiugb *FriendInviteUseGroupBy) sqlScan(ctx context.Context, root *FriendInviteUseQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(fiugb.fns))
+	for _, fn := range fiugb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*fiugb.flds)+len(fiugb.fns))
+		for _, f := range *fiugb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*fiugb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := fiugb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// FriendInviteUseSelect is the builder for selecting fields of FriendInviteUse entities.
+type FriendInviteUseSelect struct {
+	*FriendInviteUseQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (fius *FriendInviteUseSelect) Aggregate(fns ...AggregateFunc) *FriendInviteUseSelect {
+	fius.fns = append(fius.fns, fns...)
+	return fius
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (fius *FriendInviteUseSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, fius.ctx, ent.OpQuerySelect)
+	if err := fius.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FriendInviteUseQuery, *FriendInviteUseSelect](ctx, fius.FriendInviteUseQuery, fius, fius.inters, v)
+}
+
+func (fius *FriendInviteUseSelect) sqlScan(ctx context.Context, root *FriendInviteUseQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(fius.fns))
+	for _, fn := range fius.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*fius.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := fius.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
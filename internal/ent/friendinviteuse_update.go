@@ -0,0 +1,556 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/friendinvite"
+	"kakashi/chaos/internal/ent/friendinviteuse"
+	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FriendInviteUseUpdate is the builder for updating FriendInviteUse entities.
+type FriendInviteUseUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FriendInviteUseMutation
+}
+
+// Where appends a list predicates to the FriendInviteUseUpdate builder.
+func (fiuu *FriendInviteUseUpdate) Where(ps ...predicate.FriendInviteUse) *FriendInviteUseUpdate {
+	fiuu.mutation.Where(ps...)
+	return fiuu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (fiuu *FriendInviteUseUpdate) SetCreatedAt(t time.Time) *FriendInviteUseUpdate {
+	fiuu.mutation.SetCreatedAt(t)
+	return fiuu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (fiuu *FriendInviteUseUpdate) SetNillableCreatedAt(t *time.Time) *FriendInviteUseUpdate {
+	if t != nil {
+		fiuu.SetCreatedAt(*t)
+	}
+	return fiuu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fiuu *FriendInviteUseUpdate) SetUpdatedAt(t time.Time) *FriendInviteUseUpdate {
+	fiuu.mutation.SetUpdatedAt(t)
+	return fiuu
+}
+
+// SetInviteID sets the "invite_id" field.
+func (fiuu *FriendInviteUseUpdate) SetInviteID(s string) *FriendInviteUseUpdate {
+	fiuu.mutation.SetInviteID(s)
+	return fiuu
+}
+
+// SetNillableInviteID sets the "invite_id" field if the given value is not nil.
+func (fiuu *FriendInviteUseUpdate) SetNillableInviteID(s *string) *FriendInviteUseUpdate {
+	if s != nil {
+		fiuu.SetInviteID(*s)
+	}
+	return fiuu
+}
+
+// SetUserID sets the "user_id" field.
+func (fiuu *FriendInviteUseUpdate) SetUserID(s string) *FriendInviteUseUpdate {
+	fiuu.mutation.SetUserID(s)
+	return fiuu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (fiuu *FriendInviteUseUpdate) SetNillableUserID(s *string) *FriendInviteUseUpdate {
+	if s != nil {
+		fiuu.SetUserID(*s)
+	}
+	return fiuu
+}
+
+// SetResult sets the "result" field.
+func (fiuu *FriendInviteUseUpdate) SetResult(f friendinviteuse.Result) *FriendInviteUseUpdate {
+	fiuu.mutation.SetResult(f)
+	return fiuu
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (fiuu *FriendInviteUseUpdate) SetNillableResult(f *friendinviteuse.Result) *FriendInviteUseUpdate {
+	if f != nil {
+		fiuu.SetResult(*f)
+	}
+	return fiuu
+}
+
+// SetInvite sets the "invite" edge to the FriendInvite entity.
+func (fiuu *FriendInviteUseUpdate) SetInvite(f *FriendInvite) *FriendInviteUseUpdate {
+	return fiuu.SetInviteID(f.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (fiuu *FriendInviteUseUpdate) SetUser(u *User) *FriendInviteUseUpdate {
+	return fiuu.SetUserID(u.ID)
+}
+
+// Mutation returns the FriendInviteUseMutation object of the builder.
+func (fiuu *FriendInviteUseUpdate) Mutation() *FriendInviteUseMutation {
+	return fiuu.mutation
+}
+
+// ClearInvite clears the "invite" edge to the FriendInvite entity.
+func (fiuu *FriendInviteUseUpdate) ClearInvite() *FriendInviteUseUpdate {
+	fiuu.mutation.ClearInvite()
+	return fiuu
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (fiuu *FriendInviteUseUpdate) ClearUser() *FriendInviteUseUpdate {
+	fiuu.mutation.ClearUser()
+	return fiuu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (fiuu *FriendInviteUseUpdate) Save(ctx context.Context) (int, error) {
+	fiuu.defaults()
+	return withHooks(ctx, fiuu.sqlSave, fiuu.mutation, fiuu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fiuu *FriendInviteUseUpdate) SaveX(ctx context.Context) int {
+	affected, err := fiuu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (fiuu *FriendInviteUseUpdate) Exec(ctx context.Context) error {
+	_, err := fiuu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fiuu *FriendInviteUseUpdate) ExecX(ctx context.Context) {
+	if err := fiuu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fiuu *FriendInviteUseUpdate) defaults() {
+	if _, ok := fiuu.mutation.UpdatedAt(); !ok {
+		v := friendinviteuse.UpdateDefaultUpdatedAt()
+		fiuu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fiuu *FriendInviteUseUpdate) check() error {
+	if v, ok := fiuu.mutation.InviteID(); ok {
+		if err := friendinviteuse.InviteIDValidator(v); err != nil {
+			return &ValidationError{Name: "invite_id", err: fmt.Errorf(`ent: validator failed for field "FriendInviteUse.invite_id": %w`, err)}
+		}
+	}
+	if v, ok := fiuu.mutation.UserID(); ok {
+		if err := friendinviteuse.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "FriendInviteUse.user_id": %w`, err)}
+		}
+	}
+	if v, ok := fiuu.mutation.Result(); ok {
+		if err := friendinviteuse.ResultValidator(v); err != nil {
+			return &ValidationError{Name: "result", err: fmt.Errorf(`ent: validator failed for field "FriendInviteUse.result": %w`, err)}
+		}
+	}
+	if fiuu.mutation.InviteCleared() && len(fiuu.mutation.InviteIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FriendInviteUse.invite"`)
+	}
+	if fiuu.mutation.UserCleared() && len(fiuu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FriendInviteUse.user"`)
+	}
+	return nil
+}
+
+func (fiuu *FriendInviteUseUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := fiuu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(friendinviteuse.Table, friendinviteuse.Columns, sqlgraph.NewFieldSpec(friendinviteuse.FieldID, field.TypeString))
+	if ps := fiuu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fiuu.mutation.CreatedAt(); ok {
+		_spec.SetField(friendinviteuse.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := fiuu.mutation.UpdatedAt(); ok {
+		_spec.SetField(friendinviteuse.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := fiuu.mutation.Result(); ok {
+		_spec.SetField(friendinviteuse.FieldResult, field.TypeEnum, value)
+	}
+	if fiuu.mutation.InviteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   friendinviteuse.InviteTable,
+			Columns: []string{friendinviteuse.InviteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendinvite.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fiuu.mutation.InviteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   friendinviteuse.InviteTable,
+			Columns: []string{friendinviteuse.InviteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendinvite.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fiuu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   friendinviteuse.UserTable,
+			Columns: []string{friendinviteuse.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fiuu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   friendinviteuse.UserTable,
+			Columns: []string{friendinviteuse.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, fiuu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{friendinviteuse.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	fiuu.mutation.done = true
+	return n, nil
+}
+
+// FriendInviteUseUpdateOne is the builder for updating a single FriendInviteUse entity.
+type FriendInviteUseUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FriendInviteUseMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (fiuuo *FriendInviteUseUpdateOne) SetCreatedAt(t time.Time) *FriendInviteUseUpdateOne {
+	fiuuo.mutation.SetCreatedAt(t)
+	return fiuuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (fiuuo *FriendInviteUseUpdateOne) SetNillableCreatedAt(t *time.Time) *FriendInviteUseUpdateOne {
+	if t != nil {
+		fiuuo.SetCreatedAt(*t)
+	}
+	return fiuuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fiuuo *FriendInviteUseUpdateOne) SetUpdatedAt(t time.Time) *FriendInviteUseUpdateOne {
+	fiuuo.mutation.SetUpdatedAt(t)
+	return fiuuo
+}
+
+// SetInviteID sets the "invite_id" field.
+func (fiuuo *FriendInviteUseUpdateOne) SetInviteID(s string) *FriendInviteUseUpdateOne {
+	fiuuo.mutation.SetInviteID(s)
+	return fiuuo
+}
+
+// SetNillableInviteID sets the "invite_id" field if the given value is not nil.
+func (fiuuo *FriendInviteUseUpdateOne) SetNillableInviteID(s *string) *FriendInviteUseUpdateOne {
+	if s != nil {
+		fiuuo.SetInviteID(*s)
+	}
+	return fiuuo
+}
+
+// SetUserID sets the "user_id" field.
+func (fiuuo *FriendInviteUseUpdateOne) SetUserID(s string) *FriendInviteUseUpdateOne {
+	fiuuo.mutation.SetUserID(s)
+	return fiuuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (fiuuo *FriendInviteUseUpdateOne) SetNillableUserID(s *string) *FriendInviteUseUpdateOne {
+	if s != nil {
+		fiuuo.SetUserID(*s)
+	}
+	return fiuuo
+}
+
+// SetResult sets the "result" field.
+func (fiuuo *FriendInviteUseUpdateOne) SetResult(f friendinviteuse.Result) *FriendInviteUseUpdateOne {
+	fiuuo.mutation.SetResult(f)
+	return fiuuo
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (fiuuo *FriendInviteUseUpdateOne) SetNillableResult(f *friendinviteuse.Result) *FriendInviteUseUpdateOne {
+	if f != nil {
+		fiuuo.SetResult(*f)
+	}
+	return fiuuo
+}
+
+// SetInvite sets the "invite" edge to the FriendInvite entity.
+func (fiuuo *FriendInviteUseUpdateOne) SetInvite(f *FriendInvite) *FriendInviteUseUpdateOne {
+	return fiuuo.SetInviteID(f.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (fiuuo *FriendInviteUseUpdateOne) SetUser(u *User) *FriendInviteUseUpdateOne {
+	return fiuuo.SetUserID(u.ID)
+}
+
+// Mutation returns the FriendInviteUseMutation object of the builder.
+func (fiuuo *FriendInviteUseUpdateOne) Mutation() *FriendInviteUseMutation {
+	return fiuuo.mutation
+}
+
+// ClearInvite clears the "invite" edge to the FriendInvite entity.
+func (fiuuo *FriendInviteUseUpdateOne) ClearInvite() *FriendInviteUseUpdateOne {
+	fiuuo.mutation.ClearInvite()
+	return fiuuo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (fiuuo *FriendInviteUseUpdateOne) ClearUser() *FriendInviteUseUpdateOne {
+	fiuuo.mutation.ClearUser()
+	return fiuuo
+}
+
+// Where appends a list predicates to the FriendInviteUseUpdate builder.
+func (fiuuo *FriendInviteUseUpdateOne) Where(ps ...predicate.FriendInviteUse) *FriendInviteUseUpdateOne {
+	fiuuo.mutation.Where(ps...)
+	return fiuuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (fiuuo *FriendInviteUseUpdateOne) Select(field string, fields ...string) *FriendInviteUseUpdateOne {
+	fiuuo.fields = append([]string{field}, fields...)
+	return fiuuo
+}
+
+// Save executes the query and returns the updated FriendInviteUse entity.
+func (fiuuo *FriendInviteUseUpdateOne) Save(ctx context.Context) (*FriendInviteUse, error) {
+	fiuuo.defaults()
+	return withHooks(ctx, fiuuo.sqlSave, fiuuo.mutation, fiuuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fiuuo *FriendInviteUseUpdateOne) SaveX(ctx context.Context) *FriendInviteUse {
+	node, err := fiuuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (fiuuo *FriendInviteUseUpdateOne) Exec(ctx context.Context) error {
+	_, err := fiuuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fiuuo *FriendInviteUseUpdateOne) ExecX(ctx context.Context) {
+	if err := fiuuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fiuuo *FriendInviteUseUpdateOne) defaults() {
+	if _, ok := fiuuo.mutation.UpdatedAt(); !ok {
+		v := friendinviteuse.UpdateDefaultUpdatedAt()
+		fiuuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fiuuo *FriendInviteUseUpdateOne) check() error {
+	if v, ok := fiuuo.mutation.InviteID(); ok {
+		if err := friendinviteuse.InviteIDValidator(v); err != nil {
+			return &ValidationError{Name: "invite_id", err: fmt.Errorf(`ent: validator failed for field "FriendInviteUse.invite_id": %w`, err)}
+		}
+	}
+	if v, ok := fiuuo.mutation.UserID(); ok {
+		if err := friendinviteuse.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "FriendInviteUse.user_id": %w`, err)}
+		}
+	}
+	if v, ok := fiuuo.mutation.Result(); ok {
+		if err := friendinviteuse.ResultValidator(v); err != nil {
+			return &ValidationError{Name: "result", err: fmt.Errorf(`ent: validator failed for field "FriendInviteUse.result": %w`, err)}
+		}
+	}
+	if fiuuo.mutation.InviteCleared() && len(fiuuo.mutation.InviteIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FriendInviteUse.invite"`)
+	}
+	if fiuuo.mutation.UserCleared() && len(fiuuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FriendInviteUse.user"`)
+	}
+	return nil
+}
+
+func (fiuuo *FriendInviteUseUpdateOne) sqlSave(ctx context.Context) (_node *FriendInviteUse, err error) {
+	if err := fiuuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(friendinviteuse.Table, friendinviteuse.Columns, sqlgraph.NewFieldSpec(friendinviteuse.FieldID, field.TypeString))
+	id, ok := fiuuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FriendInviteUse.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := fiuuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, friendinviteuse.FieldID)
+		for _, f := range fields {
+			if !friendinviteuse.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != friendinviteuse.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := fiuuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fiuuo.mutation.CreatedAt(); ok {
+		_spec.SetField(friendinviteuse.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := fiuuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(friendinviteuse.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := fiuuo.mutation.Result(); ok {
+		_spec.SetField(friendinviteuse.FieldResult, field.TypeEnum, value)
+	}
+	if fiuuo.mutation.InviteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   friendinviteuse.InviteTable,
+			Columns: []string{friendinviteuse.InviteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendinvite.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fiuuo.mutation.InviteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   friendinviteuse.InviteTable,
+			Columns: []string{friendinviteuse.InviteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendinvite.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fiuuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   friendinviteuse.UserTable,
+			Columns: []string{friendinviteuse.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fiuuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   friendinviteuse.UserTable,
+			Columns: []string{friendinviteuse.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &FriendInviteUse{config: fiuuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, fiuuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{friendinviteuse.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	fiuuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"kakashi/chaos/internal/ent/hiddenmessage"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// HiddenMessage is the model entity for the HiddenMessage schema.
+type HiddenMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// MessageID holds the value of the "message_id" field.
+	MessageID string `json:"message_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the HiddenMessageQuery when eager-loading is set.
+	Edges        HiddenMessageEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// HiddenMessageEdges holds the relations/edges for other nodes in the graph.
+type HiddenMessageEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Message holds the value of the message edge.
+	Message *Message `json:"message,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HiddenMessageEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// MessageOrErr returns the Message value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HiddenMessageEdges) MessageOrErr() (*Message, error) {
+	if e.Message != nil {
+		return e.Message, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "message"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*HiddenMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case hiddenmessage.FieldID, hiddenmessage.FieldUserID, hiddenmessage.FieldMessageID:
+			values[i] = new(sql.NullString)
+		case hiddenmessage.FieldCreatedAt, hiddenmessage.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the HiddenMessage fields.
+func (hm *HiddenMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case hiddenmessage.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				hm.ID = value.String
+			}
+		case hiddenmessage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				hm.CreatedAt = value.Time
+			}
+		case hiddenmessage.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				hm.UpdatedAt = value.Time
+			}
+		case hiddenmessage.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				hm.UserID = value.String
+			}
+		case hiddenmessage.FieldMessageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value.Valid {
+				hm.MessageID = value.String
+			}
+		default:
+			hm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the HiddenMessage.
+// This includes values selected through modifiers, order, etc.
+func (hm *HiddenMessage) Value(name string) (ent.Value, error) {
+	return hm.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the HiddenMessage entity.
+func (hm *HiddenMessage) QueryUser() *UserQuery {
+	return NewHiddenMessageClient(hm.config).QueryUser(hm)
+}
+
+// QueryMessage queries the "message" edge of the HiddenMessage entity.
+func (hm *HiddenMessage) QueryMessage() *MessageQuery {
+	return NewHiddenMessageClient(hm.config).QueryMessage(hm)
+}
+
+// Update returns a builder for updating this HiddenMessage.
+// Note that you need to call HiddenMessage.Unwrap() before calling this method if this HiddenMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (hm *HiddenMessage) Update() *HiddenMessageUpdateOne {
+	return NewHiddenMessageClient(hm.config).UpdateOne(hm)
+}
+
+// Unwrap unwraps the HiddenMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (hm *HiddenMessage) Unwrap() *HiddenMessage {
+	_tx, ok := hm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: HiddenMessage is not a transactional entity")
+	}
+	hm.config.driver = _tx.drv
+	return hm
+}
+
+// String implements the fmt.Stringer.
+func (hm *HiddenMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("HiddenMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", hm.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(hm.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(hm.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(hm.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("message_id=")
+	builder.WriteString(hm.MessageID)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// HiddenMessages is a parsable slice of HiddenMessage.
+type HiddenMessages []*HiddenMessage
@@ -0,0 +1,330 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/hiddenmessage"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// HiddenMessageCreate is the builder for creating a HiddenMessage entity.
+type HiddenMessageCreate struct {
+	config
+	mutation *HiddenMessageMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (hmc *HiddenMessageCreate) SetCreatedAt(t time.Time) *HiddenMessageCreate {
+	hmc.mutation.SetCreatedAt(t)
+	return hmc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (hmc *HiddenMessageCreate) SetNillableCreatedAt(t *time.Time) *HiddenMessageCreate {
+	if t != nil {
+		hmc.SetCreatedAt(*t)
+	}
+	return hmc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (hmc *HiddenMessageCreate) SetUpdatedAt(t time.Time) *HiddenMessageCreate {
+	hmc.mutation.SetUpdatedAt(t)
+	return hmc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (hmc *HiddenMessageCreate) SetNillableUpdatedAt(t *time.Time) *HiddenMessageCreate {
+	if t != nil {
+		hmc.SetUpdatedAt(*t)
+	}
+	return hmc
+}
+
+// SetUserID sets the "user_id" field.
+func (hmc *HiddenMessageCreate) SetUserID(s string) *HiddenMessageCreate {
+	hmc.mutation.SetUserID(s)
+	return hmc
+}
+
+// SetMessageID sets the "message_id" field.
+func (hmc *HiddenMessageCreate) SetMessageID(s string) *HiddenMessageCreate {
+	hmc.mutation.SetMessageID(s)
+	return hmc
+}
+
+// SetID sets the "id" field.
+func (hmc *HiddenMessageCreate) SetID(s string) *HiddenMessageCreate {
+	hmc.mutation.SetID(s)
+	return hmc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (hmc *HiddenMessageCreate) SetNillableID(s *string) *HiddenMessageCreate {
+	if s != nil {
+		hmc.SetID(*s)
+	}
+	return hmc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (hmc *HiddenMessageCreate) SetUser(u *User) *HiddenMessageCreate {
+	return hmc.SetUserID(u.ID)
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (hmc *HiddenMessageCreate) SetMessage(m *Message) *HiddenMessageCreate {
+	return hmc.SetMessageID(m.ID)
+}
+
+// Mutation returns the HiddenMessageMutation object of the builder.
+func (hmc *HiddenMessageCreate) Mutation() *HiddenMessageMutation {
+	return hmc.mutation
+}
+
+// Save creates the HiddenMessage in the database.
+func (hmc *HiddenMessageCreate) Save(ctx context.Context) (*HiddenMessage, error) {
+	hmc.defaults()
+	return withHooks(ctx, hmc.sqlSave, hmc.mutation, hmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (hmc *HiddenMessageCreate) SaveX(ctx context.Context) *HiddenMessage {
+	v, err := hmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (hmc *HiddenMessageCreate) Exec(ctx context.Context) error {
+	_, err := hmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hmc *HiddenMessageCreate) ExecX(ctx context.Context) {
+	if err := hmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (hmc *HiddenMessageCreate) defaults() {
+	if _, ok := hmc.mutation.CreatedAt(); !ok {
+		v := hiddenmessage.DefaultCreatedAt()
+		hmc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := hmc.mutation.UpdatedAt(); !ok {
+		v := hiddenmessage.DefaultUpdatedAt()
+		hmc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := hmc.mutation.ID(); !ok {
+		v := hiddenmessage.DefaultID()
+		hmc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (hmc *HiddenMessageCreate) check() error {
+	if _, ok := hmc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "HiddenMessage.created_at"`)}
+	}
+	if _, ok := hmc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "HiddenMessage.updated_at"`)}
+	}
+	if _, ok := hmc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "HiddenMessage.user_id"`)}
+	}
+	if v, ok := hmc.mutation.UserID(); ok {
+		if err := hiddenmessage.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "HiddenMessage.user_id": %w`, err)}
+		}
+	}
+	if _, ok := hmc.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "HiddenMessage.message_id"`)}
+	}
+	if v, ok := hmc.mutation.MessageID(); ok {
+		if err := hiddenmessage.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "HiddenMessage.message_id": %w`, err)}
+		}
+	}
+	if len(hmc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "HiddenMessage.user"`)}
+	}
+	if len(hmc.mutation.MessageIDs()) == 0 {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required edge "HiddenMessage.message"`)}
+	}
+	return nil
+}
+
+func (hmc *HiddenMessageCreate) sqlSave(ctx context.Context) (*HiddenMessage, error) {
+	if err := hmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := hmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, hmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected HiddenMessage.ID type: %T", _spec.ID.Value)
+		}
+	}
+	hmc.mutation.id = &_node.ID
+	hmc.mutation.done = true
+	return _node, nil
+}
+
+func (hmc *HiddenMessageCreate) createSpec() (*HiddenMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &HiddenMessage{config: hmc.config}
+		_spec = sqlgraph.NewCreateSpec(hiddenmessage.Table, sqlgraph.NewFieldSpec(hiddenmessage.FieldID, field.TypeString))
+	)
+	if id, ok := hmc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := hmc.mutation.CreatedAt(); ok {
+		_spec.SetField(hiddenmessage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := hmc.mutation.UpdatedAt(); ok {
+		_spec.SetField(hiddenmessage.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := hmc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hiddenmessage.UserTable,
+			Columns: []string{hiddenmessage.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := hmc.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hiddenmessage.MessageTable,
+			Columns: []string{hiddenmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// HiddenMessageCreateBulk is the builder for creating many HiddenMessage entities in bulk.
+type HiddenMessageCreateBulk struct {
+	config
+	err      error
+	builders []*HiddenMessageCreate
+}
+
+// Save creates the HiddenMessage entities in the database.
+func (hmcb *HiddenMessageCreateBulk) Save(ctx context.Context) ([]*HiddenMessage, error) {
+	if hmcb.err != nil {
+		return nil, hmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(hmcb.builders))
+	nodes := make([]*HiddenMessage, len(hmcb.builders))
+	mutators := make([]Mutator, len(hmcb.builders))
+	for i := range hmcb.builders {
+		func(i int, root context.Context) {
+			builder := hmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HiddenMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, hmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, hmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, hmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hmcb *HiddenMessageCreateBulk) SaveX(ctx context.Context) []*HiddenMessage {
+	v, err := hmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (hmcb *HiddenMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := hmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hmcb *HiddenMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := hmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"kakashi/chaos/internal/ent/hiddenmessage"
+	"kakashi/chaos/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// HiddenMessageDelete is the builder for deleting a HiddenMessage entity.
+type HiddenMessageDelete struct {
+	config
+	hooks    []Hook
+	mutation *HiddenMessageMutation
+}
+
+// Where appends a list predicates to the HiddenMessageDelete builder.
+func (hmd *HiddenMessageDelete) Where(ps ...predicate.HiddenMessage) *HiddenMessageDelete {
+	hmd.mutation.Where(ps...)
+	return hmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (hmd *HiddenMessageDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, hmd.sqlExec, hmd.mutation, hmd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hmd *HiddenMessageDelete) ExecX(ctx context.Context) int {
+	n, err := hmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (hmd *HiddenMessageDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(hiddenmessage.Table, sqlgraph.NewFieldSpec(hiddenmessage.FieldID, field.TypeString))
+	if ps := hmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, hmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	hmd.mutation.done = true
+	return affected, err
+}
+
+// HiddenMessageDeleteOne is the builder for deleting a single HiddenMessage entity.
+type HiddenMessageDeleteOne struct {
+	hmd *HiddenMessageDelete
+}
+
+// Where appends a list predicates to the HiddenMessageDelete builder.
+func (hmdo *HiddenMessageDeleteOne) Where(ps ...predicate.HiddenMessage) *HiddenMessageDeleteOne {
+	hmdo.hmd.mutation.Where(ps...)
+	return hmdo
+}
+
+// Exec executes the deletion query.
+func (hmdo *HiddenMessageDeleteOne) Exec(ctx context.Context) error {
+	n, err := hmdo.hmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{hiddenmessage.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hmdo *HiddenMessageDeleteOne) ExecX(ctx context.Context) {
+	if err := hmdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"kakashi/chaos/internal/ent/hiddenmessage"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/user"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// HiddenMessageQuery is the builder for querying HiddenMessage entities.
+type HiddenMessageQuery struct {
+	config
+	ctx         *QueryContext
+	order       []hiddenmessage.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.HiddenMessage
+	withUser    *UserQuery
+	withMessage *MessageQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the HiddenMessageQuery builder.
+func (hmq *HiddenMessageQuery) Where(ps ...predicate.HiddenMessage) *HiddenMessageQuery {
+	hmq.predicates = append(hmq.predicates, ps...)
+	return hmq
+}
+
+// Limit the number of records to be returned by this query.
+func (hmq *HiddenMessageQuery) Limit(limit int) *HiddenMessageQuery {
+	hmq.ctx.Limit = &limit
+	return hmq
+}
+
+// Offset to start from.
+func (hmq *HiddenMessageQuery) Offset(offset int) *HiddenMessageQuery {
+	hmq.ctx.Offset = &offset
+	return hmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (hmq *HiddenMessageQuery) Unique(unique bool) *HiddenMessageQuery {
+	hmq.ctx.Unique = &unique
+	return hmq
+}
+
+// Order specifies how the records should be ordered.
+func (hmq *HiddenMessageQuery) Order(o ...hiddenmessage.OrderOption) *HiddenMessageQuery {
+	hmq.order = append(hmq.order, o...)
+	return hmq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (hmq *HiddenMessageQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: hmq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := hmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := hmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hiddenmessage.Table, hiddenmessage.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, hiddenmessage.UserTable, hiddenmessage.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(hmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMessage chains the current query on the "message" edge.
+func (hmq *HiddenMessageQuery) QueryMessage() *MessageQuery {
+	query := (&MessageClient{config: hmq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := hmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := hmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hiddenmessage.Table, hiddenmessage.FieldID, selector),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, hiddenmessage.MessageTable, hiddenmessage.MessageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(hmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first HiddenMessage entity from the query.
+// Returns a *NotFoundError when no HiddenMessage was found.
+func (hmq *HiddenMessageQuery) First(ctx context.Context) (*HiddenMessage, error) {
+	nodes, err := hmq.Limit(1).All(setContextOp(ctx, hmq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{hiddenmessage.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (hmq *HiddenMessageQuery) FirstX(ctx context.Context) *HiddenMessage {
+	node, err := hmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first HiddenMessage ID from the query.
+// Returns a *NotFoundError when no HiddenMessage ID was found.
+func (hmq *HiddenMessageQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = hmq.Limit(1).IDs(setContextOp(ctx, hmq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{hiddenmessage.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (hmq *HiddenMessageQuery) FirstIDX(ctx context.Context) string {
+	id, err := hmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single HiddenMessage entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one HiddenMessage entity is found.
+// Returns a *NotFoundError when no HiddenMessage entities are found.
+func (hmq *HiddenMessageQuery) Only(ctx context.Context) (*HiddenMessage, error) {
+	nodes, err := hmq.Limit(2).All(setContextOp(ctx, hmq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{hiddenmessage.Label}
+	default:
+		return nil, &NotSingularError{hiddenmessage.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (hmq *HiddenMessageQuery) OnlyX(ctx context.Context) *HiddenMessage {
+	node, err := hmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only HiddenMessage ID in the query.
+// Returns a *NotSingularError when more than one HiddenMessage ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (hmq *HiddenMessageQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = hmq.Limit(2).IDs(setContextOp(ctx, hmq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{hiddenmessage.Label}
+	default:
+		err = &NotSingularError{hiddenmessage.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (hmq *HiddenMessageQuery) OnlyIDX(ctx context.Context) string {
+	id, err := hmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of HiddenMessages.
+func (hmq *HiddenMessageQuery) All(ctx context.Context) ([]*HiddenMessage, error) {
+	ctx = setContextOp(ctx, hmq.ctx, ent.OpQueryAll)
+	if err := hmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*HiddenMessage, *HiddenMessageQuery]()
+	return withInterceptors[[]*HiddenMessage](ctx, hmq, qr, hmq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (hmq *HiddenMessageQuery) AllX(ctx context.Context) []*HiddenMessage {
+	nodes, err := hmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of HiddenMessage IDs.
+func (hmq *HiddenMessageQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if hmq.ctx.Unique == nil && hmq.path != nil {
+		hmq.Unique(true)
+	}
+	ctx = setContextOp(ctx, hmq.ctx, ent.OpQueryIDs)
+	if err = hmq.Select(hiddenmessage.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (hmq *HiddenMessageQuery) IDsX(ctx context.Context) []string {
+	ids, err := hmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (hmq *HiddenMessageQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, hmq.ctx, ent.OpQueryCount)
+	if err := hmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, hmq, querierCount[*HiddenMessageQuery](), hmq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (hmq *HiddenMessageQuery) CountX(ctx context.Context) int {
+	count, err := hmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (hmq *HiddenMessageQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, hmq.ctx, ent.OpQueryExist)
+	switch _, err := hmq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (hmq *HiddenMessageQuery) ExistX(ctx context.Context) bool {
+	exist, err := hmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the HiddenMessageQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (hmq *HiddenMessageQuery) Clone() *HiddenMessageQuery {
+	if hmq == nil {
+		return nil
+	}
+	return &HiddenMessageQuery{
+		config:      hmq.config,
+		ctx:         hmq.ctx.Clone(),
+		order:       append([]hiddenmessage.OrderOption{}, hmq.order...),
+		inters:      append([]Interceptor{}, hmq.inters...),
+		predicates:  append([]predicate.HiddenMessage{}, hmq.predicates...),
+		withUser:    hmq.withUser.Clone(),
+		withMessage: hmq.withMessage.Clone(),
+		// clone intermediate query.
+		sql:  hmq.sql.Clone(),
+		path: hmq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (hmq *HiddenMessageQuery) WithUser(opts ...func(*UserQuery)) *HiddenMessageQuery {
+	query := (&UserClient{config: hmq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	hmq.withUser = query
+	return hmq
+}
+
+// WithMessage tells the query-builder to eager-load the nodes that are connected to
+// the "message" edge. The optional arguments are used to configure the query builder of the edge.
+func (hmq *HiddenMessageQuery) WithMessage(opts ...func(*MessageQuery)) *HiddenMessageQuery {
+	query := (&MessageClient{config: hmq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	hmq.withMessage = query
+	return hmq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.HiddenMessage.Query().
+//		GroupBy(hiddenmessage.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (hmq *HiddenMessageQuery) GroupBy(field string, fields ...string) *HiddenMessageGroupBy {
+	hmq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &HiddenMessageGroupBy{build: hmq}
+	grbuild.flds = &hmq.ctx.Fields
+	grbuild.label = hiddenmessage.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.HiddenMessage.Query().
+//		Select(hiddenmessage.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (hmq *HiddenMessageQuery) Select(fields ...string) *HiddenMessageSelect {
+	hmq.ctx.Fields = append(hmq.ctx.Fields, fields...)
+	sbuild := &HiddenMessageSelect{HiddenMessageQuery: hmq}
+	sbuild.label = hiddenmessage.Label
+	sbuild.flds, sbuild.scan = &hmq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a HiddenMessageSelect configured with the given aggregations.
+func (hmq *HiddenMessageQuery) Aggregate(fns ...AggregateFunc) *HiddenMessageSelect {
+	return hmq.Select().Aggregate(fns...)
+}
+
+func (hmq *HiddenMessageQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range hmq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, hmq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range hmq.ctx.Fields {
+		if !hiddenmessage.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if hmq.path != nil {
+		prev, err := hmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		hmq.sql = prev
+	}
+	return nil
+}
+
+func (hmq *HiddenMessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*HiddenMessage, error) {
+	var (
+		nodes       = []*HiddenMessage{}
+		_spec       = hmq.querySpec()
+		loadedTypes = [2]bool{
+			hmq.withUser != nil,
+			hmq.withMessage != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*HiddenMessage).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &HiddenMessage{config: hmq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, hmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := hmq.withUser; query != nil {
+		if err := hmq.loadUser(ctx, query, nodes, nil,
+			func(n *HiddenMessage, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := hmq.withMessage; query != nil {
+		if err := hmq.loadMessage(ctx, query, nodes, nil,
+			func(n *HiddenMessage, e *Message) { n.Edges.Message = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (hmq *HiddenMessageQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*HiddenMessage, init func(*HiddenMessage), assign func(*HiddenMessage, *User)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*HiddenMessage)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (hmq *HiddenMessageQuery) loadMessage(ctx context.Context, query *MessageQuery, nodes []*HiddenMessage, init func(*HiddenMessage), assign func(*HiddenMessage, *Message)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*HiddenMessage)
+	for i := range nodes {
+		fk := nodes[i].MessageID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(message.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "message_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (hmq *HiddenMessageQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := hmq.querySpec()
+	_spec.Node.Columns = hmq.ctx.Fields
+	if len(hmq.ctx.Fields) > 0 {
+		_spec.Unique = hmq.ctx.Unique != nil && *hmq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, hmq.driver, _spec)
+}
+
+func (hmq *HiddenMessageQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(hiddenmessage.Table, hiddenmessage.Columns, sqlgraph.NewFieldSpec(hiddenmessage.FieldID, field.TypeString))
+	_spec.From = hmq.sql
+	if unique := hmq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if hmq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := hmq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, hiddenmessage.FieldID)
+		for i := range fields {
+			if fields[i] != hiddenmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if hmq.withUser != nil {
+			_spec.Node.AddColumnOnce(hiddenmessage.FieldUserID)
+		}
+		if hmq.withMessage != nil {
+			_spec.Node.AddColumnOnce(hiddenmessage.FieldMessageID)
+		}
+	}
+	if ps := hmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := hmq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := hmq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := hmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (hmq *HiddenMessageQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(hmq.driver.Dialect())
+	t1 := builder.Table(hiddenmessage.Table)
+	columns := hmq.ctx.Fields
+	if len(columns) == 0 {
+		columns = hiddenmessage.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if hmq.sql != nil {
+		selector = hmq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if hmq.ctx.Unique != nil && *hmq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range hmq.predicates {
+		p(selector)
+	}
+	for _, p := range hmq.order {
+		p(selector)
+	}
+	if offset := hmq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := hmq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// HiddenMessageGroupBy is the group-by builder for HiddenMessage entities.
+type HiddenMessageGroupBy struct {
+	selector
+	build *HiddenMessageQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (hmgb *HiddenMessageGroupBy) Aggregate(fns ...AggregateFunc) *HiddenMessageGroupBy {
+	hmgb.fns = append(hmgb.fns, fns...)
+	return hmgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (hmgb *HiddenMessageGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, hmgb.build.ctx, ent.OpQueryGroupBy)
+	if err := hmgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*HiddenMessageQuery, *HiddenMessageGroupBy](ctx, hmgb.build, hmgb, hmgb.build.inters, v)
+}
+
+func (hmgb *HiddenMessageGroupBy) sqlScan(ctx context.Context, root *HiddenMessageQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(hmgb.fns))
+	for _, fn := range hmgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*hmgb.flds)+len(hmgb.fns))
+		for _, f := range *hmgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*hmgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := hmgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// HiddenMessageSelect is the builder for selecting fields of HiddenMessage entities.
+type HiddenMessageSelect struct {
+	*HiddenMessageQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (hms *HiddenMessageSelect) Aggregate(fns ...AggregateFunc) *HiddenMessageSelect {
+	hms.fns = append(hms.fns, fns...)
+	return hms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (hms *HiddenMessageSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, hms.ctx, ent.OpQuerySelect)
+	if err := hms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*HiddenMessageQuery, *HiddenMessageSelect](ctx, hms.HiddenMessageQuery, hms, hms.inters, v)
+}
+
+func (hms *HiddenMessageSelect) sqlScan(ctx context.Context, root *HiddenMessageQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(hms.fns))
+	for _, fn := range hms.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*hms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := hms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,512 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/hiddenmessage"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// HiddenMessageUpdate is the builder for updating HiddenMessage entities.
+type HiddenMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *HiddenMessageMutation
+}
+
+// Where appends a list predicates to the HiddenMessageUpdate builder.
+func (hmu *HiddenMessageUpdate) Where(ps ...predicate.HiddenMessage) *HiddenMessageUpdate {
+	hmu.mutation.Where(ps...)
+	return hmu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (hmu *HiddenMessageUpdate) SetCreatedAt(t time.Time) *HiddenMessageUpdate {
+	hmu.mutation.SetCreatedAt(t)
+	return hmu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (hmu *HiddenMessageUpdate) SetNillableCreatedAt(t *time.Time) *HiddenMessageUpdate {
+	if t != nil {
+		hmu.SetCreatedAt(*t)
+	}
+	return hmu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (hmu *HiddenMessageUpdate) SetUpdatedAt(t time.Time) *HiddenMessageUpdate {
+	hmu.mutation.SetUpdatedAt(t)
+	return hmu
+}
+
+// SetUserID sets the "user_id" field.
+func (hmu *HiddenMessageUpdate) SetUserID(s string) *HiddenMessageUpdate {
+	hmu.mutation.SetUserID(s)
+	return hmu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (hmu *HiddenMessageUpdate) SetNillableUserID(s *string) *HiddenMessageUpdate {
+	if s != nil {
+		hmu.SetUserID(*s)
+	}
+	return hmu
+}
+
+// SetMessageID sets the "message_id" field.
+func (hmu *HiddenMessageUpdate) SetMessageID(s string) *HiddenMessageUpdate {
+	hmu.mutation.SetMessageID(s)
+	return hmu
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (hmu *HiddenMessageUpdate) SetNillableMessageID(s *string) *HiddenMessageUpdate {
+	if s != nil {
+		hmu.SetMessageID(*s)
+	}
+	return hmu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (hmu *HiddenMessageUpdate) SetUser(u *User) *HiddenMessageUpdate {
+	return hmu.SetUserID(u.ID)
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (hmu *HiddenMessageUpdate) SetMessage(m *Message) *HiddenMessageUpdate {
+	return hmu.SetMessageID(m.ID)
+}
+
+// Mutation returns the HiddenMessageMutation object of the builder.
+func (hmu *HiddenMessageUpdate) Mutation() *HiddenMessageMutation {
+	return hmu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (hmu *HiddenMessageUpdate) ClearUser() *HiddenMessageUpdate {
+	hmu.mutation.ClearUser()
+	return hmu
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (hmu *HiddenMessageUpdate) ClearMessage() *HiddenMessageUpdate {
+	hmu.mutation.ClearMessage()
+	return hmu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (hmu *HiddenMessageUpdate) Save(ctx context.Context) (int, error) {
+	hmu.defaults()
+	return withHooks(ctx, hmu.sqlSave, hmu.mutation, hmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hmu *HiddenMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := hmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (hmu *HiddenMessageUpdate) Exec(ctx context.Context) error {
+	_, err := hmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hmu *HiddenMessageUpdate) ExecX(ctx context.Context) {
+	if err := hmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (hmu *HiddenMessageUpdate) defaults() {
+	if _, ok := hmu.mutation.UpdatedAt(); !ok {
+		v := hiddenmessage.UpdateDefaultUpdatedAt()
+		hmu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (hmu *HiddenMessageUpdate) check() error {
+	if v, ok := hmu.mutation.UserID(); ok {
+		if err := hiddenmessage.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "HiddenMessage.user_id": %w`, err)}
+		}
+	}
+	if v, ok := hmu.mutation.MessageID(); ok {
+		if err := hiddenmessage.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "HiddenMessage.message_id": %w`, err)}
+		}
+	}
+	if hmu.mutation.UserCleared() && len(hmu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "HiddenMessage.user"`)
+	}
+	if hmu.mutation.MessageCleared() && len(hmu.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "HiddenMessage.message"`)
+	}
+	return nil
+}
+
+func (hmu *HiddenMessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := hmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(hiddenmessage.Table, hiddenmessage.Columns, sqlgraph.NewFieldSpec(hiddenmessage.FieldID, field.TypeString))
+	if ps := hmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := hmu.mutation.CreatedAt(); ok {
+		_spec.SetField(hiddenmessage.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := hmu.mutation.UpdatedAt(); ok {
+		_spec.SetField(hiddenmessage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if hmu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hiddenmessage.UserTable,
+			Columns: []string{hiddenmessage.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hmu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hiddenmessage.UserTable,
+			Columns: []string{hiddenmessage.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if hmu.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hiddenmessage.MessageTable,
+			Columns: []string{hiddenmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hmu.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hiddenmessage.MessageTable,
+			Columns: []string{hiddenmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, hmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{hiddenmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	hmu.mutation.done = true
+	return n, nil
+}
+
+// HiddenMessageUpdateOne is the builder for updating a single HiddenMessage entity.
+type HiddenMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *HiddenMessageMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (hmuo *HiddenMessageUpdateOne) SetCreatedAt(t time.Time) *HiddenMessageUpdateOne {
+	hmuo.mutation.SetCreatedAt(t)
+	return hmuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (hmuo *HiddenMessageUpdateOne) SetNillableCreatedAt(t *time.Time) *HiddenMessageUpdateOne {
+	if t != nil {
+		hmuo.SetCreatedAt(*t)
+	}
+	return hmuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (hmuo *HiddenMessageUpdateOne) SetUpdatedAt(t time.Time) *HiddenMessageUpdateOne {
+	hmuo.mutation.SetUpdatedAt(t)
+	return hmuo
+}
+
+// SetUserID sets the "user_id" field.
+func (hmuo *HiddenMessageUpdateOne) SetUserID(s string) *HiddenMessageUpdateOne {
+	hmuo.mutation.SetUserID(s)
+	return hmuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (hmuo *HiddenMessageUpdateOne) SetNillableUserID(s *string) *HiddenMessageUpdateOne {
+	if s != nil {
+		hmuo.SetUserID(*s)
+	}
+	return hmuo
+}
+
+// SetMessageID sets the "message_id" field.
+func (hmuo *HiddenMessageUpdateOne) SetMessageID(s string) *HiddenMessageUpdateOne {
+	hmuo.mutation.SetMessageID(s)
+	return hmuo
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (hmuo *HiddenMessageUpdateOne) SetNillableMessageID(s *string) *HiddenMessageUpdateOne {
+	if s != nil {
+		hmuo.SetMessageID(*s)
+	}
+	return hmuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (hmuo *HiddenMessageUpdateOne) SetUser(u *User) *HiddenMessageUpdateOne {
+	return hmuo.SetUserID(u.ID)
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (hmuo *HiddenMessageUpdateOne) SetMessage(m *Message) *HiddenMessageUpdateOne {
+	return hmuo.SetMessageID(m.ID)
+}
+
+// Mutation returns the HiddenMessageMutation object of the builder.
+func (hmuo *HiddenMessageUpdateOne) Mutation() *HiddenMessageMutation {
+	return hmuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (hmuo *HiddenMessageUpdateOne) ClearUser() *HiddenMessageUpdateOne {
+	hmuo.mutation.ClearUser()
+	return hmuo
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (hmuo *HiddenMessageUpdateOne) ClearMessage() *HiddenMessageUpdateOne {
+	hmuo.mutation.ClearMessage()
+	return hmuo
+}
+
+// Where appends a list predicates to the HiddenMessageUpdate builder.
+func (hmuo *HiddenMessageUpdateOne) Where(ps ...predicate.HiddenMessage) *HiddenMessageUpdateOne {
+	hmuo.mutation.Where(ps...)
+	return hmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (hmuo *HiddenMessageUpdateOne) Select(field string, fields ...string) *HiddenMessageUpdateOne {
+	hmuo.fields = append([]string{field}, fields...)
+	return hmuo
+}
+
+// Save executes the query and returns the updated HiddenMessage entity.
+func (hmuo *HiddenMessageUpdateOne) Save(ctx context.Context) (*HiddenMessage, error) {
+	hmuo.defaults()
+	return withHooks(ctx, hmuo.sqlSave, hmuo.mutation, hmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hmuo *HiddenMessageUpdateOne) SaveX(ctx context.Context) *HiddenMessage {
+	node, err := hmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (hmuo *HiddenMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := hmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hmuo *HiddenMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := hmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (hmuo *HiddenMessageUpdateOne) defaults() {
+	if _, ok := hmuo.mutation.UpdatedAt(); !ok {
+		v := hiddenmessage.UpdateDefaultUpdatedAt()
+		hmuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (hmuo *HiddenMessageUpdateOne) check() error {
+	if v, ok := hmuo.mutation.UserID(); ok {
+		if err := hiddenmessage.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "HiddenMessage.user_id": %w`, err)}
+		}
+	}
+	if v, ok := hmuo.mutation.MessageID(); ok {
+		if err := hiddenmessage.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "HiddenMessage.message_id": %w`, err)}
+		}
+	}
+	if hmuo.mutation.UserCleared() && len(hmuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "HiddenMessage.user"`)
+	}
+	if hmuo.mutation.MessageCleared() && len(hmuo.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "HiddenMessage.message"`)
+	}
+	return nil
+}
+
+func (hmuo *HiddenMessageUpdateOne) sqlSave(ctx context.Context) (_node *HiddenMessage, err error) {
+	if err := hmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(hiddenmessage.Table, hiddenmessage.Columns, sqlgraph.NewFieldSpec(hiddenmessage.FieldID, field.TypeString))
+	id, ok := hmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "HiddenMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := hmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, hiddenmessage.FieldID)
+		for _, f := range fields {
+			if !hiddenmessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != hiddenmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := hmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := hmuo.mutation.CreatedAt(); ok {
+		_spec.SetField(hiddenmessage.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := hmuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(hiddenmessage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if hmuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hiddenmessage.UserTable,
+			Columns: []string{hiddenmessage.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hmuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hiddenmessage.UserTable,
+			Columns: []string{hiddenmessage.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if hmuo.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hiddenmessage.MessageTable,
+			Columns: []string{hiddenmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hmuo.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hiddenmessage.MessageTable,
+			Columns: []string{hiddenmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &HiddenMessage{config: hmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, hmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{hiddenmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	hmuo.mutation.done = true
+	return _node, nil
+}
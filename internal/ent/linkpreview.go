@@ -0,0 +1,223 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"kakashi/chaos/internal/ent/linkpreview"
+	"kakashi/chaos/internal/ent/message"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// LinkPreview is the model entity for the LinkPreview schema.
+type LinkPreview struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// MessageID holds the value of the "message_id" field.
+	MessageID string `json:"message_id,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// ImageURL holds the value of the "image_url" field.
+	ImageURL string `json:"image_url,omitempty"`
+	// SiteName holds the value of the "site_name" field.
+	SiteName string `json:"site_name,omitempty"`
+	// Order of the URL within the message content
+	Position int `json:"position,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LinkPreviewQuery when eager-loading is set.
+	Edges        LinkPreviewEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LinkPreviewEdges holds the relations/edges for other nodes in the graph.
+type LinkPreviewEdges struct {
+	// Message holds the value of the message edge.
+	Message *Message `json:"message,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MessageOrErr returns the Message value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LinkPreviewEdges) MessageOrErr() (*Message, error) {
+	if e.Message != nil {
+		return e.Message, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "message"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LinkPreview) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case linkpreview.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case linkpreview.FieldID, linkpreview.FieldMessageID, linkpreview.FieldURL, linkpreview.FieldTitle, linkpreview.FieldDescription, linkpreview.FieldImageURL, linkpreview.FieldSiteName:
+			values[i] = new(sql.NullString)
+		case linkpreview.FieldCreatedAt, linkpreview.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LinkPreview fields.
+func (lp *LinkPreview) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case linkpreview.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				lp.ID = value.String
+			}
+		case linkpreview.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				lp.CreatedAt = value.Time
+			}
+		case linkpreview.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				lp.UpdatedAt = value.Time
+			}
+		case linkpreview.FieldMessageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value.Valid {
+				lp.MessageID = value.String
+			}
+		case linkpreview.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				lp.URL = value.String
+			}
+		case linkpreview.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				lp.Title = value.String
+			}
+		case linkpreview.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				lp.Description = value.String
+			}
+		case linkpreview.FieldImageURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field image_url", values[i])
+			} else if value.Valid {
+				lp.ImageURL = value.String
+			}
+		case linkpreview.FieldSiteName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field site_name", values[i])
+			} else if value.Valid {
+				lp.SiteName = value.String
+			}
+		case linkpreview.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				lp.Position = int(value.Int64)
+			}
+		default:
+			lp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LinkPreview.
+// This includes values selected through modifiers, order, etc.
+func (lp *LinkPreview) Value(name string) (ent.Value, error) {
+	return lp.selectValues.Get(name)
+}
+
+// QueryMessage queries the "message" edge of the LinkPreview entity.
+func (lp *LinkPreview) QueryMessage() *MessageQuery {
+	return NewLinkPreviewClient(lp.config).QueryMessage(lp)
+}
+
+// Update returns a builder for updating this LinkPreview.
+// Note that you need to call LinkPreview.Unwrap() before calling this method if this LinkPreview
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (lp *LinkPreview) Update() *LinkPreviewUpdateOne {
+	return NewLinkPreviewClient(lp.config).UpdateOne(lp)
+}
+
+// Unwrap unwraps the LinkPreview entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (lp *LinkPreview) Unwrap() *LinkPreview {
+	_tx, ok := lp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LinkPreview is not a transactional entity")
+	}
+	lp.config.driver = _tx.drv
+	return lp
+}
+
+// String implements the fmt.Stringer.
+func (lp *LinkPreview) String() string {
+	var builder strings.Builder
+	builder.WriteString("LinkPreview(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", lp.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(lp.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(lp.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("message_id=")
+	builder.WriteString(lp.MessageID)
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(lp.URL)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(lp.Title)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(lp.Description)
+	builder.WriteString(", ")
+	builder.WriteString("image_url=")
+	builder.WriteString(lp.ImageURL)
+	builder.WriteString(", ")
+	builder.WriteString("site_name=")
+	builder.WriteString(lp.SiteName)
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", lp.Position))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LinkPreviews is a parsable slice of LinkPreview.
+type LinkPreviews []*LinkPreview
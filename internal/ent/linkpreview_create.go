@@ -0,0 +1,403 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/linkpreview"
+	"kakashi/chaos/internal/ent/message"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LinkPreviewCreate is the builder for creating a LinkPreview entity.
+type LinkPreviewCreate struct {
+	config
+	mutation *LinkPreviewMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lpc *LinkPreviewCreate) SetCreatedAt(t time.Time) *LinkPreviewCreate {
+	lpc.mutation.SetCreatedAt(t)
+	return lpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lpc *LinkPreviewCreate) SetNillableCreatedAt(t *time.Time) *LinkPreviewCreate {
+	if t != nil {
+		lpc.SetCreatedAt(*t)
+	}
+	return lpc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (lpc *LinkPreviewCreate) SetUpdatedAt(t time.Time) *LinkPreviewCreate {
+	lpc.mutation.SetUpdatedAt(t)
+	return lpc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (lpc *LinkPreviewCreate) SetNillableUpdatedAt(t *time.Time) *LinkPreviewCreate {
+	if t != nil {
+		lpc.SetUpdatedAt(*t)
+	}
+	return lpc
+}
+
+// SetMessageID sets the "message_id" field.
+func (lpc *LinkPreviewCreate) SetMessageID(s string) *LinkPreviewCreate {
+	lpc.mutation.SetMessageID(s)
+	return lpc
+}
+
+// SetURL sets the "url" field.
+func (lpc *LinkPreviewCreate) SetURL(s string) *LinkPreviewCreate {
+	lpc.mutation.SetURL(s)
+	return lpc
+}
+
+// SetTitle sets the "title" field.
+func (lpc *LinkPreviewCreate) SetTitle(s string) *LinkPreviewCreate {
+	lpc.mutation.SetTitle(s)
+	return lpc
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (lpc *LinkPreviewCreate) SetNillableTitle(s *string) *LinkPreviewCreate {
+	if s != nil {
+		lpc.SetTitle(*s)
+	}
+	return lpc
+}
+
+// SetDescription sets the "description" field.
+func (lpc *LinkPreviewCreate) SetDescription(s string) *LinkPreviewCreate {
+	lpc.mutation.SetDescription(s)
+	return lpc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (lpc *LinkPreviewCreate) SetNillableDescription(s *string) *LinkPreviewCreate {
+	if s != nil {
+		lpc.SetDescription(*s)
+	}
+	return lpc
+}
+
+// SetImageURL sets the "image_url" field.
+func (lpc *LinkPreviewCreate) SetImageURL(s string) *LinkPreviewCreate {
+	lpc.mutation.SetImageURL(s)
+	return lpc
+}
+
+// SetNillableImageURL sets the "image_url" field if the given value is not nil.
+func (lpc *LinkPreviewCreate) SetNillableImageURL(s *string) *LinkPreviewCreate {
+	if s != nil {
+		lpc.SetImageURL(*s)
+	}
+	return lpc
+}
+
+// SetSiteName sets the "site_name" field.
+func (lpc *LinkPreviewCreate) SetSiteName(s string) *LinkPreviewCreate {
+	lpc.mutation.SetSiteName(s)
+	return lpc
+}
+
+// SetNillableSiteName sets the "site_name" field if the given value is not nil.
+func (lpc *LinkPreviewCreate) SetNillableSiteName(s *string) *LinkPreviewCreate {
+	if s != nil {
+		lpc.SetSiteName(*s)
+	}
+	return lpc
+}
+
+// SetPosition sets the "position" field.
+func (lpc *LinkPreviewCreate) SetPosition(i int) *LinkPreviewCreate {
+	lpc.mutation.SetPosition(i)
+	return lpc
+}
+
+// SetID sets the "id" field.
+func (lpc *LinkPreviewCreate) SetID(s string) *LinkPreviewCreate {
+	lpc.mutation.SetID(s)
+	return lpc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (lpc *LinkPreviewCreate) SetNillableID(s *string) *LinkPreviewCreate {
+	if s != nil {
+		lpc.SetID(*s)
+	}
+	return lpc
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (lpc *LinkPreviewCreate) SetMessage(m *Message) *LinkPreviewCreate {
+	return lpc.SetMessageID(m.ID)
+}
+
+// Mutation returns the LinkPreviewMutation object of the builder.
+func (lpc *LinkPreviewCreate) Mutation() *LinkPreviewMutation {
+	return lpc.mutation
+}
+
+// Save creates the LinkPreview in the database.
+func (lpc *LinkPreviewCreate) Save(ctx context.Context) (*LinkPreview, error) {
+	lpc.defaults()
+	return withHooks(ctx, lpc.sqlSave, lpc.mutation, lpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lpc *LinkPreviewCreate) SaveX(ctx context.Context) *LinkPreview {
+	v, err := lpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lpc *LinkPreviewCreate) Exec(ctx context.Context) error {
+	_, err := lpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lpc *LinkPreviewCreate) ExecX(ctx context.Context) {
+	if err := lpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lpc *LinkPreviewCreate) defaults() {
+	if _, ok := lpc.mutation.CreatedAt(); !ok {
+		v := linkpreview.DefaultCreatedAt()
+		lpc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := lpc.mutation.UpdatedAt(); !ok {
+		v := linkpreview.DefaultUpdatedAt()
+		lpc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := lpc.mutation.ID(); !ok {
+		v := linkpreview.DefaultID()
+		lpc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lpc *LinkPreviewCreate) check() error {
+	if _, ok := lpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LinkPreview.created_at"`)}
+	}
+	if _, ok := lpc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LinkPreview.updated_at"`)}
+	}
+	if _, ok := lpc.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "LinkPreview.message_id"`)}
+	}
+	if v, ok := lpc.mutation.MessageID(); ok {
+		if err := linkpreview.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "LinkPreview.message_id": %w`, err)}
+		}
+	}
+	if _, ok := lpc.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "LinkPreview.url"`)}
+	}
+	if v, ok := lpc.mutation.URL(); ok {
+		if err := linkpreview.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "LinkPreview.url": %w`, err)}
+		}
+	}
+	if v, ok := lpc.mutation.ImageURL(); ok {
+		if err := linkpreview.ImageURLValidator(v); err != nil {
+			return &ValidationError{Name: "image_url", err: fmt.Errorf(`ent: validator failed for field "LinkPreview.image_url": %w`, err)}
+		}
+	}
+	if _, ok := lpc.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "LinkPreview.position"`)}
+	}
+	if v, ok := lpc.mutation.Position(); ok {
+		if err := linkpreview.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "LinkPreview.position": %w`, err)}
+		}
+	}
+	if len(lpc.mutation.MessageIDs()) == 0 {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required edge "LinkPreview.message"`)}
+	}
+	return nil
+}
+
+func (lpc *LinkPreviewCreate) sqlSave(ctx context.Context) (*LinkPreview, error) {
+	if err := lpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected LinkPreview.ID type: %T", _spec.ID.Value)
+		}
+	}
+	lpc.mutation.id = &_node.ID
+	lpc.mutation.done = true
+	return _node, nil
+}
+
+func (lpc *LinkPreviewCreate) createSpec() (*LinkPreview, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LinkPreview{config: lpc.config}
+		_spec = sqlgraph.NewCreateSpec(linkpreview.Table, sqlgraph.NewFieldSpec(linkpreview.FieldID, field.TypeString))
+	)
+	if id, ok := lpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := lpc.mutation.CreatedAt(); ok {
+		_spec.SetField(linkpreview.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := lpc.mutation.UpdatedAt(); ok {
+		_spec.SetField(linkpreview.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := lpc.mutation.URL(); ok {
+		_spec.SetField(linkpreview.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := lpc.mutation.Title(); ok {
+		_spec.SetField(linkpreview.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := lpc.mutation.Description(); ok {
+		_spec.SetField(linkpreview.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := lpc.mutation.ImageURL(); ok {
+		_spec.SetField(linkpreview.FieldImageURL, field.TypeString, value)
+		_node.ImageURL = value
+	}
+	if value, ok := lpc.mutation.SiteName(); ok {
+		_spec.SetField(linkpreview.FieldSiteName, field.TypeString, value)
+		_node.SiteName = value
+	}
+	if value, ok := lpc.mutation.Position(); ok {
+		_spec.SetField(linkpreview.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if nodes := lpc.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   linkpreview.MessageTable,
+			Columns: []string{linkpreview.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LinkPreviewCreateBulk is the builder for creating many LinkPreview entities in bulk.
+type LinkPreviewCreateBulk struct {
+	config
+	err      error
+	builders []*LinkPreviewCreate
+}
+
+// Save creates the LinkPreview entities in the database.
+func (lpcb *LinkPreviewCreateBulk) Save(ctx context.Context) ([]*LinkPreview, error) {
+	if lpcb.err != nil {
+		return nil, lpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lpcb.builders))
+	nodes := make([]*LinkPreview, len(lpcb.builders))
+	mutators := make([]Mutator, len(lpcb.builders))
+	for i := range lpcb.builders {
+		func(i int, root context.Context) {
+			builder := lpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LinkPreviewMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lpcb *LinkPreviewCreateBulk) SaveX(ctx context.Context) []*LinkPreview {
+	v, err := lpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lpcb *LinkPreviewCreateBulk) Exec(ctx context.Context) error {
+	_, err := lpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lpcb *LinkPreviewCreateBulk) ExecX(ctx context.Context) {
+	if err := lpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
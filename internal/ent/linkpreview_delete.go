@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"kakashi/chaos/internal/ent/linkpreview"
+	"kakashi/chaos/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LinkPreviewDelete is the builder for deleting a LinkPreview entity.
+type LinkPreviewDelete struct {
+	config
+	hooks    []Hook
+	mutation *LinkPreviewMutation
+}
+
+// Where appends a list predicates to the LinkPreviewDelete builder.
+func (lpd *LinkPreviewDelete) Where(ps ...predicate.LinkPreview) *LinkPreviewDelete {
+	lpd.mutation.Where(ps...)
+	return lpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (lpd *LinkPreviewDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, lpd.sqlExec, lpd.mutation, lpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lpd *LinkPreviewDelete) ExecX(ctx context.Context) int {
+	n, err := lpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (lpd *LinkPreviewDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(linkpreview.Table, sqlgraph.NewFieldSpec(linkpreview.FieldID, field.TypeString))
+	if ps := lpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, lpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	lpd.mutation.done = true
+	return affected, err
+}
+
+// LinkPreviewDeleteOne is the builder for deleting a single LinkPreview entity.
+type LinkPreviewDeleteOne struct {
+	lpd *LinkPreviewDelete
+}
+
+// Where appends a list predicates to the LinkPreviewDelete builder.
+func (lpdo *LinkPreviewDeleteOne) Where(ps ...predicate.LinkPreview) *LinkPreviewDeleteOne {
+	lpdo.lpd.mutation.Where(ps...)
+	return lpdo
+}
+
+// Exec executes the deletion query.
+func (lpdo *LinkPreviewDeleteOne) Exec(ctx context.Context) error {
+	n, err := lpdo.lpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{linkpreview.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lpdo *LinkPreviewDeleteOne) ExecX(ctx context.Context) {
+	if err := lpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"kakashi/chaos/internal/ent/linkpreview"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/predicate"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LinkPreviewQuery is the builder for querying LinkPreview entities.
+type LinkPreviewQuery struct {
+	config
+	ctx         *QueryContext
+	order       []linkpreview.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.LinkPreview
+	withMessage *MessageQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LinkPreviewQuery builder.
+func (lpq *LinkPreviewQuery) Where(ps ...predicate.LinkPreview) *LinkPreviewQuery {
+	lpq.predicates = append(lpq.predicates, ps...)
+	return lpq
+}
+
+// Limit the number of records to be returned by this query.
+func (lpq *LinkPreviewQuery) Limit(limit int) *LinkPreviewQuery {
+	lpq.ctx.Limit = &limit
+	return lpq
+}
+
+// Offset to start from.
+func (lpq *LinkPreviewQuery) Offset(offset int) *LinkPreviewQuery {
+	lpq.ctx.Offset = &offset
+	return lpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (lpq *LinkPreviewQuery) Unique(unique bool) *LinkPreviewQuery {
+	lpq.ctx.Unique = &unique
+	return lpq
+}
+
+// Order specifies how the records should be ordered.
+func (lpq *LinkPreviewQuery) Order(o ...linkpreview.OrderOption) *LinkPreviewQuery {
+	lpq.order = append(lpq.order, o...)
+	return lpq
+}
+
+// QueryMessage chains the current query on the "message" edge.
+func (lpq *LinkPreviewQuery) QueryMessage() *MessageQuery {
+	query := (&MessageClient{config: lpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(linkpreview.Table, linkpreview.FieldID, selector),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, linkpreview.MessageTable, linkpreview.MessageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first LinkPreview entity from the query.
+// Returns a *NotFoundError when no LinkPreview was found.
+func (lpq *LinkPreviewQuery) First(ctx context.Context) (*LinkPreview, error) {
+	nodes, err := lpq.Limit(1).All(setContextOp(ctx, lpq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{linkpreview.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (lpq *LinkPreviewQuery) FirstX(ctx context.Context) *LinkPreview {
+	node, err := lpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LinkPreview ID from the query.
+// Returns a *NotFoundError when no LinkPreview ID was found.
+func (lpq *LinkPreviewQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = lpq.Limit(1).IDs(setContextOp(ctx, lpq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{linkpreview.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (lpq *LinkPreviewQuery) FirstIDX(ctx context.Context) string {
+	id, err := lpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LinkPreview entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one LinkPreview entity is found.
+// Returns a *NotFoundError when no LinkPreview entities are found.
+func (lpq *LinkPreviewQuery) Only(ctx context.Context) (*LinkPreview, error) {
+	nodes, err := lpq.Limit(2).All(setContextOp(ctx, lpq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{linkpreview.Label}
+	default:
+		return nil, &NotSingularError{linkpreview.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (lpq *LinkPreviewQuery) OnlyX(ctx context.Context) *LinkPreview {
+	node, err := lpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LinkPreview ID in the query.
+// Returns a *NotSingularError when more than one LinkPreview ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (lpq *LinkPreviewQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = lpq.Limit(2).IDs(setContextOp(ctx, lpq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{linkpreview.Label}
+	default:
+		err = &NotSingularError{linkpreview.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (lpq *LinkPreviewQuery) OnlyIDX(ctx context.Context) string {
+	id, err := lpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LinkPreviews.
+func (lpq *LinkPreviewQuery) All(ctx context.Context) ([]*LinkPreview, error) {
+	ctx = setContextOp(ctx, lpq.ctx, ent.OpQueryAll)
+	if err := lpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*LinkPreview, *LinkPreviewQuery]()
+	return withInterceptors[[]*LinkPreview](ctx, lpq, qr, lpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (lpq *LinkPreviewQuery) AllX(ctx context.Context) []*LinkPreview {
+	nodes, err := lpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LinkPreview IDs.
+func (lpq *LinkPreviewQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if lpq.ctx.Unique == nil && lpq.path != nil {
+		lpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, lpq.ctx, ent.OpQueryIDs)
+	if err = lpq.Select(linkpreview.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (lpq *LinkPreviewQuery) IDsX(ctx context.Context) []string {
+	ids, err := lpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (lpq *LinkPreviewQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, lpq.ctx, ent.OpQueryCount)
+	if err := lpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, lpq, querierCount[*LinkPreviewQuery](), lpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (lpq *LinkPreviewQuery) CountX(ctx context.Context) int {
+	count, err := lpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (lpq *LinkPreviewQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, lpq.ctx, ent.OpQueryExist)
+	switch _, err := lpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (lpq *LinkPreviewQuery) ExistX(ctx context.Context) bool {
+	exist, err := lpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LinkPreviewQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (lpq *LinkPreviewQuery) Clone() *LinkPreviewQuery {
+	if lpq == nil {
+		return nil
+	}
+	return &LinkPreviewQuery{
+		config:      lpq.config,
+		ctx:         lpq.ctx.Clone(),
+		order:       append([]linkpreview.OrderOption{}, lpq.order...),
+		inters:      append([]Interceptor{}, lpq.inters...),
+		predicates:  append([]predicate.LinkPreview{}, lpq.predicates...),
+		withMessage: lpq.withMessage.Clone(),
+		// clone intermediate query.
+		sql:  lpq.sql.Clone(),
+		path: lpq.path,
+	}
+}
+
+// WithMessage tells the query-builder to eager-load the nodes that are connected to
+// the "message" edge. The optional arguments are used to configure the query builder of the edge.
+func (lpq *LinkPreviewQuery) WithMessage(opts ...func(*MessageQuery)) *LinkPreviewQuery {
+	query := (&MessageClient{config: lpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lpq.withMessage = query
+	return lpq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LinkPreview.Query().
+//		GroupBy(linkpreview.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (lpq *LinkPreviewQuery) GroupBy(field string, fields ...string) *LinkPreviewGroupBy {
+	lpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &LinkPreviewGroupBy{build: lpq}
+	grbuild.flds = &lpq.ctx.Fields
+	grbuild.label = linkpreview.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.LinkPreview.Query().
+//		Select(linkpreview.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (lpq *LinkPreviewQuery) Select(fields ...string) *LinkPreviewSelect {
+	lpq.ctx.Fields = append(lpq.ctx.Fields, fields...)
+	sbuild := &LinkPreviewSelect{LinkPreviewQuery: lpq}
+	sbuild.label = linkpreview.Label
+	sbuild.flds, sbuild.scan = &lpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a LinkPreviewSelect configured with the given aggregations.
+func (lpq *LinkPreviewQuery) Aggregate(fns ...AggregateFunc) *LinkPreviewSelect {
+	return lpq.Select().Aggregate(fns...)
+}
+
+func (lpq *LinkPreviewQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range lpq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, lpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range lpq.ctx.Fields {
+		if !linkpreview.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if lpq.path != nil {
+		prev, err := lpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		lpq.sql = prev
+	}
+	return nil
+}
+
+func (lpq *LinkPreviewQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*LinkPreview, error) {
+	var (
+		nodes       = []*LinkPreview{}
+		_spec       = lpq.querySpec()
+		loadedTypes = [1]bool{
+			lpq.withMessage != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*LinkPreview).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &LinkPreview{config: lpq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, lpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := lpq.withMessage; query != nil {
+		if err := lpq.loadMessage(ctx, query, nodes, nil,
+			func(n *LinkPreview, e *Message) { n.Edges.Message = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (lpq *LinkPreviewQuery) loadMessage(ctx context.Context, query *MessageQuery, nodes []*LinkPreview, init func(*LinkPreview), assign func(*LinkPreview, *Message)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*LinkPreview)
+	for i := range nodes {
+		fk := nodes[i].MessageID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(message.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "message_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (lpq *LinkPreviewQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := lpq.querySpec()
+	_spec.Node.Columns = lpq.ctx.Fields
+	if len(lpq.ctx.Fields) > 0 {
+		_spec.Unique = lpq.ctx.Unique != nil && *lpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, lpq.driver, _spec)
+}
+
+func (lpq *LinkPreviewQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(linkpreview.Table, linkpreview.Columns, sqlgraph.NewFieldSpec(linkpreview.FieldID, field.TypeString))
+	_spec.From = lpq.sql
+	if unique := lpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if lpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := lpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, linkpreview.FieldID)
+		for i := range fields {
+			if fields[i] != linkpreview.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if lpq.withMessage != nil {
+			_spec.Node.AddColumnOnce(linkpreview.FieldMessageID)
+		}
+	}
+	if ps := lpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := lpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := lpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := lpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (lpq *LinkPreviewQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(lpq.driver.Dialect())
+	t1 := builder.Table(linkpreview.Table)
+	columns := lpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = linkpreview.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if lpq.sql != nil {
+		selector = lpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if lpq.ctx.Unique != nil && *lpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range lpq.predicates {
+		p(selector)
+	}
+	for _, p := range lpq.order {
+		p(selector)
+	}
+	if offset := lpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := lpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// LinkPreviewGroupBy is the group-by builder for LinkPreview entities.
+type LinkPreviewGroupBy struct {
+	selector
+	build *LinkPreviewQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (lpgb *LinkPreviewGroupBy) Aggregate(fns ...AggregateFunc) *LinkPreviewGroupBy {
+	lpgb.fns = append(lpgb.fns, fns...)
+	return lpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lpgb *LinkPreviewGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lpgb.build.ctx, ent.OpQueryGroupBy)
+	if err := lpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LinkPreviewQuery, *LinkPreviewGroupBy](ctx, lpgb.build, lpgb, lpgb.build.inters, v)
+}
+
+func (lpgb *LinkPreviewGroupBy) sqlScan(ctx context.Context, root *LinkPreviewQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(lpgb.fns))
+	for _, fn := range lpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*lpgb.flds)+len(lpgb.fns))
+		for _, f := range *lpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*lpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// LinkPreviewSelect is the builder for selecting fields of LinkPreview entities.
+type LinkPreviewSelect struct {
+	*LinkPreviewQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (lps *LinkPreviewSelect) Aggregate(fns ...AggregateFunc) *LinkPreviewSelect {
+	lps.fns = append(lps.fns, fns...)
+	return lps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lps *LinkPreviewSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lps.ctx, ent.OpQuerySelect)
+	if err := lps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LinkPreviewQuery, *LinkPreviewSelect](ctx, lps.LinkPreviewQuery, lps, lps.inters, v)
+}
+
+func (lps *LinkPreviewSelect) sqlScan(ctx context.Context, root *LinkPreviewQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(lps.fns))
+	for _, fn := range lps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*lps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,713 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/linkpreview"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LinkPreviewUpdate is the builder for updating LinkPreview entities.
+type LinkPreviewUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LinkPreviewMutation
+}
+
+// Where appends a list predicates to the LinkPreviewUpdate builder.
+func (lpu *LinkPreviewUpdate) Where(ps ...predicate.LinkPreview) *LinkPreviewUpdate {
+	lpu.mutation.Where(ps...)
+	return lpu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lpu *LinkPreviewUpdate) SetCreatedAt(t time.Time) *LinkPreviewUpdate {
+	lpu.mutation.SetCreatedAt(t)
+	return lpu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lpu *LinkPreviewUpdate) SetNillableCreatedAt(t *time.Time) *LinkPreviewUpdate {
+	if t != nil {
+		lpu.SetCreatedAt(*t)
+	}
+	return lpu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (lpu *LinkPreviewUpdate) SetUpdatedAt(t time.Time) *LinkPreviewUpdate {
+	lpu.mutation.SetUpdatedAt(t)
+	return lpu
+}
+
+// SetMessageID sets the "message_id" field.
+func (lpu *LinkPreviewUpdate) SetMessageID(s string) *LinkPreviewUpdate {
+	lpu.mutation.SetMessageID(s)
+	return lpu
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (lpu *LinkPreviewUpdate) SetNillableMessageID(s *string) *LinkPreviewUpdate {
+	if s != nil {
+		lpu.SetMessageID(*s)
+	}
+	return lpu
+}
+
+// SetURL sets the "url" field.
+func (lpu *LinkPreviewUpdate) SetURL(s string) *LinkPreviewUpdate {
+	lpu.mutation.SetURL(s)
+	return lpu
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (lpu *LinkPreviewUpdate) SetNillableURL(s *string) *LinkPreviewUpdate {
+	if s != nil {
+		lpu.SetURL(*s)
+	}
+	return lpu
+}
+
+// SetTitle sets the "title" field.
+func (lpu *LinkPreviewUpdate) SetTitle(s string) *LinkPreviewUpdate {
+	lpu.mutation.SetTitle(s)
+	return lpu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (lpu *LinkPreviewUpdate) SetNillableTitle(s *string) *LinkPreviewUpdate {
+	if s != nil {
+		lpu.SetTitle(*s)
+	}
+	return lpu
+}
+
+// ClearTitle clears the value of the "title" field.
+func (lpu *LinkPreviewUpdate) ClearTitle() *LinkPreviewUpdate {
+	lpu.mutation.ClearTitle()
+	return lpu
+}
+
+// SetDescription sets the "description" field.
+func (lpu *LinkPreviewUpdate) SetDescription(s string) *LinkPreviewUpdate {
+	lpu.mutation.SetDescription(s)
+	return lpu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (lpu *LinkPreviewUpdate) SetNillableDescription(s *string) *LinkPreviewUpdate {
+	if s != nil {
+		lpu.SetDescription(*s)
+	}
+	return lpu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (lpu *LinkPreviewUpdate) ClearDescription() *LinkPreviewUpdate {
+	lpu.mutation.ClearDescription()
+	return lpu
+}
+
+// SetImageURL sets the "image_url" field.
+func (lpu *LinkPreviewUpdate) SetImageURL(s string) *LinkPreviewUpdate {
+	lpu.mutation.SetImageURL(s)
+	return lpu
+}
+
+// SetNillableImageURL sets the "image_url" field if the given value is not nil.
+func (lpu *LinkPreviewUpdate) SetNillableImageURL(s *string) *LinkPreviewUpdate {
+	if s != nil {
+		lpu.SetImageURL(*s)
+	}
+	return lpu
+}
+
+// ClearImageURL clears the value of the "image_url" field.
+func (lpu *LinkPreviewUpdate) ClearImageURL() *LinkPreviewUpdate {
+	lpu.mutation.ClearImageURL()
+	return lpu
+}
+
+// SetSiteName sets the "site_name" field.
+func (lpu *LinkPreviewUpdate) SetSiteName(s string) *LinkPreviewUpdate {
+	lpu.mutation.SetSiteName(s)
+	return lpu
+}
+
+// SetNillableSiteName sets the "site_name" field if the given value is not nil.
+func (lpu *LinkPreviewUpdate) SetNillableSiteName(s *string) *LinkPreviewUpdate {
+	if s != nil {
+		lpu.SetSiteName(*s)
+	}
+	return lpu
+}
+
+// ClearSiteName clears the value of the "site_name" field.
+func (lpu *LinkPreviewUpdate) ClearSiteName() *LinkPreviewUpdate {
+	lpu.mutation.ClearSiteName()
+	return lpu
+}
+
+// SetPosition sets the "position" field.
+func (lpu *LinkPreviewUpdate) SetPosition(i int) *LinkPreviewUpdate {
+	lpu.mutation.ResetPosition()
+	lpu.mutation.SetPosition(i)
+	return lpu
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (lpu *LinkPreviewUpdate) SetNillablePosition(i *int) *LinkPreviewUpdate {
+	if i != nil {
+		lpu.SetPosition(*i)
+	}
+	return lpu
+}
+
+// AddPosition adds i to the "position" field.
+func (lpu *LinkPreviewUpdate) AddPosition(i int) *LinkPreviewUpdate {
+	lpu.mutation.AddPosition(i)
+	return lpu
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (lpu *LinkPreviewUpdate) SetMessage(m *Message) *LinkPreviewUpdate {
+	return lpu.SetMessageID(m.ID)
+}
+
+// Mutation returns the LinkPreviewMutation object of the builder.
+func (lpu *LinkPreviewUpdate) Mutation() *LinkPreviewMutation {
+	return lpu.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (lpu *LinkPreviewUpdate) ClearMessage() *LinkPreviewUpdate {
+	lpu.mutation.ClearMessage()
+	return lpu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lpu *LinkPreviewUpdate) Save(ctx context.Context) (int, error) {
+	lpu.defaults()
+	return withHooks(ctx, lpu.sqlSave, lpu.mutation, lpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lpu *LinkPreviewUpdate) SaveX(ctx context.Context) int {
+	affected, err := lpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lpu *LinkPreviewUpdate) Exec(ctx context.Context) error {
+	_, err := lpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lpu *LinkPreviewUpdate) ExecX(ctx context.Context) {
+	if err := lpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lpu *LinkPreviewUpdate) defaults() {
+	if _, ok := lpu.mutation.UpdatedAt(); !ok {
+		v := linkpreview.UpdateDefaultUpdatedAt()
+		lpu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lpu *LinkPreviewUpdate) check() error {
+	if v, ok := lpu.mutation.MessageID(); ok {
+		if err := linkpreview.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "LinkPreview.message_id": %w`, err)}
+		}
+	}
+	if v, ok := lpu.mutation.URL(); ok {
+		if err := linkpreview.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "LinkPreview.url": %w`, err)}
+		}
+	}
+	if v, ok := lpu.mutation.ImageURL(); ok {
+		if err := linkpreview.ImageURLValidator(v); err != nil {
+			return &ValidationError{Name: "image_url", err: fmt.Errorf(`ent: validator failed for field "LinkPreview.image_url": %w`, err)}
+		}
+	}
+	if v, ok := lpu.mutation.Position(); ok {
+		if err := linkpreview.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "LinkPreview.position": %w`, err)}
+		}
+	}
+	if lpu.mutation.MessageCleared() && len(lpu.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LinkPreview.message"`)
+	}
+	return nil
+}
+
+func (lpu *LinkPreviewUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := lpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(linkpreview.Table, linkpreview.Columns, sqlgraph.NewFieldSpec(linkpreview.FieldID, field.TypeString))
+	if ps := lpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lpu.mutation.CreatedAt(); ok {
+		_spec.SetField(linkpreview.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := lpu.mutation.UpdatedAt(); ok {
+		_spec.SetField(linkpreview.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := lpu.mutation.URL(); ok {
+		_spec.SetField(linkpreview.FieldURL, field.TypeString, value)
+	}
+	if value, ok := lpu.mutation.Title(); ok {
+		_spec.SetField(linkpreview.FieldTitle, field.TypeString, value)
+	}
+	if lpu.mutation.TitleCleared() {
+		_spec.ClearField(linkpreview.FieldTitle, field.TypeString)
+	}
+	if value, ok := lpu.mutation.Description(); ok {
+		_spec.SetField(linkpreview.FieldDescription, field.TypeString, value)
+	}
+	if lpu.mutation.DescriptionCleared() {
+		_spec.ClearField(linkpreview.FieldDescription, field.TypeString)
+	}
+	if value, ok := lpu.mutation.ImageURL(); ok {
+		_spec.SetField(linkpreview.FieldImageURL, field.TypeString, value)
+	}
+	if lpu.mutation.ImageURLCleared() {
+		_spec.ClearField(linkpreview.FieldImageURL, field.TypeString)
+	}
+	if value, ok := lpu.mutation.SiteName(); ok {
+		_spec.SetField(linkpreview.FieldSiteName, field.TypeString, value)
+	}
+	if lpu.mutation.SiteNameCleared() {
+		_spec.ClearField(linkpreview.FieldSiteName, field.TypeString)
+	}
+	if value, ok := lpu.mutation.Position(); ok {
+		_spec.SetField(linkpreview.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := lpu.mutation.AddedPosition(); ok {
+		_spec.AddField(linkpreview.FieldPosition, field.TypeInt, value)
+	}
+	if lpu.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   linkpreview.MessageTable,
+			Columns: []string{linkpreview.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpu.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   linkpreview.MessageTable,
+			Columns: []string{linkpreview.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{linkpreview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lpu.mutation.done = true
+	return n, nil
+}
+
+// LinkPreviewUpdateOne is the builder for updating a single LinkPreview entity.
+type LinkPreviewUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LinkPreviewMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lpuo *LinkPreviewUpdateOne) SetCreatedAt(t time.Time) *LinkPreviewUpdateOne {
+	lpuo.mutation.SetCreatedAt(t)
+	return lpuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lpuo *LinkPreviewUpdateOne) SetNillableCreatedAt(t *time.Time) *LinkPreviewUpdateOne {
+	if t != nil {
+		lpuo.SetCreatedAt(*t)
+	}
+	return lpuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (lpuo *LinkPreviewUpdateOne) SetUpdatedAt(t time.Time) *LinkPreviewUpdateOne {
+	lpuo.mutation.SetUpdatedAt(t)
+	return lpuo
+}
+
+// SetMessageID sets the "message_id" field.
+func (lpuo *LinkPreviewUpdateOne) SetMessageID(s string) *LinkPreviewUpdateOne {
+	lpuo.mutation.SetMessageID(s)
+	return lpuo
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (lpuo *LinkPreviewUpdateOne) SetNillableMessageID(s *string) *LinkPreviewUpdateOne {
+	if s != nil {
+		lpuo.SetMessageID(*s)
+	}
+	return lpuo
+}
+
+// SetURL sets the "url" field.
+func (lpuo *LinkPreviewUpdateOne) SetURL(s string) *LinkPreviewUpdateOne {
+	lpuo.mutation.SetURL(s)
+	return lpuo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (lpuo *LinkPreviewUpdateOne) SetNillableURL(s *string) *LinkPreviewUpdateOne {
+	if s != nil {
+		lpuo.SetURL(*s)
+	}
+	return lpuo
+}
+
+// SetTitle sets the "title" field.
+func (lpuo *LinkPreviewUpdateOne) SetTitle(s string) *LinkPreviewUpdateOne {
+	lpuo.mutation.SetTitle(s)
+	return lpuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (lpuo *LinkPreviewUpdateOne) SetNillableTitle(s *string) *LinkPreviewUpdateOne {
+	if s != nil {
+		lpuo.SetTitle(*s)
+	}
+	return lpuo
+}
+
+// ClearTitle clears the value of the "title" field.
+func (lpuo *LinkPreviewUpdateOne) ClearTitle() *LinkPreviewUpdateOne {
+	lpuo.mutation.ClearTitle()
+	return lpuo
+}
+
+// SetDescription sets the "description" field.
+func (lpuo *LinkPreviewUpdateOne) SetDescription(s string) *LinkPreviewUpdateOne {
+	lpuo.mutation.SetDescription(s)
+	return lpuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (lpuo *LinkPreviewUpdateOne) SetNillableDescription(s *string) *LinkPreviewUpdateOne {
+	if s != nil {
+		lpuo.SetDescription(*s)
+	}
+	return lpuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (lpuo *LinkPreviewUpdateOne) ClearDescription() *LinkPreviewUpdateOne {
+	lpuo.mutation.ClearDescription()
+	return lpuo
+}
+
+// SetImageURL sets the "image_url" field.
+func (lpuo *LinkPreviewUpdateOne) SetImageURL(s string) *LinkPreviewUpdateOne {
+	lpuo.mutation.SetImageURL(s)
+	return lpuo
+}
+
+// SetNillableImageURL sets the "image_url" field if the given value is not nil.
+func (lpuo *LinkPreviewUpdateOne) SetNillableImageURL(s *string) *LinkPreviewUpdateOne {
+	if s != nil {
+		lpuo.SetImageURL(*s)
+	}
+	return lpuo
+}
+
+// ClearImageURL clears the value of the "image_url" field.
+func (lpuo *LinkPreviewUpdateOne) ClearImageURL() *LinkPreviewUpdateOne {
+	lpuo.mutation.ClearImageURL()
+	return lpuo
+}
+
+// SetSiteName sets the "site_name" field.
+func (lpuo *LinkPreviewUpdateOne) SetSiteName(s string) *LinkPreviewUpdateOne {
+	lpuo.mutation.SetSiteName(s)
+	return lpuo
+}
+
+// SetNillableSiteName sets the "site_name" field if the given value is not nil.
+func (lpuo *LinkPreviewUpdateOne) SetNillableSiteName(s *string) *LinkPreviewUpdateOne {
+	if s != nil {
+		lpuo.SetSiteName(*s)
+	}
+	return lpuo
+}
+
+// ClearSiteName clears the value of the "site_name" field.
+func (lpuo *LinkPreviewUpdateOne) ClearSiteName() *LinkPreviewUpdateOne {
+	lpuo.mutation.ClearSiteName()
+	return lpuo
+}
+
+// SetPosition sets the "position" field.
+func (lpuo *LinkPreviewUpdateOne) SetPosition(i int) *LinkPreviewUpdateOne {
+	lpuo.mutation.ResetPosition()
+	lpuo.mutation.SetPosition(i)
+	return lpuo
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (lpuo *LinkPreviewUpdateOne) SetNillablePosition(i *int) *LinkPreviewUpdateOne {
+	if i != nil {
+		lpuo.SetPosition(*i)
+	}
+	return lpuo
+}
+
+// AddPosition adds i to the "position" field.
+func (lpuo *LinkPreviewUpdateOne) AddPosition(i int) *LinkPreviewUpdateOne {
+	lpuo.mutation.AddPosition(i)
+	return lpuo
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (lpuo *LinkPreviewUpdateOne) SetMessage(m *Message) *LinkPreviewUpdateOne {
+	return lpuo.SetMessageID(m.ID)
+}
+
+// Mutation returns the LinkPreviewMutation object of the builder.
+func (lpuo *LinkPreviewUpdateOne) Mutation() *LinkPreviewMutation {
+	return lpuo.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (lpuo *LinkPreviewUpdateOne) ClearMessage() *LinkPreviewUpdateOne {
+	lpuo.mutation.ClearMessage()
+	return lpuo
+}
+
+// Where appends a list predicates to the LinkPreviewUpdate builder.
+func (lpuo *LinkPreviewUpdateOne) Where(ps ...predicate.LinkPreview) *LinkPreviewUpdateOne {
+	lpuo.mutation.Where(ps...)
+	return lpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (lpuo *LinkPreviewUpdateOne) Select(field string, fields ...string) *LinkPreviewUpdateOne {
+	lpuo.fields = append([]string{field}, fields...)
+	return lpuo
+}
+
+// Save executes the query and returns the updated LinkPreview entity.
+func (lpuo *LinkPreviewUpdateOne) Save(ctx context.Context) (*LinkPreview, error) {
+	lpuo.defaults()
+	return withHooks(ctx, lpuo.sqlSave, lpuo.mutation, lpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lpuo *LinkPreviewUpdateOne) SaveX(ctx context.Context) *LinkPreview {
+	node, err := lpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lpuo *LinkPreviewUpdateOne) Exec(ctx context.Context) error {
+	_, err := lpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lpuo *LinkPreviewUpdateOne) ExecX(ctx context.Context) {
+	if err := lpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lpuo *LinkPreviewUpdateOne) defaults() {
+	if _, ok := lpuo.mutation.UpdatedAt(); !ok {
+		v := linkpreview.UpdateDefaultUpdatedAt()
+		lpuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lpuo *LinkPreviewUpdateOne) check() error {
+	if v, ok := lpuo.mutation.MessageID(); ok {
+		if err := linkpreview.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "LinkPreview.message_id": %w`, err)}
+		}
+	}
+	if v, ok := lpuo.mutation.URL(); ok {
+		if err := linkpreview.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "LinkPreview.url": %w`, err)}
+		}
+	}
+	if v, ok := lpuo.mutation.ImageURL(); ok {
+		if err := linkpreview.ImageURLValidator(v); err != nil {
+			return &ValidationError{Name: "image_url", err: fmt.Errorf(`ent: validator failed for field "LinkPreview.image_url": %w`, err)}
+		}
+	}
+	if v, ok := lpuo.mutation.Position(); ok {
+		if err := linkpreview.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "LinkPreview.position": %w`, err)}
+		}
+	}
+	if lpuo.mutation.MessageCleared() && len(lpuo.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LinkPreview.message"`)
+	}
+	return nil
+}
+
+func (lpuo *LinkPreviewUpdateOne) sqlSave(ctx context.Context) (_node *LinkPreview, err error) {
+	if err := lpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(linkpreview.Table, linkpreview.Columns, sqlgraph.NewFieldSpec(linkpreview.FieldID, field.TypeString))
+	id, ok := lpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LinkPreview.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := lpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, linkpreview.FieldID)
+		for _, f := range fields {
+			if !linkpreview.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != linkpreview.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := lpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lpuo.mutation.CreatedAt(); ok {
+		_spec.SetField(linkpreview.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := lpuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(linkpreview.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := lpuo.mutation.URL(); ok {
+		_spec.SetField(linkpreview.FieldURL, field.TypeString, value)
+	}
+	if value, ok := lpuo.mutation.Title(); ok {
+		_spec.SetField(linkpreview.FieldTitle, field.TypeString, value)
+	}
+	if lpuo.mutation.TitleCleared() {
+		_spec.ClearField(linkpreview.FieldTitle, field.TypeString)
+	}
+	if value, ok := lpuo.mutation.Description(); ok {
+		_spec.SetField(linkpreview.FieldDescription, field.TypeString, value)
+	}
+	if lpuo.mutation.DescriptionCleared() {
+		_spec.ClearField(linkpreview.FieldDescription, field.TypeString)
+	}
+	if value, ok := lpuo.mutation.ImageURL(); ok {
+		_spec.SetField(linkpreview.FieldImageURL, field.TypeString, value)
+	}
+	if lpuo.mutation.ImageURLCleared() {
+		_spec.ClearField(linkpreview.FieldImageURL, field.TypeString)
+	}
+	if value, ok := lpuo.mutation.SiteName(); ok {
+		_spec.SetField(linkpreview.FieldSiteName, field.TypeString, value)
+	}
+	if lpuo.mutation.SiteNameCleared() {
+		_spec.ClearField(linkpreview.FieldSiteName, field.TypeString)
+	}
+	if value, ok := lpuo.mutation.Position(); ok {
+		_spec.SetField(linkpreview.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := lpuo.mutation.AddedPosition(); ok {
+		_spec.AddField(linkpreview.FieldPosition, field.TypeInt, value)
+	}
+	if lpuo.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   linkpreview.MessageTable,
+			Columns: []string{linkpreview.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpuo.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   linkpreview.MessageTable,
+			Columns: []string{linkpreview.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LinkPreview{config: lpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{linkpreview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	lpuo.mutation.done = true
+	return _node, nil
+}
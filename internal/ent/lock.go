@@ -0,0 +1,39 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"kakashi/chaos/internal/ent/conversation"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// LockConversation locks the conversation row until the transaction ends, so checks on its participants
+// made afterwards hold until the transaction commits. SQLite serializes writers on its own and takes no lock
+func (tx *Tx) LockConversation(ctx context.Context, id string) error {
+	if tx.driver.Dialect() == dialect.SQLite {
+		return nil
+	}
+
+	query, args := sql.Dialect(tx.driver.Dialect()).
+		Select(conversation.FieldID).
+		From(sql.Table(conversation.Table)).
+		Where(sql.EQ(conversation.FieldID, id)).
+		ForUpdate().
+		Query()
+	rows := &sql.Rows{}
+	if err := tx.driver.Query(ctx, query, args, rows); err != nil {
+		return fmt.Errorf("failed to lock conversation: %w", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("failed to lock conversation: %w", err)
+		}
+		return &NotFoundError{conversation.Label}
+	}
+	return nil
+}
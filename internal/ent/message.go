@@ -3,11 +3,13 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"kakashi/chaos/internal/ent/call"
 	"kakashi/chaos/internal/ent/conversation"
 	"kakashi/chaos/internal/ent/message"
 	"kakashi/chaos/internal/ent/user"
+	"kakashi/chaos/internal/richtext"
 	"strings"
 	"time"
 
@@ -30,14 +32,36 @@ type Message struct {
 	SenderID string `json:"sender_id,omitempty"`
 	// Content holds the value of the "content" field.
 	Content string `json:"content,omitempty"`
+	// Content without markup, unset on messages that were not parsed
+	PlainText string `json:"plain_text,omitempty"`
+	// Formatted ranges of plain_text in UTF-16 code units
+	Entities []richtext.Entity `json:"entities,omitempty"`
 	// MessageType holds the value of the "message_type" field.
 	MessageType message.MessageType `json:"message_type,omitempty"`
 	// IsDeleted holds the value of the "is_deleted" field.
 	IsDeleted bool `json:"is_deleted,omitempty"`
+	// When the message was soft deleted, used by the retention reaper
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
 	// EditedAt holds the value of the "edited_at" field.
 	EditedAt time.Time `json:"edited_at,omitempty"`
 	// Reference to call for call_start and call_end messages
 	CallID string `json:"call_id,omitempty"`
+	// Message quoted by this reply
+	ReplyToID string `json:"reply_to_id,omitempty"`
+	// Root message of the thread this message belongs to
+	ThreadRootID string `json:"thread_root_id,omitempty"`
+	// Number of thread replies on a root message
+	ThreadReplyCount int `json:"thread_reply_count,omitempty"`
+	// ThreadLastReplyAt holds the value of the "thread_last_reply_at" field.
+	ThreadLastReplyAt time.Time `json:"thread_last_reply_at,omitempty"`
+	// Set by the sender to hide link previews
+	SuppressEmbeds bool `json:"suppress_embeds,omitempty"`
+	// Original message of a forward, may no longer exist
+	ForwardedFromMessageID string `json:"forwarded_from_message_id,omitempty"`
+	// Author of the original message of a forward
+	ForwardedFromUserID string `json:"forwarded_from_user_id,omitempty"`
+	// Client generated idempotency key, cleared once the dedupe window has passed
+	Nonce string `json:"nonce,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the MessageQuery when eager-loading is set.
 	Edges                 MessageEdges `json:"edges"`
@@ -53,9 +77,41 @@ type MessageEdges struct {
 	Sender *User `json:"sender,omitempty"`
 	// Call holds the value of the call edge.
 	Call *Call `json:"call,omitempty"`
+	// ForwardedFromUser holds the value of the forwarded_from_user edge.
+	ForwardedFromUser *User `json:"forwarded_from_user,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*MessageRevision `json:"revisions,omitempty"`
+	// Reactions holds the value of the reactions edge.
+	Reactions []*MessageReaction `json:"reactions,omitempty"`
+	// Attachments holds the value of the attachments edge.
+	Attachments []*Attachment `json:"attachments,omitempty"`
+	// LinkPreviews holds the value of the link_previews edge.
+	LinkPreviews []*LinkPreview `json:"link_previews,omitempty"`
+	// Mentions holds the value of the mentions edge.
+	Mentions []*MessageMention `json:"mentions,omitempty"`
+	// Pins holds the value of the pins edge.
+	Pins []*PinnedMessage `json:"pins,omitempty"`
+	// Receipts holds the value of the receipts edge.
+	Receipts []*MessageReceipt `json:"receipts,omitempty"`
+	// Notifications holds the value of the notifications edge.
+	Notifications []*Notification `json:"notifications,omitempty"`
+	// ReplyTo holds the value of the reply_to edge.
+	ReplyTo *Message `json:"reply_to,omitempty"`
+	// Replies holds the value of the replies edge.
+	Replies []*Message `json:"replies,omitempty"`
+	// ThreadRoot holds the value of the thread_root edge.
+	ThreadRoot *Message `json:"thread_root,omitempty"`
+	// ThreadReplies holds the value of the thread_replies edge.
+	ThreadReplies []*Message `json:"thread_replies,omitempty"`
+	// ThreadParticipants holds the value of the thread_participants edge.
+	ThreadParticipants []*ThreadParticipant `json:"thread_participants,omitempty"`
+	// Bookmarks holds the value of the bookmarks edge.
+	Bookmarks []*Bookmark `json:"bookmarks,omitempty"`
+	// HiddenFor holds the value of the hidden_for edge.
+	HiddenFor []*HiddenMessage `json:"hidden_for,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [19]bool
 }
 
 // ConversationOrErr returns the Conversation value or an error if the edge
@@ -91,16 +147,170 @@ func (e MessageEdges) CallOrErr() (*Call, error) {
 	return nil, &NotLoadedError{edge: "call"}
 }
 
+// ForwardedFromUserOrErr returns the ForwardedFromUser value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageEdges) ForwardedFromUserOrErr() (*User, error) {
+	if e.ForwardedFromUser != nil {
+		return e.ForwardedFromUser, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "forwarded_from_user"}
+}
+
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) RevisionsOrErr() ([]*MessageRevision, error) {
+	if e.loadedTypes[4] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// ReactionsOrErr returns the Reactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) ReactionsOrErr() ([]*MessageReaction, error) {
+	if e.loadedTypes[5] {
+		return e.Reactions, nil
+	}
+	return nil, &NotLoadedError{edge: "reactions"}
+}
+
+// AttachmentsOrErr returns the Attachments value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) AttachmentsOrErr() ([]*Attachment, error) {
+	if e.loadedTypes[6] {
+		return e.Attachments, nil
+	}
+	return nil, &NotLoadedError{edge: "attachments"}
+}
+
+// LinkPreviewsOrErr returns the LinkPreviews value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) LinkPreviewsOrErr() ([]*LinkPreview, error) {
+	if e.loadedTypes[7] {
+		return e.LinkPreviews, nil
+	}
+	return nil, &NotLoadedError{edge: "link_previews"}
+}
+
+// MentionsOrErr returns the Mentions value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) MentionsOrErr() ([]*MessageMention, error) {
+	if e.loadedTypes[8] {
+		return e.Mentions, nil
+	}
+	return nil, &NotLoadedError{edge: "mentions"}
+}
+
+// PinsOrErr returns the Pins value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) PinsOrErr() ([]*PinnedMessage, error) {
+	if e.loadedTypes[9] {
+		return e.Pins, nil
+	}
+	return nil, &NotLoadedError{edge: "pins"}
+}
+
+// ReceiptsOrErr returns the Receipts value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) ReceiptsOrErr() ([]*MessageReceipt, error) {
+	if e.loadedTypes[10] {
+		return e.Receipts, nil
+	}
+	return nil, &NotLoadedError{edge: "receipts"}
+}
+
+// NotificationsOrErr returns the Notifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) NotificationsOrErr() ([]*Notification, error) {
+	if e.loadedTypes[11] {
+		return e.Notifications, nil
+	}
+	return nil, &NotLoadedError{edge: "notifications"}
+}
+
+// ReplyToOrErr returns the ReplyTo value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageEdges) ReplyToOrErr() (*Message, error) {
+	if e.ReplyTo != nil {
+		return e.ReplyTo, nil
+	} else if e.loadedTypes[12] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "reply_to"}
+}
+
+// RepliesOrErr returns the Replies value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) RepliesOrErr() ([]*Message, error) {
+	if e.loadedTypes[13] {
+		return e.Replies, nil
+	}
+	return nil, &NotLoadedError{edge: "replies"}
+}
+
+// ThreadRootOrErr returns the ThreadRoot value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageEdges) ThreadRootOrErr() (*Message, error) {
+	if e.ThreadRoot != nil {
+		return e.ThreadRoot, nil
+	} else if e.loadedTypes[14] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "thread_root"}
+}
+
+// ThreadRepliesOrErr returns the ThreadReplies value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) ThreadRepliesOrErr() ([]*Message, error) {
+	if e.loadedTypes[15] {
+		return e.ThreadReplies, nil
+	}
+	return nil, &NotLoadedError{edge: "thread_replies"}
+}
+
+// ThreadParticipantsOrErr returns the ThreadParticipants value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) ThreadParticipantsOrErr() ([]*ThreadParticipant, error) {
+	if e.loadedTypes[16] {
+		return e.ThreadParticipants, nil
+	}
+	return nil, &NotLoadedError{edge: "thread_participants"}
+}
+
+// BookmarksOrErr returns the Bookmarks value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) BookmarksOrErr() ([]*Bookmark, error) {
+	if e.loadedTypes[17] {
+		return e.Bookmarks, nil
+	}
+	return nil, &NotLoadedError{edge: "bookmarks"}
+}
+
+// HiddenForOrErr returns the HiddenFor value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) HiddenForOrErr() ([]*HiddenMessage, error) {
+	if e.loadedTypes[18] {
+		return e.HiddenFor, nil
+	}
+	return nil, &NotLoadedError{edge: "hidden_for"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Message) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case message.FieldIsDeleted:
+		case message.FieldEntities:
+			values[i] = new([]byte)
+		case message.FieldIsDeleted, message.FieldSuppressEmbeds:
 			values[i] = new(sql.NullBool)
-		case message.FieldID, message.FieldConversationID, message.FieldSenderID, message.FieldContent, message.FieldMessageType, message.FieldCallID:
+		case message.FieldThreadReplyCount:
+			values[i] = new(sql.NullInt64)
+		case message.FieldID, message.FieldConversationID, message.FieldSenderID, message.FieldContent, message.FieldPlainText, message.FieldMessageType, message.FieldCallID, message.FieldReplyToID, message.FieldThreadRootID, message.FieldForwardedFromMessageID, message.FieldForwardedFromUserID, message.FieldNonce:
 			values[i] = new(sql.NullString)
-		case message.FieldCreatedAt, message.FieldUpdatedAt, message.FieldEditedAt:
+		case message.FieldCreatedAt, message.FieldUpdatedAt, message.FieldDeletedAt, message.FieldEditedAt, message.FieldThreadLastReplyAt:
 			values[i] = new(sql.NullTime)
 		case message.ForeignKeys[0]: // conversation_messages
 			values[i] = new(sql.NullString)
@@ -155,6 +365,20 @@ func (m *Message) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				m.Content = value.String
 			}
+		case message.FieldPlainText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field plain_text", values[i])
+			} else if value.Valid {
+				m.PlainText = value.String
+			}
+		case message.FieldEntities:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field entities", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &m.Entities); err != nil {
+					return fmt.Errorf("unmarshal field entities: %w", err)
+				}
+			}
 		case message.FieldMessageType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field message_type", values[i])
@@ -167,6 +391,12 @@ func (m *Message) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				m.IsDeleted = value.Bool
 			}
+		case message.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				m.DeletedAt = value.Time
+			}
 		case message.FieldEditedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field edited_at", values[i])
@@ -179,6 +409,54 @@ func (m *Message) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				m.CallID = value.String
 			}
+		case message.FieldReplyToID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reply_to_id", values[i])
+			} else if value.Valid {
+				m.ReplyToID = value.String
+			}
+		case message.FieldThreadRootID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field thread_root_id", values[i])
+			} else if value.Valid {
+				m.ThreadRootID = value.String
+			}
+		case message.FieldThreadReplyCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field thread_reply_count", values[i])
+			} else if value.Valid {
+				m.ThreadReplyCount = int(value.Int64)
+			}
+		case message.FieldThreadLastReplyAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field thread_last_reply_at", values[i])
+			} else if value.Valid {
+				m.ThreadLastReplyAt = value.Time
+			}
+		case message.FieldSuppressEmbeds:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field suppress_embeds", values[i])
+			} else if value.Valid {
+				m.SuppressEmbeds = value.Bool
+			}
+		case message.FieldForwardedFromMessageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field forwarded_from_message_id", values[i])
+			} else if value.Valid {
+				m.ForwardedFromMessageID = value.String
+			}
+		case message.FieldForwardedFromUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field forwarded_from_user_id", values[i])
+			} else if value.Valid {
+				m.ForwardedFromUserID = value.String
+			}
+		case message.FieldNonce:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nonce", values[i])
+			} else if value.Valid {
+				m.Nonce = value.String
+			}
 		case message.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field conversation_messages", values[i])
@@ -214,6 +492,86 @@ func (m *Message) QueryCall() *CallQuery {
 	return NewMessageClient(m.config).QueryCall(m)
 }
 
+// QueryForwardedFromUser queries the "forwarded_from_user" edge of the Message entity.
+func (m *Message) QueryForwardedFromUser() *UserQuery {
+	return NewMessageClient(m.config).QueryForwardedFromUser(m)
+}
+
+// QueryRevisions queries the "revisions" edge of the Message entity.
+func (m *Message) QueryRevisions() *MessageRevisionQuery {
+	return NewMessageClient(m.config).QueryRevisions(m)
+}
+
+// QueryReactions queries the "reactions" edge of the Message entity.
+func (m *Message) QueryReactions() *MessageReactionQuery {
+	return NewMessageClient(m.config).QueryReactions(m)
+}
+
+// QueryAttachments queries the "attachments" edge of the Message entity.
+func (m *Message) QueryAttachments() *AttachmentQuery {
+	return NewMessageClient(m.config).QueryAttachments(m)
+}
+
+// QueryLinkPreviews queries the "link_previews" edge of the Message entity.
+func (m *Message) QueryLinkPreviews() *LinkPreviewQuery {
+	return NewMessageClient(m.config).QueryLinkPreviews(m)
+}
+
+// QueryMentions queries the "mentions" edge of the Message entity.
+func (m *Message) QueryMentions() *MessageMentionQuery {
+	return NewMessageClient(m.config).QueryMentions(m)
+}
+
+// QueryPins queries the "pins" edge of the Message entity.
+func (m *Message) QueryPins() *PinnedMessageQuery {
+	return NewMessageClient(m.config).QueryPins(m)
+}
+
+// QueryReceipts queries the "receipts" edge of the Message entity.
+func (m *Message) QueryReceipts() *MessageReceiptQuery {
+	return NewMessageClient(m.config).QueryReceipts(m)
+}
+
+// QueryNotifications queries the "notifications" edge of the Message entity.
+func (m *Message) QueryNotifications() *NotificationQuery {
+	return NewMessageClient(m.config).QueryNotifications(m)
+}
+
+// QueryReplyTo queries the "reply_to" edge of the Message entity.
+func (m *Message) QueryReplyTo() *MessageQuery {
+	return NewMessageClient(m.config).QueryReplyTo(m)
+}
+
+// QueryReplies queries the "replies" edge of the Message entity.
+func (m *Message) QueryReplies() *MessageQuery {
+	return NewMessageClient(m.config).QueryReplies(m)
+}
+
+// QueryThreadRoot queries the "thread_root" edge of the Message entity.
+func (m *Message) QueryThreadRoot() *MessageQuery {
+	return NewMessageClient(m.config).QueryThreadRoot(m)
+}
+
+// QueryThreadReplies queries the "thread_replies" edge of the Message entity.
+func (m *Message) QueryThreadReplies() *MessageQuery {
+	return NewMessageClient(m.config).QueryThreadReplies(m)
+}
+
+// QueryThreadParticipants queries the "thread_participants" edge of the Message entity.
+func (m *Message) QueryThreadParticipants() *ThreadParticipantQuery {
+	return NewMessageClient(m.config).QueryThreadParticipants(m)
+}
+
+// QueryBookmarks queries the "bookmarks" edge of the Message entity.
+func (m *Message) QueryBookmarks() *BookmarkQuery {
+	return NewMessageClient(m.config).QueryBookmarks(m)
+}
+
+// QueryHiddenFor queries the "hidden_for" edge of the Message entity.
+func (m *Message) QueryHiddenFor() *HiddenMessageQuery {
+	return NewMessageClient(m.config).QueryHiddenFor(m)
+}
+
 // Update returns a builder for updating this Message.
 // Note that you need to call Message.Unwrap() before calling this method if this Message
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -252,17 +610,50 @@ func (m *Message) String() string {
 	builder.WriteString("content=")
 	builder.WriteString(m.Content)
 	builder.WriteString(", ")
+	builder.WriteString("plain_text=")
+	builder.WriteString(m.PlainText)
+	builder.WriteString(", ")
+	builder.WriteString("entities=")
+	builder.WriteString(fmt.Sprintf("%v", m.Entities))
+	builder.WriteString(", ")
 	builder.WriteString("message_type=")
 	builder.WriteString(fmt.Sprintf("%v", m.MessageType))
 	builder.WriteString(", ")
 	builder.WriteString("is_deleted=")
 	builder.WriteString(fmt.Sprintf("%v", m.IsDeleted))
 	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(m.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("edited_at=")
 	builder.WriteString(m.EditedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("call_id=")
 	builder.WriteString(m.CallID)
+	builder.WriteString(", ")
+	builder.WriteString("reply_to_id=")
+	builder.WriteString(m.ReplyToID)
+	builder.WriteString(", ")
+	builder.WriteString("thread_root_id=")
+	builder.WriteString(m.ThreadRootID)
+	builder.WriteString(", ")
+	builder.WriteString("thread_reply_count=")
+	builder.WriteString(fmt.Sprintf("%v", m.ThreadReplyCount))
+	builder.WriteString(", ")
+	builder.WriteString("thread_last_reply_at=")
+	builder.WriteString(m.ThreadLastReplyAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("suppress_embeds=")
+	builder.WriteString(fmt.Sprintf("%v", m.SuppressEmbeds))
+	builder.WriteString(", ")
+	builder.WriteString("forwarded_from_message_id=")
+	builder.WriteString(m.ForwardedFromMessageID)
+	builder.WriteString(", ")
+	builder.WriteString("forwarded_from_user_id=")
+	builder.WriteString(m.ForwardedFromUserID)
+	builder.WriteString(", ")
+	builder.WriteString("nonce=")
+	builder.WriteString(m.Nonce)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -25,20 +25,74 @@ const (
 	FieldSenderID = "sender_id"
 	// FieldContent holds the string denoting the content field in the database.
 	FieldContent = "content"
+	// FieldPlainText holds the string denoting the plain_text field in the database.
+	FieldPlainText = "plain_text"
+	// FieldEntities holds the string denoting the entities field in the database.
+	FieldEntities = "entities"
 	// FieldMessageType holds the string denoting the message_type field in the database.
 	FieldMessageType = "message_type"
 	// FieldIsDeleted holds the string denoting the is_deleted field in the database.
 	FieldIsDeleted = "is_deleted"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldEditedAt holds the string denoting the edited_at field in the database.
 	FieldEditedAt = "edited_at"
 	// FieldCallID holds the string denoting the call_id field in the database.
 	FieldCallID = "call_id"
+	// FieldReplyToID holds the string denoting the reply_to_id field in the database.
+	FieldReplyToID = "reply_to_id"
+	// FieldThreadRootID holds the string denoting the thread_root_id field in the database.
+	FieldThreadRootID = "thread_root_id"
+	// FieldThreadReplyCount holds the string denoting the thread_reply_count field in the database.
+	FieldThreadReplyCount = "thread_reply_count"
+	// FieldThreadLastReplyAt holds the string denoting the thread_last_reply_at field in the database.
+	FieldThreadLastReplyAt = "thread_last_reply_at"
+	// FieldSuppressEmbeds holds the string denoting the suppress_embeds field in the database.
+	FieldSuppressEmbeds = "suppress_embeds"
+	// FieldForwardedFromMessageID holds the string denoting the forwarded_from_message_id field in the database.
+	FieldForwardedFromMessageID = "forwarded_from_message_id"
+	// FieldForwardedFromUserID holds the string denoting the forwarded_from_user_id field in the database.
+	FieldForwardedFromUserID = "forwarded_from_user_id"
+	// FieldNonce holds the string denoting the nonce field in the database.
+	FieldNonce = "nonce"
 	// EdgeConversation holds the string denoting the conversation edge name in mutations.
 	EdgeConversation = "conversation"
 	// EdgeSender holds the string denoting the sender edge name in mutations.
 	EdgeSender = "sender"
 	// EdgeCall holds the string denoting the call edge name in mutations.
 	EdgeCall = "call"
+	// EdgeForwardedFromUser holds the string denoting the forwarded_from_user edge name in mutations.
+	EdgeForwardedFromUser = "forwarded_from_user"
+	// EdgeRevisions holds the string denoting the revisions edge name in mutations.
+	EdgeRevisions = "revisions"
+	// EdgeReactions holds the string denoting the reactions edge name in mutations.
+	EdgeReactions = "reactions"
+	// EdgeAttachments holds the string denoting the attachments edge name in mutations.
+	EdgeAttachments = "attachments"
+	// EdgeLinkPreviews holds the string denoting the link_previews edge name in mutations.
+	EdgeLinkPreviews = "link_previews"
+	// EdgeMentions holds the string denoting the mentions edge name in mutations.
+	EdgeMentions = "mentions"
+	// EdgePins holds the string denoting the pins edge name in mutations.
+	EdgePins = "pins"
+	// EdgeReceipts holds the string denoting the receipts edge name in mutations.
+	EdgeReceipts = "receipts"
+	// EdgeNotifications holds the string denoting the notifications edge name in mutations.
+	EdgeNotifications = "notifications"
+	// EdgeReplyTo holds the string denoting the reply_to edge name in mutations.
+	EdgeReplyTo = "reply_to"
+	// EdgeReplies holds the string denoting the replies edge name in mutations.
+	EdgeReplies = "replies"
+	// EdgeThreadRoot holds the string denoting the thread_root edge name in mutations.
+	EdgeThreadRoot = "thread_root"
+	// EdgeThreadReplies holds the string denoting the thread_replies edge name in mutations.
+	EdgeThreadReplies = "thread_replies"
+	// EdgeThreadParticipants holds the string denoting the thread_participants edge name in mutations.
+	EdgeThreadParticipants = "thread_participants"
+	// EdgeBookmarks holds the string denoting the bookmarks edge name in mutations.
+	EdgeBookmarks = "bookmarks"
+	// EdgeHiddenFor holds the string denoting the hidden_for edge name in mutations.
+	EdgeHiddenFor = "hidden_for"
 	// Table holds the table name of the message in the database.
 	Table = "messages"
 	// ConversationTable is the table that holds the conversation relation/edge.
@@ -62,6 +116,106 @@ const (
 	CallInverseTable = "calls"
 	// CallColumn is the table column denoting the call relation/edge.
 	CallColumn = "call_id"
+	// ForwardedFromUserTable is the table that holds the forwarded_from_user relation/edge.
+	ForwardedFromUserTable = "messages"
+	// ForwardedFromUserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	ForwardedFromUserInverseTable = "users"
+	// ForwardedFromUserColumn is the table column denoting the forwarded_from_user relation/edge.
+	ForwardedFromUserColumn = "forwarded_from_user_id"
+	// RevisionsTable is the table that holds the revisions relation/edge.
+	RevisionsTable = "message_revisions"
+	// RevisionsInverseTable is the table name for the MessageRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "messagerevision" package.
+	RevisionsInverseTable = "message_revisions"
+	// RevisionsColumn is the table column denoting the revisions relation/edge.
+	RevisionsColumn = "message_id"
+	// ReactionsTable is the table that holds the reactions relation/edge.
+	ReactionsTable = "message_reactions"
+	// ReactionsInverseTable is the table name for the MessageReaction entity.
+	// It exists in this package in order to avoid circular dependency with the "messagereaction" package.
+	ReactionsInverseTable = "message_reactions"
+	// ReactionsColumn is the table column denoting the reactions relation/edge.
+	ReactionsColumn = "message_id"
+	// AttachmentsTable is the table that holds the attachments relation/edge.
+	AttachmentsTable = "attachments"
+	// AttachmentsInverseTable is the table name for the Attachment entity.
+	// It exists in this package in order to avoid circular dependency with the "attachment" package.
+	AttachmentsInverseTable = "attachments"
+	// AttachmentsColumn is the table column denoting the attachments relation/edge.
+	AttachmentsColumn = "message_id"
+	// LinkPreviewsTable is the table that holds the link_previews relation/edge.
+	LinkPreviewsTable = "link_previews"
+	// LinkPreviewsInverseTable is the table name for the LinkPreview entity.
+	// It exists in this package in order to avoid circular dependency with the "linkpreview" package.
+	LinkPreviewsInverseTable = "link_previews"
+	// LinkPreviewsColumn is the table column denoting the link_previews relation/edge.
+	LinkPreviewsColumn = "message_id"
+	// MentionsTable is the table that holds the mentions relation/edge.
+	MentionsTable = "message_mentions"
+	// MentionsInverseTable is the table name for the MessageMention entity.
+	// It exists in this package in order to avoid circular dependency with the "messagemention" package.
+	MentionsInverseTable = "message_mentions"
+	// MentionsColumn is the table column denoting the mentions relation/edge.
+	MentionsColumn = "message_id"
+	// PinsTable is the table that holds the pins relation/edge.
+	PinsTable = "pinned_messages"
+	// PinsInverseTable is the table name for the PinnedMessage entity.
+	// It exists in this package in order to avoid circular dependency with the "pinnedmessage" package.
+	PinsInverseTable = "pinned_messages"
+	// PinsColumn is the table column denoting the pins relation/edge.
+	PinsColumn = "message_id"
+	// ReceiptsTable is the table that holds the receipts relation/edge.
+	ReceiptsTable = "message_receipts"
+	// ReceiptsInverseTable is the table name for the MessageReceipt entity.
+	// It exists in this package in order to avoid circular dependency with the "messagereceipt" package.
+	ReceiptsInverseTable = "message_receipts"
+	// ReceiptsColumn is the table column denoting the receipts relation/edge.
+	ReceiptsColumn = "message_id"
+	// NotificationsTable is the table that holds the notifications relation/edge.
+	NotificationsTable = "notifications"
+	// NotificationsInverseTable is the table name for the Notification entity.
+	// It exists in this package in order to avoid circular dependency with the "notification" package.
+	NotificationsInverseTable = "notifications"
+	// NotificationsColumn is the table column denoting the notifications relation/edge.
+	NotificationsColumn = "related_message_id"
+	// ReplyToTable is the table that holds the reply_to relation/edge.
+	ReplyToTable = "messages"
+	// ReplyToColumn is the table column denoting the reply_to relation/edge.
+	ReplyToColumn = "reply_to_id"
+	// RepliesTable is the table that holds the replies relation/edge.
+	RepliesTable = "messages"
+	// RepliesColumn is the table column denoting the replies relation/edge.
+	RepliesColumn = "reply_to_id"
+	// ThreadRootTable is the table that holds the thread_root relation/edge.
+	ThreadRootTable = "messages"
+	// ThreadRootColumn is the table column denoting the thread_root relation/edge.
+	ThreadRootColumn = "thread_root_id"
+	// ThreadRepliesTable is the table that holds the thread_replies relation/edge.
+	ThreadRepliesTable = "messages"
+	// ThreadRepliesColumn is the table column denoting the thread_replies relation/edge.
+	ThreadRepliesColumn = "thread_root_id"
+	// ThreadParticipantsTable is the table that holds the thread_participants relation/edge.
+	ThreadParticipantsTable = "thread_participants"
+	// ThreadParticipantsInverseTable is the table name for the ThreadParticipant entity.
+	// It exists in this package in order to avoid circular dependency with the "threadparticipant" package.
+	ThreadParticipantsInverseTable = "thread_participants"
+	// ThreadParticipantsColumn is the table column denoting the thread_participants relation/edge.
+	ThreadParticipantsColumn = "root_message_id"
+	// BookmarksTable is the table that holds the bookmarks relation/edge.
+	BookmarksTable = "bookmarks"
+	// BookmarksInverseTable is the table name for the Bookmark entity.
+	// It exists in this package in order to avoid circular dependency with the "bookmark" package.
+	BookmarksInverseTable = "bookmarks"
+	// BookmarksColumn is the table column denoting the bookmarks relation/edge.
+	BookmarksColumn = "message_id"
+	// HiddenForTable is the table that holds the hidden_for relation/edge.
+	HiddenForTable = "hidden_messages"
+	// HiddenForInverseTable is the table name for the HiddenMessage entity.
+	// It exists in this package in order to avoid circular dependency with the "hiddenmessage" package.
+	HiddenForInverseTable = "hidden_messages"
+	// HiddenForColumn is the table column denoting the hidden_for relation/edge.
+	HiddenForColumn = "message_id"
 )
 
 // Columns holds all SQL columns for message fields.
@@ -72,10 +226,21 @@ var Columns = []string{
 	FieldConversationID,
 	FieldSenderID,
 	FieldContent,
+	FieldPlainText,
+	FieldEntities,
 	FieldMessageType,
 	FieldIsDeleted,
+	FieldDeletedAt,
 	FieldEditedAt,
 	FieldCallID,
+	FieldReplyToID,
+	FieldThreadRootID,
+	FieldThreadReplyCount,
+	FieldThreadLastReplyAt,
+	FieldSuppressEmbeds,
+	FieldForwardedFromMessageID,
+	FieldForwardedFromUserID,
+	FieldNonce,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "messages"
@@ -114,6 +279,14 @@ var (
 	ContentValidator func(string) error
 	// DefaultIsDeleted holds the default value on creation for the "is_deleted" field.
 	DefaultIsDeleted bool
+	// DefaultThreadReplyCount holds the default value on creation for the "thread_reply_count" field.
+	DefaultThreadReplyCount int
+	// ThreadReplyCountValidator is a validator for the "thread_reply_count" field. It is called by the builders before save.
+	ThreadReplyCountValidator func(int) error
+	// DefaultSuppressEmbeds holds the default value on creation for the "suppress_embeds" field.
+	DefaultSuppressEmbeds bool
+	// NonceValidator is a validator for the "nonce" field. It is called by the builders before save.
+	NonceValidator func(string) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() string
 )
@@ -131,6 +304,7 @@ const (
 	MessageTypeFile      MessageType = "file"
 	MessageTypeCallStart MessageType = "call_start"
 	MessageTypeCallEnd   MessageType = "call_end"
+	MessageTypeSystem    MessageType = "system"
 )
 
 func (mt MessageType) String() string {
@@ -140,7 +314,7 @@ func (mt MessageType) String() string {
 // MessageTypeValidator is a validator for the "message_type" field enum values. It is called by the builders before save.
 func MessageTypeValidator(mt MessageType) error {
 	switch mt {
-	case MessageTypeText, MessageTypeImage, MessageTypeFile, MessageTypeCallStart, MessageTypeCallEnd:
+	case MessageTypeText, MessageTypeImage, MessageTypeFile, MessageTypeCallStart, MessageTypeCallEnd, MessageTypeSystem:
 		return nil
 	default:
 		return fmt.Errorf("message: invalid enum value for message_type field: %q", mt)
@@ -180,6 +354,11 @@ func ByContent(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldContent, opts...).ToFunc()
 }
 
+// ByPlainText orders the results by the plain_text field.
+func ByPlainText(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlainText, opts...).ToFunc()
+}
+
 // ByMessageType orders the results by the message_type field.
 func ByMessageType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMessageType, opts...).ToFunc()
@@ -190,6 +369,11 @@ func ByIsDeleted(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsDeleted, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByEditedAt orders the results by the edited_at field.
 func ByEditedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEditedAt, opts...).ToFunc()
@@ -200,6 +384,46 @@ func ByCallID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCallID, opts...).ToFunc()
 }
 
+// ByReplyToID orders the results by the reply_to_id field.
+func ByReplyToID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReplyToID, opts...).ToFunc()
+}
+
+// ByThreadRootID orders the results by the thread_root_id field.
+func ByThreadRootID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldThreadRootID, opts...).ToFunc()
+}
+
+// ByThreadReplyCount orders the results by the thread_reply_count field.
+func ByThreadReplyCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldThreadReplyCount, opts...).ToFunc()
+}
+
+// ByThreadLastReplyAt orders the results by the thread_last_reply_at field.
+func ByThreadLastReplyAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldThreadLastReplyAt, opts...).ToFunc()
+}
+
+// BySuppressEmbeds orders the results by the suppress_embeds field.
+func BySuppressEmbeds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuppressEmbeds, opts...).ToFunc()
+}
+
+// ByForwardedFromMessageID orders the results by the forwarded_from_message_id field.
+func ByForwardedFromMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldForwardedFromMessageID, opts...).ToFunc()
+}
+
+// ByForwardedFromUserID orders the results by the forwarded_from_user_id field.
+func ByForwardedFromUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldForwardedFromUserID, opts...).ToFunc()
+}
+
+// ByNonce orders the results by the nonce field.
+func ByNonce(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNonce, opts...).ToFunc()
+}
+
 // ByConversationField orders the results by conversation field.
 func ByConversationField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -220,6 +444,209 @@ func ByCallField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newCallStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByForwardedFromUserField orders the results by forwarded_from_user field.
+func ByForwardedFromUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newForwardedFromUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRevisionsCount orders the results by revisions count.
+func ByRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRevisionsStep(), opts...)
+	}
+}
+
+// ByRevisions orders the results by revisions terms.
+func ByRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReactionsCount orders the results by reactions count.
+func ByReactionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReactionsStep(), opts...)
+	}
+}
+
+// ByReactions orders the results by reactions terms.
+func ByReactions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReactionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAttachmentsCount orders the results by attachments count.
+func ByAttachmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAttachmentsStep(), opts...)
+	}
+}
+
+// ByAttachments orders the results by attachments terms.
+func ByAttachments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttachmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLinkPreviewsCount orders the results by link_previews count.
+func ByLinkPreviewsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLinkPreviewsStep(), opts...)
+	}
+}
+
+// ByLinkPreviews orders the results by link_previews terms.
+func ByLinkPreviews(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLinkPreviewsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMentionsCount orders the results by mentions count.
+func ByMentionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMentionsStep(), opts...)
+	}
+}
+
+// ByMentions orders the results by mentions terms.
+func ByMentions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMentionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPinsCount orders the results by pins count.
+func ByPinsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPinsStep(), opts...)
+	}
+}
+
+// ByPins orders the results by pins terms.
+func ByPins(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPinsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReceiptsCount orders the results by receipts count.
+func ByReceiptsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReceiptsStep(), opts...)
+	}
+}
+
+// ByReceipts orders the results by receipts terms.
+func ByReceipts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReceiptsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNotificationsCount orders the results by notifications count.
+func ByNotificationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotificationsStep(), opts...)
+	}
+}
+
+// ByNotifications orders the results by notifications terms.
+func ByNotifications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotificationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReplyToField orders the results by reply_to field.
+func ByReplyToField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReplyToStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRepliesCount orders the results by replies count.
+func ByRepliesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRepliesStep(), opts...)
+	}
+}
+
+// ByReplies orders the results by replies terms.
+func ByReplies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRepliesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByThreadRootField orders the results by thread_root field.
+func ByThreadRootField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newThreadRootStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByThreadRepliesCount orders the results by thread_replies count.
+func ByThreadRepliesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newThreadRepliesStep(), opts...)
+	}
+}
+
+// ByThreadReplies orders the results by thread_replies terms.
+func ByThreadReplies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newThreadRepliesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByThreadParticipantsCount orders the results by thread_participants count.
+func ByThreadParticipantsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newThreadParticipantsStep(), opts...)
+	}
+}
+
+// ByThreadParticipants orders the results by thread_participants terms.
+func ByThreadParticipants(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newThreadParticipantsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBookmarksCount orders the results by bookmarks count.
+func ByBookmarksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBookmarksStep(), opts...)
+	}
+}
+
+// ByBookmarks orders the results by bookmarks terms.
+func ByBookmarks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBookmarksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByHiddenForCount orders the results by hidden_for count.
+func ByHiddenForCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newHiddenForStep(), opts...)
+	}
+}
+
+// ByHiddenFor orders the results by hidden_for terms.
+func ByHiddenFor(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHiddenForStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newConversationStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -241,3 +668,115 @@ func newCallStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, false, CallTable, CallColumn),
 	)
 }
+func newForwardedFromUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ForwardedFromUserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ForwardedFromUserTable, ForwardedFromUserColumn),
+	)
+}
+func newRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RevisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, RevisionsTable, RevisionsColumn),
+	)
+}
+func newReactionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReactionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, ReactionsTable, ReactionsColumn),
+	)
+}
+func newAttachmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttachmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, AttachmentsTable, AttachmentsColumn),
+	)
+}
+func newLinkPreviewsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LinkPreviewsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, LinkPreviewsTable, LinkPreviewsColumn),
+	)
+}
+func newMentionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MentionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, MentionsTable, MentionsColumn),
+	)
+}
+func newPinsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PinsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, PinsTable, PinsColumn),
+	)
+}
+func newReceiptsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReceiptsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, ReceiptsTable, ReceiptsColumn),
+	)
+}
+func newNotificationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotificationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, NotificationsTable, NotificationsColumn),
+	)
+}
+func newReplyToStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ReplyToTable, ReplyToColumn),
+	)
+}
+func newRepliesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RepliesTable, RepliesColumn),
+	)
+}
+func newThreadRootStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ThreadRootTable, ThreadRootColumn),
+	)
+}
+func newThreadRepliesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ThreadRepliesTable, ThreadRepliesColumn),
+	)
+}
+func newThreadParticipantsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ThreadParticipantsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, ThreadParticipantsTable, ThreadParticipantsColumn),
+	)
+}
+func newBookmarksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BookmarksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, BookmarksTable, BookmarksColumn),
+	)
+}
+func newHiddenForStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HiddenForInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, HiddenForTable, HiddenForColumn),
+	)
+}
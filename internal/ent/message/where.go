@@ -90,11 +90,21 @@ func Content(v string) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldContent, v))
 }
 
+// PlainText applies equality check predicate on the "plain_text" field. It's identical to PlainTextEQ.
+func PlainText(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldPlainText, v))
+}
+
 // IsDeleted applies equality check predicate on the "is_deleted" field. It's identical to IsDeletedEQ.
 func IsDeleted(v bool) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldIsDeleted, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // EditedAt applies equality check predicate on the "edited_at" field. It's identical to EditedAtEQ.
 func EditedAt(v time.Time) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldEditedAt, v))
@@ -105,6 +115,46 @@ func CallID(v string) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldCallID, v))
 }
 
+// ReplyToID applies equality check predicate on the "reply_to_id" field. It's identical to ReplyToIDEQ.
+func ReplyToID(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldReplyToID, v))
+}
+
+// ThreadRootID applies equality check predicate on the "thread_root_id" field. It's identical to ThreadRootIDEQ.
+func ThreadRootID(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldThreadRootID, v))
+}
+
+// ThreadReplyCount applies equality check predicate on the "thread_reply_count" field. It's identical to ThreadReplyCountEQ.
+func ThreadReplyCount(v int) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldThreadReplyCount, v))
+}
+
+// ThreadLastReplyAt applies equality check predicate on the "thread_last_reply_at" field. It's identical to ThreadLastReplyAtEQ.
+func ThreadLastReplyAt(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldThreadLastReplyAt, v))
+}
+
+// SuppressEmbeds applies equality check predicate on the "suppress_embeds" field. It's identical to SuppressEmbedsEQ.
+func SuppressEmbeds(v bool) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldSuppressEmbeds, v))
+}
+
+// ForwardedFromMessageID applies equality check predicate on the "forwarded_from_message_id" field. It's identical to ForwardedFromMessageIDEQ.
+func ForwardedFromMessageID(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldForwardedFromMessageID, v))
+}
+
+// ForwardedFromUserID applies equality check predicate on the "forwarded_from_user_id" field. It's identical to ForwardedFromUserIDEQ.
+func ForwardedFromUserID(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldForwardedFromUserID, v))
+}
+
+// Nonce applies equality check predicate on the "nonce" field. It's identical to NonceEQ.
+func Nonce(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldNonce, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldCreatedAt, v))
@@ -380,6 +430,91 @@ func ContentContainsFold(v string) predicate.Message {
 	return predicate.Message(sql.FieldContainsFold(FieldContent, v))
 }
 
+// PlainTextEQ applies the EQ predicate on the "plain_text" field.
+func PlainTextEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldPlainText, v))
+}
+
+// PlainTextNEQ applies the NEQ predicate on the "plain_text" field.
+func PlainTextNEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldPlainText, v))
+}
+
+// PlainTextIn applies the In predicate on the "plain_text" field.
+func PlainTextIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldPlainText, vs...))
+}
+
+// PlainTextNotIn applies the NotIn predicate on the "plain_text" field.
+func PlainTextNotIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldPlainText, vs...))
+}
+
+// PlainTextGT applies the GT predicate on the "plain_text" field.
+func PlainTextGT(v string) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldPlainText, v))
+}
+
+// PlainTextGTE applies the GTE predicate on the "plain_text" field.
+func PlainTextGTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldPlainText, v))
+}
+
+// PlainTextLT applies the LT predicate on the "plain_text" field.
+func PlainTextLT(v string) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldPlainText, v))
+}
+
+// PlainTextLTE applies the LTE predicate on the "plain_text" field.
+func PlainTextLTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldPlainText, v))
+}
+
+// PlainTextContains applies the Contains predicate on the "plain_text" field.
+func PlainTextContains(v string) predicate.Message {
+	return predicate.Message(sql.FieldContains(FieldPlainText, v))
+}
+
+// PlainTextHasPrefix applies the HasPrefix predicate on the "plain_text" field.
+func PlainTextHasPrefix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasPrefix(FieldPlainText, v))
+}
+
+// PlainTextHasSuffix applies the HasSuffix predicate on the "plain_text" field.
+func PlainTextHasSuffix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasSuffix(FieldPlainText, v))
+}
+
+// PlainTextIsNil applies the IsNil predicate on the "plain_text" field.
+func PlainTextIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldPlainText))
+}
+
+// PlainTextNotNil applies the NotNil predicate on the "plain_text" field.
+func PlainTextNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldPlainText))
+}
+
+// PlainTextEqualFold applies the EqualFold predicate on the "plain_text" field.
+func PlainTextEqualFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldEqualFold(FieldPlainText, v))
+}
+
+// PlainTextContainsFold applies the ContainsFold predicate on the "plain_text" field.
+func PlainTextContainsFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldContainsFold(FieldPlainText, v))
+}
+
+// EntitiesIsNil applies the IsNil predicate on the "entities" field.
+func EntitiesIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldEntities))
+}
+
+// EntitiesNotNil applies the NotNil predicate on the "entities" field.
+func EntitiesNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldEntities))
+}
+
 // MessageTypeEQ applies the EQ predicate on the "message_type" field.
 func MessageTypeEQ(v MessageType) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldMessageType, v))
@@ -410,6 +545,56 @@ func IsDeletedNEQ(v bool) predicate.Message {
 	return predicate.Message(sql.FieldNEQ(FieldIsDeleted, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // EditedAtEQ applies the EQ predicate on the "edited_at" field.
 func EditedAtEQ(v time.Time) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldEditedAt, v))
@@ -535,67 +720,910 @@ func CallIDContainsFold(v string) predicate.Message {
 	return predicate.Message(sql.FieldContainsFold(FieldCallID, v))
 }
 
-// HasConversation applies the HasEdge predicate on the "conversation" edge.
-func HasConversation() predicate.Message {
-	return predicate.Message(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, ConversationTable, ConversationColumn),
-		)
-		sqlgraph.HasNeighbors(s, step)
-	})
+// ReplyToIDEQ applies the EQ predicate on the "reply_to_id" field.
+func ReplyToIDEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldReplyToID, v))
 }
 
-// HasConversationWith applies the HasEdge predicate on the "conversation" edge with a given conditions (other predicates).
-func HasConversationWith(preds ...predicate.Conversation) predicate.Message {
-	return predicate.Message(func(s *sql.Selector) {
-		step := newConversationStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
-			for _, p := range preds {
-				p(s)
-			}
-		})
-	})
+// ReplyToIDNEQ applies the NEQ predicate on the "reply_to_id" field.
+func ReplyToIDNEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldReplyToID, v))
 }
 
-// HasSender applies the HasEdge predicate on the "sender" edge.
-func HasSender() predicate.Message {
-	return predicate.Message(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, SenderTable, SenderColumn),
-		)
-		sqlgraph.HasNeighbors(s, step)
-	})
+// ReplyToIDIn applies the In predicate on the "reply_to_id" field.
+func ReplyToIDIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldReplyToID, vs...))
 }
 
-// HasSenderWith applies the HasEdge predicate on the "sender" edge with a given conditions (other predicates).
-func HasSenderWith(preds ...predicate.User) predicate.Message {
-	return predicate.Message(func(s *sql.Selector) {
-		step := newSenderStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
-			for _, p := range preds {
-				p(s)
-			}
-		})
-	})
+// ReplyToIDNotIn applies the NotIn predicate on the "reply_to_id" field.
+func ReplyToIDNotIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldReplyToID, vs...))
 }
 
-// HasCall applies the HasEdge predicate on the "call" edge.
-func HasCall() predicate.Message {
-	return predicate.Message(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, CallTable, CallColumn),
-		)
-		sqlgraph.HasNeighbors(s, step)
-	})
+// ReplyToIDGT applies the GT predicate on the "reply_to_id" field.
+func ReplyToIDGT(v string) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldReplyToID, v))
 }
 
-// HasCallWith applies the HasEdge predicate on the "call" edge with a given conditions (other predicates).
-func HasCallWith(preds ...predicate.Call) predicate.Message {
-	return predicate.Message(func(s *sql.Selector) {
-		step := newCallStep()
+// ReplyToIDGTE applies the GTE predicate on the "reply_to_id" field.
+func ReplyToIDGTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldReplyToID, v))
+}
+
+// ReplyToIDLT applies the LT predicate on the "reply_to_id" field.
+func ReplyToIDLT(v string) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldReplyToID, v))
+}
+
+// ReplyToIDLTE applies the LTE predicate on the "reply_to_id" field.
+func ReplyToIDLTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldReplyToID, v))
+}
+
+// ReplyToIDContains applies the Contains predicate on the "reply_to_id" field.
+func ReplyToIDContains(v string) predicate.Message {
+	return predicate.Message(sql.FieldContains(FieldReplyToID, v))
+}
+
+// ReplyToIDHasPrefix applies the HasPrefix predicate on the "reply_to_id" field.
+func ReplyToIDHasPrefix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasPrefix(FieldReplyToID, v))
+}
+
+// ReplyToIDHasSuffix applies the HasSuffix predicate on the "reply_to_id" field.
+func ReplyToIDHasSuffix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasSuffix(FieldReplyToID, v))
+}
+
+// ReplyToIDIsNil applies the IsNil predicate on the "reply_to_id" field.
+func ReplyToIDIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldReplyToID))
+}
+
+// ReplyToIDNotNil applies the NotNil predicate on the "reply_to_id" field.
+func ReplyToIDNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldReplyToID))
+}
+
+// ReplyToIDEqualFold applies the EqualFold predicate on the "reply_to_id" field.
+func ReplyToIDEqualFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldEqualFold(FieldReplyToID, v))
+}
+
+// ReplyToIDContainsFold applies the ContainsFold predicate on the "reply_to_id" field.
+func ReplyToIDContainsFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldContainsFold(FieldReplyToID, v))
+}
+
+// ThreadRootIDEQ applies the EQ predicate on the "thread_root_id" field.
+func ThreadRootIDEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldThreadRootID, v))
+}
+
+// ThreadRootIDNEQ applies the NEQ predicate on the "thread_root_id" field.
+func ThreadRootIDNEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldThreadRootID, v))
+}
+
+// ThreadRootIDIn applies the In predicate on the "thread_root_id" field.
+func ThreadRootIDIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldThreadRootID, vs...))
+}
+
+// ThreadRootIDNotIn applies the NotIn predicate on the "thread_root_id" field.
+func ThreadRootIDNotIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldThreadRootID, vs...))
+}
+
+// ThreadRootIDGT applies the GT predicate on the "thread_root_id" field.
+func ThreadRootIDGT(v string) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldThreadRootID, v))
+}
+
+// ThreadRootIDGTE applies the GTE predicate on the "thread_root_id" field.
+func ThreadRootIDGTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldThreadRootID, v))
+}
+
+// ThreadRootIDLT applies the LT predicate on the "thread_root_id" field.
+func ThreadRootIDLT(v string) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldThreadRootID, v))
+}
+
+// ThreadRootIDLTE applies the LTE predicate on the "thread_root_id" field.
+func ThreadRootIDLTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldThreadRootID, v))
+}
+
+// ThreadRootIDContains applies the Contains predicate on the "thread_root_id" field.
+func ThreadRootIDContains(v string) predicate.Message {
+	return predicate.Message(sql.FieldContains(FieldThreadRootID, v))
+}
+
+// ThreadRootIDHasPrefix applies the HasPrefix predicate on the "thread_root_id" field.
+func ThreadRootIDHasPrefix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasPrefix(FieldThreadRootID, v))
+}
+
+// ThreadRootIDHasSuffix applies the HasSuffix predicate on the "thread_root_id" field.
+func ThreadRootIDHasSuffix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasSuffix(FieldThreadRootID, v))
+}
+
+// ThreadRootIDIsNil applies the IsNil predicate on the "thread_root_id" field.
+func ThreadRootIDIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldThreadRootID))
+}
+
+// ThreadRootIDNotNil applies the NotNil predicate on the "thread_root_id" field.
+func ThreadRootIDNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldThreadRootID))
+}
+
+// ThreadRootIDEqualFold applies the EqualFold predicate on the "thread_root_id" field.
+func ThreadRootIDEqualFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldEqualFold(FieldThreadRootID, v))
+}
+
+// ThreadRootIDContainsFold applies the ContainsFold predicate on the "thread_root_id" field.
+func ThreadRootIDContainsFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldContainsFold(FieldThreadRootID, v))
+}
+
+// ThreadReplyCountEQ applies the EQ predicate on the "thread_reply_count" field.
+func ThreadReplyCountEQ(v int) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldThreadReplyCount, v))
+}
+
+// ThreadReplyCountNEQ applies the NEQ predicate on the "thread_reply_count" field.
+func ThreadReplyCountNEQ(v int) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldThreadReplyCount, v))
+}
+
+// ThreadReplyCountIn applies the In predicate on the "thread_reply_count" field.
+func ThreadReplyCountIn(vs ...int) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldThreadReplyCount, vs...))
+}
+
+// ThreadReplyCountNotIn applies the NotIn predicate on the "thread_reply_count" field.
+func ThreadReplyCountNotIn(vs ...int) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldThreadReplyCount, vs...))
+}
+
+// ThreadReplyCountGT applies the GT predicate on the "thread_reply_count" field.
+func ThreadReplyCountGT(v int) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldThreadReplyCount, v))
+}
+
+// ThreadReplyCountGTE applies the GTE predicate on the "thread_reply_count" field.
+func ThreadReplyCountGTE(v int) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldThreadReplyCount, v))
+}
+
+// ThreadReplyCountLT applies the LT predicate on the "thread_reply_count" field.
+func ThreadReplyCountLT(v int) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldThreadReplyCount, v))
+}
+
+// ThreadReplyCountLTE applies the LTE predicate on the "thread_reply_count" field.
+func ThreadReplyCountLTE(v int) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldThreadReplyCount, v))
+}
+
+// ThreadLastReplyAtEQ applies the EQ predicate on the "thread_last_reply_at" field.
+func ThreadLastReplyAtEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldThreadLastReplyAt, v))
+}
+
+// ThreadLastReplyAtNEQ applies the NEQ predicate on the "thread_last_reply_at" field.
+func ThreadLastReplyAtNEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldThreadLastReplyAt, v))
+}
+
+// ThreadLastReplyAtIn applies the In predicate on the "thread_last_reply_at" field.
+func ThreadLastReplyAtIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldThreadLastReplyAt, vs...))
+}
+
+// ThreadLastReplyAtNotIn applies the NotIn predicate on the "thread_last_reply_at" field.
+func ThreadLastReplyAtNotIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldThreadLastReplyAt, vs...))
+}
+
+// ThreadLastReplyAtGT applies the GT predicate on the "thread_last_reply_at" field.
+func ThreadLastReplyAtGT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldThreadLastReplyAt, v))
+}
+
+// ThreadLastReplyAtGTE applies the GTE predicate on the "thread_last_reply_at" field.
+func ThreadLastReplyAtGTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldThreadLastReplyAt, v))
+}
+
+// ThreadLastReplyAtLT applies the LT predicate on the "thread_last_reply_at" field.
+func ThreadLastReplyAtLT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldThreadLastReplyAt, v))
+}
+
+// ThreadLastReplyAtLTE applies the LTE predicate on the "thread_last_reply_at" field.
+func ThreadLastReplyAtLTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldThreadLastReplyAt, v))
+}
+
+// ThreadLastReplyAtIsNil applies the IsNil predicate on the "thread_last_reply_at" field.
+func ThreadLastReplyAtIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldThreadLastReplyAt))
+}
+
+// ThreadLastReplyAtNotNil applies the NotNil predicate on the "thread_last_reply_at" field.
+func ThreadLastReplyAtNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldThreadLastReplyAt))
+}
+
+// SuppressEmbedsEQ applies the EQ predicate on the "suppress_embeds" field.
+func SuppressEmbedsEQ(v bool) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldSuppressEmbeds, v))
+}
+
+// SuppressEmbedsNEQ applies the NEQ predicate on the "suppress_embeds" field.
+func SuppressEmbedsNEQ(v bool) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldSuppressEmbeds, v))
+}
+
+// ForwardedFromMessageIDEQ applies the EQ predicate on the "forwarded_from_message_id" field.
+func ForwardedFromMessageIDEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldForwardedFromMessageID, v))
+}
+
+// ForwardedFromMessageIDNEQ applies the NEQ predicate on the "forwarded_from_message_id" field.
+func ForwardedFromMessageIDNEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldForwardedFromMessageID, v))
+}
+
+// ForwardedFromMessageIDIn applies the In predicate on the "forwarded_from_message_id" field.
+func ForwardedFromMessageIDIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldForwardedFromMessageID, vs...))
+}
+
+// ForwardedFromMessageIDNotIn applies the NotIn predicate on the "forwarded_from_message_id" field.
+func ForwardedFromMessageIDNotIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldForwardedFromMessageID, vs...))
+}
+
+// ForwardedFromMessageIDGT applies the GT predicate on the "forwarded_from_message_id" field.
+func ForwardedFromMessageIDGT(v string) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldForwardedFromMessageID, v))
+}
+
+// ForwardedFromMessageIDGTE applies the GTE predicate on the "forwarded_from_message_id" field.
+func ForwardedFromMessageIDGTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldForwardedFromMessageID, v))
+}
+
+// ForwardedFromMessageIDLT applies the LT predicate on the "forwarded_from_message_id" field.
+func ForwardedFromMessageIDLT(v string) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldForwardedFromMessageID, v))
+}
+
+// ForwardedFromMessageIDLTE applies the LTE predicate on the "forwarded_from_message_id" field.
+func ForwardedFromMessageIDLTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldForwardedFromMessageID, v))
+}
+
+// ForwardedFromMessageIDContains applies the Contains predicate on the "forwarded_from_message_id" field.
+func ForwardedFromMessageIDContains(v string) predicate.Message {
+	return predicate.Message(sql.FieldContains(FieldForwardedFromMessageID, v))
+}
+
+// ForwardedFromMessageIDHasPrefix applies the HasPrefix predicate on the "forwarded_from_message_id" field.
+func ForwardedFromMessageIDHasPrefix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasPrefix(FieldForwardedFromMessageID, v))
+}
+
+// ForwardedFromMessageIDHasSuffix applies the HasSuffix predicate on the "forwarded_from_message_id" field.
+func ForwardedFromMessageIDHasSuffix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasSuffix(FieldForwardedFromMessageID, v))
+}
+
+// ForwardedFromMessageIDIsNil applies the IsNil predicate on the "forwarded_from_message_id" field.
+func ForwardedFromMessageIDIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldForwardedFromMessageID))
+}
+
+// ForwardedFromMessageIDNotNil applies the NotNil predicate on the "forwarded_from_message_id" field.
+func ForwardedFromMessageIDNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldForwardedFromMessageID))
+}
+
+// ForwardedFromMessageIDEqualFold applies the EqualFold predicate on the "forwarded_from_message_id" field.
+func ForwardedFromMessageIDEqualFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldEqualFold(FieldForwardedFromMessageID, v))
+}
+
+// ForwardedFromMessageIDContainsFold applies the ContainsFold predicate on the "forwarded_from_message_id" field.
+func ForwardedFromMessageIDContainsFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldContainsFold(FieldForwardedFromMessageID, v))
+}
+
+// ForwardedFromUserIDEQ applies the EQ predicate on the "forwarded_from_user_id" field.
+func ForwardedFromUserIDEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldForwardedFromUserID, v))
+}
+
+// ForwardedFromUserIDNEQ applies the NEQ predicate on the "forwarded_from_user_id" field.
+func ForwardedFromUserIDNEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldForwardedFromUserID, v))
+}
+
+// ForwardedFromUserIDIn applies the In predicate on the "forwarded_from_user_id" field.
+func ForwardedFromUserIDIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldForwardedFromUserID, vs...))
+}
+
+// ForwardedFromUserIDNotIn applies the NotIn predicate on the "forwarded_from_user_id" field.
+func ForwardedFromUserIDNotIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldForwardedFromUserID, vs...))
+}
+
+// ForwardedFromUserIDGT applies the GT predicate on the "forwarded_from_user_id" field.
+func ForwardedFromUserIDGT(v string) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldForwardedFromUserID, v))
+}
+
+// ForwardedFromUserIDGTE applies the GTE predicate on the "forwarded_from_user_id" field.
+func ForwardedFromUserIDGTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldForwardedFromUserID, v))
+}
+
+// ForwardedFromUserIDLT applies the LT predicate on the "forwarded_from_user_id" field.
+func ForwardedFromUserIDLT(v string) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldForwardedFromUserID, v))
+}
+
+// ForwardedFromUserIDLTE applies the LTE predicate on the "forwarded_from_user_id" field.
+func ForwardedFromUserIDLTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldForwardedFromUserID, v))
+}
+
+// ForwardedFromUserIDContains applies the Contains predicate on the "forwarded_from_user_id" field.
+func ForwardedFromUserIDContains(v string) predicate.Message {
+	return predicate.Message(sql.FieldContains(FieldForwardedFromUserID, v))
+}
+
+// ForwardedFromUserIDHasPrefix applies the HasPrefix predicate on the "forwarded_from_user_id" field.
+func ForwardedFromUserIDHasPrefix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasPrefix(FieldForwardedFromUserID, v))
+}
+
+// ForwardedFromUserIDHasSuffix applies the HasSuffix predicate on the "forwarded_from_user_id" field.
+func ForwardedFromUserIDHasSuffix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasSuffix(FieldForwardedFromUserID, v))
+}
+
+// ForwardedFromUserIDIsNil applies the IsNil predicate on the "forwarded_from_user_id" field.
+func ForwardedFromUserIDIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldForwardedFromUserID))
+}
+
+// ForwardedFromUserIDNotNil applies the NotNil predicate on the "forwarded_from_user_id" field.
+func ForwardedFromUserIDNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldForwardedFromUserID))
+}
+
+// ForwardedFromUserIDEqualFold applies the EqualFold predicate on the "forwarded_from_user_id" field.
+func ForwardedFromUserIDEqualFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldEqualFold(FieldForwardedFromUserID, v))
+}
+
+// ForwardedFromUserIDContainsFold applies the ContainsFold predicate on the "forwarded_from_user_id" field.
+func ForwardedFromUserIDContainsFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldContainsFold(FieldForwardedFromUserID, v))
+}
+
+// NonceEQ applies the EQ predicate on the "nonce" field.
+func NonceEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldNonce, v))
+}
+
+// NonceNEQ applies the NEQ predicate on the "nonce" field.
+func NonceNEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldNonce, v))
+}
+
+// NonceIn applies the In predicate on the "nonce" field.
+func NonceIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldNonce, vs...))
+}
+
+// NonceNotIn applies the NotIn predicate on the "nonce" field.
+func NonceNotIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldNonce, vs...))
+}
+
+// NonceGT applies the GT predicate on the "nonce" field.
+func NonceGT(v string) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldNonce, v))
+}
+
+// NonceGTE applies the GTE predicate on the "nonce" field.
+func NonceGTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldNonce, v))
+}
+
+// NonceLT applies the LT predicate on the "nonce" field.
+func NonceLT(v string) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldNonce, v))
+}
+
+// NonceLTE applies the LTE predicate on the "nonce" field.
+func NonceLTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldNonce, v))
+}
+
+// NonceContains applies the Contains predicate on the "nonce" field.
+func NonceContains(v string) predicate.Message {
+	return predicate.Message(sql.FieldContains(FieldNonce, v))
+}
+
+// NonceHasPrefix applies the HasPrefix predicate on the "nonce" field.
+func NonceHasPrefix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasPrefix(FieldNonce, v))
+}
+
+// NonceHasSuffix applies the HasSuffix predicate on the "nonce" field.
+func NonceHasSuffix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasSuffix(FieldNonce, v))
+}
+
+// NonceIsNil applies the IsNil predicate on the "nonce" field.
+func NonceIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldNonce))
+}
+
+// NonceNotNil applies the NotNil predicate on the "nonce" field.
+func NonceNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldNonce))
+}
+
+// NonceEqualFold applies the EqualFold predicate on the "nonce" field.
+func NonceEqualFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldEqualFold(FieldNonce, v))
+}
+
+// NonceContainsFold applies the ContainsFold predicate on the "nonce" field.
+func NonceContainsFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldContainsFold(FieldNonce, v))
+}
+
+// HasConversation applies the HasEdge predicate on the "conversation" edge.
+func HasConversation() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ConversationTable, ConversationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConversationWith applies the HasEdge predicate on the "conversation" edge with a given conditions (other predicates).
+func HasConversationWith(preds ...predicate.Conversation) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newConversationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSender applies the HasEdge predicate on the "sender" edge.
+func HasSender() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, SenderTable, SenderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSenderWith applies the HasEdge predicate on the "sender" edge with a given conditions (other predicates).
+func HasSenderWith(preds ...predicate.User) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newSenderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCall applies the HasEdge predicate on the "call" edge.
+func HasCall() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, CallTable, CallColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCallWith applies the HasEdge predicate on the "call" edge with a given conditions (other predicates).
+func HasCallWith(preds ...predicate.Call) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newCallStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasForwardedFromUser applies the HasEdge predicate on the "forwarded_from_user" edge.
+func HasForwardedFromUser() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ForwardedFromUserTable, ForwardedFromUserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasForwardedFromUserWith applies the HasEdge predicate on the "forwarded_from_user" edge with a given conditions (other predicates).
+func HasForwardedFromUserWith(preds ...predicate.User) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newForwardedFromUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRevisions applies the HasEdge predicate on the "revisions" edge.
+func HasRevisions() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, RevisionsTable, RevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRevisionsWith applies the HasEdge predicate on the "revisions" edge with a given conditions (other predicates).
+func HasRevisionsWith(preds ...predicate.MessageRevision) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReactions applies the HasEdge predicate on the "reactions" edge.
+func HasReactions() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, ReactionsTable, ReactionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReactionsWith applies the HasEdge predicate on the "reactions" edge with a given conditions (other predicates).
+func HasReactionsWith(preds ...predicate.MessageReaction) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newReactionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttachments applies the HasEdge predicate on the "attachments" edge.
+func HasAttachments() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, AttachmentsTable, AttachmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttachmentsWith applies the HasEdge predicate on the "attachments" edge with a given conditions (other predicates).
+func HasAttachmentsWith(preds ...predicate.Attachment) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newAttachmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLinkPreviews applies the HasEdge predicate on the "link_previews" edge.
+func HasLinkPreviews() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, LinkPreviewsTable, LinkPreviewsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLinkPreviewsWith applies the HasEdge predicate on the "link_previews" edge with a given conditions (other predicates).
+func HasLinkPreviewsWith(preds ...predicate.LinkPreview) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newLinkPreviewsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMentions applies the HasEdge predicate on the "mentions" edge.
+func HasMentions() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, MentionsTable, MentionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMentionsWith applies the HasEdge predicate on the "mentions" edge with a given conditions (other predicates).
+func HasMentionsWith(preds ...predicate.MessageMention) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newMentionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPins applies the HasEdge predicate on the "pins" edge.
+func HasPins() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, PinsTable, PinsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPinsWith applies the HasEdge predicate on the "pins" edge with a given conditions (other predicates).
+func HasPinsWith(preds ...predicate.PinnedMessage) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newPinsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReceipts applies the HasEdge predicate on the "receipts" edge.
+func HasReceipts() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, ReceiptsTable, ReceiptsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReceiptsWith applies the HasEdge predicate on the "receipts" edge with a given conditions (other predicates).
+func HasReceiptsWith(preds ...predicate.MessageReceipt) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newReceiptsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNotifications applies the HasEdge predicate on the "notifications" edge.
+func HasNotifications() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, NotificationsTable, NotificationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotificationsWith applies the HasEdge predicate on the "notifications" edge with a given conditions (other predicates).
+func HasNotificationsWith(preds ...predicate.Notification) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newNotificationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReplyTo applies the HasEdge predicate on the "reply_to" edge.
+func HasReplyTo() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ReplyToTable, ReplyToColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReplyToWith applies the HasEdge predicate on the "reply_to" edge with a given conditions (other predicates).
+func HasReplyToWith(preds ...predicate.Message) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newReplyToStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReplies applies the HasEdge predicate on the "replies" edge.
+func HasReplies() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RepliesTable, RepliesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRepliesWith applies the HasEdge predicate on the "replies" edge with a given conditions (other predicates).
+func HasRepliesWith(preds ...predicate.Message) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newRepliesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasThreadRoot applies the HasEdge predicate on the "thread_root" edge.
+func HasThreadRoot() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ThreadRootTable, ThreadRootColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasThreadRootWith applies the HasEdge predicate on the "thread_root" edge with a given conditions (other predicates).
+func HasThreadRootWith(preds ...predicate.Message) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newThreadRootStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasThreadReplies applies the HasEdge predicate on the "thread_replies" edge.
+func HasThreadReplies() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ThreadRepliesTable, ThreadRepliesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasThreadRepliesWith applies the HasEdge predicate on the "thread_replies" edge with a given conditions (other predicates).
+func HasThreadRepliesWith(preds ...predicate.Message) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newThreadRepliesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasThreadParticipants applies the HasEdge predicate on the "thread_participants" edge.
+func HasThreadParticipants() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, ThreadParticipantsTable, ThreadParticipantsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasThreadParticipantsWith applies the HasEdge predicate on the "thread_participants" edge with a given conditions (other predicates).
+func HasThreadParticipantsWith(preds ...predicate.ThreadParticipant) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newThreadParticipantsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBookmarks applies the HasEdge predicate on the "bookmarks" edge.
+func HasBookmarks() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, BookmarksTable, BookmarksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBookmarksWith applies the HasEdge predicate on the "bookmarks" edge with a given conditions (other predicates).
+func HasBookmarksWith(preds ...predicate.Bookmark) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newBookmarksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasHiddenFor applies the HasEdge predicate on the "hidden_for" edge.
+func HasHiddenFor() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, HiddenForTable, HiddenForColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHiddenForWith applies the HasEdge predicate on the "hidden_for" edge with a given conditions (other predicates).
+func HasHiddenForWith(preds ...predicate.HiddenMessage) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newHiddenForStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
@@ -6,10 +6,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"kakashi/chaos/internal/ent/attachment"
+	"kakashi/chaos/internal/ent/bookmark"
 	"kakashi/chaos/internal/ent/call"
 	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/hiddenmessage"
+	"kakashi/chaos/internal/ent/linkpreview"
 	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/messagemention"
+	"kakashi/chaos/internal/ent/messagereaction"
+	"kakashi/chaos/internal/ent/messagereceipt"
+	"kakashi/chaos/internal/ent/messagerevision"
+	"kakashi/chaos/internal/ent/notification"
+	"kakashi/chaos/internal/ent/pinnedmessage"
+	"kakashi/chaos/internal/ent/threadparticipant"
 	"kakashi/chaos/internal/ent/user"
+	"kakashi/chaos/internal/richtext"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -69,6 +81,26 @@ func (mc *MessageCreate) SetContent(s string) *MessageCreate {
 	return mc
 }
 
+// SetPlainText sets the "plain_text" field.
+func (mc *MessageCreate) SetPlainText(s string) *MessageCreate {
+	mc.mutation.SetPlainText(s)
+	return mc
+}
+
+// SetNillablePlainText sets the "plain_text" field if the given value is not nil.
+func (mc *MessageCreate) SetNillablePlainText(s *string) *MessageCreate {
+	if s != nil {
+		mc.SetPlainText(*s)
+	}
+	return mc
+}
+
+// SetEntities sets the "entities" field.
+func (mc *MessageCreate) SetEntities(r []richtext.Entity) *MessageCreate {
+	mc.mutation.SetEntities(r)
+	return mc
+}
+
 // SetMessageType sets the "message_type" field.
 func (mc *MessageCreate) SetMessageType(mt message.MessageType) *MessageCreate {
 	mc.mutation.SetMessageType(mt)
@@ -97,6 +129,20 @@ func (mc *MessageCreate) SetNillableIsDeleted(b *bool) *MessageCreate {
 	return mc
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (mc *MessageCreate) SetDeletedAt(t time.Time) *MessageCreate {
+	mc.mutation.SetDeletedAt(t)
+	return mc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableDeletedAt(t *time.Time) *MessageCreate {
+	if t != nil {
+		mc.SetDeletedAt(*t)
+	}
+	return mc
+}
+
 // SetEditedAt sets the "edited_at" field.
 func (mc *MessageCreate) SetEditedAt(t time.Time) *MessageCreate {
 	mc.mutation.SetEditedAt(t)
@@ -125,6 +171,118 @@ func (mc *MessageCreate) SetNillableCallID(s *string) *MessageCreate {
 	return mc
 }
 
+// SetReplyToID sets the "reply_to_id" field.
+func (mc *MessageCreate) SetReplyToID(s string) *MessageCreate {
+	mc.mutation.SetReplyToID(s)
+	return mc
+}
+
+// SetNillableReplyToID sets the "reply_to_id" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableReplyToID(s *string) *MessageCreate {
+	if s != nil {
+		mc.SetReplyToID(*s)
+	}
+	return mc
+}
+
+// SetThreadRootID sets the "thread_root_id" field.
+func (mc *MessageCreate) SetThreadRootID(s string) *MessageCreate {
+	mc.mutation.SetThreadRootID(s)
+	return mc
+}
+
+// SetNillableThreadRootID sets the "thread_root_id" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableThreadRootID(s *string) *MessageCreate {
+	if s != nil {
+		mc.SetThreadRootID(*s)
+	}
+	return mc
+}
+
+// SetThreadReplyCount sets the "thread_reply_count" field.
+func (mc *MessageCreate) SetThreadReplyCount(i int) *MessageCreate {
+	mc.mutation.SetThreadReplyCount(i)
+	return mc
+}
+
+// SetNillableThreadReplyCount sets the "thread_reply_count" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableThreadReplyCount(i *int) *MessageCreate {
+	if i != nil {
+		mc.SetThreadReplyCount(*i)
+	}
+	return mc
+}
+
+// SetThreadLastReplyAt sets the "thread_last_reply_at" field.
+func (mc *MessageCreate) SetThreadLastReplyAt(t time.Time) *MessageCreate {
+	mc.mutation.SetThreadLastReplyAt(t)
+	return mc
+}
+
+// SetNillableThreadLastReplyAt sets the "thread_last_reply_at" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableThreadLastReplyAt(t *time.Time) *MessageCreate {
+	if t != nil {
+		mc.SetThreadLastReplyAt(*t)
+	}
+	return mc
+}
+
+// SetSuppressEmbeds sets the "suppress_embeds" field.
+func (mc *MessageCreate) SetSuppressEmbeds(b bool) *MessageCreate {
+	mc.mutation.SetSuppressEmbeds(b)
+	return mc
+}
+
+// SetNillableSuppressEmbeds sets the "suppress_embeds" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableSuppressEmbeds(b *bool) *MessageCreate {
+	if b != nil {
+		mc.SetSuppressEmbeds(*b)
+	}
+	return mc
+}
+
+// SetForwardedFromMessageID sets the "forwarded_from_message_id" field.
+func (mc *MessageCreate) SetForwardedFromMessageID(s string) *MessageCreate {
+	mc.mutation.SetForwardedFromMessageID(s)
+	return mc
+}
+
+// SetNillableForwardedFromMessageID sets the "forwarded_from_message_id" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableForwardedFromMessageID(s *string) *MessageCreate {
+	if s != nil {
+		mc.SetForwardedFromMessageID(*s)
+	}
+	return mc
+}
+
+// SetForwardedFromUserID sets the "forwarded_from_user_id" field.
+func (mc *MessageCreate) SetForwardedFromUserID(s string) *MessageCreate {
+	mc.mutation.SetForwardedFromUserID(s)
+	return mc
+}
+
+// SetNillableForwardedFromUserID sets the "forwarded_from_user_id" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableForwardedFromUserID(s *string) *MessageCreate {
+	if s != nil {
+		mc.SetForwardedFromUserID(*s)
+	}
+	return mc
+}
+
+// SetNonce sets the "nonce" field.
+func (mc *MessageCreate) SetNonce(s string) *MessageCreate {
+	mc.mutation.SetNonce(s)
+	return mc
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableNonce(s *string) *MessageCreate {
+	if s != nil {
+		mc.SetNonce(*s)
+	}
+	return mc
+}
+
 // SetID sets the "id" field.
 func (mc *MessageCreate) SetID(s string) *MessageCreate {
 	mc.mutation.SetID(s)
@@ -154,6 +312,216 @@ func (mc *MessageCreate) SetCall(c *Call) *MessageCreate {
 	return mc.SetCallID(c.ID)
 }
 
+// SetForwardedFromUser sets the "forwarded_from_user" edge to the User entity.
+func (mc *MessageCreate) SetForwardedFromUser(u *User) *MessageCreate {
+	return mc.SetForwardedFromUserID(u.ID)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the MessageRevision entity by IDs.
+func (mc *MessageCreate) AddRevisionIDs(ids ...string) *MessageCreate {
+	mc.mutation.AddRevisionIDs(ids...)
+	return mc
+}
+
+// AddRevisions adds the "revisions" edges to the MessageRevision entity.
+func (mc *MessageCreate) AddRevisions(m ...*MessageRevision) *MessageCreate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mc.AddRevisionIDs(ids...)
+}
+
+// AddReactionIDs adds the "reactions" edge to the MessageReaction entity by IDs.
+func (mc *MessageCreate) AddReactionIDs(ids ...string) *MessageCreate {
+	mc.mutation.AddReactionIDs(ids...)
+	return mc
+}
+
+// AddReactions adds the "reactions" edges to the MessageReaction entity.
+func (mc *MessageCreate) AddReactions(m ...*MessageReaction) *MessageCreate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mc.AddReactionIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (mc *MessageCreate) AddAttachmentIDs(ids ...string) *MessageCreate {
+	mc.mutation.AddAttachmentIDs(ids...)
+	return mc
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (mc *MessageCreate) AddAttachments(a ...*Attachment) *MessageCreate {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return mc.AddAttachmentIDs(ids...)
+}
+
+// AddLinkPreviewIDs adds the "link_previews" edge to the LinkPreview entity by IDs.
+func (mc *MessageCreate) AddLinkPreviewIDs(ids ...string) *MessageCreate {
+	mc.mutation.AddLinkPreviewIDs(ids...)
+	return mc
+}
+
+// AddLinkPreviews adds the "link_previews" edges to the LinkPreview entity.
+func (mc *MessageCreate) AddLinkPreviews(l ...*LinkPreview) *MessageCreate {
+	ids := make([]string, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return mc.AddLinkPreviewIDs(ids...)
+}
+
+// AddMentionIDs adds the "mentions" edge to the MessageMention entity by IDs.
+func (mc *MessageCreate) AddMentionIDs(ids ...string) *MessageCreate {
+	mc.mutation.AddMentionIDs(ids...)
+	return mc
+}
+
+// AddMentions adds the "mentions" edges to the MessageMention entity.
+func (mc *MessageCreate) AddMentions(m ...*MessageMention) *MessageCreate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mc.AddMentionIDs(ids...)
+}
+
+// AddPinIDs adds the "pins" edge to the PinnedMessage entity by IDs.
+func (mc *MessageCreate) AddPinIDs(ids ...string) *MessageCreate {
+	mc.mutation.AddPinIDs(ids...)
+	return mc
+}
+
+// AddPins adds the "pins" edges to the PinnedMessage entity.
+func (mc *MessageCreate) AddPins(p ...*PinnedMessage) *MessageCreate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return mc.AddPinIDs(ids...)
+}
+
+// AddReceiptIDs adds the "receipts" edge to the MessageReceipt entity by IDs.
+func (mc *MessageCreate) AddReceiptIDs(ids ...string) *MessageCreate {
+	mc.mutation.AddReceiptIDs(ids...)
+	return mc
+}
+
+// AddReceipts adds the "receipts" edges to the MessageReceipt entity.
+func (mc *MessageCreate) AddReceipts(m ...*MessageReceipt) *MessageCreate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mc.AddReceiptIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (mc *MessageCreate) AddNotificationIDs(ids ...string) *MessageCreate {
+	mc.mutation.AddNotificationIDs(ids...)
+	return mc
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (mc *MessageCreate) AddNotifications(n ...*Notification) *MessageCreate {
+	ids := make([]string, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return mc.AddNotificationIDs(ids...)
+}
+
+// SetReplyTo sets the "reply_to" edge to the Message entity.
+func (mc *MessageCreate) SetReplyTo(m *Message) *MessageCreate {
+	return mc.SetReplyToID(m.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the Message entity by IDs.
+func (mc *MessageCreate) AddReplyIDs(ids ...string) *MessageCreate {
+	mc.mutation.AddReplyIDs(ids...)
+	return mc
+}
+
+// AddReplies adds the "replies" edges to the Message entity.
+func (mc *MessageCreate) AddReplies(m ...*Message) *MessageCreate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mc.AddReplyIDs(ids...)
+}
+
+// SetThreadRoot sets the "thread_root" edge to the Message entity.
+func (mc *MessageCreate) SetThreadRoot(m *Message) *MessageCreate {
+	return mc.SetThreadRootID(m.ID)
+}
+
+// AddThreadReplyIDs adds the "thread_replies" edge to the Message entity by IDs.
+func (mc *MessageCreate) AddThreadReplyIDs(ids ...string) *MessageCreate {
+	mc.mutation.AddThreadReplyIDs(ids...)
+	return mc
+}
+
+// AddThreadReplies adds the "thread_replies" edges to the Message entity.
+func (mc *MessageCreate) AddThreadReplies(m ...*Message) *MessageCreate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mc.AddThreadReplyIDs(ids...)
+}
+
+// AddThreadParticipantIDs adds the "thread_participants" edge to the ThreadParticipant entity by IDs.
+func (mc *MessageCreate) AddThreadParticipantIDs(ids ...string) *MessageCreate {
+	mc.mutation.AddThreadParticipantIDs(ids...)
+	return mc
+}
+
+// AddThreadParticipants adds the "thread_participants" edges to the ThreadParticipant entity.
+func (mc *MessageCreate) AddThreadParticipants(t ...*ThreadParticipant) *MessageCreate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return mc.AddThreadParticipantIDs(ids...)
+}
+
+// AddBookmarkIDs adds the "bookmarks" edge to the Bookmark entity by IDs.
+func (mc *MessageCreate) AddBookmarkIDs(ids ...string) *MessageCreate {
+	mc.mutation.AddBookmarkIDs(ids...)
+	return mc
+}
+
+// AddBookmarks adds the "bookmarks" edges to the Bookmark entity.
+func (mc *MessageCreate) AddBookmarks(b ...*Bookmark) *MessageCreate {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return mc.AddBookmarkIDs(ids...)
+}
+
+// AddHiddenForIDs adds the "hidden_for" edge to the HiddenMessage entity by IDs.
+func (mc *MessageCreate) AddHiddenForIDs(ids ...string) *MessageCreate {
+	mc.mutation.AddHiddenForIDs(ids...)
+	return mc
+}
+
+// AddHiddenFor adds the "hidden_for" edges to the HiddenMessage entity.
+func (mc *MessageCreate) AddHiddenFor(h ...*HiddenMessage) *MessageCreate {
+	ids := make([]string, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return mc.AddHiddenForIDs(ids...)
+}
+
 // Mutation returns the MessageMutation object of the builder.
 func (mc *MessageCreate) Mutation() *MessageMutation {
 	return mc.mutation
@@ -205,6 +573,14 @@ func (mc *MessageCreate) defaults() {
 		v := message.DefaultIsDeleted
 		mc.mutation.SetIsDeleted(v)
 	}
+	if _, ok := mc.mutation.ThreadReplyCount(); !ok {
+		v := message.DefaultThreadReplyCount
+		mc.mutation.SetThreadReplyCount(v)
+	}
+	if _, ok := mc.mutation.SuppressEmbeds(); !ok {
+		v := message.DefaultSuppressEmbeds
+		mc.mutation.SetSuppressEmbeds(v)
+	}
 	if _, ok := mc.mutation.ID(); !ok {
 		v := message.DefaultID()
 		mc.mutation.SetID(v)
@@ -254,6 +630,22 @@ func (mc *MessageCreate) check() error {
 	if _, ok := mc.mutation.IsDeleted(); !ok {
 		return &ValidationError{Name: "is_deleted", err: errors.New(`ent: missing required field "Message.is_deleted"`)}
 	}
+	if _, ok := mc.mutation.ThreadReplyCount(); !ok {
+		return &ValidationError{Name: "thread_reply_count", err: errors.New(`ent: missing required field "Message.thread_reply_count"`)}
+	}
+	if v, ok := mc.mutation.ThreadReplyCount(); ok {
+		if err := message.ThreadReplyCountValidator(v); err != nil {
+			return &ValidationError{Name: "thread_reply_count", err: fmt.Errorf(`ent: validator failed for field "Message.thread_reply_count": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.SuppressEmbeds(); !ok {
+		return &ValidationError{Name: "suppress_embeds", err: errors.New(`ent: missing required field "Message.suppress_embeds"`)}
+	}
+	if v, ok := mc.mutation.Nonce(); ok {
+		if err := message.NonceValidator(v); err != nil {
+			return &ValidationError{Name: "nonce", err: fmt.Errorf(`ent: validator failed for field "Message.nonce": %w`, err)}
+		}
+	}
 	if len(mc.mutation.ConversationIDs()) == 0 {
 		return &ValidationError{Name: "conversation", err: errors.New(`ent: missing required edge "Message.conversation"`)}
 	}
@@ -307,6 +699,14 @@ func (mc *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_spec.SetField(message.FieldContent, field.TypeString, value)
 		_node.Content = value
 	}
+	if value, ok := mc.mutation.PlainText(); ok {
+		_spec.SetField(message.FieldPlainText, field.TypeString, value)
+		_node.PlainText = value
+	}
+	if value, ok := mc.mutation.Entities(); ok {
+		_spec.SetField(message.FieldEntities, field.TypeJSON, value)
+		_node.Entities = value
+	}
 	if value, ok := mc.mutation.MessageType(); ok {
 		_spec.SetField(message.FieldMessageType, field.TypeEnum, value)
 		_node.MessageType = value
@@ -315,10 +715,34 @@ func (mc *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_spec.SetField(message.FieldIsDeleted, field.TypeBool, value)
 		_node.IsDeleted = value
 	}
+	if value, ok := mc.mutation.DeletedAt(); ok {
+		_spec.SetField(message.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
 	if value, ok := mc.mutation.EditedAt(); ok {
 		_spec.SetField(message.FieldEditedAt, field.TypeTime, value)
 		_node.EditedAt = value
 	}
+	if value, ok := mc.mutation.ThreadReplyCount(); ok {
+		_spec.SetField(message.FieldThreadReplyCount, field.TypeInt, value)
+		_node.ThreadReplyCount = value
+	}
+	if value, ok := mc.mutation.ThreadLastReplyAt(); ok {
+		_spec.SetField(message.FieldThreadLastReplyAt, field.TypeTime, value)
+		_node.ThreadLastReplyAt = value
+	}
+	if value, ok := mc.mutation.SuppressEmbeds(); ok {
+		_spec.SetField(message.FieldSuppressEmbeds, field.TypeBool, value)
+		_node.SuppressEmbeds = value
+	}
+	if value, ok := mc.mutation.ForwardedFromMessageID(); ok {
+		_spec.SetField(message.FieldForwardedFromMessageID, field.TypeString, value)
+		_node.ForwardedFromMessageID = value
+	}
+	if value, ok := mc.mutation.Nonce(); ok {
+		_spec.SetField(message.FieldNonce, field.TypeString, value)
+		_node.Nonce = value
+	}
 	if nodes := mc.mutation.ConversationIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -370,6 +794,265 @@ func (mc *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_node.CallID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := mc.mutation.ForwardedFromUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.ForwardedFromUserTable,
+			Columns: []string{message.ForwardedFromUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ForwardedFromUserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.AttachmentsTable,
+			Columns: []string{message.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.LinkPreviewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.LinkPreviewsTable,
+			Columns: []string{message.LinkPreviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkpreview.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.MentionsTable,
+			Columns: []string{message.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.PinsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.PinsTable,
+			Columns: []string{message.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.ReceiptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.ReceiptsTable,
+			Columns: []string{message.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereceipt.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.NotificationsTable,
+			Columns: []string{message.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.ReplyToIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.ReplyToTable,
+			Columns: []string{message.ReplyToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReplyToID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RepliesTable,
+			Columns: []string{message.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.ThreadRootIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.ThreadRootTable,
+			Columns: []string{message.ThreadRootColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ThreadRootID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.ThreadRepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ThreadRepliesTable,
+			Columns: []string{message.ThreadRepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.ThreadParticipantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.ThreadParticipantsTable,
+			Columns: []string{message.ThreadParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(threadparticipant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.BookmarksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.BookmarksTable,
+			Columns: []string{message.BookmarksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.HiddenForIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.HiddenForTable,
+			Columns: []string{message.HiddenForColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hiddenmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
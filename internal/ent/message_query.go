@@ -4,11 +4,23 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
+	"kakashi/chaos/internal/ent/attachment"
+	"kakashi/chaos/internal/ent/bookmark"
 	"kakashi/chaos/internal/ent/call"
 	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/hiddenmessage"
+	"kakashi/chaos/internal/ent/linkpreview"
 	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/messagemention"
+	"kakashi/chaos/internal/ent/messagereaction"
+	"kakashi/chaos/internal/ent/messagereceipt"
+	"kakashi/chaos/internal/ent/messagerevision"
+	"kakashi/chaos/internal/ent/notification"
+	"kakashi/chaos/internal/ent/pinnedmessage"
 	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/threadparticipant"
 	"kakashi/chaos/internal/ent/user"
 	"math"
 
@@ -21,14 +33,30 @@ import (
 // MessageQuery is the builder for querying Message entities.
 type MessageQuery struct {
 	config
-	ctx              *QueryContext
-	order            []message.OrderOption
-	inters           []Interceptor
-	predicates       []predicate.Message
-	withConversation *ConversationQuery
-	withSender       *UserQuery
-	withCall         *CallQuery
-	withFKs          bool
+	ctx                    *QueryContext
+	order                  []message.OrderOption
+	inters                 []Interceptor
+	predicates             []predicate.Message
+	withConversation       *ConversationQuery
+	withSender             *UserQuery
+	withCall               *CallQuery
+	withForwardedFromUser  *UserQuery
+	withRevisions          *MessageRevisionQuery
+	withReactions          *MessageReactionQuery
+	withAttachments        *AttachmentQuery
+	withLinkPreviews       *LinkPreviewQuery
+	withMentions           *MessageMentionQuery
+	withPins               *PinnedMessageQuery
+	withReceipts           *MessageReceiptQuery
+	withNotifications      *NotificationQuery
+	withReplyTo            *MessageQuery
+	withReplies            *MessageQuery
+	withThreadRoot         *MessageQuery
+	withThreadReplies      *MessageQuery
+	withThreadParticipants *ThreadParticipantQuery
+	withBookmarks          *BookmarkQuery
+	withHiddenFor          *HiddenMessageQuery
+	withFKs                bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -131,139 +159,491 @@ func (mq *MessageQuery) QueryCall() *CallQuery {
 	return query
 }
 
-// First returns the first Message entity from the query.
-// Returns a *NotFoundError when no Message was found.
-func (mq *MessageQuery) First(ctx context.Context) (*Message, error) {
-	nodes, err := mq.Limit(1).All(setContextOp(ctx, mq.ctx, ent.OpQueryFirst))
-	if err != nil {
-		return nil, err
-	}
-	if len(nodes) == 0 {
-		return nil, &NotFoundError{message.Label}
+// QueryForwardedFromUser chains the current query on the "forwarded_from_user" edge.
+func (mq *MessageQuery) QueryForwardedFromUser() *UserQuery {
+	query := (&UserClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, message.ForwardedFromUserTable, message.ForwardedFromUserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return nodes[0], nil
+	return query
 }
 
-// FirstX is like First, but panics if an error occurs.
-func (mq *MessageQuery) FirstX(ctx context.Context) *Message {
-	node, err := mq.First(ctx)
-	if err != nil && !IsNotFound(err) {
-		panic(err)
+// QueryRevisions chains the current query on the "revisions" edge.
+func (mq *MessageQuery) QueryRevisions() *MessageRevisionQuery {
+	query := (&MessageRevisionClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(messagerevision.Table, messagerevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.RevisionsTable, message.RevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return node
+	return query
 }
 
-// FirstID returns the first Message ID from the query.
-// Returns a *NotFoundError when no Message ID was found.
-func (mq *MessageQuery) FirstID(ctx context.Context) (id string, err error) {
-	var ids []string
-	if ids, err = mq.Limit(1).IDs(setContextOp(ctx, mq.ctx, ent.OpQueryFirstID)); err != nil {
-		return
-	}
-	if len(ids) == 0 {
-		err = &NotFoundError{message.Label}
-		return
+// QueryReactions chains the current query on the "reactions" edge.
+func (mq *MessageQuery) QueryReactions() *MessageReactionQuery {
+	query := (&MessageReactionClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(messagereaction.Table, messagereaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.ReactionsTable, message.ReactionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return ids[0], nil
+	return query
 }
 
-// FirstIDX is like FirstID, but panics if an error occurs.
-func (mq *MessageQuery) FirstIDX(ctx context.Context) string {
-	id, err := mq.FirstID(ctx)
-	if err != nil && !IsNotFound(err) {
-		panic(err)
+// QueryAttachments chains the current query on the "attachments" edge.
+func (mq *MessageQuery) QueryAttachments() *AttachmentQuery {
+	query := (&AttachmentClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(attachment.Table, attachment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.AttachmentsTable, message.AttachmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return id
+	return query
 }
 
-// Only returns a single Message entity found by the query, ensuring it only returns one.
-// Returns a *NotSingularError when more than one Message entity is found.
-// Returns a *NotFoundError when no Message entities are found.
-func (mq *MessageQuery) Only(ctx context.Context) (*Message, error) {
-	nodes, err := mq.Limit(2).All(setContextOp(ctx, mq.ctx, ent.OpQueryOnly))
-	if err != nil {
-		return nil, err
-	}
-	switch len(nodes) {
-	case 1:
-		return nodes[0], nil
-	case 0:
-		return nil, &NotFoundError{message.Label}
-	default:
-		return nil, &NotSingularError{message.Label}
+// QueryLinkPreviews chains the current query on the "link_previews" edge.
+func (mq *MessageQuery) QueryLinkPreviews() *LinkPreviewQuery {
+	query := (&LinkPreviewClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(linkpreview.Table, linkpreview.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.LinkPreviewsTable, message.LinkPreviewsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
 	}
+	return query
 }
 
-// OnlyX is like Only, but panics if an error occurs.
-func (mq *MessageQuery) OnlyX(ctx context.Context) *Message {
-	node, err := mq.Only(ctx)
-	if err != nil {
-		panic(err)
+// QueryMentions chains the current query on the "mentions" edge.
+func (mq *MessageQuery) QueryMentions() *MessageMentionQuery {
+	query := (&MessageMentionClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(messagemention.Table, messagemention.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.MentionsTable, message.MentionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return node
+	return query
 }
 
-// OnlyID is like Only, but returns the only Message ID in the query.
-// Returns a *NotSingularError when more than one Message ID is found.
-// Returns a *NotFoundError when no entities are found.
-func (mq *MessageQuery) OnlyID(ctx context.Context) (id string, err error) {
-	var ids []string
-	if ids, err = mq.Limit(2).IDs(setContextOp(ctx, mq.ctx, ent.OpQueryOnlyID)); err != nil {
-		return
-	}
-	switch len(ids) {
-	case 1:
-		id = ids[0]
-	case 0:
-		err = &NotFoundError{message.Label}
-	default:
-		err = &NotSingularError{message.Label}
+// QueryPins chains the current query on the "pins" edge.
+func (mq *MessageQuery) QueryPins() *PinnedMessageQuery {
+	query := (&PinnedMessageClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(pinnedmessage.Table, pinnedmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.PinsTable, message.PinsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return
+	return query
 }
 
-// OnlyIDX is like OnlyID, but panics if an error occurs.
-func (mq *MessageQuery) OnlyIDX(ctx context.Context) string {
-	id, err := mq.OnlyID(ctx)
-	if err != nil {
-		panic(err)
+// QueryReceipts chains the current query on the "receipts" edge.
+func (mq *MessageQuery) QueryReceipts() *MessageReceiptQuery {
+	query := (&MessageReceiptClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(messagereceipt.Table, messagereceipt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.ReceiptsTable, message.ReceiptsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return id
+	return query
 }
 
-// All executes the query and returns a list of Messages.
-func (mq *MessageQuery) All(ctx context.Context) ([]*Message, error) {
-	ctx = setContextOp(ctx, mq.ctx, ent.OpQueryAll)
-	if err := mq.prepareQuery(ctx); err != nil {
-		return nil, err
+// QueryNotifications chains the current query on the "notifications" edge.
+func (mq *MessageQuery) QueryNotifications() *NotificationQuery {
+	query := (&NotificationClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(notification.Table, notification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.NotificationsTable, message.NotificationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	qr := querierAll[[]*Message, *MessageQuery]()
-	return withInterceptors[[]*Message](ctx, mq, qr, mq.inters)
+	return query
 }
 
-// AllX is like All, but panics if an error occurs.
-func (mq *MessageQuery) AllX(ctx context.Context) []*Message {
-	nodes, err := mq.All(ctx)
-	if err != nil {
-		panic(err)
+// QueryReplyTo chains the current query on the "reply_to" edge.
+func (mq *MessageQuery) QueryReplyTo() *MessageQuery {
+	query := (&MessageClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, message.ReplyToTable, message.ReplyToColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return nodes
+	return query
 }
 
-// IDs executes the query and returns a list of Message IDs.
-func (mq *MessageQuery) IDs(ctx context.Context) (ids []string, err error) {
-	if mq.ctx.Unique == nil && mq.path != nil {
-		mq.Unique(true)
-	}
-	ctx = setContextOp(ctx, mq.ctx, ent.OpQueryIDs)
-	if err = mq.Select(message.FieldID).Scan(ctx, &ids); err != nil {
-		return nil, err
+// QueryReplies chains the current query on the "replies" edge.
+func (mq *MessageQuery) QueryReplies() *MessageQuery {
+	query := (&MessageClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.RepliesTable, message.RepliesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return ids, nil
+	return query
 }
 
-// IDsX is like IDs, but panics if an error occurs.
-func (mq *MessageQuery) IDsX(ctx context.Context) []string {
+// QueryThreadRoot chains the current query on the "thread_root" edge.
+func (mq *MessageQuery) QueryThreadRoot() *MessageQuery {
+	query := (&MessageClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, message.ThreadRootTable, message.ThreadRootColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryThreadReplies chains the current query on the "thread_replies" edge.
+func (mq *MessageQuery) QueryThreadReplies() *MessageQuery {
+	query := (&MessageClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.ThreadRepliesTable, message.ThreadRepliesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryThreadParticipants chains the current query on the "thread_participants" edge.
+func (mq *MessageQuery) QueryThreadParticipants() *ThreadParticipantQuery {
+	query := (&ThreadParticipantClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(threadparticipant.Table, threadparticipant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.ThreadParticipantsTable, message.ThreadParticipantsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBookmarks chains the current query on the "bookmarks" edge.
+func (mq *MessageQuery) QueryBookmarks() *BookmarkQuery {
+	query := (&BookmarkClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(bookmark.Table, bookmark.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.BookmarksTable, message.BookmarksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryHiddenFor chains the current query on the "hidden_for" edge.
+func (mq *MessageQuery) QueryHiddenFor() *HiddenMessageQuery {
+	query := (&HiddenMessageClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(hiddenmessage.Table, hiddenmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.HiddenForTable, message.HiddenForColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Message entity from the query.
+// Returns a *NotFoundError when no Message was found.
+func (mq *MessageQuery) First(ctx context.Context) (*Message, error) {
+	nodes, err := mq.Limit(1).All(setContextOp(ctx, mq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{message.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mq *MessageQuery) FirstX(ctx context.Context) *Message {
+	node, err := mq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Message ID from the query.
+// Returns a *NotFoundError when no Message ID was found.
+func (mq *MessageQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = mq.Limit(1).IDs(setContextOp(ctx, mq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{message.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mq *MessageQuery) FirstIDX(ctx context.Context) string {
+	id, err := mq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Message entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Message entity is found.
+// Returns a *NotFoundError when no Message entities are found.
+func (mq *MessageQuery) Only(ctx context.Context) (*Message, error) {
+	nodes, err := mq.Limit(2).All(setContextOp(ctx, mq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{message.Label}
+	default:
+		return nil, &NotSingularError{message.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mq *MessageQuery) OnlyX(ctx context.Context) *Message {
+	node, err := mq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Message ID in the query.
+// Returns a *NotSingularError when more than one Message ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mq *MessageQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = mq.Limit(2).IDs(setContextOp(ctx, mq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{message.Label}
+	default:
+		err = &NotSingularError{message.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mq *MessageQuery) OnlyIDX(ctx context.Context) string {
+	id, err := mq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Messages.
+func (mq *MessageQuery) All(ctx context.Context) ([]*Message, error) {
+	ctx = setContextOp(ctx, mq.ctx, ent.OpQueryAll)
+	if err := mq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Message, *MessageQuery]()
+	return withInterceptors[[]*Message](ctx, mq, qr, mq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mq *MessageQuery) AllX(ctx context.Context) []*Message {
+	nodes, err := mq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Message IDs.
+func (mq *MessageQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if mq.ctx.Unique == nil && mq.path != nil {
+		mq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mq.ctx, ent.OpQueryIDs)
+	if err = mq.Select(message.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mq *MessageQuery) IDsX(ctx context.Context) []string {
 	ids, err := mq.IDs(ctx)
 	if err != nil {
 		panic(err)
@@ -318,14 +698,30 @@ func (mq *MessageQuery) Clone() *MessageQuery {
 		return nil
 	}
 	return &MessageQuery{
-		config:           mq.config,
-		ctx:              mq.ctx.Clone(),
-		order:            append([]message.OrderOption{}, mq.order...),
-		inters:           append([]Interceptor{}, mq.inters...),
-		predicates:       append([]predicate.Message{}, mq.predicates...),
-		withConversation: mq.withConversation.Clone(),
-		withSender:       mq.withSender.Clone(),
-		withCall:         mq.withCall.Clone(),
+		config:                 mq.config,
+		ctx:                    mq.ctx.Clone(),
+		order:                  append([]message.OrderOption{}, mq.order...),
+		inters:                 append([]Interceptor{}, mq.inters...),
+		predicates:             append([]predicate.Message{}, mq.predicates...),
+		withConversation:       mq.withConversation.Clone(),
+		withSender:             mq.withSender.Clone(),
+		withCall:               mq.withCall.Clone(),
+		withForwardedFromUser:  mq.withForwardedFromUser.Clone(),
+		withRevisions:          mq.withRevisions.Clone(),
+		withReactions:          mq.withReactions.Clone(),
+		withAttachments:        mq.withAttachments.Clone(),
+		withLinkPreviews:       mq.withLinkPreviews.Clone(),
+		withMentions:           mq.withMentions.Clone(),
+		withPins:               mq.withPins.Clone(),
+		withReceipts:           mq.withReceipts.Clone(),
+		withNotifications:      mq.withNotifications.Clone(),
+		withReplyTo:            mq.withReplyTo.Clone(),
+		withReplies:            mq.withReplies.Clone(),
+		withThreadRoot:         mq.withThreadRoot.Clone(),
+		withThreadReplies:      mq.withThreadReplies.Clone(),
+		withThreadParticipants: mq.withThreadParticipants.Clone(),
+		withBookmarks:          mq.withBookmarks.Clone(),
+		withHiddenFor:          mq.withHiddenFor.Clone(),
 		// clone intermediate query.
 		sql:  mq.sql.Clone(),
 		path: mq.path,
@@ -350,153 +746,812 @@ func (mq *MessageQuery) WithSender(opts ...func(*UserQuery)) *MessageQuery {
 	for _, opt := range opts {
 		opt(query)
 	}
-	mq.withSender = query
-	return mq
+	mq.withSender = query
+	return mq
+}
+
+// WithCall tells the query-builder to eager-load the nodes that are connected to
+// the "call" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MessageQuery) WithCall(opts ...func(*CallQuery)) *MessageQuery {
+	query := (&CallClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withCall = query
+	return mq
+}
+
+// WithForwardedFromUser tells the query-builder to eager-load the nodes that are connected to
+// the "forwarded_from_user" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MessageQuery) WithForwardedFromUser(opts ...func(*UserQuery)) *MessageQuery {
+	query := (&UserClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withForwardedFromUser = query
+	return mq
+}
+
+// WithRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MessageQuery) WithRevisions(opts ...func(*MessageRevisionQuery)) *MessageQuery {
+	query := (&MessageRevisionClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withRevisions = query
+	return mq
+}
+
+// WithReactions tells the query-builder to eager-load the nodes that are connected to
+// the "reactions" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MessageQuery) WithReactions(opts ...func(*MessageReactionQuery)) *MessageQuery {
+	query := (&MessageReactionClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withReactions = query
+	return mq
+}
+
+// WithAttachments tells the query-builder to eager-load the nodes that are connected to
+// the "attachments" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MessageQuery) WithAttachments(opts ...func(*AttachmentQuery)) *MessageQuery {
+	query := (&AttachmentClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withAttachments = query
+	return mq
+}
+
+// WithLinkPreviews tells the query-builder to eager-load the nodes that are connected to
+// the "link_previews" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MessageQuery) WithLinkPreviews(opts ...func(*LinkPreviewQuery)) *MessageQuery {
+	query := (&LinkPreviewClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withLinkPreviews = query
+	return mq
+}
+
+// WithMentions tells the query-builder to eager-load the nodes that are connected to
+// the "mentions" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MessageQuery) WithMentions(opts ...func(*MessageMentionQuery)) *MessageQuery {
+	query := (&MessageMentionClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withMentions = query
+	return mq
+}
+
+// WithPins tells the query-builder to eager-load the nodes that are connected to
+// the "pins" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MessageQuery) WithPins(opts ...func(*PinnedMessageQuery)) *MessageQuery {
+	query := (&PinnedMessageClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withPins = query
+	return mq
+}
+
+// WithReceipts tells the query-builder to eager-load the nodes that are connected to
+// the "receipts" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MessageQuery) WithReceipts(opts ...func(*MessageReceiptQuery)) *MessageQuery {
+	query := (&MessageReceiptClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withReceipts = query
+	return mq
+}
+
+// WithNotifications tells the query-builder to eager-load the nodes that are connected to
+// the "notifications" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MessageQuery) WithNotifications(opts ...func(*NotificationQuery)) *MessageQuery {
+	query := (&NotificationClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withNotifications = query
+	return mq
+}
+
+// WithReplyTo tells the query-builder to eager-load the nodes that are connected to
+// the "reply_to" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MessageQuery) WithReplyTo(opts ...func(*MessageQuery)) *MessageQuery {
+	query := (&MessageClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withReplyTo = query
+	return mq
+}
+
+// WithReplies tells the query-builder to eager-load the nodes that are connected to
+// the "replies" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MessageQuery) WithReplies(opts ...func(*MessageQuery)) *MessageQuery {
+	query := (&MessageClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withReplies = query
+	return mq
+}
+
+// WithThreadRoot tells the query-builder to eager-load the nodes that are connected to
+// the "thread_root" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MessageQuery) WithThreadRoot(opts ...func(*MessageQuery)) *MessageQuery {
+	query := (&MessageClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withThreadRoot = query
+	return mq
+}
+
+// WithThreadReplies tells the query-builder to eager-load the nodes that are connected to
+// the "thread_replies" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MessageQuery) WithThreadReplies(opts ...func(*MessageQuery)) *MessageQuery {
+	query := (&MessageClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withThreadReplies = query
+	return mq
+}
+
+// WithThreadParticipants tells the query-builder to eager-load the nodes that are connected to
+// the "thread_participants" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MessageQuery) WithThreadParticipants(opts ...func(*ThreadParticipantQuery)) *MessageQuery {
+	query := (&ThreadParticipantClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withThreadParticipants = query
+	return mq
+}
+
+// WithBookmarks tells the query-builder to eager-load the nodes that are connected to
+// the "bookmarks" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MessageQuery) WithBookmarks(opts ...func(*BookmarkQuery)) *MessageQuery {
+	query := (&BookmarkClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withBookmarks = query
+	return mq
+}
+
+// WithHiddenFor tells the query-builder to eager-load the nodes that are connected to
+// the "hidden_for" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MessageQuery) WithHiddenFor(opts ...func(*HiddenMessageQuery)) *MessageQuery {
+	query := (&HiddenMessageClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withHiddenFor = query
+	return mq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Message.Query().
+//		GroupBy(message.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mq *MessageQuery) GroupBy(field string, fields ...string) *MessageGroupBy {
+	mq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MessageGroupBy{build: mq}
+	grbuild.flds = &mq.ctx.Fields
+	grbuild.label = message.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.Message.Query().
+//		Select(message.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (mq *MessageQuery) Select(fields ...string) *MessageSelect {
+	mq.ctx.Fields = append(mq.ctx.Fields, fields...)
+	sbuild := &MessageSelect{MessageQuery: mq}
+	sbuild.label = message.Label
+	sbuild.flds, sbuild.scan = &mq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MessageSelect configured with the given aggregations.
+func (mq *MessageQuery) Aggregate(fns ...AggregateFunc) *MessageSelect {
+	return mq.Select().Aggregate(fns...)
+}
+
+func (mq *MessageQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mq.ctx.Fields {
+		if !message.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mq.path != nil {
+		prev, err := mq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mq.sql = prev
+	}
+	return nil
+}
+
+func (mq *MessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Message, error) {
+	var (
+		nodes       = []*Message{}
+		withFKs     = mq.withFKs
+		_spec       = mq.querySpec()
+		loadedTypes = [19]bool{
+			mq.withConversation != nil,
+			mq.withSender != nil,
+			mq.withCall != nil,
+			mq.withForwardedFromUser != nil,
+			mq.withRevisions != nil,
+			mq.withReactions != nil,
+			mq.withAttachments != nil,
+			mq.withLinkPreviews != nil,
+			mq.withMentions != nil,
+			mq.withPins != nil,
+			mq.withReceipts != nil,
+			mq.withNotifications != nil,
+			mq.withReplyTo != nil,
+			mq.withReplies != nil,
+			mq.withThreadRoot != nil,
+			mq.withThreadReplies != nil,
+			mq.withThreadParticipants != nil,
+			mq.withBookmarks != nil,
+			mq.withHiddenFor != nil,
+		}
+	)
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, message.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Message).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Message{config: mq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := mq.withConversation; query != nil {
+		if err := mq.loadConversation(ctx, query, nodes, nil,
+			func(n *Message, e *Conversation) { n.Edges.Conversation = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withSender; query != nil {
+		if err := mq.loadSender(ctx, query, nodes, nil,
+			func(n *Message, e *User) { n.Edges.Sender = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withCall; query != nil {
+		if err := mq.loadCall(ctx, query, nodes, nil,
+			func(n *Message, e *Call) { n.Edges.Call = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withForwardedFromUser; query != nil {
+		if err := mq.loadForwardedFromUser(ctx, query, nodes, nil,
+			func(n *Message, e *User) { n.Edges.ForwardedFromUser = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withRevisions; query != nil {
+		if err := mq.loadRevisions(ctx, query, nodes,
+			func(n *Message) { n.Edges.Revisions = []*MessageRevision{} },
+			func(n *Message, e *MessageRevision) { n.Edges.Revisions = append(n.Edges.Revisions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withReactions; query != nil {
+		if err := mq.loadReactions(ctx, query, nodes,
+			func(n *Message) { n.Edges.Reactions = []*MessageReaction{} },
+			func(n *Message, e *MessageReaction) { n.Edges.Reactions = append(n.Edges.Reactions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withAttachments; query != nil {
+		if err := mq.loadAttachments(ctx, query, nodes,
+			func(n *Message) { n.Edges.Attachments = []*Attachment{} },
+			func(n *Message, e *Attachment) { n.Edges.Attachments = append(n.Edges.Attachments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withLinkPreviews; query != nil {
+		if err := mq.loadLinkPreviews(ctx, query, nodes,
+			func(n *Message) { n.Edges.LinkPreviews = []*LinkPreview{} },
+			func(n *Message, e *LinkPreview) { n.Edges.LinkPreviews = append(n.Edges.LinkPreviews, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withMentions; query != nil {
+		if err := mq.loadMentions(ctx, query, nodes,
+			func(n *Message) { n.Edges.Mentions = []*MessageMention{} },
+			func(n *Message, e *MessageMention) { n.Edges.Mentions = append(n.Edges.Mentions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withPins; query != nil {
+		if err := mq.loadPins(ctx, query, nodes,
+			func(n *Message) { n.Edges.Pins = []*PinnedMessage{} },
+			func(n *Message, e *PinnedMessage) { n.Edges.Pins = append(n.Edges.Pins, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withReceipts; query != nil {
+		if err := mq.loadReceipts(ctx, query, nodes,
+			func(n *Message) { n.Edges.Receipts = []*MessageReceipt{} },
+			func(n *Message, e *MessageReceipt) { n.Edges.Receipts = append(n.Edges.Receipts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withNotifications; query != nil {
+		if err := mq.loadNotifications(ctx, query, nodes,
+			func(n *Message) { n.Edges.Notifications = []*Notification{} },
+			func(n *Message, e *Notification) { n.Edges.Notifications = append(n.Edges.Notifications, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withReplyTo; query != nil {
+		if err := mq.loadReplyTo(ctx, query, nodes, nil,
+			func(n *Message, e *Message) { n.Edges.ReplyTo = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withReplies; query != nil {
+		if err := mq.loadReplies(ctx, query, nodes,
+			func(n *Message) { n.Edges.Replies = []*Message{} },
+			func(n *Message, e *Message) { n.Edges.Replies = append(n.Edges.Replies, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withThreadRoot; query != nil {
+		if err := mq.loadThreadRoot(ctx, query, nodes, nil,
+			func(n *Message, e *Message) { n.Edges.ThreadRoot = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withThreadReplies; query != nil {
+		if err := mq.loadThreadReplies(ctx, query, nodes,
+			func(n *Message) { n.Edges.ThreadReplies = []*Message{} },
+			func(n *Message, e *Message) { n.Edges.ThreadReplies = append(n.Edges.ThreadReplies, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withThreadParticipants; query != nil {
+		if err := mq.loadThreadParticipants(ctx, query, nodes,
+			func(n *Message) { n.Edges.ThreadParticipants = []*ThreadParticipant{} },
+			func(n *Message, e *ThreadParticipant) {
+				n.Edges.ThreadParticipants = append(n.Edges.ThreadParticipants, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withBookmarks; query != nil {
+		if err := mq.loadBookmarks(ctx, query, nodes,
+			func(n *Message) { n.Edges.Bookmarks = []*Bookmark{} },
+			func(n *Message, e *Bookmark) { n.Edges.Bookmarks = append(n.Edges.Bookmarks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withHiddenFor; query != nil {
+		if err := mq.loadHiddenFor(ctx, query, nodes,
+			func(n *Message) { n.Edges.HiddenFor = []*HiddenMessage{} },
+			func(n *Message, e *HiddenMessage) { n.Edges.HiddenFor = append(n.Edges.HiddenFor, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (mq *MessageQuery) loadConversation(ctx context.Context, query *ConversationQuery, nodes []*Message, init func(*Message), assign func(*Message, *Conversation)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Message)
+	for i := range nodes {
+		fk := nodes[i].ConversationID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(conversation.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "conversation_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (mq *MessageQuery) loadSender(ctx context.Context, query *UserQuery, nodes []*Message, init func(*Message), assign func(*Message, *User)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Message)
+	for i := range nodes {
+		fk := nodes[i].SenderID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "sender_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (mq *MessageQuery) loadCall(ctx context.Context, query *CallQuery, nodes []*Message, init func(*Message), assign func(*Message, *Call)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Message)
+	for i := range nodes {
+		fk := nodes[i].CallID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(call.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "call_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (mq *MessageQuery) loadForwardedFromUser(ctx context.Context, query *UserQuery, nodes []*Message, init func(*Message), assign func(*Message, *User)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Message)
+	for i := range nodes {
+		fk := nodes[i].ForwardedFromUserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "forwarded_from_user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (mq *MessageQuery) loadRevisions(ctx context.Context, query *MessageRevisionQuery, nodes []*Message, init func(*Message), assign func(*Message, *MessageRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Message)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(messagerevision.FieldMessageID)
+	}
+	query.Where(predicate.MessageRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.RevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MessageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "message_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (mq *MessageQuery) loadReactions(ctx context.Context, query *MessageReactionQuery, nodes []*Message, init func(*Message), assign func(*Message, *MessageReaction)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Message)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(messagereaction.FieldMessageID)
+	}
+	query.Where(predicate.MessageReaction(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.ReactionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MessageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "message_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
 }
-
-// WithCall tells the query-builder to eager-load the nodes that are connected to
-// the "call" edge. The optional arguments are used to configure the query builder of the edge.
-func (mq *MessageQuery) WithCall(opts ...func(*CallQuery)) *MessageQuery {
-	query := (&CallClient{config: mq.config}).Query()
-	for _, opt := range opts {
-		opt(query)
+func (mq *MessageQuery) loadAttachments(ctx context.Context, query *AttachmentQuery, nodes []*Message, init func(*Message), assign func(*Message, *Attachment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Message)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
 	}
-	mq.withCall = query
-	return mq
-}
-
-// GroupBy is used to group vertices by one or more fields/columns.
-// It is often used with aggregate functions, like: count, max, mean, min, sum.
-//
-// Example:
-//
-//	var v []struct {
-//		CreatedAt time.Time `json:"created_at,omitempty"`
-//		Count int `json:"count,omitempty"`
-//	}
-//
-//	client.Message.Query().
-//		GroupBy(message.FieldCreatedAt).
-//		Aggregate(ent.Count()).
-//		Scan(ctx, &v)
-func (mq *MessageQuery) GroupBy(field string, fields ...string) *MessageGroupBy {
-	mq.ctx.Fields = append([]string{field}, fields...)
-	grbuild := &MessageGroupBy{build: mq}
-	grbuild.flds = &mq.ctx.Fields
-	grbuild.label = message.Label
-	grbuild.scan = grbuild.Scan
-	return grbuild
-}
-
-// Select allows the selection one or more fields/columns for the given query,
-// instead of selecting all fields in the entity.
-//
-// Example:
-//
-//	var v []struct {
-//		CreatedAt time.Time `json:"created_at,omitempty"`
-//	}
-//
-//	client.Message.Query().
-//		Select(message.FieldCreatedAt).
-//		Scan(ctx, &v)
-func (mq *MessageQuery) Select(fields ...string) *MessageSelect {
-	mq.ctx.Fields = append(mq.ctx.Fields, fields...)
-	sbuild := &MessageSelect{MessageQuery: mq}
-	sbuild.label = message.Label
-	sbuild.flds, sbuild.scan = &mq.ctx.Fields, sbuild.Scan
-	return sbuild
-}
-
-// Aggregate returns a MessageSelect configured with the given aggregations.
-func (mq *MessageQuery) Aggregate(fns ...AggregateFunc) *MessageSelect {
-	return mq.Select().Aggregate(fns...)
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(attachment.FieldMessageID)
+	}
+	query.Where(predicate.Attachment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.AttachmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MessageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "message_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
 }
-
-func (mq *MessageQuery) prepareQuery(ctx context.Context) error {
-	for _, inter := range mq.inters {
-		if inter == nil {
-			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+func (mq *MessageQuery) loadLinkPreviews(ctx context.Context, query *LinkPreviewQuery, nodes []*Message, init func(*Message), assign func(*Message, *LinkPreview)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Message)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
 		}
-		if trv, ok := inter.(Traverser); ok {
-			if err := trv.Traverse(ctx, mq); err != nil {
-				return err
-			}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(linkpreview.FieldMessageID)
+	}
+	query.Where(predicate.LinkPreview(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.LinkPreviewsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MessageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "message_id" returned %v for node %v`, fk, n.ID)
 		}
+		assign(node, n)
 	}
-	for _, f := range mq.ctx.Fields {
-		if !message.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+	return nil
+}
+func (mq *MessageQuery) loadMentions(ctx context.Context, query *MessageMentionQuery, nodes []*Message, init func(*Message), assign func(*Message, *MessageMention)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Message)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
 		}
 	}
-	if mq.path != nil {
-		prev, err := mq.path(ctx)
-		if err != nil {
-			return err
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(messagemention.FieldMessageID)
+	}
+	query.Where(predicate.MessageMention(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.MentionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MessageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "message_id" returned %v for node %v`, fk, n.ID)
 		}
-		mq.sql = prev
+		assign(node, n)
 	}
 	return nil
 }
-
-func (mq *MessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Message, error) {
-	var (
-		nodes       = []*Message{}
-		withFKs     = mq.withFKs
-		_spec       = mq.querySpec()
-		loadedTypes = [3]bool{
-			mq.withConversation != nil,
-			mq.withSender != nil,
-			mq.withCall != nil,
+func (mq *MessageQuery) loadPins(ctx context.Context, query *PinnedMessageQuery, nodes []*Message, init func(*Message), assign func(*Message, *PinnedMessage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Message)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
 		}
-	)
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, message.ForeignKeys...)
 	}
-	_spec.ScanValues = func(columns []string) ([]any, error) {
-		return (*Message).scanValues(nil, columns)
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(pinnedmessage.FieldMessageID)
 	}
-	_spec.Assign = func(columns []string, values []any) error {
-		node := &Message{config: mq.config}
-		nodes = append(nodes, node)
-		node.Edges.loadedTypes = loadedTypes
-		return node.assignValues(columns, values)
+	query.Where(predicate.PinnedMessage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.PinsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
 	}
-	for i := range hooks {
-		hooks[i](ctx, _spec)
+	for _, n := range neighbors {
+		fk := n.MessageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "message_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
 	}
-	if err := sqlgraph.QueryNodes(ctx, mq.driver, _spec); err != nil {
-		return nil, err
+	return nil
+}
+func (mq *MessageQuery) loadReceipts(ctx context.Context, query *MessageReceiptQuery, nodes []*Message, init func(*Message), assign func(*Message, *MessageReceipt)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Message)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
 	}
-	if len(nodes) == 0 {
-		return nodes, nil
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(messagereceipt.FieldMessageID)
 	}
-	if query := mq.withConversation; query != nil {
-		if err := mq.loadConversation(ctx, query, nodes, nil,
-			func(n *Message, e *Conversation) { n.Edges.Conversation = e }); err != nil {
-			return nil, err
+	query.Where(predicate.MessageReceipt(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.ReceiptsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MessageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "message_id" returned %v for node %v`, fk, n.ID)
 		}
+		assign(node, n)
 	}
-	if query := mq.withSender; query != nil {
-		if err := mq.loadSender(ctx, query, nodes, nil,
-			func(n *Message, e *User) { n.Edges.Sender = e }); err != nil {
-			return nil, err
+	return nil
+}
+func (mq *MessageQuery) loadNotifications(ctx context.Context, query *NotificationQuery, nodes []*Message, init func(*Message), assign func(*Message, *Notification)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Message)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
 		}
 	}
-	if query := mq.withCall; query != nil {
-		if err := mq.loadCall(ctx, query, nodes, nil,
-			func(n *Message, e *Call) { n.Edges.Call = e }); err != nil {
-			return nil, err
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(notification.FieldRelatedMessageID)
+	}
+	query.Where(predicate.Notification(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.NotificationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RelatedMessageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "related_message_id" returned %v for node %v`, fk, n.ID)
 		}
+		assign(node, n)
 	}
-	return nodes, nil
+	return nil
 }
-
-func (mq *MessageQuery) loadConversation(ctx context.Context, query *ConversationQuery, nodes []*Message, init func(*Message), assign func(*Message, *Conversation)) error {
+func (mq *MessageQuery) loadReplyTo(ctx context.Context, query *MessageQuery, nodes []*Message, init func(*Message), assign func(*Message, *Message)) error {
 	ids := make([]string, 0, len(nodes))
 	nodeids := make(map[string][]*Message)
 	for i := range nodes {
-		fk := nodes[i].ConversationID
+		fk := nodes[i].ReplyToID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -505,7 +1560,7 @@ func (mq *MessageQuery) loadConversation(ctx context.Context, query *Conversatio
 	if len(ids) == 0 {
 		return nil
 	}
-	query.Where(conversation.IDIn(ids...))
+	query.Where(message.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
@@ -513,7 +1568,7 @@ func (mq *MessageQuery) loadConversation(ctx context.Context, query *Conversatio
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "conversation_id" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "reply_to_id" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -521,11 +1576,42 @@ func (mq *MessageQuery) loadConversation(ctx context.Context, query *Conversatio
 	}
 	return nil
 }
-func (mq *MessageQuery) loadSender(ctx context.Context, query *UserQuery, nodes []*Message, init func(*Message), assign func(*Message, *User)) error {
+func (mq *MessageQuery) loadReplies(ctx context.Context, query *MessageQuery, nodes []*Message, init func(*Message), assign func(*Message, *Message)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Message)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(message.FieldReplyToID)
+	}
+	query.Where(predicate.Message(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.RepliesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ReplyToID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "reply_to_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (mq *MessageQuery) loadThreadRoot(ctx context.Context, query *MessageQuery, nodes []*Message, init func(*Message), assign func(*Message, *Message)) error {
 	ids := make([]string, 0, len(nodes))
 	nodeids := make(map[string][]*Message)
 	for i := range nodes {
-		fk := nodes[i].SenderID
+		fk := nodes[i].ThreadRootID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -534,7 +1620,7 @@ func (mq *MessageQuery) loadSender(ctx context.Context, query *UserQuery, nodes
 	if len(ids) == 0 {
 		return nil
 	}
-	query.Where(user.IDIn(ids...))
+	query.Where(message.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
@@ -542,7 +1628,7 @@ func (mq *MessageQuery) loadSender(ctx context.Context, query *UserQuery, nodes
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "sender_id" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "thread_root_id" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -550,32 +1636,124 @@ func (mq *MessageQuery) loadSender(ctx context.Context, query *UserQuery, nodes
 	}
 	return nil
 }
-func (mq *MessageQuery) loadCall(ctx context.Context, query *CallQuery, nodes []*Message, init func(*Message), assign func(*Message, *Call)) error {
-	ids := make([]string, 0, len(nodes))
-	nodeids := make(map[string][]*Message)
+func (mq *MessageQuery) loadThreadReplies(ctx context.Context, query *MessageQuery, nodes []*Message, init func(*Message), assign func(*Message, *Message)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Message)
 	for i := range nodes {
-		fk := nodes[i].CallID
-		if _, ok := nodeids[fk]; !ok {
-			ids = append(ids, fk)
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
 		}
-		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	if len(ids) == 0 {
-		return nil
+	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(message.FieldThreadRootID)
 	}
-	query.Where(call.IDIn(ids...))
+	query.Where(predicate.Message(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.ThreadRepliesColumn), fks...))
+	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		nodes, ok := nodeids[n.ID]
+		fk := n.ThreadRootID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "call_id" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "thread_root_id" returned %v for node %v`, fk, n.ID)
 		}
-		for i := range nodes {
-			assign(nodes[i], n)
+		assign(node, n)
+	}
+	return nil
+}
+func (mq *MessageQuery) loadThreadParticipants(ctx context.Context, query *ThreadParticipantQuery, nodes []*Message, init func(*Message), assign func(*Message, *ThreadParticipant)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Message)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(threadparticipant.FieldRootMessageID)
+	}
+	query.Where(predicate.ThreadParticipant(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.ThreadParticipantsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RootMessageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "root_message_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (mq *MessageQuery) loadBookmarks(ctx context.Context, query *BookmarkQuery, nodes []*Message, init func(*Message), assign func(*Message, *Bookmark)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Message)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(bookmark.FieldMessageID)
+	}
+	query.Where(predicate.Bookmark(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.BookmarksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MessageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "message_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (mq *MessageQuery) loadHiddenFor(ctx context.Context, query *HiddenMessageQuery, nodes []*Message, init func(*Message), assign func(*Message, *HiddenMessage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Message)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(hiddenmessage.FieldMessageID)
+	}
+	query.Where(predicate.HiddenMessage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.HiddenForColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MessageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "message_id" returned %v for node %v`, fk, n.ID)
 		}
+		assign(node, n)
 	}
 	return nil
 }
@@ -614,6 +1792,15 @@ func (mq *MessageQuery) querySpec() *sqlgraph.QuerySpec {
 		if mq.withCall != nil {
 			_spec.Node.AddColumnOnce(message.FieldCallID)
 		}
+		if mq.withForwardedFromUser != nil {
+			_spec.Node.AddColumnOnce(message.FieldForwardedFromUserID)
+		}
+		if mq.withReplyTo != nil {
+			_spec.Node.AddColumnOnce(message.FieldReplyToID)
+		}
+		if mq.withThreadRoot != nil {
+			_spec.Node.AddColumnOnce(message.FieldThreadRootID)
+		}
 	}
 	if ps := mq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -6,15 +6,28 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"kakashi/chaos/internal/ent/attachment"
+	"kakashi/chaos/internal/ent/bookmark"
 	"kakashi/chaos/internal/ent/call"
 	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/hiddenmessage"
+	"kakashi/chaos/internal/ent/linkpreview"
 	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/messagemention"
+	"kakashi/chaos/internal/ent/messagereaction"
+	"kakashi/chaos/internal/ent/messagereceipt"
+	"kakashi/chaos/internal/ent/messagerevision"
+	"kakashi/chaos/internal/ent/notification"
+	"kakashi/chaos/internal/ent/pinnedmessage"
 	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/threadparticipant"
 	"kakashi/chaos/internal/ent/user"
+	"kakashi/chaos/internal/richtext"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -93,6 +106,44 @@ func (mu *MessageUpdate) SetNillableContent(s *string) *MessageUpdate {
 	return mu
 }
 
+// SetPlainText sets the "plain_text" field.
+func (mu *MessageUpdate) SetPlainText(s string) *MessageUpdate {
+	mu.mutation.SetPlainText(s)
+	return mu
+}
+
+// SetNillablePlainText sets the "plain_text" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillablePlainText(s *string) *MessageUpdate {
+	if s != nil {
+		mu.SetPlainText(*s)
+	}
+	return mu
+}
+
+// ClearPlainText clears the value of the "plain_text" field.
+func (mu *MessageUpdate) ClearPlainText() *MessageUpdate {
+	mu.mutation.ClearPlainText()
+	return mu
+}
+
+// SetEntities sets the "entities" field.
+func (mu *MessageUpdate) SetEntities(r []richtext.Entity) *MessageUpdate {
+	mu.mutation.SetEntities(r)
+	return mu
+}
+
+// AppendEntities appends r to the "entities" field.
+func (mu *MessageUpdate) AppendEntities(r []richtext.Entity) *MessageUpdate {
+	mu.mutation.AppendEntities(r)
+	return mu
+}
+
+// ClearEntities clears the value of the "entities" field.
+func (mu *MessageUpdate) ClearEntities() *MessageUpdate {
+	mu.mutation.ClearEntities()
+	return mu
+}
+
 // SetMessageType sets the "message_type" field.
 func (mu *MessageUpdate) SetMessageType(mt message.MessageType) *MessageUpdate {
 	mu.mutation.SetMessageType(mt)
@@ -121,6 +172,26 @@ func (mu *MessageUpdate) SetNillableIsDeleted(b *bool) *MessageUpdate {
 	return mu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (mu *MessageUpdate) SetDeletedAt(t time.Time) *MessageUpdate {
+	mu.mutation.SetDeletedAt(t)
+	return mu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableDeletedAt(t *time.Time) *MessageUpdate {
+	if t != nil {
+		mu.SetDeletedAt(*t)
+	}
+	return mu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (mu *MessageUpdate) ClearDeletedAt() *MessageUpdate {
+	mu.mutation.ClearDeletedAt()
+	return mu
+}
+
 // SetEditedAt sets the "edited_at" field.
 func (mu *MessageUpdate) SetEditedAt(t time.Time) *MessageUpdate {
 	mu.mutation.SetEditedAt(t)
@@ -161,6 +232,161 @@ func (mu *MessageUpdate) ClearCallID() *MessageUpdate {
 	return mu
 }
 
+// SetReplyToID sets the "reply_to_id" field.
+func (mu *MessageUpdate) SetReplyToID(s string) *MessageUpdate {
+	mu.mutation.SetReplyToID(s)
+	return mu
+}
+
+// SetNillableReplyToID sets the "reply_to_id" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableReplyToID(s *string) *MessageUpdate {
+	if s != nil {
+		mu.SetReplyToID(*s)
+	}
+	return mu
+}
+
+// ClearReplyToID clears the value of the "reply_to_id" field.
+func (mu *MessageUpdate) ClearReplyToID() *MessageUpdate {
+	mu.mutation.ClearReplyToID()
+	return mu
+}
+
+// SetThreadRootID sets the "thread_root_id" field.
+func (mu *MessageUpdate) SetThreadRootID(s string) *MessageUpdate {
+	mu.mutation.SetThreadRootID(s)
+	return mu
+}
+
+// SetNillableThreadRootID sets the "thread_root_id" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableThreadRootID(s *string) *MessageUpdate {
+	if s != nil {
+		mu.SetThreadRootID(*s)
+	}
+	return mu
+}
+
+// ClearThreadRootID clears the value of the "thread_root_id" field.
+func (mu *MessageUpdate) ClearThreadRootID() *MessageUpdate {
+	mu.mutation.ClearThreadRootID()
+	return mu
+}
+
+// SetThreadReplyCount sets the "thread_reply_count" field.
+func (mu *MessageUpdate) SetThreadReplyCount(i int) *MessageUpdate {
+	mu.mutation.ResetThreadReplyCount()
+	mu.mutation.SetThreadReplyCount(i)
+	return mu
+}
+
+// SetNillableThreadReplyCount sets the "thread_reply_count" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableThreadReplyCount(i *int) *MessageUpdate {
+	if i != nil {
+		mu.SetThreadReplyCount(*i)
+	}
+	return mu
+}
+
+// AddThreadReplyCount adds i to the "thread_reply_count" field.
+func (mu *MessageUpdate) AddThreadReplyCount(i int) *MessageUpdate {
+	mu.mutation.AddThreadReplyCount(i)
+	return mu
+}
+
+// SetThreadLastReplyAt sets the "thread_last_reply_at" field.
+func (mu *MessageUpdate) SetThreadLastReplyAt(t time.Time) *MessageUpdate {
+	mu.mutation.SetThreadLastReplyAt(t)
+	return mu
+}
+
+// SetNillableThreadLastReplyAt sets the "thread_last_reply_at" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableThreadLastReplyAt(t *time.Time) *MessageUpdate {
+	if t != nil {
+		mu.SetThreadLastReplyAt(*t)
+	}
+	return mu
+}
+
+// ClearThreadLastReplyAt clears the value of the "thread_last_reply_at" field.
+func (mu *MessageUpdate) ClearThreadLastReplyAt() *MessageUpdate {
+	mu.mutation.ClearThreadLastReplyAt()
+	return mu
+}
+
+// SetSuppressEmbeds sets the "suppress_embeds" field.
+func (mu *MessageUpdate) SetSuppressEmbeds(b bool) *MessageUpdate {
+	mu.mutation.SetSuppressEmbeds(b)
+	return mu
+}
+
+// SetNillableSuppressEmbeds sets the "suppress_embeds" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableSuppressEmbeds(b *bool) *MessageUpdate {
+	if b != nil {
+		mu.SetSuppressEmbeds(*b)
+	}
+	return mu
+}
+
+// SetForwardedFromMessageID sets the "forwarded_from_message_id" field.
+func (mu *MessageUpdate) SetForwardedFromMessageID(s string) *MessageUpdate {
+	mu.mutation.SetForwardedFromMessageID(s)
+	return mu
+}
+
+// SetNillableForwardedFromMessageID sets the "forwarded_from_message_id" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableForwardedFromMessageID(s *string) *MessageUpdate {
+	if s != nil {
+		mu.SetForwardedFromMessageID(*s)
+	}
+	return mu
+}
+
+// ClearForwardedFromMessageID clears the value of the "forwarded_from_message_id" field.
+func (mu *MessageUpdate) ClearForwardedFromMessageID() *MessageUpdate {
+	mu.mutation.ClearForwardedFromMessageID()
+	return mu
+}
+
+// SetForwardedFromUserID sets the "forwarded_from_user_id" field.
+func (mu *MessageUpdate) SetForwardedFromUserID(s string) *MessageUpdate {
+	mu.mutation.SetForwardedFromUserID(s)
+	return mu
+}
+
+// SetNillableForwardedFromUserID sets the "forwarded_from_user_id" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableForwardedFromUserID(s *string) *MessageUpdate {
+	if s != nil {
+		mu.SetForwardedFromUserID(*s)
+	}
+	return mu
+}
+
+// ClearForwardedFromUserID clears the value of the "forwarded_from_user_id" field.
+func (mu *MessageUpdate) ClearForwardedFromUserID() *MessageUpdate {
+	mu.mutation.ClearForwardedFromUserID()
+	return mu
+}
+
+// SetNonce sets the "nonce" field.
+func (mu *MessageUpdate) SetNonce(s string) *MessageUpdate {
+	mu.mutation.SetNonce(s)
+	return mu
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableNonce(s *string) *MessageUpdate {
+	if s != nil {
+		mu.SetNonce(*s)
+	}
+	return mu
+}
+
+// ClearNonce clears the value of the "nonce" field.
+func (mu *MessageUpdate) ClearNonce() *MessageUpdate {
+	mu.mutation.ClearNonce()
+	return mu
+}
+
 // SetConversation sets the "conversation" edge to the Conversation entity.
 func (mu *MessageUpdate) SetConversation(c *Conversation) *MessageUpdate {
 	return mu.SetConversationID(c.ID)
@@ -176,6 +402,216 @@ func (mu *MessageUpdate) SetCall(c *Call) *MessageUpdate {
 	return mu.SetCallID(c.ID)
 }
 
+// SetForwardedFromUser sets the "forwarded_from_user" edge to the User entity.
+func (mu *MessageUpdate) SetForwardedFromUser(u *User) *MessageUpdate {
+	return mu.SetForwardedFromUserID(u.ID)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the MessageRevision entity by IDs.
+func (mu *MessageUpdate) AddRevisionIDs(ids ...string) *MessageUpdate {
+	mu.mutation.AddRevisionIDs(ids...)
+	return mu
+}
+
+// AddRevisions adds the "revisions" edges to the MessageRevision entity.
+func (mu *MessageUpdate) AddRevisions(m ...*MessageRevision) *MessageUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.AddRevisionIDs(ids...)
+}
+
+// AddReactionIDs adds the "reactions" edge to the MessageReaction entity by IDs.
+func (mu *MessageUpdate) AddReactionIDs(ids ...string) *MessageUpdate {
+	mu.mutation.AddReactionIDs(ids...)
+	return mu
+}
+
+// AddReactions adds the "reactions" edges to the MessageReaction entity.
+func (mu *MessageUpdate) AddReactions(m ...*MessageReaction) *MessageUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.AddReactionIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (mu *MessageUpdate) AddAttachmentIDs(ids ...string) *MessageUpdate {
+	mu.mutation.AddAttachmentIDs(ids...)
+	return mu
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (mu *MessageUpdate) AddAttachments(a ...*Attachment) *MessageUpdate {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return mu.AddAttachmentIDs(ids...)
+}
+
+// AddLinkPreviewIDs adds the "link_previews" edge to the LinkPreview entity by IDs.
+func (mu *MessageUpdate) AddLinkPreviewIDs(ids ...string) *MessageUpdate {
+	mu.mutation.AddLinkPreviewIDs(ids...)
+	return mu
+}
+
+// AddLinkPreviews adds the "link_previews" edges to the LinkPreview entity.
+func (mu *MessageUpdate) AddLinkPreviews(l ...*LinkPreview) *MessageUpdate {
+	ids := make([]string, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return mu.AddLinkPreviewIDs(ids...)
+}
+
+// AddMentionIDs adds the "mentions" edge to the MessageMention entity by IDs.
+func (mu *MessageUpdate) AddMentionIDs(ids ...string) *MessageUpdate {
+	mu.mutation.AddMentionIDs(ids...)
+	return mu
+}
+
+// AddMentions adds the "mentions" edges to the MessageMention entity.
+func (mu *MessageUpdate) AddMentions(m ...*MessageMention) *MessageUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.AddMentionIDs(ids...)
+}
+
+// AddPinIDs adds the "pins" edge to the PinnedMessage entity by IDs.
+func (mu *MessageUpdate) AddPinIDs(ids ...string) *MessageUpdate {
+	mu.mutation.AddPinIDs(ids...)
+	return mu
+}
+
+// AddPins adds the "pins" edges to the PinnedMessage entity.
+func (mu *MessageUpdate) AddPins(p ...*PinnedMessage) *MessageUpdate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return mu.AddPinIDs(ids...)
+}
+
+// AddReceiptIDs adds the "receipts" edge to the MessageReceipt entity by IDs.
+func (mu *MessageUpdate) AddReceiptIDs(ids ...string) *MessageUpdate {
+	mu.mutation.AddReceiptIDs(ids...)
+	return mu
+}
+
+// AddReceipts adds the "receipts" edges to the MessageReceipt entity.
+func (mu *MessageUpdate) AddReceipts(m ...*MessageReceipt) *MessageUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.AddReceiptIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (mu *MessageUpdate) AddNotificationIDs(ids ...string) *MessageUpdate {
+	mu.mutation.AddNotificationIDs(ids...)
+	return mu
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (mu *MessageUpdate) AddNotifications(n ...*Notification) *MessageUpdate {
+	ids := make([]string, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return mu.AddNotificationIDs(ids...)
+}
+
+// SetReplyTo sets the "reply_to" edge to the Message entity.
+func (mu *MessageUpdate) SetReplyTo(m *Message) *MessageUpdate {
+	return mu.SetReplyToID(m.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the Message entity by IDs.
+func (mu *MessageUpdate) AddReplyIDs(ids ...string) *MessageUpdate {
+	mu.mutation.AddReplyIDs(ids...)
+	return mu
+}
+
+// AddReplies adds the "replies" edges to the Message entity.
+func (mu *MessageUpdate) AddReplies(m ...*Message) *MessageUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.AddReplyIDs(ids...)
+}
+
+// SetThreadRoot sets the "thread_root" edge to the Message entity.
+func (mu *MessageUpdate) SetThreadRoot(m *Message) *MessageUpdate {
+	return mu.SetThreadRootID(m.ID)
+}
+
+// AddThreadReplyIDs adds the "thread_replies" edge to the Message entity by IDs.
+func (mu *MessageUpdate) AddThreadReplyIDs(ids ...string) *MessageUpdate {
+	mu.mutation.AddThreadReplyIDs(ids...)
+	return mu
+}
+
+// AddThreadReplies adds the "thread_replies" edges to the Message entity.
+func (mu *MessageUpdate) AddThreadReplies(m ...*Message) *MessageUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.AddThreadReplyIDs(ids...)
+}
+
+// AddThreadParticipantIDs adds the "thread_participants" edge to the ThreadParticipant entity by IDs.
+func (mu *MessageUpdate) AddThreadParticipantIDs(ids ...string) *MessageUpdate {
+	mu.mutation.AddThreadParticipantIDs(ids...)
+	return mu
+}
+
+// AddThreadParticipants adds the "thread_participants" edges to the ThreadParticipant entity.
+func (mu *MessageUpdate) AddThreadParticipants(t ...*ThreadParticipant) *MessageUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return mu.AddThreadParticipantIDs(ids...)
+}
+
+// AddBookmarkIDs adds the "bookmarks" edge to the Bookmark entity by IDs.
+func (mu *MessageUpdate) AddBookmarkIDs(ids ...string) *MessageUpdate {
+	mu.mutation.AddBookmarkIDs(ids...)
+	return mu
+}
+
+// AddBookmarks adds the "bookmarks" edges to the Bookmark entity.
+func (mu *MessageUpdate) AddBookmarks(b ...*Bookmark) *MessageUpdate {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return mu.AddBookmarkIDs(ids...)
+}
+
+// AddHiddenForIDs adds the "hidden_for" edge to the HiddenMessage entity by IDs.
+func (mu *MessageUpdate) AddHiddenForIDs(ids ...string) *MessageUpdate {
+	mu.mutation.AddHiddenForIDs(ids...)
+	return mu
+}
+
+// AddHiddenFor adds the "hidden_for" edges to the HiddenMessage entity.
+func (mu *MessageUpdate) AddHiddenFor(h ...*HiddenMessage) *MessageUpdate {
+	ids := make([]string, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return mu.AddHiddenForIDs(ids...)
+}
+
 // Mutation returns the MessageMutation object of the builder.
 func (mu *MessageUpdate) Mutation() *MessageMutation {
 	return mu.mutation
@@ -199,53 +635,344 @@ func (mu *MessageUpdate) ClearCall() *MessageUpdate {
 	return mu
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (mu *MessageUpdate) Save(ctx context.Context) (int, error) {
-	mu.defaults()
-	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
+// ClearForwardedFromUser clears the "forwarded_from_user" edge to the User entity.
+func (mu *MessageUpdate) ClearForwardedFromUser() *MessageUpdate {
+	mu.mutation.ClearForwardedFromUser()
+	return mu
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (mu *MessageUpdate) SaveX(ctx context.Context) int {
-	affected, err := mu.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return affected
+// ClearRevisions clears all "revisions" edges to the MessageRevision entity.
+func (mu *MessageUpdate) ClearRevisions() *MessageUpdate {
+	mu.mutation.ClearRevisions()
+	return mu
 }
 
-// Exec executes the query.
-func (mu *MessageUpdate) Exec(ctx context.Context) error {
-	_, err := mu.Save(ctx)
-	return err
+// RemoveRevisionIDs removes the "revisions" edge to MessageRevision entities by IDs.
+func (mu *MessageUpdate) RemoveRevisionIDs(ids ...string) *MessageUpdate {
+	mu.mutation.RemoveRevisionIDs(ids...)
+	return mu
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (mu *MessageUpdate) ExecX(ctx context.Context) {
-	if err := mu.Exec(ctx); err != nil {
-		panic(err)
+// RemoveRevisions removes "revisions" edges to MessageRevision entities.
+func (mu *MessageUpdate) RemoveRevisions(m ...*MessageRevision) *MessageUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
 	}
+	return mu.RemoveRevisionIDs(ids...)
 }
 
-// defaults sets the default values of the builder before save.
-func (mu *MessageUpdate) defaults() {
-	if _, ok := mu.mutation.UpdatedAt(); !ok {
-		v := message.UpdateDefaultUpdatedAt()
-		mu.mutation.SetUpdatedAt(v)
+// ClearReactions clears all "reactions" edges to the MessageReaction entity.
+func (mu *MessageUpdate) ClearReactions() *MessageUpdate {
+	mu.mutation.ClearReactions()
+	return mu
+}
+
+// RemoveReactionIDs removes the "reactions" edge to MessageReaction entities by IDs.
+func (mu *MessageUpdate) RemoveReactionIDs(ids ...string) *MessageUpdate {
+	mu.mutation.RemoveReactionIDs(ids...)
+	return mu
+}
+
+// RemoveReactions removes "reactions" edges to MessageReaction entities.
+func (mu *MessageUpdate) RemoveReactions(m ...*MessageReaction) *MessageUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
 	}
+	return mu.RemoveReactionIDs(ids...)
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (mu *MessageUpdate) check() error {
-	if v, ok := mu.mutation.ConversationID(); ok {
-		if err := message.ConversationIDValidator(v); err != nil {
-			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "Message.conversation_id": %w`, err)}
-		}
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (mu *MessageUpdate) ClearAttachments() *MessageUpdate {
+	mu.mutation.ClearAttachments()
+	return mu
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (mu *MessageUpdate) RemoveAttachmentIDs(ids ...string) *MessageUpdate {
+	mu.mutation.RemoveAttachmentIDs(ids...)
+	return mu
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (mu *MessageUpdate) RemoveAttachments(a ...*Attachment) *MessageUpdate {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
 	}
-	if v, ok := mu.mutation.SenderID(); ok {
-		if err := message.SenderIDValidator(v); err != nil {
-			return &ValidationError{Name: "sender_id", err: fmt.Errorf(`ent: validator failed for field "Message.sender_id": %w`, err)}
-		}
+	return mu.RemoveAttachmentIDs(ids...)
+}
+
+// ClearLinkPreviews clears all "link_previews" edges to the LinkPreview entity.
+func (mu *MessageUpdate) ClearLinkPreviews() *MessageUpdate {
+	mu.mutation.ClearLinkPreviews()
+	return mu
+}
+
+// RemoveLinkPreviewIDs removes the "link_previews" edge to LinkPreview entities by IDs.
+func (mu *MessageUpdate) RemoveLinkPreviewIDs(ids ...string) *MessageUpdate {
+	mu.mutation.RemoveLinkPreviewIDs(ids...)
+	return mu
+}
+
+// RemoveLinkPreviews removes "link_previews" edges to LinkPreview entities.
+func (mu *MessageUpdate) RemoveLinkPreviews(l ...*LinkPreview) *MessageUpdate {
+	ids := make([]string, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return mu.RemoveLinkPreviewIDs(ids...)
+}
+
+// ClearMentions clears all "mentions" edges to the MessageMention entity.
+func (mu *MessageUpdate) ClearMentions() *MessageUpdate {
+	mu.mutation.ClearMentions()
+	return mu
+}
+
+// RemoveMentionIDs removes the "mentions" edge to MessageMention entities by IDs.
+func (mu *MessageUpdate) RemoveMentionIDs(ids ...string) *MessageUpdate {
+	mu.mutation.RemoveMentionIDs(ids...)
+	return mu
+}
+
+// RemoveMentions removes "mentions" edges to MessageMention entities.
+func (mu *MessageUpdate) RemoveMentions(m ...*MessageMention) *MessageUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.RemoveMentionIDs(ids...)
+}
+
+// ClearPins clears all "pins" edges to the PinnedMessage entity.
+func (mu *MessageUpdate) ClearPins() *MessageUpdate {
+	mu.mutation.ClearPins()
+	return mu
+}
+
+// RemovePinIDs removes the "pins" edge to PinnedMessage entities by IDs.
+func (mu *MessageUpdate) RemovePinIDs(ids ...string) *MessageUpdate {
+	mu.mutation.RemovePinIDs(ids...)
+	return mu
+}
+
+// RemovePins removes "pins" edges to PinnedMessage entities.
+func (mu *MessageUpdate) RemovePins(p ...*PinnedMessage) *MessageUpdate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return mu.RemovePinIDs(ids...)
+}
+
+// ClearReceipts clears all "receipts" edges to the MessageReceipt entity.
+func (mu *MessageUpdate) ClearReceipts() *MessageUpdate {
+	mu.mutation.ClearReceipts()
+	return mu
+}
+
+// RemoveReceiptIDs removes the "receipts" edge to MessageReceipt entities by IDs.
+func (mu *MessageUpdate) RemoveReceiptIDs(ids ...string) *MessageUpdate {
+	mu.mutation.RemoveReceiptIDs(ids...)
+	return mu
+}
+
+// RemoveReceipts removes "receipts" edges to MessageReceipt entities.
+func (mu *MessageUpdate) RemoveReceipts(m ...*MessageReceipt) *MessageUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.RemoveReceiptIDs(ids...)
+}
+
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (mu *MessageUpdate) ClearNotifications() *MessageUpdate {
+	mu.mutation.ClearNotifications()
+	return mu
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (mu *MessageUpdate) RemoveNotificationIDs(ids ...string) *MessageUpdate {
+	mu.mutation.RemoveNotificationIDs(ids...)
+	return mu
+}
+
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (mu *MessageUpdate) RemoveNotifications(n ...*Notification) *MessageUpdate {
+	ids := make([]string, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return mu.RemoveNotificationIDs(ids...)
+}
+
+// ClearReplyTo clears the "reply_to" edge to the Message entity.
+func (mu *MessageUpdate) ClearReplyTo() *MessageUpdate {
+	mu.mutation.ClearReplyTo()
+	return mu
+}
+
+// ClearReplies clears all "replies" edges to the Message entity.
+func (mu *MessageUpdate) ClearReplies() *MessageUpdate {
+	mu.mutation.ClearReplies()
+	return mu
+}
+
+// RemoveReplyIDs removes the "replies" edge to Message entities by IDs.
+func (mu *MessageUpdate) RemoveReplyIDs(ids ...string) *MessageUpdate {
+	mu.mutation.RemoveReplyIDs(ids...)
+	return mu
+}
+
+// RemoveReplies removes "replies" edges to Message entities.
+func (mu *MessageUpdate) RemoveReplies(m ...*Message) *MessageUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.RemoveReplyIDs(ids...)
+}
+
+// ClearThreadRoot clears the "thread_root" edge to the Message entity.
+func (mu *MessageUpdate) ClearThreadRoot() *MessageUpdate {
+	mu.mutation.ClearThreadRoot()
+	return mu
+}
+
+// ClearThreadReplies clears all "thread_replies" edges to the Message entity.
+func (mu *MessageUpdate) ClearThreadReplies() *MessageUpdate {
+	mu.mutation.ClearThreadReplies()
+	return mu
+}
+
+// RemoveThreadReplyIDs removes the "thread_replies" edge to Message entities by IDs.
+func (mu *MessageUpdate) RemoveThreadReplyIDs(ids ...string) *MessageUpdate {
+	mu.mutation.RemoveThreadReplyIDs(ids...)
+	return mu
+}
+
+// RemoveThreadReplies removes "thread_replies" edges to Message entities.
+func (mu *MessageUpdate) RemoveThreadReplies(m ...*Message) *MessageUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.RemoveThreadReplyIDs(ids...)
+}
+
+// ClearThreadParticipants clears all "thread_participants" edges to the ThreadParticipant entity.
+func (mu *MessageUpdate) ClearThreadParticipants() *MessageUpdate {
+	mu.mutation.ClearThreadParticipants()
+	return mu
+}
+
+// RemoveThreadParticipantIDs removes the "thread_participants" edge to ThreadParticipant entities by IDs.
+func (mu *MessageUpdate) RemoveThreadParticipantIDs(ids ...string) *MessageUpdate {
+	mu.mutation.RemoveThreadParticipantIDs(ids...)
+	return mu
+}
+
+// RemoveThreadParticipants removes "thread_participants" edges to ThreadParticipant entities.
+func (mu *MessageUpdate) RemoveThreadParticipants(t ...*ThreadParticipant) *MessageUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return mu.RemoveThreadParticipantIDs(ids...)
+}
+
+// ClearBookmarks clears all "bookmarks" edges to the Bookmark entity.
+func (mu *MessageUpdate) ClearBookmarks() *MessageUpdate {
+	mu.mutation.ClearBookmarks()
+	return mu
+}
+
+// RemoveBookmarkIDs removes the "bookmarks" edge to Bookmark entities by IDs.
+func (mu *MessageUpdate) RemoveBookmarkIDs(ids ...string) *MessageUpdate {
+	mu.mutation.RemoveBookmarkIDs(ids...)
+	return mu
+}
+
+// RemoveBookmarks removes "bookmarks" edges to Bookmark entities.
+func (mu *MessageUpdate) RemoveBookmarks(b ...*Bookmark) *MessageUpdate {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return mu.RemoveBookmarkIDs(ids...)
+}
+
+// ClearHiddenFor clears all "hidden_for" edges to the HiddenMessage entity.
+func (mu *MessageUpdate) ClearHiddenFor() *MessageUpdate {
+	mu.mutation.ClearHiddenFor()
+	return mu
+}
+
+// RemoveHiddenForIDs removes the "hidden_for" edge to HiddenMessage entities by IDs.
+func (mu *MessageUpdate) RemoveHiddenForIDs(ids ...string) *MessageUpdate {
+	mu.mutation.RemoveHiddenForIDs(ids...)
+	return mu
+}
+
+// RemoveHiddenFor removes "hidden_for" edges to HiddenMessage entities.
+func (mu *MessageUpdate) RemoveHiddenFor(h ...*HiddenMessage) *MessageUpdate {
+	ids := make([]string, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return mu.RemoveHiddenForIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mu *MessageUpdate) Save(ctx context.Context) (int, error) {
+	mu.defaults()
+	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mu *MessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := mu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mu *MessageUpdate) Exec(ctx context.Context) error {
+	_, err := mu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mu *MessageUpdate) ExecX(ctx context.Context) {
+	if err := mu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mu *MessageUpdate) defaults() {
+	if _, ok := mu.mutation.UpdatedAt(); !ok {
+		v := message.UpdateDefaultUpdatedAt()
+		mu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mu *MessageUpdate) check() error {
+	if v, ok := mu.mutation.ConversationID(); ok {
+		if err := message.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "Message.conversation_id": %w`, err)}
+		}
+	}
+	if v, ok := mu.mutation.SenderID(); ok {
+		if err := message.SenderIDValidator(v); err != nil {
+			return &ValidationError{Name: "sender_id", err: fmt.Errorf(`ent: validator failed for field "Message.sender_id": %w`, err)}
+		}
 	}
 	if v, ok := mu.mutation.Content(); ok {
 		if err := message.ContentValidator(v); err != nil {
@@ -257,6 +984,16 @@ func (mu *MessageUpdate) check() error {
 			return &ValidationError{Name: "message_type", err: fmt.Errorf(`ent: validator failed for field "Message.message_type": %w`, err)}
 		}
 	}
+	if v, ok := mu.mutation.ThreadReplyCount(); ok {
+		if err := message.ThreadReplyCountValidator(v); err != nil {
+			return &ValidationError{Name: "thread_reply_count", err: fmt.Errorf(`ent: validator failed for field "Message.thread_reply_count": %w`, err)}
+		}
+	}
+	if v, ok := mu.mutation.Nonce(); ok {
+		if err := message.NonceValidator(v); err != nil {
+			return &ValidationError{Name: "nonce", err: fmt.Errorf(`ent: validator failed for field "Message.nonce": %w`, err)}
+		}
+	}
 	if mu.mutation.ConversationCleared() && len(mu.mutation.ConversationIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Message.conversation"`)
 	}
@@ -287,18 +1024,68 @@ func (mu *MessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := mu.mutation.Content(); ok {
 		_spec.SetField(message.FieldContent, field.TypeString, value)
 	}
+	if value, ok := mu.mutation.PlainText(); ok {
+		_spec.SetField(message.FieldPlainText, field.TypeString, value)
+	}
+	if mu.mutation.PlainTextCleared() {
+		_spec.ClearField(message.FieldPlainText, field.TypeString)
+	}
+	if value, ok := mu.mutation.Entities(); ok {
+		_spec.SetField(message.FieldEntities, field.TypeJSON, value)
+	}
+	if value, ok := mu.mutation.AppendedEntities(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, message.FieldEntities, value)
+		})
+	}
+	if mu.mutation.EntitiesCleared() {
+		_spec.ClearField(message.FieldEntities, field.TypeJSON)
+	}
 	if value, ok := mu.mutation.MessageType(); ok {
 		_spec.SetField(message.FieldMessageType, field.TypeEnum, value)
 	}
 	if value, ok := mu.mutation.IsDeleted(); ok {
 		_spec.SetField(message.FieldIsDeleted, field.TypeBool, value)
 	}
+	if value, ok := mu.mutation.DeletedAt(); ok {
+		_spec.SetField(message.FieldDeletedAt, field.TypeTime, value)
+	}
+	if mu.mutation.DeletedAtCleared() {
+		_spec.ClearField(message.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := mu.mutation.EditedAt(); ok {
 		_spec.SetField(message.FieldEditedAt, field.TypeTime, value)
 	}
 	if mu.mutation.EditedAtCleared() {
 		_spec.ClearField(message.FieldEditedAt, field.TypeTime)
 	}
+	if value, ok := mu.mutation.ThreadReplyCount(); ok {
+		_spec.SetField(message.FieldThreadReplyCount, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedThreadReplyCount(); ok {
+		_spec.AddField(message.FieldThreadReplyCount, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.ThreadLastReplyAt(); ok {
+		_spec.SetField(message.FieldThreadLastReplyAt, field.TypeTime, value)
+	}
+	if mu.mutation.ThreadLastReplyAtCleared() {
+		_spec.ClearField(message.FieldThreadLastReplyAt, field.TypeTime)
+	}
+	if value, ok := mu.mutation.SuppressEmbeds(); ok {
+		_spec.SetField(message.FieldSuppressEmbeds, field.TypeBool, value)
+	}
+	if value, ok := mu.mutation.ForwardedFromMessageID(); ok {
+		_spec.SetField(message.FieldForwardedFromMessageID, field.TypeString, value)
+	}
+	if mu.mutation.ForwardedFromMessageIDCleared() {
+		_spec.ClearField(message.FieldForwardedFromMessageID, field.TypeString)
+	}
+	if value, ok := mu.mutation.Nonce(); ok {
+		_spec.SetField(message.FieldNonce, field.TypeString, value)
+	}
+	if mu.mutation.NonceCleared() {
+		_spec.ClearField(message.FieldNonce, field.TypeString)
+	}
 	if mu.mutation.ConversationCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -386,346 +1173,2432 @@ func (mu *MessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{message.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if mu.mutation.ForwardedFromUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.ForwardedFromUserTable,
+			Columns: []string{message.ForwardedFromUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	mu.mutation.done = true
-	return n, nil
-}
-
-// MessageUpdateOne is the builder for updating a single Message entity.
-type MessageUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *MessageMutation
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (muo *MessageUpdateOne) SetCreatedAt(t time.Time) *MessageUpdateOne {
-	muo.mutation.SetCreatedAt(t)
-	return muo
-}
-
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (muo *MessageUpdateOne) SetNillableCreatedAt(t *time.Time) *MessageUpdateOne {
-	if t != nil {
-		muo.SetCreatedAt(*t)
+	if nodes := mu.mutation.ForwardedFromUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.ForwardedFromUserTable,
+			Columns: []string{message.ForwardedFromUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return muo
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (muo *MessageUpdateOne) SetUpdatedAt(t time.Time) *MessageUpdateOne {
-	muo.mutation.SetUpdatedAt(t)
-	return muo
-}
-
-// SetConversationID sets the "conversation_id" field.
-func (muo *MessageUpdateOne) SetConversationID(s string) *MessageUpdateOne {
-	muo.mutation.SetConversationID(s)
-	return muo
-}
-
-// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
-func (muo *MessageUpdateOne) SetNillableConversationID(s *string) *MessageUpdateOne {
-	if s != nil {
-		muo.SetConversationID(*s)
+	if mu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return muo
-}
-
-// SetSenderID sets the "sender_id" field.
-func (muo *MessageUpdateOne) SetSenderID(s string) *MessageUpdateOne {
-	muo.mutation.SetSenderID(s)
-	return muo
-}
-
-// SetNillableSenderID sets the "sender_id" field if the given value is not nil.
-func (muo *MessageUpdateOne) SetNillableSenderID(s *string) *MessageUpdateOne {
-	if s != nil {
-		muo.SetSenderID(*s)
+	if nodes := mu.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !mu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return muo
-}
-
-// SetContent sets the "content" field.
+	if nodes := mu.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedReactionsIDs(); len(nodes) > 0 && !mu.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.AttachmentsTable,
+			Columns: []string{message.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !mu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.AttachmentsTable,
+			Columns: []string{message.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.AttachmentsTable,
+			Columns: []string{message.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.LinkPreviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.LinkPreviewsTable,
+			Columns: []string{message.LinkPreviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkpreview.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedLinkPreviewsIDs(); len(nodes) > 0 && !mu.mutation.LinkPreviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.LinkPreviewsTable,
+			Columns: []string{message.LinkPreviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkpreview.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.LinkPreviewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.LinkPreviewsTable,
+			Columns: []string{message.LinkPreviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkpreview.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.MentionsTable,
+			Columns: []string{message.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedMentionsIDs(); len(nodes) > 0 && !mu.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.MentionsTable,
+			Columns: []string{message.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.MentionsTable,
+			Columns: []string{message.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.PinsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.PinsTable,
+			Columns: []string{message.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedPinsIDs(); len(nodes) > 0 && !mu.mutation.PinsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.PinsTable,
+			Columns: []string{message.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.PinsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.PinsTable,
+			Columns: []string{message.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.ReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.ReceiptsTable,
+			Columns: []string{message.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereceipt.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedReceiptsIDs(); len(nodes) > 0 && !mu.mutation.ReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.ReceiptsTable,
+			Columns: []string{message.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereceipt.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.ReceiptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.ReceiptsTable,
+			Columns: []string{message.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereceipt.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.NotificationsTable,
+			Columns: []string{message.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !mu.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.NotificationsTable,
+			Columns: []string{message.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.NotificationsTable,
+			Columns: []string{message.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.ReplyToCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.ReplyToTable,
+			Columns: []string{message.ReplyToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.ReplyToIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.ReplyToTable,
+			Columns: []string{message.ReplyToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RepliesTable,
+			Columns: []string{message.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedRepliesIDs(); len(nodes) > 0 && !mu.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RepliesTable,
+			Columns: []string{message.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RepliesTable,
+			Columns: []string{message.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.ThreadRootCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.ThreadRootTable,
+			Columns: []string{message.ThreadRootColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.ThreadRootIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.ThreadRootTable,
+			Columns: []string{message.ThreadRootColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.ThreadRepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ThreadRepliesTable,
+			Columns: []string{message.ThreadRepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedThreadRepliesIDs(); len(nodes) > 0 && !mu.mutation.ThreadRepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ThreadRepliesTable,
+			Columns: []string{message.ThreadRepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.ThreadRepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ThreadRepliesTable,
+			Columns: []string{message.ThreadRepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.ThreadParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.ThreadParticipantsTable,
+			Columns: []string{message.ThreadParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(threadparticipant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedThreadParticipantsIDs(); len(nodes) > 0 && !mu.mutation.ThreadParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.ThreadParticipantsTable,
+			Columns: []string{message.ThreadParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(threadparticipant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.ThreadParticipantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.ThreadParticipantsTable,
+			Columns: []string{message.ThreadParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(threadparticipant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.BookmarksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.BookmarksTable,
+			Columns: []string{message.BookmarksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedBookmarksIDs(); len(nodes) > 0 && !mu.mutation.BookmarksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.BookmarksTable,
+			Columns: []string{message.BookmarksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.BookmarksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.BookmarksTable,
+			Columns: []string{message.BookmarksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.HiddenForCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.HiddenForTable,
+			Columns: []string{message.HiddenForColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hiddenmessage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedHiddenForIDs(); len(nodes) > 0 && !mu.mutation.HiddenForCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.HiddenForTable,
+			Columns: []string{message.HiddenForColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hiddenmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.HiddenForIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.HiddenForTable,
+			Columns: []string{message.HiddenForColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hiddenmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{message.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mu.mutation.done = true
+	return n, nil
+}
+
+// MessageUpdateOne is the builder for updating a single Message entity.
+type MessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MessageMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (muo *MessageUpdateOne) SetCreatedAt(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetCreatedAt(t)
+	return muo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableCreatedAt(t *time.Time) *MessageUpdateOne {
+	if t != nil {
+		muo.SetCreatedAt(*t)
+	}
+	return muo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (muo *MessageUpdateOne) SetUpdatedAt(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetUpdatedAt(t)
+	return muo
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (muo *MessageUpdateOne) SetConversationID(s string) *MessageUpdateOne {
+	muo.mutation.SetConversationID(s)
+	return muo
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableConversationID(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetConversationID(*s)
+	}
+	return muo
+}
+
+// SetSenderID sets the "sender_id" field.
+func (muo *MessageUpdateOne) SetSenderID(s string) *MessageUpdateOne {
+	muo.mutation.SetSenderID(s)
+	return muo
+}
+
+// SetNillableSenderID sets the "sender_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableSenderID(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetSenderID(*s)
+	}
+	return muo
+}
+
+// SetContent sets the "content" field.
 func (muo *MessageUpdateOne) SetContent(s string) *MessageUpdateOne {
 	muo.mutation.SetContent(s)
 	return muo
 }
 
-// SetNillableContent sets the "content" field if the given value is not nil.
-func (muo *MessageUpdateOne) SetNillableContent(s *string) *MessageUpdateOne {
-	if s != nil {
-		muo.SetContent(*s)
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableContent(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetContent(*s)
+	}
+	return muo
+}
+
+// SetPlainText sets the "plain_text" field.
+func (muo *MessageUpdateOne) SetPlainText(s string) *MessageUpdateOne {
+	muo.mutation.SetPlainText(s)
+	return muo
+}
+
+// SetNillablePlainText sets the "plain_text" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillablePlainText(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetPlainText(*s)
+	}
+	return muo
+}
+
+// ClearPlainText clears the value of the "plain_text" field.
+func (muo *MessageUpdateOne) ClearPlainText() *MessageUpdateOne {
+	muo.mutation.ClearPlainText()
+	return muo
+}
+
+// SetEntities sets the "entities" field.
+func (muo *MessageUpdateOne) SetEntities(r []richtext.Entity) *MessageUpdateOne {
+	muo.mutation.SetEntities(r)
+	return muo
+}
+
+// AppendEntities appends r to the "entities" field.
+func (muo *MessageUpdateOne) AppendEntities(r []richtext.Entity) *MessageUpdateOne {
+	muo.mutation.AppendEntities(r)
+	return muo
+}
+
+// ClearEntities clears the value of the "entities" field.
+func (muo *MessageUpdateOne) ClearEntities() *MessageUpdateOne {
+	muo.mutation.ClearEntities()
+	return muo
+}
+
+// SetMessageType sets the "message_type" field.
+func (muo *MessageUpdateOne) SetMessageType(mt message.MessageType) *MessageUpdateOne {
+	muo.mutation.SetMessageType(mt)
+	return muo
+}
+
+// SetNillableMessageType sets the "message_type" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableMessageType(mt *message.MessageType) *MessageUpdateOne {
+	if mt != nil {
+		muo.SetMessageType(*mt)
+	}
+	return muo
+}
+
+// SetIsDeleted sets the "is_deleted" field.
+func (muo *MessageUpdateOne) SetIsDeleted(b bool) *MessageUpdateOne {
+	muo.mutation.SetIsDeleted(b)
+	return muo
+}
+
+// SetNillableIsDeleted sets the "is_deleted" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableIsDeleted(b *bool) *MessageUpdateOne {
+	if b != nil {
+		muo.SetIsDeleted(*b)
+	}
+	return muo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (muo *MessageUpdateOne) SetDeletedAt(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetDeletedAt(t)
+	return muo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableDeletedAt(t *time.Time) *MessageUpdateOne {
+	if t != nil {
+		muo.SetDeletedAt(*t)
+	}
+	return muo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (muo *MessageUpdateOne) ClearDeletedAt() *MessageUpdateOne {
+	muo.mutation.ClearDeletedAt()
+	return muo
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (muo *MessageUpdateOne) SetEditedAt(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetEditedAt(t)
+	return muo
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableEditedAt(t *time.Time) *MessageUpdateOne {
+	if t != nil {
+		muo.SetEditedAt(*t)
+	}
+	return muo
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (muo *MessageUpdateOne) ClearEditedAt() *MessageUpdateOne {
+	muo.mutation.ClearEditedAt()
+	return muo
+}
+
+// SetCallID sets the "call_id" field.
+func (muo *MessageUpdateOne) SetCallID(s string) *MessageUpdateOne {
+	muo.mutation.SetCallID(s)
+	return muo
+}
+
+// SetNillableCallID sets the "call_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableCallID(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetCallID(*s)
+	}
+	return muo
+}
+
+// ClearCallID clears the value of the "call_id" field.
+func (muo *MessageUpdateOne) ClearCallID() *MessageUpdateOne {
+	muo.mutation.ClearCallID()
+	return muo
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (muo *MessageUpdateOne) SetReplyToID(s string) *MessageUpdateOne {
+	muo.mutation.SetReplyToID(s)
+	return muo
+}
+
+// SetNillableReplyToID sets the "reply_to_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableReplyToID(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetReplyToID(*s)
+	}
+	return muo
+}
+
+// ClearReplyToID clears the value of the "reply_to_id" field.
+func (muo *MessageUpdateOne) ClearReplyToID() *MessageUpdateOne {
+	muo.mutation.ClearReplyToID()
+	return muo
+}
+
+// SetThreadRootID sets the "thread_root_id" field.
+func (muo *MessageUpdateOne) SetThreadRootID(s string) *MessageUpdateOne {
+	muo.mutation.SetThreadRootID(s)
+	return muo
+}
+
+// SetNillableThreadRootID sets the "thread_root_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableThreadRootID(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetThreadRootID(*s)
+	}
+	return muo
+}
+
+// ClearThreadRootID clears the value of the "thread_root_id" field.
+func (muo *MessageUpdateOne) ClearThreadRootID() *MessageUpdateOne {
+	muo.mutation.ClearThreadRootID()
+	return muo
+}
+
+// SetThreadReplyCount sets the "thread_reply_count" field.
+func (muo *MessageUpdateOne) SetThreadReplyCount(i int) *MessageUpdateOne {
+	muo.mutation.ResetThreadReplyCount()
+	muo.mutation.SetThreadReplyCount(i)
+	return muo
+}
+
+// SetNillableThreadReplyCount sets the "thread_reply_count" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableThreadReplyCount(i *int) *MessageUpdateOne {
+	if i != nil {
+		muo.SetThreadReplyCount(*i)
+	}
+	return muo
+}
+
+// AddThreadReplyCount adds i to the "thread_reply_count" field.
+func (muo *MessageUpdateOne) AddThreadReplyCount(i int) *MessageUpdateOne {
+	muo.mutation.AddThreadReplyCount(i)
+	return muo
+}
+
+// SetThreadLastReplyAt sets the "thread_last_reply_at" field.
+func (muo *MessageUpdateOne) SetThreadLastReplyAt(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetThreadLastReplyAt(t)
+	return muo
+}
+
+// SetNillableThreadLastReplyAt sets the "thread_last_reply_at" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableThreadLastReplyAt(t *time.Time) *MessageUpdateOne {
+	if t != nil {
+		muo.SetThreadLastReplyAt(*t)
+	}
+	return muo
+}
+
+// ClearThreadLastReplyAt clears the value of the "thread_last_reply_at" field.
+func (muo *MessageUpdateOne) ClearThreadLastReplyAt() *MessageUpdateOne {
+	muo.mutation.ClearThreadLastReplyAt()
+	return muo
+}
+
+// SetSuppressEmbeds sets the "suppress_embeds" field.
+func (muo *MessageUpdateOne) SetSuppressEmbeds(b bool) *MessageUpdateOne {
+	muo.mutation.SetSuppressEmbeds(b)
+	return muo
+}
+
+// SetNillableSuppressEmbeds sets the "suppress_embeds" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableSuppressEmbeds(b *bool) *MessageUpdateOne {
+	if b != nil {
+		muo.SetSuppressEmbeds(*b)
+	}
+	return muo
+}
+
+// SetForwardedFromMessageID sets the "forwarded_from_message_id" field.
+func (muo *MessageUpdateOne) SetForwardedFromMessageID(s string) *MessageUpdateOne {
+	muo.mutation.SetForwardedFromMessageID(s)
+	return muo
+}
+
+// SetNillableForwardedFromMessageID sets the "forwarded_from_message_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableForwardedFromMessageID(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetForwardedFromMessageID(*s)
+	}
+	return muo
+}
+
+// ClearForwardedFromMessageID clears the value of the "forwarded_from_message_id" field.
+func (muo *MessageUpdateOne) ClearForwardedFromMessageID() *MessageUpdateOne {
+	muo.mutation.ClearForwardedFromMessageID()
+	return muo
+}
+
+// SetForwardedFromUserID sets the "forwarded_from_user_id" field.
+func (muo *MessageUpdateOne) SetForwardedFromUserID(s string) *MessageUpdateOne {
+	muo.mutation.SetForwardedFromUserID(s)
+	return muo
+}
+
+// SetNillableForwardedFromUserID sets the "forwarded_from_user_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableForwardedFromUserID(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetForwardedFromUserID(*s)
+	}
+	return muo
+}
+
+// ClearForwardedFromUserID clears the value of the "forwarded_from_user_id" field.
+func (muo *MessageUpdateOne) ClearForwardedFromUserID() *MessageUpdateOne {
+	muo.mutation.ClearForwardedFromUserID()
+	return muo
+}
+
+// SetNonce sets the "nonce" field.
+func (muo *MessageUpdateOne) SetNonce(s string) *MessageUpdateOne {
+	muo.mutation.SetNonce(s)
+	return muo
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableNonce(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetNonce(*s)
+	}
+	return muo
+}
+
+// ClearNonce clears the value of the "nonce" field.
+func (muo *MessageUpdateOne) ClearNonce() *MessageUpdateOne {
+	muo.mutation.ClearNonce()
+	return muo
+}
+
+// SetConversation sets the "conversation" edge to the Conversation entity.
+func (muo *MessageUpdateOne) SetConversation(c *Conversation) *MessageUpdateOne {
+	return muo.SetConversationID(c.ID)
+}
+
+// SetSender sets the "sender" edge to the User entity.
+func (muo *MessageUpdateOne) SetSender(u *User) *MessageUpdateOne {
+	return muo.SetSenderID(u.ID)
+}
+
+// SetCall sets the "call" edge to the Call entity.
+func (muo *MessageUpdateOne) SetCall(c *Call) *MessageUpdateOne {
+	return muo.SetCallID(c.ID)
+}
+
+// SetForwardedFromUser sets the "forwarded_from_user" edge to the User entity.
+func (muo *MessageUpdateOne) SetForwardedFromUser(u *User) *MessageUpdateOne {
+	return muo.SetForwardedFromUserID(u.ID)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the MessageRevision entity by IDs.
+func (muo *MessageUpdateOne) AddRevisionIDs(ids ...string) *MessageUpdateOne {
+	muo.mutation.AddRevisionIDs(ids...)
+	return muo
+}
+
+// AddRevisions adds the "revisions" edges to the MessageRevision entity.
+func (muo *MessageUpdateOne) AddRevisions(m ...*MessageRevision) *MessageUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.AddRevisionIDs(ids...)
+}
+
+// AddReactionIDs adds the "reactions" edge to the MessageReaction entity by IDs.
+func (muo *MessageUpdateOne) AddReactionIDs(ids ...string) *MessageUpdateOne {
+	muo.mutation.AddReactionIDs(ids...)
+	return muo
+}
+
+// AddReactions adds the "reactions" edges to the MessageReaction entity.
+func (muo *MessageUpdateOne) AddReactions(m ...*MessageReaction) *MessageUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.AddReactionIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (muo *MessageUpdateOne) AddAttachmentIDs(ids ...string) *MessageUpdateOne {
+	muo.mutation.AddAttachmentIDs(ids...)
+	return muo
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (muo *MessageUpdateOne) AddAttachments(a ...*Attachment) *MessageUpdateOne {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return muo.AddAttachmentIDs(ids...)
+}
+
+// AddLinkPreviewIDs adds the "link_previews" edge to the LinkPreview entity by IDs.
+func (muo *MessageUpdateOne) AddLinkPreviewIDs(ids ...string) *MessageUpdateOne {
+	muo.mutation.AddLinkPreviewIDs(ids...)
+	return muo
+}
+
+// AddLinkPreviews adds the "link_previews" edges to the LinkPreview entity.
+func (muo *MessageUpdateOne) AddLinkPreviews(l ...*LinkPreview) *MessageUpdateOne {
+	ids := make([]string, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return muo.AddLinkPreviewIDs(ids...)
+}
+
+// AddMentionIDs adds the "mentions" edge to the MessageMention entity by IDs.
+func (muo *MessageUpdateOne) AddMentionIDs(ids ...string) *MessageUpdateOne {
+	muo.mutation.AddMentionIDs(ids...)
+	return muo
+}
+
+// AddMentions adds the "mentions" edges to the MessageMention entity.
+func (muo *MessageUpdateOne) AddMentions(m ...*MessageMention) *MessageUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.AddMentionIDs(ids...)
+}
+
+// AddPinIDs adds the "pins" edge to the PinnedMessage entity by IDs.
+func (muo *MessageUpdateOne) AddPinIDs(ids ...string) *MessageUpdateOne {
+	muo.mutation.AddPinIDs(ids...)
+	return muo
+}
+
+// AddPins adds the "pins" edges to the PinnedMessage entity.
+func (muo *MessageUpdateOne) AddPins(p ...*PinnedMessage) *MessageUpdateOne {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return muo.AddPinIDs(ids...)
+}
+
+// AddReceiptIDs adds the "receipts" edge to the MessageReceipt entity by IDs.
+func (muo *MessageUpdateOne) AddReceiptIDs(ids ...string) *MessageUpdateOne {
+	muo.mutation.AddReceiptIDs(ids...)
+	return muo
+}
+
+// AddReceipts adds the "receipts" edges to the MessageReceipt entity.
+func (muo *MessageUpdateOne) AddReceipts(m ...*MessageReceipt) *MessageUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.AddReceiptIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (muo *MessageUpdateOne) AddNotificationIDs(ids ...string) *MessageUpdateOne {
+	muo.mutation.AddNotificationIDs(ids...)
+	return muo
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (muo *MessageUpdateOne) AddNotifications(n ...*Notification) *MessageUpdateOne {
+	ids := make([]string, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return muo.AddNotificationIDs(ids...)
+}
+
+// SetReplyTo sets the "reply_to" edge to the Message entity.
+func (muo *MessageUpdateOne) SetReplyTo(m *Message) *MessageUpdateOne {
+	return muo.SetReplyToID(m.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the Message entity by IDs.
+func (muo *MessageUpdateOne) AddReplyIDs(ids ...string) *MessageUpdateOne {
+	muo.mutation.AddReplyIDs(ids...)
+	return muo
+}
+
+// AddReplies adds the "replies" edges to the Message entity.
+func (muo *MessageUpdateOne) AddReplies(m ...*Message) *MessageUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.AddReplyIDs(ids...)
+}
+
+// SetThreadRoot sets the "thread_root" edge to the Message entity.
+func (muo *MessageUpdateOne) SetThreadRoot(m *Message) *MessageUpdateOne {
+	return muo.SetThreadRootID(m.ID)
+}
+
+// AddThreadReplyIDs adds the "thread_replies" edge to the Message entity by IDs.
+func (muo *MessageUpdateOne) AddThreadReplyIDs(ids ...string) *MessageUpdateOne {
+	muo.mutation.AddThreadReplyIDs(ids...)
+	return muo
+}
+
+// AddThreadReplies adds the "thread_replies" edges to the Message entity.
+func (muo *MessageUpdateOne) AddThreadReplies(m ...*Message) *MessageUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.AddThreadReplyIDs(ids...)
+}
+
+// AddThreadParticipantIDs adds the "thread_participants" edge to the ThreadParticipant entity by IDs.
+func (muo *MessageUpdateOne) AddThreadParticipantIDs(ids ...string) *MessageUpdateOne {
+	muo.mutation.AddThreadParticipantIDs(ids...)
+	return muo
+}
+
+// AddThreadParticipants adds the "thread_participants" edges to the ThreadParticipant entity.
+func (muo *MessageUpdateOne) AddThreadParticipants(t ...*ThreadParticipant) *MessageUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return muo.AddThreadParticipantIDs(ids...)
+}
+
+// AddBookmarkIDs adds the "bookmarks" edge to the Bookmark entity by IDs.
+func (muo *MessageUpdateOne) AddBookmarkIDs(ids ...string) *MessageUpdateOne {
+	muo.mutation.AddBookmarkIDs(ids...)
+	return muo
+}
+
+// AddBookmarks adds the "bookmarks" edges to the Bookmark entity.
+func (muo *MessageUpdateOne) AddBookmarks(b ...*Bookmark) *MessageUpdateOne {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return muo.AddBookmarkIDs(ids...)
+}
+
+// AddHiddenForIDs adds the "hidden_for" edge to the HiddenMessage entity by IDs.
+func (muo *MessageUpdateOne) AddHiddenForIDs(ids ...string) *MessageUpdateOne {
+	muo.mutation.AddHiddenForIDs(ids...)
+	return muo
+}
+
+// AddHiddenFor adds the "hidden_for" edges to the HiddenMessage entity.
+func (muo *MessageUpdateOne) AddHiddenFor(h ...*HiddenMessage) *MessageUpdateOne {
+	ids := make([]string, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return muo.AddHiddenForIDs(ids...)
+}
+
+// Mutation returns the MessageMutation object of the builder.
+func (muo *MessageUpdateOne) Mutation() *MessageMutation {
+	return muo.mutation
+}
+
+// ClearConversation clears the "conversation" edge to the Conversation entity.
+func (muo *MessageUpdateOne) ClearConversation() *MessageUpdateOne {
+	muo.mutation.ClearConversation()
+	return muo
+}
+
+// ClearSender clears the "sender" edge to the User entity.
+func (muo *MessageUpdateOne) ClearSender() *MessageUpdateOne {
+	muo.mutation.ClearSender()
+	return muo
+}
+
+// ClearCall clears the "call" edge to the Call entity.
+func (muo *MessageUpdateOne) ClearCall() *MessageUpdateOne {
+	muo.mutation.ClearCall()
+	return muo
+}
+
+// ClearForwardedFromUser clears the "forwarded_from_user" edge to the User entity.
+func (muo *MessageUpdateOne) ClearForwardedFromUser() *MessageUpdateOne {
+	muo.mutation.ClearForwardedFromUser()
+	return muo
+}
+
+// ClearRevisions clears all "revisions" edges to the MessageRevision entity.
+func (muo *MessageUpdateOne) ClearRevisions() *MessageUpdateOne {
+	muo.mutation.ClearRevisions()
+	return muo
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to MessageRevision entities by IDs.
+func (muo *MessageUpdateOne) RemoveRevisionIDs(ids ...string) *MessageUpdateOne {
+	muo.mutation.RemoveRevisionIDs(ids...)
+	return muo
+}
+
+// RemoveRevisions removes "revisions" edges to MessageRevision entities.
+func (muo *MessageUpdateOne) RemoveRevisions(m ...*MessageRevision) *MessageUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.RemoveRevisionIDs(ids...)
+}
+
+// ClearReactions clears all "reactions" edges to the MessageReaction entity.
+func (muo *MessageUpdateOne) ClearReactions() *MessageUpdateOne {
+	muo.mutation.ClearReactions()
+	return muo
+}
+
+// RemoveReactionIDs removes the "reactions" edge to MessageReaction entities by IDs.
+func (muo *MessageUpdateOne) RemoveReactionIDs(ids ...string) *MessageUpdateOne {
+	muo.mutation.RemoveReactionIDs(ids...)
+	return muo
+}
+
+// RemoveReactions removes "reactions" edges to MessageReaction entities.
+func (muo *MessageUpdateOne) RemoveReactions(m ...*MessageReaction) *MessageUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.RemoveReactionIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (muo *MessageUpdateOne) ClearAttachments() *MessageUpdateOne {
+	muo.mutation.ClearAttachments()
+	return muo
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (muo *MessageUpdateOne) RemoveAttachmentIDs(ids ...string) *MessageUpdateOne {
+	muo.mutation.RemoveAttachmentIDs(ids...)
+	return muo
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (muo *MessageUpdateOne) RemoveAttachments(a ...*Attachment) *MessageUpdateOne {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return muo.RemoveAttachmentIDs(ids...)
+}
+
+// ClearLinkPreviews clears all "link_previews" edges to the LinkPreview entity.
+func (muo *MessageUpdateOne) ClearLinkPreviews() *MessageUpdateOne {
+	muo.mutation.ClearLinkPreviews()
+	return muo
+}
+
+// RemoveLinkPreviewIDs removes the "link_previews" edge to LinkPreview entities by IDs.
+func (muo *MessageUpdateOne) RemoveLinkPreviewIDs(ids ...string) *MessageUpdateOne {
+	muo.mutation.RemoveLinkPreviewIDs(ids...)
+	return muo
+}
+
+// RemoveLinkPreviews removes "link_previews" edges to LinkPreview entities.
+func (muo *MessageUpdateOne) RemoveLinkPreviews(l ...*LinkPreview) *MessageUpdateOne {
+	ids := make([]string, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return muo.RemoveLinkPreviewIDs(ids...)
+}
+
+// ClearMentions clears all "mentions" edges to the MessageMention entity.
+func (muo *MessageUpdateOne) ClearMentions() *MessageUpdateOne {
+	muo.mutation.ClearMentions()
+	return muo
+}
+
+// RemoveMentionIDs removes the "mentions" edge to MessageMention entities by IDs.
+func (muo *MessageUpdateOne) RemoveMentionIDs(ids ...string) *MessageUpdateOne {
+	muo.mutation.RemoveMentionIDs(ids...)
+	return muo
+}
+
+// RemoveMentions removes "mentions" edges to MessageMention entities.
+func (muo *MessageUpdateOne) RemoveMentions(m ...*MessageMention) *MessageUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.RemoveMentionIDs(ids...)
+}
+
+// ClearPins clears all "pins" edges to the PinnedMessage entity.
+func (muo *MessageUpdateOne) ClearPins() *MessageUpdateOne {
+	muo.mutation.ClearPins()
+	return muo
+}
+
+// RemovePinIDs removes the "pins" edge to PinnedMessage entities by IDs.
+func (muo *MessageUpdateOne) RemovePinIDs(ids ...string) *MessageUpdateOne {
+	muo.mutation.RemovePinIDs(ids...)
+	return muo
+}
+
+// RemovePins removes "pins" edges to PinnedMessage entities.
+func (muo *MessageUpdateOne) RemovePins(p ...*PinnedMessage) *MessageUpdateOne {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return muo.RemovePinIDs(ids...)
+}
+
+// ClearReceipts clears all "receipts" edges to the MessageReceipt entity.
+func (muo *MessageUpdateOne) ClearReceipts() *MessageUpdateOne {
+	muo.mutation.ClearReceipts()
+	return muo
+}
+
+// RemoveReceiptIDs removes the "receipts" edge to MessageReceipt entities by IDs.
+func (muo *MessageUpdateOne) RemoveReceiptIDs(ids ...string) *MessageUpdateOne {
+	muo.mutation.RemoveReceiptIDs(ids...)
+	return muo
+}
+
+// RemoveReceipts removes "receipts" edges to MessageReceipt entities.
+func (muo *MessageUpdateOne) RemoveReceipts(m ...*MessageReceipt) *MessageUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.RemoveReceiptIDs(ids...)
+}
+
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (muo *MessageUpdateOne) ClearNotifications() *MessageUpdateOne {
+	muo.mutation.ClearNotifications()
+	return muo
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (muo *MessageUpdateOne) RemoveNotificationIDs(ids ...string) *MessageUpdateOne {
+	muo.mutation.RemoveNotificationIDs(ids...)
+	return muo
+}
+
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (muo *MessageUpdateOne) RemoveNotifications(n ...*Notification) *MessageUpdateOne {
+	ids := make([]string, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return muo.RemoveNotificationIDs(ids...)
+}
+
+// ClearReplyTo clears the "reply_to" edge to the Message entity.
+func (muo *MessageUpdateOne) ClearReplyTo() *MessageUpdateOne {
+	muo.mutation.ClearReplyTo()
+	return muo
+}
+
+// ClearReplies clears all "replies" edges to the Message entity.
+func (muo *MessageUpdateOne) ClearReplies() *MessageUpdateOne {
+	muo.mutation.ClearReplies()
+	return muo
+}
+
+// RemoveReplyIDs removes the "replies" edge to Message entities by IDs.
+func (muo *MessageUpdateOne) RemoveReplyIDs(ids ...string) *MessageUpdateOne {
+	muo.mutation.RemoveReplyIDs(ids...)
+	return muo
+}
+
+// RemoveReplies removes "replies" edges to Message entities.
+func (muo *MessageUpdateOne) RemoveReplies(m ...*Message) *MessageUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.RemoveReplyIDs(ids...)
+}
+
+// ClearThreadRoot clears the "thread_root" edge to the Message entity.
+func (muo *MessageUpdateOne) ClearThreadRoot() *MessageUpdateOne {
+	muo.mutation.ClearThreadRoot()
+	return muo
+}
+
+// ClearThreadReplies clears all "thread_replies" edges to the Message entity.
+func (muo *MessageUpdateOne) ClearThreadReplies() *MessageUpdateOne {
+	muo.mutation.ClearThreadReplies()
+	return muo
+}
+
+// RemoveThreadReplyIDs removes the "thread_replies" edge to Message entities by IDs.
+func (muo *MessageUpdateOne) RemoveThreadReplyIDs(ids ...string) *MessageUpdateOne {
+	muo.mutation.RemoveThreadReplyIDs(ids...)
+	return muo
+}
+
+// RemoveThreadReplies removes "thread_replies" edges to Message entities.
+func (muo *MessageUpdateOne) RemoveThreadReplies(m ...*Message) *MessageUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.RemoveThreadReplyIDs(ids...)
+}
+
+// ClearThreadParticipants clears all "thread_participants" edges to the ThreadParticipant entity.
+func (muo *MessageUpdateOne) ClearThreadParticipants() *MessageUpdateOne {
+	muo.mutation.ClearThreadParticipants()
+	return muo
+}
+
+// RemoveThreadParticipantIDs removes the "thread_participants" edge to ThreadParticipant entities by IDs.
+func (muo *MessageUpdateOne) RemoveThreadParticipantIDs(ids ...string) *MessageUpdateOne {
+	muo.mutation.RemoveThreadParticipantIDs(ids...)
+	return muo
+}
+
+// RemoveThreadParticipants removes "thread_participants" edges to ThreadParticipant entities.
+func (muo *MessageUpdateOne) RemoveThreadParticipants(t ...*ThreadParticipant) *MessageUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return muo.RemoveThreadParticipantIDs(ids...)
+}
+
+// ClearBookmarks clears all "bookmarks" edges to the Bookmark entity.
+func (muo *MessageUpdateOne) ClearBookmarks() *MessageUpdateOne {
+	muo.mutation.ClearBookmarks()
+	return muo
+}
+
+// RemoveBookmarkIDs removes the "bookmarks" edge to Bookmark entities by IDs.
+func (muo *MessageUpdateOne) RemoveBookmarkIDs(ids ...string) *MessageUpdateOne {
+	muo.mutation.RemoveBookmarkIDs(ids...)
+	return muo
+}
+
+// RemoveBookmarks removes "bookmarks" edges to Bookmark entities.
+func (muo *MessageUpdateOne) RemoveBookmarks(b ...*Bookmark) *MessageUpdateOne {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return muo.RemoveBookmarkIDs(ids...)
+}
+
+// ClearHiddenFor clears all "hidden_for" edges to the HiddenMessage entity.
+func (muo *MessageUpdateOne) ClearHiddenFor() *MessageUpdateOne {
+	muo.mutation.ClearHiddenFor()
+	return muo
+}
+
+// RemoveHiddenForIDs removes the "hidden_for" edge to HiddenMessage entities by IDs.
+func (muo *MessageUpdateOne) RemoveHiddenForIDs(ids ...string) *MessageUpdateOne {
+	muo.mutation.RemoveHiddenForIDs(ids...)
+	return muo
+}
+
+// RemoveHiddenFor removes "hidden_for" edges to HiddenMessage entities.
+func (muo *MessageUpdateOne) RemoveHiddenFor(h ...*HiddenMessage) *MessageUpdateOne {
+	ids := make([]string, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return muo.RemoveHiddenForIDs(ids...)
+}
+
+// Where appends a list predicates to the MessageUpdate builder.
+func (muo *MessageUpdateOne) Where(ps ...predicate.Message) *MessageUpdateOne {
+	muo.mutation.Where(ps...)
+	return muo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (muo *MessageUpdateOne) Select(field string, fields ...string) *MessageUpdateOne {
+	muo.fields = append([]string{field}, fields...)
+	return muo
+}
+
+// Save executes the query and returns the updated Message entity.
+func (muo *MessageUpdateOne) Save(ctx context.Context) (*Message, error) {
+	muo.defaults()
+	return withHooks(ctx, muo.sqlSave, muo.mutation, muo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (muo *MessageUpdateOne) SaveX(ctx context.Context) *Message {
+	node, err := muo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (muo *MessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := muo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (muo *MessageUpdateOne) ExecX(ctx context.Context) {
+	if err := muo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (muo *MessageUpdateOne) defaults() {
+	if _, ok := muo.mutation.UpdatedAt(); !ok {
+		v := message.UpdateDefaultUpdatedAt()
+		muo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (muo *MessageUpdateOne) check() error {
+	if v, ok := muo.mutation.ConversationID(); ok {
+		if err := message.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "Message.conversation_id": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.SenderID(); ok {
+		if err := message.SenderIDValidator(v); err != nil {
+			return &ValidationError{Name: "sender_id", err: fmt.Errorf(`ent: validator failed for field "Message.sender_id": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.Content(); ok {
+		if err := message.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "Message.content": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.MessageType(); ok {
+		if err := message.MessageTypeValidator(v); err != nil {
+			return &ValidationError{Name: "message_type", err: fmt.Errorf(`ent: validator failed for field "Message.message_type": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.ThreadReplyCount(); ok {
+		if err := message.ThreadReplyCountValidator(v); err != nil {
+			return &ValidationError{Name: "thread_reply_count", err: fmt.Errorf(`ent: validator failed for field "Message.thread_reply_count": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.Nonce(); ok {
+		if err := message.NonceValidator(v); err != nil {
+			return &ValidationError{Name: "nonce", err: fmt.Errorf(`ent: validator failed for field "Message.nonce": %w`, err)}
+		}
+	}
+	if muo.mutation.ConversationCleared() && len(muo.mutation.ConversationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Message.conversation"`)
+	}
+	if muo.mutation.SenderCleared() && len(muo.mutation.SenderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Message.sender"`)
+	}
+	return nil
+}
+
+func (muo *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err error) {
+	if err := muo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(message.Table, message.Columns, sqlgraph.NewFieldSpec(message.FieldID, field.TypeString))
+	id, ok := muo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Message.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := muo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, message.FieldID)
+		for _, f := range fields {
+			if !message.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != message.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := muo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := muo.mutation.CreatedAt(); ok {
+		_spec.SetField(message.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := muo.mutation.UpdatedAt(); ok {
+		_spec.SetField(message.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := muo.mutation.Content(); ok {
+		_spec.SetField(message.FieldContent, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.PlainText(); ok {
+		_spec.SetField(message.FieldPlainText, field.TypeString, value)
+	}
+	if muo.mutation.PlainTextCleared() {
+		_spec.ClearField(message.FieldPlainText, field.TypeString)
+	}
+	if value, ok := muo.mutation.Entities(); ok {
+		_spec.SetField(message.FieldEntities, field.TypeJSON, value)
+	}
+	if value, ok := muo.mutation.AppendedEntities(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, message.FieldEntities, value)
+		})
+	}
+	if muo.mutation.EntitiesCleared() {
+		_spec.ClearField(message.FieldEntities, field.TypeJSON)
+	}
+	if value, ok := muo.mutation.MessageType(); ok {
+		_spec.SetField(message.FieldMessageType, field.TypeEnum, value)
+	}
+	if value, ok := muo.mutation.IsDeleted(); ok {
+		_spec.SetField(message.FieldIsDeleted, field.TypeBool, value)
+	}
+	if value, ok := muo.mutation.DeletedAt(); ok {
+		_spec.SetField(message.FieldDeletedAt, field.TypeTime, value)
+	}
+	if muo.mutation.DeletedAtCleared() {
+		_spec.ClearField(message.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := muo.mutation.EditedAt(); ok {
+		_spec.SetField(message.FieldEditedAt, field.TypeTime, value)
+	}
+	if muo.mutation.EditedAtCleared() {
+		_spec.ClearField(message.FieldEditedAt, field.TypeTime)
+	}
+	if value, ok := muo.mutation.ThreadReplyCount(); ok {
+		_spec.SetField(message.FieldThreadReplyCount, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedThreadReplyCount(); ok {
+		_spec.AddField(message.FieldThreadReplyCount, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.ThreadLastReplyAt(); ok {
+		_spec.SetField(message.FieldThreadLastReplyAt, field.TypeTime, value)
+	}
+	if muo.mutation.ThreadLastReplyAtCleared() {
+		_spec.ClearField(message.FieldThreadLastReplyAt, field.TypeTime)
+	}
+	if value, ok := muo.mutation.SuppressEmbeds(); ok {
+		_spec.SetField(message.FieldSuppressEmbeds, field.TypeBool, value)
+	}
+	if value, ok := muo.mutation.ForwardedFromMessageID(); ok {
+		_spec.SetField(message.FieldForwardedFromMessageID, field.TypeString, value)
+	}
+	if muo.mutation.ForwardedFromMessageIDCleared() {
+		_spec.ClearField(message.FieldForwardedFromMessageID, field.TypeString)
+	}
+	if value, ok := muo.mutation.Nonce(); ok {
+		_spec.SetField(message.FieldNonce, field.TypeString, value)
+	}
+	if muo.mutation.NonceCleared() {
+		_spec.ClearField(message.FieldNonce, field.TypeString)
+	}
+	if muo.mutation.ConversationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.ConversationTable,
+			Columns: []string{message.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.ConversationTable,
+			Columns: []string{message.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.SenderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.SenderTable,
+			Columns: []string{message.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.SenderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.SenderTable,
+			Columns: []string{message.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.CallCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.CallTable,
+			Columns: []string{message.CallColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(call.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.CallIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.CallTable,
+			Columns: []string{message.CallColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(call.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.ForwardedFromUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.ForwardedFromUserTable,
+			Columns: []string{message.ForwardedFromUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.ForwardedFromUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.ForwardedFromUserTable,
+			Columns: []string{message.ForwardedFromUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !muo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedReactionsIDs(); len(nodes) > 0 && !muo.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return muo
-}
-
-// SetMessageType sets the "message_type" field.
-func (muo *MessageUpdateOne) SetMessageType(mt message.MessageType) *MessageUpdateOne {
-	muo.mutation.SetMessageType(mt)
-	return muo
-}
-
-// SetNillableMessageType sets the "message_type" field if the given value is not nil.
-func (muo *MessageUpdateOne) SetNillableMessageType(mt *message.MessageType) *MessageUpdateOne {
-	if mt != nil {
-		muo.SetMessageType(*mt)
+	if muo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.AttachmentsTable,
+			Columns: []string{message.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return muo
-}
-
-// SetIsDeleted sets the "is_deleted" field.
-func (muo *MessageUpdateOne) SetIsDeleted(b bool) *MessageUpdateOne {
-	muo.mutation.SetIsDeleted(b)
-	return muo
-}
-
-// SetNillableIsDeleted sets the "is_deleted" field if the given value is not nil.
-func (muo *MessageUpdateOne) SetNillableIsDeleted(b *bool) *MessageUpdateOne {
-	if b != nil {
-		muo.SetIsDeleted(*b)
+	if nodes := muo.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !muo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.AttachmentsTable,
+			Columns: []string{message.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return muo
-}
-
-// SetEditedAt sets the "edited_at" field.
-func (muo *MessageUpdateOne) SetEditedAt(t time.Time) *MessageUpdateOne {
-	muo.mutation.SetEditedAt(t)
-	return muo
-}
-
-// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
-func (muo *MessageUpdateOne) SetNillableEditedAt(t *time.Time) *MessageUpdateOne {
-	if t != nil {
-		muo.SetEditedAt(*t)
+	if nodes := muo.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.AttachmentsTable,
+			Columns: []string{message.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return muo
-}
-
-// ClearEditedAt clears the value of the "edited_at" field.
-func (muo *MessageUpdateOne) ClearEditedAt() *MessageUpdateOne {
-	muo.mutation.ClearEditedAt()
-	return muo
-}
-
-// SetCallID sets the "call_id" field.
-func (muo *MessageUpdateOne) SetCallID(s string) *MessageUpdateOne {
-	muo.mutation.SetCallID(s)
-	return muo
-}
-
-// SetNillableCallID sets the "call_id" field if the given value is not nil.
-func (muo *MessageUpdateOne) SetNillableCallID(s *string) *MessageUpdateOne {
-	if s != nil {
-		muo.SetCallID(*s)
+	if muo.mutation.LinkPreviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.LinkPreviewsTable,
+			Columns: []string{message.LinkPreviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkpreview.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return muo
-}
-
-// ClearCallID clears the value of the "call_id" field.
-func (muo *MessageUpdateOne) ClearCallID() *MessageUpdateOne {
-	muo.mutation.ClearCallID()
-	return muo
-}
-
-// SetConversation sets the "conversation" edge to the Conversation entity.
-func (muo *MessageUpdateOne) SetConversation(c *Conversation) *MessageUpdateOne {
-	return muo.SetConversationID(c.ID)
-}
-
-// SetSender sets the "sender" edge to the User entity.
-func (muo *MessageUpdateOne) SetSender(u *User) *MessageUpdateOne {
-	return muo.SetSenderID(u.ID)
-}
-
-// SetCall sets the "call" edge to the Call entity.
-func (muo *MessageUpdateOne) SetCall(c *Call) *MessageUpdateOne {
-	return muo.SetCallID(c.ID)
-}
-
-// Mutation returns the MessageMutation object of the builder.
-func (muo *MessageUpdateOne) Mutation() *MessageMutation {
-	return muo.mutation
-}
-
-// ClearConversation clears the "conversation" edge to the Conversation entity.
-func (muo *MessageUpdateOne) ClearConversation() *MessageUpdateOne {
-	muo.mutation.ClearConversation()
-	return muo
-}
-
-// ClearSender clears the "sender" edge to the User entity.
-func (muo *MessageUpdateOne) ClearSender() *MessageUpdateOne {
-	muo.mutation.ClearSender()
-	return muo
-}
-
-// ClearCall clears the "call" edge to the Call entity.
-func (muo *MessageUpdateOne) ClearCall() *MessageUpdateOne {
-	muo.mutation.ClearCall()
-	return muo
-}
-
-// Where appends a list predicates to the MessageUpdate builder.
-func (muo *MessageUpdateOne) Where(ps ...predicate.Message) *MessageUpdateOne {
-	muo.mutation.Where(ps...)
-	return muo
-}
-
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (muo *MessageUpdateOne) Select(field string, fields ...string) *MessageUpdateOne {
-	muo.fields = append([]string{field}, fields...)
-	return muo
-}
-
-// Save executes the query and returns the updated Message entity.
-func (muo *MessageUpdateOne) Save(ctx context.Context) (*Message, error) {
-	muo.defaults()
-	return withHooks(ctx, muo.sqlSave, muo.mutation, muo.hooks)
-}
-
-// SaveX is like Save, but panics if an error occurs.
-func (muo *MessageUpdateOne) SaveX(ctx context.Context) *Message {
-	node, err := muo.Save(ctx)
-	if err != nil {
-		panic(err)
+	if nodes := muo.mutation.RemovedLinkPreviewsIDs(); len(nodes) > 0 && !muo.mutation.LinkPreviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.LinkPreviewsTable,
+			Columns: []string{message.LinkPreviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkpreview.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return node
-}
-
-// Exec executes the query on the entity.
-func (muo *MessageUpdateOne) Exec(ctx context.Context) error {
-	_, err := muo.Save(ctx)
-	return err
-}
-
-// ExecX is like Exec, but panics if an error occurs.
-func (muo *MessageUpdateOne) ExecX(ctx context.Context) {
-	if err := muo.Exec(ctx); err != nil {
-		panic(err)
+	if nodes := muo.mutation.LinkPreviewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.LinkPreviewsTable,
+			Columns: []string{message.LinkPreviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(linkpreview.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-}
-
-// defaults sets the default values of the builder before save.
-func (muo *MessageUpdateOne) defaults() {
-	if _, ok := muo.mutation.UpdatedAt(); !ok {
-		v := message.UpdateDefaultUpdatedAt()
-		muo.mutation.SetUpdatedAt(v)
+	if muo.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.MentionsTable,
+			Columns: []string{message.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedMentionsIDs(); len(nodes) > 0 && !muo.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.MentionsTable,
+			Columns: []string{message.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.MentionsTable,
+			Columns: []string{message.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.PinsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.PinsTable,
+			Columns: []string{message.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedPinsIDs(); len(nodes) > 0 && !muo.mutation.PinsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.PinsTable,
+			Columns: []string{message.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.PinsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.PinsTable,
+			Columns: []string{message.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-}
-
-// check runs all checks and user-defined validators on the builder.
-func (muo *MessageUpdateOne) check() error {
-	if v, ok := muo.mutation.ConversationID(); ok {
-		if err := message.ConversationIDValidator(v); err != nil {
-			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "Message.conversation_id": %w`, err)}
+	if muo.mutation.ReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.ReceiptsTable,
+			Columns: []string{message.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereceipt.FieldID, field.TypeString),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := muo.mutation.SenderID(); ok {
-		if err := message.SenderIDValidator(v); err != nil {
-			return &ValidationError{Name: "sender_id", err: fmt.Errorf(`ent: validator failed for field "Message.sender_id": %w`, err)}
+	if nodes := muo.mutation.RemovedReceiptsIDs(); len(nodes) > 0 && !muo.mutation.ReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.ReceiptsTable,
+			Columns: []string{message.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereceipt.FieldID, field.TypeString),
+			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := muo.mutation.Content(); ok {
-		if err := message.ContentValidator(v); err != nil {
-			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "Message.content": %w`, err)}
+	if nodes := muo.mutation.ReceiptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.ReceiptsTable,
+			Columns: []string{message.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereceipt.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if v, ok := muo.mutation.MessageType(); ok {
-		if err := message.MessageTypeValidator(v); err != nil {
-			return &ValidationError{Name: "message_type", err: fmt.Errorf(`ent: validator failed for field "Message.message_type": %w`, err)}
+	if muo.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.NotificationsTable,
+			Columns: []string{message.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if muo.mutation.ConversationCleared() && len(muo.mutation.ConversationIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "Message.conversation"`)
+	if nodes := muo.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !muo.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.NotificationsTable,
+			Columns: []string{message.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if muo.mutation.SenderCleared() && len(muo.mutation.SenderIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "Message.sender"`)
+	if nodes := muo.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.NotificationsTable,
+			Columns: []string{message.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return nil
-}
-
-func (muo *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err error) {
-	if err := muo.check(); err != nil {
-		return _node, err
+	if muo.mutation.ReplyToCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.ReplyToTable,
+			Columns: []string{message.ReplyToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_spec := sqlgraph.NewUpdateSpec(message.Table, message.Columns, sqlgraph.NewFieldSpec(message.FieldID, field.TypeString))
-	id, ok := muo.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Message.id" for update`)}
+	if nodes := muo.mutation.ReplyToIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.ReplyToTable,
+			Columns: []string{message.ReplyToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec.Node.ID.Value = id
-	if fields := muo.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, message.FieldID)
-		for _, f := range fields {
-			if !message.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != message.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	if muo.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RepliesTable,
+			Columns: []string{message.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if ps := muo.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if nodes := muo.mutation.RemovedRepliesIDs(); len(nodes) > 0 && !muo.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RepliesTable,
+			Columns: []string{message.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := muo.mutation.CreatedAt(); ok {
-		_spec.SetField(message.FieldCreatedAt, field.TypeTime, value)
+	if nodes := muo.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RepliesTable,
+			Columns: []string{message.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := muo.mutation.UpdatedAt(); ok {
-		_spec.SetField(message.FieldUpdatedAt, field.TypeTime, value)
+	if muo.mutation.ThreadRootCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.ThreadRootTable,
+			Columns: []string{message.ThreadRootColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := muo.mutation.Content(); ok {
-		_spec.SetField(message.FieldContent, field.TypeString, value)
+	if nodes := muo.mutation.ThreadRootIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.ThreadRootTable,
+			Columns: []string{message.ThreadRootColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := muo.mutation.MessageType(); ok {
-		_spec.SetField(message.FieldMessageType, field.TypeEnum, value)
+	if muo.mutation.ThreadRepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ThreadRepliesTable,
+			Columns: []string{message.ThreadRepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := muo.mutation.IsDeleted(); ok {
-		_spec.SetField(message.FieldIsDeleted, field.TypeBool, value)
+	if nodes := muo.mutation.RemovedThreadRepliesIDs(); len(nodes) > 0 && !muo.mutation.ThreadRepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ThreadRepliesTable,
+			Columns: []string{message.ThreadRepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := muo.mutation.EditedAt(); ok {
-		_spec.SetField(message.FieldEditedAt, field.TypeTime, value)
+	if nodes := muo.mutation.ThreadRepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ThreadRepliesTable,
+			Columns: []string{message.ThreadRepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if muo.mutation.EditedAtCleared() {
-		_spec.ClearField(message.FieldEditedAt, field.TypeTime)
+	if muo.mutation.ThreadParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.ThreadParticipantsTable,
+			Columns: []string{message.ThreadParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(threadparticipant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if muo.mutation.ConversationCleared() {
+	if nodes := muo.mutation.RemovedThreadParticipantsIDs(); len(nodes) > 0 && !muo.mutation.ThreadParticipantsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: false,
-			Table:   message.ConversationTable,
-			Columns: []string{message.ConversationColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.ThreadParticipantsTable,
+			Columns: []string{message.ThreadParticipantsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(threadparticipant.FieldID, field.TypeString),
 			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := muo.mutation.ConversationIDs(); len(nodes) > 0 {
+	if nodes := muo.mutation.ThreadParticipantsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: false,
-			Table:   message.ConversationTable,
-			Columns: []string{message.ConversationColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.ThreadParticipantsTable,
+			Columns: []string{message.ThreadParticipantsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(threadparticipant.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -733,28 +3606,44 @@ func (muo *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if muo.mutation.SenderCleared() {
+	if muo.mutation.BookmarksCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: false,
-			Table:   message.SenderTable,
-			Columns: []string{message.SenderColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.BookmarksTable,
+			Columns: []string{message.BookmarksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := muo.mutation.SenderIDs(); len(nodes) > 0 {
+	if nodes := muo.mutation.RemovedBookmarksIDs(); len(nodes) > 0 && !muo.mutation.BookmarksCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: false,
-			Table:   message.SenderTable,
-			Columns: []string{message.SenderColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.BookmarksTable,
+			Columns: []string{message.BookmarksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.BookmarksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.BookmarksTable,
+			Columns: []string{message.BookmarksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -762,28 +3651,44 @@ func (muo *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if muo.mutation.CallCleared() {
+	if muo.mutation.HiddenForCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: false,
-			Table:   message.CallTable,
-			Columns: []string{message.CallColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.HiddenForTable,
+			Columns: []string{message.HiddenForColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(call.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(hiddenmessage.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := muo.mutation.CallIDs(); len(nodes) > 0 {
+	if nodes := muo.mutation.RemovedHiddenForIDs(); len(nodes) > 0 && !muo.mutation.HiddenForCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: false,
-			Table:   message.CallTable,
-			Columns: []string{message.CallColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.HiddenForTable,
+			Columns: []string{message.HiddenForColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(call.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(hiddenmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.HiddenForIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.HiddenForTable,
+			Columns: []string{message.HiddenForColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hiddenmessage.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
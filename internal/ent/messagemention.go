@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/messagemention"
+	"kakashi/chaos/internal/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// MessageMention is the model entity for the MessageMention schema.
+type MessageMention struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// MessageID holds the value of the "message_id" field.
+	MessageID string `json:"message_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind messagemention.Kind `json:"kind,omitempty"`
+	// Mentioned user, empty for @everyone
+	UserID string `json:"user_id,omitempty"`
+	// Start of the mention in the content, in UTF-16 code units
+	Offset int `json:"offset,omitempty"`
+	// Length of the mention in UTF-16 code units
+	Length int `json:"length,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MessageMentionQuery when eager-loading is set.
+	Edges        MessageMentionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MessageMentionEdges holds the relations/edges for other nodes in the graph.
+type MessageMentionEdges struct {
+	// Message holds the value of the message edge.
+	Message *Message `json:"message,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// MessageOrErr returns the Message value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageMentionEdges) MessageOrErr() (*Message, error) {
+	if e.Message != nil {
+		return e.Message, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "message"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageMentionEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MessageMention) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case messagemention.FieldOffset, messagemention.FieldLength:
+			values[i] = new(sql.NullInt64)
+		case messagemention.FieldID, messagemention.FieldMessageID, messagemention.FieldKind, messagemention.FieldUserID:
+			values[i] = new(sql.NullString)
+		case messagemention.FieldCreatedAt, messagemention.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MessageMention fields.
+func (mm *MessageMention) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case messagemention.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				mm.ID = value.String
+			}
+		case messagemention.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mm.CreatedAt = value.Time
+			}
+		case messagemention.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				mm.UpdatedAt = value.Time
+			}
+		case messagemention.FieldMessageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value.Valid {
+				mm.MessageID = value.String
+			}
+		case messagemention.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				mm.Kind = messagemention.Kind(value.String)
+			}
+		case messagemention.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				mm.UserID = value.String
+			}
+		case messagemention.FieldOffset:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field offset", values[i])
+			} else if value.Valid {
+				mm.Offset = int(value.Int64)
+			}
+		case messagemention.FieldLength:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field length", values[i])
+			} else if value.Valid {
+				mm.Length = int(value.Int64)
+			}
+		default:
+			mm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MessageMention.
+// This includes values selected through modifiers, order, etc.
+func (mm *MessageMention) Value(name string) (ent.Value, error) {
+	return mm.selectValues.Get(name)
+}
+
+// QueryMessage queries the "message" edge of the MessageMention entity.
+func (mm *MessageMention) QueryMessage() *MessageQuery {
+	return NewMessageMentionClient(mm.config).QueryMessage(mm)
+}
+
+// QueryUser queries the "user" edge of the MessageMention entity.
+func (mm *MessageMention) QueryUser() *UserQuery {
+	return NewMessageMentionClient(mm.config).QueryUser(mm)
+}
+
+// Update returns a builder for updating this MessageMention.
+// Note that you need to call MessageMention.Unwrap() before calling this method if this MessageMention
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mm *MessageMention) Update() *MessageMentionUpdateOne {
+	return NewMessageMentionClient(mm.config).UpdateOne(mm)
+}
+
+// Unwrap unwraps the MessageMention entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mm *MessageMention) Unwrap() *MessageMention {
+	_tx, ok := mm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MessageMention is not a transactional entity")
+	}
+	mm.config.driver = _tx.drv
+	return mm
+}
+
+// String implements the fmt.Stringer.
+func (mm *MessageMention) String() string {
+	var builder strings.Builder
+	builder.WriteString("MessageMention(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mm.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(mm.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(mm.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("message_id=")
+	builder.WriteString(mm.MessageID)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", mm.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(mm.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("offset=")
+	builder.WriteString(fmt.Sprintf("%v", mm.Offset))
+	builder.WriteString(", ")
+	builder.WriteString("length=")
+	builder.WriteString(fmt.Sprintf("%v", mm.Length))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MessageMentions is a parsable slice of MessageMention.
+type MessageMentions []*MessageMention
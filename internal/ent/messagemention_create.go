@@ -0,0 +1,381 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/messagemention"
+	"kakashi/chaos/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageMentionCreate is the builder for creating a MessageMention entity.
+type MessageMentionCreate struct {
+	config
+	mutation *MessageMentionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mmc *MessageMentionCreate) SetCreatedAt(t time.Time) *MessageMentionCreate {
+	mmc.mutation.SetCreatedAt(t)
+	return mmc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mmc *MessageMentionCreate) SetNillableCreatedAt(t *time.Time) *MessageMentionCreate {
+	if t != nil {
+		mmc.SetCreatedAt(*t)
+	}
+	return mmc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mmc *MessageMentionCreate) SetUpdatedAt(t time.Time) *MessageMentionCreate {
+	mmc.mutation.SetUpdatedAt(t)
+	return mmc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mmc *MessageMentionCreate) SetNillableUpdatedAt(t *time.Time) *MessageMentionCreate {
+	if t != nil {
+		mmc.SetUpdatedAt(*t)
+	}
+	return mmc
+}
+
+// SetMessageID sets the "message_id" field.
+func (mmc *MessageMentionCreate) SetMessageID(s string) *MessageMentionCreate {
+	mmc.mutation.SetMessageID(s)
+	return mmc
+}
+
+// SetKind sets the "kind" field.
+func (mmc *MessageMentionCreate) SetKind(m messagemention.Kind) *MessageMentionCreate {
+	mmc.mutation.SetKind(m)
+	return mmc
+}
+
+// SetUserID sets the "user_id" field.
+func (mmc *MessageMentionCreate) SetUserID(s string) *MessageMentionCreate {
+	mmc.mutation.SetUserID(s)
+	return mmc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (mmc *MessageMentionCreate) SetNillableUserID(s *string) *MessageMentionCreate {
+	if s != nil {
+		mmc.SetUserID(*s)
+	}
+	return mmc
+}
+
+// SetOffset sets the "offset" field.
+func (mmc *MessageMentionCreate) SetOffset(i int) *MessageMentionCreate {
+	mmc.mutation.SetOffset(i)
+	return mmc
+}
+
+// SetLength sets the "length" field.
+func (mmc *MessageMentionCreate) SetLength(i int) *MessageMentionCreate {
+	mmc.mutation.SetLength(i)
+	return mmc
+}
+
+// SetID sets the "id" field.
+func (mmc *MessageMentionCreate) SetID(s string) *MessageMentionCreate {
+	mmc.mutation.SetID(s)
+	return mmc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (mmc *MessageMentionCreate) SetNillableID(s *string) *MessageMentionCreate {
+	if s != nil {
+		mmc.SetID(*s)
+	}
+	return mmc
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (mmc *MessageMentionCreate) SetMessage(m *Message) *MessageMentionCreate {
+	return mmc.SetMessageID(m.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (mmc *MessageMentionCreate) SetUser(u *User) *MessageMentionCreate {
+	return mmc.SetUserID(u.ID)
+}
+
+// Mutation returns the MessageMentionMutation object of the builder.
+func (mmc *MessageMentionCreate) Mutation() *MessageMentionMutation {
+	return mmc.mutation
+}
+
+// Save creates the MessageMention in the database.
+func (mmc *MessageMentionCreate) Save(ctx context.Context) (*MessageMention, error) {
+	mmc.defaults()
+	return withHooks(ctx, mmc.sqlSave, mmc.mutation, mmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mmc *MessageMentionCreate) SaveX(ctx context.Context) *MessageMention {
+	v, err := mmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mmc *MessageMentionCreate) Exec(ctx context.Context) error {
+	_, err := mmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mmc *MessageMentionCreate) ExecX(ctx context.Context) {
+	if err := mmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mmc *MessageMentionCreate) defaults() {
+	if _, ok := mmc.mutation.CreatedAt(); !ok {
+		v := messagemention.DefaultCreatedAt()
+		mmc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mmc.mutation.UpdatedAt(); !ok {
+		v := messagemention.DefaultUpdatedAt()
+		mmc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := mmc.mutation.ID(); !ok {
+		v := messagemention.DefaultID()
+		mmc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mmc *MessageMentionCreate) check() error {
+	if _, ok := mmc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MessageMention.created_at"`)}
+	}
+	if _, ok := mmc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "MessageMention.updated_at"`)}
+	}
+	if _, ok := mmc.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "MessageMention.message_id"`)}
+	}
+	if v, ok := mmc.mutation.MessageID(); ok {
+		if err := messagemention.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "MessageMention.message_id": %w`, err)}
+		}
+	}
+	if _, ok := mmc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "MessageMention.kind"`)}
+	}
+	if v, ok := mmc.mutation.Kind(); ok {
+		if err := messagemention.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "MessageMention.kind": %w`, err)}
+		}
+	}
+	if _, ok := mmc.mutation.Offset(); !ok {
+		return &ValidationError{Name: "offset", err: errors.New(`ent: missing required field "MessageMention.offset"`)}
+	}
+	if v, ok := mmc.mutation.Offset(); ok {
+		if err := messagemention.OffsetValidator(v); err != nil {
+			return &ValidationError{Name: "offset", err: fmt.Errorf(`ent: validator failed for field "MessageMention.offset": %w`, err)}
+		}
+	}
+	if _, ok := mmc.mutation.Length(); !ok {
+		return &ValidationError{Name: "length", err: errors.New(`ent: missing required field "MessageMention.length"`)}
+	}
+	if v, ok := mmc.mutation.Length(); ok {
+		if err := messagemention.LengthValidator(v); err != nil {
+			return &ValidationError{Name: "length", err: fmt.Errorf(`ent: validator failed for field "MessageMention.length": %w`, err)}
+		}
+	}
+	if len(mmc.mutation.MessageIDs()) == 0 {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required edge "MessageMention.message"`)}
+	}
+	return nil
+}
+
+func (mmc *MessageMentionCreate) sqlSave(ctx context.Context) (*MessageMention, error) {
+	if err := mmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected MessageMention.ID type: %T", _spec.ID.Value)
+		}
+	}
+	mmc.mutation.id = &_node.ID
+	mmc.mutation.done = true
+	return _node, nil
+}
+
+func (mmc *MessageMentionCreate) createSpec() (*MessageMention, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MessageMention{config: mmc.config}
+		_spec = sqlgraph.NewCreateSpec(messagemention.Table, sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeString))
+	)
+	if id, ok := mmc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := mmc.mutation.CreatedAt(); ok {
+		_spec.SetField(messagemention.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mmc.mutation.UpdatedAt(); ok {
+		_spec.SetField(messagemention.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := mmc.mutation.Kind(); ok {
+		_spec.SetField(messagemention.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := mmc.mutation.Offset(); ok {
+		_spec.SetField(messagemention.FieldOffset, field.TypeInt, value)
+		_node.Offset = value
+	}
+	if value, ok := mmc.mutation.Length(); ok {
+		_spec.SetField(messagemention.FieldLength, field.TypeInt, value)
+		_node.Length = value
+	}
+	if nodes := mmc.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messagemention.MessageTable,
+			Columns: []string{messagemention.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mmc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messagemention.UserTable,
+			Columns: []string{messagemention.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MessageMentionCreateBulk is the builder for creating many MessageMention entities in bulk.
+type MessageMentionCreateBulk struct {
+	config
+	err      error
+	builders []*MessageMentionCreate
+}
+
+// Save creates the MessageMention entities in the database.
+func (mmcb *MessageMentionCreateBulk) Save(ctx context.Context) ([]*MessageMention, error) {
+	if mmcb.err != nil {
+		return nil, mmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mmcb.builders))
+	nodes := make([]*MessageMention, len(mmcb.builders))
+	mutators := make([]Mutator, len(mmcb.builders))
+	for i := range mmcb.builders {
+		func(i int, root context.Context) {
+			builder := mmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MessageMentionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mmcb *MessageMentionCreateBulk) SaveX(ctx context.Context) []*MessageMention {
+	v, err := mmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mmcb *MessageMentionCreateBulk) Exec(ctx context.Context) error {
+	_, err := mmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mmcb *MessageMentionCreateBulk) ExecX(ctx context.Context) {
+	if err := mmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"kakashi/chaos/internal/ent/messagemention"
+	"kakashi/chaos/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageMentionDelete is the builder for deleting a MessageMention entity.
+type MessageMentionDelete struct {
+	config
+	hooks    []Hook
+	mutation *MessageMentionMutation
+}
+
+// Where appends a list predicates to the MessageMentionDelete builder.
+func (mmd *MessageMentionDelete) Where(ps ...predicate.MessageMention) *MessageMentionDelete {
+	mmd.mutation.Where(ps...)
+	return mmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mmd *MessageMentionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mmd.sqlExec, mmd.mutation, mmd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mmd *MessageMentionDelete) ExecX(ctx context.Context) int {
+	n, err := mmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mmd *MessageMentionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(messagemention.Table, sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeString))
+	if ps := mmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mmd.mutation.done = true
+	return affected, err
+}
+
+// MessageMentionDeleteOne is the builder for deleting a single MessageMention entity.
+type MessageMentionDeleteOne struct {
+	mmd *MessageMentionDelete
+}
+
+// Where appends a list predicates to the MessageMentionDelete builder.
+func (mmdo *MessageMentionDeleteOne) Where(ps ...predicate.MessageMention) *MessageMentionDeleteOne {
+	mmdo.mmd.mutation.Where(ps...)
+	return mmdo
+}
+
+// Exec executes the deletion query.
+func (mmdo *MessageMentionDeleteOne) Exec(ctx context.Context) error {
+	n, err := mmdo.mmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{messagemention.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mmdo *MessageMentionDeleteOne) ExecX(ctx context.Context) {
+	if err := mmdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/messagemention"
+	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/user"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageMentionQuery is the builder for querying MessageMention entities.
+type MessageMentionQuery struct {
+	config
+	ctx         *QueryContext
+	order       []messagemention.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.MessageMention
+	withMessage *MessageQuery
+	withUser    *UserQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MessageMentionQuery builder.
+func (mmq *MessageMentionQuery) Where(ps ...predicate.MessageMention) *MessageMentionQuery {
+	mmq.predicates = append(mmq.predicates, ps...)
+	return mmq
+}
+
+// Limit the number of records to be returned by this query.
+func (mmq *MessageMentionQuery) Limit(limit int) *MessageMentionQuery {
+	mmq.ctx.Limit = &limit
+	return mmq
+}
+
+// Offset to start from.
+func (mmq *MessageMentionQuery) Offset(offset int) *MessageMentionQuery {
+	mmq.ctx.Offset = &offset
+	return mmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mmq *MessageMentionQuery) Unique(unique bool) *MessageMentionQuery {
+	mmq.ctx.Unique = &unique
+	return mmq
+}
+
+// Order specifies how the records should be ordered.
+func (mmq *MessageMentionQuery) Order(o ...messagemention.OrderOption) *MessageMentionQuery {
+	mmq.order = append(mmq.order, o...)
+	return mmq
+}
+
+// QueryMessage chains the current query on the "message" edge.
+func (mmq *MessageMentionQuery) QueryMessage() *MessageQuery {
+	query := (&MessageClient{config: mmq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(messagemention.Table, messagemention.FieldID, selector),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, messagemention.MessageTable, messagemention.MessageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (mmq *MessageMentionQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: mmq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(messagemention.Table, messagemention.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, messagemention.UserTable, messagemention.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first MessageMention entity from the query.
+// Returns a *NotFoundError when no MessageMention was found.
+func (mmq *MessageMentionQuery) First(ctx context.Context) (*MessageMention, error) {
+	nodes, err := mmq.Limit(1).All(setContextOp(ctx, mmq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{messagemention.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mmq *MessageMentionQuery) FirstX(ctx context.Context) *MessageMention {
+	node, err := mmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MessageMention ID from the query.
+// Returns a *NotFoundError when no MessageMention ID was found.
+func (mmq *MessageMentionQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = mmq.Limit(1).IDs(setContextOp(ctx, mmq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{messagemention.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mmq *MessageMentionQuery) FirstIDX(ctx context.Context) string {
+	id, err := mmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MessageMention entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MessageMention entity is found.
+// Returns a *NotFoundError when no MessageMention entities are found.
+func (mmq *MessageMentionQuery) Only(ctx context.Context) (*MessageMention, error) {
+	nodes, err := mmq.Limit(2).All(setContextOp(ctx, mmq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{messagemention.Label}
+	default:
+		return nil, &NotSingularError{messagemention.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mmq *MessageMentionQuery) OnlyX(ctx context.Context) *MessageMention {
+	node, err := mmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MessageMention ID in the query.
+// Returns a *NotSingularError when more than one MessageMention ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mmq *MessageMentionQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = mmq.Limit(2).IDs(setContextOp(ctx, mmq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{messagemention.Label}
+	default:
+		err = &NotSingularError{messagemention.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mmq *MessageMentionQuery) OnlyIDX(ctx context.Context) string {
+	id, err := mmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MessageMentions.
+func (mmq *MessageMentionQuery) All(ctx context.Context) ([]*MessageMention, error) {
+	ctx = setContextOp(ctx, mmq.ctx, ent.OpQueryAll)
+	if err := mmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MessageMention, *MessageMentionQuery]()
+	return withInterceptors[[]*MessageMention](ctx, mmq, qr, mmq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mmq *MessageMentionQuery) AllX(ctx context.Context) []*MessageMention {
+	nodes, err := mmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MessageMention IDs.
+func (mmq *MessageMentionQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if mmq.ctx.Unique == nil && mmq.path != nil {
+		mmq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mmq.ctx, ent.OpQueryIDs)
+	if err = mmq.Select(messagemention.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mmq *MessageMentionQuery) IDsX(ctx context.Context) []string {
+	ids, err := mmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mmq *MessageMentionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mmq.ctx, ent.OpQueryCount)
+	if err := mmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mmq, querierCount[*MessageMentionQuery](), mmq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mmq *MessageMentionQuery) CountX(ctx context.Context) int {
+	count, err := mmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mmq *MessageMentionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mmq.ctx, ent.OpQueryExist)
+	switch _, err := mmq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mmq *MessageMentionQuery) ExistX(ctx context.Context) bool {
+	exist, err := mmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MessageMentionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mmq *MessageMentionQuery) Clone() *MessageMentionQuery {
+	if mmq == nil {
+		return nil
+	}
+	return &MessageMentionQuery{
+		config:      mmq.config,
+		ctx:         mmq.ctx.Clone(),
+		order:       append([]messagemention.OrderOption{}, mmq.order...),
+		inters:      append([]Interceptor{}, mmq.inters...),
+		predicates:  append([]predicate.MessageMention{}, mmq.predicates...),
+		withMessage: mmq.withMessage.Clone(),
+		withUser:    mmq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  mmq.sql.Clone(),
+		path: mmq.path,
+	}
+}
+
+// WithMessage tells the query-builder to eager-load the nodes that are connected to
+// the "message" edge. The optional arguments are used to configure the query builder of the edge.
+func (mmq *MessageMentionQuery) WithMessage(opts ...func(*MessageQuery)) *MessageMentionQuery {
+	query := (&MessageClient{config: mmq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mmq.withMessage = query
+	return mmq
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (mmq *MessageMentionQuery) WithUser(opts ...func(*UserQuery)) *MessageMentionQuery {
+	query := (&UserClient{config: mmq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mmq.withUser = query
+	return mmq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MessageMention.Query().
+//		GroupBy(messagemention.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mmq *MessageMentionQuery) GroupBy(field string, fields ...string) *MessageMentionGroupBy {
+	mmq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MessageMentionGroupBy{build: mmq}
+	grbuild.flds = &mmq.ctx.Fields
+	grbuild.label = messagemention.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.MessageMention.Query().
+//		Select(messagemention.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (mmq *MessageMentionQuery) Select(fields ...string) *MessageMentionSelect {
+	mmq.ctx.Fields = append(mmq.ctx.Fields, fields...)
+	sbuild := &MessageMentionSelect{MessageMentionQuery: mmq}
+	sbuild.label = messagemention.Label
+	sbuild.flds, sbuild.scan = &mmq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MessageMentionSelect configured with the given aggregations.
+func (mmq *MessageMentionQuery) Aggregate(fns ...AggregateFunc) *MessageMentionSelect {
+	return mmq.Select().Aggregate(fns...)
+}
+
+func (mmq *MessageMentionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mmq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mmq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mmq.ctx.Fields {
+		if !messagemention.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mmq.path != nil {
+		prev, err := mmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mmq.sql = prev
+	}
+	return nil
+}
+
+func (mmq *MessageMentionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MessageMention, error) {
+	var (
+		nodes       = []*MessageMention{}
+		_spec       = mmq.querySpec()
+		loadedTypes = [2]bool{
+			mmq.withMessage != nil,
+			mmq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MessageMention).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MessageMention{config: mmq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := mmq.withMessage; query != nil {
+		if err := mmq.loadMessage(ctx, query, nodes, nil,
+			func(n *MessageMention, e *Message) { n.Edges.Message = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mmq.withUser; query != nil {
+		if err := mmq.loadUser(ctx, query, nodes, nil,
+			func(n *MessageMention, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (mmq *MessageMentionQuery) loadMessage(ctx context.Context, query *MessageQuery, nodes []*MessageMention, init func(*MessageMention), assign func(*MessageMention, *Message)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*MessageMention)
+	for i := range nodes {
+		fk := nodes[i].MessageID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(message.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "message_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (mmq *MessageMentionQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*MessageMention, init func(*MessageMention), assign func(*MessageMention, *User)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*MessageMention)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (mmq *MessageMentionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mmq.querySpec()
+	_spec.Node.Columns = mmq.ctx.Fields
+	if len(mmq.ctx.Fields) > 0 {
+		_spec.Unique = mmq.ctx.Unique != nil && *mmq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mmq.driver, _spec)
+}
+
+func (mmq *MessageMentionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(messagemention.Table, messagemention.Columns, sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeString))
+	_spec.From = mmq.sql
+	if unique := mmq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mmq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mmq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messagemention.FieldID)
+		for i := range fields {
+			if fields[i] != messagemention.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if mmq.withMessage != nil {
+			_spec.Node.AddColumnOnce(messagemention.FieldMessageID)
+		}
+		if mmq.withUser != nil {
+			_spec.Node.AddColumnOnce(messagemention.FieldUserID)
+		}
+	}
+	if ps := mmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mmq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mmq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mmq *MessageMentionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mmq.driver.Dialect())
+	t1 := builder.Table(messagemention.Table)
+	columns := mmq.ctx.Fields
+	if len(columns) == 0 {
+		columns = messagemention.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mmq.sql != nil {
+		selector = mmq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mmq.ctx.Unique != nil && *mmq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range mmq.predicates {
+		p(selector)
+	}
+	for _, p := range mmq.order {
+		p(selector)
+	}
+	if offset := mmq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mmq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MessageMentionGroupBy is the group-by builder for MessageMention entities.
+type MessageMentionGroupBy struct {
+	selector
+	build *MessageMentionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mmgb *MessageMentionGroupBy) Aggregate(fns ...AggregateFunc) *MessageMentionGroupBy {
+	mmgb.fns = append(mmgb.fns, fns...)
+	return mmgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mmgb *MessageMentionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mmgb.build.ctx, ent.OpQueryGroupBy)
+	if err := mmgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MessageMentionQuery, *MessageMentionGroupBy](ctx, mmgb.build, mmgb, mmgb.build.inters, v)
+}
+
+func (mmgb *MessageMentionGroupBy) sqlScan(ctx context.Context, root *MessageMentionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mmgb.fns))
+	for _, fn := range mmgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mmgb.flds)+len(mmgb.fns))
+		for _, f := range *mmgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mmgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mmgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MessageMentionSelect is the builder for selecting fields of MessageMention entities.
+type MessageMentionSelect struct {
+	*MessageMentionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mms *MessageMentionSelect) Aggregate(fns ...AggregateFunc) *MessageMentionSelect {
+	mms.fns = append(mms.fns, fns...)
+	return mms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mms *MessageMentionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mms.ctx, ent.OpQuerySelect)
+	if err := mms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MessageMentionQuery, *MessageMentionSelect](ctx, mms.MessageMentionQuery, mms, mms.inters, v)
+}
+
+func (mms *MessageMentionSelect) sqlScan(ctx context.Context, root *MessageMentionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mms.fns))
+	for _, fn := range mms.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,680 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/messagemention"
+	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageMentionUpdate is the builder for updating MessageMention entities.
+type MessageMentionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MessageMentionMutation
+}
+
+// Where appends a list predicates to the MessageMentionUpdate builder.
+func (mmu *MessageMentionUpdate) Where(ps ...predicate.MessageMention) *MessageMentionUpdate {
+	mmu.mutation.Where(ps...)
+	return mmu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mmu *MessageMentionUpdate) SetCreatedAt(t time.Time) *MessageMentionUpdate {
+	mmu.mutation.SetCreatedAt(t)
+	return mmu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mmu *MessageMentionUpdate) SetNillableCreatedAt(t *time.Time) *MessageMentionUpdate {
+	if t != nil {
+		mmu.SetCreatedAt(*t)
+	}
+	return mmu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mmu *MessageMentionUpdate) SetUpdatedAt(t time.Time) *MessageMentionUpdate {
+	mmu.mutation.SetUpdatedAt(t)
+	return mmu
+}
+
+// SetMessageID sets the "message_id" field.
+func (mmu *MessageMentionUpdate) SetMessageID(s string) *MessageMentionUpdate {
+	mmu.mutation.SetMessageID(s)
+	return mmu
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (mmu *MessageMentionUpdate) SetNillableMessageID(s *string) *MessageMentionUpdate {
+	if s != nil {
+		mmu.SetMessageID(*s)
+	}
+	return mmu
+}
+
+// SetKind sets the "kind" field.
+func (mmu *MessageMentionUpdate) SetKind(m messagemention.Kind) *MessageMentionUpdate {
+	mmu.mutation.SetKind(m)
+	return mmu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (mmu *MessageMentionUpdate) SetNillableKind(m *messagemention.Kind) *MessageMentionUpdate {
+	if m != nil {
+		mmu.SetKind(*m)
+	}
+	return mmu
+}
+
+// SetUserID sets the "user_id" field.
+func (mmu *MessageMentionUpdate) SetUserID(s string) *MessageMentionUpdate {
+	mmu.mutation.SetUserID(s)
+	return mmu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (mmu *MessageMentionUpdate) SetNillableUserID(s *string) *MessageMentionUpdate {
+	if s != nil {
+		mmu.SetUserID(*s)
+	}
+	return mmu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (mmu *MessageMentionUpdate) ClearUserID() *MessageMentionUpdate {
+	mmu.mutation.ClearUserID()
+	return mmu
+}
+
+// SetOffset sets the "offset" field.
+func (mmu *MessageMentionUpdate) SetOffset(i int) *MessageMentionUpdate {
+	mmu.mutation.ResetOffset()
+	mmu.mutation.SetOffset(i)
+	return mmu
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (mmu *MessageMentionUpdate) SetNillableOffset(i *int) *MessageMentionUpdate {
+	if i != nil {
+		mmu.SetOffset(*i)
+	}
+	return mmu
+}
+
+// AddOffset adds i to the "offset" field.
+func (mmu *MessageMentionUpdate) AddOffset(i int) *MessageMentionUpdate {
+	mmu.mutation.AddOffset(i)
+	return mmu
+}
+
+// SetLength sets the "length" field.
+func (mmu *MessageMentionUpdate) SetLength(i int) *MessageMentionUpdate {
+	mmu.mutation.ResetLength()
+	mmu.mutation.SetLength(i)
+	return mmu
+}
+
+// SetNillableLength sets the "length" field if the given value is not nil.
+func (mmu *MessageMentionUpdate) SetNillableLength(i *int) *MessageMentionUpdate {
+	if i != nil {
+		mmu.SetLength(*i)
+	}
+	return mmu
+}
+
+// AddLength adds i to the "length" field.
+func (mmu *MessageMentionUpdate) AddLength(i int) *MessageMentionUpdate {
+	mmu.mutation.AddLength(i)
+	return mmu
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (mmu *MessageMentionUpdate) SetMessage(m *Message) *MessageMentionUpdate {
+	return mmu.SetMessageID(m.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (mmu *MessageMentionUpdate) SetUser(u *User) *MessageMentionUpdate {
+	return mmu.SetUserID(u.ID)
+}
+
+// Mutation returns the MessageMentionMutation object of the builder.
+func (mmu *MessageMentionUpdate) Mutation() *MessageMentionMutation {
+	return mmu.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (mmu *MessageMentionUpdate) ClearMessage() *MessageMentionUpdate {
+	mmu.mutation.ClearMessage()
+	return mmu
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (mmu *MessageMentionUpdate) ClearUser() *MessageMentionUpdate {
+	mmu.mutation.ClearUser()
+	return mmu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mmu *MessageMentionUpdate) Save(ctx context.Context) (int, error) {
+	mmu.defaults()
+	return withHooks(ctx, mmu.sqlSave, mmu.mutation, mmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mmu *MessageMentionUpdate) SaveX(ctx context.Context) int {
+	affected, err := mmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mmu *MessageMentionUpdate) Exec(ctx context.Context) error {
+	_, err := mmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mmu *MessageMentionUpdate) ExecX(ctx context.Context) {
+	if err := mmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mmu *MessageMentionUpdate) defaults() {
+	if _, ok := mmu.mutation.UpdatedAt(); !ok {
+		v := messagemention.UpdateDefaultUpdatedAt()
+		mmu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mmu *MessageMentionUpdate) check() error {
+	if v, ok := mmu.mutation.MessageID(); ok {
+		if err := messagemention.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "MessageMention.message_id": %w`, err)}
+		}
+	}
+	if v, ok := mmu.mutation.Kind(); ok {
+		if err := messagemention.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "MessageMention.kind": %w`, err)}
+		}
+	}
+	if v, ok := mmu.mutation.Offset(); ok {
+		if err := messagemention.OffsetValidator(v); err != nil {
+			return &ValidationError{Name: "offset", err: fmt.Errorf(`ent: validator failed for field "MessageMention.offset": %w`, err)}
+		}
+	}
+	if v, ok := mmu.mutation.Length(); ok {
+		if err := messagemention.LengthValidator(v); err != nil {
+			return &ValidationError{Name: "length", err: fmt.Errorf(`ent: validator failed for field "MessageMention.length": %w`, err)}
+		}
+	}
+	if mmu.mutation.MessageCleared() && len(mmu.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MessageMention.message"`)
+	}
+	return nil
+}
+
+func (mmu *MessageMentionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagemention.Table, messagemention.Columns, sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeString))
+	if ps := mmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mmu.mutation.CreatedAt(); ok {
+		_spec.SetField(messagemention.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := mmu.mutation.UpdatedAt(); ok {
+		_spec.SetField(messagemention.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := mmu.mutation.Kind(); ok {
+		_spec.SetField(messagemention.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := mmu.mutation.Offset(); ok {
+		_spec.SetField(messagemention.FieldOffset, field.TypeInt, value)
+	}
+	if value, ok := mmu.mutation.AddedOffset(); ok {
+		_spec.AddField(messagemention.FieldOffset, field.TypeInt, value)
+	}
+	if value, ok := mmu.mutation.Length(); ok {
+		_spec.SetField(messagemention.FieldLength, field.TypeInt, value)
+	}
+	if value, ok := mmu.mutation.AddedLength(); ok {
+		_spec.AddField(messagemention.FieldLength, field.TypeInt, value)
+	}
+	if mmu.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messagemention.MessageTable,
+			Columns: []string{messagemention.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mmu.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messagemention.MessageTable,
+			Columns: []string{messagemention.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mmu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messagemention.UserTable,
+			Columns: []string{messagemention.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mmu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messagemention.UserTable,
+			Columns: []string{messagemention.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagemention.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mmu.mutation.done = true
+	return n, nil
+}
+
+// MessageMentionUpdateOne is the builder for updating a single MessageMention entity.
+type MessageMentionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MessageMentionMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mmuo *MessageMentionUpdateOne) SetCreatedAt(t time.Time) *MessageMentionUpdateOne {
+	mmuo.mutation.SetCreatedAt(t)
+	return mmuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mmuo *MessageMentionUpdateOne) SetNillableCreatedAt(t *time.Time) *MessageMentionUpdateOne {
+	if t != nil {
+		mmuo.SetCreatedAt(*t)
+	}
+	return mmuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mmuo *MessageMentionUpdateOne) SetUpdatedAt(t time.Time) *MessageMentionUpdateOne {
+	mmuo.mutation.SetUpdatedAt(t)
+	return mmuo
+}
+
+// SetMessageID sets the "message_id" field.
+func (mmuo *MessageMentionUpdateOne) SetMessageID(s string) *MessageMentionUpdateOne {
+	mmuo.mutation.SetMessageID(s)
+	return mmuo
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (mmuo *MessageMentionUpdateOne) SetNillableMessageID(s *string) *MessageMentionUpdateOne {
+	if s != nil {
+		mmuo.SetMessageID(*s)
+	}
+	return mmuo
+}
+
+// SetKind sets the "kind" field.
+func (mmuo *MessageMentionUpdateOne) SetKind(m messagemention.Kind) *MessageMentionUpdateOne {
+	mmuo.mutation.SetKind(m)
+	return mmuo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (mmuo *MessageMentionUpdateOne) SetNillableKind(m *messagemention.Kind) *MessageMentionUpdateOne {
+	if m != nil {
+		mmuo.SetKind(*m)
+	}
+	return mmuo
+}
+
+// SetUserID sets the "user_id" field.
+func (mmuo *MessageMentionUpdateOne) SetUserID(s string) *MessageMentionUpdateOne {
+	mmuo.mutation.SetUserID(s)
+	return mmuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (mmuo *MessageMentionUpdateOne) SetNillableUserID(s *string) *MessageMentionUpdateOne {
+	if s != nil {
+		mmuo.SetUserID(*s)
+	}
+	return mmuo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (mmuo *MessageMentionUpdateOne) ClearUserID() *MessageMentionUpdateOne {
+	mmuo.mutation.ClearUserID()
+	return mmuo
+}
+
+// SetOffset sets the "offset" field.
+func (mmuo *MessageMentionUpdateOne) SetOffset(i int) *MessageMentionUpdateOne {
+	mmuo.mutation.ResetOffset()
+	mmuo.mutation.SetOffset(i)
+	return mmuo
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (mmuo *MessageMentionUpdateOne) SetNillableOffset(i *int) *MessageMentionUpdateOne {
+	if i != nil {
+		mmuo.SetOffset(*i)
+	}
+	return mmuo
+}
+
+// AddOffset adds i to the "offset" field.
+func (mmuo *MessageMentionUpdateOne) AddOffset(i int) *MessageMentionUpdateOne {
+	mmuo.mutation.AddOffset(i)
+	return mmuo
+}
+
+// SetLength sets the "length" field.
+func (mmuo *MessageMentionUpdateOne) SetLength(i int) *MessageMentionUpdateOne {
+	mmuo.mutation.ResetLength()
+	mmuo.mutation.SetLength(i)
+	return mmuo
+}
+
+// SetNillableLength sets the "length" field if the given value is not nil.
+func (mmuo *MessageMentionUpdateOne) SetNillableLength(i *int) *MessageMentionUpdateOne {
+	if i != nil {
+		mmuo.SetLength(*i)
+	}
+	return mmuo
+}
+
+// AddLength adds i to the "length" field.
+func (mmuo *MessageMentionUpdateOne) AddLength(i int) *MessageMentionUpdateOne {
+	mmuo.mutation.AddLength(i)
+	return mmuo
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (mmuo *MessageMentionUpdateOne) SetMessage(m *Message) *MessageMentionUpdateOne {
+	return mmuo.SetMessageID(m.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (mmuo *MessageMentionUpdateOne) SetUser(u *User) *MessageMentionUpdateOne {
+	return mmuo.SetUserID(u.ID)
+}
+
+// Mutation returns the MessageMentionMutation object of the builder.
+func (mmuo *MessageMentionUpdateOne) Mutation() *MessageMentionMutation {
+	return mmuo.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (mmuo *MessageMentionUpdateOne) ClearMessage() *MessageMentionUpdateOne {
+	mmuo.mutation.ClearMessage()
+	return mmuo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (mmuo *MessageMentionUpdateOne) ClearUser() *MessageMentionUpdateOne {
+	mmuo.mutation.ClearUser()
+	return mmuo
+}
+
+// Where appends a list predicates to the MessageMentionUpdate builder.
+func (mmuo *MessageMentionUpdateOne) Where(ps ...predicate.MessageMention) *MessageMentionUpdateOne {
+	mmuo.mutation.Where(ps...)
+	return mmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mmuo *MessageMentionUpdateOne) Select(field string, fields ...string) *MessageMentionUpdateOne {
+	mmuo.fields = append([]string{field}, fields...)
+	return mmuo
+}
+
+// Save executes the query and returns the updated MessageMention entity.
+func (mmuo *MessageMentionUpdateOne) Save(ctx context.Context) (*MessageMention, error) {
+	mmuo.defaults()
+	return withHooks(ctx, mmuo.sqlSave, mmuo.mutation, mmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mmuo *MessageMentionUpdateOne) SaveX(ctx context.Context) *MessageMention {
+	node, err := mmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mmuo *MessageMentionUpdateOne) Exec(ctx context.Context) error {
+	_, err := mmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mmuo *MessageMentionUpdateOne) ExecX(ctx context.Context) {
+	if err := mmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mmuo *MessageMentionUpdateOne) defaults() {
+	if _, ok := mmuo.mutation.UpdatedAt(); !ok {
+		v := messagemention.UpdateDefaultUpdatedAt()
+		mmuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mmuo *MessageMentionUpdateOne) check() error {
+	if v, ok := mmuo.mutation.MessageID(); ok {
+		if err := messagemention.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "MessageMention.message_id": %w`, err)}
+		}
+	}
+	if v, ok := mmuo.mutation.Kind(); ok {
+		if err := messagemention.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "MessageMention.kind": %w`, err)}
+		}
+	}
+	if v, ok := mmuo.mutation.Offset(); ok {
+		if err := messagemention.OffsetValidator(v); err != nil {
+			return &ValidationError{Name: "offset", err: fmt.Errorf(`ent: validator failed for field "MessageMention.offset": %w`, err)}
+		}
+	}
+	if v, ok := mmuo.mutation.Length(); ok {
+		if err := messagemention.LengthValidator(v); err != nil {
+			return &ValidationError{Name: "length", err: fmt.Errorf(`ent: validator failed for field "MessageMention.length": %w`, err)}
+		}
+	}
+	if mmuo.mutation.MessageCleared() && len(mmuo.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MessageMention.message"`)
+	}
+	return nil
+}
+
+func (mmuo *MessageMentionUpdateOne) sqlSave(ctx context.Context) (_node *MessageMention, err error) {
+	if err := mmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagemention.Table, messagemention.Columns, sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeString))
+	id, ok := mmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MessageMention.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messagemention.FieldID)
+		for _, f := range fields {
+			if !messagemention.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != messagemention.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mmuo.mutation.CreatedAt(); ok {
+		_spec.SetField(messagemention.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := mmuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(messagemention.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := mmuo.mutation.Kind(); ok {
+		_spec.SetField(messagemention.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := mmuo.mutation.Offset(); ok {
+		_spec.SetField(messagemention.FieldOffset, field.TypeInt, value)
+	}
+	if value, ok := mmuo.mutation.AddedOffset(); ok {
+		_spec.AddField(messagemention.FieldOffset, field.TypeInt, value)
+	}
+	if value, ok := mmuo.mutation.Length(); ok {
+		_spec.SetField(messagemention.FieldLength, field.TypeInt, value)
+	}
+	if value, ok := mmuo.mutation.AddedLength(); ok {
+		_spec.AddField(messagemention.FieldLength, field.TypeInt, value)
+	}
+	if mmuo.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messagemention.MessageTable,
+			Columns: []string{messagemention.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mmuo.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messagemention.MessageTable,
+			Columns: []string{messagemention.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mmuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messagemention.UserTable,
+			Columns: []string{messagemention.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mmuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messagemention.UserTable,
+			Columns: []string{messagemention.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &MessageMention{config: mmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagemention.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mmuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"kakashi/chaos/internal/ent/messagereaction"
+	"kakashi/chaos/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageReactionDelete is the builder for deleting a MessageReaction entity.
+type MessageReactionDelete struct {
+	config
+	hooks    []Hook
+	mutation *MessageReactionMutation
+}
+
+// Where appends a list predicates to the MessageReactionDelete builder.
+func (mrd *MessageReactionDelete) Where(ps ...predicate.MessageReaction) *MessageReactionDelete {
+	mrd.mutation.Where(ps...)
+	return mrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mrd *MessageReactionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mrd.sqlExec, mrd.mutation, mrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrd *MessageReactionDelete) ExecX(ctx context.Context) int {
+	n, err := mrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mrd *MessageReactionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(messagereaction.Table, sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeString))
+	if ps := mrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mrd.mutation.done = true
+	return affected, err
+}
+
+// MessageReactionDeleteOne is the builder for deleting a single MessageReaction entity.
+type MessageReactionDeleteOne struct {
+	mrd *MessageReactionDelete
+}
+
+// Where appends a list predicates to the MessageReactionDelete builder.
+func (mrdo *MessageReactionDeleteOne) Where(ps ...predicate.MessageReaction) *MessageReactionDeleteOne {
+	mrdo.mrd.mutation.Where(ps...)
+	return mrdo
+}
+
+// Exec executes the deletion query.
+func (mrdo *MessageReactionDeleteOne) Exec(ctx context.Context) error {
+	n, err := mrdo.mrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{messagereaction.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrdo *MessageReactionDeleteOne) ExecX(ctx context.Context) {
+	if err := mrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
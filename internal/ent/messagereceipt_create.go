@@ -0,0 +1,366 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/messagereceipt"
+	"kakashi/chaos/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageReceiptCreate is the builder for creating a MessageReceipt entity.
+type MessageReceiptCreate struct {
+	config
+	mutation *MessageReceiptMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mrc *MessageReceiptCreate) SetCreatedAt(t time.Time) *MessageReceiptCreate {
+	mrc.mutation.SetCreatedAt(t)
+	return mrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mrc *MessageReceiptCreate) SetNillableCreatedAt(t *time.Time) *MessageReceiptCreate {
+	if t != nil {
+		mrc.SetCreatedAt(*t)
+	}
+	return mrc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mrc *MessageReceiptCreate) SetUpdatedAt(t time.Time) *MessageReceiptCreate {
+	mrc.mutation.SetUpdatedAt(t)
+	return mrc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mrc *MessageReceiptCreate) SetNillableUpdatedAt(t *time.Time) *MessageReceiptCreate {
+	if t != nil {
+		mrc.SetUpdatedAt(*t)
+	}
+	return mrc
+}
+
+// SetMessageID sets the "message_id" field.
+func (mrc *MessageReceiptCreate) SetMessageID(s string) *MessageReceiptCreate {
+	mrc.mutation.SetMessageID(s)
+	return mrc
+}
+
+// SetUserID sets the "user_id" field.
+func (mrc *MessageReceiptCreate) SetUserID(s string) *MessageReceiptCreate {
+	mrc.mutation.SetUserID(s)
+	return mrc
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (mrc *MessageReceiptCreate) SetDeliveredAt(t time.Time) *MessageReceiptCreate {
+	mrc.mutation.SetDeliveredAt(t)
+	return mrc
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (mrc *MessageReceiptCreate) SetNillableDeliveredAt(t *time.Time) *MessageReceiptCreate {
+	if t != nil {
+		mrc.SetDeliveredAt(*t)
+	}
+	return mrc
+}
+
+// SetReadAt sets the "read_at" field.
+func (mrc *MessageReceiptCreate) SetReadAt(t time.Time) *MessageReceiptCreate {
+	mrc.mutation.SetReadAt(t)
+	return mrc
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (mrc *MessageReceiptCreate) SetNillableReadAt(t *time.Time) *MessageReceiptCreate {
+	if t != nil {
+		mrc.SetReadAt(*t)
+	}
+	return mrc
+}
+
+// SetID sets the "id" field.
+func (mrc *MessageReceiptCreate) SetID(s string) *MessageReceiptCreate {
+	mrc.mutation.SetID(s)
+	return mrc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (mrc *MessageReceiptCreate) SetNillableID(s *string) *MessageReceiptCreate {
+	if s != nil {
+		mrc.SetID(*s)
+	}
+	return mrc
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (mrc *MessageReceiptCreate) SetMessage(m *Message) *MessageReceiptCreate {
+	return mrc.SetMessageID(m.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (mrc *MessageReceiptCreate) SetUser(u *User) *MessageReceiptCreate {
+	return mrc.SetUserID(u.ID)
+}
+
+// Mutation returns the MessageReceiptMutation object of the builder.
+func (mrc *MessageReceiptCreate) Mutation() *MessageReceiptMutation {
+	return mrc.mutation
+}
+
+// Save creates the MessageReceipt in the database.
+func (mrc *MessageReceiptCreate) Save(ctx context.Context) (*MessageReceipt, error) {
+	mrc.defaults()
+	return withHooks(ctx, mrc.sqlSave, mrc.mutation, mrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mrc *MessageReceiptCreate) SaveX(ctx context.Context) *MessageReceipt {
+	v, err := mrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mrc *MessageReceiptCreate) Exec(ctx context.Context) error {
+	_, err := mrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrc *MessageReceiptCreate) ExecX(ctx context.Context) {
+	if err := mrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mrc *MessageReceiptCreate) defaults() {
+	if _, ok := mrc.mutation.CreatedAt(); !ok {
+		v := messagereceipt.DefaultCreatedAt()
+		mrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mrc.mutation.UpdatedAt(); !ok {
+		v := messagereceipt.DefaultUpdatedAt()
+		mrc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := mrc.mutation.ID(); !ok {
+		v := messagereceipt.DefaultID()
+		mrc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mrc *MessageReceiptCreate) check() error {
+	if _, ok := mrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MessageReceipt.created_at"`)}
+	}
+	if _, ok := mrc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "MessageReceipt.updated_at"`)}
+	}
+	if _, ok := mrc.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "MessageReceipt.message_id"`)}
+	}
+	if v, ok := mrc.mutation.MessageID(); ok {
+		if err := messagereceipt.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "MessageReceipt.message_id": %w`, err)}
+		}
+	}
+	if _, ok := mrc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "MessageReceipt.user_id"`)}
+	}
+	if v, ok := mrc.mutation.UserID(); ok {
+		if err := messagereceipt.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "MessageReceipt.user_id": %w`, err)}
+		}
+	}
+	if len(mrc.mutation.MessageIDs()) == 0 {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required edge "MessageReceipt.message"`)}
+	}
+	if len(mrc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "MessageReceipt.user"`)}
+	}
+	return nil
+}
+
+func (mrc *MessageReceiptCreate) sqlSave(ctx context.Context) (*MessageReceipt, error) {
+	if err := mrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected MessageReceipt.ID type: %T", _spec.ID.Value)
+		}
+	}
+	mrc.mutation.id = &_node.ID
+	mrc.mutation.done = true
+	return _node, nil
+}
+
+func (mrc *MessageReceiptCreate) createSpec() (*MessageReceipt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MessageReceipt{config: mrc.config}
+		_spec = sqlgraph.NewCreateSpec(messagereceipt.Table, sqlgraph.NewFieldSpec(messagereceipt.FieldID, field.TypeString))
+	)
+	if id, ok := mrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := mrc.mutation.CreatedAt(); ok {
+		_spec.SetField(messagereceipt.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mrc.mutation.UpdatedAt(); ok {
+		_spec.SetField(messagereceipt.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := mrc.mutation.DeliveredAt(); ok {
+		_spec.SetField(messagereceipt.FieldDeliveredAt, field.TypeTime, value)
+		_node.DeliveredAt = value
+	}
+	if value, ok := mrc.mutation.ReadAt(); ok {
+		_spec.SetField(messagereceipt.FieldReadAt, field.TypeTime, value)
+		_node.ReadAt = value
+	}
+	if nodes := mrc.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messagereceipt.MessageTable,
+			Columns: []string{messagereceipt.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mrc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messagereceipt.UserTable,
+			Columns: []string{messagereceipt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MessageReceiptCreateBulk is the builder for creating many MessageReceipt entities in bulk.
+type MessageReceiptCreateBulk struct {
+	config
+	err      error
+	builders []*MessageReceiptCreate
+}
+
+// Save creates the MessageReceipt entities in the database.
+func (mrcb *MessageReceiptCreateBulk) Save(ctx context.Context) ([]*MessageReceipt, error) {
+	if mrcb.err != nil {
+		return nil, mrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mrcb.builders))
+	nodes := make([]*MessageReceipt, len(mrcb.builders))
+	mutators := make([]Mutator, len(mrcb.builders))
+	for i := range mrcb.builders {
+		func(i int, root context.Context) {
+			builder := mrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MessageReceiptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mrcb *MessageReceiptCreateBulk) SaveX(ctx context.Context) []*MessageReceipt {
+	v, err := mrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mrcb *MessageReceiptCreateBulk) Exec(ctx context.Context) error {
+	_, err := mrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrcb *MessageReceiptCreateBulk) ExecX(ctx context.Context) {
+	if err := mrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
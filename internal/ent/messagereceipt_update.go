@@ -0,0 +1,616 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/messagereceipt"
+	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageReceiptUpdate is the builder for updating MessageReceipt entities.
+type MessageReceiptUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MessageReceiptMutation
+}
+
+// Where appends a list predicates to the MessageReceiptUpdate builder.
+func (mru *MessageReceiptUpdate) Where(ps ...predicate.MessageReceipt) *MessageReceiptUpdate {
+	mru.mutation.Where(ps...)
+	return mru
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mru *MessageReceiptUpdate) SetCreatedAt(t time.Time) *MessageReceiptUpdate {
+	mru.mutation.SetCreatedAt(t)
+	return mru
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mru *MessageReceiptUpdate) SetNillableCreatedAt(t *time.Time) *MessageReceiptUpdate {
+	if t != nil {
+		mru.SetCreatedAt(*t)
+	}
+	return mru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mru *MessageReceiptUpdate) SetUpdatedAt(t time.Time) *MessageReceiptUpdate {
+	mru.mutation.SetUpdatedAt(t)
+	return mru
+}
+
+// SetMessageID sets the "message_id" field.
+func (mru *MessageReceiptUpdate) SetMessageID(s string) *MessageReceiptUpdate {
+	mru.mutation.SetMessageID(s)
+	return mru
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (mru *MessageReceiptUpdate) SetNillableMessageID(s *string) *MessageReceiptUpdate {
+	if s != nil {
+		mru.SetMessageID(*s)
+	}
+	return mru
+}
+
+// SetUserID sets the "user_id" field.
+func (mru *MessageReceiptUpdate) SetUserID(s string) *MessageReceiptUpdate {
+	mru.mutation.SetUserID(s)
+	return mru
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (mru *MessageReceiptUpdate) SetNillableUserID(s *string) *MessageReceiptUpdate {
+	if s != nil {
+		mru.SetUserID(*s)
+	}
+	return mru
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (mru *MessageReceiptUpdate) SetDeliveredAt(t time.Time) *MessageReceiptUpdate {
+	mru.mutation.SetDeliveredAt(t)
+	return mru
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (mru *MessageReceiptUpdate) SetNillableDeliveredAt(t *time.Time) *MessageReceiptUpdate {
+	if t != nil {
+		mru.SetDeliveredAt(*t)
+	}
+	return mru
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (mru *MessageReceiptUpdate) ClearDeliveredAt() *MessageReceiptUpdate {
+	mru.mutation.ClearDeliveredAt()
+	return mru
+}
+
+// SetReadAt sets the "read_at" field.
+func (mru *MessageReceiptUpdate) SetReadAt(t time.Time) *MessageReceiptUpdate {
+	mru.mutation.SetReadAt(t)
+	return mru
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (mru *MessageReceiptUpdate) SetNillableReadAt(t *time.Time) *MessageReceiptUpdate {
+	if t != nil {
+		mru.SetReadAt(*t)
+	}
+	return mru
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (mru *MessageReceiptUpdate) ClearReadAt() *MessageReceiptUpdate {
+	mru.mutation.ClearReadAt()
+	return mru
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (mru *MessageReceiptUpdate) SetMessage(m *Message) *MessageReceiptUpdate {
+	return mru.SetMessageID(m.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (mru *MessageReceiptUpdate) SetUser(u *User) *MessageReceiptUpdate {
+	return mru.SetUserID(u.ID)
+}
+
+// Mutation returns the MessageReceiptMutation object of the builder.
+func (mru *MessageReceiptUpdate) Mutation() *MessageReceiptMutation {
+	return mru.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (mru *MessageReceiptUpdate) ClearMessage() *MessageReceiptUpdate {
+	mru.mutation.ClearMessage()
+	return mru
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (mru *MessageReceiptUpdate) ClearUser() *MessageReceiptUpdate {
+	mru.mutation.ClearUser()
+	return mru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mru *MessageReceiptUpdate) Save(ctx context.Context) (int, error) {
+	mru.defaults()
+	return withHooks(ctx, mru.sqlSave, mru.mutation, mru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mru *MessageReceiptUpdate) SaveX(ctx context.Context) int {
+	affected, err := mru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mru *MessageReceiptUpdate) Exec(ctx context.Context) error {
+	_, err := mru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mru *MessageReceiptUpdate) ExecX(ctx context.Context) {
+	if err := mru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mru *MessageReceiptUpdate) defaults() {
+	if _, ok := mru.mutation.UpdatedAt(); !ok {
+		v := messagereceipt.UpdateDefaultUpdatedAt()
+		mru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mru *MessageReceiptUpdate) check() error {
+	if v, ok := mru.mutation.MessageID(); ok {
+		if err := messagereceipt.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "MessageReceipt.message_id": %w`, err)}
+		}
+	}
+	if v, ok := mru.mutation.UserID(); ok {
+		if err := messagereceipt.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "MessageReceipt.user_id": %w`, err)}
+		}
+	}
+	if mru.mutation.MessageCleared() && len(mru.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MessageReceipt.message"`)
+	}
+	if mru.mutation.UserCleared() && len(mru.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MessageReceipt.user"`)
+	}
+	return nil
+}
+
+func (mru *MessageReceiptUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagereceipt.Table, messagereceipt.Columns, sqlgraph.NewFieldSpec(messagereceipt.FieldID, field.TypeString))
+	if ps := mru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mru.mutation.CreatedAt(); ok {
+		_spec.SetField(messagereceipt.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := mru.mutation.UpdatedAt(); ok {
+		_spec.SetField(messagereceipt.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := mru.mutation.DeliveredAt(); ok {
+		_spec.SetField(messagereceipt.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if mru.mutation.DeliveredAtCleared() {
+		_spec.ClearField(messagereceipt.FieldDeliveredAt, field.TypeTime)
+	}
+	if value, ok := mru.mutation.ReadAt(); ok {
+		_spec.SetField(messagereceipt.FieldReadAt, field.TypeTime, value)
+	}
+	if mru.mutation.ReadAtCleared() {
+		_spec.ClearField(messagereceipt.FieldReadAt, field.TypeTime)
+	}
+	if mru.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messagereceipt.MessageTable,
+			Columns: []string{messagereceipt.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mru.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messagereceipt.MessageTable,
+			Columns: []string{messagereceipt.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mru.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messagereceipt.UserTable,
+			Columns: []string{messagereceipt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mru.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messagereceipt.UserTable,
+			Columns: []string{messagereceipt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagereceipt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mru.mutation.done = true
+	return n, nil
+}
+
+// MessageReceiptUpdateOne is the builder for updating a single MessageReceipt entity.
+type MessageReceiptUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MessageReceiptMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mruo *MessageReceiptUpdateOne) SetCreatedAt(t time.Time) *MessageReceiptUpdateOne {
+	mruo.mutation.SetCreatedAt(t)
+	return mruo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mruo *MessageReceiptUpdateOne) SetNillableCreatedAt(t *time.Time) *MessageReceiptUpdateOne {
+	if t != nil {
+		mruo.SetCreatedAt(*t)
+	}
+	return mruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mruo *MessageReceiptUpdateOne) SetUpdatedAt(t time.Time) *MessageReceiptUpdateOne {
+	mruo.mutation.SetUpdatedAt(t)
+	return mruo
+}
+
+// SetMessageID sets the "message_id" field.
+func (mruo *MessageReceiptUpdateOne) SetMessageID(s string) *MessageReceiptUpdateOne {
+	mruo.mutation.SetMessageID(s)
+	return mruo
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (mruo *MessageReceiptUpdateOne) SetNillableMessageID(s *string) *MessageReceiptUpdateOne {
+	if s != nil {
+		mruo.SetMessageID(*s)
+	}
+	return mruo
+}
+
+// SetUserID sets the "user_id" field.
+func (mruo *MessageReceiptUpdateOne) SetUserID(s string) *MessageReceiptUpdateOne {
+	mruo.mutation.SetUserID(s)
+	return mruo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (mruo *MessageReceiptUpdateOne) SetNillableUserID(s *string) *MessageReceiptUpdateOne {
+	if s != nil {
+		mruo.SetUserID(*s)
+	}
+	return mruo
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (mruo *MessageReceiptUpdateOne) SetDeliveredAt(t time.Time) *MessageReceiptUpdateOne {
+	mruo.mutation.SetDeliveredAt(t)
+	return mruo
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (mruo *MessageReceiptUpdateOne) SetNillableDeliveredAt(t *time.Time) *MessageReceiptUpdateOne {
+	if t != nil {
+		mruo.SetDeliveredAt(*t)
+	}
+	return mruo
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (mruo *MessageReceiptUpdateOne) ClearDeliveredAt() *MessageReceiptUpdateOne {
+	mruo.mutation.ClearDeliveredAt()
+	return mruo
+}
+
+// SetReadAt sets the "read_at" field.
+func (mruo *MessageReceiptUpdateOne) SetReadAt(t time.Time) *MessageReceiptUpdateOne {
+	mruo.mutation.SetReadAt(t)
+	return mruo
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (mruo *MessageReceiptUpdateOne) SetNillableReadAt(t *time.Time) *MessageReceiptUpdateOne {
+	if t != nil {
+		mruo.SetReadAt(*t)
+	}
+	return mruo
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (mruo *MessageReceiptUpdateOne) ClearReadAt() *MessageReceiptUpdateOne {
+	mruo.mutation.ClearReadAt()
+	return mruo
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (mruo *MessageReceiptUpdateOne) SetMessage(m *Message) *MessageReceiptUpdateOne {
+	return mruo.SetMessageID(m.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (mruo *MessageReceiptUpdateOne) SetUser(u *User) *MessageReceiptUpdateOne {
+	return mruo.SetUserID(u.ID)
+}
+
+// Mutation returns the MessageReceiptMutation object of the builder.
+func (mruo *MessageReceiptUpdateOne) Mutation() *MessageReceiptMutation {
+	return mruo.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (mruo *MessageReceiptUpdateOne) ClearMessage() *MessageReceiptUpdateOne {
+	mruo.mutation.ClearMessage()
+	return mruo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (mruo *MessageReceiptUpdateOne) ClearUser() *MessageReceiptUpdateOne {
+	mruo.mutation.ClearUser()
+	return mruo
+}
+
+// Where appends a list predicates to the MessageReceiptUpdate builder.
+func (mruo *MessageReceiptUpdateOne) Where(ps ...predicate.MessageReceipt) *MessageReceiptUpdateOne {
+	mruo.mutation.Where(ps...)
+	return mruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mruo *MessageReceiptUpdateOne) Select(field string, fields ...string) *MessageReceiptUpdateOne {
+	mruo.fields = append([]string{field}, fields...)
+	return mruo
+}
+
+// Save executes the query and returns the updated MessageReceipt entity.
+func (mruo *MessageReceiptUpdateOne) Save(ctx context.Context) (*MessageReceipt, error) {
+	mruo.defaults()
+	return withHooks(ctx, mruo.sqlSave, mruo.mutation, mruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mruo *MessageReceiptUpdateOne) SaveX(ctx context.Context) *MessageReceipt {
+	node, err := mruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mruo *MessageReceiptUpdateOne) Exec(ctx context.Context) error {
+	_, err := mruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mruo *MessageReceiptUpdateOne) ExecX(ctx context.Context) {
+	if err := mruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mruo *MessageReceiptUpdateOne) defaults() {
+	if _, ok := mruo.mutation.UpdatedAt(); !ok {
+		v := messagereceipt.UpdateDefaultUpdatedAt()
+		mruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mruo *MessageReceiptUpdateOne) check() error {
+	if v, ok := mruo.mutation.MessageID(); ok {
+		if err := messagereceipt.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "MessageReceipt.message_id": %w`, err)}
+		}
+	}
+	if v, ok := mruo.mutation.UserID(); ok {
+		if err := messagereceipt.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "MessageReceipt.user_id": %w`, err)}
+		}
+	}
+	if mruo.mutation.MessageCleared() && len(mruo.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MessageReceipt.message"`)
+	}
+	if mruo.mutation.UserCleared() && len(mruo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MessageReceipt.user"`)
+	}
+	return nil
+}
+
+func (mruo *MessageReceiptUpdateOne) sqlSave(ctx context.Context) (_node *MessageReceipt, err error) {
+	if err := mruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagereceipt.Table, messagereceipt.Columns, sqlgraph.NewFieldSpec(messagereceipt.FieldID, field.TypeString))
+	id, ok := mruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MessageReceipt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messagereceipt.FieldID)
+		for _, f := range fields {
+			if !messagereceipt.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != messagereceipt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mruo.mutation.CreatedAt(); ok {
+		_spec.SetField(messagereceipt.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := mruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(messagereceipt.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := mruo.mutation.DeliveredAt(); ok {
+		_spec.SetField(messagereceipt.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if mruo.mutation.DeliveredAtCleared() {
+		_spec.ClearField(messagereceipt.FieldDeliveredAt, field.TypeTime)
+	}
+	if value, ok := mruo.mutation.ReadAt(); ok {
+		_spec.SetField(messagereceipt.FieldReadAt, field.TypeTime, value)
+	}
+	if mruo.mutation.ReadAtCleared() {
+		_spec.ClearField(messagereceipt.FieldReadAt, field.TypeTime)
+	}
+	if mruo.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messagereceipt.MessageTable,
+			Columns: []string{messagereceipt.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mruo.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messagereceipt.MessageTable,
+			Columns: []string{messagereceipt.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mruo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messagereceipt.UserTable,
+			Columns: []string{messagereceipt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mruo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messagereceipt.UserTable,
+			Columns: []string{messagereceipt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &MessageReceipt{config: mruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagereceipt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mruo.mutation.done = true
+	return _node, nil
+}
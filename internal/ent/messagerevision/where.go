@@ -0,0 +1,472 @@
+// Code generated by ent, DO NOT EDIT.
+
+package messagerevision
+
+import (
+	"kakashi/chaos/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
+func MessageID(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEQ(FieldMessageID, v))
+}
+
+// EditorID applies equality check predicate on the "editor_id" field. It's identical to EditorIDEQ.
+func EditorID(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEQ(FieldEditorID, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEQ(FieldContent, v))
+}
+
+// EditedAt applies equality check predicate on the "edited_at" field. It's identical to EditedAtEQ.
+func EditedAt(v time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEQ(FieldEditedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// MessageIDEQ applies the EQ predicate on the "message_id" field.
+func MessageIDEQ(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEQ(FieldMessageID, v))
+}
+
+// MessageIDNEQ applies the NEQ predicate on the "message_id" field.
+func MessageIDNEQ(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldNEQ(FieldMessageID, v))
+}
+
+// MessageIDIn applies the In predicate on the "message_id" field.
+func MessageIDIn(vs ...string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldIn(FieldMessageID, vs...))
+}
+
+// MessageIDNotIn applies the NotIn predicate on the "message_id" field.
+func MessageIDNotIn(vs ...string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldNotIn(FieldMessageID, vs...))
+}
+
+// MessageIDGT applies the GT predicate on the "message_id" field.
+func MessageIDGT(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldGT(FieldMessageID, v))
+}
+
+// MessageIDGTE applies the GTE predicate on the "message_id" field.
+func MessageIDGTE(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldGTE(FieldMessageID, v))
+}
+
+// MessageIDLT applies the LT predicate on the "message_id" field.
+func MessageIDLT(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldLT(FieldMessageID, v))
+}
+
+// MessageIDLTE applies the LTE predicate on the "message_id" field.
+func MessageIDLTE(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldLTE(FieldMessageID, v))
+}
+
+// MessageIDContains applies the Contains predicate on the "message_id" field.
+func MessageIDContains(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldContains(FieldMessageID, v))
+}
+
+// MessageIDHasPrefix applies the HasPrefix predicate on the "message_id" field.
+func MessageIDHasPrefix(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldHasPrefix(FieldMessageID, v))
+}
+
+// MessageIDHasSuffix applies the HasSuffix predicate on the "message_id" field.
+func MessageIDHasSuffix(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldHasSuffix(FieldMessageID, v))
+}
+
+// MessageIDEqualFold applies the EqualFold predicate on the "message_id" field.
+func MessageIDEqualFold(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEqualFold(FieldMessageID, v))
+}
+
+// MessageIDContainsFold applies the ContainsFold predicate on the "message_id" field.
+func MessageIDContainsFold(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldContainsFold(FieldMessageID, v))
+}
+
+// EditorIDEQ applies the EQ predicate on the "editor_id" field.
+func EditorIDEQ(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEQ(FieldEditorID, v))
+}
+
+// EditorIDNEQ applies the NEQ predicate on the "editor_id" field.
+func EditorIDNEQ(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldNEQ(FieldEditorID, v))
+}
+
+// EditorIDIn applies the In predicate on the "editor_id" field.
+func EditorIDIn(vs ...string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldIn(FieldEditorID, vs...))
+}
+
+// EditorIDNotIn applies the NotIn predicate on the "editor_id" field.
+func EditorIDNotIn(vs ...string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldNotIn(FieldEditorID, vs...))
+}
+
+// EditorIDGT applies the GT predicate on the "editor_id" field.
+func EditorIDGT(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldGT(FieldEditorID, v))
+}
+
+// EditorIDGTE applies the GTE predicate on the "editor_id" field.
+func EditorIDGTE(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldGTE(FieldEditorID, v))
+}
+
+// EditorIDLT applies the LT predicate on the "editor_id" field.
+func EditorIDLT(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldLT(FieldEditorID, v))
+}
+
+// EditorIDLTE applies the LTE predicate on the "editor_id" field.
+func EditorIDLTE(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldLTE(FieldEditorID, v))
+}
+
+// EditorIDContains applies the Contains predicate on the "editor_id" field.
+func EditorIDContains(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldContains(FieldEditorID, v))
+}
+
+// EditorIDHasPrefix applies the HasPrefix predicate on the "editor_id" field.
+func EditorIDHasPrefix(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldHasPrefix(FieldEditorID, v))
+}
+
+// EditorIDHasSuffix applies the HasSuffix predicate on the "editor_id" field.
+func EditorIDHasSuffix(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldHasSuffix(FieldEditorID, v))
+}
+
+// EditorIDEqualFold applies the EqualFold predicate on the "editor_id" field.
+func EditorIDEqualFold(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEqualFold(FieldEditorID, v))
+}
+
+// EditorIDContainsFold applies the ContainsFold predicate on the "editor_id" field.
+func EditorIDContainsFold(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldContainsFold(FieldEditorID, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldContainsFold(FieldContent, v))
+}
+
+// EditedAtEQ applies the EQ predicate on the "edited_at" field.
+func EditedAtEQ(v time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldEQ(FieldEditedAt, v))
+}
+
+// EditedAtNEQ applies the NEQ predicate on the "edited_at" field.
+func EditedAtNEQ(v time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldNEQ(FieldEditedAt, v))
+}
+
+// EditedAtIn applies the In predicate on the "edited_at" field.
+func EditedAtIn(vs ...time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldIn(FieldEditedAt, vs...))
+}
+
+// EditedAtNotIn applies the NotIn predicate on the "edited_at" field.
+func EditedAtNotIn(vs ...time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldNotIn(FieldEditedAt, vs...))
+}
+
+// EditedAtGT applies the GT predicate on the "edited_at" field.
+func EditedAtGT(v time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldGT(FieldEditedAt, v))
+}
+
+// EditedAtGTE applies the GTE predicate on the "edited_at" field.
+func EditedAtGTE(v time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldGTE(FieldEditedAt, v))
+}
+
+// EditedAtLT applies the LT predicate on the "edited_at" field.
+func EditedAtLT(v time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldLT(FieldEditedAt, v))
+}
+
+// EditedAtLTE applies the LTE predicate on the "edited_at" field.
+func EditedAtLTE(v time.Time) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.FieldLTE(FieldEditedAt, v))
+}
+
+// HasMessage applies the HasEdge predicate on the "message" edge.
+func HasMessage() predicate.MessageRevision {
+	return predicate.MessageRevision(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, MessageTable, MessageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMessageWith applies the HasEdge predicate on the "message" edge with a given conditions (other predicates).
+func HasMessageWith(preds ...predicate.Message) predicate.MessageRevision {
+	return predicate.MessageRevision(func(s *sql.Selector) {
+		step := newMessageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEditor applies the HasEdge predicate on the "editor" edge.
+func HasEditor() predicate.MessageRevision {
+	return predicate.MessageRevision(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, EditorTable, EditorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEditorWith applies the HasEdge predicate on the "editor" edge with a given conditions (other predicates).
+func HasEditorWith(preds ...predicate.User) predicate.MessageRevision {
+	return predicate.MessageRevision(func(s *sql.Selector) {
+		step := newEditorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MessageRevision) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MessageRevision) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MessageRevision) predicate.MessageRevision {
+	return predicate.MessageRevision(sql.NotPredicates(p))
+}
@@ -0,0 +1,361 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/messagerevision"
+	"kakashi/chaos/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageRevisionCreate is the builder for creating a MessageRevision entity.
+type MessageRevisionCreate struct {
+	config
+	mutation *MessageRevisionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mrc *MessageRevisionCreate) SetCreatedAt(t time.Time) *MessageRevisionCreate {
+	mrc.mutation.SetCreatedAt(t)
+	return mrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mrc *MessageRevisionCreate) SetNillableCreatedAt(t *time.Time) *MessageRevisionCreate {
+	if t != nil {
+		mrc.SetCreatedAt(*t)
+	}
+	return mrc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mrc *MessageRevisionCreate) SetUpdatedAt(t time.Time) *MessageRevisionCreate {
+	mrc.mutation.SetUpdatedAt(t)
+	return mrc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mrc *MessageRevisionCreate) SetNillableUpdatedAt(t *time.Time) *MessageRevisionCreate {
+	if t != nil {
+		mrc.SetUpdatedAt(*t)
+	}
+	return mrc
+}
+
+// SetMessageID sets the "message_id" field.
+func (mrc *MessageRevisionCreate) SetMessageID(s string) *MessageRevisionCreate {
+	mrc.mutation.SetMessageID(s)
+	return mrc
+}
+
+// SetEditorID sets the "editor_id" field.
+func (mrc *MessageRevisionCreate) SetEditorID(s string) *MessageRevisionCreate {
+	mrc.mutation.SetEditorID(s)
+	return mrc
+}
+
+// SetContent sets the "content" field.
+func (mrc *MessageRevisionCreate) SetContent(s string) *MessageRevisionCreate {
+	mrc.mutation.SetContent(s)
+	return mrc
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (mrc *MessageRevisionCreate) SetEditedAt(t time.Time) *MessageRevisionCreate {
+	mrc.mutation.SetEditedAt(t)
+	return mrc
+}
+
+// SetID sets the "id" field.
+func (mrc *MessageRevisionCreate) SetID(s string) *MessageRevisionCreate {
+	mrc.mutation.SetID(s)
+	return mrc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (mrc *MessageRevisionCreate) SetNillableID(s *string) *MessageRevisionCreate {
+	if s != nil {
+		mrc.SetID(*s)
+	}
+	return mrc
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (mrc *MessageRevisionCreate) SetMessage(m *Message) *MessageRevisionCreate {
+	return mrc.SetMessageID(m.ID)
+}
+
+// SetEditor sets the "editor" edge to the User entity.
+func (mrc *MessageRevisionCreate) SetEditor(u *User) *MessageRevisionCreate {
+	return mrc.SetEditorID(u.ID)
+}
+
+// Mutation returns the MessageRevisionMutation object of the builder.
+func (mrc *MessageRevisionCreate) Mutation() *MessageRevisionMutation {
+	return mrc.mutation
+}
+
+// Save creates the MessageRevision in the database.
+func (mrc *MessageRevisionCreate) Save(ctx context.Context) (*MessageRevision, error) {
+	mrc.defaults()
+	return withHooks(ctx, mrc.sqlSave, mrc.mutation, mrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mrc *MessageRevisionCreate) SaveX(ctx context.Context) *MessageRevision {
+	v, err := mrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mrc *MessageRevisionCreate) Exec(ctx context.Context) error {
+	_, err := mrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrc *MessageRevisionCreate) ExecX(ctx context.Context) {
+	if err := mrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mrc *MessageRevisionCreate) defaults() {
+	if _, ok := mrc.mutation.CreatedAt(); !ok {
+		v := messagerevision.DefaultCreatedAt()
+		mrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mrc.mutation.UpdatedAt(); !ok {
+		v := messagerevision.DefaultUpdatedAt()
+		mrc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := mrc.mutation.ID(); !ok {
+		v := messagerevision.DefaultID()
+		mrc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mrc *MessageRevisionCreate) check() error {
+	if _, ok := mrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MessageRevision.created_at"`)}
+	}
+	if _, ok := mrc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "MessageRevision.updated_at"`)}
+	}
+	if _, ok := mrc.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "MessageRevision.message_id"`)}
+	}
+	if v, ok := mrc.mutation.MessageID(); ok {
+		if err := messagerevision.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "MessageRevision.message_id": %w`, err)}
+		}
+	}
+	if _, ok := mrc.mutation.EditorID(); !ok {
+		return &ValidationError{Name: "editor_id", err: errors.New(`ent: missing required field "MessageRevision.editor_id"`)}
+	}
+	if v, ok := mrc.mutation.EditorID(); ok {
+		if err := messagerevision.EditorIDValidator(v); err != nil {
+			return &ValidationError{Name: "editor_id", err: fmt.Errorf(`ent: validator failed for field "MessageRevision.editor_id": %w`, err)}
+		}
+	}
+	if _, ok := mrc.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "MessageRevision.content"`)}
+	}
+	if v, ok := mrc.mutation.Content(); ok {
+		if err := messagerevision.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "MessageRevision.content": %w`, err)}
+		}
+	}
+	if _, ok := mrc.mutation.EditedAt(); !ok {
+		return &ValidationError{Name: "edited_at", err: errors.New(`ent: missing required field "MessageRevision.edited_at"`)}
+	}
+	if len(mrc.mutation.MessageIDs()) == 0 {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required edge "MessageRevision.message"`)}
+	}
+	if len(mrc.mutation.EditorIDs()) == 0 {
+		return &ValidationError{Name: "editor", err: errors.New(`ent: missing required edge "MessageRevision.editor"`)}
+	}
+	return nil
+}
+
+func (mrc *MessageRevisionCreate) sqlSave(ctx context.Context) (*MessageRevision, error) {
+	if err := mrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected MessageRevision.ID type: %T", _spec.ID.Value)
+		}
+	}
+	mrc.mutation.id = &_node.ID
+	mrc.mutation.done = true
+	return _node, nil
+}
+
+func (mrc *MessageRevisionCreate) createSpec() (*MessageRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MessageRevision{config: mrc.config}
+		_spec = sqlgraph.NewCreateSpec(messagerevision.Table, sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeString))
+	)
+	if id, ok := mrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := mrc.mutation.CreatedAt(); ok {
+		_spec.SetField(messagerevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mrc.mutation.UpdatedAt(); ok {
+		_spec.SetField(messagerevision.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := mrc.mutation.Content(); ok {
+		_spec.SetField(messagerevision.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := mrc.mutation.EditedAt(); ok {
+		_spec.SetField(messagerevision.FieldEditedAt, field.TypeTime, value)
+		_node.EditedAt = value
+	}
+	if nodes := mrc.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messagerevision.MessageTable,
+			Columns: []string{messagerevision.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mrc.mutation.EditorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messagerevision.EditorTable,
+			Columns: []string{messagerevision.EditorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EditorID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MessageRevisionCreateBulk is the builder for creating many MessageRevision entities in bulk.
+type MessageRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*MessageRevisionCreate
+}
+
+// Save creates the MessageRevision entities in the database.
+func (mrcb *MessageRevisionCreateBulk) Save(ctx context.Context) ([]*MessageRevision, error) {
+	if mrcb.err != nil {
+		return nil, mrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mrcb.builders))
+	nodes := make([]*MessageRevision, len(mrcb.builders))
+	mutators := make([]Mutator, len(mrcb.builders))
+	for i := range mrcb.builders {
+		func(i int, root context.Context) {
+			builder := mrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MessageRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mrcb *MessageRevisionCreateBulk) SaveX(ctx context.Context) []*MessageRevision {
+	v, err := mrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mrcb *MessageRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := mrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrcb *MessageRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := mrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -8,6 +8,61 @@ import (
 )
 
 var (
+	// AttachmentsColumns holds the columns for the "attachments" table.
+	AttachmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "storage_key", Type: field.TypeString, Unique: true},
+		{Name: "filename", Type: field.TypeString, Size: 255},
+		{Name: "content_type", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "processing_status", Type: field.TypeEnum, Enums: []string{"none", "pending", "done", "failed"}, Default: "none"},
+		{Name: "width", Type: field.TypeInt, Nullable: true},
+		{Name: "height", Type: field.TypeInt, Nullable: true},
+		{Name: "blurhash", Type: field.TypeString, Nullable: true},
+		{Name: "thumbnail_key", Type: field.TypeString, Nullable: true},
+		{Name: "thumbnail_content_type", Type: field.TypeString, Nullable: true},
+		{Name: "message_id", Type: field.TypeString},
+		{Name: "uploader_id", Type: field.TypeString},
+	}
+	// AttachmentsTable holds the schema information for the "attachments" table.
+	AttachmentsTable = &schema.Table{
+		Name:       "attachments",
+		Columns:    AttachmentsColumns,
+		PrimaryKey: []*schema.Column{AttachmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "attachments_messages_message",
+				Columns:    []*schema.Column{AttachmentsColumns[13]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "attachments_users_uploader",
+				Columns:    []*schema.Column{AttachmentsColumns[14]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "attachment_message_id",
+				Unique:  false,
+				Columns: []*schema.Column{AttachmentsColumns[13]},
+			},
+			{
+				Name:    "attachment_uploader_id",
+				Unique:  false,
+				Columns: []*schema.Column{AttachmentsColumns[14]},
+			},
+			{
+				Name:    "attachment_processing_status",
+				Unique:  false,
+				Columns: []*schema.Column{AttachmentsColumns[7]},
+			},
+		},
+	}
 	// BlocksColumns holds the columns for the "blocks" table.
 	BlocksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -48,6 +103,65 @@ var (
 			},
 		},
 	}
+	// BookmarksColumns holds the columns for the "bookmarks" table.
+	BookmarksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "conversation_id", Type: field.TypeString, Nullable: true},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "remind_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reminded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "message_id", Type: field.TypeString, Nullable: true},
+	}
+	// BookmarksTable holds the schema information for the "bookmarks" table.
+	BookmarksTable = &schema.Table{
+		Name:       "bookmarks",
+		Columns:    BookmarksColumns,
+		PrimaryKey: []*schema.Column{BookmarksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "bookmarks_users_user",
+				Columns:    []*schema.Column{BookmarksColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "bookmarks_messages_message",
+				Columns:    []*schema.Column{BookmarksColumns[8]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bookmark_user_id_message_id",
+				Unique:  true,
+				Columns: []*schema.Column{BookmarksColumns[7], BookmarksColumns[8]},
+			},
+			{
+				Name:    "bookmark_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{BookmarksColumns[7], BookmarksColumns[1]},
+			},
+			{
+				Name:    "bookmark_user_id_conversation_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{BookmarksColumns[7], BookmarksColumns[3], BookmarksColumns[1]},
+			},
+			{
+				Name:    "bookmark_remind_at",
+				Unique:  false,
+				Columns: []*schema.Column{BookmarksColumns[5]},
+			},
+			{
+				Name:    "bookmark_message_id",
+				Unique:  false,
+				Columns: []*schema.Column{BookmarksColumns[8]},
+			},
+		},
+	}
 	// CallsColumns holds the columns for the "calls" table.
 	CallsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -126,7 +240,11 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "type", Type: field.TypeEnum, Enums: []string{"direct", "group"}, Default: "direct"},
 		{Name: "name", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "icon_url", Type: field.TypeString, Nullable: true},
+		{Name: "direct_key", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "last_message_at", Type: field.TypeTime, Nullable: true},
+		{Name: "message_ttl", Type: field.TypeInt, Default: 0},
+		{Name: "message_ttl_set_at", Type: field.TypeTime, Nullable: true},
 		{Name: "is_archived", Type: field.TypeBool, Default: false},
 		{Name: "is_muted", Type: field.TypeBool, Default: false},
 	}
@@ -144,28 +262,68 @@ var (
 			{
 				Name:    "conversation_type_last_message_at",
 				Unique:  false,
-				Columns: []*schema.Column{ConversationsColumns[3], ConversationsColumns[5]},
+				Columns: []*schema.Column{ConversationsColumns[3], ConversationsColumns[7]},
 			},
 			{
 				Name:    "conversation_last_message_at",
 				Unique:  false,
-				Columns: []*schema.Column{ConversationsColumns[5]},
+				Columns: []*schema.Column{ConversationsColumns[7]},
 			},
 			{
 				Name:    "conversation_is_archived",
 				Unique:  false,
-				Columns: []*schema.Column{ConversationsColumns[6]},
+				Columns: []*schema.Column{ConversationsColumns[10]},
 			},
 			{
 				Name:    "conversation_is_muted",
 				Unique:  false,
-				Columns: []*schema.Column{ConversationsColumns[7]},
+				Columns: []*schema.Column{ConversationsColumns[11]},
 			},
 			{
 				Name:    "conversation_created_at",
 				Unique:  false,
 				Columns: []*schema.Column{ConversationsColumns[1]},
 			},
+			{
+				Name:    "conversation_message_ttl",
+				Unique:  false,
+				Columns: []*schema.Column{ConversationsColumns[8]},
+			},
+		},
+	}
+	// ConversationFoldersColumns holds the columns for the "conversation_folders" table.
+	ConversationFoldersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 50},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "user_id", Type: field.TypeString},
+	}
+	// ConversationFoldersTable holds the schema information for the "conversation_folders" table.
+	ConversationFoldersTable = &schema.Table{
+		Name:       "conversation_folders",
+		Columns:    ConversationFoldersColumns,
+		PrimaryKey: []*schema.Column{ConversationFoldersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "conversation_folders_users_user",
+				Columns:    []*schema.Column{ConversationFoldersColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "conversationfolder_user_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{ConversationFoldersColumns[5], ConversationFoldersColumns[3]},
+			},
+			{
+				Name:    "conversationfolder_user_id_position",
+				Unique:  false,
+				Columns: []*schema.Column{ConversationFoldersColumns[5], ConversationFoldersColumns[4]},
+			},
 		},
 	}
 	// ConversationParticipantsColumns holds the columns for the "conversation_participants" table.
@@ -173,10 +331,15 @@ var (
 		{Name: "id", Type: field.TypeString},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"owner", "admin", "member"}, Default: "member"},
 		{Name: "joined_at", Type: field.TypeTime},
 		{Name: "last_read_at", Type: field.TypeTime, Nullable: true},
 		{Name: "is_archived", Type: field.TypeBool, Default: false},
-		{Name: "is_muted", Type: field.TypeBool, Default: false},
+		{Name: "notification_level", Type: field.TypeEnum, Enums: []string{"all", "mentions", "nothing"}, Default: "all"},
+		{Name: "muted_until", Type: field.TypeTime, Nullable: true},
+		{Name: "show_when_muted", Type: field.TypeBool, Default: false},
+		{Name: "pinned_at", Type: field.TypeTime, Nullable: true},
+		{Name: "pin_position", Type: field.TypeInt, Default: 0},
 		{Name: "conversation_participants", Type: field.TypeString, Nullable: true},
 		{Name: "conversation_id", Type: field.TypeString},
 		{Name: "user_id", Type: field.TypeString},
@@ -189,19 +352,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "conversation_participants_conversations_participants",
-				Columns:    []*schema.Column{ConversationParticipantsColumns[7]},
+				Columns:    []*schema.Column{ConversationParticipantsColumns[12]},
 				RefColumns: []*schema.Column{ConversationsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "conversation_participants_conversations_conversation",
-				Columns:    []*schema.Column{ConversationParticipantsColumns[8]},
+				Columns:    []*schema.Column{ConversationParticipantsColumns[13]},
 				RefColumns: []*schema.Column{ConversationsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "conversation_participants_users_user",
-				Columns:    []*schema.Column{ConversationParticipantsColumns[9]},
+				Columns:    []*schema.Column{ConversationParticipantsColumns[14]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -210,47 +373,134 @@ var (
 			{
 				Name:    "conversationparticipant_conversation_id_user_id",
 				Unique:  true,
-				Columns: []*schema.Column{ConversationParticipantsColumns[8], ConversationParticipantsColumns[9]},
+				Columns: []*schema.Column{ConversationParticipantsColumns[13], ConversationParticipantsColumns[14]},
 			},
 			{
 				Name:    "conversationparticipant_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{ConversationParticipantsColumns[9]},
+				Columns: []*schema.Column{ConversationParticipantsColumns[14]},
+			},
+			{
+				Name:    "conversationparticipant_conversation_id_role",
+				Unique:  false,
+				Columns: []*schema.Column{ConversationParticipantsColumns[13], ConversationParticipantsColumns[3]},
 			},
 			{
 				Name:    "conversationparticipant_user_id_is_archived",
 				Unique:  false,
-				Columns: []*schema.Column{ConversationParticipantsColumns[9], ConversationParticipantsColumns[5]},
+				Columns: []*schema.Column{ConversationParticipantsColumns[14], ConversationParticipantsColumns[6]},
 			},
 			{
-				Name:    "conversationparticipant_user_id_is_muted",
+				Name:    "conversationparticipant_user_id_notification_level",
 				Unique:  false,
-				Columns: []*schema.Column{ConversationParticipantsColumns[9], ConversationParticipantsColumns[6]},
+				Columns: []*schema.Column{ConversationParticipantsColumns[14], ConversationParticipantsColumns[7]},
 			},
 			{
-				Name:    "conversationparticipant_user_id_is_archived_is_muted",
+				Name:    "conversationparticipant_user_id_is_archived_notification_level",
 				Unique:  false,
-				Columns: []*schema.Column{ConversationParticipantsColumns[9], ConversationParticipantsColumns[5], ConversationParticipantsColumns[6]},
+				Columns: []*schema.Column{ConversationParticipantsColumns[14], ConversationParticipantsColumns[6], ConversationParticipantsColumns[7]},
 			},
 			{
-				Name:    "conversationparticipant_last_read_at",
+				Name:    "conversationparticipant_user_id_pinned_at",
 				Unique:  false,
-				Columns: []*schema.Column{ConversationParticipantsColumns[4]},
+				Columns: []*schema.Column{ConversationParticipantsColumns[14], ConversationParticipantsColumns[10]},
 			},
 			{
-				Name:    "conversationparticipant_is_archived",
+				Name:    "conversationparticipant_last_read_at",
 				Unique:  false,
 				Columns: []*schema.Column{ConversationParticipantsColumns[5]},
 			},
 			{
-				Name:    "conversationparticipant_is_muted",
+				Name:    "conversationparticipant_is_archived",
 				Unique:  false,
 				Columns: []*schema.Column{ConversationParticipantsColumns[6]},
 			},
 			{
 				Name:    "conversationparticipant_joined_at",
 				Unique:  false,
-				Columns: []*schema.Column{ConversationParticipantsColumns[3]},
+				Columns: []*schema.Column{ConversationParticipantsColumns[4]},
+			},
+		},
+	}
+	// DraftsColumns holds the columns for the "drafts" table.
+	DraftsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "reply_to_id", Type: field.TypeString, Nullable: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "conversation_id", Type: field.TypeString},
+	}
+	// DraftsTable holds the schema information for the "drafts" table.
+	DraftsTable = &schema.Table{
+		Name:       "drafts",
+		Columns:    DraftsColumns,
+		PrimaryKey: []*schema.Column{DraftsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "drafts_users_user",
+				Columns:    []*schema.Column{DraftsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "drafts_conversations_conversation",
+				Columns:    []*schema.Column{DraftsColumns[6]},
+				RefColumns: []*schema.Column{ConversationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "draft_user_id_conversation_id",
+				Unique:  true,
+				Columns: []*schema.Column{DraftsColumns[5], DraftsColumns[6]},
+			},
+			{
+				Name:    "draft_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{DraftsColumns[2]},
+			},
+		},
+	}
+	// FolderConversationsColumns holds the columns for the "folder_conversations" table.
+	FolderConversationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "folder_id", Type: field.TypeString},
+		{Name: "conversation_id", Type: field.TypeString},
+	}
+	// FolderConversationsTable holds the schema information for the "folder_conversations" table.
+	FolderConversationsTable = &schema.Table{
+		Name:       "folder_conversations",
+		Columns:    FolderConversationsColumns,
+		PrimaryKey: []*schema.Column{FolderConversationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "folder_conversations_conversation_folders_folder",
+				Columns:    []*schema.Column{FolderConversationsColumns[3]},
+				RefColumns: []*schema.Column{ConversationFoldersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "folder_conversations_conversations_conversation",
+				Columns:    []*schema.Column{FolderConversationsColumns[4]},
+				RefColumns: []*schema.Column{ConversationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "folderconversation_folder_id_conversation_id",
+				Unique:  true,
+				Columns: []*schema.Column{FolderConversationsColumns[3], FolderConversationsColumns[4]},
+			},
+			{
+				Name:    "folderconversation_conversation_id",
+				Unique:  false,
+				Columns: []*schema.Column{FolderConversationsColumns[4]},
 			},
 		},
 	}
@@ -320,6 +570,86 @@ var (
 			},
 		},
 	}
+	// FriendInvitesColumns holds the columns for the "friend_invites" table.
+	FriendInvitesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "code", Type: field.TypeString, Unique: true},
+		{Name: "auto_accept", Type: field.TypeBool, Default: true},
+		{Name: "max_uses", Type: field.TypeInt, Default: 0},
+		{Name: "uses", Type: field.TypeInt, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "owner_id", Type: field.TypeString},
+	}
+	// FriendInvitesTable holds the schema information for the "friend_invites" table.
+	FriendInvitesTable = &schema.Table{
+		Name:       "friend_invites",
+		Columns:    FriendInvitesColumns,
+		PrimaryKey: []*schema.Column{FriendInvitesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "friend_invites_users_owner",
+				Columns:    []*schema.Column{FriendInvitesColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "friendinvite_owner_id",
+				Unique:  false,
+				Columns: []*schema.Column{FriendInvitesColumns[9]},
+			},
+			{
+				Name:    "friendinvite_owner_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{FriendInvitesColumns[9], FriendInvitesColumns[1]},
+			},
+		},
+	}
+	// FriendInviteUsesColumns holds the columns for the "friend_invite_uses" table.
+	FriendInviteUsesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "result", Type: field.TypeEnum, Enums: []string{"accepted", "pending"}},
+		{Name: "invite_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString},
+	}
+	// FriendInviteUsesTable holds the schema information for the "friend_invite_uses" table.
+	FriendInviteUsesTable = &schema.Table{
+		Name:       "friend_invite_uses",
+		Columns:    FriendInviteUsesColumns,
+		PrimaryKey: []*schema.Column{FriendInviteUsesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "friend_invite_uses_friend_invites_invite",
+				Columns:    []*schema.Column{FriendInviteUsesColumns[4]},
+				RefColumns: []*schema.Column{FriendInvitesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "friend_invite_uses_users_user",
+				Columns:    []*schema.Column{FriendInviteUsesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "friendinviteuse_invite_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{FriendInviteUsesColumns[4], FriendInviteUsesColumns[5]},
+			},
+			{
+				Name:    "friendinviteuse_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{FriendInviteUsesColumns[5]},
+			},
+		},
+	}
 	// GuildsColumns holds the columns for the "guilds" table.
 	GuildsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -345,6 +675,46 @@ var (
 			},
 		},
 	}
+	// HiddenMessagesColumns holds the columns for the "hidden_messages" table.
+	HiddenMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "message_id", Type: field.TypeString},
+	}
+	// HiddenMessagesTable holds the schema information for the "hidden_messages" table.
+	HiddenMessagesTable = &schema.Table{
+		Name:       "hidden_messages",
+		Columns:    HiddenMessagesColumns,
+		PrimaryKey: []*schema.Column{HiddenMessagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "hidden_messages_users_user",
+				Columns:    []*schema.Column{HiddenMessagesColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "hidden_messages_messages_message",
+				Columns:    []*schema.Column{HiddenMessagesColumns[4]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "hiddenmessage_user_id_message_id",
+				Unique:  true,
+				Columns: []*schema.Column{HiddenMessagesColumns[3], HiddenMessagesColumns[4]},
+			},
+			{
+				Name:    "hiddenmessage_message_id",
+				Unique:  false,
+				Columns: []*schema.Column{HiddenMessagesColumns[4]},
+			},
+		},
+	}
 	// InvitationsColumns holds the columns for the "invitations" table.
 	InvitationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -374,6 +744,40 @@ var (
 			},
 		},
 	}
+	// LinkPreviewsColumns holds the columns for the "link_previews" table.
+	LinkPreviewsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "url", Type: field.TypeString, Size: 2048},
+		{Name: "title", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "image_url", Type: field.TypeString, Nullable: true, Size: 2048},
+		{Name: "site_name", Type: field.TypeString, Nullable: true},
+		{Name: "position", Type: field.TypeInt},
+		{Name: "message_id", Type: field.TypeString},
+	}
+	// LinkPreviewsTable holds the schema information for the "link_previews" table.
+	LinkPreviewsTable = &schema.Table{
+		Name:       "link_previews",
+		Columns:    LinkPreviewsColumns,
+		PrimaryKey: []*schema.Column{LinkPreviewsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "link_previews_messages_message",
+				Columns:    []*schema.Column{LinkPreviewsColumns[9]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "linkpreview_message_id_position",
+				Unique:  false,
+				Columns: []*schema.Column{LinkPreviewsColumns[9], LinkPreviewsColumns[8]},
+			},
+		},
+	}
 	// MembersColumns holds the columns for the "members" table.
 	MembersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -413,13 +817,24 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "content", Type: field.TypeString, Size: 2147483647},
-		{Name: "message_type", Type: field.TypeEnum, Enums: []string{"text", "image", "file", "call_start", "call_end"}, Default: "text"},
+		{Name: "plain_text", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "entities", Type: field.TypeJSON, Nullable: true},
+		{Name: "message_type", Type: field.TypeEnum, Enums: []string{"text", "image", "file", "call_start", "call_end", "system"}, Default: "text"},
 		{Name: "is_deleted", Type: field.TypeBool, Default: false},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "edited_at", Type: field.TypeTime, Nullable: true},
+		{Name: "thread_reply_count", Type: field.TypeInt, Default: 0},
+		{Name: "thread_last_reply_at", Type: field.TypeTime, Nullable: true},
+		{Name: "suppress_embeds", Type: field.TypeBool, Default: false},
+		{Name: "forwarded_from_message_id", Type: field.TypeString, Nullable: true},
+		{Name: "nonce", Type: field.TypeString, Nullable: true, Size: 128},
 		{Name: "conversation_messages", Type: field.TypeString, Nullable: true},
 		{Name: "conversation_id", Type: field.TypeString},
 		{Name: "sender_id", Type: field.TypeString},
 		{Name: "call_id", Type: field.TypeString, Nullable: true},
+		{Name: "forwarded_from_user_id", Type: field.TypeString, Nullable: true},
+		{Name: "reply_to_id", Type: field.TypeString, Nullable: true},
+		{Name: "thread_root_id", Type: field.TypeString, Nullable: true},
 	}
 	// MessagesTable holds the schema information for the "messages" table.
 	MessagesTable = &schema.Table{
@@ -429,69 +844,280 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "messages_conversations_messages",
-				Columns:    []*schema.Column{MessagesColumns[7]},
+				Columns:    []*schema.Column{MessagesColumns[15]},
 				RefColumns: []*schema.Column{ConversationsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "messages_conversations_conversation",
-				Columns:    []*schema.Column{MessagesColumns[8]},
+				Columns:    []*schema.Column{MessagesColumns[16]},
 				RefColumns: []*schema.Column{ConversationsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "messages_users_sender",
-				Columns:    []*schema.Column{MessagesColumns[9]},
+				Columns:    []*schema.Column{MessagesColumns[17]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "messages_calls_call",
-				Columns:    []*schema.Column{MessagesColumns[10]},
+				Columns:    []*schema.Column{MessagesColumns[18]},
 				RefColumns: []*schema.Column{CallsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "messages_users_forwarded_from_user",
+				Columns:    []*schema.Column{MessagesColumns[19]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "messages_messages_replies",
+				Columns:    []*schema.Column{MessagesColumns[20]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "messages_messages_thread_replies",
+				Columns:    []*schema.Column{MessagesColumns[21]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "message_conversation_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[8], MessagesColumns[1]},
+				Columns: []*schema.Column{MessagesColumns[16], MessagesColumns[1]},
 			},
 			{
 				Name:    "message_conversation_id_is_deleted_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[8], MessagesColumns[5], MessagesColumns[1]},
+				Columns: []*schema.Column{MessagesColumns[16], MessagesColumns[7], MessagesColumns[1]},
 			},
 			{
 				Name:    "message_sender_id",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[9]},
+				Columns: []*schema.Column{MessagesColumns[17]},
 			},
 			{
 				Name:    "message_sender_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[9], MessagesColumns[1]},
+				Columns: []*schema.Column{MessagesColumns[17], MessagesColumns[1]},
 			},
 			{
 				Name:    "message_is_deleted",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[5]},
+				Columns: []*schema.Column{MessagesColumns[7]},
 			},
 			{
 				Name:    "message_message_type",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[4]},
+				Columns: []*schema.Column{MessagesColumns[6]},
 			},
 			{
 				Name:    "message_created_at",
 				Unique:  false,
 				Columns: []*schema.Column{MessagesColumns[1]},
 			},
+			{
+				Name:    "message_is_deleted_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{MessagesColumns[7], MessagesColumns[8]},
+			},
 			{
 				Name:    "message_call_id",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[10]},
+				Columns: []*schema.Column{MessagesColumns[18]},
+			},
+			{
+				Name:    "message_reply_to_id",
+				Unique:  false,
+				Columns: []*schema.Column{MessagesColumns[20]},
+			},
+			{
+				Name:    "message_forwarded_from_message_id",
+				Unique:  false,
+				Columns: []*schema.Column{MessagesColumns[13]},
+			},
+			{
+				Name:    "message_thread_root_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{MessagesColumns[21], MessagesColumns[1]},
+			},
+			{
+				Name:    "message_sender_id_conversation_id_nonce",
+				Unique:  true,
+				Columns: []*schema.Column{MessagesColumns[17], MessagesColumns[16], MessagesColumns[14]},
+			},
+		},
+	}
+	// MessageMentionsColumns holds the columns for the "message_mentions" table.
+	MessageMentionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"user", "everyone"}},
+		{Name: "offset", Type: field.TypeInt},
+		{Name: "length", Type: field.TypeInt},
+		{Name: "message_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString, Nullable: true},
+	}
+	// MessageMentionsTable holds the schema information for the "message_mentions" table.
+	MessageMentionsTable = &schema.Table{
+		Name:       "message_mentions",
+		Columns:    MessageMentionsColumns,
+		PrimaryKey: []*schema.Column{MessageMentionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "message_mentions_messages_message",
+				Columns:    []*schema.Column{MessageMentionsColumns[6]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "message_mentions_users_user",
+				Columns:    []*schema.Column{MessageMentionsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messagemention_message_id",
+				Unique:  false,
+				Columns: []*schema.Column{MessageMentionsColumns[6]},
+			},
+			{
+				Name:    "messagemention_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{MessageMentionsColumns[7], MessageMentionsColumns[1]},
+			},
+			{
+				Name:    "messagemention_kind",
+				Unique:  false,
+				Columns: []*schema.Column{MessageMentionsColumns[3]},
+			},
+		},
+	}
+	// MessageReactionsColumns holds the columns for the "message_reactions" table.
+	MessageReactionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "emoji", Type: field.TypeString, Size: 64},
+		{Name: "message_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString},
+	}
+	// MessageReactionsTable holds the schema information for the "message_reactions" table.
+	MessageReactionsTable = &schema.Table{
+		Name:       "message_reactions",
+		Columns:    MessageReactionsColumns,
+		PrimaryKey: []*schema.Column{MessageReactionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "message_reactions_messages_message",
+				Columns:    []*schema.Column{MessageReactionsColumns[4]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "message_reactions_users_user",
+				Columns:    []*schema.Column{MessageReactionsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messagereaction_message_id_user_id_emoji",
+				Unique:  true,
+				Columns: []*schema.Column{MessageReactionsColumns[4], MessageReactionsColumns[5], MessageReactionsColumns[3]},
+			},
+			{
+				Name:    "messagereaction_message_id_emoji",
+				Unique:  false,
+				Columns: []*schema.Column{MessageReactionsColumns[4], MessageReactionsColumns[3]},
+			},
+		},
+	}
+	// MessageReceiptsColumns holds the columns for the "message_receipts" table.
+	MessageReceiptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "read_at", Type: field.TypeTime, Nullable: true},
+		{Name: "message_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString},
+	}
+	// MessageReceiptsTable holds the schema information for the "message_receipts" table.
+	MessageReceiptsTable = &schema.Table{
+		Name:       "message_receipts",
+		Columns:    MessageReceiptsColumns,
+		PrimaryKey: []*schema.Column{MessageReceiptsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "message_receipts_messages_message",
+				Columns:    []*schema.Column{MessageReceiptsColumns[5]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "message_receipts_users_user",
+				Columns:    []*schema.Column{MessageReceiptsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messagereceipt_message_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{MessageReceiptsColumns[5], MessageReceiptsColumns[6]},
+			},
+			{
+				Name:    "messagereceipt_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{MessageReceiptsColumns[6]},
+			},
+		},
+	}
+	// MessageRevisionsColumns holds the columns for the "message_revisions" table.
+	MessageRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "edited_at", Type: field.TypeTime},
+		{Name: "message_id", Type: field.TypeString},
+		{Name: "editor_id", Type: field.TypeString},
+	}
+	// MessageRevisionsTable holds the schema information for the "message_revisions" table.
+	MessageRevisionsTable = &schema.Table{
+		Name:       "message_revisions",
+		Columns:    MessageRevisionsColumns,
+		PrimaryKey: []*schema.Column{MessageRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "message_revisions_messages_message",
+				Columns:    []*schema.Column{MessageRevisionsColumns[5]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "message_revisions_users_editor",
+				Columns:    []*schema.Column{MessageRevisionsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messagerevision_message_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{MessageRevisionsColumns[5], MessageRevisionsColumns[1]},
 			},
 		},
 	}
@@ -500,13 +1126,14 @@ var (
 		{Name: "id", Type: field.TypeString},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"friend_request", "message", "friend_accepted"}},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"friend_request", "message", "friend_accepted", "friend_invite", "mention", "reminder"}},
 		{Name: "title", Type: field.TypeString, Size: 100},
 		{Name: "content", Type: field.TypeString, Size: 2147483647},
 		{Name: "is_read", Type: field.TypeBool, Default: false},
 		{Name: "user_id", Type: field.TypeString},
 		{Name: "related_user_id", Type: field.TypeString, Nullable: true},
 		{Name: "related_conversation_id", Type: field.TypeString, Nullable: true},
+		{Name: "related_message_id", Type: field.TypeString, Nullable: true},
 	}
 	// NotificationsTable holds the schema information for the "notifications" table.
 	NotificationsTable = &schema.Table{
@@ -532,6 +1159,12 @@ var (
 				RefColumns: []*schema.Column{ConversationsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "notifications_messages_related_message",
+				Columns:    []*schema.Column{NotificationsColumns[10]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
@@ -579,6 +1212,129 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{NotificationsColumns[9]},
 			},
+			{
+				Name:    "notification_related_message_id",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[10]},
+			},
+		},
+	}
+	// PinnedMessagesColumns holds the columns for the "pinned_messages" table.
+	PinnedMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "conversation_id", Type: field.TypeString},
+		{Name: "message_id", Type: field.TypeString},
+		{Name: "pinned_by_id", Type: field.TypeString},
+	}
+	// PinnedMessagesTable holds the schema information for the "pinned_messages" table.
+	PinnedMessagesTable = &schema.Table{
+		Name:       "pinned_messages",
+		Columns:    PinnedMessagesColumns,
+		PrimaryKey: []*schema.Column{PinnedMessagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "pinned_messages_conversations_conversation",
+				Columns:    []*schema.Column{PinnedMessagesColumns[3]},
+				RefColumns: []*schema.Column{ConversationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "pinned_messages_messages_message",
+				Columns:    []*schema.Column{PinnedMessagesColumns[4]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "pinned_messages_users_pinned_by",
+				Columns:    []*schema.Column{PinnedMessagesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pinnedmessage_message_id",
+				Unique:  true,
+				Columns: []*schema.Column{PinnedMessagesColumns[4]},
+			},
+			{
+				Name:    "pinnedmessage_conversation_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PinnedMessagesColumns[3], PinnedMessagesColumns[1]},
+			},
+			{
+				Name:    "pinnedmessage_pinned_by_id",
+				Unique:  false,
+				Columns: []*schema.Column{PinnedMessagesColumns[5]},
+			},
+		},
+	}
+	// ScheduledMessagesColumns holds the columns for the "scheduled_messages" table.
+	ScheduledMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "reply_to_id", Type: field.TypeString, Nullable: true},
+		{Name: "suppress_embeds", Type: field.TypeBool, Default: false},
+		{Name: "send_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "sent", "failed", "cancelled"}, Default: "pending"},
+		{Name: "failure_reason", Type: field.TypeString, Nullable: true},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "claim_token", Type: field.TypeString, Nullable: true},
+		{Name: "claim_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "conversation_id", Type: field.TypeString},
+		{Name: "sender_id", Type: field.TypeString},
+		{Name: "message_id", Type: field.TypeString, Nullable: true},
+	}
+	// ScheduledMessagesTable holds the schema information for the "scheduled_messages" table.
+	ScheduledMessagesTable = &schema.Table{
+		Name:       "scheduled_messages",
+		Columns:    ScheduledMessagesColumns,
+		PrimaryKey: []*schema.Column{ScheduledMessagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "scheduled_messages_conversations_conversation",
+				Columns:    []*schema.Column{ScheduledMessagesColumns[12]},
+				RefColumns: []*schema.Column{ConversationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "scheduled_messages_users_sender",
+				Columns:    []*schema.Column{ScheduledMessagesColumns[13]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "scheduled_messages_messages_message",
+				Columns:    []*schema.Column{ScheduledMessagesColumns[14]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "scheduledmessage_status_send_at",
+				Unique:  false,
+				Columns: []*schema.Column{ScheduledMessagesColumns[7], ScheduledMessagesColumns[6]},
+			},
+			{
+				Name:    "scheduledmessage_sender_id_status_send_at",
+				Unique:  false,
+				Columns: []*schema.Column{ScheduledMessagesColumns[13], ScheduledMessagesColumns[7], ScheduledMessagesColumns[6]},
+			},
+			{
+				Name:    "scheduledmessage_conversation_id",
+				Unique:  false,
+				Columns: []*schema.Column{ScheduledMessagesColumns[12]},
+			},
+			{
+				Name:    "scheduledmessage_message_id",
+				Unique:  true,
+				Columns: []*schema.Column{ScheduledMessagesColumns[14]},
+			},
 		},
 	}
 	// SessionsColumns holds the columns for the "sessions" table.
@@ -605,6 +1361,47 @@ var (
 			},
 		},
 	}
+	// ThreadParticipantsColumns holds the columns for the "thread_participants" table.
+	ThreadParticipantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "last_read_at", Type: field.TypeTime, Nullable: true},
+		{Name: "root_message_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString},
+	}
+	// ThreadParticipantsTable holds the schema information for the "thread_participants" table.
+	ThreadParticipantsTable = &schema.Table{
+		Name:       "thread_participants",
+		Columns:    ThreadParticipantsColumns,
+		PrimaryKey: []*schema.Column{ThreadParticipantsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "thread_participants_messages_root_message",
+				Columns:    []*schema.Column{ThreadParticipantsColumns[4]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "thread_participants_users_user",
+				Columns:    []*schema.Column{ThreadParticipantsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "threadparticipant_root_message_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{ThreadParticipantsColumns[4], ThreadParticipantsColumns[5]},
+			},
+			{
+				Name:    "threadparticipant_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{ThreadParticipantsColumns[5]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -617,6 +1414,7 @@ var (
 		{Name: "bio", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "avater_url", Type: field.TypeString, Nullable: true},
 		{Name: "cover_url", Type: field.TypeString, Nullable: true},
+		{Name: "read_receipts_enabled", Type: field.TypeBool, Default: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -648,42 +1446,93 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AttachmentsTable,
 		BlocksTable,
+		BookmarksTable,
 		CallsTable,
 		ConversationsTable,
+		ConversationFoldersTable,
 		ConversationParticipantsTable,
+		DraftsTable,
+		FolderConversationsTable,
 		FriendsTable,
+		FriendInvitesTable,
+		FriendInviteUsesTable,
 		GuildsTable,
+		HiddenMessagesTable,
 		InvitationsTable,
+		LinkPreviewsTable,
 		MembersTable,
 		MessagesTable,
+		MessageMentionsTable,
+		MessageReactionsTable,
+		MessageReceiptsTable,
+		MessageRevisionsTable,
 		NotificationsTable,
+		PinnedMessagesTable,
+		ScheduledMessagesTable,
 		SessionsTable,
+		ThreadParticipantsTable,
 		UsersTable,
 	}
 )
 
 func init() {
+	AttachmentsTable.ForeignKeys[0].RefTable = MessagesTable
+	AttachmentsTable.ForeignKeys[1].RefTable = UsersTable
 	BlocksTable.ForeignKeys[0].RefTable = UsersTable
 	BlocksTable.ForeignKeys[1].RefTable = UsersTable
+	BookmarksTable.ForeignKeys[0].RefTable = UsersTable
+	BookmarksTable.ForeignKeys[1].RefTable = MessagesTable
 	CallsTable.ForeignKeys[0].RefTable = UsersTable
 	CallsTable.ForeignKeys[1].RefTable = UsersTable
+	ConversationFoldersTable.ForeignKeys[0].RefTable = UsersTable
 	ConversationParticipantsTable.ForeignKeys[0].RefTable = ConversationsTable
 	ConversationParticipantsTable.ForeignKeys[1].RefTable = ConversationsTable
 	ConversationParticipantsTable.ForeignKeys[2].RefTable = UsersTable
+	DraftsTable.ForeignKeys[0].RefTable = UsersTable
+	DraftsTable.ForeignKeys[1].RefTable = ConversationsTable
+	FolderConversationsTable.ForeignKeys[0].RefTable = ConversationFoldersTable
+	FolderConversationsTable.ForeignKeys[1].RefTable = ConversationsTable
 	FriendsTable.ForeignKeys[0].RefTable = UsersTable
 	FriendsTable.ForeignKeys[1].RefTable = UsersTable
+	FriendInvitesTable.ForeignKeys[0].RefTable = UsersTable
+	FriendInviteUsesTable.ForeignKeys[0].RefTable = FriendInvitesTable
+	FriendInviteUsesTable.ForeignKeys[1].RefTable = UsersTable
 	GuildsTable.ForeignKeys[0].RefTable = UsersTable
+	HiddenMessagesTable.ForeignKeys[0].RefTable = UsersTable
+	HiddenMessagesTable.ForeignKeys[1].RefTable = MessagesTable
 	InvitationsTable.ForeignKeys[0].RefTable = GuildsTable
 	InvitationsTable.ForeignKeys[1].RefTable = UsersTable
+	LinkPreviewsTable.ForeignKeys[0].RefTable = MessagesTable
 	MembersTable.ForeignKeys[0].RefTable = GuildsTable
 	MembersTable.ForeignKeys[1].RefTable = UsersTable
 	MessagesTable.ForeignKeys[0].RefTable = ConversationsTable
 	MessagesTable.ForeignKeys[1].RefTable = ConversationsTable
 	MessagesTable.ForeignKeys[2].RefTable = UsersTable
 	MessagesTable.ForeignKeys[3].RefTable = CallsTable
+	MessagesTable.ForeignKeys[4].RefTable = UsersTable
+	MessagesTable.ForeignKeys[5].RefTable = MessagesTable
+	MessagesTable.ForeignKeys[6].RefTable = MessagesTable
+	MessageMentionsTable.ForeignKeys[0].RefTable = MessagesTable
+	MessageMentionsTable.ForeignKeys[1].RefTable = UsersTable
+	MessageReactionsTable.ForeignKeys[0].RefTable = MessagesTable
+	MessageReactionsTable.ForeignKeys[1].RefTable = UsersTable
+	MessageReceiptsTable.ForeignKeys[0].RefTable = MessagesTable
+	MessageReceiptsTable.ForeignKeys[1].RefTable = UsersTable
+	MessageRevisionsTable.ForeignKeys[0].RefTable = MessagesTable
+	MessageRevisionsTable.ForeignKeys[1].RefTable = UsersTable
 	NotificationsTable.ForeignKeys[0].RefTable = UsersTable
 	NotificationsTable.ForeignKeys[1].RefTable = UsersTable
 	NotificationsTable.ForeignKeys[2].RefTable = ConversationsTable
+	NotificationsTable.ForeignKeys[3].RefTable = MessagesTable
+	PinnedMessagesTable.ForeignKeys[0].RefTable = ConversationsTable
+	PinnedMessagesTable.ForeignKeys[1].RefTable = MessagesTable
+	PinnedMessagesTable.ForeignKeys[2].RefTable = UsersTable
+	ScheduledMessagesTable.ForeignKeys[0].RefTable = ConversationsTable
+	ScheduledMessagesTable.ForeignKeys[1].RefTable = UsersTable
+	ScheduledMessagesTable.ForeignKeys[2].RefTable = MessagesTable
 	SessionsTable.ForeignKeys[0].RefTable = UsersTable
+	ThreadParticipantsTable.ForeignKeys[0].RefTable = MessagesTable
+	ThreadParticipantsTable.ForeignKeys[1].RefTable = UsersTable
 }
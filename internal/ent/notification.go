@@ -5,6 +5,7 @@ package ent
 import (
 	"fmt"
 	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/message"
 	"kakashi/chaos/internal/ent/notification"
 	"kakashi/chaos/internal/ent/user"
 	"strings"
@@ -37,6 +38,8 @@ type Notification struct {
 	RelatedUserID string `json:"related_user_id,omitempty"`
 	// RelatedConversationID holds the value of the "related_conversation_id" field.
 	RelatedConversationID string `json:"related_conversation_id,omitempty"`
+	// RelatedMessageID holds the value of the "related_message_id" field.
+	RelatedMessageID string `json:"related_message_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the NotificationQuery when eager-loading is set.
 	Edges        NotificationEdges `json:"edges"`
@@ -51,9 +54,11 @@ type NotificationEdges struct {
 	RelatedUser *User `json:"related_user,omitempty"`
 	// RelatedConversation holds the value of the related_conversation edge.
 	RelatedConversation *Conversation `json:"related_conversation,omitempty"`
+	// RelatedMessage holds the value of the related_message edge.
+	RelatedMessage *Message `json:"related_message,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -89,6 +94,17 @@ func (e NotificationEdges) RelatedConversationOrErr() (*Conversation, error) {
 	return nil, &NotLoadedError{edge: "related_conversation"}
 }
 
+// RelatedMessageOrErr returns the RelatedMessage value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NotificationEdges) RelatedMessageOrErr() (*Message, error) {
+	if e.RelatedMessage != nil {
+		return e.RelatedMessage, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "related_message"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Notification) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -96,7 +112,7 @@ func (*Notification) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case notification.FieldIsRead:
 			values[i] = new(sql.NullBool)
-		case notification.FieldID, notification.FieldUserID, notification.FieldType, notification.FieldTitle, notification.FieldContent, notification.FieldRelatedUserID, notification.FieldRelatedConversationID:
+		case notification.FieldID, notification.FieldUserID, notification.FieldType, notification.FieldTitle, notification.FieldContent, notification.FieldRelatedUserID, notification.FieldRelatedConversationID, notification.FieldRelatedMessageID:
 			values[i] = new(sql.NullString)
 		case notification.FieldCreatedAt, notification.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -175,6 +191,12 @@ func (n *Notification) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				n.RelatedConversationID = value.String
 			}
+		case notification.FieldRelatedMessageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field related_message_id", values[i])
+			} else if value.Valid {
+				n.RelatedMessageID = value.String
+			}
 		default:
 			n.selectValues.Set(columns[i], values[i])
 		}
@@ -203,6 +225,11 @@ func (n *Notification) QueryRelatedConversation() *ConversationQuery {
 	return NewNotificationClient(n.config).QueryRelatedConversation(n)
 }
 
+// QueryRelatedMessage queries the "related_message" edge of the Notification entity.
+func (n *Notification) QueryRelatedMessage() *MessageQuery {
+	return NewNotificationClient(n.config).QueryRelatedMessage(n)
+}
+
 // Update returns a builder for updating this Notification.
 // Note that you need to call Notification.Unwrap() before calling this method if this Notification
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -252,6 +279,9 @@ func (n *Notification) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("related_conversation_id=")
 	builder.WriteString(n.RelatedConversationID)
+	builder.WriteString(", ")
+	builder.WriteString("related_message_id=")
+	builder.WriteString(n.RelatedMessageID)
 	builder.WriteByte(')')
 	return builder.String()
 }
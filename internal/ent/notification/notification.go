@@ -33,12 +33,16 @@ const (
 	FieldRelatedUserID = "related_user_id"
 	// FieldRelatedConversationID holds the string denoting the related_conversation_id field in the database.
 	FieldRelatedConversationID = "related_conversation_id"
+	// FieldRelatedMessageID holds the string denoting the related_message_id field in the database.
+	FieldRelatedMessageID = "related_message_id"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// EdgeRelatedUser holds the string denoting the related_user edge name in mutations.
 	EdgeRelatedUser = "related_user"
 	// EdgeRelatedConversation holds the string denoting the related_conversation edge name in mutations.
 	EdgeRelatedConversation = "related_conversation"
+	// EdgeRelatedMessage holds the string denoting the related_message edge name in mutations.
+	EdgeRelatedMessage = "related_message"
 	// Table holds the table name of the notification in the database.
 	Table = "notifications"
 	// UserTable is the table that holds the user relation/edge.
@@ -62,6 +66,13 @@ const (
 	RelatedConversationInverseTable = "conversations"
 	// RelatedConversationColumn is the table column denoting the related_conversation relation/edge.
 	RelatedConversationColumn = "related_conversation_id"
+	// RelatedMessageTable is the table that holds the related_message relation/edge.
+	RelatedMessageTable = "notifications"
+	// RelatedMessageInverseTable is the table name for the Message entity.
+	// It exists in this package in order to avoid circular dependency with the "message" package.
+	RelatedMessageInverseTable = "messages"
+	// RelatedMessageColumn is the table column denoting the related_message relation/edge.
+	RelatedMessageColumn = "related_message_id"
 )
 
 // Columns holds all SQL columns for notification fields.
@@ -76,6 +87,7 @@ var Columns = []string{
 	FieldIsRead,
 	FieldRelatedUserID,
 	FieldRelatedConversationID,
+	FieldRelatedMessageID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -115,6 +127,9 @@ const (
 	TypeFriendRequest  Type = "friend_request"
 	TypeMessage        Type = "message"
 	TypeFriendAccepted Type = "friend_accepted"
+	TypeFriendInvite   Type = "friend_invite"
+	TypeMention        Type = "mention"
+	TypeReminder       Type = "reminder"
 )
 
 func (_type Type) String() string {
@@ -124,7 +139,7 @@ func (_type Type) String() string {
 // TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
 func TypeValidator(_type Type) error {
 	switch _type {
-	case TypeFriendRequest, TypeMessage, TypeFriendAccepted:
+	case TypeFriendRequest, TypeMessage, TypeFriendAccepted, TypeFriendInvite, TypeMention, TypeReminder:
 		return nil
 	default:
 		return fmt.Errorf("notification: invalid enum value for type field: %q", _type)
@@ -184,6 +199,11 @@ func ByRelatedConversationID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRelatedConversationID, opts...).ToFunc()
 }
 
+// ByRelatedMessageID orders the results by the related_message_id field.
+func ByRelatedMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRelatedMessageID, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -204,6 +224,13 @@ func ByRelatedConversationField(field string, opts ...sql.OrderTermOption) Order
 		sqlgraph.OrderByNeighborTerms(s, newRelatedConversationStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByRelatedMessageField orders the results by related_message field.
+func ByRelatedMessageField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRelatedMessageStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -225,3 +252,10 @@ func newRelatedConversationStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, false, RelatedConversationTable, RelatedConversationColumn),
 	)
 }
+func newRelatedMessageStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RelatedMessageInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, RelatedMessageTable, RelatedMessageColumn),
+	)
+}
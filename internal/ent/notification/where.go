@@ -105,6 +105,11 @@ func RelatedConversationID(v string) predicate.Notification {
 	return predicate.Notification(sql.FieldEQ(FieldRelatedConversationID, v))
 }
 
+// RelatedMessageID applies equality check predicate on the "related_message_id" field. It's identical to RelatedMessageIDEQ.
+func RelatedMessageID(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldEQ(FieldRelatedMessageID, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Notification {
 	return predicate.Notification(sql.FieldEQ(FieldCreatedAt, v))
@@ -560,6 +565,81 @@ func RelatedConversationIDContainsFold(v string) predicate.Notification {
 	return predicate.Notification(sql.FieldContainsFold(FieldRelatedConversationID, v))
 }
 
+// RelatedMessageIDEQ applies the EQ predicate on the "related_message_id" field.
+func RelatedMessageIDEQ(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldEQ(FieldRelatedMessageID, v))
+}
+
+// RelatedMessageIDNEQ applies the NEQ predicate on the "related_message_id" field.
+func RelatedMessageIDNEQ(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldNEQ(FieldRelatedMessageID, v))
+}
+
+// RelatedMessageIDIn applies the In predicate on the "related_message_id" field.
+func RelatedMessageIDIn(vs ...string) predicate.Notification {
+	return predicate.Notification(sql.FieldIn(FieldRelatedMessageID, vs...))
+}
+
+// RelatedMessageIDNotIn applies the NotIn predicate on the "related_message_id" field.
+func RelatedMessageIDNotIn(vs ...string) predicate.Notification {
+	return predicate.Notification(sql.FieldNotIn(FieldRelatedMessageID, vs...))
+}
+
+// RelatedMessageIDGT applies the GT predicate on the "related_message_id" field.
+func RelatedMessageIDGT(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldGT(FieldRelatedMessageID, v))
+}
+
+// RelatedMessageIDGTE applies the GTE predicate on the "related_message_id" field.
+func RelatedMessageIDGTE(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldGTE(FieldRelatedMessageID, v))
+}
+
+// RelatedMessageIDLT applies the LT predicate on the "related_message_id" field.
+func RelatedMessageIDLT(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldLT(FieldRelatedMessageID, v))
+}
+
+// RelatedMessageIDLTE applies the LTE predicate on the "related_message_id" field.
+func RelatedMessageIDLTE(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldLTE(FieldRelatedMessageID, v))
+}
+
+// RelatedMessageIDContains applies the Contains predicate on the "related_message_id" field.
+func RelatedMessageIDContains(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldContains(FieldRelatedMessageID, v))
+}
+
+// RelatedMessageIDHasPrefix applies the HasPrefix predicate on the "related_message_id" field.
+func RelatedMessageIDHasPrefix(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldHasPrefix(FieldRelatedMessageID, v))
+}
+
+// RelatedMessageIDHasSuffix applies the HasSuffix predicate on the "related_message_id" field.
+func RelatedMessageIDHasSuffix(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldHasSuffix(FieldRelatedMessageID, v))
+}
+
+// RelatedMessageIDIsNil applies the IsNil predicate on the "related_message_id" field.
+func RelatedMessageIDIsNil() predicate.Notification {
+	return predicate.Notification(sql.FieldIsNull(FieldRelatedMessageID))
+}
+
+// RelatedMessageIDNotNil applies the NotNil predicate on the "related_message_id" field.
+func RelatedMessageIDNotNil() predicate.Notification {
+	return predicate.Notification(sql.FieldNotNull(FieldRelatedMessageID))
+}
+
+// RelatedMessageIDEqualFold applies the EqualFold predicate on the "related_message_id" field.
+func RelatedMessageIDEqualFold(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldEqualFold(FieldRelatedMessageID, v))
+}
+
+// RelatedMessageIDContainsFold applies the ContainsFold predicate on the "related_message_id" field.
+func RelatedMessageIDContainsFold(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldContainsFold(FieldRelatedMessageID, v))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Notification {
 	return predicate.Notification(func(s *sql.Selector) {
@@ -629,6 +709,29 @@ func HasRelatedConversationWith(preds ...predicate.Conversation) predicate.Notif
 	})
 }
 
+// HasRelatedMessage applies the HasEdge predicate on the "related_message" edge.
+func HasRelatedMessage() predicate.Notification {
+	return predicate.Notification(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, RelatedMessageTable, RelatedMessageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRelatedMessageWith applies the HasEdge predicate on the "related_message" edge with a given conditions (other predicates).
+func HasRelatedMessageWith(preds ...predicate.Message) predicate.Notification {
+	return predicate.Notification(func(s *sql.Selector) {
+		step := newRelatedMessageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Notification) predicate.Notification {
 	return predicate.Notification(sql.AndPredicates(predicates...))
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/message"
 	"kakashi/chaos/internal/ent/notification"
 	"kakashi/chaos/internal/ent/user"
 	"time"
@@ -116,6 +117,20 @@ func (nc *NotificationCreate) SetNillableRelatedConversationID(s *string) *Notif
 	return nc
 }
 
+// SetRelatedMessageID sets the "related_message_id" field.
+func (nc *NotificationCreate) SetRelatedMessageID(s string) *NotificationCreate {
+	nc.mutation.SetRelatedMessageID(s)
+	return nc
+}
+
+// SetNillableRelatedMessageID sets the "related_message_id" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableRelatedMessageID(s *string) *NotificationCreate {
+	if s != nil {
+		nc.SetRelatedMessageID(*s)
+	}
+	return nc
+}
+
 // SetID sets the "id" field.
 func (nc *NotificationCreate) SetID(s string) *NotificationCreate {
 	nc.mutation.SetID(s)
@@ -145,6 +160,11 @@ func (nc *NotificationCreate) SetRelatedConversation(c *Conversation) *Notificat
 	return nc.SetRelatedConversationID(c.ID)
 }
 
+// SetRelatedMessage sets the "related_message" edge to the Message entity.
+func (nc *NotificationCreate) SetRelatedMessage(m *Message) *NotificationCreate {
+	return nc.SetRelatedMessageID(m.ID)
+}
+
 // Mutation returns the NotificationMutation object of the builder.
 func (nc *NotificationCreate) Mutation() *NotificationMutation {
 	return nc.mutation
@@ -354,6 +374,23 @@ func (nc *NotificationCreate) createSpec() (*Notification, *sqlgraph.CreateSpec)
 		_node.RelatedConversationID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := nc.mutation.RelatedMessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   notification.RelatedMessageTable,
+			Columns: []string{notification.RelatedMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RelatedMessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -0,0 +1,65 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/schema"
+
+	atlas "ariga.io/atlas/sql/schema"
+)
+
+// legacyMuteColumn is the boolean mute flag of conversation participants that notification_level replaced
+const legacyMuteColumn = "is_muted"
+
+// KeepLegacyMutes stops auto migration from dropping the old participant mute flag, so
+// BackfillNotificationLevels can carry existing mutes over before removing it
+func KeepLegacyMutes() schema.MigrateOption {
+	return schema.WithDiffHook(func(next schema.Differ) schema.Differ {
+		return schema.DiffFunc(func(current, desired *atlas.Schema) ([]atlas.Change, error) {
+			changes, err := next.Diff(current, desired)
+			if err != nil {
+				return nil, err
+			}
+			for _, change := range changes {
+				modify, ok := change.(*atlas.ModifyTable)
+				if !ok || modify.T.Name != "conversation_participants" {
+					continue
+				}
+				kept := modify.Changes[:0]
+				for _, c := range modify.Changes {
+					if drop, ok := c.(*atlas.DropColumn); ok && drop.C.Name == legacyMuteColumn {
+						continue
+					}
+					kept = append(kept, c)
+				}
+				modify.Changes = kept
+			}
+			return changes, nil
+		})
+	})
+}
+
+// BackfillNotificationLevels turns participants muted with the old flag into the mentions level, since a
+// mute never silenced mentions, and then drops the flag on PostgreSQL. It runs after auto migration and does nothing once the flag is gone
+func BackfillNotificationLevels(ctx context.Context, client *Client) error {
+	if client.driver.Dialect() != dialect.Postgres {
+		return nil
+	}
+
+	statement := fmt.Sprintf(`DO $$
+BEGIN
+	IF EXISTS (
+		SELECT 1 FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = 'conversation_participants' AND column_name = '%[1]s'
+	) THEN
+		UPDATE conversation_participants SET notification_level = 'mentions' WHERE %[1]s AND notification_level = 'all';
+		ALTER TABLE conversation_participants DROP COLUMN %[1]s;
+	END IF;
+END $$`, legacyMuteColumn)
+	if err := client.driver.Exec(ctx, statement, []any{}, nil); err != nil {
+		return fmt.Errorf("failed to backfill notification levels: %w", err)
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/message"
 	"kakashi/chaos/internal/ent/notification"
 	"kakashi/chaos/internal/ent/predicate"
 	"kakashi/chaos/internal/ent/user"
@@ -27,6 +28,7 @@ type NotificationQuery struct {
 	withUser                *UserQuery
 	withRelatedUser         *UserQuery
 	withRelatedConversation *ConversationQuery
+	withRelatedMessage      *MessageQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -129,6 +131,28 @@ func (nq *NotificationQuery) QueryRelatedConversation() *ConversationQuery {
 	return query
 }
 
+// QueryRelatedMessage chains the current query on the "related_message" edge.
+func (nq *NotificationQuery) QueryRelatedMessage() *MessageQuery {
+	query := (&MessageClient{config: nq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notification.Table, notification.FieldID, selector),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, notification.RelatedMessageTable, notification.RelatedMessageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(nq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Notification entity from the query.
 // Returns a *NotFoundError when no Notification was found.
 func (nq *NotificationQuery) First(ctx context.Context) (*Notification, error) {
@@ -324,6 +348,7 @@ func (nq *NotificationQuery) Clone() *NotificationQuery {
 		withUser:                nq.withUser.Clone(),
 		withRelatedUser:         nq.withRelatedUser.Clone(),
 		withRelatedConversation: nq.withRelatedConversation.Clone(),
+		withRelatedMessage:      nq.withRelatedMessage.Clone(),
 		// clone intermediate query.
 		sql:  nq.sql.Clone(),
 		path: nq.path,
@@ -363,6 +388,17 @@ func (nq *NotificationQuery) WithRelatedConversation(opts ...func(*ConversationQ
 	return nq
 }
 
+// WithRelatedMessage tells the query-builder to eager-load the nodes that are connected to
+// the "related_message" edge. The optional arguments are used to configure the query builder of the edge.
+func (nq *NotificationQuery) WithRelatedMessage(opts ...func(*MessageQuery)) *NotificationQuery {
+	query := (&MessageClient{config: nq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	nq.withRelatedMessage = query
+	return nq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -441,10 +477,11 @@ func (nq *NotificationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 	var (
 		nodes       = []*Notification{}
 		_spec       = nq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [4]bool{
 			nq.withUser != nil,
 			nq.withRelatedUser != nil,
 			nq.withRelatedConversation != nil,
+			nq.withRelatedMessage != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -483,6 +520,12 @@ func (nq *NotificationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	if query := nq.withRelatedMessage; query != nil {
+		if err := nq.loadRelatedMessage(ctx, query, nodes, nil,
+			func(n *Notification, e *Message) { n.Edges.RelatedMessage = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -573,6 +616,35 @@ func (nq *NotificationQuery) loadRelatedConversation(ctx context.Context, query
 	}
 	return nil
 }
+func (nq *NotificationQuery) loadRelatedMessage(ctx context.Context, query *MessageQuery, nodes []*Notification, init func(*Notification), assign func(*Notification, *Message)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Notification)
+	for i := range nodes {
+		fk := nodes[i].RelatedMessageID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(message.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "related_message_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (nq *NotificationQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := nq.querySpec()
@@ -608,6 +680,9 @@ func (nq *NotificationQuery) querySpec() *sqlgraph.QuerySpec {
 		if nq.withRelatedConversation != nil {
 			_spec.Node.AddColumnOnce(notification.FieldRelatedConversationID)
 		}
+		if nq.withRelatedMessage != nil {
+			_spec.Node.AddColumnOnce(notification.FieldRelatedMessageID)
+		}
 	}
 	if ps := nq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/message"
 	"kakashi/chaos/internal/ent/notification"
 	"kakashi/chaos/internal/ent/predicate"
 	"kakashi/chaos/internal/ent/user"
@@ -160,6 +161,26 @@ func (nu *NotificationUpdate) ClearRelatedConversationID() *NotificationUpdate {
 	return nu
 }
 
+// SetRelatedMessageID sets the "related_message_id" field.
+func (nu *NotificationUpdate) SetRelatedMessageID(s string) *NotificationUpdate {
+	nu.mutation.SetRelatedMessageID(s)
+	return nu
+}
+
+// SetNillableRelatedMessageID sets the "related_message_id" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableRelatedMessageID(s *string) *NotificationUpdate {
+	if s != nil {
+		nu.SetRelatedMessageID(*s)
+	}
+	return nu
+}
+
+// ClearRelatedMessageID clears the value of the "related_message_id" field.
+func (nu *NotificationUpdate) ClearRelatedMessageID() *NotificationUpdate {
+	nu.mutation.ClearRelatedMessageID()
+	return nu
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (nu *NotificationUpdate) SetUser(u *User) *NotificationUpdate {
 	return nu.SetUserID(u.ID)
@@ -175,6 +196,11 @@ func (nu *NotificationUpdate) SetRelatedConversation(c *Conversation) *Notificat
 	return nu.SetRelatedConversationID(c.ID)
 }
 
+// SetRelatedMessage sets the "related_message" edge to the Message entity.
+func (nu *NotificationUpdate) SetRelatedMessage(m *Message) *NotificationUpdate {
+	return nu.SetRelatedMessageID(m.ID)
+}
+
 // Mutation returns the NotificationMutation object of the builder.
 func (nu *NotificationUpdate) Mutation() *NotificationMutation {
 	return nu.mutation
@@ -198,6 +224,12 @@ func (nu *NotificationUpdate) ClearRelatedConversation() *NotificationUpdate {
 	return nu
 }
 
+// ClearRelatedMessage clears the "related_message" edge to the Message entity.
+func (nu *NotificationUpdate) ClearRelatedMessage() *NotificationUpdate {
+	nu.mutation.ClearRelatedMessage()
+	return nu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (nu *NotificationUpdate) Save(ctx context.Context) (int, error) {
 	nu.defaults()
@@ -379,6 +411,35 @@ func (nu *NotificationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if nu.mutation.RelatedMessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   notification.RelatedMessageTable,
+			Columns: []string{notification.RelatedMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.RelatedMessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   notification.RelatedMessageTable,
+			Columns: []string{notification.RelatedMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{notification.Label}
@@ -529,6 +590,26 @@ func (nuo *NotificationUpdateOne) ClearRelatedConversationID() *NotificationUpda
 	return nuo
 }
 
+// SetRelatedMessageID sets the "related_message_id" field.
+func (nuo *NotificationUpdateOne) SetRelatedMessageID(s string) *NotificationUpdateOne {
+	nuo.mutation.SetRelatedMessageID(s)
+	return nuo
+}
+
+// SetNillableRelatedMessageID sets the "related_message_id" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableRelatedMessageID(s *string) *NotificationUpdateOne {
+	if s != nil {
+		nuo.SetRelatedMessageID(*s)
+	}
+	return nuo
+}
+
+// ClearRelatedMessageID clears the value of the "related_message_id" field.
+func (nuo *NotificationUpdateOne) ClearRelatedMessageID() *NotificationUpdateOne {
+	nuo.mutation.ClearRelatedMessageID()
+	return nuo
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (nuo *NotificationUpdateOne) SetUser(u *User) *NotificationUpdateOne {
 	return nuo.SetUserID(u.ID)
@@ -544,6 +625,11 @@ func (nuo *NotificationUpdateOne) SetRelatedConversation(c *Conversation) *Notif
 	return nuo.SetRelatedConversationID(c.ID)
 }
 
+// SetRelatedMessage sets the "related_message" edge to the Message entity.
+func (nuo *NotificationUpdateOne) SetRelatedMessage(m *Message) *NotificationUpdateOne {
+	return nuo.SetRelatedMessageID(m.ID)
+}
+
 // Mutation returns the NotificationMutation object of the builder.
 func (nuo *NotificationUpdateOne) Mutation() *NotificationMutation {
 	return nuo.mutation
@@ -567,6 +653,12 @@ func (nuo *NotificationUpdateOne) ClearRelatedConversation() *NotificationUpdate
 	return nuo
 }
 
+// ClearRelatedMessage clears the "related_message" edge to the Message entity.
+func (nuo *NotificationUpdateOne) ClearRelatedMessage() *NotificationUpdateOne {
+	nuo.mutation.ClearRelatedMessage()
+	return nuo
+}
+
 // Where appends a list predicates to the NotificationUpdate builder.
 func (nuo *NotificationUpdateOne) Where(ps ...predicate.Notification) *NotificationUpdateOne {
 	nuo.mutation.Where(ps...)
@@ -778,6 +870,35 @@ func (nuo *NotificationUpdateOne) sqlSave(ctx context.Context) (_node *Notificat
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if nuo.mutation.RelatedMessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   notification.RelatedMessageTable,
+			Columns: []string{notification.RelatedMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.RelatedMessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   notification.RelatedMessageTable,
+			Columns: []string{notification.RelatedMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Notification{config: nuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
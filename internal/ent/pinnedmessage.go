@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/pinnedmessage"
+	"kakashi/chaos/internal/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// PinnedMessage is the model entity for the PinnedMessage schema.
+type PinnedMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// ConversationID holds the value of the "conversation_id" field.
+	ConversationID string `json:"conversation_id,omitempty"`
+	// MessageID holds the value of the "message_id" field.
+	MessageID string `json:"message_id,omitempty"`
+	// PinnedByID holds the value of the "pinned_by_id" field.
+	PinnedByID string `json:"pinned_by_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PinnedMessageQuery when eager-loading is set.
+	Edges        PinnedMessageEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PinnedMessageEdges holds the relations/edges for other nodes in the graph.
+type PinnedMessageEdges struct {
+	// Conversation holds the value of the conversation edge.
+	Conversation *Conversation `json:"conversation,omitempty"`
+	// Message holds the value of the message edge.
+	Message *Message `json:"message,omitempty"`
+	// PinnedBy holds the value of the pinned_by edge.
+	PinnedBy *User `json:"pinned_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// ConversationOrErr returns the Conversation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PinnedMessageEdges) ConversationOrErr() (*Conversation, error) {
+	if e.Conversation != nil {
+		return e.Conversation, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: conversation.Label}
+	}
+	return nil, &NotLoadedError{edge: "conversation"}
+}
+
+// MessageOrErr returns the Message value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PinnedMessageEdges) MessageOrErr() (*Message, error) {
+	if e.Message != nil {
+		return e.Message, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "message"}
+}
+
+// PinnedByOrErr returns the PinnedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PinnedMessageEdges) PinnedByOrErr() (*User, error) {
+	if e.PinnedBy != nil {
+		return e.PinnedBy, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "pinned_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PinnedMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pinnedmessage.FieldID, pinnedmessage.FieldConversationID, pinnedmessage.FieldMessageID, pinnedmessage.FieldPinnedByID:
+			values[i] = new(sql.NullString)
+		case pinnedmessage.FieldCreatedAt, pinnedmessage.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PinnedMessage fields.
+func (pm *PinnedMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pinnedmessage.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				pm.ID = value.String
+			}
+		case pinnedmessage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pm.CreatedAt = value.Time
+			}
+		case pinnedmessage.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pm.UpdatedAt = value.Time
+			}
+		case pinnedmessage.FieldConversationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field conversation_id", values[i])
+			} else if value.Valid {
+				pm.ConversationID = value.String
+			}
+		case pinnedmessage.FieldMessageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value.Valid {
+				pm.MessageID = value.String
+			}
+		case pinnedmessage.FieldPinnedByID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pinned_by_id", values[i])
+			} else if value.Valid {
+				pm.PinnedByID = value.String
+			}
+		default:
+			pm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PinnedMessage.
+// This includes values selected through modifiers, order, etc.
+func (pm *PinnedMessage) Value(name string) (ent.Value, error) {
+	return pm.selectValues.Get(name)
+}
+
+// QueryConversation queries the "conversation" edge of the PinnedMessage entity.
+func (pm *PinnedMessage) QueryConversation() *ConversationQuery {
+	return NewPinnedMessageClient(pm.config).QueryConversation(pm)
+}
+
+// QueryMessage queries the "message" edge of the PinnedMessage entity.
+func (pm *PinnedMessage) QueryMessage() *MessageQuery {
+	return NewPinnedMessageClient(pm.config).QueryMessage(pm)
+}
+
+// QueryPinnedBy queries the "pinned_by" edge of the PinnedMessage entity.
+func (pm *PinnedMessage) QueryPinnedBy() *UserQuery {
+	return NewPinnedMessageClient(pm.config).QueryPinnedBy(pm)
+}
+
+// Update returns a builder for updating this PinnedMessage.
+// Note that you need to call PinnedMessage.Unwrap() before calling this method if this PinnedMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pm *PinnedMessage) Update() *PinnedMessageUpdateOne {
+	return NewPinnedMessageClient(pm.config).UpdateOne(pm)
+}
+
+// Unwrap unwraps the PinnedMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pm *PinnedMessage) Unwrap() *PinnedMessage {
+	_tx, ok := pm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PinnedMessage is not a transactional entity")
+	}
+	pm.config.driver = _tx.drv
+	return pm
+}
+
+// String implements the fmt.Stringer.
+func (pm *PinnedMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("PinnedMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pm.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(pm.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pm.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("conversation_id=")
+	builder.WriteString(pm.ConversationID)
+	builder.WriteString(", ")
+	builder.WriteString("message_id=")
+	builder.WriteString(pm.MessageID)
+	builder.WriteString(", ")
+	builder.WriteString("pinned_by_id=")
+	builder.WriteString(pm.PinnedByID)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PinnedMessages is a parsable slice of PinnedMessage.
+type PinnedMessages []*PinnedMessage
@@ -0,0 +1,450 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pinnedmessage
+
+import (
+	"kakashi/chaos/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ConversationID applies equality check predicate on the "conversation_id" field. It's identical to ConversationIDEQ.
+func ConversationID(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldConversationID, v))
+}
+
+// MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
+func MessageID(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldMessageID, v))
+}
+
+// PinnedByID applies equality check predicate on the "pinned_by_id" field. It's identical to PinnedByIDEQ.
+func PinnedByID(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldPinnedByID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ConversationIDEQ applies the EQ predicate on the "conversation_id" field.
+func ConversationIDEQ(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldConversationID, v))
+}
+
+// ConversationIDNEQ applies the NEQ predicate on the "conversation_id" field.
+func ConversationIDNEQ(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNEQ(FieldConversationID, v))
+}
+
+// ConversationIDIn applies the In predicate on the "conversation_id" field.
+func ConversationIDIn(vs ...string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldIn(FieldConversationID, vs...))
+}
+
+// ConversationIDNotIn applies the NotIn predicate on the "conversation_id" field.
+func ConversationIDNotIn(vs ...string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNotIn(FieldConversationID, vs...))
+}
+
+// ConversationIDGT applies the GT predicate on the "conversation_id" field.
+func ConversationIDGT(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldGT(FieldConversationID, v))
+}
+
+// ConversationIDGTE applies the GTE predicate on the "conversation_id" field.
+func ConversationIDGTE(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldGTE(FieldConversationID, v))
+}
+
+// ConversationIDLT applies the LT predicate on the "conversation_id" field.
+func ConversationIDLT(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldLT(FieldConversationID, v))
+}
+
+// ConversationIDLTE applies the LTE predicate on the "conversation_id" field.
+func ConversationIDLTE(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldLTE(FieldConversationID, v))
+}
+
+// ConversationIDContains applies the Contains predicate on the "conversation_id" field.
+func ConversationIDContains(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldContains(FieldConversationID, v))
+}
+
+// ConversationIDHasPrefix applies the HasPrefix predicate on the "conversation_id" field.
+func ConversationIDHasPrefix(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldHasPrefix(FieldConversationID, v))
+}
+
+// ConversationIDHasSuffix applies the HasSuffix predicate on the "conversation_id" field.
+func ConversationIDHasSuffix(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldHasSuffix(FieldConversationID, v))
+}
+
+// ConversationIDEqualFold applies the EqualFold predicate on the "conversation_id" field.
+func ConversationIDEqualFold(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEqualFold(FieldConversationID, v))
+}
+
+// ConversationIDContainsFold applies the ContainsFold predicate on the "conversation_id" field.
+func ConversationIDContainsFold(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldContainsFold(FieldConversationID, v))
+}
+
+// MessageIDEQ applies the EQ predicate on the "message_id" field.
+func MessageIDEQ(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldMessageID, v))
+}
+
+// MessageIDNEQ applies the NEQ predicate on the "message_id" field.
+func MessageIDNEQ(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNEQ(FieldMessageID, v))
+}
+
+// MessageIDIn applies the In predicate on the "message_id" field.
+func MessageIDIn(vs ...string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldIn(FieldMessageID, vs...))
+}
+
+// MessageIDNotIn applies the NotIn predicate on the "message_id" field.
+func MessageIDNotIn(vs ...string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNotIn(FieldMessageID, vs...))
+}
+
+// MessageIDGT applies the GT predicate on the "message_id" field.
+func MessageIDGT(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldGT(FieldMessageID, v))
+}
+
+// MessageIDGTE applies the GTE predicate on the "message_id" field.
+func MessageIDGTE(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldGTE(FieldMessageID, v))
+}
+
+// MessageIDLT applies the LT predicate on the "message_id" field.
+func MessageIDLT(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldLT(FieldMessageID, v))
+}
+
+// MessageIDLTE applies the LTE predicate on the "message_id" field.
+func MessageIDLTE(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldLTE(FieldMessageID, v))
+}
+
+// MessageIDContains applies the Contains predicate on the "message_id" field.
+func MessageIDContains(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldContains(FieldMessageID, v))
+}
+
+// MessageIDHasPrefix applies the HasPrefix predicate on the "message_id" field.
+func MessageIDHasPrefix(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldHasPrefix(FieldMessageID, v))
+}
+
+// MessageIDHasSuffix applies the HasSuffix predicate on the "message_id" field.
+func MessageIDHasSuffix(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldHasSuffix(FieldMessageID, v))
+}
+
+// MessageIDEqualFold applies the EqualFold predicate on the "message_id" field.
+func MessageIDEqualFold(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEqualFold(FieldMessageID, v))
+}
+
+// MessageIDContainsFold applies the ContainsFold predicate on the "message_id" field.
+func MessageIDContainsFold(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldContainsFold(FieldMessageID, v))
+}
+
+// PinnedByIDEQ applies the EQ predicate on the "pinned_by_id" field.
+func PinnedByIDEQ(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldPinnedByID, v))
+}
+
+// PinnedByIDNEQ applies the NEQ predicate on the "pinned_by_id" field.
+func PinnedByIDNEQ(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNEQ(FieldPinnedByID, v))
+}
+
+// PinnedByIDIn applies the In predicate on the "pinned_by_id" field.
+func PinnedByIDIn(vs ...string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldIn(FieldPinnedByID, vs...))
+}
+
+// PinnedByIDNotIn applies the NotIn predicate on the "pinned_by_id" field.
+func PinnedByIDNotIn(vs ...string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNotIn(FieldPinnedByID, vs...))
+}
+
+// PinnedByIDGT applies the GT predicate on the "pinned_by_id" field.
+func PinnedByIDGT(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldGT(FieldPinnedByID, v))
+}
+
+// PinnedByIDGTE applies the GTE predicate on the "pinned_by_id" field.
+func PinnedByIDGTE(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldGTE(FieldPinnedByID, v))
+}
+
+// PinnedByIDLT applies the LT predicate on the "pinned_by_id" field.
+func PinnedByIDLT(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldLT(FieldPinnedByID, v))
+}
+
+// PinnedByIDLTE applies the LTE predicate on the "pinned_by_id" field.
+func PinnedByIDLTE(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldLTE(FieldPinnedByID, v))
+}
+
+// PinnedByIDContains applies the Contains predicate on the "pinned_by_id" field.
+func PinnedByIDContains(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldContains(FieldPinnedByID, v))
+}
+
+// PinnedByIDHasPrefix applies the HasPrefix predicate on the "pinned_by_id" field.
+func PinnedByIDHasPrefix(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldHasPrefix(FieldPinnedByID, v))
+}
+
+// PinnedByIDHasSuffix applies the HasSuffix predicate on the "pinned_by_id" field.
+func PinnedByIDHasSuffix(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldHasSuffix(FieldPinnedByID, v))
+}
+
+// PinnedByIDEqualFold applies the EqualFold predicate on the "pinned_by_id" field.
+func PinnedByIDEqualFold(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEqualFold(FieldPinnedByID, v))
+}
+
+// PinnedByIDContainsFold applies the ContainsFold predicate on the "pinned_by_id" field.
+func PinnedByIDContainsFold(v string) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldContainsFold(FieldPinnedByID, v))
+}
+
+// HasConversation applies the HasEdge predicate on the "conversation" edge.
+func HasConversation() predicate.PinnedMessage {
+	return predicate.PinnedMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ConversationTable, ConversationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConversationWith applies the HasEdge predicate on the "conversation" edge with a given conditions (other predicates).
+func HasConversationWith(preds ...predicate.Conversation) predicate.PinnedMessage {
+	return predicate.PinnedMessage(func(s *sql.Selector) {
+		step := newConversationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMessage applies the HasEdge predicate on the "message" edge.
+func HasMessage() predicate.PinnedMessage {
+	return predicate.PinnedMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, MessageTable, MessageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMessageWith applies the HasEdge predicate on the "message" edge with a given conditions (other predicates).
+func HasMessageWith(preds ...predicate.Message) predicate.PinnedMessage {
+	return predicate.PinnedMessage(func(s *sql.Selector) {
+		step := newMessageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPinnedBy applies the HasEdge predicate on the "pinned_by" edge.
+func HasPinnedBy() predicate.PinnedMessage {
+	return predicate.PinnedMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, PinnedByTable, PinnedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPinnedByWith applies the HasEdge predicate on the "pinned_by" edge with a given conditions (other predicates).
+func HasPinnedByWith(preds ...predicate.User) predicate.PinnedMessage {
+	return predicate.PinnedMessage(func(s *sql.Selector) {
+		step := newPinnedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PinnedMessage) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PinnedMessage) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PinnedMessage) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.NotPredicates(p))
+}
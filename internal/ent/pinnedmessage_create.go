@@ -0,0 +1,370 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/pinnedmessage"
+	"kakashi/chaos/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PinnedMessageCreate is the builder for creating a PinnedMessage entity.
+type PinnedMessageCreate struct {
+	config
+	mutation *PinnedMessageMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pmc *PinnedMessageCreate) SetCreatedAt(t time.Time) *PinnedMessageCreate {
+	pmc.mutation.SetCreatedAt(t)
+	return pmc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pmc *PinnedMessageCreate) SetNillableCreatedAt(t *time.Time) *PinnedMessageCreate {
+	if t != nil {
+		pmc.SetCreatedAt(*t)
+	}
+	return pmc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pmc *PinnedMessageCreate) SetUpdatedAt(t time.Time) *PinnedMessageCreate {
+	pmc.mutation.SetUpdatedAt(t)
+	return pmc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pmc *PinnedMessageCreate) SetNillableUpdatedAt(t *time.Time) *PinnedMessageCreate {
+	if t != nil {
+		pmc.SetUpdatedAt(*t)
+	}
+	return pmc
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (pmc *PinnedMessageCreate) SetConversationID(s string) *PinnedMessageCreate {
+	pmc.mutation.SetConversationID(s)
+	return pmc
+}
+
+// SetMessageID sets the "message_id" field.
+func (pmc *PinnedMessageCreate) SetMessageID(s string) *PinnedMessageCreate {
+	pmc.mutation.SetMessageID(s)
+	return pmc
+}
+
+// SetPinnedByID sets the "pinned_by_id" field.
+func (pmc *PinnedMessageCreate) SetPinnedByID(s string) *PinnedMessageCreate {
+	pmc.mutation.SetPinnedByID(s)
+	return pmc
+}
+
+// SetID sets the "id" field.
+func (pmc *PinnedMessageCreate) SetID(s string) *PinnedMessageCreate {
+	pmc.mutation.SetID(s)
+	return pmc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (pmc *PinnedMessageCreate) SetNillableID(s *string) *PinnedMessageCreate {
+	if s != nil {
+		pmc.SetID(*s)
+	}
+	return pmc
+}
+
+// SetConversation sets the "conversation" edge to the Conversation entity.
+func (pmc *PinnedMessageCreate) SetConversation(c *Conversation) *PinnedMessageCreate {
+	return pmc.SetConversationID(c.ID)
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (pmc *PinnedMessageCreate) SetMessage(m *Message) *PinnedMessageCreate {
+	return pmc.SetMessageID(m.ID)
+}
+
+// SetPinnedBy sets the "pinned_by" edge to the User entity.
+func (pmc *PinnedMessageCreate) SetPinnedBy(u *User) *PinnedMessageCreate {
+	return pmc.SetPinnedByID(u.ID)
+}
+
+// Mutation returns the PinnedMessageMutation object of the builder.
+func (pmc *PinnedMessageCreate) Mutation() *PinnedMessageMutation {
+	return pmc.mutation
+}
+
+// Save creates the PinnedMessage in the database.
+func (pmc *PinnedMessageCreate) Save(ctx context.Context) (*PinnedMessage, error) {
+	pmc.defaults()
+	return withHooks(ctx, pmc.sqlSave, pmc.mutation, pmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pmc *PinnedMessageCreate) SaveX(ctx context.Context) *PinnedMessage {
+	v, err := pmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pmc *PinnedMessageCreate) Exec(ctx context.Context) error {
+	_, err := pmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmc *PinnedMessageCreate) ExecX(ctx context.Context) {
+	if err := pmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pmc *PinnedMessageCreate) defaults() {
+	if _, ok := pmc.mutation.CreatedAt(); !ok {
+		v := pinnedmessage.DefaultCreatedAt()
+		pmc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pmc.mutation.UpdatedAt(); !ok {
+		v := pinnedmessage.DefaultUpdatedAt()
+		pmc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := pmc.mutation.ID(); !ok {
+		v := pinnedmessage.DefaultID()
+		pmc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pmc *PinnedMessageCreate) check() error {
+	if _, ok := pmc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PinnedMessage.created_at"`)}
+	}
+	if _, ok := pmc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PinnedMessage.updated_at"`)}
+	}
+	if _, ok := pmc.mutation.ConversationID(); !ok {
+		return &ValidationError{Name: "conversation_id", err: errors.New(`ent: missing required field "PinnedMessage.conversation_id"`)}
+	}
+	if v, ok := pmc.mutation.ConversationID(); ok {
+		if err := pinnedmessage.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "PinnedMessage.conversation_id": %w`, err)}
+		}
+	}
+	if _, ok := pmc.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "PinnedMessage.message_id"`)}
+	}
+	if v, ok := pmc.mutation.MessageID(); ok {
+		if err := pinnedmessage.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "PinnedMessage.message_id": %w`, err)}
+		}
+	}
+	if _, ok := pmc.mutation.PinnedByID(); !ok {
+		return &ValidationError{Name: "pinned_by_id", err: errors.New(`ent: missing required field "PinnedMessage.pinned_by_id"`)}
+	}
+	if v, ok := pmc.mutation.PinnedByID(); ok {
+		if err := pinnedmessage.PinnedByIDValidator(v); err != nil {
+			return &ValidationError{Name: "pinned_by_id", err: fmt.Errorf(`ent: validator failed for field "PinnedMessage.pinned_by_id": %w`, err)}
+		}
+	}
+	if len(pmc.mutation.ConversationIDs()) == 0 {
+		return &ValidationError{Name: "conversation", err: errors.New(`ent: missing required edge "PinnedMessage.conversation"`)}
+	}
+	if len(pmc.mutation.MessageIDs()) == 0 {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required edge "PinnedMessage.message"`)}
+	}
+	if len(pmc.mutation.PinnedByIDs()) == 0 {
+		return &ValidationError{Name: "pinned_by", err: errors.New(`ent: missing required edge "PinnedMessage.pinned_by"`)}
+	}
+	return nil
+}
+
+func (pmc *PinnedMessageCreate) sqlSave(ctx context.Context) (*PinnedMessage, error) {
+	if err := pmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected PinnedMessage.ID type: %T", _spec.ID.Value)
+		}
+	}
+	pmc.mutation.id = &_node.ID
+	pmc.mutation.done = true
+	return _node, nil
+}
+
+func (pmc *PinnedMessageCreate) createSpec() (*PinnedMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PinnedMessage{config: pmc.config}
+		_spec = sqlgraph.NewCreateSpec(pinnedmessage.Table, sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeString))
+	)
+	if id, ok := pmc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := pmc.mutation.CreatedAt(); ok {
+		_spec.SetField(pinnedmessage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pmc.mutation.UpdatedAt(); ok {
+		_spec.SetField(pinnedmessage.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := pmc.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   pinnedmessage.ConversationTable,
+			Columns: []string{pinnedmessage.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ConversationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pmc.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   pinnedmessage.MessageTable,
+			Columns: []string{pinnedmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pmc.mutation.PinnedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   pinnedmessage.PinnedByTable,
+			Columns: []string{pinnedmessage.PinnedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PinnedByID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PinnedMessageCreateBulk is the builder for creating many PinnedMessage entities in bulk.
+type PinnedMessageCreateBulk struct {
+	config
+	err      error
+	builders []*PinnedMessageCreate
+}
+
+// Save creates the PinnedMessage entities in the database.
+func (pmcb *PinnedMessageCreateBulk) Save(ctx context.Context) ([]*PinnedMessage, error) {
+	if pmcb.err != nil {
+		return nil, pmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pmcb.builders))
+	nodes := make([]*PinnedMessage, len(pmcb.builders))
+	mutators := make([]Mutator, len(pmcb.builders))
+	for i := range pmcb.builders {
+		func(i int, root context.Context) {
+			builder := pmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PinnedMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmcb *PinnedMessageCreateBulk) SaveX(ctx context.Context) []*PinnedMessage {
+	v, err := pmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pmcb *PinnedMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := pmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmcb *PinnedMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := pmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
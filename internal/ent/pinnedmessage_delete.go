@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"kakashi/chaos/internal/ent/pinnedmessage"
+	"kakashi/chaos/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PinnedMessageDelete is the builder for deleting a PinnedMessage entity.
+type PinnedMessageDelete struct {
+	config
+	hooks    []Hook
+	mutation *PinnedMessageMutation
+}
+
+// Where appends a list predicates to the PinnedMessageDelete builder.
+func (pmd *PinnedMessageDelete) Where(ps ...predicate.PinnedMessage) *PinnedMessageDelete {
+	pmd.mutation.Where(ps...)
+	return pmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pmd *PinnedMessageDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pmd.sqlExec, pmd.mutation, pmd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmd *PinnedMessageDelete) ExecX(ctx context.Context) int {
+	n, err := pmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pmd *PinnedMessageDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(pinnedmessage.Table, sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeString))
+	if ps := pmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pmd.mutation.done = true
+	return affected, err
+}
+
+// PinnedMessageDeleteOne is the builder for deleting a single PinnedMessage entity.
+type PinnedMessageDeleteOne struct {
+	pmd *PinnedMessageDelete
+}
+
+// Where appends a list predicates to the PinnedMessageDelete builder.
+func (pmdo *PinnedMessageDeleteOne) Where(ps ...predicate.PinnedMessage) *PinnedMessageDeleteOne {
+	pmdo.pmd.mutation.Where(ps...)
+	return pmdo
+}
+
+// Exec executes the deletion query.
+func (pmdo *PinnedMessageDeleteOne) Exec(ctx context.Context) error {
+	n, err := pmdo.pmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{pinnedmessage.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmdo *PinnedMessageDeleteOne) ExecX(ctx context.Context) {
+	if err := pmdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,637 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/pinnedmessage"
+	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PinnedMessageUpdate is the builder for updating PinnedMessage entities.
+type PinnedMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PinnedMessageMutation
+}
+
+// Where appends a list predicates to the PinnedMessageUpdate builder.
+func (pmu *PinnedMessageUpdate) Where(ps ...predicate.PinnedMessage) *PinnedMessageUpdate {
+	pmu.mutation.Where(ps...)
+	return pmu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pmu *PinnedMessageUpdate) SetCreatedAt(t time.Time) *PinnedMessageUpdate {
+	pmu.mutation.SetCreatedAt(t)
+	return pmu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pmu *PinnedMessageUpdate) SetNillableCreatedAt(t *time.Time) *PinnedMessageUpdate {
+	if t != nil {
+		pmu.SetCreatedAt(*t)
+	}
+	return pmu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pmu *PinnedMessageUpdate) SetUpdatedAt(t time.Time) *PinnedMessageUpdate {
+	pmu.mutation.SetUpdatedAt(t)
+	return pmu
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (pmu *PinnedMessageUpdate) SetConversationID(s string) *PinnedMessageUpdate {
+	pmu.mutation.SetConversationID(s)
+	return pmu
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (pmu *PinnedMessageUpdate) SetNillableConversationID(s *string) *PinnedMessageUpdate {
+	if s != nil {
+		pmu.SetConversationID(*s)
+	}
+	return pmu
+}
+
+// SetMessageID sets the "message_id" field.
+func (pmu *PinnedMessageUpdate) SetMessageID(s string) *PinnedMessageUpdate {
+	pmu.mutation.SetMessageID(s)
+	return pmu
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (pmu *PinnedMessageUpdate) SetNillableMessageID(s *string) *PinnedMessageUpdate {
+	if s != nil {
+		pmu.SetMessageID(*s)
+	}
+	return pmu
+}
+
+// SetPinnedByID sets the "pinned_by_id" field.
+func (pmu *PinnedMessageUpdate) SetPinnedByID(s string) *PinnedMessageUpdate {
+	pmu.mutation.SetPinnedByID(s)
+	return pmu
+}
+
+// SetNillablePinnedByID sets the "pinned_by_id" field if the given value is not nil.
+func (pmu *PinnedMessageUpdate) SetNillablePinnedByID(s *string) *PinnedMessageUpdate {
+	if s != nil {
+		pmu.SetPinnedByID(*s)
+	}
+	return pmu
+}
+
+// SetConversation sets the "conversation" edge to the Conversation entity.
+func (pmu *PinnedMessageUpdate) SetConversation(c *Conversation) *PinnedMessageUpdate {
+	return pmu.SetConversationID(c.ID)
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (pmu *PinnedMessageUpdate) SetMessage(m *Message) *PinnedMessageUpdate {
+	return pmu.SetMessageID(m.ID)
+}
+
+// SetPinnedBy sets the "pinned_by" edge to the User entity.
+func (pmu *PinnedMessageUpdate) SetPinnedBy(u *User) *PinnedMessageUpdate {
+	return pmu.SetPinnedByID(u.ID)
+}
+
+// Mutation returns the PinnedMessageMutation object of the builder.
+func (pmu *PinnedMessageUpdate) Mutation() *PinnedMessageMutation {
+	return pmu.mutation
+}
+
+// ClearConversation clears the "conversation" edge to the Conversation entity.
+func (pmu *PinnedMessageUpdate) ClearConversation() *PinnedMessageUpdate {
+	pmu.mutation.ClearConversation()
+	return pmu
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (pmu *PinnedMessageUpdate) ClearMessage() *PinnedMessageUpdate {
+	pmu.mutation.ClearMessage()
+	return pmu
+}
+
+// ClearPinnedBy clears the "pinned_by" edge to the User entity.
+func (pmu *PinnedMessageUpdate) ClearPinnedBy() *PinnedMessageUpdate {
+	pmu.mutation.ClearPinnedBy()
+	return pmu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pmu *PinnedMessageUpdate) Save(ctx context.Context) (int, error) {
+	pmu.defaults()
+	return withHooks(ctx, pmu.sqlSave, pmu.mutation, pmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmu *PinnedMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := pmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pmu *PinnedMessageUpdate) Exec(ctx context.Context) error {
+	_, err := pmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmu *PinnedMessageUpdate) ExecX(ctx context.Context) {
+	if err := pmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pmu *PinnedMessageUpdate) defaults() {
+	if _, ok := pmu.mutation.UpdatedAt(); !ok {
+		v := pinnedmessage.UpdateDefaultUpdatedAt()
+		pmu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pmu *PinnedMessageUpdate) check() error {
+	if v, ok := pmu.mutation.ConversationID(); ok {
+		if err := pinnedmessage.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "PinnedMessage.conversation_id": %w`, err)}
+		}
+	}
+	if v, ok := pmu.mutation.MessageID(); ok {
+		if err := pinnedmessage.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "PinnedMessage.message_id": %w`, err)}
+		}
+	}
+	if v, ok := pmu.mutation.PinnedByID(); ok {
+		if err := pinnedmessage.PinnedByIDValidator(v); err != nil {
+			return &ValidationError{Name: "pinned_by_id", err: fmt.Errorf(`ent: validator failed for field "PinnedMessage.pinned_by_id": %w`, err)}
+		}
+	}
+	if pmu.mutation.ConversationCleared() && len(pmu.mutation.ConversationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PinnedMessage.conversation"`)
+	}
+	if pmu.mutation.MessageCleared() && len(pmu.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PinnedMessage.message"`)
+	}
+	if pmu.mutation.PinnedByCleared() && len(pmu.mutation.PinnedByIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PinnedMessage.pinned_by"`)
+	}
+	return nil
+}
+
+func (pmu *PinnedMessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pinnedmessage.Table, pinnedmessage.Columns, sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeString))
+	if ps := pmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pmu.mutation.CreatedAt(); ok {
+		_spec.SetField(pinnedmessage.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := pmu.mutation.UpdatedAt(); ok {
+		_spec.SetField(pinnedmessage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pmu.mutation.ConversationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   pinnedmessage.ConversationTable,
+			Columns: []string{pinnedmessage.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pmu.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   pinnedmessage.ConversationTable,
+			Columns: []string{pinnedmessage.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pmu.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   pinnedmessage.MessageTable,
+			Columns: []string{pinnedmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pmu.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   pinnedmessage.MessageTable,
+			Columns: []string{pinnedmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pmu.mutation.PinnedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   pinnedmessage.PinnedByTable,
+			Columns: []string{pinnedmessage.PinnedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pmu.mutation.PinnedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   pinnedmessage.PinnedByTable,
+			Columns: []string{pinnedmessage.PinnedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pinnedmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pmu.mutation.done = true
+	return n, nil
+}
+
+// PinnedMessageUpdateOne is the builder for updating a single PinnedMessage entity.
+type PinnedMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PinnedMessageMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pmuo *PinnedMessageUpdateOne) SetCreatedAt(t time.Time) *PinnedMessageUpdateOne {
+	pmuo.mutation.SetCreatedAt(t)
+	return pmuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pmuo *PinnedMessageUpdateOne) SetNillableCreatedAt(t *time.Time) *PinnedMessageUpdateOne {
+	if t != nil {
+		pmuo.SetCreatedAt(*t)
+	}
+	return pmuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pmuo *PinnedMessageUpdateOne) SetUpdatedAt(t time.Time) *PinnedMessageUpdateOne {
+	pmuo.mutation.SetUpdatedAt(t)
+	return pmuo
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (pmuo *PinnedMessageUpdateOne) SetConversationID(s string) *PinnedMessageUpdateOne {
+	pmuo.mutation.SetConversationID(s)
+	return pmuo
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (pmuo *PinnedMessageUpdateOne) SetNillableConversationID(s *string) *PinnedMessageUpdateOne {
+	if s != nil {
+		pmuo.SetConversationID(*s)
+	}
+	return pmuo
+}
+
+// SetMessageID sets the "message_id" field.
+func (pmuo *PinnedMessageUpdateOne) SetMessageID(s string) *PinnedMessageUpdateOne {
+	pmuo.mutation.SetMessageID(s)
+	return pmuo
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (pmuo *PinnedMessageUpdateOne) SetNillableMessageID(s *string) *PinnedMessageUpdateOne {
+	if s != nil {
+		pmuo.SetMessageID(*s)
+	}
+	return pmuo
+}
+
+// SetPinnedByID sets the "pinned_by_id" field.
+func (pmuo *PinnedMessageUpdateOne) SetPinnedByID(s string) *PinnedMessageUpdateOne {
+	pmuo.mutation.SetPinnedByID(s)
+	return pmuo
+}
+
+// SetNillablePinnedByID sets the "pinned_by_id" field if the given value is not nil.
+func (pmuo *PinnedMessageUpdateOne) SetNillablePinnedByID(s *string) *PinnedMessageUpdateOne {
+	if s != nil {
+		pmuo.SetPinnedByID(*s)
+	}
+	return pmuo
+}
+
+// SetConversation sets the "conversation" edge to the Conversation entity.
+func (pmuo *PinnedMessageUpdateOne) SetConversation(c *Conversation) *PinnedMessageUpdateOne {
+	return pmuo.SetConversationID(c.ID)
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (pmuo *PinnedMessageUpdateOne) SetMessage(m *Message) *PinnedMessageUpdateOne {
+	return pmuo.SetMessageID(m.ID)
+}
+
+// SetPinnedBy sets the "pinned_by" edge to the User entity.
+func (pmuo *PinnedMessageUpdateOne) SetPinnedBy(u *User) *PinnedMessageUpdateOne {
+	return pmuo.SetPinnedByID(u.ID)
+}
+
+// Mutation returns the PinnedMessageMutation object of the builder.
+func (pmuo *PinnedMessageUpdateOne) Mutation() *PinnedMessageMutation {
+	return pmuo.mutation
+}
+
+// ClearConversation clears the "conversation" edge to the Conversation entity.
+func (pmuo *PinnedMessageUpdateOne) ClearConversation() *PinnedMessageUpdateOne {
+	pmuo.mutation.ClearConversation()
+	return pmuo
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (pmuo *PinnedMessageUpdateOne) ClearMessage() *PinnedMessageUpdateOne {
+	pmuo.mutation.ClearMessage()
+	return pmuo
+}
+
+// ClearPinnedBy clears the "pinned_by" edge to the User entity.
+func (pmuo *PinnedMessageUpdateOne) ClearPinnedBy() *PinnedMessageUpdateOne {
+	pmuo.mutation.ClearPinnedBy()
+	return pmuo
+}
+
+// Where appends a list predicates to the PinnedMessageUpdate builder.
+func (pmuo *PinnedMessageUpdateOne) Where(ps ...predicate.PinnedMessage) *PinnedMessageUpdateOne {
+	pmuo.mutation.Where(ps...)
+	return pmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pmuo *PinnedMessageUpdateOne) Select(field string, fields ...string) *PinnedMessageUpdateOne {
+	pmuo.fields = append([]string{field}, fields...)
+	return pmuo
+}
+
+// Save executes the query and returns the updated PinnedMessage entity.
+func (pmuo *PinnedMessageUpdateOne) Save(ctx context.Context) (*PinnedMessage, error) {
+	pmuo.defaults()
+	return withHooks(ctx, pmuo.sqlSave, pmuo.mutation, pmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmuo *PinnedMessageUpdateOne) SaveX(ctx context.Context) *PinnedMessage {
+	node, err := pmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pmuo *PinnedMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := pmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmuo *PinnedMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := pmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pmuo *PinnedMessageUpdateOne) defaults() {
+	if _, ok := pmuo.mutation.UpdatedAt(); !ok {
+		v := pinnedmessage.UpdateDefaultUpdatedAt()
+		pmuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pmuo *PinnedMessageUpdateOne) check() error {
+	if v, ok := pmuo.mutation.ConversationID(); ok {
+		if err := pinnedmessage.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "PinnedMessage.conversation_id": %w`, err)}
+		}
+	}
+	if v, ok := pmuo.mutation.MessageID(); ok {
+		if err := pinnedmessage.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "PinnedMessage.message_id": %w`, err)}
+		}
+	}
+	if v, ok := pmuo.mutation.PinnedByID(); ok {
+		if err := pinnedmessage.PinnedByIDValidator(v); err != nil {
+			return &ValidationError{Name: "pinned_by_id", err: fmt.Errorf(`ent: validator failed for field "PinnedMessage.pinned_by_id": %w`, err)}
+		}
+	}
+	if pmuo.mutation.ConversationCleared() && len(pmuo.mutation.ConversationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PinnedMessage.conversation"`)
+	}
+	if pmuo.mutation.MessageCleared() && len(pmuo.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PinnedMessage.message"`)
+	}
+	if pmuo.mutation.PinnedByCleared() && len(pmuo.mutation.PinnedByIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PinnedMessage.pinned_by"`)
+	}
+	return nil
+}
+
+func (pmuo *PinnedMessageUpdateOne) sqlSave(ctx context.Context) (_node *PinnedMessage, err error) {
+	if err := pmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pinnedmessage.Table, pinnedmessage.Columns, sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeString))
+	id, ok := pmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PinnedMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pinnedmessage.FieldID)
+		for _, f := range fields {
+			if !pinnedmessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pinnedmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pmuo.mutation.CreatedAt(); ok {
+		_spec.SetField(pinnedmessage.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := pmuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(pinnedmessage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pmuo.mutation.ConversationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   pinnedmessage.ConversationTable,
+			Columns: []string{pinnedmessage.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pmuo.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   pinnedmessage.ConversationTable,
+			Columns: []string{pinnedmessage.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pmuo.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   pinnedmessage.MessageTable,
+			Columns: []string{pinnedmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pmuo.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   pinnedmessage.MessageTable,
+			Columns: []string{pinnedmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pmuo.mutation.PinnedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   pinnedmessage.PinnedByTable,
+			Columns: []string{pinnedmessage.PinnedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pmuo.mutation.PinnedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   pinnedmessage.PinnedByTable,
+			Columns: []string{pinnedmessage.PinnedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PinnedMessage{config: pmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pinnedmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pmuo.mutation.done = true
+	return _node, nil
+}
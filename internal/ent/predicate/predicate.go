@@ -6,38 +6,86 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Attachment is the predicate function for attachment builders.
+type Attachment func(*sql.Selector)
+
 // Block is the predicate function for block builders.
 type Block func(*sql.Selector)
 
+// Bookmark is the predicate function for bookmark builders.
+type Bookmark func(*sql.Selector)
+
 // Call is the predicate function for call builders.
 type Call func(*sql.Selector)
 
 // Conversation is the predicate function for conversation builders.
 type Conversation func(*sql.Selector)
 
+// ConversationFolder is the predicate function for conversationfolder builders.
+type ConversationFolder func(*sql.Selector)
+
 // ConversationParticipant is the predicate function for conversationparticipant builders.
 type ConversationParticipant func(*sql.Selector)
 
+// Draft is the predicate function for draft builders.
+type Draft func(*sql.Selector)
+
+// FolderConversation is the predicate function for folderconversation builders.
+type FolderConversation func(*sql.Selector)
+
 // Friend is the predicate function for friend builders.
 type Friend func(*sql.Selector)
 
+// FriendInvite is the predicate function for friendinvite builders.
+type FriendInvite func(*sql.Selector)
+
+// FriendInviteUse is the predicate function for friendinviteuse builders.
+type FriendInviteUse func(*sql.Selector)
+
 // Guild is the predicate function for guild builders.
 type Guild func(*sql.Selector)
 
+// HiddenMessage is the predicate function for hiddenmessage builders.
+type HiddenMessage func(*sql.Selector)
+
 // Invitation is the predicate function for invitation builders.
 type Invitation func(*sql.Selector)
 
+// LinkPreview is the predicate function for linkpreview builders.
+type LinkPreview func(*sql.Selector)
+
 // Member is the predicate function for member builders.
 type Member func(*sql.Selector)
 
 // Message is the predicate function for message builders.
 type Message func(*sql.Selector)
 
+// MessageMention is the predicate function for messagemention builders.
+type MessageMention func(*sql.Selector)
+
+// MessageReaction is the predicate function for messagereaction builders.
+type MessageReaction func(*sql.Selector)
+
+// MessageReceipt is the predicate function for messagereceipt builders.
+type MessageReceipt func(*sql.Selector)
+
+// MessageRevision is the predicate function for messagerevision builders.
+type MessageRevision func(*sql.Selector)
+
 // Notification is the predicate function for notification builders.
 type Notification func(*sql.Selector)
 
+// PinnedMessage is the predicate function for pinnedmessage builders.
+type PinnedMessage func(*sql.Selector)
+
+// ScheduledMessage is the predicate function for scheduledmessage builders.
+type ScheduledMessage func(*sql.Selector)
+
 // Session is the predicate function for session builders.
 type Session func(*sql.Selector)
 
+// ThreadParticipant is the predicate function for threadparticipant builders.
+type ThreadParticipant func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
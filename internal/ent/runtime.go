@@ -3,18 +3,34 @@
 package ent
 
 import (
+	"kakashi/chaos/internal/ent/attachment"
 	"kakashi/chaos/internal/ent/block"
+	"kakashi/chaos/internal/ent/bookmark"
 	"kakashi/chaos/internal/ent/call"
 	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/conversationfolder"
 	"kakashi/chaos/internal/ent/conversationparticipant"
+	"kakashi/chaos/internal/ent/draft"
+	"kakashi/chaos/internal/ent/folderconversation"
 	"kakashi/chaos/internal/ent/friend"
+	"kakashi/chaos/internal/ent/friendinvite"
+	"kakashi/chaos/internal/ent/friendinviteuse"
 	"kakashi/chaos/internal/ent/guild"
+	"kakashi/chaos/internal/ent/hiddenmessage"
 	"kakashi/chaos/internal/ent/invitation"
+	"kakashi/chaos/internal/ent/linkpreview"
 	"kakashi/chaos/internal/ent/member"
 	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/messagemention"
+	"kakashi/chaos/internal/ent/messagereaction"
+	"kakashi/chaos/internal/ent/messagereceipt"
+	"kakashi/chaos/internal/ent/messagerevision"
 	"kakashi/chaos/internal/ent/notification"
+	"kakashi/chaos/internal/ent/pinnedmessage"
+	"kakashi/chaos/internal/ent/scheduledmessage"
 	"kakashi/chaos/internal/ent/schema"
 	"kakashi/chaos/internal/ent/session"
+	"kakashi/chaos/internal/ent/threadparticipant"
 	"kakashi/chaos/internal/ent/user"
 	"time"
 )
@@ -23,6 +39,63 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	attachmentMixin := schema.Attachment{}.Mixin()
+	attachmentMixinFields0 := attachmentMixin[0].Fields()
+	_ = attachmentMixinFields0
+	attachmentFields := schema.Attachment{}.Fields()
+	_ = attachmentFields
+	// attachmentDescCreatedAt is the schema descriptor for created_at field.
+	attachmentDescCreatedAt := attachmentMixinFields0[1].Descriptor()
+	// attachment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	attachment.DefaultCreatedAt = attachmentDescCreatedAt.Default.(func() time.Time)
+	// attachmentDescUpdatedAt is the schema descriptor for updated_at field.
+	attachmentDescUpdatedAt := attachmentMixinFields0[2].Descriptor()
+	// attachment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	attachment.DefaultUpdatedAt = attachmentDescUpdatedAt.Default.(func() time.Time)
+	// attachment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	attachment.UpdateDefaultUpdatedAt = attachmentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// attachmentDescMessageID is the schema descriptor for message_id field.
+	attachmentDescMessageID := attachmentFields[0].Descriptor()
+	// attachment.MessageIDValidator is a validator for the "message_id" field. It is called by the builders before save.
+	attachment.MessageIDValidator = attachmentDescMessageID.Validators[0].(func(string) error)
+	// attachmentDescUploaderID is the schema descriptor for uploader_id field.
+	attachmentDescUploaderID := attachmentFields[1].Descriptor()
+	// attachment.UploaderIDValidator is a validator for the "uploader_id" field. It is called by the builders before save.
+	attachment.UploaderIDValidator = attachmentDescUploaderID.Validators[0].(func(string) error)
+	// attachmentDescStorageKey is the schema descriptor for storage_key field.
+	attachmentDescStorageKey := attachmentFields[2].Descriptor()
+	// attachment.StorageKeyValidator is a validator for the "storage_key" field. It is called by the builders before save.
+	attachment.StorageKeyValidator = attachmentDescStorageKey.Validators[0].(func(string) error)
+	// attachmentDescFilename is the schema descriptor for filename field.
+	attachmentDescFilename := attachmentFields[3].Descriptor()
+	// attachment.FilenameValidator is a validator for the "filename" field. It is called by the builders before save.
+	attachment.FilenameValidator = func() func(string) error {
+		validators := attachmentDescFilename.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(filename string) error {
+			for _, fn := range fns {
+				if err := fn(filename); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// attachmentDescContentType is the schema descriptor for content_type field.
+	attachmentDescContentType := attachmentFields[4].Descriptor()
+	// attachment.ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	attachment.ContentTypeValidator = attachmentDescContentType.Validators[0].(func(string) error)
+	// attachmentDescSize is the schema descriptor for size field.
+	attachmentDescSize := attachmentFields[5].Descriptor()
+	// attachment.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	attachment.SizeValidator = attachmentDescSize.Validators[0].(func(int64) error)
+	// attachmentDescID is the schema descriptor for id field.
+	attachmentDescID := attachmentMixinFields0[0].Descriptor()
+	// attachment.DefaultID holds the default value on creation for the id field.
+	attachment.DefaultID = attachmentDescID.Default.(func() string)
 	blockMixin := schema.Block{}.Mixin()
 	blockMixinFields0 := blockMixin[0].Fields()
 	_ = blockMixinFields0
@@ -50,6 +123,29 @@ func init() {
 	blockDescID := blockMixinFields0[0].Descriptor()
 	// block.DefaultID holds the default value on creation for the id field.
 	block.DefaultID = blockDescID.Default.(func() string)
+	bookmarkMixin := schema.Bookmark{}.Mixin()
+	bookmarkMixinFields0 := bookmarkMixin[0].Fields()
+	_ = bookmarkMixinFields0
+	bookmarkFields := schema.Bookmark{}.Fields()
+	_ = bookmarkFields
+	// bookmarkDescCreatedAt is the schema descriptor for created_at field.
+	bookmarkDescCreatedAt := bookmarkMixinFields0[1].Descriptor()
+	// bookmark.DefaultCreatedAt holds the default value on creation for the created_at field.
+	bookmark.DefaultCreatedAt = bookmarkDescCreatedAt.Default.(func() time.Time)
+	// bookmarkDescUpdatedAt is the schema descriptor for updated_at field.
+	bookmarkDescUpdatedAt := bookmarkMixinFields0[2].Descriptor()
+	// bookmark.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	bookmark.DefaultUpdatedAt = bookmarkDescUpdatedAt.Default.(func() time.Time)
+	// bookmark.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	bookmark.UpdateDefaultUpdatedAt = bookmarkDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// bookmarkDescUserID is the schema descriptor for user_id field.
+	bookmarkDescUserID := bookmarkFields[0].Descriptor()
+	// bookmark.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	bookmark.UserIDValidator = bookmarkDescUserID.Validators[0].(func(string) error)
+	// bookmarkDescID is the schema descriptor for id field.
+	bookmarkDescID := bookmarkMixinFields0[0].Descriptor()
+	// bookmark.DefaultID holds the default value on creation for the id field.
+	bookmark.DefaultID = bookmarkDescID.Default.(func() string)
 	callMixin := schema.Call{}.Mixin()
 	callMixinFields0 := callMixin[0].Fields()
 	_ = callMixinFields0
@@ -96,18 +192,71 @@ func init() {
 	conversationDescName := conversationFields[1].Descriptor()
 	// conversation.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	conversation.NameValidator = conversationDescName.Validators[0].(func(string) error)
+	// conversationDescMessageTTL is the schema descriptor for message_ttl field.
+	conversationDescMessageTTL := conversationFields[5].Descriptor()
+	// conversation.DefaultMessageTTL holds the default value on creation for the message_ttl field.
+	conversation.DefaultMessageTTL = conversationDescMessageTTL.Default.(int)
+	// conversation.MessageTTLValidator is a validator for the "message_ttl" field. It is called by the builders before save.
+	conversation.MessageTTLValidator = conversationDescMessageTTL.Validators[0].(func(int) error)
 	// conversationDescIsArchived is the schema descriptor for is_archived field.
-	conversationDescIsArchived := conversationFields[3].Descriptor()
+	conversationDescIsArchived := conversationFields[7].Descriptor()
 	// conversation.DefaultIsArchived holds the default value on creation for the is_archived field.
 	conversation.DefaultIsArchived = conversationDescIsArchived.Default.(bool)
 	// conversationDescIsMuted is the schema descriptor for is_muted field.
-	conversationDescIsMuted := conversationFields[4].Descriptor()
+	conversationDescIsMuted := conversationFields[8].Descriptor()
 	// conversation.DefaultIsMuted holds the default value on creation for the is_muted field.
 	conversation.DefaultIsMuted = conversationDescIsMuted.Default.(bool)
 	// conversationDescID is the schema descriptor for id field.
 	conversationDescID := conversationMixinFields0[0].Descriptor()
 	// conversation.DefaultID holds the default value on creation for the id field.
 	conversation.DefaultID = conversationDescID.Default.(func() string)
+	conversationfolderMixin := schema.ConversationFolder{}.Mixin()
+	conversationfolderMixinFields0 := conversationfolderMixin[0].Fields()
+	_ = conversationfolderMixinFields0
+	conversationfolderFields := schema.ConversationFolder{}.Fields()
+	_ = conversationfolderFields
+	// conversationfolderDescCreatedAt is the schema descriptor for created_at field.
+	conversationfolderDescCreatedAt := conversationfolderMixinFields0[1].Descriptor()
+	// conversationfolder.DefaultCreatedAt holds the default value on creation for the created_at field.
+	conversationfolder.DefaultCreatedAt = conversationfolderDescCreatedAt.Default.(func() time.Time)
+	// conversationfolderDescUpdatedAt is the schema descriptor for updated_at field.
+	conversationfolderDescUpdatedAt := conversationfolderMixinFields0[2].Descriptor()
+	// conversationfolder.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	conversationfolder.DefaultUpdatedAt = conversationfolderDescUpdatedAt.Default.(func() time.Time)
+	// conversationfolder.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	conversationfolder.UpdateDefaultUpdatedAt = conversationfolderDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// conversationfolderDescUserID is the schema descriptor for user_id field.
+	conversationfolderDescUserID := conversationfolderFields[0].Descriptor()
+	// conversationfolder.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	conversationfolder.UserIDValidator = conversationfolderDescUserID.Validators[0].(func(string) error)
+	// conversationfolderDescName is the schema descriptor for name field.
+	conversationfolderDescName := conversationfolderFields[1].Descriptor()
+	// conversationfolder.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	conversationfolder.NameValidator = func() func(string) error {
+		validators := conversationfolderDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// conversationfolderDescPosition is the schema descriptor for position field.
+	conversationfolderDescPosition := conversationfolderFields[2].Descriptor()
+	// conversationfolder.DefaultPosition holds the default value on creation for the position field.
+	conversationfolder.DefaultPosition = conversationfolderDescPosition.Default.(int)
+	// conversationfolder.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	conversationfolder.PositionValidator = conversationfolderDescPosition.Validators[0].(func(int) error)
+	// conversationfolderDescID is the schema descriptor for id field.
+	conversationfolderDescID := conversationfolderMixinFields0[0].Descriptor()
+	// conversationfolder.DefaultID holds the default value on creation for the id field.
+	conversationfolder.DefaultID = conversationfolderDescID.Default.(func() string)
 	conversationparticipantMixin := schema.ConversationParticipant{}.Mixin()
 	conversationparticipantMixinFields0 := conversationparticipantMixin[0].Fields()
 	_ = conversationparticipantMixinFields0
@@ -132,21 +281,81 @@ func init() {
 	// conversationparticipant.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
 	conversationparticipant.UserIDValidator = conversationparticipantDescUserID.Validators[0].(func(string) error)
 	// conversationparticipantDescJoinedAt is the schema descriptor for joined_at field.
-	conversationparticipantDescJoinedAt := conversationparticipantFields[2].Descriptor()
+	conversationparticipantDescJoinedAt := conversationparticipantFields[3].Descriptor()
 	// conversationparticipant.DefaultJoinedAt holds the default value on creation for the joined_at field.
 	conversationparticipant.DefaultJoinedAt = conversationparticipantDescJoinedAt.Default.(func() time.Time)
 	// conversationparticipantDescIsArchived is the schema descriptor for is_archived field.
-	conversationparticipantDescIsArchived := conversationparticipantFields[4].Descriptor()
+	conversationparticipantDescIsArchived := conversationparticipantFields[5].Descriptor()
 	// conversationparticipant.DefaultIsArchived holds the default value on creation for the is_archived field.
 	conversationparticipant.DefaultIsArchived = conversationparticipantDescIsArchived.Default.(bool)
-	// conversationparticipantDescIsMuted is the schema descriptor for is_muted field.
-	conversationparticipantDescIsMuted := conversationparticipantFields[5].Descriptor()
-	// conversationparticipant.DefaultIsMuted holds the default value on creation for the is_muted field.
-	conversationparticipant.DefaultIsMuted = conversationparticipantDescIsMuted.Default.(bool)
+	// conversationparticipantDescShowWhenMuted is the schema descriptor for show_when_muted field.
+	conversationparticipantDescShowWhenMuted := conversationparticipantFields[8].Descriptor()
+	// conversationparticipant.DefaultShowWhenMuted holds the default value on creation for the show_when_muted field.
+	conversationparticipant.DefaultShowWhenMuted = conversationparticipantDescShowWhenMuted.Default.(bool)
+	// conversationparticipantDescPinPosition is the schema descriptor for pin_position field.
+	conversationparticipantDescPinPosition := conversationparticipantFields[10].Descriptor()
+	// conversationparticipant.DefaultPinPosition holds the default value on creation for the pin_position field.
+	conversationparticipant.DefaultPinPosition = conversationparticipantDescPinPosition.Default.(int)
+	// conversationparticipant.PinPositionValidator is a validator for the "pin_position" field. It is called by the builders before save.
+	conversationparticipant.PinPositionValidator = conversationparticipantDescPinPosition.Validators[0].(func(int) error)
 	// conversationparticipantDescID is the schema descriptor for id field.
 	conversationparticipantDescID := conversationparticipantMixinFields0[0].Descriptor()
 	// conversationparticipant.DefaultID holds the default value on creation for the id field.
 	conversationparticipant.DefaultID = conversationparticipantDescID.Default.(func() string)
+	draftMixin := schema.Draft{}.Mixin()
+	draftMixinFields0 := draftMixin[0].Fields()
+	_ = draftMixinFields0
+	draftFields := schema.Draft{}.Fields()
+	_ = draftFields
+	// draftDescCreatedAt is the schema descriptor for created_at field.
+	draftDescCreatedAt := draftMixinFields0[1].Descriptor()
+	// draft.DefaultCreatedAt holds the default value on creation for the created_at field.
+	draft.DefaultCreatedAt = draftDescCreatedAt.Default.(func() time.Time)
+	// draftDescUpdatedAt is the schema descriptor for updated_at field.
+	draftDescUpdatedAt := draftMixinFields0[2].Descriptor()
+	// draft.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	draft.DefaultUpdatedAt = draftDescUpdatedAt.Default.(func() time.Time)
+	// draft.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	draft.UpdateDefaultUpdatedAt = draftDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// draftDescUserID is the schema descriptor for user_id field.
+	draftDescUserID := draftFields[0].Descriptor()
+	// draft.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	draft.UserIDValidator = draftDescUserID.Validators[0].(func(string) error)
+	// draftDescConversationID is the schema descriptor for conversation_id field.
+	draftDescConversationID := draftFields[1].Descriptor()
+	// draft.ConversationIDValidator is a validator for the "conversation_id" field. It is called by the builders before save.
+	draft.ConversationIDValidator = draftDescConversationID.Validators[0].(func(string) error)
+	// draftDescID is the schema descriptor for id field.
+	draftDescID := draftMixinFields0[0].Descriptor()
+	// draft.DefaultID holds the default value on creation for the id field.
+	draft.DefaultID = draftDescID.Default.(func() string)
+	folderconversationMixin := schema.FolderConversation{}.Mixin()
+	folderconversationMixinFields0 := folderconversationMixin[0].Fields()
+	_ = folderconversationMixinFields0
+	folderconversationFields := schema.FolderConversation{}.Fields()
+	_ = folderconversationFields
+	// folderconversationDescCreatedAt is the schema descriptor for created_at field.
+	folderconversationDescCreatedAt := folderconversationMixinFields0[1].Descriptor()
+	// folderconversation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	folderconversation.DefaultCreatedAt = folderconversationDescCreatedAt.Default.(func() time.Time)
+	// folderconversationDescUpdatedAt is the schema descriptor for updated_at field.
+	folderconversationDescUpdatedAt := folderconversationMixinFields0[2].Descriptor()
+	// folderconversation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	folderconversation.DefaultUpdatedAt = folderconversationDescUpdatedAt.Default.(func() time.Time)
+	// folderconversation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	folderconversation.UpdateDefaultUpdatedAt = folderconversationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// folderconversationDescFolderID is the schema descriptor for folder_id field.
+	folderconversationDescFolderID := folderconversationFields[0].Descriptor()
+	// folderconversation.FolderIDValidator is a validator for the "folder_id" field. It is called by the builders before save.
+	folderconversation.FolderIDValidator = folderconversationDescFolderID.Validators[0].(func(string) error)
+	// folderconversationDescConversationID is the schema descriptor for conversation_id field.
+	folderconversationDescConversationID := folderconversationFields[1].Descriptor()
+	// folderconversation.ConversationIDValidator is a validator for the "conversation_id" field. It is called by the builders before save.
+	folderconversation.ConversationIDValidator = folderconversationDescConversationID.Validators[0].(func(string) error)
+	// folderconversationDescID is the schema descriptor for id field.
+	folderconversationDescID := folderconversationMixinFields0[0].Descriptor()
+	// folderconversation.DefaultID holds the default value on creation for the id field.
+	folderconversation.DefaultID = folderconversationDescID.Default.(func() string)
 	friendMixin := schema.Friend{}.Mixin()
 	friendMixinFields0 := friendMixin[0].Fields()
 	_ = friendMixinFields0
@@ -174,6 +383,76 @@ func init() {
 	friendDescID := friendMixinFields0[0].Descriptor()
 	// friend.DefaultID holds the default value on creation for the id field.
 	friend.DefaultID = friendDescID.Default.(func() string)
+	friendinviteMixin := schema.FriendInvite{}.Mixin()
+	friendinviteMixinFields0 := friendinviteMixin[0].Fields()
+	_ = friendinviteMixinFields0
+	friendinviteFields := schema.FriendInvite{}.Fields()
+	_ = friendinviteFields
+	// friendinviteDescCreatedAt is the schema descriptor for created_at field.
+	friendinviteDescCreatedAt := friendinviteMixinFields0[1].Descriptor()
+	// friendinvite.DefaultCreatedAt holds the default value on creation for the created_at field.
+	friendinvite.DefaultCreatedAt = friendinviteDescCreatedAt.Default.(func() time.Time)
+	// friendinviteDescUpdatedAt is the schema descriptor for updated_at field.
+	friendinviteDescUpdatedAt := friendinviteMixinFields0[2].Descriptor()
+	// friendinvite.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	friendinvite.DefaultUpdatedAt = friendinviteDescUpdatedAt.Default.(func() time.Time)
+	// friendinvite.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	friendinvite.UpdateDefaultUpdatedAt = friendinviteDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// friendinviteDescOwnerID is the schema descriptor for owner_id field.
+	friendinviteDescOwnerID := friendinviteFields[0].Descriptor()
+	// friendinvite.OwnerIDValidator is a validator for the "owner_id" field. It is called by the builders before save.
+	friendinvite.OwnerIDValidator = friendinviteDescOwnerID.Validators[0].(func(string) error)
+	// friendinviteDescCode is the schema descriptor for code field.
+	friendinviteDescCode := friendinviteFields[1].Descriptor()
+	// friendinvite.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	friendinvite.CodeValidator = friendinviteDescCode.Validators[0].(func(string) error)
+	// friendinviteDescAutoAccept is the schema descriptor for auto_accept field.
+	friendinviteDescAutoAccept := friendinviteFields[2].Descriptor()
+	// friendinvite.DefaultAutoAccept holds the default value on creation for the auto_accept field.
+	friendinvite.DefaultAutoAccept = friendinviteDescAutoAccept.Default.(bool)
+	// friendinviteDescMaxUses is the schema descriptor for max_uses field.
+	friendinviteDescMaxUses := friendinviteFields[3].Descriptor()
+	// friendinvite.DefaultMaxUses holds the default value on creation for the max_uses field.
+	friendinvite.DefaultMaxUses = friendinviteDescMaxUses.Default.(int)
+	// friendinvite.MaxUsesValidator is a validator for the "max_uses" field. It is called by the builders before save.
+	friendinvite.MaxUsesValidator = friendinviteDescMaxUses.Validators[0].(func(int) error)
+	// friendinviteDescUses is the schema descriptor for uses field.
+	friendinviteDescUses := friendinviteFields[4].Descriptor()
+	// friendinvite.DefaultUses holds the default value on creation for the uses field.
+	friendinvite.DefaultUses = friendinviteDescUses.Default.(int)
+	// friendinvite.UsesValidator is a validator for the "uses" field. It is called by the builders before save.
+	friendinvite.UsesValidator = friendinviteDescUses.Validators[0].(func(int) error)
+	// friendinviteDescID is the schema descriptor for id field.
+	friendinviteDescID := friendinviteMixinFields0[0].Descriptor()
+	// friendinvite.DefaultID holds the default value on creation for the id field.
+	friendinvite.DefaultID = friendinviteDescID.Default.(func() string)
+	friendinviteuseMixin := schema.FriendInviteUse{}.Mixin()
+	friendinviteuseMixinFields0 := friendinviteuseMixin[0].Fields()
+	_ = friendinviteuseMixinFields0
+	friendinviteuseFields := schema.FriendInviteUse{}.Fields()
+	_ = friendinviteuseFields
+	// friendinviteuseDescCreatedAt is the schema descriptor for created_at field.
+	friendinviteuseDescCreatedAt := friendinviteuseMixinFields0[1].Descriptor()
+	// friendinviteuse.DefaultCreatedAt holds the default value on creation for the created_at field.
+	friendinviteuse.DefaultCreatedAt = friendinviteuseDescCreatedAt.Default.(func() time.Time)
+	// friendinviteuseDescUpdatedAt is the schema descriptor for updated_at field.
+	friendinviteuseDescUpdatedAt := friendinviteuseMixinFields0[2].Descriptor()
+	// friendinviteuse.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	friendinviteuse.DefaultUpdatedAt = friendinviteuseDescUpdatedAt.Default.(func() time.Time)
+	// friendinviteuse.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	friendinviteuse.UpdateDefaultUpdatedAt = friendinviteuseDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// friendinviteuseDescInviteID is the schema descriptor for invite_id field.
+	friendinviteuseDescInviteID := friendinviteuseFields[0].Descriptor()
+	// friendinviteuse.InviteIDValidator is a validator for the "invite_id" field. It is called by the builders before save.
+	friendinviteuse.InviteIDValidator = friendinviteuseDescInviteID.Validators[0].(func(string) error)
+	// friendinviteuseDescUserID is the schema descriptor for user_id field.
+	friendinviteuseDescUserID := friendinviteuseFields[1].Descriptor()
+	// friendinviteuse.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	friendinviteuse.UserIDValidator = friendinviteuseDescUserID.Validators[0].(func(string) error)
+	// friendinviteuseDescID is the schema descriptor for id field.
+	friendinviteuseDescID := friendinviteuseMixinFields0[0].Descriptor()
+	// friendinviteuse.DefaultID holds the default value on creation for the id field.
+	friendinviteuse.DefaultID = friendinviteuseDescID.Default.(func() string)
 	guildMixin := schema.Guild{}.Mixin()
 	guildMixinFields0 := guildMixin[0].Fields()
 	_ = guildMixinFields0
@@ -197,6 +476,33 @@ func init() {
 	guildDescID := guildMixinFields0[0].Descriptor()
 	// guild.DefaultID holds the default value on creation for the id field.
 	guild.DefaultID = guildDescID.Default.(func() string)
+	hiddenmessageMixin := schema.HiddenMessage{}.Mixin()
+	hiddenmessageMixinFields0 := hiddenmessageMixin[0].Fields()
+	_ = hiddenmessageMixinFields0
+	hiddenmessageFields := schema.HiddenMessage{}.Fields()
+	_ = hiddenmessageFields
+	// hiddenmessageDescCreatedAt is the schema descriptor for created_at field.
+	hiddenmessageDescCreatedAt := hiddenmessageMixinFields0[1].Descriptor()
+	// hiddenmessage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	hiddenmessage.DefaultCreatedAt = hiddenmessageDescCreatedAt.Default.(func() time.Time)
+	// hiddenmessageDescUpdatedAt is the schema descriptor for updated_at field.
+	hiddenmessageDescUpdatedAt := hiddenmessageMixinFields0[2].Descriptor()
+	// hiddenmessage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	hiddenmessage.DefaultUpdatedAt = hiddenmessageDescUpdatedAt.Default.(func() time.Time)
+	// hiddenmessage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	hiddenmessage.UpdateDefaultUpdatedAt = hiddenmessageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// hiddenmessageDescUserID is the schema descriptor for user_id field.
+	hiddenmessageDescUserID := hiddenmessageFields[0].Descriptor()
+	// hiddenmessage.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	hiddenmessage.UserIDValidator = hiddenmessageDescUserID.Validators[0].(func(string) error)
+	// hiddenmessageDescMessageID is the schema descriptor for message_id field.
+	hiddenmessageDescMessageID := hiddenmessageFields[1].Descriptor()
+	// hiddenmessage.MessageIDValidator is a validator for the "message_id" field. It is called by the builders before save.
+	hiddenmessage.MessageIDValidator = hiddenmessageDescMessageID.Validators[0].(func(string) error)
+	// hiddenmessageDescID is the schema descriptor for id field.
+	hiddenmessageDescID := hiddenmessageMixinFields0[0].Descriptor()
+	// hiddenmessage.DefaultID holds the default value on creation for the id field.
+	hiddenmessage.DefaultID = hiddenmessageDescID.Default.(func() string)
 	invitationMixin := schema.Invitation{}.Mixin()
 	invitationMixinFields0 := invitationMixin[0].Fields()
 	_ = invitationMixinFields0
@@ -220,6 +526,55 @@ func init() {
 	invitationDescID := invitationMixinFields0[0].Descriptor()
 	// invitation.DefaultID holds the default value on creation for the id field.
 	invitation.DefaultID = invitationDescID.Default.(func() string)
+	linkpreviewMixin := schema.LinkPreview{}.Mixin()
+	linkpreviewMixinFields0 := linkpreviewMixin[0].Fields()
+	_ = linkpreviewMixinFields0
+	linkpreviewFields := schema.LinkPreview{}.Fields()
+	_ = linkpreviewFields
+	// linkpreviewDescCreatedAt is the schema descriptor for created_at field.
+	linkpreviewDescCreatedAt := linkpreviewMixinFields0[1].Descriptor()
+	// linkpreview.DefaultCreatedAt holds the default value on creation for the created_at field.
+	linkpreview.DefaultCreatedAt = linkpreviewDescCreatedAt.Default.(func() time.Time)
+	// linkpreviewDescUpdatedAt is the schema descriptor for updated_at field.
+	linkpreviewDescUpdatedAt := linkpreviewMixinFields0[2].Descriptor()
+	// linkpreview.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	linkpreview.DefaultUpdatedAt = linkpreviewDescUpdatedAt.Default.(func() time.Time)
+	// linkpreview.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	linkpreview.UpdateDefaultUpdatedAt = linkpreviewDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// linkpreviewDescMessageID is the schema descriptor for message_id field.
+	linkpreviewDescMessageID := linkpreviewFields[0].Descriptor()
+	// linkpreview.MessageIDValidator is a validator for the "message_id" field. It is called by the builders before save.
+	linkpreview.MessageIDValidator = linkpreviewDescMessageID.Validators[0].(func(string) error)
+	// linkpreviewDescURL is the schema descriptor for url field.
+	linkpreviewDescURL := linkpreviewFields[1].Descriptor()
+	// linkpreview.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	linkpreview.URLValidator = func() func(string) error {
+		validators := linkpreviewDescURL.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(url string) error {
+			for _, fn := range fns {
+				if err := fn(url); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// linkpreviewDescImageURL is the schema descriptor for image_url field.
+	linkpreviewDescImageURL := linkpreviewFields[4].Descriptor()
+	// linkpreview.ImageURLValidator is a validator for the "image_url" field. It is called by the builders before save.
+	linkpreview.ImageURLValidator = linkpreviewDescImageURL.Validators[0].(func(string) error)
+	// linkpreviewDescPosition is the schema descriptor for position field.
+	linkpreviewDescPosition := linkpreviewFields[6].Descriptor()
+	// linkpreview.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	linkpreview.PositionValidator = linkpreviewDescPosition.Validators[0].(func(int) error)
+	// linkpreviewDescID is the schema descriptor for id field.
+	linkpreviewDescID := linkpreviewMixinFields0[0].Descriptor()
+	// linkpreview.DefaultID holds the default value on creation for the id field.
+	linkpreview.DefaultID = linkpreviewDescID.Default.(func() string)
 	memberMixin := schema.Member{}.Mixin()
 	memberMixinFields0 := memberMixin[0].Fields()
 	_ = memberMixinFields0
@@ -287,13 +642,161 @@ func init() {
 	// message.ContentValidator is a validator for the "content" field. It is called by the builders before save.
 	message.ContentValidator = messageDescContent.Validators[0].(func(string) error)
 	// messageDescIsDeleted is the schema descriptor for is_deleted field.
-	messageDescIsDeleted := messageFields[4].Descriptor()
+	messageDescIsDeleted := messageFields[6].Descriptor()
 	// message.DefaultIsDeleted holds the default value on creation for the is_deleted field.
 	message.DefaultIsDeleted = messageDescIsDeleted.Default.(bool)
+	// messageDescThreadReplyCount is the schema descriptor for thread_reply_count field.
+	messageDescThreadReplyCount := messageFields[12].Descriptor()
+	// message.DefaultThreadReplyCount holds the default value on creation for the thread_reply_count field.
+	message.DefaultThreadReplyCount = messageDescThreadReplyCount.Default.(int)
+	// message.ThreadReplyCountValidator is a validator for the "thread_reply_count" field. It is called by the builders before save.
+	message.ThreadReplyCountValidator = messageDescThreadReplyCount.Validators[0].(func(int) error)
+	// messageDescSuppressEmbeds is the schema descriptor for suppress_embeds field.
+	messageDescSuppressEmbeds := messageFields[14].Descriptor()
+	// message.DefaultSuppressEmbeds holds the default value on creation for the suppress_embeds field.
+	message.DefaultSuppressEmbeds = messageDescSuppressEmbeds.Default.(bool)
+	// messageDescNonce is the schema descriptor for nonce field.
+	messageDescNonce := messageFields[17].Descriptor()
+	// message.NonceValidator is a validator for the "nonce" field. It is called by the builders before save.
+	message.NonceValidator = messageDescNonce.Validators[0].(func(string) error)
 	// messageDescID is the schema descriptor for id field.
 	messageDescID := messageMixinFields0[0].Descriptor()
 	// message.DefaultID holds the default value on creation for the id field.
 	message.DefaultID = messageDescID.Default.(func() string)
+	messagementionMixin := schema.MessageMention{}.Mixin()
+	messagementionMixinFields0 := messagementionMixin[0].Fields()
+	_ = messagementionMixinFields0
+	messagementionFields := schema.MessageMention{}.Fields()
+	_ = messagementionFields
+	// messagementionDescCreatedAt is the schema descriptor for created_at field.
+	messagementionDescCreatedAt := messagementionMixinFields0[1].Descriptor()
+	// messagemention.DefaultCreatedAt holds the default value on creation for the created_at field.
+	messagemention.DefaultCreatedAt = messagementionDescCreatedAt.Default.(func() time.Time)
+	// messagementionDescUpdatedAt is the schema descriptor for updated_at field.
+	messagementionDescUpdatedAt := messagementionMixinFields0[2].Descriptor()
+	// messagemention.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	messagemention.DefaultUpdatedAt = messagementionDescUpdatedAt.Default.(func() time.Time)
+	// messagemention.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	messagemention.UpdateDefaultUpdatedAt = messagementionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// messagementionDescMessageID is the schema descriptor for message_id field.
+	messagementionDescMessageID := messagementionFields[0].Descriptor()
+	// messagemention.MessageIDValidator is a validator for the "message_id" field. It is called by the builders before save.
+	messagemention.MessageIDValidator = messagementionDescMessageID.Validators[0].(func(string) error)
+	// messagementionDescOffset is the schema descriptor for offset field.
+	messagementionDescOffset := messagementionFields[3].Descriptor()
+	// messagemention.OffsetValidator is a validator for the "offset" field. It is called by the builders before save.
+	messagemention.OffsetValidator = messagementionDescOffset.Validators[0].(func(int) error)
+	// messagementionDescLength is the schema descriptor for length field.
+	messagementionDescLength := messagementionFields[4].Descriptor()
+	// messagemention.LengthValidator is a validator for the "length" field. It is called by the builders before save.
+	messagemention.LengthValidator = messagementionDescLength.Validators[0].(func(int) error)
+	// messagementionDescID is the schema descriptor for id field.
+	messagementionDescID := messagementionMixinFields0[0].Descriptor()
+	// messagemention.DefaultID holds the default value on creation for the id field.
+	messagemention.DefaultID = messagementionDescID.Default.(func() string)
+	messagereactionMixin := schema.MessageReaction{}.Mixin()
+	messagereactionMixinFields0 := messagereactionMixin[0].Fields()
+	_ = messagereactionMixinFields0
+	messagereactionFields := schema.MessageReaction{}.Fields()
+	_ = messagereactionFields
+	// messagereactionDescCreatedAt is the schema descriptor for created_at field.
+	messagereactionDescCreatedAt := messagereactionMixinFields0[1].Descriptor()
+	// messagereaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	messagereaction.DefaultCreatedAt = messagereactionDescCreatedAt.Default.(func() time.Time)
+	// messagereactionDescUpdatedAt is the schema descriptor for updated_at field.
+	messagereactionDescUpdatedAt := messagereactionMixinFields0[2].Descriptor()
+	// messagereaction.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	messagereaction.DefaultUpdatedAt = messagereactionDescUpdatedAt.Default.(func() time.Time)
+	// messagereaction.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	messagereaction.UpdateDefaultUpdatedAt = messagereactionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// messagereactionDescMessageID is the schema descriptor for message_id field.
+	messagereactionDescMessageID := messagereactionFields[0].Descriptor()
+	// messagereaction.MessageIDValidator is a validator for the "message_id" field. It is called by the builders before save.
+	messagereaction.MessageIDValidator = messagereactionDescMessageID.Validators[0].(func(string) error)
+	// messagereactionDescUserID is the schema descriptor for user_id field.
+	messagereactionDescUserID := messagereactionFields[1].Descriptor()
+	// messagereaction.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	messagereaction.UserIDValidator = messagereactionDescUserID.Validators[0].(func(string) error)
+	// messagereactionDescEmoji is the schema descriptor for emoji field.
+	messagereactionDescEmoji := messagereactionFields[2].Descriptor()
+	// messagereaction.EmojiValidator is a validator for the "emoji" field. It is called by the builders before save.
+	messagereaction.EmojiValidator = func() func(string) error {
+		validators := messagereactionDescEmoji.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(emoji string) error {
+			for _, fn := range fns {
+				if err := fn(emoji); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// messagereactionDescID is the schema descriptor for id field.
+	messagereactionDescID := messagereactionMixinFields0[0].Descriptor()
+	// messagereaction.DefaultID holds the default value on creation for the id field.
+	messagereaction.DefaultID = messagereactionDescID.Default.(func() string)
+	messagereceiptMixin := schema.MessageReceipt{}.Mixin()
+	messagereceiptMixinFields0 := messagereceiptMixin[0].Fields()
+	_ = messagereceiptMixinFields0
+	messagereceiptFields := schema.MessageReceipt{}.Fields()
+	_ = messagereceiptFields
+	// messagereceiptDescCreatedAt is the schema descriptor for created_at field.
+	messagereceiptDescCreatedAt := messagereceiptMixinFields0[1].Descriptor()
+	// messagereceipt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	messagereceipt.DefaultCreatedAt = messagereceiptDescCreatedAt.Default.(func() time.Time)
+	// messagereceiptDescUpdatedAt is the schema descriptor for updated_at field.
+	messagereceiptDescUpdatedAt := messagereceiptMixinFields0[2].Descriptor()
+	// messagereceipt.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	messagereceipt.DefaultUpdatedAt = messagereceiptDescUpdatedAt.Default.(func() time.Time)
+	// messagereceipt.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	messagereceipt.UpdateDefaultUpdatedAt = messagereceiptDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// messagereceiptDescMessageID is the schema descriptor for message_id field.
+	messagereceiptDescMessageID := messagereceiptFields[0].Descriptor()
+	// messagereceipt.MessageIDValidator is a validator for the "message_id" field. It is called by the builders before save.
+	messagereceipt.MessageIDValidator = messagereceiptDescMessageID.Validators[0].(func(string) error)
+	// messagereceiptDescUserID is the schema descriptor for user_id field.
+	messagereceiptDescUserID := messagereceiptFields[1].Descriptor()
+	// messagereceipt.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	messagereceipt.UserIDValidator = messagereceiptDescUserID.Validators[0].(func(string) error)
+	// messagereceiptDescID is the schema descriptor for id field.
+	messagereceiptDescID := messagereceiptMixinFields0[0].Descriptor()
+	// messagereceipt.DefaultID holds the default value on creation for the id field.
+	messagereceipt.DefaultID = messagereceiptDescID.Default.(func() string)
+	messagerevisionMixin := schema.MessageRevision{}.Mixin()
+	messagerevisionMixinFields0 := messagerevisionMixin[0].Fields()
+	_ = messagerevisionMixinFields0
+	messagerevisionFields := schema.MessageRevision{}.Fields()
+	_ = messagerevisionFields
+	// messagerevisionDescCreatedAt is the schema descriptor for created_at field.
+	messagerevisionDescCreatedAt := messagerevisionMixinFields0[1].Descriptor()
+	// messagerevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	messagerevision.DefaultCreatedAt = messagerevisionDescCreatedAt.Default.(func() time.Time)
+	// messagerevisionDescUpdatedAt is the schema descriptor for updated_at field.
+	messagerevisionDescUpdatedAt := messagerevisionMixinFields0[2].Descriptor()
+	// messagerevision.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	messagerevision.DefaultUpdatedAt = messagerevisionDescUpdatedAt.Default.(func() time.Time)
+	// messagerevision.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	messagerevision.UpdateDefaultUpdatedAt = messagerevisionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// messagerevisionDescMessageID is the schema descriptor for message_id field.
+	messagerevisionDescMessageID := messagerevisionFields[0].Descriptor()
+	// messagerevision.MessageIDValidator is a validator for the "message_id" field. It is called by the builders before save.
+	messagerevision.MessageIDValidator = messagerevisionDescMessageID.Validators[0].(func(string) error)
+	// messagerevisionDescEditorID is the schema descriptor for editor_id field.
+	messagerevisionDescEditorID := messagerevisionFields[1].Descriptor()
+	// messagerevision.EditorIDValidator is a validator for the "editor_id" field. It is called by the builders before save.
+	messagerevision.EditorIDValidator = messagerevisionDescEditorID.Validators[0].(func(string) error)
+	// messagerevisionDescContent is the schema descriptor for content field.
+	messagerevisionDescContent := messagerevisionFields[2].Descriptor()
+	// messagerevision.ContentValidator is a validator for the "content" field. It is called by the builders before save.
+	messagerevision.ContentValidator = messagerevisionDescContent.Validators[0].(func(string) error)
+	// messagerevisionDescID is the schema descriptor for id field.
+	messagerevisionDescID := messagerevisionMixinFields0[0].Descriptor()
+	// messagerevision.DefaultID holds the default value on creation for the id field.
+	messagerevision.DefaultID = messagerevisionDescID.Default.(func() string)
 	notificationMixin := schema.Notification{}.Mixin()
 	notificationMixinFields0 := notificationMixin[0].Fields()
 	_ = notificationMixinFields0
@@ -343,6 +846,76 @@ func init() {
 	notificationDescID := notificationMixinFields0[0].Descriptor()
 	// notification.DefaultID holds the default value on creation for the id field.
 	notification.DefaultID = notificationDescID.Default.(func() string)
+	pinnedmessageMixin := schema.PinnedMessage{}.Mixin()
+	pinnedmessageMixinFields0 := pinnedmessageMixin[0].Fields()
+	_ = pinnedmessageMixinFields0
+	pinnedmessageFields := schema.PinnedMessage{}.Fields()
+	_ = pinnedmessageFields
+	// pinnedmessageDescCreatedAt is the schema descriptor for created_at field.
+	pinnedmessageDescCreatedAt := pinnedmessageMixinFields0[1].Descriptor()
+	// pinnedmessage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pinnedmessage.DefaultCreatedAt = pinnedmessageDescCreatedAt.Default.(func() time.Time)
+	// pinnedmessageDescUpdatedAt is the schema descriptor for updated_at field.
+	pinnedmessageDescUpdatedAt := pinnedmessageMixinFields0[2].Descriptor()
+	// pinnedmessage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	pinnedmessage.DefaultUpdatedAt = pinnedmessageDescUpdatedAt.Default.(func() time.Time)
+	// pinnedmessage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	pinnedmessage.UpdateDefaultUpdatedAt = pinnedmessageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// pinnedmessageDescConversationID is the schema descriptor for conversation_id field.
+	pinnedmessageDescConversationID := pinnedmessageFields[0].Descriptor()
+	// pinnedmessage.ConversationIDValidator is a validator for the "conversation_id" field. It is called by the builders before save.
+	pinnedmessage.ConversationIDValidator = pinnedmessageDescConversationID.Validators[0].(func(string) error)
+	// pinnedmessageDescMessageID is the schema descriptor for message_id field.
+	pinnedmessageDescMessageID := pinnedmessageFields[1].Descriptor()
+	// pinnedmessage.MessageIDValidator is a validator for the "message_id" field. It is called by the builders before save.
+	pinnedmessage.MessageIDValidator = pinnedmessageDescMessageID.Validators[0].(func(string) error)
+	// pinnedmessageDescPinnedByID is the schema descriptor for pinned_by_id field.
+	pinnedmessageDescPinnedByID := pinnedmessageFields[2].Descriptor()
+	// pinnedmessage.PinnedByIDValidator is a validator for the "pinned_by_id" field. It is called by the builders before save.
+	pinnedmessage.PinnedByIDValidator = pinnedmessageDescPinnedByID.Validators[0].(func(string) error)
+	// pinnedmessageDescID is the schema descriptor for id field.
+	pinnedmessageDescID := pinnedmessageMixinFields0[0].Descriptor()
+	// pinnedmessage.DefaultID holds the default value on creation for the id field.
+	pinnedmessage.DefaultID = pinnedmessageDescID.Default.(func() string)
+	scheduledmessageMixin := schema.ScheduledMessage{}.Mixin()
+	scheduledmessageMixinFields0 := scheduledmessageMixin[0].Fields()
+	_ = scheduledmessageMixinFields0
+	scheduledmessageFields := schema.ScheduledMessage{}.Fields()
+	_ = scheduledmessageFields
+	// scheduledmessageDescCreatedAt is the schema descriptor for created_at field.
+	scheduledmessageDescCreatedAt := scheduledmessageMixinFields0[1].Descriptor()
+	// scheduledmessage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	scheduledmessage.DefaultCreatedAt = scheduledmessageDescCreatedAt.Default.(func() time.Time)
+	// scheduledmessageDescUpdatedAt is the schema descriptor for updated_at field.
+	scheduledmessageDescUpdatedAt := scheduledmessageMixinFields0[2].Descriptor()
+	// scheduledmessage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	scheduledmessage.DefaultUpdatedAt = scheduledmessageDescUpdatedAt.Default.(func() time.Time)
+	// scheduledmessage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	scheduledmessage.UpdateDefaultUpdatedAt = scheduledmessageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// scheduledmessageDescConversationID is the schema descriptor for conversation_id field.
+	scheduledmessageDescConversationID := scheduledmessageFields[0].Descriptor()
+	// scheduledmessage.ConversationIDValidator is a validator for the "conversation_id" field. It is called by the builders before save.
+	scheduledmessage.ConversationIDValidator = scheduledmessageDescConversationID.Validators[0].(func(string) error)
+	// scheduledmessageDescSenderID is the schema descriptor for sender_id field.
+	scheduledmessageDescSenderID := scheduledmessageFields[1].Descriptor()
+	// scheduledmessage.SenderIDValidator is a validator for the "sender_id" field. It is called by the builders before save.
+	scheduledmessage.SenderIDValidator = scheduledmessageDescSenderID.Validators[0].(func(string) error)
+	// scheduledmessageDescContent is the schema descriptor for content field.
+	scheduledmessageDescContent := scheduledmessageFields[2].Descriptor()
+	// scheduledmessage.ContentValidator is a validator for the "content" field. It is called by the builders before save.
+	scheduledmessage.ContentValidator = scheduledmessageDescContent.Validators[0].(func(string) error)
+	// scheduledmessageDescSuppressEmbeds is the schema descriptor for suppress_embeds field.
+	scheduledmessageDescSuppressEmbeds := scheduledmessageFields[4].Descriptor()
+	// scheduledmessage.DefaultSuppressEmbeds holds the default value on creation for the suppress_embeds field.
+	scheduledmessage.DefaultSuppressEmbeds = scheduledmessageDescSuppressEmbeds.Default.(bool)
+	// scheduledmessageDescAttempts is the schema descriptor for attempts field.
+	scheduledmessageDescAttempts := scheduledmessageFields[9].Descriptor()
+	// scheduledmessage.DefaultAttempts holds the default value on creation for the attempts field.
+	scheduledmessage.DefaultAttempts = scheduledmessageDescAttempts.Default.(int)
+	// scheduledmessageDescID is the schema descriptor for id field.
+	scheduledmessageDescID := scheduledmessageMixinFields0[0].Descriptor()
+	// scheduledmessage.DefaultID holds the default value on creation for the id field.
+	scheduledmessage.DefaultID = scheduledmessageDescID.Default.(func() string)
 	sessionMixin := schema.Session{}.Mixin()
 	sessionMixinFields0 := sessionMixin[0].Fields()
 	_ = sessionMixinFields0
@@ -374,6 +947,33 @@ func init() {
 	sessionDescID := sessionMixinFields0[0].Descriptor()
 	// session.DefaultID holds the default value on creation for the id field.
 	session.DefaultID = sessionDescID.Default.(func() string)
+	threadparticipantMixin := schema.ThreadParticipant{}.Mixin()
+	threadparticipantMixinFields0 := threadparticipantMixin[0].Fields()
+	_ = threadparticipantMixinFields0
+	threadparticipantFields := schema.ThreadParticipant{}.Fields()
+	_ = threadparticipantFields
+	// threadparticipantDescCreatedAt is the schema descriptor for created_at field.
+	threadparticipantDescCreatedAt := threadparticipantMixinFields0[1].Descriptor()
+	// threadparticipant.DefaultCreatedAt holds the default value on creation for the created_at field.
+	threadparticipant.DefaultCreatedAt = threadparticipantDescCreatedAt.Default.(func() time.Time)
+	// threadparticipantDescUpdatedAt is the schema descriptor for updated_at field.
+	threadparticipantDescUpdatedAt := threadparticipantMixinFields0[2].Descriptor()
+	// threadparticipant.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	threadparticipant.DefaultUpdatedAt = threadparticipantDescUpdatedAt.Default.(func() time.Time)
+	// threadparticipant.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	threadparticipant.UpdateDefaultUpdatedAt = threadparticipantDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// threadparticipantDescRootMessageID is the schema descriptor for root_message_id field.
+	threadparticipantDescRootMessageID := threadparticipantFields[0].Descriptor()
+	// threadparticipant.RootMessageIDValidator is a validator for the "root_message_id" field. It is called by the builders before save.
+	threadparticipant.RootMessageIDValidator = threadparticipantDescRootMessageID.Validators[0].(func(string) error)
+	// threadparticipantDescUserID is the schema descriptor for user_id field.
+	threadparticipantDescUserID := threadparticipantFields[1].Descriptor()
+	// threadparticipant.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	threadparticipant.UserIDValidator = threadparticipantDescUserID.Validators[0].(func(string) error)
+	// threadparticipantDescID is the schema descriptor for id field.
+	threadparticipantDescID := threadparticipantMixinFields0[0].Descriptor()
+	// threadparticipant.DefaultID holds the default value on creation for the id field.
+	threadparticipant.DefaultID = threadparticipantDescID.Default.(func() string)
 	userMixin := schema.User{}.Mixin()
 	userMixinFields0 := userMixin[0].Fields()
 	_ = userMixinFields0
@@ -420,6 +1020,10 @@ func init() {
 	userDescBio := userFields[4].Descriptor()
 	// user.BioValidator is a validator for the "bio" field. It is called by the builders before save.
 	user.BioValidator = userDescBio.Validators[0].(func(string) error)
+	// userDescReadReceiptsEnabled is the schema descriptor for read_receipts_enabled field.
+	userDescReadReceiptsEnabled := userFields[7].Descriptor()
+	// user.DefaultReadReceiptsEnabled holds the default value on creation for the read_receipts_enabled field.
+	user.DefaultReadReceiptsEnabled = userDescReadReceiptsEnabled.Default.(bool)
 	// userDescID is the schema descriptor for id field.
 	userDescID := userMixinFields0[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
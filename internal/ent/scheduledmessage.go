@@ -0,0 +1,317 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/scheduledmessage"
+	"kakashi/chaos/internal/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ScheduledMessage is the model entity for the ScheduledMessage schema.
+type ScheduledMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// ConversationID holds the value of the "conversation_id" field.
+	ConversationID string `json:"conversation_id,omitempty"`
+	// SenderID holds the value of the "sender_id" field.
+	SenderID string `json:"sender_id,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// ReplyToID holds the value of the "reply_to_id" field.
+	ReplyToID string `json:"reply_to_id,omitempty"`
+	// SuppressEmbeds holds the value of the "suppress_embeds" field.
+	SuppressEmbeds bool `json:"suppress_embeds,omitempty"`
+	// SendAt holds the value of the "send_at" field.
+	SendAt time.Time `json:"send_at,omitempty"`
+	// Status holds the value of the "status" field.
+	Status scheduledmessage.Status `json:"status,omitempty"`
+	// The message created on delivery
+	MessageID string `json:"message_id,omitempty"`
+	// FailureReason holds the value of the "failure_reason" field.
+	FailureReason string `json:"failure_reason,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// Identifies the scheduler run currently delivering the message
+	ClaimToken string `json:"-"`
+	// After this time the claim is abandoned and another run may deliver the message
+	ClaimExpiresAt time.Time `json:"claim_expires_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ScheduledMessageQuery when eager-loading is set.
+	Edges        ScheduledMessageEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ScheduledMessageEdges holds the relations/edges for other nodes in the graph.
+type ScheduledMessageEdges struct {
+	// Conversation holds the value of the conversation edge.
+	Conversation *Conversation `json:"conversation,omitempty"`
+	// Sender holds the value of the sender edge.
+	Sender *User `json:"sender,omitempty"`
+	// Message holds the value of the message edge.
+	Message *Message `json:"message,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// ConversationOrErr returns the Conversation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScheduledMessageEdges) ConversationOrErr() (*Conversation, error) {
+	if e.Conversation != nil {
+		return e.Conversation, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: conversation.Label}
+	}
+	return nil, &NotLoadedError{edge: "conversation"}
+}
+
+// SenderOrErr returns the Sender value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScheduledMessageEdges) SenderOrErr() (*User, error) {
+	if e.Sender != nil {
+		return e.Sender, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "sender"}
+}
+
+// MessageOrErr returns the Message value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScheduledMessageEdges) MessageOrErr() (*Message, error) {
+	if e.Message != nil {
+		return e.Message, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "message"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScheduledMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case scheduledmessage.FieldSuppressEmbeds:
+			values[i] = new(sql.NullBool)
+		case scheduledmessage.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case scheduledmessage.FieldID, scheduledmessage.FieldConversationID, scheduledmessage.FieldSenderID, scheduledmessage.FieldContent, scheduledmessage.FieldReplyToID, scheduledmessage.FieldStatus, scheduledmessage.FieldMessageID, scheduledmessage.FieldFailureReason, scheduledmessage.FieldClaimToken:
+			values[i] = new(sql.NullString)
+		case scheduledmessage.FieldCreatedAt, scheduledmessage.FieldUpdatedAt, scheduledmessage.FieldSendAt, scheduledmessage.FieldClaimExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScheduledMessage fields.
+func (sm *ScheduledMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case scheduledmessage.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				sm.ID = value.String
+			}
+		case scheduledmessage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sm.CreatedAt = value.Time
+			}
+		case scheduledmessage.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sm.UpdatedAt = value.Time
+			}
+		case scheduledmessage.FieldConversationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field conversation_id", values[i])
+			} else if value.Valid {
+				sm.ConversationID = value.String
+			}
+		case scheduledmessage.FieldSenderID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_id", values[i])
+			} else if value.Valid {
+				sm.SenderID = value.String
+			}
+		case scheduledmessage.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				sm.Content = value.String
+			}
+		case scheduledmessage.FieldReplyToID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reply_to_id", values[i])
+			} else if value.Valid {
+				sm.ReplyToID = value.String
+			}
+		case scheduledmessage.FieldSuppressEmbeds:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field suppress_embeds", values[i])
+			} else if value.Valid {
+				sm.SuppressEmbeds = value.Bool
+			}
+		case scheduledmessage.FieldSendAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field send_at", values[i])
+			} else if value.Valid {
+				sm.SendAt = value.Time
+			}
+		case scheduledmessage.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				sm.Status = scheduledmessage.Status(value.String)
+			}
+		case scheduledmessage.FieldMessageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value.Valid {
+				sm.MessageID = value.String
+			}
+		case scheduledmessage.FieldFailureReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_reason", values[i])
+			} else if value.Valid {
+				sm.FailureReason = value.String
+			}
+		case scheduledmessage.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				sm.Attempts = int(value.Int64)
+			}
+		case scheduledmessage.FieldClaimToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field claim_token", values[i])
+			} else if value.Valid {
+				sm.ClaimToken = value.String
+			}
+		case scheduledmessage.FieldClaimExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field claim_expires_at", values[i])
+			} else if value.Valid {
+				sm.ClaimExpiresAt = value.Time
+			}
+		default:
+			sm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScheduledMessage.
+// This includes values selected through modifiers, order, etc.
+func (sm *ScheduledMessage) Value(name string) (ent.Value, error) {
+	return sm.selectValues.Get(name)
+}
+
+// QueryConversation queries the "conversation" edge of the ScheduledMessage entity.
+func (sm *ScheduledMessage) QueryConversation() *ConversationQuery {
+	return NewScheduledMessageClient(sm.config).QueryConversation(sm)
+}
+
+// QuerySender queries the "sender" edge of the ScheduledMessage entity.
+func (sm *ScheduledMessage) QuerySender() *UserQuery {
+	return NewScheduledMessageClient(sm.config).QuerySender(sm)
+}
+
+// QueryMessage queries the "message" edge of the ScheduledMessage entity.
+func (sm *ScheduledMessage) QueryMessage() *MessageQuery {
+	return NewScheduledMessageClient(sm.config).QueryMessage(sm)
+}
+
+// Update returns a builder for updating this ScheduledMessage.
+// Note that you need to call ScheduledMessage.Unwrap() before calling this method if this ScheduledMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sm *ScheduledMessage) Update() *ScheduledMessageUpdateOne {
+	return NewScheduledMessageClient(sm.config).UpdateOne(sm)
+}
+
+// Unwrap unwraps the ScheduledMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sm *ScheduledMessage) Unwrap() *ScheduledMessage {
+	_tx, ok := sm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScheduledMessage is not a transactional entity")
+	}
+	sm.config.driver = _tx.drv
+	return sm
+}
+
+// String implements the fmt.Stringer.
+func (sm *ScheduledMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScheduledMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sm.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(sm.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sm.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("conversation_id=")
+	builder.WriteString(sm.ConversationID)
+	builder.WriteString(", ")
+	builder.WriteString("sender_id=")
+	builder.WriteString(sm.SenderID)
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(sm.Content)
+	builder.WriteString(", ")
+	builder.WriteString("reply_to_id=")
+	builder.WriteString(sm.ReplyToID)
+	builder.WriteString(", ")
+	builder.WriteString("suppress_embeds=")
+	builder.WriteString(fmt.Sprintf("%v", sm.SuppressEmbeds))
+	builder.WriteString(", ")
+	builder.WriteString("send_at=")
+	builder.WriteString(sm.SendAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", sm.Status))
+	builder.WriteString(", ")
+	builder.WriteString("message_id=")
+	builder.WriteString(sm.MessageID)
+	builder.WriteString(", ")
+	builder.WriteString("failure_reason=")
+	builder.WriteString(sm.FailureReason)
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", sm.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("claim_token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("claim_expires_at=")
+	builder.WriteString(sm.ClaimExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScheduledMessages is a parsable slice of ScheduledMessage.
+type ScheduledMessages []*ScheduledMessage
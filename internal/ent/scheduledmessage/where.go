@@ -0,0 +1,950 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scheduledmessage
+
+import (
+	"kakashi/chaos/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ConversationID applies equality check predicate on the "conversation_id" field. It's identical to ConversationIDEQ.
+func ConversationID(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldConversationID, v))
+}
+
+// SenderID applies equality check predicate on the "sender_id" field. It's identical to SenderIDEQ.
+func SenderID(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldSenderID, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldContent, v))
+}
+
+// ReplyToID applies equality check predicate on the "reply_to_id" field. It's identical to ReplyToIDEQ.
+func ReplyToID(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldReplyToID, v))
+}
+
+// SuppressEmbeds applies equality check predicate on the "suppress_embeds" field. It's identical to SuppressEmbedsEQ.
+func SuppressEmbeds(v bool) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldSuppressEmbeds, v))
+}
+
+// SendAt applies equality check predicate on the "send_at" field. It's identical to SendAtEQ.
+func SendAt(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldSendAt, v))
+}
+
+// MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
+func MessageID(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldMessageID, v))
+}
+
+// FailureReason applies equality check predicate on the "failure_reason" field. It's identical to FailureReasonEQ.
+func FailureReason(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldFailureReason, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldAttempts, v))
+}
+
+// ClaimToken applies equality check predicate on the "claim_token" field. It's identical to ClaimTokenEQ.
+func ClaimToken(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldClaimToken, v))
+}
+
+// ClaimExpiresAt applies equality check predicate on the "claim_expires_at" field. It's identical to ClaimExpiresAtEQ.
+func ClaimExpiresAt(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldClaimExpiresAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ConversationIDEQ applies the EQ predicate on the "conversation_id" field.
+func ConversationIDEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldConversationID, v))
+}
+
+// ConversationIDNEQ applies the NEQ predicate on the "conversation_id" field.
+func ConversationIDNEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldConversationID, v))
+}
+
+// ConversationIDIn applies the In predicate on the "conversation_id" field.
+func ConversationIDIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldConversationID, vs...))
+}
+
+// ConversationIDNotIn applies the NotIn predicate on the "conversation_id" field.
+func ConversationIDNotIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldConversationID, vs...))
+}
+
+// ConversationIDGT applies the GT predicate on the "conversation_id" field.
+func ConversationIDGT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldConversationID, v))
+}
+
+// ConversationIDGTE applies the GTE predicate on the "conversation_id" field.
+func ConversationIDGTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldConversationID, v))
+}
+
+// ConversationIDLT applies the LT predicate on the "conversation_id" field.
+func ConversationIDLT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldConversationID, v))
+}
+
+// ConversationIDLTE applies the LTE predicate on the "conversation_id" field.
+func ConversationIDLTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldConversationID, v))
+}
+
+// ConversationIDContains applies the Contains predicate on the "conversation_id" field.
+func ConversationIDContains(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContains(FieldConversationID, v))
+}
+
+// ConversationIDHasPrefix applies the HasPrefix predicate on the "conversation_id" field.
+func ConversationIDHasPrefix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasPrefix(FieldConversationID, v))
+}
+
+// ConversationIDHasSuffix applies the HasSuffix predicate on the "conversation_id" field.
+func ConversationIDHasSuffix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasSuffix(FieldConversationID, v))
+}
+
+// ConversationIDEqualFold applies the EqualFold predicate on the "conversation_id" field.
+func ConversationIDEqualFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEqualFold(FieldConversationID, v))
+}
+
+// ConversationIDContainsFold applies the ContainsFold predicate on the "conversation_id" field.
+func ConversationIDContainsFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContainsFold(FieldConversationID, v))
+}
+
+// SenderIDEQ applies the EQ predicate on the "sender_id" field.
+func SenderIDEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldSenderID, v))
+}
+
+// SenderIDNEQ applies the NEQ predicate on the "sender_id" field.
+func SenderIDNEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldSenderID, v))
+}
+
+// SenderIDIn applies the In predicate on the "sender_id" field.
+func SenderIDIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldSenderID, vs...))
+}
+
+// SenderIDNotIn applies the NotIn predicate on the "sender_id" field.
+func SenderIDNotIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldSenderID, vs...))
+}
+
+// SenderIDGT applies the GT predicate on the "sender_id" field.
+func SenderIDGT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldSenderID, v))
+}
+
+// SenderIDGTE applies the GTE predicate on the "sender_id" field.
+func SenderIDGTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldSenderID, v))
+}
+
+// SenderIDLT applies the LT predicate on the "sender_id" field.
+func SenderIDLT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldSenderID, v))
+}
+
+// SenderIDLTE applies the LTE predicate on the "sender_id" field.
+func SenderIDLTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldSenderID, v))
+}
+
+// SenderIDContains applies the Contains predicate on the "sender_id" field.
+func SenderIDContains(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContains(FieldSenderID, v))
+}
+
+// SenderIDHasPrefix applies the HasPrefix predicate on the "sender_id" field.
+func SenderIDHasPrefix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasPrefix(FieldSenderID, v))
+}
+
+// SenderIDHasSuffix applies the HasSuffix predicate on the "sender_id" field.
+func SenderIDHasSuffix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasSuffix(FieldSenderID, v))
+}
+
+// SenderIDEqualFold applies the EqualFold predicate on the "sender_id" field.
+func SenderIDEqualFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEqualFold(FieldSenderID, v))
+}
+
+// SenderIDContainsFold applies the ContainsFold predicate on the "sender_id" field.
+func SenderIDContainsFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContainsFold(FieldSenderID, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContainsFold(FieldContent, v))
+}
+
+// ReplyToIDEQ applies the EQ predicate on the "reply_to_id" field.
+func ReplyToIDEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldReplyToID, v))
+}
+
+// ReplyToIDNEQ applies the NEQ predicate on the "reply_to_id" field.
+func ReplyToIDNEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldReplyToID, v))
+}
+
+// ReplyToIDIn applies the In predicate on the "reply_to_id" field.
+func ReplyToIDIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldReplyToID, vs...))
+}
+
+// ReplyToIDNotIn applies the NotIn predicate on the "reply_to_id" field.
+func ReplyToIDNotIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldReplyToID, vs...))
+}
+
+// ReplyToIDGT applies the GT predicate on the "reply_to_id" field.
+func ReplyToIDGT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldReplyToID, v))
+}
+
+// ReplyToIDGTE applies the GTE predicate on the "reply_to_id" field.
+func ReplyToIDGTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldReplyToID, v))
+}
+
+// ReplyToIDLT applies the LT predicate on the "reply_to_id" field.
+func ReplyToIDLT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldReplyToID, v))
+}
+
+// ReplyToIDLTE applies the LTE predicate on the "reply_to_id" field.
+func ReplyToIDLTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldReplyToID, v))
+}
+
+// ReplyToIDContains applies the Contains predicate on the "reply_to_id" field.
+func ReplyToIDContains(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContains(FieldReplyToID, v))
+}
+
+// ReplyToIDHasPrefix applies the HasPrefix predicate on the "reply_to_id" field.
+func ReplyToIDHasPrefix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasPrefix(FieldReplyToID, v))
+}
+
+// ReplyToIDHasSuffix applies the HasSuffix predicate on the "reply_to_id" field.
+func ReplyToIDHasSuffix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasSuffix(FieldReplyToID, v))
+}
+
+// ReplyToIDIsNil applies the IsNil predicate on the "reply_to_id" field.
+func ReplyToIDIsNil() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIsNull(FieldReplyToID))
+}
+
+// ReplyToIDNotNil applies the NotNil predicate on the "reply_to_id" field.
+func ReplyToIDNotNil() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotNull(FieldReplyToID))
+}
+
+// ReplyToIDEqualFold applies the EqualFold predicate on the "reply_to_id" field.
+func ReplyToIDEqualFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEqualFold(FieldReplyToID, v))
+}
+
+// ReplyToIDContainsFold applies the ContainsFold predicate on the "reply_to_id" field.
+func ReplyToIDContainsFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContainsFold(FieldReplyToID, v))
+}
+
+// SuppressEmbedsEQ applies the EQ predicate on the "suppress_embeds" field.
+func SuppressEmbedsEQ(v bool) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldSuppressEmbeds, v))
+}
+
+// SuppressEmbedsNEQ applies the NEQ predicate on the "suppress_embeds" field.
+func SuppressEmbedsNEQ(v bool) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldSuppressEmbeds, v))
+}
+
+// SendAtEQ applies the EQ predicate on the "send_at" field.
+func SendAtEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldSendAt, v))
+}
+
+// SendAtNEQ applies the NEQ predicate on the "send_at" field.
+func SendAtNEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldSendAt, v))
+}
+
+// SendAtIn applies the In predicate on the "send_at" field.
+func SendAtIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldSendAt, vs...))
+}
+
+// SendAtNotIn applies the NotIn predicate on the "send_at" field.
+func SendAtNotIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldSendAt, vs...))
+}
+
+// SendAtGT applies the GT predicate on the "send_at" field.
+func SendAtGT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldSendAt, v))
+}
+
+// SendAtGTE applies the GTE predicate on the "send_at" field.
+func SendAtGTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldSendAt, v))
+}
+
+// SendAtLT applies the LT predicate on the "send_at" field.
+func SendAtLT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldSendAt, v))
+}
+
+// SendAtLTE applies the LTE predicate on the "send_at" field.
+func SendAtLTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldSendAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// MessageIDEQ applies the EQ predicate on the "message_id" field.
+func MessageIDEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldMessageID, v))
+}
+
+// MessageIDNEQ applies the NEQ predicate on the "message_id" field.
+func MessageIDNEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldMessageID, v))
+}
+
+// MessageIDIn applies the In predicate on the "message_id" field.
+func MessageIDIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldMessageID, vs...))
+}
+
+// MessageIDNotIn applies the NotIn predicate on the "message_id" field.
+func MessageIDNotIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldMessageID, vs...))
+}
+
+// MessageIDGT applies the GT predicate on the "message_id" field.
+func MessageIDGT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldMessageID, v))
+}
+
+// MessageIDGTE applies the GTE predicate on the "message_id" field.
+func MessageIDGTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldMessageID, v))
+}
+
+// MessageIDLT applies the LT predicate on the "message_id" field.
+func MessageIDLT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldMessageID, v))
+}
+
+// MessageIDLTE applies the LTE predicate on the "message_id" field.
+func MessageIDLTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldMessageID, v))
+}
+
+// MessageIDContains applies the Contains predicate on the "message_id" field.
+func MessageIDContains(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContains(FieldMessageID, v))
+}
+
+// MessageIDHasPrefix applies the HasPrefix predicate on the "message_id" field.
+func MessageIDHasPrefix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasPrefix(FieldMessageID, v))
+}
+
+// MessageIDHasSuffix applies the HasSuffix predicate on the "message_id" field.
+func MessageIDHasSuffix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasSuffix(FieldMessageID, v))
+}
+
+// MessageIDIsNil applies the IsNil predicate on the "message_id" field.
+func MessageIDIsNil() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIsNull(FieldMessageID))
+}
+
+// MessageIDNotNil applies the NotNil predicate on the "message_id" field.
+func MessageIDNotNil() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotNull(FieldMessageID))
+}
+
+// MessageIDEqualFold applies the EqualFold predicate on the "message_id" field.
+func MessageIDEqualFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEqualFold(FieldMessageID, v))
+}
+
+// MessageIDContainsFold applies the ContainsFold predicate on the "message_id" field.
+func MessageIDContainsFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContainsFold(FieldMessageID, v))
+}
+
+// FailureReasonEQ applies the EQ predicate on the "failure_reason" field.
+func FailureReasonEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldFailureReason, v))
+}
+
+// FailureReasonNEQ applies the NEQ predicate on the "failure_reason" field.
+func FailureReasonNEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldFailureReason, v))
+}
+
+// FailureReasonIn applies the In predicate on the "failure_reason" field.
+func FailureReasonIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldFailureReason, vs...))
+}
+
+// FailureReasonNotIn applies the NotIn predicate on the "failure_reason" field.
+func FailureReasonNotIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldFailureReason, vs...))
+}
+
+// FailureReasonGT applies the GT predicate on the "failure_reason" field.
+func FailureReasonGT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldFailureReason, v))
+}
+
+// FailureReasonGTE applies the GTE predicate on the "failure_reason" field.
+func FailureReasonGTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldFailureReason, v))
+}
+
+// FailureReasonLT applies the LT predicate on the "failure_reason" field.
+func FailureReasonLT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldFailureReason, v))
+}
+
+// FailureReasonLTE applies the LTE predicate on the "failure_reason" field.
+func FailureReasonLTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldFailureReason, v))
+}
+
+// FailureReasonContains applies the Contains predicate on the "failure_reason" field.
+func FailureReasonContains(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContains(FieldFailureReason, v))
+}
+
+// FailureReasonHasPrefix applies the HasPrefix predicate on the "failure_reason" field.
+func FailureReasonHasPrefix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasPrefix(FieldFailureReason, v))
+}
+
+// FailureReasonHasSuffix applies the HasSuffix predicate on the "failure_reason" field.
+func FailureReasonHasSuffix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasSuffix(FieldFailureReason, v))
+}
+
+// FailureReasonIsNil applies the IsNil predicate on the "failure_reason" field.
+func FailureReasonIsNil() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIsNull(FieldFailureReason))
+}
+
+// FailureReasonNotNil applies the NotNil predicate on the "failure_reason" field.
+func FailureReasonNotNil() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotNull(FieldFailureReason))
+}
+
+// FailureReasonEqualFold applies the EqualFold predicate on the "failure_reason" field.
+func FailureReasonEqualFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEqualFold(FieldFailureReason, v))
+}
+
+// FailureReasonContainsFold applies the ContainsFold predicate on the "failure_reason" field.
+func FailureReasonContainsFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContainsFold(FieldFailureReason, v))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldAttempts, v))
+}
+
+// ClaimTokenEQ applies the EQ predicate on the "claim_token" field.
+func ClaimTokenEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldClaimToken, v))
+}
+
+// ClaimTokenNEQ applies the NEQ predicate on the "claim_token" field.
+func ClaimTokenNEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldClaimToken, v))
+}
+
+// ClaimTokenIn applies the In predicate on the "claim_token" field.
+func ClaimTokenIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldClaimToken, vs...))
+}
+
+// ClaimTokenNotIn applies the NotIn predicate on the "claim_token" field.
+func ClaimTokenNotIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldClaimToken, vs...))
+}
+
+// ClaimTokenGT applies the GT predicate on the "claim_token" field.
+func ClaimTokenGT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldClaimToken, v))
+}
+
+// ClaimTokenGTE applies the GTE predicate on the "claim_token" field.
+func ClaimTokenGTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldClaimToken, v))
+}
+
+// ClaimTokenLT applies the LT predicate on the "claim_token" field.
+func ClaimTokenLT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldClaimToken, v))
+}
+
+// ClaimTokenLTE applies the LTE predicate on the "claim_token" field.
+func ClaimTokenLTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldClaimToken, v))
+}
+
+// ClaimTokenContains applies the Contains predicate on the "claim_token" field.
+func ClaimTokenContains(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContains(FieldClaimToken, v))
+}
+
+// ClaimTokenHasPrefix applies the HasPrefix predicate on the "claim_token" field.
+func ClaimTokenHasPrefix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasPrefix(FieldClaimToken, v))
+}
+
+// ClaimTokenHasSuffix applies the HasSuffix predicate on the "claim_token" field.
+func ClaimTokenHasSuffix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasSuffix(FieldClaimToken, v))
+}
+
+// ClaimTokenIsNil applies the IsNil predicate on the "claim_token" field.
+func ClaimTokenIsNil() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIsNull(FieldClaimToken))
+}
+
+// ClaimTokenNotNil applies the NotNil predicate on the "claim_token" field.
+func ClaimTokenNotNil() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotNull(FieldClaimToken))
+}
+
+// ClaimTokenEqualFold applies the EqualFold predicate on the "claim_token" field.
+func ClaimTokenEqualFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEqualFold(FieldClaimToken, v))
+}
+
+// ClaimTokenContainsFold applies the ContainsFold predicate on the "claim_token" field.
+func ClaimTokenContainsFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContainsFold(FieldClaimToken, v))
+}
+
+// ClaimExpiresAtEQ applies the EQ predicate on the "claim_expires_at" field.
+func ClaimExpiresAtEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldClaimExpiresAt, v))
+}
+
+// ClaimExpiresAtNEQ applies the NEQ predicate on the "claim_expires_at" field.
+func ClaimExpiresAtNEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldClaimExpiresAt, v))
+}
+
+// ClaimExpiresAtIn applies the In predicate on the "claim_expires_at" field.
+func ClaimExpiresAtIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldClaimExpiresAt, vs...))
+}
+
+// ClaimExpiresAtNotIn applies the NotIn predicate on the "claim_expires_at" field.
+func ClaimExpiresAtNotIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldClaimExpiresAt, vs...))
+}
+
+// ClaimExpiresAtGT applies the GT predicate on the "claim_expires_at" field.
+func ClaimExpiresAtGT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldClaimExpiresAt, v))
+}
+
+// ClaimExpiresAtGTE applies the GTE predicate on the "claim_expires_at" field.
+func ClaimExpiresAtGTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldClaimExpiresAt, v))
+}
+
+// ClaimExpiresAtLT applies the LT predicate on the "claim_expires_at" field.
+func ClaimExpiresAtLT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldClaimExpiresAt, v))
+}
+
+// ClaimExpiresAtLTE applies the LTE predicate on the "claim_expires_at" field.
+func ClaimExpiresAtLTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldClaimExpiresAt, v))
+}
+
+// ClaimExpiresAtIsNil applies the IsNil predicate on the "claim_expires_at" field.
+func ClaimExpiresAtIsNil() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIsNull(FieldClaimExpiresAt))
+}
+
+// ClaimExpiresAtNotNil applies the NotNil predicate on the "claim_expires_at" field.
+func ClaimExpiresAtNotNil() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotNull(FieldClaimExpiresAt))
+}
+
+// HasConversation applies the HasEdge predicate on the "conversation" edge.
+func HasConversation() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ConversationTable, ConversationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConversationWith applies the HasEdge predicate on the "conversation" edge with a given conditions (other predicates).
+func HasConversationWith(preds ...predicate.Conversation) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(func(s *sql.Selector) {
+		step := newConversationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSender applies the HasEdge predicate on the "sender" edge.
+func HasSender() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, SenderTable, SenderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSenderWith applies the HasEdge predicate on the "sender" edge with a given conditions (other predicates).
+func HasSenderWith(preds ...predicate.User) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(func(s *sql.Selector) {
+		step := newSenderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMessage applies the HasEdge predicate on the "message" edge.
+func HasMessage() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, MessageTable, MessageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMessageWith applies the HasEdge predicate on the "message" edge with a given conditions (other predicates).
+func HasMessageWith(preds ...predicate.Message) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(func(s *sql.Selector) {
+		step := newMessageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ScheduledMessage) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ScheduledMessage) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ScheduledMessage) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.NotPredicates(p))
+}
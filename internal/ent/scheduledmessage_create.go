@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/scheduledmessage"
+	"kakashi/chaos/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ScheduledMessageCreate is the builder for creating a ScheduledMessage entity.
+type ScheduledMessageCreate struct {
+	config
+	mutation *ScheduledMessageMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (smc *ScheduledMessageCreate) SetCreatedAt(t time.Time) *ScheduledMessageCreate {
+	smc.mutation.SetCreatedAt(t)
+	return smc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableCreatedAt(t *time.Time) *ScheduledMessageCreate {
+	if t != nil {
+		smc.SetCreatedAt(*t)
+	}
+	return smc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (smc *ScheduledMessageCreate) SetUpdatedAt(t time.Time) *ScheduledMessageCreate {
+	smc.mutation.SetUpdatedAt(t)
+	return smc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableUpdatedAt(t *time.Time) *ScheduledMessageCreate {
+	if t != nil {
+		smc.SetUpdatedAt(*t)
+	}
+	return smc
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (smc *ScheduledMessageCreate) SetConversationID(s string) *ScheduledMessageCreate {
+	smc.mutation.SetConversationID(s)
+	return smc
+}
+
+// SetSenderID sets the "sender_id" field.
+func (smc *ScheduledMessageCreate) SetSenderID(s string) *ScheduledMessageCreate {
+	smc.mutation.SetSenderID(s)
+	return smc
+}
+
+// SetContent sets the "content" field.
+func (smc *ScheduledMessageCreate) SetContent(s string) *ScheduledMessageCreate {
+	smc.mutation.SetContent(s)
+	return smc
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (smc *ScheduledMessageCreate) SetReplyToID(s string) *ScheduledMessageCreate {
+	smc.mutation.SetReplyToID(s)
+	return smc
+}
+
+// SetNillableReplyToID sets the "reply_to_id" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableReplyToID(s *string) *ScheduledMessageCreate {
+	if s != nil {
+		smc.SetReplyToID(*s)
+	}
+	return smc
+}
+
+// SetSuppressEmbeds sets the "suppress_embeds" field.
+func (smc *ScheduledMessageCreate) SetSuppressEmbeds(b bool) *ScheduledMessageCreate {
+	smc.mutation.SetSuppressEmbeds(b)
+	return smc
+}
+
+// SetNillableSuppressEmbeds sets the "suppress_embeds" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableSuppressEmbeds(b *bool) *ScheduledMessageCreate {
+	if b != nil {
+		smc.SetSuppressEmbeds(*b)
+	}
+	return smc
+}
+
+// SetSendAt sets the "send_at" field.
+func (smc *ScheduledMessageCreate) SetSendAt(t time.Time) *ScheduledMessageCreate {
+	smc.mutation.SetSendAt(t)
+	return smc
+}
+
+// SetStatus sets the "status" field.
+func (smc *ScheduledMessageCreate) SetStatus(s scheduledmessage.Status) *ScheduledMessageCreate {
+	smc.mutation.SetStatus(s)
+	return smc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableStatus(s *scheduledmessage.Status) *ScheduledMessageCreate {
+	if s != nil {
+		smc.SetStatus(*s)
+	}
+	return smc
+}
+
+// SetMessageID sets the "message_id" field.
+func (smc *ScheduledMessageCreate) SetMessageID(s string) *ScheduledMessageCreate {
+	smc.mutation.SetMessageID(s)
+	return smc
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableMessageID(s *string) *ScheduledMessageCreate {
+	if s != nil {
+		smc.SetMessageID(*s)
+	}
+	return smc
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (smc *ScheduledMessageCreate) SetFailureReason(s string) *ScheduledMessageCreate {
+	smc.mutation.SetFailureReason(s)
+	return smc
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableFailureReason(s *string) *ScheduledMessageCreate {
+	if s != nil {
+		smc.SetFailureReason(*s)
+	}
+	return smc
+}
+
+// SetAttempts sets the "attempts" field.
+func (smc *ScheduledMessageCreate) SetAttempts(i int) *ScheduledMessageCreate {
+	smc.mutation.SetAttempts(i)
+	return smc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableAttempts(i *int) *ScheduledMessageCreate {
+	if i != nil {
+		smc.SetAttempts(*i)
+	}
+	return smc
+}
+
+// SetClaimToken sets the "claim_token" field.
+func (smc *ScheduledMessageCreate) SetClaimToken(s string) *ScheduledMessageCreate {
+	smc.mutation.SetClaimToken(s)
+	return smc
+}
+
+// SetNillableClaimToken sets the "claim_token" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableClaimToken(s *string) *ScheduledMessageCreate {
+	if s != nil {
+		smc.SetClaimToken(*s)
+	}
+	return smc
+}
+
+// SetClaimExpiresAt sets the "claim_expires_at" field.
+func (smc *ScheduledMessageCreate) SetClaimExpiresAt(t time.Time) *ScheduledMessageCreate {
+	smc.mutation.SetClaimExpiresAt(t)
+	return smc
+}
+
+// SetNillableClaimExpiresAt sets the "claim_expires_at" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableClaimExpiresAt(t *time.Time) *ScheduledMessageCreate {
+	if t != nil {
+		smc.SetClaimExpiresAt(*t)
+	}
+	return smc
+}
+
+// SetID sets the "id" field.
+func (smc *ScheduledMessageCreate) SetID(s string) *ScheduledMessageCreate {
+	smc.mutation.SetID(s)
+	return smc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableID(s *string) *ScheduledMessageCreate {
+	if s != nil {
+		smc.SetID(*s)
+	}
+	return smc
+}
+
+// SetConversation sets the "conversation" edge to the Conversation entity.
+func (smc *ScheduledMessageCreate) SetConversation(c *Conversation) *ScheduledMessageCreate {
+	return smc.SetConversationID(c.ID)
+}
+
+// SetSender sets the "sender" edge to the User entity.
+func (smc *ScheduledMessageCreate) SetSender(u *User) *ScheduledMessageCreate {
+	return smc.SetSenderID(u.ID)
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (smc *ScheduledMessageCreate) SetMessage(m *Message) *ScheduledMessageCreate {
+	return smc.SetMessageID(m.ID)
+}
+
+// Mutation returns the ScheduledMessageMutation object of the builder.
+func (smc *ScheduledMessageCreate) Mutation() *ScheduledMessageMutation {
+	return smc.mutation
+}
+
+// Save creates the ScheduledMessage in the database.
+func (smc *ScheduledMessageCreate) Save(ctx context.Context) (*ScheduledMessage, error) {
+	smc.defaults()
+	return withHooks(ctx, smc.sqlSave, smc.mutation, smc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (smc *ScheduledMessageCreate) SaveX(ctx context.Context) *ScheduledMessage {
+	v, err := smc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (smc *ScheduledMessageCreate) Exec(ctx context.Context) error {
+	_, err := smc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smc *ScheduledMessageCreate) ExecX(ctx context.Context) {
+	if err := smc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (smc *ScheduledMessageCreate) defaults() {
+	if _, ok := smc.mutation.CreatedAt(); !ok {
+		v := scheduledmessage.DefaultCreatedAt()
+		smc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := smc.mutation.UpdatedAt(); !ok {
+		v := scheduledmessage.DefaultUpdatedAt()
+		smc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := smc.mutation.SuppressEmbeds(); !ok {
+		v := scheduledmessage.DefaultSuppressEmbeds
+		smc.mutation.SetSuppressEmbeds(v)
+	}
+	if _, ok := smc.mutation.Status(); !ok {
+		v := scheduledmessage.DefaultStatus
+		smc.mutation.SetStatus(v)
+	}
+	if _, ok := smc.mutation.Attempts(); !ok {
+		v := scheduledmessage.DefaultAttempts
+		smc.mutation.SetAttempts(v)
+	}
+	if _, ok := smc.mutation.ID(); !ok {
+		v := scheduledmessage.DefaultID()
+		smc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (smc *ScheduledMessageCreate) check() error {
+	if _, ok := smc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ScheduledMessage.created_at"`)}
+	}
+	if _, ok := smc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ScheduledMessage.updated_at"`)}
+	}
+	if _, ok := smc.mutation.ConversationID(); !ok {
+		return &ValidationError{Name: "conversation_id", err: errors.New(`ent: missing required field "ScheduledMessage.conversation_id"`)}
+	}
+	if v, ok := smc.mutation.ConversationID(); ok {
+		if err := scheduledmessage.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.conversation_id": %w`, err)}
+		}
+	}
+	if _, ok := smc.mutation.SenderID(); !ok {
+		return &ValidationError{Name: "sender_id", err: errors.New(`ent: missing required field "ScheduledMessage.sender_id"`)}
+	}
+	if v, ok := smc.mutation.SenderID(); ok {
+		if err := scheduledmessage.SenderIDValidator(v); err != nil {
+			return &ValidationError{Name: "sender_id", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.sender_id": %w`, err)}
+		}
+	}
+	if _, ok := smc.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "ScheduledMessage.content"`)}
+	}
+	if v, ok := smc.mutation.Content(); ok {
+		if err := scheduledmessage.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.content": %w`, err)}
+		}
+	}
+	if _, ok := smc.mutation.SuppressEmbeds(); !ok {
+		return &ValidationError{Name: "suppress_embeds", err: errors.New(`ent: missing required field "ScheduledMessage.suppress_embeds"`)}
+	}
+	if _, ok := smc.mutation.SendAt(); !ok {
+		return &ValidationError{Name: "send_at", err: errors.New(`ent: missing required field "ScheduledMessage.send_at"`)}
+	}
+	if _, ok := smc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ScheduledMessage.status"`)}
+	}
+	if v, ok := smc.mutation.Status(); ok {
+		if err := scheduledmessage.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.status": %w`, err)}
+		}
+	}
+	if _, ok := smc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "ScheduledMessage.attempts"`)}
+	}
+	if len(smc.mutation.ConversationIDs()) == 0 {
+		return &ValidationError{Name: "conversation", err: errors.New(`ent: missing required edge "ScheduledMessage.conversation"`)}
+	}
+	if len(smc.mutation.SenderIDs()) == 0 {
+		return &ValidationError{Name: "sender", err: errors.New(`ent: missing required edge "ScheduledMessage.sender"`)}
+	}
+	return nil
+}
+
+func (smc *ScheduledMessageCreate) sqlSave(ctx context.Context) (*ScheduledMessage, error) {
+	if err := smc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := smc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, smc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ScheduledMessage.ID type: %T", _spec.ID.Value)
+		}
+	}
+	smc.mutation.id = &_node.ID
+	smc.mutation.done = true
+	return _node, nil
+}
+
+func (smc *ScheduledMessageCreate) createSpec() (*ScheduledMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScheduledMessage{config: smc.config}
+		_spec = sqlgraph.NewCreateSpec(scheduledmessage.Table, sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeString))
+	)
+	if id, ok := smc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := smc.mutation.CreatedAt(); ok {
+		_spec.SetField(scheduledmessage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := smc.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledmessage.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := smc.mutation.Content(); ok {
+		_spec.SetField(scheduledmessage.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := smc.mutation.ReplyToID(); ok {
+		_spec.SetField(scheduledmessage.FieldReplyToID, field.TypeString, value)
+		_node.ReplyToID = value
+	}
+	if value, ok := smc.mutation.SuppressEmbeds(); ok {
+		_spec.SetField(scheduledmessage.FieldSuppressEmbeds, field.TypeBool, value)
+		_node.SuppressEmbeds = value
+	}
+	if value, ok := smc.mutation.SendAt(); ok {
+		_spec.SetField(scheduledmessage.FieldSendAt, field.TypeTime, value)
+		_node.SendAt = value
+	}
+	if value, ok := smc.mutation.Status(); ok {
+		_spec.SetField(scheduledmessage.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := smc.mutation.FailureReason(); ok {
+		_spec.SetField(scheduledmessage.FieldFailureReason, field.TypeString, value)
+		_node.FailureReason = value
+	}
+	if value, ok := smc.mutation.Attempts(); ok {
+		_spec.SetField(scheduledmessage.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := smc.mutation.ClaimToken(); ok {
+		_spec.SetField(scheduledmessage.FieldClaimToken, field.TypeString, value)
+		_node.ClaimToken = value
+	}
+	if value, ok := smc.mutation.ClaimExpiresAt(); ok {
+		_spec.SetField(scheduledmessage.FieldClaimExpiresAt, field.TypeTime, value)
+		_node.ClaimExpiresAt = value
+	}
+	if nodes := smc.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledmessage.ConversationTable,
+			Columns: []string{scheduledmessage.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ConversationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := smc.mutation.SenderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledmessage.SenderTable,
+			Columns: []string{scheduledmessage.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SenderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := smc.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledmessage.MessageTable,
+			Columns: []string{scheduledmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ScheduledMessageCreateBulk is the builder for creating many ScheduledMessage entities in bulk.
+type ScheduledMessageCreateBulk struct {
+	config
+	err      error
+	builders []*ScheduledMessageCreate
+}
+
+// Save creates the ScheduledMessage entities in the database.
+func (smcb *ScheduledMessageCreateBulk) Save(ctx context.Context) ([]*ScheduledMessage, error) {
+	if smcb.err != nil {
+		return nil, smcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(smcb.builders))
+	nodes := make([]*ScheduledMessage, len(smcb.builders))
+	mutators := make([]Mutator, len(smcb.builders))
+	for i := range smcb.builders {
+		func(i int, root context.Context) {
+			builder := smcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScheduledMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, smcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, smcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, smcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (smcb *ScheduledMessageCreateBulk) SaveX(ctx context.Context) []*ScheduledMessage {
+	v, err := smcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (smcb *ScheduledMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := smcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smcb *ScheduledMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := smcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,756 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/scheduledmessage"
+	"kakashi/chaos/internal/ent/user"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ScheduledMessageQuery is the builder for querying ScheduledMessage entities.
+type ScheduledMessageQuery struct {
+	config
+	ctx              *QueryContext
+	order            []scheduledmessage.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.ScheduledMessage
+	withConversation *ConversationQuery
+	withSender       *UserQuery
+	withMessage      *MessageQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ScheduledMessageQuery builder.
+func (smq *ScheduledMessageQuery) Where(ps ...predicate.ScheduledMessage) *ScheduledMessageQuery {
+	smq.predicates = append(smq.predicates, ps...)
+	return smq
+}
+
+// Limit the number of records to be returned by this query.
+func (smq *ScheduledMessageQuery) Limit(limit int) *ScheduledMessageQuery {
+	smq.ctx.Limit = &limit
+	return smq
+}
+
+// Offset to start from.
+func (smq *ScheduledMessageQuery) Offset(offset int) *ScheduledMessageQuery {
+	smq.ctx.Offset = &offset
+	return smq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (smq *ScheduledMessageQuery) Unique(unique bool) *ScheduledMessageQuery {
+	smq.ctx.Unique = &unique
+	return smq
+}
+
+// Order specifies how the records should be ordered.
+func (smq *ScheduledMessageQuery) Order(o ...scheduledmessage.OrderOption) *ScheduledMessageQuery {
+	smq.order = append(smq.order, o...)
+	return smq
+}
+
+// QueryConversation chains the current query on the "conversation" edge.
+func (smq *ScheduledMessageQuery) QueryConversation() *ConversationQuery {
+	query := (&ConversationClient{config: smq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := smq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := smq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scheduledmessage.Table, scheduledmessage.FieldID, selector),
+			sqlgraph.To(conversation.Table, conversation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, scheduledmessage.ConversationTable, scheduledmessage.ConversationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(smq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySender chains the current query on the "sender" edge.
+func (smq *ScheduledMessageQuery) QuerySender() *UserQuery {
+	query := (&UserClient{config: smq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := smq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := smq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scheduledmessage.Table, scheduledmessage.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, scheduledmessage.SenderTable, scheduledmessage.SenderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(smq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMessage chains the current query on the "message" edge.
+func (smq *ScheduledMessageQuery) QueryMessage() *MessageQuery {
+	query := (&MessageClient{config: smq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := smq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := smq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scheduledmessage.Table, scheduledmessage.FieldID, selector),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, scheduledmessage.MessageTable, scheduledmessage.MessageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(smq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ScheduledMessage entity from the query.
+// Returns a *NotFoundError when no ScheduledMessage was found.
+func (smq *ScheduledMessageQuery) First(ctx context.Context) (*ScheduledMessage, error) {
+	nodes, err := smq.Limit(1).All(setContextOp(ctx, smq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{scheduledmessage.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (smq *ScheduledMessageQuery) FirstX(ctx context.Context) *ScheduledMessage {
+	node, err := smq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ScheduledMessage ID from the query.
+// Returns a *NotFoundError when no ScheduledMessage ID was found.
+func (smq *ScheduledMessageQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = smq.Limit(1).IDs(setContextOp(ctx, smq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{scheduledmessage.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (smq *ScheduledMessageQuery) FirstIDX(ctx context.Context) string {
+	id, err := smq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ScheduledMessage entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ScheduledMessage entity is found.
+// Returns a *NotFoundError when no ScheduledMessage entities are found.
+func (smq *ScheduledMessageQuery) Only(ctx context.Context) (*ScheduledMessage, error) {
+	nodes, err := smq.Limit(2).All(setContextOp(ctx, smq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{scheduledmessage.Label}
+	default:
+		return nil, &NotSingularError{scheduledmessage.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (smq *ScheduledMessageQuery) OnlyX(ctx context.Context) *ScheduledMessage {
+	node, err := smq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ScheduledMessage ID in the query.
+// Returns a *NotSingularError when more than one ScheduledMessage ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (smq *ScheduledMessageQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = smq.Limit(2).IDs(setContextOp(ctx, smq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{scheduledmessage.Label}
+	default:
+		err = &NotSingularError{scheduledmessage.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (smq *ScheduledMessageQuery) OnlyIDX(ctx context.Context) string {
+	id, err := smq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ScheduledMessages.
+func (smq *ScheduledMessageQuery) All(ctx context.Context) ([]*ScheduledMessage, error) {
+	ctx = setContextOp(ctx, smq.ctx, ent.OpQueryAll)
+	if err := smq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ScheduledMessage, *ScheduledMessageQuery]()
+	return withInterceptors[[]*ScheduledMessage](ctx, smq, qr, smq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (smq *ScheduledMessageQuery) AllX(ctx context.Context) []*ScheduledMessage {
+	nodes, err := smq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ScheduledMessage IDs.
+func (smq *ScheduledMessageQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if smq.ctx.Unique == nil && smq.path != nil {
+		smq.Unique(true)
+	}
+	ctx = setContextOp(ctx, smq.ctx, ent.OpQueryIDs)
+	if err = smq.Select(scheduledmessage.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (smq *ScheduledMessageQuery) IDsX(ctx context.Context) []string {
+	ids, err := smq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (smq *ScheduledMessageQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, smq.ctx, ent.OpQueryCount)
+	if err := smq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, smq, querierCount[*ScheduledMessageQuery](), smq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (smq *ScheduledMessageQuery) CountX(ctx context.Context) int {
+	count, err := smq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (smq *ScheduledMessageQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, smq.ctx, ent.OpQueryExist)
+	switch _, err := smq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (smq *ScheduledMessageQuery) ExistX(ctx context.Context) bool {
+	exist, err := smq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ScheduledMessageQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (smq *ScheduledMessageQuery) Clone() *ScheduledMessageQuery {
+	if smq == nil {
+		return nil
+	}
+	return &ScheduledMessageQuery{
+		config:           smq.config,
+		ctx:              smq.ctx.Clone(),
+		order:            append([]scheduledmessage.OrderOption{}, smq.order...),
+		inters:           append([]Interceptor{}, smq.inters...),
+		predicates:       append([]predicate.ScheduledMessage{}, smq.predicates...),
+		withConversation: smq.withConversation.Clone(),
+		withSender:       smq.withSender.Clone(),
+		withMessage:      smq.withMessage.Clone(),
+		// clone intermediate query.
+		sql:  smq.sql.Clone(),
+		path: smq.path,
+	}
+}
+
+// WithConversation tells the query-builder to eager-load the nodes that are connected to
+// the "conversation" edge. The optional arguments are used to configure the query builder of the edge.
+func (smq *ScheduledMessageQuery) WithConversation(opts ...func(*ConversationQuery)) *ScheduledMessageQuery {
+	query := (&ConversationClient{config: smq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	smq.withConversation = query
+	return smq
+}
+
+// WithSender tells the query-builder to eager-load the nodes that are connected to
+// the "sender" edge. The optional arguments are used to configure the query builder of the edge.
+func (smq *ScheduledMessageQuery) WithSender(opts ...func(*UserQuery)) *ScheduledMessageQuery {
+	query := (&UserClient{config: smq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	smq.withSender = query
+	return smq
+}
+
+// WithMessage tells the query-builder to eager-load the nodes that are connected to
+// the "message" edge. The optional arguments are used to configure the query builder of the edge.
+func (smq *ScheduledMessageQuery) WithMessage(opts ...func(*MessageQuery)) *ScheduledMessageQuery {
+	query := (&MessageClient{config: smq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	smq.withMessage = query
+	return smq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ScheduledMessage.Query().
+//		GroupBy(scheduledmessage.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (smq *ScheduledMessageQuery) GroupBy(field string, fields ...string) *ScheduledMessageGroupBy {
+	smq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ScheduledMessageGroupBy{build: smq}
+	grbuild.flds = &smq.ctx.Fields
+	grbuild.label = scheduledmessage.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.ScheduledMessage.Query().
+//		Select(scheduledmessage.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (smq *ScheduledMessageQuery) Select(fields ...string) *ScheduledMessageSelect {
+	smq.ctx.Fields = append(smq.ctx.Fields, fields...)
+	sbuild := &ScheduledMessageSelect{ScheduledMessageQuery: smq}
+	sbuild.label = scheduledmessage.Label
+	sbuild.flds, sbuild.scan = &smq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ScheduledMessageSelect configured with the given aggregations.
+func (smq *ScheduledMessageQuery) Aggregate(fns ...AggregateFunc) *ScheduledMessageSelect {
+	return smq.Select().Aggregate(fns...)
+}
+
+func (smq *ScheduledMessageQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range smq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, smq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range smq.ctx.Fields {
+		if !scheduledmessage.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if smq.path != nil {
+		prev, err := smq.path(ctx)
+		if err != nil {
+			return err
+		}
+		smq.sql = prev
+	}
+	return nil
+}
+
+func (smq *ScheduledMessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ScheduledMessage, error) {
+	var (
+		nodes       = []*ScheduledMessage{}
+		_spec       = smq.querySpec()
+		loadedTypes = [3]bool{
+			smq.withConversation != nil,
+			smq.withSender != nil,
+			smq.withMessage != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ScheduledMessage).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ScheduledMessage{config: smq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, smq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := smq.withConversation; query != nil {
+		if err := smq.loadConversation(ctx, query, nodes, nil,
+			func(n *ScheduledMessage, e *Conversation) { n.Edges.Conversation = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := smq.withSender; query != nil {
+		if err := smq.loadSender(ctx, query, nodes, nil,
+			func(n *ScheduledMessage, e *User) { n.Edges.Sender = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := smq.withMessage; query != nil {
+		if err := smq.loadMessage(ctx, query, nodes, nil,
+			func(n *ScheduledMessage, e *Message) { n.Edges.Message = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (smq *ScheduledMessageQuery) loadConversation(ctx context.Context, query *ConversationQuery, nodes []*ScheduledMessage, init func(*ScheduledMessage), assign func(*ScheduledMessage, *Conversation)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*ScheduledMessage)
+	for i := range nodes {
+		fk := nodes[i].ConversationID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(conversation.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "conversation_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (smq *ScheduledMessageQuery) loadSender(ctx context.Context, query *UserQuery, nodes []*ScheduledMessage, init func(*ScheduledMessage), assign func(*ScheduledMessage, *User)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*ScheduledMessage)
+	for i := range nodes {
+		fk := nodes[i].SenderID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "sender_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (smq *ScheduledMessageQuery) loadMessage(ctx context.Context, query *MessageQuery, nodes []*ScheduledMessage, init func(*ScheduledMessage), assign func(*ScheduledMessage, *Message)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*ScheduledMessage)
+	for i := range nodes {
+		fk := nodes[i].MessageID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(message.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "message_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (smq *ScheduledMessageQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := smq.querySpec()
+	_spec.Node.Columns = smq.ctx.Fields
+	if len(smq.ctx.Fields) > 0 {
+		_spec.Unique = smq.ctx.Unique != nil && *smq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, smq.driver, _spec)
+}
+
+func (smq *ScheduledMessageQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(scheduledmessage.Table, scheduledmessage.Columns, sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeString))
+	_spec.From = smq.sql
+	if unique := smq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if smq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := smq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scheduledmessage.FieldID)
+		for i := range fields {
+			if fields[i] != scheduledmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if smq.withConversation != nil {
+			_spec.Node.AddColumnOnce(scheduledmessage.FieldConversationID)
+		}
+		if smq.withSender != nil {
+			_spec.Node.AddColumnOnce(scheduledmessage.FieldSenderID)
+		}
+		if smq.withMessage != nil {
+			_spec.Node.AddColumnOnce(scheduledmessage.FieldMessageID)
+		}
+	}
+	if ps := smq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := smq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := smq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := smq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (smq *ScheduledMessageQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(smq.driver.Dialect())
+	t1 := builder.Table(scheduledmessage.Table)
+	columns := smq.ctx.Fields
+	if len(columns) == 0 {
+		columns = scheduledmessage.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if smq.sql != nil {
+		selector = smq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if smq.ctx.Unique != nil && *smq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range smq.predicates {
+		p(selector)
+	}
+	for _, p := range smq.order {
+		p(selector)
+	}
+	if offset := smq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := smq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ScheduledMessageGroupBy is the group-by builder for ScheduledMessage entities.
+type ScheduledMessageGroupBy struct {
+	selector
+	build *ScheduledMessageQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (smgb *ScheduledMessageGroupBy) Aggregate(fns ...AggregateFunc) *ScheduledMessageGroupBy {
+	smgb.fns = append(smgb.fns, fns...)
+	return smgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (smgb *ScheduledMessageGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, smgb.build.ctx, ent.OpQueryGroupBy)
+	if err := smgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ScheduledMessageQuery, *ScheduledMessageGroupBy](ctx, smgb.build, smgb, smgb.build.inters, v)
+}
+
+func (smgb *ScheduledMessageGroupBy) sqlScan(ctx context.Context, root *ScheduledMessageQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(smgb.fns))
+	for _, fn := range smgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*smgb.flds)+len(smgb.fns))
+		for _, f := range *smgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*smgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := smgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ScheduledMessageSelect is the builder for selecting fields of ScheduledMessage entities.
+type ScheduledMessageSelect struct {
+	*ScheduledMessageQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sms *ScheduledMessageSelect) Aggregate(fns ...AggregateFunc) *ScheduledMessageSelect {
+	sms.fns = append(sms.fns, fns...)
+	return sms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sms *ScheduledMessageSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sms.ctx, ent.OpQuerySelect)
+	if err := sms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ScheduledMessageQuery, *ScheduledMessageSelect](ctx, sms.ScheduledMessageQuery, sms, sms.inters, v)
+}
+
+func (sms *ScheduledMessageSelect) sqlScan(ctx context.Context, root *ScheduledMessageQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sms.fns))
+	for _, fn := range sms.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
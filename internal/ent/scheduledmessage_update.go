@@ -0,0 +1,1051 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/scheduledmessage"
+	"kakashi/chaos/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ScheduledMessageUpdate is the builder for updating ScheduledMessage entities.
+type ScheduledMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ScheduledMessageMutation
+}
+
+// Where appends a list predicates to the ScheduledMessageUpdate builder.
+func (smu *ScheduledMessageUpdate) Where(ps ...predicate.ScheduledMessage) *ScheduledMessageUpdate {
+	smu.mutation.Where(ps...)
+	return smu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (smu *ScheduledMessageUpdate) SetCreatedAt(t time.Time) *ScheduledMessageUpdate {
+	smu.mutation.SetCreatedAt(t)
+	return smu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableCreatedAt(t *time.Time) *ScheduledMessageUpdate {
+	if t != nil {
+		smu.SetCreatedAt(*t)
+	}
+	return smu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (smu *ScheduledMessageUpdate) SetUpdatedAt(t time.Time) *ScheduledMessageUpdate {
+	smu.mutation.SetUpdatedAt(t)
+	return smu
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (smu *ScheduledMessageUpdate) SetConversationID(s string) *ScheduledMessageUpdate {
+	smu.mutation.SetConversationID(s)
+	return smu
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableConversationID(s *string) *ScheduledMessageUpdate {
+	if s != nil {
+		smu.SetConversationID(*s)
+	}
+	return smu
+}
+
+// SetSenderID sets the "sender_id" field.
+func (smu *ScheduledMessageUpdate) SetSenderID(s string) *ScheduledMessageUpdate {
+	smu.mutation.SetSenderID(s)
+	return smu
+}
+
+// SetNillableSenderID sets the "sender_id" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableSenderID(s *string) *ScheduledMessageUpdate {
+	if s != nil {
+		smu.SetSenderID(*s)
+	}
+	return smu
+}
+
+// SetContent sets the "content" field.
+func (smu *ScheduledMessageUpdate) SetContent(s string) *ScheduledMessageUpdate {
+	smu.mutation.SetContent(s)
+	return smu
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableContent(s *string) *ScheduledMessageUpdate {
+	if s != nil {
+		smu.SetContent(*s)
+	}
+	return smu
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (smu *ScheduledMessageUpdate) SetReplyToID(s string) *ScheduledMessageUpdate {
+	smu.mutation.SetReplyToID(s)
+	return smu
+}
+
+// SetNillableReplyToID sets the "reply_to_id" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableReplyToID(s *string) *ScheduledMessageUpdate {
+	if s != nil {
+		smu.SetReplyToID(*s)
+	}
+	return smu
+}
+
+// ClearReplyToID clears the value of the "reply_to_id" field.
+func (smu *ScheduledMessageUpdate) ClearReplyToID() *ScheduledMessageUpdate {
+	smu.mutation.ClearReplyToID()
+	return smu
+}
+
+// SetSuppressEmbeds sets the "suppress_embeds" field.
+func (smu *ScheduledMessageUpdate) SetSuppressEmbeds(b bool) *ScheduledMessageUpdate {
+	smu.mutation.SetSuppressEmbeds(b)
+	return smu
+}
+
+// SetNillableSuppressEmbeds sets the "suppress_embeds" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableSuppressEmbeds(b *bool) *ScheduledMessageUpdate {
+	if b != nil {
+		smu.SetSuppressEmbeds(*b)
+	}
+	return smu
+}
+
+// SetSendAt sets the "send_at" field.
+func (smu *ScheduledMessageUpdate) SetSendAt(t time.Time) *ScheduledMessageUpdate {
+	smu.mutation.SetSendAt(t)
+	return smu
+}
+
+// SetNillableSendAt sets the "send_at" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableSendAt(t *time.Time) *ScheduledMessageUpdate {
+	if t != nil {
+		smu.SetSendAt(*t)
+	}
+	return smu
+}
+
+// SetStatus sets the "status" field.
+func (smu *ScheduledMessageUpdate) SetStatus(s scheduledmessage.Status) *ScheduledMessageUpdate {
+	smu.mutation.SetStatus(s)
+	return smu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableStatus(s *scheduledmessage.Status) *ScheduledMessageUpdate {
+	if s != nil {
+		smu.SetStatus(*s)
+	}
+	return smu
+}
+
+// SetMessageID sets the "message_id" field.
+func (smu *ScheduledMessageUpdate) SetMessageID(s string) *ScheduledMessageUpdate {
+	smu.mutation.SetMessageID(s)
+	return smu
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableMessageID(s *string) *ScheduledMessageUpdate {
+	if s != nil {
+		smu.SetMessageID(*s)
+	}
+	return smu
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (smu *ScheduledMessageUpdate) ClearMessageID() *ScheduledMessageUpdate {
+	smu.mutation.ClearMessageID()
+	return smu
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (smu *ScheduledMessageUpdate) SetFailureReason(s string) *ScheduledMessageUpdate {
+	smu.mutation.SetFailureReason(s)
+	return smu
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableFailureReason(s *string) *ScheduledMessageUpdate {
+	if s != nil {
+		smu.SetFailureReason(*s)
+	}
+	return smu
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (smu *ScheduledMessageUpdate) ClearFailureReason() *ScheduledMessageUpdate {
+	smu.mutation.ClearFailureReason()
+	return smu
+}
+
+// SetAttempts sets the "attempts" field.
+func (smu *ScheduledMessageUpdate) SetAttempts(i int) *ScheduledMessageUpdate {
+	smu.mutation.ResetAttempts()
+	smu.mutation.SetAttempts(i)
+	return smu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableAttempts(i *int) *ScheduledMessageUpdate {
+	if i != nil {
+		smu.SetAttempts(*i)
+	}
+	return smu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (smu *ScheduledMessageUpdate) AddAttempts(i int) *ScheduledMessageUpdate {
+	smu.mutation.AddAttempts(i)
+	return smu
+}
+
+// SetClaimToken sets the "claim_token" field.
+func (smu *ScheduledMessageUpdate) SetClaimToken(s string) *ScheduledMessageUpdate {
+	smu.mutation.SetClaimToken(s)
+	return smu
+}
+
+// SetNillableClaimToken sets the "claim_token" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableClaimToken(s *string) *ScheduledMessageUpdate {
+	if s != nil {
+		smu.SetClaimToken(*s)
+	}
+	return smu
+}
+
+// ClearClaimToken clears the value of the "claim_token" field.
+func (smu *ScheduledMessageUpdate) ClearClaimToken() *ScheduledMessageUpdate {
+	smu.mutation.ClearClaimToken()
+	return smu
+}
+
+// SetClaimExpiresAt sets the "claim_expires_at" field.
+func (smu *ScheduledMessageUpdate) SetClaimExpiresAt(t time.Time) *ScheduledMessageUpdate {
+	smu.mutation.SetClaimExpiresAt(t)
+	return smu
+}
+
+// SetNillableClaimExpiresAt sets the "claim_expires_at" field if the given value is not nil.
+func (smu *ScheduledMessageUpdate) SetNillableClaimExpiresAt(t *time.Time) *ScheduledMessageUpdate {
+	if t != nil {
+		smu.SetClaimExpiresAt(*t)
+	}
+	return smu
+}
+
+// ClearClaimExpiresAt clears the value of the "claim_expires_at" field.
+func (smu *ScheduledMessageUpdate) ClearClaimExpiresAt() *ScheduledMessageUpdate {
+	smu.mutation.ClearClaimExpiresAt()
+	return smu
+}
+
+// SetConversation sets the "conversation" edge to the Conversation entity.
+func (smu *ScheduledMessageUpdate) SetConversation(c *Conversation) *ScheduledMessageUpdate {
+	return smu.SetConversationID(c.ID)
+}
+
+// SetSender sets the "sender" edge to the User entity.
+func (smu *ScheduledMessageUpdate) SetSender(u *User) *ScheduledMessageUpdate {
+	return smu.SetSenderID(u.ID)
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (smu *ScheduledMessageUpdate) SetMessage(m *Message) *ScheduledMessageUpdate {
+	return smu.SetMessageID(m.ID)
+}
+
+// Mutation returns the ScheduledMessageMutation object of the builder.
+func (smu *ScheduledMessageUpdate) Mutation() *ScheduledMessageMutation {
+	return smu.mutation
+}
+
+// ClearConversation clears the "conversation" edge to the Conversation entity.
+func (smu *ScheduledMessageUpdate) ClearConversation() *ScheduledMessageUpdate {
+	smu.mutation.ClearConversation()
+	return smu
+}
+
+// ClearSender clears the "sender" edge to the User entity.
+func (smu *ScheduledMessageUpdate) ClearSender() *ScheduledMessageUpdate {
+	smu.mutation.ClearSender()
+	return smu
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (smu *ScheduledMessageUpdate) ClearMessage() *ScheduledMessageUpdate {
+	smu.mutation.ClearMessage()
+	return smu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (smu *ScheduledMessageUpdate) Save(ctx context.Context) (int, error) {
+	smu.defaults()
+	return withHooks(ctx, smu.sqlSave, smu.mutation, smu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (smu *ScheduledMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := smu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (smu *ScheduledMessageUpdate) Exec(ctx context.Context) error {
+	_, err := smu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smu *ScheduledMessageUpdate) ExecX(ctx context.Context) {
+	if err := smu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (smu *ScheduledMessageUpdate) defaults() {
+	if _, ok := smu.mutation.UpdatedAt(); !ok {
+		v := scheduledmessage.UpdateDefaultUpdatedAt()
+		smu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (smu *ScheduledMessageUpdate) check() error {
+	if v, ok := smu.mutation.ConversationID(); ok {
+		if err := scheduledmessage.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.conversation_id": %w`, err)}
+		}
+	}
+	if v, ok := smu.mutation.SenderID(); ok {
+		if err := scheduledmessage.SenderIDValidator(v); err != nil {
+			return &ValidationError{Name: "sender_id", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.sender_id": %w`, err)}
+		}
+	}
+	if v, ok := smu.mutation.Content(); ok {
+		if err := scheduledmessage.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.content": %w`, err)}
+		}
+	}
+	if v, ok := smu.mutation.Status(); ok {
+		if err := scheduledmessage.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.status": %w`, err)}
+		}
+	}
+	if smu.mutation.ConversationCleared() && len(smu.mutation.ConversationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScheduledMessage.conversation"`)
+	}
+	if smu.mutation.SenderCleared() && len(smu.mutation.SenderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScheduledMessage.sender"`)
+	}
+	return nil
+}
+
+func (smu *ScheduledMessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := smu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scheduledmessage.Table, scheduledmessage.Columns, sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeString))
+	if ps := smu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := smu.mutation.CreatedAt(); ok {
+		_spec.SetField(scheduledmessage.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := smu.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledmessage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := smu.mutation.Content(); ok {
+		_spec.SetField(scheduledmessage.FieldContent, field.TypeString, value)
+	}
+	if value, ok := smu.mutation.ReplyToID(); ok {
+		_spec.SetField(scheduledmessage.FieldReplyToID, field.TypeString, value)
+	}
+	if smu.mutation.ReplyToIDCleared() {
+		_spec.ClearField(scheduledmessage.FieldReplyToID, field.TypeString)
+	}
+	if value, ok := smu.mutation.SuppressEmbeds(); ok {
+		_spec.SetField(scheduledmessage.FieldSuppressEmbeds, field.TypeBool, value)
+	}
+	if value, ok := smu.mutation.SendAt(); ok {
+		_spec.SetField(scheduledmessage.FieldSendAt, field.TypeTime, value)
+	}
+	if value, ok := smu.mutation.Status(); ok {
+		_spec.SetField(scheduledmessage.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := smu.mutation.FailureReason(); ok {
+		_spec.SetField(scheduledmessage.FieldFailureReason, field.TypeString, value)
+	}
+	if smu.mutation.FailureReasonCleared() {
+		_spec.ClearField(scheduledmessage.FieldFailureReason, field.TypeString)
+	}
+	if value, ok := smu.mutation.Attempts(); ok {
+		_spec.SetField(scheduledmessage.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := smu.mutation.AddedAttempts(); ok {
+		_spec.AddField(scheduledmessage.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := smu.mutation.ClaimToken(); ok {
+		_spec.SetField(scheduledmessage.FieldClaimToken, field.TypeString, value)
+	}
+	if smu.mutation.ClaimTokenCleared() {
+		_spec.ClearField(scheduledmessage.FieldClaimToken, field.TypeString)
+	}
+	if value, ok := smu.mutation.ClaimExpiresAt(); ok {
+		_spec.SetField(scheduledmessage.FieldClaimExpiresAt, field.TypeTime, value)
+	}
+	if smu.mutation.ClaimExpiresAtCleared() {
+		_spec.ClearField(scheduledmessage.FieldClaimExpiresAt, field.TypeTime)
+	}
+	if smu.mutation.ConversationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledmessage.ConversationTable,
+			Columns: []string{scheduledmessage.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := smu.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledmessage.ConversationTable,
+			Columns: []string{scheduledmessage.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if smu.mutation.SenderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledmessage.SenderTable,
+			Columns: []string{scheduledmessage.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := smu.mutation.SenderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledmessage.SenderTable,
+			Columns: []string{scheduledmessage.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if smu.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledmessage.MessageTable,
+			Columns: []string{scheduledmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := smu.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledmessage.MessageTable,
+			Columns: []string{scheduledmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, smu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduledmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	smu.mutation.done = true
+	return n, nil
+}
+
+// ScheduledMessageUpdateOne is the builder for updating a single ScheduledMessage entity.
+type ScheduledMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ScheduledMessageMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (smuo *ScheduledMessageUpdateOne) SetCreatedAt(t time.Time) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetCreatedAt(t)
+	return smuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableCreatedAt(t *time.Time) *ScheduledMessageUpdateOne {
+	if t != nil {
+		smuo.SetCreatedAt(*t)
+	}
+	return smuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (smuo *ScheduledMessageUpdateOne) SetUpdatedAt(t time.Time) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetUpdatedAt(t)
+	return smuo
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (smuo *ScheduledMessageUpdateOne) SetConversationID(s string) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetConversationID(s)
+	return smuo
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableConversationID(s *string) *ScheduledMessageUpdateOne {
+	if s != nil {
+		smuo.SetConversationID(*s)
+	}
+	return smuo
+}
+
+// SetSenderID sets the "sender_id" field.
+func (smuo *ScheduledMessageUpdateOne) SetSenderID(s string) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetSenderID(s)
+	return smuo
+}
+
+// SetNillableSenderID sets the "sender_id" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableSenderID(s *string) *ScheduledMessageUpdateOne {
+	if s != nil {
+		smuo.SetSenderID(*s)
+	}
+	return smuo
+}
+
+// SetContent sets the "content" field.
+func (smuo *ScheduledMessageUpdateOne) SetContent(s string) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetContent(s)
+	return smuo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableContent(s *string) *ScheduledMessageUpdateOne {
+	if s != nil {
+		smuo.SetContent(*s)
+	}
+	return smuo
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (smuo *ScheduledMessageUpdateOne) SetReplyToID(s string) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetReplyToID(s)
+	return smuo
+}
+
+// SetNillableReplyToID sets the "reply_to_id" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableReplyToID(s *string) *ScheduledMessageUpdateOne {
+	if s != nil {
+		smuo.SetReplyToID(*s)
+	}
+	return smuo
+}
+
+// ClearReplyToID clears the value of the "reply_to_id" field.
+func (smuo *ScheduledMessageUpdateOne) ClearReplyToID() *ScheduledMessageUpdateOne {
+	smuo.mutation.ClearReplyToID()
+	return smuo
+}
+
+// SetSuppressEmbeds sets the "suppress_embeds" field.
+func (smuo *ScheduledMessageUpdateOne) SetSuppressEmbeds(b bool) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetSuppressEmbeds(b)
+	return smuo
+}
+
+// SetNillableSuppressEmbeds sets the "suppress_embeds" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableSuppressEmbeds(b *bool) *ScheduledMessageUpdateOne {
+	if b != nil {
+		smuo.SetSuppressEmbeds(*b)
+	}
+	return smuo
+}
+
+// SetSendAt sets the "send_at" field.
+func (smuo *ScheduledMessageUpdateOne) SetSendAt(t time.Time) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetSendAt(t)
+	return smuo
+}
+
+// SetNillableSendAt sets the "send_at" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableSendAt(t *time.Time) *ScheduledMessageUpdateOne {
+	if t != nil {
+		smuo.SetSendAt(*t)
+	}
+	return smuo
+}
+
+// SetStatus sets the "status" field.
+func (smuo *ScheduledMessageUpdateOne) SetStatus(s scheduledmessage.Status) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetStatus(s)
+	return smuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableStatus(s *scheduledmessage.Status) *ScheduledMessageUpdateOne {
+	if s != nil {
+		smuo.SetStatus(*s)
+	}
+	return smuo
+}
+
+// SetMessageID sets the "message_id" field.
+func (smuo *ScheduledMessageUpdateOne) SetMessageID(s string) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetMessageID(s)
+	return smuo
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableMessageID(s *string) *ScheduledMessageUpdateOne {
+	if s != nil {
+		smuo.SetMessageID(*s)
+	}
+	return smuo
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (smuo *ScheduledMessageUpdateOne) ClearMessageID() *ScheduledMessageUpdateOne {
+	smuo.mutation.ClearMessageID()
+	return smuo
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (smuo *ScheduledMessageUpdateOne) SetFailureReason(s string) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetFailureReason(s)
+	return smuo
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableFailureReason(s *string) *ScheduledMessageUpdateOne {
+	if s != nil {
+		smuo.SetFailureReason(*s)
+	}
+	return smuo
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (smuo *ScheduledMessageUpdateOne) ClearFailureReason() *ScheduledMessageUpdateOne {
+	smuo.mutation.ClearFailureReason()
+	return smuo
+}
+
+// SetAttempts sets the "attempts" field.
+func (smuo *ScheduledMessageUpdateOne) SetAttempts(i int) *ScheduledMessageUpdateOne {
+	smuo.mutation.ResetAttempts()
+	smuo.mutation.SetAttempts(i)
+	return smuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableAttempts(i *int) *ScheduledMessageUpdateOne {
+	if i != nil {
+		smuo.SetAttempts(*i)
+	}
+	return smuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (smuo *ScheduledMessageUpdateOne) AddAttempts(i int) *ScheduledMessageUpdateOne {
+	smuo.mutation.AddAttempts(i)
+	return smuo
+}
+
+// SetClaimToken sets the "claim_token" field.
+func (smuo *ScheduledMessageUpdateOne) SetClaimToken(s string) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetClaimToken(s)
+	return smuo
+}
+
+// SetNillableClaimToken sets the "claim_token" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableClaimToken(s *string) *ScheduledMessageUpdateOne {
+	if s != nil {
+		smuo.SetClaimToken(*s)
+	}
+	return smuo
+}
+
+// ClearClaimToken clears the value of the "claim_token" field.
+func (smuo *ScheduledMessageUpdateOne) ClearClaimToken() *ScheduledMessageUpdateOne {
+	smuo.mutation.ClearClaimToken()
+	return smuo
+}
+
+// SetClaimExpiresAt sets the "claim_expires_at" field.
+func (smuo *ScheduledMessageUpdateOne) SetClaimExpiresAt(t time.Time) *ScheduledMessageUpdateOne {
+	smuo.mutation.SetClaimExpiresAt(t)
+	return smuo
+}
+
+// SetNillableClaimExpiresAt sets the "claim_expires_at" field if the given value is not nil.
+func (smuo *ScheduledMessageUpdateOne) SetNillableClaimExpiresAt(t *time.Time) *ScheduledMessageUpdateOne {
+	if t != nil {
+		smuo.SetClaimExpiresAt(*t)
+	}
+	return smuo
+}
+
+// ClearClaimExpiresAt clears the value of the "claim_expires_at" field.
+func (smuo *ScheduledMessageUpdateOne) ClearClaimExpiresAt() *ScheduledMessageUpdateOne {
+	smuo.mutation.ClearClaimExpiresAt()
+	return smuo
+}
+
+// SetConversation sets the "conversation" edge to the Conversation entity.
+func (smuo *ScheduledMessageUpdateOne) SetConversation(c *Conversation) *ScheduledMessageUpdateOne {
+	return smuo.SetConversationID(c.ID)
+}
+
+// SetSender sets the "sender" edge to the User entity.
+func (smuo *ScheduledMessageUpdateOne) SetSender(u *User) *ScheduledMessageUpdateOne {
+	return smuo.SetSenderID(u.ID)
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (smuo *ScheduledMessageUpdateOne) SetMessage(m *Message) *ScheduledMessageUpdateOne {
+	return smuo.SetMessageID(m.ID)
+}
+
+// Mutation returns the ScheduledMessageMutation object of the builder.
+func (smuo *ScheduledMessageUpdateOne) Mutation() *ScheduledMessageMutation {
+	return smuo.mutation
+}
+
+// ClearConversation clears the "conversation" edge to the Conversation entity.
+func (smuo *ScheduledMessageUpdateOne) ClearConversation() *ScheduledMessageUpdateOne {
+	smuo.mutation.ClearConversation()
+	return smuo
+}
+
+// ClearSender clears the "sender" edge to the User entity.
+func (smuo *ScheduledMessageUpdateOne) ClearSender() *ScheduledMessageUpdateOne {
+	smuo.mutation.ClearSender()
+	return smuo
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (smuo *ScheduledMessageUpdateOne) ClearMessage() *ScheduledMessageUpdateOne {
+	smuo.mutation.ClearMessage()
+	return smuo
+}
+
+// Where appends a list predicates to the ScheduledMessageUpdate builder.
+func (smuo *ScheduledMessageUpdateOne) Where(ps ...predicate.ScheduledMessage) *ScheduledMessageUpdateOne {
+	smuo.mutation.Where(ps...)
+	return smuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (smuo *ScheduledMessageUpdateOne) Select(field string, fields ...string) *ScheduledMessageUpdateOne {
+	smuo.fields = append([]string{field}, fields...)
+	return smuo
+}
+
+// Save executes the query and returns the updated ScheduledMessage entity.
+func (smuo *ScheduledMessageUpdateOne) Save(ctx context.Context) (*ScheduledMessage, error) {
+	smuo.defaults()
+	return withHooks(ctx, smuo.sqlSave, smuo.mutation, smuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (smuo *ScheduledMessageUpdateOne) SaveX(ctx context.Context) *ScheduledMessage {
+	node, err := smuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (smuo *ScheduledMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := smuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smuo *ScheduledMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := smuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (smuo *ScheduledMessageUpdateOne) defaults() {
+	if _, ok := smuo.mutation.UpdatedAt(); !ok {
+		v := scheduledmessage.UpdateDefaultUpdatedAt()
+		smuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (smuo *ScheduledMessageUpdateOne) check() error {
+	if v, ok := smuo.mutation.ConversationID(); ok {
+		if err := scheduledmessage.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.conversation_id": %w`, err)}
+		}
+	}
+	if v, ok := smuo.mutation.SenderID(); ok {
+		if err := scheduledmessage.SenderIDValidator(v); err != nil {
+			return &ValidationError{Name: "sender_id", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.sender_id": %w`, err)}
+		}
+	}
+	if v, ok := smuo.mutation.Content(); ok {
+		if err := scheduledmessage.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.content": %w`, err)}
+		}
+	}
+	if v, ok := smuo.mutation.Status(); ok {
+		if err := scheduledmessage.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.status": %w`, err)}
+		}
+	}
+	if smuo.mutation.ConversationCleared() && len(smuo.mutation.ConversationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScheduledMessage.conversation"`)
+	}
+	if smuo.mutation.SenderCleared() && len(smuo.mutation.SenderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScheduledMessage.sender"`)
+	}
+	return nil
+}
+
+func (smuo *ScheduledMessageUpdateOne) sqlSave(ctx context.Context) (_node *ScheduledMessage, err error) {
+	if err := smuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scheduledmessage.Table, scheduledmessage.Columns, sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeString))
+	id, ok := smuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ScheduledMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := smuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scheduledmessage.FieldID)
+		for _, f := range fields {
+			if !scheduledmessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != scheduledmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := smuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := smuo.mutation.CreatedAt(); ok {
+		_spec.SetField(scheduledmessage.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := smuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledmessage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := smuo.mutation.Content(); ok {
+		_spec.SetField(scheduledmessage.FieldContent, field.TypeString, value)
+	}
+	if value, ok := smuo.mutation.ReplyToID(); ok {
+		_spec.SetField(scheduledmessage.FieldReplyToID, field.TypeString, value)
+	}
+	if smuo.mutation.ReplyToIDCleared() {
+		_spec.ClearField(scheduledmessage.FieldReplyToID, field.TypeString)
+	}
+	if value, ok := smuo.mutation.SuppressEmbeds(); ok {
+		_spec.SetField(scheduledmessage.FieldSuppressEmbeds, field.TypeBool, value)
+	}
+	if value, ok := smuo.mutation.SendAt(); ok {
+		_spec.SetField(scheduledmessage.FieldSendAt, field.TypeTime, value)
+	}
+	if value, ok := smuo.mutation.Status(); ok {
+		_spec.SetField(scheduledmessage.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := smuo.mutation.FailureReason(); ok {
+		_spec.SetField(scheduledmessage.FieldFailureReason, field.TypeString, value)
+	}
+	if smuo.mutation.FailureReasonCleared() {
+		_spec.ClearField(scheduledmessage.FieldFailureReason, field.TypeString)
+	}
+	if value, ok := smuo.mutation.Attempts(); ok {
+		_spec.SetField(scheduledmessage.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := smuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(scheduledmessage.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := smuo.mutation.ClaimToken(); ok {
+		_spec.SetField(scheduledmessage.FieldClaimToken, field.TypeString, value)
+	}
+	if smuo.mutation.ClaimTokenCleared() {
+		_spec.ClearField(scheduledmessage.FieldClaimToken, field.TypeString)
+	}
+	if value, ok := smuo.mutation.ClaimExpiresAt(); ok {
+		_spec.SetField(scheduledmessage.FieldClaimExpiresAt, field.TypeTime, value)
+	}
+	if smuo.mutation.ClaimExpiresAtCleared() {
+		_spec.ClearField(scheduledmessage.FieldClaimExpiresAt, field.TypeTime)
+	}
+	if smuo.mutation.ConversationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledmessage.ConversationTable,
+			Columns: []string{scheduledmessage.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := smuo.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledmessage.ConversationTable,
+			Columns: []string{scheduledmessage.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if smuo.mutation.SenderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledmessage.SenderTable,
+			Columns: []string{scheduledmessage.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := smuo.mutation.SenderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledmessage.SenderTable,
+			Columns: []string{scheduledmessage.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if smuo.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledmessage.MessageTable,
+			Columns: []string{scheduledmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := smuo.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledmessage.MessageTable,
+			Columns: []string{scheduledmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ScheduledMessage{config: smuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, smuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduledmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	smuo.mutation.done = true
+	return _node, nil
+}
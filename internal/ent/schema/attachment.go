@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Attachment holds the schema definition for the Attachment entity.
+type Attachment struct {
+	ent.Schema
+}
+
+func (Attachment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the Attachment.
+func (Attachment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("message_id").NotEmpty(),
+		field.String("uploader_id").NotEmpty(),
+		field.String("storage_key").NotEmpty().Unique().Sensitive(),
+		field.String("filename").NotEmpty().MaxLen(255),
+		field.String("content_type").NotEmpty().Comment("MIME type sniffed from the uploaded content"),
+		field.Int64("size").NonNegative(),
+		// Image processing results
+		field.Enum("processing_status").Values("none", "pending", "done", "failed").Default("none"),
+		field.Int("width").Optional(),
+		field.Int("height").Optional(),
+		field.String("blurhash").Optional(),
+		field.String("thumbnail_key").Optional().Sensitive(),
+		field.String("thumbnail_content_type").Optional(),
+	}
+}
+
+// Edges of the Attachment.
+func (Attachment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("message", Message.Type).Unique().Required().Field("message_id"),
+		edge.To("uploader", User.Type).Unique().Required().Field("uploader_id"),
+	}
+}
+
+// Indexes of the Attachment.
+func (Attachment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("message_id"),
+		index.Fields("uploader_id"),
+		index.Fields("processing_status"),
+	}
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Bookmark holds the schema definition for the Bookmark entity.
+type Bookmark struct {
+	ent.Schema
+}
+
+func (Bookmark) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the Bookmark.
+func (Bookmark) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user_id").NotEmpty(),
+		field.String("message_id").Optional().Comment("Saved message, cleared when the message is hard deleted so the bookmark stays as a tombstone"),
+		field.String("conversation_id").Optional().Comment("Conversation of the saved message, kept for filtering after the message is gone"),
+		field.Text("note").Optional(),
+		field.Time("remind_at").Optional().Comment("When to remind the user of the message, cleared once the reminder is sent"),
+		field.Time("reminded_at").Optional(),
+	}
+}
+
+// Edges of the Bookmark.
+func (Bookmark) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).Unique().Required().Field("user_id"),
+		edge.To("message", Message.Type).Unique().Field("message_id"),
+	}
+}
+
+// Indexes of the Bookmark.
+func (Bookmark) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "message_id").Unique(),
+		index.Fields("user_id", "created_at"),
+		index.Fields("user_id", "conversation_id", "created_at"),
+		index.Fields("remind_at"),
+		index.Fields("message_id"),
+	}
+}
@@ -23,7 +23,11 @@ func (Conversation) Fields() []ent.Field {
 	return []ent.Field{
 		field.Enum("type").Values("direct", "group").Default("direct"),
 		field.String("name").Optional().MaxLen(100),
+		field.String("icon_url").Optional(),
+		field.String("direct_key").Optional().Unique().Comment("Sorted participant pair for direct conversations, guarantees one conversation per pair"),
 		field.Time("last_message_at").Optional(),
+		field.Int("message_ttl").Default(0).NonNegative().Comment("Seconds after which messages disappear, 0 keeps messages"),
+		field.Time("message_ttl_set_at").Optional().Comment("When disappearing messages were turned on, only messages sent since then disappear"),
 		field.Bool("is_archived").Default(false),
 		field.Bool("is_muted").Default(false),
 	}
@@ -34,6 +38,10 @@ func (Conversation) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("messages", Message.Type),
 		edge.To("participants", ConversationParticipant.Type),
+		edge.From("pins", PinnedMessage.Type).Ref("conversation"),
+		edge.From("scheduled_messages", ScheduledMessage.Type).Ref("conversation"),
+		edge.From("drafts", Draft.Type).Ref("conversation"),
+		edge.From("folder_entries", FolderConversation.Type).Ref("conversation"),
 	}
 }
 
@@ -46,5 +54,6 @@ func (Conversation) Indexes() []ent.Index {
 		index.Fields("is_archived"),
 		index.Fields("is_muted"),
 		index.Fields("created_at"),
+		index.Fields("message_ttl"),
 	}
 }
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ConversationFolder holds the schema definition for the ConversationFolder entity.
+type ConversationFolder struct {
+	ent.Schema
+}
+
+func (ConversationFolder) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the ConversationFolder.
+func (ConversationFolder) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user_id").NotEmpty(),
+		field.String("name").NotEmpty().MaxLen(50),
+		field.Int("position").Default(0).NonNegative().Comment("Place of the folder in the user's folder list, lowest first"),
+	}
+}
+
+// Edges of the ConversationFolder.
+func (ConversationFolder) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).Unique().Required().Field("user_id"),
+		edge.From("entries", FolderConversation.Type).Ref("folder"),
+	}
+}
+
+// Indexes of the ConversationFolder.
+func (ConversationFolder) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "name").Unique(),
+		index.Fields("user_id", "position"),
+	}
+}
@@ -25,10 +25,15 @@ func (ConversationParticipant) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("conversation_id").NotEmpty(),
 		field.String("user_id").NotEmpty(),
+		field.Enum("role").Values("owner", "admin", "member").Default("member"),
 		field.Time("joined_at").Default(time.Now),
 		field.Time("last_read_at").Optional(),
 		field.Bool("is_archived").Default(false),
-		field.Bool("is_muted").Default(false),
+		field.Enum("notification_level").Values("all", "mentions", "nothing").Default("all"),
+		field.Time("muted_until").Optional().Comment("When a mute ends and the level reverts to all, unset keeps it until changed"),
+		field.Bool("show_when_muted").Default(false).Comment("Keeps the conversation in the default conversation list while muted"),
+		field.Time("pinned_at").Optional().Comment("When the user pinned the conversation to the top of their list, unset when not pinned"),
+		field.Int("pin_position").Default(0).NonNegative().Comment("Place among the user's pinned conversations, lowest first"),
 	}
 }
 
@@ -45,12 +50,13 @@ func (ConversationParticipant) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("conversation_id", "user_id").Unique(),
 		index.Fields("user_id"),
+		index.Fields("conversation_id", "role"),
 		index.Fields("user_id", "is_archived"),
-		index.Fields("user_id", "is_muted"),
-		index.Fields("user_id", "is_archived", "is_muted"),
+		index.Fields("user_id", "notification_level"),
+		index.Fields("user_id", "is_archived", "notification_level"),
+		index.Fields("user_id", "pinned_at"),
 		index.Fields("last_read_at"),
 		index.Fields("is_archived"),
-		index.Fields("is_muted"),
 		index.Fields("joined_at"),
 	}
 }
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Draft holds the schema definition for the Draft entity.
+type Draft struct {
+	ent.Schema
+}
+
+func (Draft) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the Draft.
+func (Draft) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user_id").NotEmpty(),
+		field.String("conversation_id").NotEmpty(),
+		field.Text("content").Optional(),
+		field.String("reply_to_id").Optional().Comment("Message the draft replies to, not enforced as a reference so drafts outlive deleted targets"),
+	}
+}
+
+// Edges of the Draft.
+func (Draft) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).Unique().Required().Field("user_id"),
+		edge.To("conversation", Conversation.Type).Unique().Required().Field("conversation_id"),
+	}
+}
+
+// Indexes of the Draft.
+func (Draft) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "conversation_id").Unique(),
+		index.Fields("updated_at"),
+	}
+}
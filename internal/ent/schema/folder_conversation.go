@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// FolderConversation holds the schema definition for the FolderConversation entity.
+type FolderConversation struct {
+	ent.Schema
+}
+
+func (FolderConversation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the FolderConversation.
+func (FolderConversation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("folder_id").NotEmpty(),
+		field.String("conversation_id").NotEmpty(),
+	}
+}
+
+// Edges of the FolderConversation.
+func (FolderConversation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("folder", ConversationFolder.Type).Unique().Required().Field("folder_id"),
+		edge.To("conversation", Conversation.Type).Unique().Required().Field("conversation_id"),
+	}
+}
+
+// Indexes of the FolderConversation.
+func (FolderConversation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("folder_id", "conversation_id").Unique(),
+		index.Fields("conversation_id"),
+	}
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// FriendInvite holds the schema definition for the FriendInvite entity.
+type FriendInvite struct {
+	ent.Schema
+}
+
+func (FriendInvite) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the FriendInvite.
+func (FriendInvite) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("owner_id").NotEmpty(),
+		field.String("code").NotEmpty().Unique(),
+		field.Bool("auto_accept").Default(true).Comment("Create an accepted friendship instead of a pending request on redeem"),
+		field.Int("max_uses").Default(0).NonNegative().Comment("Maximum number of redemptions, 0 means unlimited"),
+		field.Int("uses").Default(0).NonNegative(),
+		field.Time("expires_at").Optional(),
+		field.Time("revoked_at").Optional(),
+	}
+}
+
+// Edges of the FriendInvite.
+func (FriendInvite) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("owner", User.Type).Unique().Required().Field("owner_id"),
+		edge.From("redemptions", FriendInviteUse.Type).Ref("invite"),
+	}
+}
+
+// Indexes of the FriendInvite.
+func (FriendInvite) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id"),
+		index.Fields("owner_id", "created_at"),
+	}
+}
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// FriendInviteUse holds the schema definition for the FriendInviteUse entity.
+type FriendInviteUse struct {
+	ent.Schema
+}
+
+func (FriendInviteUse) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the FriendInviteUse.
+func (FriendInviteUse) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("invite_id").NotEmpty(),
+		field.String("user_id").NotEmpty(),
+		field.Enum("result").Values("accepted", "pending"),
+	}
+}
+
+// Edges of the FriendInviteUse.
+func (FriendInviteUse) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("invite", FriendInvite.Type).Unique().Required().Field("invite_id"),
+		edge.To("user", User.Type).Unique().Required().Field("user_id"),
+	}
+}
+
+// Indexes of the FriendInviteUse.
+func (FriendInviteUse) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("invite_id", "user_id").Unique(),
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// HiddenMessage holds the schema definition for the HiddenMessage entity, a message deleted for one user only.
+type HiddenMessage struct {
+	ent.Schema
+}
+
+func (HiddenMessage) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the HiddenMessage.
+func (HiddenMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user_id").NotEmpty(),
+		field.String("message_id").NotEmpty(),
+	}
+}
+
+// Edges of the HiddenMessage.
+func (HiddenMessage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).Unique().Required().Field("user_id"),
+		edge.To("message", Message.Type).Unique().Required().Field("message_id"),
+	}
+}
+
+// Indexes of the HiddenMessage.
+func (HiddenMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "message_id").Unique(),
+		index.Fields("message_id"),
+	}
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LinkPreview holds the schema definition for the LinkPreview entity.
+type LinkPreview struct {
+	ent.Schema
+}
+
+func (LinkPreview) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the LinkPreview.
+func (LinkPreview) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("message_id").NotEmpty(),
+		field.String("url").NotEmpty().MaxLen(2048),
+		field.String("title").Optional(),
+		field.Text("description").Optional(),
+		field.String("image_url").Optional().MaxLen(2048),
+		field.String("site_name").Optional(),
+		field.Int("position").NonNegative().Comment("Order of the URL within the message content"),
+	}
+}
+
+// Edges of the LinkPreview.
+func (LinkPreview) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("message", Message.Type).Unique().Required().Field("message_id"),
+	}
+}
+
+// Indexes of the LinkPreview.
+func (LinkPreview) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("message_id", "position"),
+	}
+}
@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"kakashi/chaos/internal/richtext"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -24,10 +26,21 @@ func (Message) Fields() []ent.Field {
 		field.String("conversation_id").NotEmpty(),
 		field.String("sender_id").NotEmpty(),
 		field.Text("content").NotEmpty(),
-		field.Enum("message_type").Values("text", "image", "file", "call_start", "call_end").Default("text"),
+		field.Text("plain_text").Optional().Comment("Content without markup, unset on messages that were not parsed"),
+		field.JSON("entities", []richtext.Entity{}).Optional().Comment("Formatted ranges of plain_text in UTF-16 code units"),
+		field.Enum("message_type").Values("text", "image", "file", "call_start", "call_end", "system").Default("text"),
 		field.Bool("is_deleted").Default(false),
+		field.Time("deleted_at").Optional().Comment("When the message was soft deleted, used by the retention reaper"),
 		field.Time("edited_at").Optional(),
 		field.String("call_id").Optional().Comment("Reference to call for call_start and call_end messages"),
+		field.String("reply_to_id").Optional().Comment("Message quoted by this reply"),
+		field.String("thread_root_id").Optional().Comment("Root message of the thread this message belongs to"),
+		field.Int("thread_reply_count").Default(0).NonNegative().Comment("Number of thread replies on a root message"),
+		field.Time("thread_last_reply_at").Optional(),
+		field.Bool("suppress_embeds").Default(false).Comment("Set by the sender to hide link previews"),
+		field.String("forwarded_from_message_id").Optional().Comment("Original message of a forward, may no longer exist"),
+		field.String("forwarded_from_user_id").Optional().Comment("Author of the original message of a forward"),
+		field.String("nonce").Optional().MaxLen(128).Comment("Client generated idempotency key, cleared once the dedupe window has passed"),
 	}
 }
 
@@ -37,6 +50,20 @@ func (Message) Edges() []ent.Edge {
 		edge.To("conversation", Conversation.Type).Unique().Required().Field("conversation_id"),
 		edge.To("sender", User.Type).Unique().Required().Field("sender_id"),
 		edge.To("call", Call.Type).Unique().Field("call_id"),
+		edge.To("forwarded_from_user", User.Type).Unique().Field("forwarded_from_user_id"),
+		edge.From("revisions", MessageRevision.Type).Ref("message"),
+		edge.From("reactions", MessageReaction.Type).Ref("message"),
+		edge.From("attachments", Attachment.Type).Ref("message"),
+		edge.From("link_previews", LinkPreview.Type).Ref("message"),
+		edge.From("mentions", MessageMention.Type).Ref("message"),
+		edge.From("pins", PinnedMessage.Type).Ref("message"),
+		edge.From("receipts", MessageReceipt.Type).Ref("message"),
+		edge.From("notifications", Notification.Type).Ref("related_message"),
+		edge.To("replies", Message.Type).From("reply_to").Unique().Field("reply_to_id"),
+		edge.To("thread_replies", Message.Type).From("thread_root").Unique().Field("thread_root_id"),
+		edge.From("thread_participants", ThreadParticipant.Type).Ref("root_message"),
+		edge.From("bookmarks", Bookmark.Type).Ref("message"),
+		edge.From("hidden_for", HiddenMessage.Type).Ref("message"),
 	}
 }
 
@@ -50,6 +77,12 @@ func (Message) Indexes() []ent.Index {
 		index.Fields("is_deleted"),
 		index.Fields("message_type"),
 		index.Fields("created_at"),
+		index.Fields("is_deleted", "deleted_at"),
 		index.Fields("call_id"),
+		index.Fields("reply_to_id"),
+		index.Fields("forwarded_from_message_id"),
+		index.Fields("thread_root_id", "created_at"),
+		// Retries of a send with the same nonce resolve to one message
+		index.Fields("sender_id", "conversation_id", "nonce").Unique(),
 	}
 }
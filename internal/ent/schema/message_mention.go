@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MessageMention holds the schema definition for the MessageMention entity.
+type MessageMention struct {
+	ent.Schema
+}
+
+func (MessageMention) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the MessageMention.
+func (MessageMention) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("message_id").NotEmpty(),
+		field.Enum("kind").Values("user", "everyone"),
+		field.String("user_id").Optional().Comment("Mentioned user, empty for @everyone"),
+		field.Int("offset").NonNegative().Comment("Start of the mention in the content, in UTF-16 code units"),
+		field.Int("length").Positive().Comment("Length of the mention in UTF-16 code units"),
+	}
+}
+
+// Edges of the MessageMention.
+func (MessageMention) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("message", Message.Type).Unique().Required().Field("message_id"),
+		edge.To("user", User.Type).Unique().Field("user_id"),
+	}
+}
+
+// Indexes of the MessageMention.
+func (MessageMention) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("message_id"),
+		index.Fields("user_id", "created_at"),
+		index.Fields("kind"),
+	}
+}
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MessageReaction holds the schema definition for the MessageReaction entity.
+type MessageReaction struct {
+	ent.Schema
+}
+
+func (MessageReaction) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the MessageReaction.
+func (MessageReaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("message_id").NotEmpty(),
+		field.String("user_id").NotEmpty(),
+		field.String("emoji").NotEmpty().MaxLen(64).Comment("Unicode emoji or custom emoji name in :name: form"),
+	}
+}
+
+// Edges of the MessageReaction.
+func (MessageReaction) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("message", Message.Type).Unique().Required().Field("message_id"),
+		edge.To("user", User.Type).Unique().Required().Field("user_id"),
+	}
+}
+
+// Indexes of the MessageReaction.
+func (MessageReaction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("message_id", "user_id", "emoji").Unique(),
+		index.Fields("message_id", "emoji"),
+	}
+}
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MessageReceipt holds the schema definition for the MessageReceipt entity.
+type MessageReceipt struct {
+	ent.Schema
+}
+
+func (MessageReceipt) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the MessageReceipt.
+func (MessageReceipt) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("message_id").NotEmpty(),
+		field.String("user_id").NotEmpty(),
+		field.Time("delivered_at").Optional().Comment("When the message reached one of the user's devices"),
+		field.Time("read_at").Optional().Comment("When the user's client acknowledged seeing the message"),
+	}
+}
+
+// Edges of the MessageReceipt.
+func (MessageReceipt) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("message", Message.Type).Unique().Required().Field("message_id"),
+		edge.To("user", User.Type).Unique().Required().Field("user_id"),
+	}
+}
+
+// Indexes of the MessageReceipt.
+func (MessageReceipt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("message_id", "user_id").Unique(),
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MessageRevision holds the schema definition for the MessageRevision entity.
+type MessageRevision struct {
+	ent.Schema
+}
+
+func (MessageRevision) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the MessageRevision.
+func (MessageRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("message_id").NotEmpty(),
+		field.String("editor_id").NotEmpty(),
+		field.Text("content").NotEmpty().Comment("Message content before the edit"),
+		field.Time("edited_at").Comment("When the edit replacing this content was made"),
+	}
+}
+
+// Edges of the MessageRevision.
+func (MessageRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("message", Message.Type).Unique().Required().Field("message_id"),
+		edge.To("editor", User.Type).Unique().Required().Field("editor_id"),
+	}
+}
+
+// Indexes of the MessageRevision.
+func (MessageRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("message_id", "created_at"),
+	}
+}
@@ -22,12 +22,13 @@ func (Notification) Mixin() []ent.Mixin {
 func (Notification) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("user_id").NotEmpty(),
-		field.Enum("type").Values("friend_request", "message", "friend_accepted"),
+		field.Enum("type").Values("friend_request", "message", "friend_accepted", "friend_invite", "mention", "reminder"),
 		field.String("title").NotEmpty().MaxLen(100),
 		field.Text("content").NotEmpty(),
 		field.Bool("is_read").Default(false),
 		field.String("related_user_id").Optional(),
 		field.String("related_conversation_id").Optional(),
+		field.String("related_message_id").Optional(),
 	}
 }
 
@@ -37,6 +38,7 @@ func (Notification) Edges() []ent.Edge {
 		edge.To("user", User.Type).Unique().Required().Field("user_id"),
 		edge.To("related_user", User.Type).Unique().Field("related_user_id"),
 		edge.To("related_conversation", Conversation.Type).Unique().Field("related_conversation_id"),
+		edge.To("related_message", Message.Type).Unique().Field("related_message_id"),
 	}
 }
 
@@ -52,5 +54,6 @@ func (Notification) Indexes() []ent.Index {
 		index.Fields("created_at"),
 		index.Fields("related_user_id"),
 		index.Fields("related_conversation_id"),
+		index.Fields("related_message_id"),
 	}
 }
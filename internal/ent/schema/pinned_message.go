@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PinnedMessage holds the schema definition for the PinnedMessage entity.
+type PinnedMessage struct {
+	ent.Schema
+}
+
+func (PinnedMessage) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the PinnedMessage.
+func (PinnedMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("conversation_id").NotEmpty(),
+		field.String("message_id").NotEmpty(),
+		field.String("pinned_by_id").NotEmpty(),
+	}
+}
+
+// Edges of the PinnedMessage.
+func (PinnedMessage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("conversation", Conversation.Type).Unique().Required().Field("conversation_id"),
+		edge.To("message", Message.Type).Unique().Required().Field("message_id"),
+		edge.To("pinned_by", User.Type).Unique().Required().Field("pinned_by_id"),
+	}
+}
+
+// Indexes of the PinnedMessage.
+func (PinnedMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("message_id").Unique(),
+		index.Fields("conversation_id", "created_at"),
+		index.Fields("pinned_by_id"),
+	}
+}
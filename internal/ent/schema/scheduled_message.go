@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ScheduledMessage holds the schema definition for the ScheduledMessage entity.
+type ScheduledMessage struct {
+	ent.Schema
+}
+
+func (ScheduledMessage) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the ScheduledMessage.
+func (ScheduledMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("conversation_id").NotEmpty(),
+		field.String("sender_id").NotEmpty(),
+		field.Text("content").NotEmpty(),
+		field.String("reply_to_id").Optional(),
+		field.Bool("suppress_embeds").Default(false),
+		field.Time("send_at"),
+		field.Enum("status").Values("pending", "sent", "failed", "cancelled").Default("pending"),
+		field.String("message_id").Optional().Comment("The message created on delivery"),
+		field.String("failure_reason").Optional(),
+		field.Int("attempts").Default(0),
+		field.String("claim_token").Optional().Sensitive().Comment("Identifies the scheduler run currently delivering the message"),
+		field.Time("claim_expires_at").Optional().Comment("After this time the claim is abandoned and another run may deliver the message"),
+	}
+}
+
+// Edges of the ScheduledMessage.
+func (ScheduledMessage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("conversation", Conversation.Type).Unique().Required().Field("conversation_id"),
+		edge.To("sender", User.Type).Unique().Required().Field("sender_id"),
+		edge.To("message", Message.Type).Unique().Field("message_id"),
+	}
+}
+
+// Indexes of the ScheduledMessage.
+func (ScheduledMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "send_at"),
+		index.Fields("sender_id", "status", "send_at"),
+		index.Fields("conversation_id"),
+		index.Fields("message_id").Unique(),
+	}
+}
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ThreadParticipant holds the schema definition for the ThreadParticipant entity.
+type ThreadParticipant struct {
+	ent.Schema
+}
+
+func (ThreadParticipant) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+// Fields of the ThreadParticipant.
+func (ThreadParticipant) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("root_message_id").NotEmpty(),
+		field.String("user_id").NotEmpty(),
+		field.Time("last_read_at").Optional(),
+	}
+}
+
+// Edges of the ThreadParticipant.
+func (ThreadParticipant) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("root_message", Message.Type).Unique().Required().Field("root_message_id"),
+		edge.To("user", User.Type).Unique().Required().Field("user_id"),
+	}
+}
+
+// Indexes of the ThreadParticipant.
+func (ThreadParticipant) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("root_message_id", "user_id").Unique(),
+		index.Fields("user_id"),
+	}
+}
@@ -28,6 +28,8 @@ func (User) Fields() []ent.Field {
 		field.String("bio").Optional().MaxLen(255),
 		field.String("avater_url").Optional(),
 		field.String("cover_url").Optional(),
+		// Privacy settings
+		field.Bool("read_receipts_enabled").Default(true).Comment("Whether other users can see when this user has read their messages"),
 	}
 }
 
@@ -51,11 +53,26 @@ func (User) Edges() []ent.Edge {
 		// Friend relationships
 		edge.From("friend_requests_sent", Friend.Type).Ref("requester"),
 		edge.From("friend_requests_received", Friend.Type).Ref("addressee"),
+		edge.From("friend_invites", FriendInvite.Type).Ref("owner"),
+		edge.From("friend_invite_uses", FriendInviteUse.Type).Ref("user"),
 		// Messaging relationships
 		edge.From("sent_messages", Message.Type).Ref("sender"),
+		edge.From("forwarded_messages", Message.Type).Ref("forwarded_from_user"),
+		edge.From("message_revisions", MessageRevision.Type).Ref("editor"),
+		edge.From("message_reactions", MessageReaction.Type).Ref("user"),
+		edge.From("attachments", Attachment.Type).Ref("uploader"),
+		edge.From("mentions", MessageMention.Type).Ref("user"),
+		edge.From("pinned_messages", PinnedMessage.Type).Ref("pinned_by"),
+		edge.From("message_receipts", MessageReceipt.Type).Ref("user"),
+		edge.From("scheduled_messages", ScheduledMessage.Type).Ref("sender"),
+		edge.From("drafts", Draft.Type).Ref("user"),
+		edge.From("bookmarks", Bookmark.Type).Ref("user"),
+		edge.From("hidden_messages", HiddenMessage.Type).Ref("user"),
+		edge.From("conversation_folders", ConversationFolder.Type).Ref("user"),
 		edge.From("notifications", Notification.Type).Ref("user"),
 		edge.From("related_notifications", Notification.Type).Ref("related_user"),
 		edge.From("conversation_participations", ConversationParticipant.Type).Ref("user"),
+		edge.From("thread_participations", ThreadParticipant.Type).Ref("user"),
 		// Block relationships
 		edge.From("blocked_users", Block.Type).Ref("blocker"),
 		edge.From("blocked_by_users", Block.Type).Ref("blocked"),
@@ -0,0 +1,73 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/schema"
+
+	atlas "ariga.io/atlas/sql/schema"
+)
+
+const (
+	// MessageSearchColumn is the generated tsvector of message text. Ent cannot describe generated
+	// columns, so it is created by SetupMessageSearch instead of the schema
+	MessageSearchColumn = "search_vector"
+	// MessageSearchConfig is the text search configuration of the column, simple works for any
+	// language because it does not stem
+	MessageSearchConfig = "simple"
+
+	messageSearchIndex = "messages_search_vector_idx"
+)
+
+// KeepMessageSearch stops auto migration from dropping the search column and index, which are not
+// part of the ent schema
+func KeepMessageSearch() schema.MigrateOption {
+	return schema.WithDiffHook(func(next schema.Differ) schema.Differ {
+		return schema.DiffFunc(func(current, desired *atlas.Schema) ([]atlas.Change, error) {
+			changes, err := next.Diff(current, desired)
+			if err != nil {
+				return nil, err
+			}
+			for _, change := range changes {
+				modify, ok := change.(*atlas.ModifyTable)
+				if !ok || modify.T.Name != "messages" {
+					continue
+				}
+				kept := modify.Changes[:0]
+				for _, c := range modify.Changes {
+					if drop, ok := c.(*atlas.DropColumn); ok && drop.C.Name == MessageSearchColumn {
+						continue
+					}
+					if drop, ok := c.(*atlas.DropIndex); ok && drop.I.Name == messageSearchIndex {
+						continue
+					}
+					kept = append(kept, c)
+				}
+				modify.Changes = kept
+			}
+			return changes, nil
+		})
+	})
+}
+
+// SetupMessageSearch adds the generated search column of messages and its GIN index on PostgreSQL
+func SetupMessageSearch(ctx context.Context, client *Client) error {
+	if client.driver.Dialect() != dialect.Postgres {
+		return nil
+	}
+
+	statements := []string{
+		fmt.Sprintf(`ALTER TABLE messages ADD COLUMN IF NOT EXISTS %s tsvector
+			GENERATED ALWAYS AS (to_tsvector('%s'::regconfig, coalesce(plain_text, content))) STORED`,
+			MessageSearchColumn, MessageSearchConfig),
+		fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s ON messages USING GIN (%s)`, messageSearchIndex, MessageSearchColumn),
+	}
+	for _, statement := range statements {
+		if err := client.driver.Exec(ctx, statement, []any{}, nil); err != nil {
+			return fmt.Errorf("failed to set up message search: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/threadparticipant"
+	"kakashi/chaos/internal/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ThreadParticipant is the model entity for the ThreadParticipant schema.
+type ThreadParticipant struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// RootMessageID holds the value of the "root_message_id" field.
+	RootMessageID string `json:"root_message_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// LastReadAt holds the value of the "last_read_at" field.
+	LastReadAt time.Time `json:"last_read_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ThreadParticipantQuery when eager-loading is set.
+	Edges        ThreadParticipantEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ThreadParticipantEdges holds the relations/edges for other nodes in the graph.
+type ThreadParticipantEdges struct {
+	// RootMessage holds the value of the root_message edge.
+	RootMessage *Message `json:"root_message,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// RootMessageOrErr returns the RootMessage value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ThreadParticipantEdges) RootMessageOrErr() (*Message, error) {
+	if e.RootMessage != nil {
+		return e.RootMessage, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "root_message"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ThreadParticipantEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ThreadParticipant) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case threadparticipant.FieldID, threadparticipant.FieldRootMessageID, threadparticipant.FieldUserID:
+			values[i] = new(sql.NullString)
+		case threadparticipant.FieldCreatedAt, threadparticipant.FieldUpdatedAt, threadparticipant.FieldLastReadAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ThreadParticipant fields.
+func (tp *ThreadParticipant) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case threadparticipant.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				tp.ID = value.String
+			}
+		case threadparticipant.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tp.CreatedAt = value.Time
+			}
+		case threadparticipant.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				tp.UpdatedAt = value.Time
+			}
+		case threadparticipant.FieldRootMessageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field root_message_id", values[i])
+			} else if value.Valid {
+				tp.RootMessageID = value.String
+			}
+		case threadparticipant.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				tp.UserID = value.String
+			}
+		case threadparticipant.FieldLastReadAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_read_at", values[i])
+			} else if value.Valid {
+				tp.LastReadAt = value.Time
+			}
+		default:
+			tp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ThreadParticipant.
+// This includes values selected through modifiers, order, etc.
+func (tp *ThreadParticipant) Value(name string) (ent.Value, error) {
+	return tp.selectValues.Get(name)
+}
+
+// QueryRootMessage queries the "root_message" edge of the ThreadParticipant entity.
+func (tp *ThreadParticipant) QueryRootMessage() *MessageQuery {
+	return NewThreadParticipantClient(tp.config).QueryRootMessage(tp)
+}
+
+// QueryUser queries the "user" edge of the ThreadParticipant entity.
+func (tp *ThreadParticipant) QueryUser() *UserQuery {
+	return NewThreadParticipantClient(tp.config).QueryUser(tp)
+}
+
+// Update returns a builder for updating this ThreadParticipant.
+// Note that you need to call ThreadParticipant.Unwrap() before calling this method if this ThreadParticipant
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tp *ThreadParticipant) Update() *ThreadParticipantUpdateOne {
+	return NewThreadParticipantClient(tp.config).UpdateOne(tp)
+}
+
+// Unwrap unwraps the ThreadParticipant entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tp *ThreadParticipant) Unwrap() *ThreadParticipant {
+	_tx, ok := tp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ThreadParticipant is not a transactional entity")
+	}
+	tp.config.driver = _tx.drv
+	return tp
+}
+
+// String implements the fmt.Stringer.
+func (tp *ThreadParticipant) String() string {
+	var builder strings.Builder
+	builder.WriteString("ThreadParticipant(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tp.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(tp.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(tp.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("root_message_id=")
+	builder.WriteString(tp.RootMessageID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(tp.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("last_read_at=")
+	builder.WriteString(tp.LastReadAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ThreadParticipants is a parsable slice of ThreadParticipant.
+type ThreadParticipants []*ThreadParticipant
@@ -0,0 +1,412 @@
+// Code generated by ent, DO NOT EDIT.
+
+package threadparticipant
+
+import (
+	"kakashi/chaos/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// RootMessageID applies equality check predicate on the "root_message_id" field. It's identical to RootMessageIDEQ.
+func RootMessageID(v string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldEQ(FieldRootMessageID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldEQ(FieldUserID, v))
+}
+
+// LastReadAt applies equality check predicate on the "last_read_at" field. It's identical to LastReadAtEQ.
+func LastReadAt(v time.Time) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldEQ(FieldLastReadAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// RootMessageIDEQ applies the EQ predicate on the "root_message_id" field.
+func RootMessageIDEQ(v string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldEQ(FieldRootMessageID, v))
+}
+
+// RootMessageIDNEQ applies the NEQ predicate on the "root_message_id" field.
+func RootMessageIDNEQ(v string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldNEQ(FieldRootMessageID, v))
+}
+
+// RootMessageIDIn applies the In predicate on the "root_message_id" field.
+func RootMessageIDIn(vs ...string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldIn(FieldRootMessageID, vs...))
+}
+
+// RootMessageIDNotIn applies the NotIn predicate on the "root_message_id" field.
+func RootMessageIDNotIn(vs ...string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldNotIn(FieldRootMessageID, vs...))
+}
+
+// RootMessageIDGT applies the GT predicate on the "root_message_id" field.
+func RootMessageIDGT(v string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldGT(FieldRootMessageID, v))
+}
+
+// RootMessageIDGTE applies the GTE predicate on the "root_message_id" field.
+func RootMessageIDGTE(v string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldGTE(FieldRootMessageID, v))
+}
+
+// RootMessageIDLT applies the LT predicate on the "root_message_id" field.
+func RootMessageIDLT(v string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldLT(FieldRootMessageID, v))
+}
+
+// RootMessageIDLTE applies the LTE predicate on the "root_message_id" field.
+func RootMessageIDLTE(v string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldLTE(FieldRootMessageID, v))
+}
+
+// RootMessageIDContains applies the Contains predicate on the "root_message_id" field.
+func RootMessageIDContains(v string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldContains(FieldRootMessageID, v))
+}
+
+// RootMessageIDHasPrefix applies the HasPrefix predicate on the "root_message_id" field.
+func RootMessageIDHasPrefix(v string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldHasPrefix(FieldRootMessageID, v))
+}
+
+// RootMessageIDHasSuffix applies the HasSuffix predicate on the "root_message_id" field.
+func RootMessageIDHasSuffix(v string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldHasSuffix(FieldRootMessageID, v))
+}
+
+// RootMessageIDEqualFold applies the EqualFold predicate on the "root_message_id" field.
+func RootMessageIDEqualFold(v string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldEqualFold(FieldRootMessageID, v))
+}
+
+// RootMessageIDContainsFold applies the ContainsFold predicate on the "root_message_id" field.
+func RootMessageIDContainsFold(v string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldContainsFold(FieldRootMessageID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// LastReadAtEQ applies the EQ predicate on the "last_read_at" field.
+func LastReadAtEQ(v time.Time) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldEQ(FieldLastReadAt, v))
+}
+
+// LastReadAtNEQ applies the NEQ predicate on the "last_read_at" field.
+func LastReadAtNEQ(v time.Time) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldNEQ(FieldLastReadAt, v))
+}
+
+// LastReadAtIn applies the In predicate on the "last_read_at" field.
+func LastReadAtIn(vs ...time.Time) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldIn(FieldLastReadAt, vs...))
+}
+
+// LastReadAtNotIn applies the NotIn predicate on the "last_read_at" field.
+func LastReadAtNotIn(vs ...time.Time) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldNotIn(FieldLastReadAt, vs...))
+}
+
+// LastReadAtGT applies the GT predicate on the "last_read_at" field.
+func LastReadAtGT(v time.Time) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldGT(FieldLastReadAt, v))
+}
+
+// LastReadAtGTE applies the GTE predicate on the "last_read_at" field.
+func LastReadAtGTE(v time.Time) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldGTE(FieldLastReadAt, v))
+}
+
+// LastReadAtLT applies the LT predicate on the "last_read_at" field.
+func LastReadAtLT(v time.Time) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldLT(FieldLastReadAt, v))
+}
+
+// LastReadAtLTE applies the LTE predicate on the "last_read_at" field.
+func LastReadAtLTE(v time.Time) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldLTE(FieldLastReadAt, v))
+}
+
+// LastReadAtIsNil applies the IsNil predicate on the "last_read_at" field.
+func LastReadAtIsNil() predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldIsNull(FieldLastReadAt))
+}
+
+// LastReadAtNotNil applies the NotNil predicate on the "last_read_at" field.
+func LastReadAtNotNil() predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.FieldNotNull(FieldLastReadAt))
+}
+
+// HasRootMessage applies the HasEdge predicate on the "root_message" edge.
+func HasRootMessage() predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, RootMessageTable, RootMessageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRootMessageWith applies the HasEdge predicate on the "root_message" edge with a given conditions (other predicates).
+func HasRootMessageWith(preds ...predicate.Message) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(func(s *sql.Selector) {
+		step := newRootMessageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ThreadParticipant) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ThreadParticipant) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ThreadParticipant) predicate.ThreadParticipant {
+	return predicate.ThreadParticipant(sql.NotPredicates(p))
+}
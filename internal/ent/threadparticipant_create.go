@@ -0,0 +1,348 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/threadparticipant"
+	"kakashi/chaos/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ThreadParticipantCreate is the builder for creating a ThreadParticipant entity.
+type ThreadParticipantCreate struct {
+	config
+	mutation *ThreadParticipantMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tpc *ThreadParticipantCreate) SetCreatedAt(t time.Time) *ThreadParticipantCreate {
+	tpc.mutation.SetCreatedAt(t)
+	return tpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tpc *ThreadParticipantCreate) SetNillableCreatedAt(t *time.Time) *ThreadParticipantCreate {
+	if t != nil {
+		tpc.SetCreatedAt(*t)
+	}
+	return tpc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tpc *ThreadParticipantCreate) SetUpdatedAt(t time.Time) *ThreadParticipantCreate {
+	tpc.mutation.SetUpdatedAt(t)
+	return tpc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tpc *ThreadParticipantCreate) SetNillableUpdatedAt(t *time.Time) *ThreadParticipantCreate {
+	if t != nil {
+		tpc.SetUpdatedAt(*t)
+	}
+	return tpc
+}
+
+// SetRootMessageID sets the "root_message_id" field.
+func (tpc *ThreadParticipantCreate) SetRootMessageID(s string) *ThreadParticipantCreate {
+	tpc.mutation.SetRootMessageID(s)
+	return tpc
+}
+
+// SetUserID sets the "user_id" field.
+func (tpc *ThreadParticipantCreate) SetUserID(s string) *ThreadParticipantCreate {
+	tpc.mutation.SetUserID(s)
+	return tpc
+}
+
+// SetLastReadAt sets the "last_read_at" field.
+func (tpc *ThreadParticipantCreate) SetLastReadAt(t time.Time) *ThreadParticipantCreate {
+	tpc.mutation.SetLastReadAt(t)
+	return tpc
+}
+
+// SetNillableLastReadAt sets the "last_read_at" field if the given value is not nil.
+func (tpc *ThreadParticipantCreate) SetNillableLastReadAt(t *time.Time) *ThreadParticipantCreate {
+	if t != nil {
+		tpc.SetLastReadAt(*t)
+	}
+	return tpc
+}
+
+// SetID sets the "id" field.
+func (tpc *ThreadParticipantCreate) SetID(s string) *ThreadParticipantCreate {
+	tpc.mutation.SetID(s)
+	return tpc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tpc *ThreadParticipantCreate) SetNillableID(s *string) *ThreadParticipantCreate {
+	if s != nil {
+		tpc.SetID(*s)
+	}
+	return tpc
+}
+
+// SetRootMessage sets the "root_message" edge to the Message entity.
+func (tpc *ThreadParticipantCreate) SetRootMessage(m *Message) *ThreadParticipantCreate {
+	return tpc.SetRootMessageID(m.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (tpc *ThreadParticipantCreate) SetUser(u *User) *ThreadParticipantCreate {
+	return tpc.SetUserID(u.ID)
+}
+
+// Mutation returns the ThreadParticipantMutation object of the builder.
+func (tpc *ThreadParticipantCreate) Mutation() *ThreadParticipantMutation {
+	return tpc.mutation
+}
+
+// Save creates the ThreadParticipant in the database.
+func (tpc *ThreadParticipantCreate) Save(ctx context.Context) (*ThreadParticipant, error) {
+	tpc.defaults()
+	return withHooks(ctx, tpc.sqlSave, tpc.mutation, tpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tpc *ThreadParticipantCreate) SaveX(ctx context.Context) *ThreadParticipant {
+	v, err := tpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tpc *ThreadParticipantCreate) Exec(ctx context.Context) error {
+	_, err := tpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tpc *ThreadParticipantCreate) ExecX(ctx context.Context) {
+	if err := tpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tpc *ThreadParticipantCreate) defaults() {
+	if _, ok := tpc.mutation.CreatedAt(); !ok {
+		v := threadparticipant.DefaultCreatedAt()
+		tpc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tpc.mutation.UpdatedAt(); !ok {
+		v := threadparticipant.DefaultUpdatedAt()
+		tpc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := tpc.mutation.ID(); !ok {
+		v := threadparticipant.DefaultID()
+		tpc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tpc *ThreadParticipantCreate) check() error {
+	if _, ok := tpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ThreadParticipant.created_at"`)}
+	}
+	if _, ok := tpc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ThreadParticipant.updated_at"`)}
+	}
+	if _, ok := tpc.mutation.RootMessageID(); !ok {
+		return &ValidationError{Name: "root_message_id", err: errors.New(`ent: missing required field "ThreadParticipant.root_message_id"`)}
+	}
+	if v, ok := tpc.mutation.RootMessageID(); ok {
+		if err := threadparticipant.RootMessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "root_message_id", err: fmt.Errorf(`ent: validator failed for field "ThreadParticipant.root_message_id": %w`, err)}
+		}
+	}
+	if _, ok := tpc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ThreadParticipant.user_id"`)}
+	}
+	if v, ok := tpc.mutation.UserID(); ok {
+		if err := threadparticipant.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "ThreadParticipant.user_id": %w`, err)}
+		}
+	}
+	if len(tpc.mutation.RootMessageIDs()) == 0 {
+		return &ValidationError{Name: "root_message", err: errors.New(`ent: missing required edge "ThreadParticipant.root_message"`)}
+	}
+	if len(tpc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ThreadParticipant.user"`)}
+	}
+	return nil
+}
+
+func (tpc *ThreadParticipantCreate) sqlSave(ctx context.Context) (*ThreadParticipant, error) {
+	if err := tpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ThreadParticipant.ID type: %T", _spec.ID.Value)
+		}
+	}
+	tpc.mutation.id = &_node.ID
+	tpc.mutation.done = true
+	return _node, nil
+}
+
+func (tpc *ThreadParticipantCreate) createSpec() (*ThreadParticipant, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ThreadParticipant{config: tpc.config}
+		_spec = sqlgraph.NewCreateSpec(threadparticipant.Table, sqlgraph.NewFieldSpec(threadparticipant.FieldID, field.TypeString))
+	)
+	if id, ok := tpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := tpc.mutation.CreatedAt(); ok {
+		_spec.SetField(threadparticipant.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := tpc.mutation.UpdatedAt(); ok {
+		_spec.SetField(threadparticipant.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := tpc.mutation.LastReadAt(); ok {
+		_spec.SetField(threadparticipant.FieldLastReadAt, field.TypeTime, value)
+		_node.LastReadAt = value
+	}
+	if nodes := tpc.mutation.RootMessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   threadparticipant.RootMessageTable,
+			Columns: []string{threadparticipant.RootMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RootMessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tpc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   threadparticipant.UserTable,
+			Columns: []string{threadparticipant.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ThreadParticipantCreateBulk is the builder for creating many ThreadParticipant entities in bulk.
+type ThreadParticipantCreateBulk struct {
+	config
+	err      error
+	builders []*ThreadParticipantCreate
+}
+
+// Save creates the ThreadParticipant entities in the database.
+func (tpcb *ThreadParticipantCreateBulk) Save(ctx context.Context) ([]*ThreadParticipant, error) {
+	if tpcb.err != nil {
+		return nil, tpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tpcb.builders))
+	nodes := make([]*ThreadParticipant, len(tpcb.builders))
+	mutators := make([]Mutator, len(tpcb.builders))
+	for i := range tpcb.builders {
+		func(i int, root context.Context) {
+			builder := tpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ThreadParticipantMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tpcb *ThreadParticipantCreateBulk) SaveX(ctx context.Context) []*ThreadParticipant {
+	v, err := tpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tpcb *ThreadParticipantCreateBulk) Exec(ctx context.Context) error {
+	_, err := tpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tpcb *ThreadParticipantCreateBulk) ExecX(ctx context.Context) {
+	if err := tpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
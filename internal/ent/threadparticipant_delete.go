@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/threadparticipant"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ThreadParticipantDelete is the builder for deleting a ThreadParticipant entity.
+type ThreadParticipantDelete struct {
+	config
+	hooks    []Hook
+	mutation *ThreadParticipantMutation
+}
+
+// Where appends a list predicates to the ThreadParticipantDelete builder.
+func (tpd *ThreadParticipantDelete) Where(ps ...predicate.ThreadParticipant) *ThreadParticipantDelete {
+	tpd.mutation.Where(ps...)
+	return tpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tpd *ThreadParticipantDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tpd.sqlExec, tpd.mutation, tpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tpd *ThreadParticipantDelete) ExecX(ctx context.Context) int {
+	n, err := tpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tpd *ThreadParticipantDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(threadparticipant.Table, sqlgraph.NewFieldSpec(threadparticipant.FieldID, field.TypeString))
+	if ps := tpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tpd.mutation.done = true
+	return affected, err
+}
+
+// ThreadParticipantDeleteOne is the builder for deleting a single ThreadParticipant entity.
+type ThreadParticipantDeleteOne struct {
+	tpd *ThreadParticipantDelete
+}
+
+// Where appends a list predicates to the ThreadParticipantDelete builder.
+func (tpdo *ThreadParticipantDeleteOne) Where(ps ...predicate.ThreadParticipant) *ThreadParticipantDeleteOne {
+	tpdo.tpd.mutation.Where(ps...)
+	return tpdo
+}
+
+// Exec executes the deletion query.
+func (tpdo *ThreadParticipantDeleteOne) Exec(ctx context.Context) error {
+	n, err := tpdo.tpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{threadparticipant.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tpdo *ThreadParticipantDeleteOne) ExecX(ctx context.Context) {
+	if err := tpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/threadparticipant"
+	"kakashi/chaos/internal/ent/user"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ThreadParticipantQuery is the builder for querying ThreadParticipant entities.
+type ThreadParticipantQuery struct {
+	config
+	ctx             *QueryContext
+	order           []threadparticipant.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.ThreadParticipant
+	withRootMessage *MessageQuery
+	withUser        *UserQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ThreadParticipantQuery builder.
+func (tpq *ThreadParticipantQuery) Where(ps ...predicate.ThreadParticipant) *ThreadParticipantQuery {
+	tpq.predicates = append(tpq.predicates, ps...)
+	return tpq
+}
+
+// Limit the number of records to be returned by this query.
+func (tpq *ThreadParticipantQuery) Limit(limit int) *ThreadParticipantQuery {
+	tpq.ctx.Limit = &limit
+	return tpq
+}
+
+// Offset to start from.
+func (tpq *ThreadParticipantQuery) Offset(offset int) *ThreadParticipantQuery {
+	tpq.ctx.Offset = &offset
+	return tpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tpq *ThreadParticipantQuery) Unique(unique bool) *ThreadParticipantQuery {
+	tpq.ctx.Unique = &unique
+	return tpq
+}
+
+// Order specifies how the records should be ordered.
+func (tpq *ThreadParticipantQuery) Order(o ...threadparticipant.OrderOption) *ThreadParticipantQuery {
+	tpq.order = append(tpq.order, o...)
+	return tpq
+}
+
+// QueryRootMessage chains the current query on the "root_message" edge.
+func (tpq *ThreadParticipantQuery) QueryRootMessage() *MessageQuery {
+	query := (&MessageClient{config: tpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(threadparticipant.Table, threadparticipant.FieldID, selector),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, threadparticipant.RootMessageTable, threadparticipant.RootMessageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (tpq *ThreadParticipantQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: tpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(threadparticipant.Table, threadparticipant.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, threadparticipant.UserTable, threadparticipant.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ThreadParticipant entity from the query.
+// Returns a *NotFoundError when no ThreadParticipant was found.
+func (tpq *ThreadParticipantQuery) First(ctx context.Context) (*ThreadParticipant, error) {
+	nodes, err := tpq.Limit(1).All(setContextOp(ctx, tpq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{threadparticipant.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tpq *ThreadParticipantQuery) FirstX(ctx context.Context) *ThreadParticipant {
+	node, err := tpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ThreadParticipant ID from the query.
+// Returns a *NotFoundError when no ThreadParticipant ID was found.
+func (tpq *ThreadParticipantQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = tpq.Limit(1).IDs(setContextOp(ctx, tpq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{threadparticipant.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tpq *ThreadParticipantQuery) FirstIDX(ctx context.Context) string {
+	id, err := tpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ThreadParticipant entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ThreadParticipant entity is found.
+// Returns a *NotFoundError when no ThreadParticipant entities are found.
+func (tpq *ThreadParticipantQuery) Only(ctx context.Context) (*ThreadParticipant, error) {
+	nodes, err := tpq.Limit(2).All(setContextOp(ctx, tpq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{threadparticipant.Label}
+	default:
+		return nil, &NotSingularError{threadparticipant.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tpq *ThreadParticipantQuery) OnlyX(ctx context.Context) *ThreadParticipant {
+	node, err := tpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ThreadParticipant ID in the query.
+// Returns a *NotSingularError when more than one ThreadParticipant ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tpq *ThreadParticipantQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = tpq.Limit(2).IDs(setContextOp(ctx, tpq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{threadparticipant.Label}
+	default:
+		err = &NotSingularError{threadparticipant.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tpq *ThreadParticipantQuery) OnlyIDX(ctx context.Context) string {
+	id, err := tpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ThreadParticipants.
+func (tpq *ThreadParticipantQuery) All(ctx context.Context) ([]*ThreadParticipant, error) {
+	ctx = setContextOp(ctx, tpq.ctx, ent.OpQueryAll)
+	if err := tpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ThreadParticipant, *ThreadParticipantQuery]()
+	return withInterceptors[[]*ThreadParticipant](ctx, tpq, qr, tpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tpq *ThreadParticipantQuery) AllX(ctx context.Context) []*ThreadParticipant {
+	nodes, err := tpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ThreadParticipant IDs.
+func (tpq *ThreadParticipantQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if tpq.ctx.Unique == nil && tpq.path != nil {
+		tpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tpq.ctx, ent.OpQueryIDs)
+	if err = tpq.Select(threadparticipant.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tpq *ThreadParticipantQuery) IDsX(ctx context.Context) []string {
+	ids, err := tpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tpq *ThreadParticipantQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tpq.ctx, ent.OpQueryCount)
+	if err := tpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tpq, querierCount[*ThreadParticipantQuery](), tpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tpq *ThreadParticipantQuery) CountX(ctx context.Context) int {
+	count, err := tpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tpq *ThreadParticipantQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tpq.ctx, ent.OpQueryExist)
+	switch _, err := tpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tpq *ThreadParticipantQuery) ExistX(ctx context.Context) bool {
+	exist, err := tpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ThreadParticipantQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tpq *ThreadParticipantQuery) Clone() *ThreadParticipantQuery {
+	if tpq == nil {
+		return nil
+	}
+	return &ThreadParticipantQuery{
+		config:          tpq.config,
+		ctx:             tpq.ctx.Clone(),
+		order:           append([]threadparticipant.OrderOption{}, tpq.order...),
+		inters:          append([]Interceptor{}, tpq.inters...),
+		predicates:      append([]predicate.ThreadParticipant{}, tpq.predicates...),
+		withRootMessage: tpq.withRootMessage.Clone(),
+		withUser:        tpq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  tpq.sql.Clone(),
+		path: tpq.path,
+	}
+}
+
+// WithRootMessage tells the query-builder to eager-load the nodes that are connected to
+// the "root_message" edge. The optional arguments are used to configure the query builder of the edge.
+func (tpq *ThreadParticipantQuery) WithRootMessage(opts ...func(*MessageQuery)) *ThreadParticipantQuery {
+	query := (&MessageClient{config: tpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tpq.withRootMessage = query
+	return tpq
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (tpq *ThreadParticipantQuery) WithUser(opts ...func(*UserQuery)) *ThreadParticipantQuery {
+	query := (&UserClient{config: tpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tpq.withUser = query
+	return tpq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ThreadParticipant.Query().
+//		GroupBy(threadparticipant.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tpq *ThreadParticipantQuery) GroupBy(field string, fields ...string) *ThreadParticipantGroupBy {
+	tpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ThreadParticipantGroupBy{build: tpq}
+	grbuild.flds = &tpq.ctx.Fields
+	grbuild.label = threadparticipant.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.ThreadParticipant.Query().
+//		Select(threadparticipant.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (tpq *ThreadParticipantQuery) Select(fields ...string) *ThreadParticipantSelect {
+	tpq.ctx.Fields = append(tpq.ctx.Fields, fields...)
+	sbuild := &ThreadParticipantSelect{ThreadParticipantQuery: tpq}
+	sbuild.label = threadparticipant.Label
+	sbuild.flds, sbuild.scan = &tpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ThreadParticipantSelect configured with the given aggregations.
+func (tpq *ThreadParticipantQuery) Aggregate(fns ...AggregateFunc) *ThreadParticipantSelect {
+	return tpq.Select().Aggregate(fns...)
+}
+
+func (tpq *ThreadParticipantQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tpq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tpq.ctx.Fields {
+		if !threadparticipant.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tpq.path != nil {
+		prev, err := tpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tpq.sql = prev
+	}
+	return nil
+}
+
+func (tpq *ThreadParticipantQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ThreadParticipant, error) {
+	var (
+		nodes       = []*ThreadParticipant{}
+		_spec       = tpq.querySpec()
+		loadedTypes = [2]bool{
+			tpq.withRootMessage != nil,
+			tpq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ThreadParticipant).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ThreadParticipant{config: tpq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := tpq.withRootMessage; query != nil {
+		if err := tpq.loadRootMessage(ctx, query, nodes, nil,
+			func(n *ThreadParticipant, e *Message) { n.Edges.RootMessage = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tpq.withUser; query != nil {
+		if err := tpq.loadUser(ctx, query, nodes, nil,
+			func(n *ThreadParticipant, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (tpq *ThreadParticipantQuery) loadRootMessage(ctx context.Context, query *MessageQuery, nodes []*ThreadParticipant, init func(*ThreadParticipant), assign func(*ThreadParticipant, *Message)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*ThreadParticipant)
+	for i := range nodes {
+		fk := nodes[i].RootMessageID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(message.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "root_message_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tpq *ThreadParticipantQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*ThreadParticipant, init func(*ThreadParticipant), assign func(*ThreadParticipant, *User)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*ThreadParticipant)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (tpq *ThreadParticipantQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tpq.querySpec()
+	_spec.Node.Columns = tpq.ctx.Fields
+	if len(tpq.ctx.Fields) > 0 {
+		_spec.Unique = tpq.ctx.Unique != nil && *tpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tpq.driver, _spec)
+}
+
+func (tpq *ThreadParticipantQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(threadparticipant.Table, threadparticipant.Columns, sqlgraph.NewFieldSpec(threadparticipant.FieldID, field.TypeString))
+	_spec.From = tpq.sql
+	if unique := tpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, threadparticipant.FieldID)
+		for i := range fields {
+			if fields[i] != threadparticipant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if tpq.withRootMessage != nil {
+			_spec.Node.AddColumnOnce(threadparticipant.FieldRootMessageID)
+		}
+		if tpq.withUser != nil {
+			_spec.Node.AddColumnOnce(threadparticipant.FieldUserID)
+		}
+	}
+	if ps := tpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tpq *ThreadParticipantQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tpq.driver.Dialect())
+	t1 := builder.Table(threadparticipant.Table)
+	columns := tpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = threadparticipant.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tpq.sql != nil {
+		selector = tpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tpq.ctx.Unique != nil && *tpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range tpq.predicates {
+		p(selector)
+	}
+	for _, p := range tpq.order {
+		p(selector)
+	}
+	if offset := tpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ThreadParticipantGroupBy is the group-by builder for ThreadParticipant entities.
+type ThreadParticipantGroupBy struct {
+	selector
+	build *ThreadParticipantQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tpgb *ThreadParticipantGroupBy) Aggregate(fns ...AggregateFunc) *ThreadParticipantGroupBy {
+	tpgb.fns = append(tpgb.fns, fns...)
+	return tpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tpgb *ThreadParticipantGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tpgb.build.ctx, ent.OpQueryGroupBy)
+	if err := tpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ThreadParticipantQuery, *ThreadParticipantGroupBy](ctx, tpgb.build, tpgb, tpgb.build.inters, v)
+}
+
+func (tpgb *ThreadParticipantGroupBy) sqlScan(ctx context.Context, root *ThreadParticipantQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tpgb.fns))
+	for _, fn := range tpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tpgb.flds)+len(tpgb.fns))
+		for _, f := range *tpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ThreadParticipantSelect is the builder for selecting fields of ThreadParticipant entities.
+type ThreadParticipantSelect struct {
+	*ThreadParticipantQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tps *ThreadParticipantSelect) Aggregate(fns ...AggregateFunc) *ThreadParticipantSelect {
+	tps.fns = append(tps.fns, fns...)
+	return tps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tps *ThreadParticipantSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tps.ctx, ent.OpQuerySelect)
+	if err := tps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ThreadParticipantQuery, *ThreadParticipantSelect](ctx, tps.ThreadParticipantQuery, tps, tps.inters, v)
+}
+
+func (tps *ThreadParticipantSelect) sqlScan(ctx context.Context, root *ThreadParticipantQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tps.fns))
+	for _, fn := range tps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,564 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/threadparticipant"
+	"kakashi/chaos/internal/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ThreadParticipantUpdate is the builder for updating ThreadParticipant entities.
+type ThreadParticipantUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ThreadParticipantMutation
+}
+
+// Where appends a list predicates to the ThreadParticipantUpdate builder.
+func (tpu *ThreadParticipantUpdate) Where(ps ...predicate.ThreadParticipant) *ThreadParticipantUpdate {
+	tpu.mutation.Where(ps...)
+	return tpu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tpu *ThreadParticipantUpdate) SetCreatedAt(t time.Time) *ThreadParticipantUpdate {
+	tpu.mutation.SetCreatedAt(t)
+	return tpu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tpu *ThreadParticipantUpdate) SetNillableCreatedAt(t *time.Time) *ThreadParticipantUpdate {
+	if t != nil {
+		tpu.SetCreatedAt(*t)
+	}
+	return tpu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tpu *ThreadParticipantUpdate) SetUpdatedAt(t time.Time) *ThreadParticipantUpdate {
+	tpu.mutation.SetUpdatedAt(t)
+	return tpu
+}
+
+// SetRootMessageID sets the "root_message_id" field.
+func (tpu *ThreadParticipantUpdate) SetRootMessageID(s string) *ThreadParticipantUpdate {
+	tpu.mutation.SetRootMessageID(s)
+	return tpu
+}
+
+// SetNillableRootMessageID sets the "root_message_id" field if the given value is not nil.
+func (tpu *ThreadParticipantUpdate) SetNillableRootMessageID(s *string) *ThreadParticipantUpdate {
+	if s != nil {
+		tpu.SetRootMessageID(*s)
+	}
+	return tpu
+}
+
+// SetUserID sets the "user_id" field.
+func (tpu *ThreadParticipantUpdate) SetUserID(s string) *ThreadParticipantUpdate {
+	tpu.mutation.SetUserID(s)
+	return tpu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (tpu *ThreadParticipantUpdate) SetNillableUserID(s *string) *ThreadParticipantUpdate {
+	if s != nil {
+		tpu.SetUserID(*s)
+	}
+	return tpu
+}
+
+// SetLastReadAt sets the "last_read_at" field.
+func (tpu *ThreadParticipantUpdate) SetLastReadAt(t time.Time) *ThreadParticipantUpdate {
+	tpu.mutation.SetLastReadAt(t)
+	return tpu
+}
+
+// SetNillableLastReadAt sets the "last_read_at" field if the given value is not nil.
+func (tpu *ThreadParticipantUpdate) SetNillableLastReadAt(t *time.Time) *ThreadParticipantUpdate {
+	if t != nil {
+		tpu.SetLastReadAt(*t)
+	}
+	return tpu
+}
+
+// ClearLastReadAt clears the value of the "last_read_at" field.
+func (tpu *ThreadParticipantUpdate) ClearLastReadAt() *ThreadParticipantUpdate {
+	tpu.mutation.ClearLastReadAt()
+	return tpu
+}
+
+// SetRootMessage sets the "root_message" edge to the Message entity.
+func (tpu *ThreadParticipantUpdate) SetRootMessage(m *Message) *ThreadParticipantUpdate {
+	return tpu.SetRootMessageID(m.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (tpu *ThreadParticipantUpdate) SetUser(u *User) *ThreadParticipantUpdate {
+	return tpu.SetUserID(u.ID)
+}
+
+// Mutation returns the ThreadParticipantMutation object of the builder.
+func (tpu *ThreadParticipantUpdate) Mutation() *ThreadParticipantMutation {
+	return tpu.mutation
+}
+
+// ClearRootMessage clears the "root_message" edge to the Message entity.
+func (tpu *ThreadParticipantUpdate) ClearRootMessage() *ThreadParticipantUpdate {
+	tpu.mutation.ClearRootMessage()
+	return tpu
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (tpu *ThreadParticipantUpdate) ClearUser() *ThreadParticipantUpdate {
+	tpu.mutation.ClearUser()
+	return tpu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tpu *ThreadParticipantUpdate) Save(ctx context.Context) (int, error) {
+	tpu.defaults()
+	return withHooks(ctx, tpu.sqlSave, tpu.mutation, tpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tpu *ThreadParticipantUpdate) SaveX(ctx context.Context) int {
+	affected, err := tpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tpu *ThreadParticipantUpdate) Exec(ctx context.Context) error {
+	_, err := tpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tpu *ThreadParticipantUpdate) ExecX(ctx context.Context) {
+	if err := tpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tpu *ThreadParticipantUpdate) defaults() {
+	if _, ok := tpu.mutation.UpdatedAt(); !ok {
+		v := threadparticipant.UpdateDefaultUpdatedAt()
+		tpu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tpu *ThreadParticipantUpdate) check() error {
+	if v, ok := tpu.mutation.RootMessageID(); ok {
+		if err := threadparticipant.RootMessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "root_message_id", err: fmt.Errorf(`ent: validator failed for field "ThreadParticipant.root_message_id": %w`, err)}
+		}
+	}
+	if v, ok := tpu.mutation.UserID(); ok {
+		if err := threadparticipant.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "ThreadParticipant.user_id": %w`, err)}
+		}
+	}
+	if tpu.mutation.RootMessageCleared() && len(tpu.mutation.RootMessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ThreadParticipant.root_message"`)
+	}
+	if tpu.mutation.UserCleared() && len(tpu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ThreadParticipant.user"`)
+	}
+	return nil
+}
+
+func (tpu *ThreadParticipantUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(threadparticipant.Table, threadparticipant.Columns, sqlgraph.NewFieldSpec(threadparticipant.FieldID, field.TypeString))
+	if ps := tpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tpu.mutation.CreatedAt(); ok {
+		_spec.SetField(threadparticipant.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := tpu.mutation.UpdatedAt(); ok {
+		_spec.SetField(threadparticipant.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := tpu.mutation.LastReadAt(); ok {
+		_spec.SetField(threadparticipant.FieldLastReadAt, field.TypeTime, value)
+	}
+	if tpu.mutation.LastReadAtCleared() {
+		_spec.ClearField(threadparticipant.FieldLastReadAt, field.TypeTime)
+	}
+	if tpu.mutation.RootMessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   threadparticipant.RootMessageTable,
+			Columns: []string{threadparticipant.RootMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tpu.mutation.RootMessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   threadparticipant.RootMessageTable,
+			Columns: []string{threadparticipant.RootMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tpu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   threadparticipant.UserTable,
+			Columns: []string{threadparticipant.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tpu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   threadparticipant.UserTable,
+			Columns: []string{threadparticipant.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{threadparticipant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tpu.mutation.done = true
+	return n, nil
+}
+
+// ThreadParticipantUpdateOne is the builder for updating a single ThreadParticipant entity.
+type ThreadParticipantUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ThreadParticipantMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tpuo *ThreadParticipantUpdateOne) SetCreatedAt(t time.Time) *ThreadParticipantUpdateOne {
+	tpuo.mutation.SetCreatedAt(t)
+	return tpuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tpuo *ThreadParticipantUpdateOne) SetNillableCreatedAt(t *time.Time) *ThreadParticipantUpdateOne {
+	if t != nil {
+		tpuo.SetCreatedAt(*t)
+	}
+	return tpuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tpuo *ThreadParticipantUpdateOne) SetUpdatedAt(t time.Time) *ThreadParticipantUpdateOne {
+	tpuo.mutation.SetUpdatedAt(t)
+	return tpuo
+}
+
+// SetRootMessageID sets the "root_message_id" field.
+func (tpuo *ThreadParticipantUpdateOne) SetRootMessageID(s string) *ThreadParticipantUpdateOne {
+	tpuo.mutation.SetRootMessageID(s)
+	return tpuo
+}
+
+// SetNillableRootMessageID sets the "root_message_id" field if the given value is not nil.
+func (tpuo *ThreadParticipantUpdateOne) SetNillableRootMessageID(s *string) *ThreadParticipantUpdateOne {
+	if s != nil {
+		tpuo.SetRootMessageID(*s)
+	}
+	return tpuo
+}
+
+// SetUserID sets the "user_id" field.
+func (tpuo *ThreadParticipantUpdateOne) SetUserID(s string) *ThreadParticipantUpdateOne {
+	tpuo.mutation.SetUserID(s)
+	return tpuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (tpuo *ThreadParticipantUpdateOne) SetNillableUserID(s *string) *ThreadParticipantUpdateOne {
+	if s != nil {
+		tpuo.SetUserID(*s)
+	}
+	return tpuo
+}
+
+// SetLastReadAt sets the "last_read_at" field.
+func (tpuo *ThreadParticipantUpdateOne) SetLastReadAt(t time.Time) *ThreadParticipantUpdateOne {
+	tpuo.mutation.SetLastReadAt(t)
+	return tpuo
+}
+
+// SetNillableLastReadAt sets the "last_read_at" field if the given value is not nil.
+func (tpuo *ThreadParticipantUpdateOne) SetNillableLastReadAt(t *time.Time) *ThreadParticipantUpdateOne {
+	if t != nil {
+		tpuo.SetLastReadAt(*t)
+	}
+	return tpuo
+}
+
+// ClearLastReadAt clears the value of the "last_read_at" field.
+func (tpuo *ThreadParticipantUpdateOne) ClearLastReadAt() *ThreadParticipantUpdateOne {
+	tpuo.mutation.ClearLastReadAt()
+	return tpuo
+}
+
+// SetRootMessage sets the "root_message" edge to the Message entity.
+func (tpuo *ThreadParticipantUpdateOne) SetRootMessage(m *Message) *ThreadParticipantUpdateOne {
+	return tpuo.SetRootMessageID(m.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (tpuo *ThreadParticipantUpdateOne) SetUser(u *User) *ThreadParticipantUpdateOne {
+	return tpuo.SetUserID(u.ID)
+}
+
+// Mutation returns the ThreadParticipantMutation object of the builder.
+func (tpuo *ThreadParticipantUpdateOne) Mutation() *ThreadParticipantMutation {
+	return tpuo.mutation
+}
+
+// ClearRootMessage clears the "root_message" edge to the Message entity.
+func (tpuo *ThreadParticipantUpdateOne) ClearRootMessage() *ThreadParticipantUpdateOne {
+	tpuo.mutation.ClearRootMessage()
+	return tpuo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (tpuo *ThreadParticipantUpdateOne) ClearUser() *ThreadParticipantUpdateOne {
+	tpuo.mutation.ClearUser()
+	return tpuo
+}
+
+// Where appends a list predicates to the ThreadParticipantUpdate builder.
+func (tpuo *ThreadParticipantUpdateOne) Where(ps ...predicate.ThreadParticipant) *ThreadParticipantUpdateOne {
+	tpuo.mutation.Where(ps...)
+	return tpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tpuo *ThreadParticipantUpdateOne) Select(field string, fields ...string) *ThreadParticipantUpdateOne {
+	tpuo.fields = append([]string{field}, fields...)
+	return tpuo
+}
+
+// Save executes the query and returns the updated ThreadParticipant entity.
+func (tpuo *ThreadParticipantUpdateOne) Save(ctx context.Context) (*ThreadParticipant, error) {
+	tpuo.defaults()
+	return withHooks(ctx, tpuo.sqlSave, tpuo.mutation, tpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tpuo *ThreadParticipantUpdateOne) SaveX(ctx context.Context) *ThreadParticipant {
+	node, err := tpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tpuo *ThreadParticipantUpdateOne) Exec(ctx context.Context) error {
+	_, err := tpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tpuo *ThreadParticipantUpdateOne) ExecX(ctx context.Context) {
+	if err := tpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tpuo *ThreadParticipantUpdateOne) defaults() {
+	if _, ok := tpuo.mutation.UpdatedAt(); !ok {
+		v := threadparticipant.UpdateDefaultUpdatedAt()
+		tpuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tpuo *ThreadParticipantUpdateOne) check() error {
+	if v, ok := tpuo.mutation.RootMessageID(); ok {
+		if err := threadparticipant.RootMessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "root_message_id", err: fmt.Errorf(`ent: validator failed for field "ThreadParticipant.root_message_id": %w`, err)}
+		}
+	}
+	if v, ok := tpuo.mutation.UserID(); ok {
+		if err := threadparticipant.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "ThreadParticipant.user_id": %w`, err)}
+		}
+	}
+	if tpuo.mutation.RootMessageCleared() && len(tpuo.mutation.RootMessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ThreadParticipant.root_message"`)
+	}
+	if tpuo.mutation.UserCleared() && len(tpuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ThreadParticipant.user"`)
+	}
+	return nil
+}
+
+func (tpuo *ThreadParticipantUpdateOne) sqlSave(ctx context.Context) (_node *ThreadParticipant, err error) {
+	if err := tpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(threadparticipant.Table, threadparticipant.Columns, sqlgraph.NewFieldSpec(threadparticipant.FieldID, field.TypeString))
+	id, ok := tpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ThreadParticipant.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, threadparticipant.FieldID)
+		for _, f := range fields {
+			if !threadparticipant.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != threadparticipant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tpuo.mutation.CreatedAt(); ok {
+		_spec.SetField(threadparticipant.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := tpuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(threadparticipant.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := tpuo.mutation.LastReadAt(); ok {
+		_spec.SetField(threadparticipant.FieldLastReadAt, field.TypeTime, value)
+	}
+	if tpuo.mutation.LastReadAtCleared() {
+		_spec.ClearField(threadparticipant.FieldLastReadAt, field.TypeTime)
+	}
+	if tpuo.mutation.RootMessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   threadparticipant.RootMessageTable,
+			Columns: []string{threadparticipant.RootMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tpuo.mutation.RootMessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   threadparticipant.RootMessageTable,
+			Columns: []string{threadparticipant.RootMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tpuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   threadparticipant.UserTable,
+			Columns: []string{threadparticipant.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tpuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   threadparticipant.UserTable,
+			Columns: []string{threadparticipant.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ThreadParticipant{config: tpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{threadparticipant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tpuo.mutation.done = true
+	return _node, nil
+}
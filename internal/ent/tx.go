@@ -12,28 +12,60 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Attachment is the client for interacting with the Attachment builders.
+	Attachment *AttachmentClient
 	// Block is the client for interacting with the Block builders.
 	Block *BlockClient
+	// Bookmark is the client for interacting with the Bookmark builders.
+	Bookmark *BookmarkClient
 	// Call is the client for interacting with the Call builders.
 	Call *CallClient
 	// Conversation is the client for interacting with the Conversation builders.
 	Conversation *ConversationClient
+	// ConversationFolder is the client for interacting with the ConversationFolder builders.
+	ConversationFolder *ConversationFolderClient
 	// ConversationParticipant is the client for interacting with the ConversationParticipant builders.
 	ConversationParticipant *ConversationParticipantClient
+	// Draft is the client for interacting with the Draft builders.
+	Draft *DraftClient
+	// FolderConversation is the client for interacting with the FolderConversation builders.
+	FolderConversation *FolderConversationClient
 	// Friend is the client for interacting with the Friend builders.
 	Friend *FriendClient
+	// FriendInvite is the client for interacting with the FriendInvite builders.
+	FriendInvite *FriendInviteClient
+	// FriendInviteUse is the client for interacting with the FriendInviteUse builders.
+	FriendInviteUse *FriendInviteUseClient
 	// Guild is the client for interacting with the Guild builders.
 	Guild *GuildClient
+	// HiddenMessage is the client for interacting with the HiddenMessage builders.
+	HiddenMessage *HiddenMessageClient
 	// Invitation is the client for interacting with the Invitation builders.
 	Invitation *InvitationClient
+	// LinkPreview is the client for interacting with the LinkPreview builders.
+	LinkPreview *LinkPreviewClient
 	// Member is the client for interacting with the Member builders.
 	Member *MemberClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
+	// MessageMention is the client for interacting with the MessageMention builders.
+	MessageMention *MessageMentionClient
+	// MessageReaction is the client for interacting with the MessageReaction builders.
+	MessageReaction *MessageReactionClient
+	// MessageReceipt is the client for interacting with the MessageReceipt builders.
+	MessageReceipt *MessageReceiptClient
+	// MessageRevision is the client for interacting with the MessageRevision builders.
+	MessageRevision *MessageRevisionClient
 	// Notification is the client for interacting with the Notification builders.
 	Notification *NotificationClient
+	// PinnedMessage is the client for interacting with the PinnedMessage builders.
+	PinnedMessage *PinnedMessageClient
+	// ScheduledMessage is the client for interacting with the ScheduledMessage builders.
+	ScheduledMessage *ScheduledMessageClient
 	// Session is the client for interacting with the Session builders.
 	Session *SessionClient
+	// ThreadParticipant is the client for interacting with the ThreadParticipant builders.
+	ThreadParticipant *ThreadParticipantClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -167,17 +199,33 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Attachment = NewAttachmentClient(tx.config)
 	tx.Block = NewBlockClient(tx.config)
+	tx.Bookmark = NewBookmarkClient(tx.config)
 	tx.Call = NewCallClient(tx.config)
 	tx.Conversation = NewConversationClient(tx.config)
+	tx.ConversationFolder = NewConversationFolderClient(tx.config)
 	tx.ConversationParticipant = NewConversationParticipantClient(tx.config)
+	tx.Draft = NewDraftClient(tx.config)
+	tx.FolderConversation = NewFolderConversationClient(tx.config)
 	tx.Friend = NewFriendClient(tx.config)
+	tx.FriendInvite = NewFriendInviteClient(tx.config)
+	tx.FriendInviteUse = NewFriendInviteUseClient(tx.config)
 	tx.Guild = NewGuildClient(tx.config)
+	tx.HiddenMessage = NewHiddenMessageClient(tx.config)
 	tx.Invitation = NewInvitationClient(tx.config)
+	tx.LinkPreview = NewLinkPreviewClient(tx.config)
 	tx.Member = NewMemberClient(tx.config)
 	tx.Message = NewMessageClient(tx.config)
+	tx.MessageMention = NewMessageMentionClient(tx.config)
+	tx.MessageReaction = NewMessageReactionClient(tx.config)
+	tx.MessageReceipt = NewMessageReceiptClient(tx.config)
+	tx.MessageRevision = NewMessageRevisionClient(tx.config)
 	tx.Notification = NewNotificationClient(tx.config)
+	tx.PinnedMessage = NewPinnedMessageClient(tx.config)
+	tx.ScheduledMessage = NewScheduledMessageClient(tx.config)
 	tx.Session = NewSessionClient(tx.config)
+	tx.ThreadParticipant = NewThreadParticipantClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -188,7 +236,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Block.QueryXXX(), the query will be executed
+// applies a query, for example: Attachment.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -35,6 +35,8 @@ type User struct {
 	AvaterURL string `json:"avater_url,omitempty"`
 	// CoverURL holds the value of the "cover_url" field.
 	CoverURL string `json:"cover_url,omitempty"`
+	// Whether other users can see when this user has read their messages
+	ReadReceiptsEnabled bool `json:"read_receipts_enabled,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -55,14 +57,44 @@ type UserEdges struct {
 	FriendRequestsSent []*Friend `json:"friend_requests_sent,omitempty"`
 	// FriendRequestsReceived holds the value of the friend_requests_received edge.
 	FriendRequestsReceived []*Friend `json:"friend_requests_received,omitempty"`
+	// FriendInvites holds the value of the friend_invites edge.
+	FriendInvites []*FriendInvite `json:"friend_invites,omitempty"`
+	// FriendInviteUses holds the value of the friend_invite_uses edge.
+	FriendInviteUses []*FriendInviteUse `json:"friend_invite_uses,omitempty"`
 	// SentMessages holds the value of the sent_messages edge.
 	SentMessages []*Message `json:"sent_messages,omitempty"`
+	// ForwardedMessages holds the value of the forwarded_messages edge.
+	ForwardedMessages []*Message `json:"forwarded_messages,omitempty"`
+	// MessageRevisions holds the value of the message_revisions edge.
+	MessageRevisions []*MessageRevision `json:"message_revisions,omitempty"`
+	// MessageReactions holds the value of the message_reactions edge.
+	MessageReactions []*MessageReaction `json:"message_reactions,omitempty"`
+	// Attachments holds the value of the attachments edge.
+	Attachments []*Attachment `json:"attachments,omitempty"`
+	// Mentions holds the value of the mentions edge.
+	Mentions []*MessageMention `json:"mentions,omitempty"`
+	// PinnedMessages holds the value of the pinned_messages edge.
+	PinnedMessages []*PinnedMessage `json:"pinned_messages,omitempty"`
+	// MessageReceipts holds the value of the message_receipts edge.
+	MessageReceipts []*MessageReceipt `json:"message_receipts,omitempty"`
+	// ScheduledMessages holds the value of the scheduled_messages edge.
+	ScheduledMessages []*ScheduledMessage `json:"scheduled_messages,omitempty"`
+	// Drafts holds the value of the drafts edge.
+	Drafts []*Draft `json:"drafts,omitempty"`
+	// Bookmarks holds the value of the bookmarks edge.
+	Bookmarks []*Bookmark `json:"bookmarks,omitempty"`
+	// HiddenMessages holds the value of the hidden_messages edge.
+	HiddenMessages []*HiddenMessage `json:"hidden_messages,omitempty"`
+	// ConversationFolders holds the value of the conversation_folders edge.
+	ConversationFolders []*ConversationFolder `json:"conversation_folders,omitempty"`
 	// Notifications holds the value of the notifications edge.
 	Notifications []*Notification `json:"notifications,omitempty"`
 	// RelatedNotifications holds the value of the related_notifications edge.
 	RelatedNotifications []*Notification `json:"related_notifications,omitempty"`
 	// ConversationParticipations holds the value of the conversation_participations edge.
 	ConversationParticipations []*ConversationParticipant `json:"conversation_participations,omitempty"`
+	// ThreadParticipations holds the value of the thread_participations edge.
+	ThreadParticipations []*ThreadParticipant `json:"thread_participations,omitempty"`
 	// BlockedUsers holds the value of the blocked_users edge.
 	BlockedUsers []*Block `json:"blocked_users,omitempty"`
 	// BlockedByUsers holds the value of the blocked_by_users edge.
@@ -73,7 +105,7 @@ type UserEdges struct {
 	CallsReceived []*Call `json:"calls_received,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [14]bool
+	loadedTypes [29]bool
 }
 
 // SessionsOrErr returns the Sessions value or an error if the edge
@@ -130,19 +162,145 @@ func (e UserEdges) FriendRequestsReceivedOrErr() ([]*Friend, error) {
 	return nil, &NotLoadedError{edge: "friend_requests_received"}
 }
 
+// FriendInvitesOrErr returns the FriendInvites value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) FriendInvitesOrErr() ([]*FriendInvite, error) {
+	if e.loadedTypes[6] {
+		return e.FriendInvites, nil
+	}
+	return nil, &NotLoadedError{edge: "friend_invites"}
+}
+
+// FriendInviteUsesOrErr returns the FriendInviteUses value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) FriendInviteUsesOrErr() ([]*FriendInviteUse, error) {
+	if e.loadedTypes[7] {
+		return e.FriendInviteUses, nil
+	}
+	return nil, &NotLoadedError{edge: "friend_invite_uses"}
+}
+
 // SentMessagesOrErr returns the SentMessages value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) SentMessagesOrErr() ([]*Message, error) {
-	if e.loadedTypes[6] {
+	if e.loadedTypes[8] {
 		return e.SentMessages, nil
 	}
 	return nil, &NotLoadedError{edge: "sent_messages"}
 }
 
+// ForwardedMessagesOrErr returns the ForwardedMessages value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ForwardedMessagesOrErr() ([]*Message, error) {
+	if e.loadedTypes[9] {
+		return e.ForwardedMessages, nil
+	}
+	return nil, &NotLoadedError{edge: "forwarded_messages"}
+}
+
+// MessageRevisionsOrErr returns the MessageRevisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MessageRevisionsOrErr() ([]*MessageRevision, error) {
+	if e.loadedTypes[10] {
+		return e.MessageRevisions, nil
+	}
+	return nil, &NotLoadedError{edge: "message_revisions"}
+}
+
+// MessageReactionsOrErr returns the MessageReactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MessageReactionsOrErr() ([]*MessageReaction, error) {
+	if e.loadedTypes[11] {
+		return e.MessageReactions, nil
+	}
+	return nil, &NotLoadedError{edge: "message_reactions"}
+}
+
+// AttachmentsOrErr returns the Attachments value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AttachmentsOrErr() ([]*Attachment, error) {
+	if e.loadedTypes[12] {
+		return e.Attachments, nil
+	}
+	return nil, &NotLoadedError{edge: "attachments"}
+}
+
+// MentionsOrErr returns the Mentions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MentionsOrErr() ([]*MessageMention, error) {
+	if e.loadedTypes[13] {
+		return e.Mentions, nil
+	}
+	return nil, &NotLoadedError{edge: "mentions"}
+}
+
+// PinnedMessagesOrErr returns the PinnedMessages value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PinnedMessagesOrErr() ([]*PinnedMessage, error) {
+	if e.loadedTypes[14] {
+		return e.PinnedMessages, nil
+	}
+	return nil, &NotLoadedError{edge: "pinned_messages"}
+}
+
+// MessageReceiptsOrErr returns the MessageReceipts value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MessageReceiptsOrErr() ([]*MessageReceipt, error) {
+	if e.loadedTypes[15] {
+		return e.MessageReceipts, nil
+	}
+	return nil, &NotLoadedError{edge: "message_receipts"}
+}
+
+// ScheduledMessagesOrErr returns the ScheduledMessages value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ScheduledMessagesOrErr() ([]*ScheduledMessage, error) {
+	if e.loadedTypes[16] {
+		return e.ScheduledMessages, nil
+	}
+	return nil, &NotLoadedError{edge: "scheduled_messages"}
+}
+
+// DraftsOrErr returns the Drafts value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) DraftsOrErr() ([]*Draft, error) {
+	if e.loadedTypes[17] {
+		return e.Drafts, nil
+	}
+	return nil, &NotLoadedError{edge: "drafts"}
+}
+
+// BookmarksOrErr returns the Bookmarks value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) BookmarksOrErr() ([]*Bookmark, error) {
+	if e.loadedTypes[18] {
+		return e.Bookmarks, nil
+	}
+	return nil, &NotLoadedError{edge: "bookmarks"}
+}
+
+// HiddenMessagesOrErr returns the HiddenMessages value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) HiddenMessagesOrErr() ([]*HiddenMessage, error) {
+	if e.loadedTypes[19] {
+		return e.HiddenMessages, nil
+	}
+	return nil, &NotLoadedError{edge: "hidden_messages"}
+}
+
+// ConversationFoldersOrErr returns the ConversationFolders value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ConversationFoldersOrErr() ([]*ConversationFolder, error) {
+	if e.loadedTypes[20] {
+		return e.ConversationFolders, nil
+	}
+	return nil, &NotLoadedError{edge: "conversation_folders"}
+}
+
 // NotificationsOrErr returns the Notifications value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) NotificationsOrErr() ([]*Notification, error) {
-	if e.loadedTypes[7] {
+	if e.loadedTypes[21] {
 		return e.Notifications, nil
 	}
 	return nil, &NotLoadedError{edge: "notifications"}
@@ -151,7 +309,7 @@ func (e UserEdges) NotificationsOrErr() ([]*Notification, error) {
 // RelatedNotificationsOrErr returns the RelatedNotifications value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) RelatedNotificationsOrErr() ([]*Notification, error) {
-	if e.loadedTypes[8] {
+	if e.loadedTypes[22] {
 		return e.RelatedNotifications, nil
 	}
 	return nil, &NotLoadedError{edge: "related_notifications"}
@@ -160,16 +318,25 @@ func (e UserEdges) RelatedNotificationsOrErr() ([]*Notification, error) {
 // ConversationParticipationsOrErr returns the ConversationParticipations value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) ConversationParticipationsOrErr() ([]*ConversationParticipant, error) {
-	if e.loadedTypes[9] {
+	if e.loadedTypes[23] {
 		return e.ConversationParticipations, nil
 	}
 	return nil, &NotLoadedError{edge: "conversation_participations"}
 }
 
+// ThreadParticipationsOrErr returns the ThreadParticipations value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ThreadParticipationsOrErr() ([]*ThreadParticipant, error) {
+	if e.loadedTypes[24] {
+		return e.ThreadParticipations, nil
+	}
+	return nil, &NotLoadedError{edge: "thread_participations"}
+}
+
 // BlockedUsersOrErr returns the BlockedUsers value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) BlockedUsersOrErr() ([]*Block, error) {
-	if e.loadedTypes[10] {
+	if e.loadedTypes[25] {
 		return e.BlockedUsers, nil
 	}
 	return nil, &NotLoadedError{edge: "blocked_users"}
@@ -178,7 +345,7 @@ func (e UserEdges) BlockedUsersOrErr() ([]*Block, error) {
 // BlockedByUsersOrErr returns the BlockedByUsers value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) BlockedByUsersOrErr() ([]*Block, error) {
-	if e.loadedTypes[11] {
+	if e.loadedTypes[26] {
 		return e.BlockedByUsers, nil
 	}
 	return nil, &NotLoadedError{edge: "blocked_by_users"}
@@ -187,7 +354,7 @@ func (e UserEdges) BlockedByUsersOrErr() ([]*Block, error) {
 // CallsMadeOrErr returns the CallsMade value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) CallsMadeOrErr() ([]*Call, error) {
-	if e.loadedTypes[12] {
+	if e.loadedTypes[27] {
 		return e.CallsMade, nil
 	}
 	return nil, &NotLoadedError{edge: "calls_made"}
@@ -196,7 +363,7 @@ func (e UserEdges) CallsMadeOrErr() ([]*Call, error) {
 // CallsReceivedOrErr returns the CallsReceived value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) CallsReceivedOrErr() ([]*Call, error) {
-	if e.loadedTypes[13] {
+	if e.loadedTypes[28] {
 		return e.CallsReceived, nil
 	}
 	return nil, &NotLoadedError{edge: "calls_received"}
@@ -207,6 +374,8 @@ func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case user.FieldReadReceiptsEnabled:
+			values[i] = new(sql.NullBool)
 		case user.FieldID, user.FieldName, user.FieldEmail, user.FieldPassword, user.FieldUsername, user.FieldBio, user.FieldAvaterURL, user.FieldCoverURL:
 			values[i] = new(sql.NullString)
 		case user.FieldCreatedAt, user.FieldUpdatedAt:
@@ -286,6 +455,12 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.CoverURL = value.String
 			}
+		case user.FieldReadReceiptsEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field read_receipts_enabled", values[i])
+			} else if value.Valid {
+				u.ReadReceiptsEnabled = value.Bool
+			}
 		default:
 			u.selectValues.Set(columns[i], values[i])
 		}
@@ -329,11 +504,81 @@ func (u *User) QueryFriendRequestsReceived() *FriendQuery {
 	return NewUserClient(u.config).QueryFriendRequestsReceived(u)
 }
 
+// QueryFriendInvites queries the "friend_invites" edge of the User entity.
+func (u *User) QueryFriendInvites() *FriendInviteQuery {
+	return NewUserClient(u.config).QueryFriendInvites(u)
+}
+
+// QueryFriendInviteUses queries the "friend_invite_uses" edge of the User entity.
+func (u *User) QueryFriendInviteUses() *FriendInviteUseQuery {
+	return NewUserClient(u.config).QueryFriendInviteUses(u)
+}
+
 // QuerySentMessages queries the "sent_messages" edge of the User entity.
 func (u *User) QuerySentMessages() *MessageQuery {
 	return NewUserClient(u.config).QuerySentMessages(u)
 }
 
+// QueryForwardedMessages queries the "forwarded_messages" edge of the User entity.
+func (u *User) QueryForwardedMessages() *MessageQuery {
+	return NewUserClient(u.config).QueryForwardedMessages(u)
+}
+
+// QueryMessageRevisions queries the "message_revisions" edge of the User entity.
+func (u *User) QueryMessageRevisions() *MessageRevisionQuery {
+	return NewUserClient(u.config).QueryMessageRevisions(u)
+}
+
+// QueryMessageReactions queries the "message_reactions" edge of the User entity.
+func (u *User) QueryMessageReactions() *MessageReactionQuery {
+	return NewUserClient(u.config).QueryMessageReactions(u)
+}
+
+// QueryAttachments queries the "attachments" edge of the User entity.
+func (u *User) QueryAttachments() *AttachmentQuery {
+	return NewUserClient(u.config).QueryAttachments(u)
+}
+
+// QueryMentions queries the "mentions" edge of the User entity.
+func (u *User) QueryMentions() *MessageMentionQuery {
+	return NewUserClient(u.config).QueryMentions(u)
+}
+
+// QueryPinnedMessages queries the "pinned_messages" edge of the User entity.
+func (u *User) QueryPinnedMessages() *PinnedMessageQuery {
+	return NewUserClient(u.config).QueryPinnedMessages(u)
+}
+
+// QueryMessageReceipts queries the "message_receipts" edge of the User entity.
+func (u *User) QueryMessageReceipts() *MessageReceiptQuery {
+	return NewUserClient(u.config).QueryMessageReceipts(u)
+}
+
+// QueryScheduledMessages queries the "scheduled_messages" edge of the User entity.
+func (u *User) QueryScheduledMessages() *ScheduledMessageQuery {
+	return NewUserClient(u.config).QueryScheduledMessages(u)
+}
+
+// QueryDrafts queries the "drafts" edge of the User entity.
+func (u *User) QueryDrafts() *DraftQuery {
+	return NewUserClient(u.config).QueryDrafts(u)
+}
+
+// QueryBookmarks queries the "bookmarks" edge of the User entity.
+func (u *User) QueryBookmarks() *BookmarkQuery {
+	return NewUserClient(u.config).QueryBookmarks(u)
+}
+
+// QueryHiddenMessages queries the "hidden_messages" edge of the User entity.
+func (u *User) QueryHiddenMessages() *HiddenMessageQuery {
+	return NewUserClient(u.config).QueryHiddenMessages(u)
+}
+
+// QueryConversationFolders queries the "conversation_folders" edge of the User entity.
+func (u *User) QueryConversationFolders() *ConversationFolderQuery {
+	return NewUserClient(u.config).QueryConversationFolders(u)
+}
+
 // QueryNotifications queries the "notifications" edge of the User entity.
 func (u *User) QueryNotifications() *NotificationQuery {
 	return NewUserClient(u.config).QueryNotifications(u)
@@ -349,6 +594,11 @@ func (u *User) QueryConversationParticipations() *ConversationParticipantQuery {
 	return NewUserClient(u.config).QueryConversationParticipations(u)
 }
 
+// QueryThreadParticipations queries the "thread_participations" edge of the User entity.
+func (u *User) QueryThreadParticipations() *ThreadParticipantQuery {
+	return NewUserClient(u.config).QueryThreadParticipations(u)
+}
+
 // QueryBlockedUsers queries the "blocked_users" edge of the User entity.
 func (u *User) QueryBlockedUsers() *BlockQuery {
 	return NewUserClient(u.config).QueryBlockedUsers(u)
@@ -417,6 +667,9 @@ func (u *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("cover_url=")
 	builder.WriteString(u.CoverURL)
+	builder.WriteString(", ")
+	builder.WriteString("read_receipts_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", u.ReadReceiptsEnabled))
 	builder.WriteByte(')')
 	return builder.String()
 }
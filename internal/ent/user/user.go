@@ -32,6 +32,8 @@ const (
 	FieldAvaterURL = "avater_url"
 	// FieldCoverURL holds the string denoting the cover_url field in the database.
 	FieldCoverURL = "cover_url"
+	// FieldReadReceiptsEnabled holds the string denoting the read_receipts_enabled field in the database.
+	FieldReadReceiptsEnabled = "read_receipts_enabled"
 	// EdgeSessions holds the string denoting the sessions edge name in mutations.
 	EdgeSessions = "sessions"
 	// EdgeOwnedGuilds holds the string denoting the owned_guilds edge name in mutations.
@@ -44,14 +46,44 @@ const (
 	EdgeFriendRequestsSent = "friend_requests_sent"
 	// EdgeFriendRequestsReceived holds the string denoting the friend_requests_received edge name in mutations.
 	EdgeFriendRequestsReceived = "friend_requests_received"
+	// EdgeFriendInvites holds the string denoting the friend_invites edge name in mutations.
+	EdgeFriendInvites = "friend_invites"
+	// EdgeFriendInviteUses holds the string denoting the friend_invite_uses edge name in mutations.
+	EdgeFriendInviteUses = "friend_invite_uses"
 	// EdgeSentMessages holds the string denoting the sent_messages edge name in mutations.
 	EdgeSentMessages = "sent_messages"
+	// EdgeForwardedMessages holds the string denoting the forwarded_messages edge name in mutations.
+	EdgeForwardedMessages = "forwarded_messages"
+	// EdgeMessageRevisions holds the string denoting the message_revisions edge name in mutations.
+	EdgeMessageRevisions = "message_revisions"
+	// EdgeMessageReactions holds the string denoting the message_reactions edge name in mutations.
+	EdgeMessageReactions = "message_reactions"
+	// EdgeAttachments holds the string denoting the attachments edge name in mutations.
+	EdgeAttachments = "attachments"
+	// EdgeMentions holds the string denoting the mentions edge name in mutations.
+	EdgeMentions = "mentions"
+	// EdgePinnedMessages holds the string denoting the pinned_messages edge name in mutations.
+	EdgePinnedMessages = "pinned_messages"
+	// EdgeMessageReceipts holds the string denoting the message_receipts edge name in mutations.
+	EdgeMessageReceipts = "message_receipts"
+	// EdgeScheduledMessages holds the string denoting the scheduled_messages edge name in mutations.
+	EdgeScheduledMessages = "scheduled_messages"
+	// EdgeDrafts holds the string denoting the drafts edge name in mutations.
+	EdgeDrafts = "drafts"
+	// EdgeBookmarks holds the string denoting the bookmarks edge name in mutations.
+	EdgeBookmarks = "bookmarks"
+	// EdgeHiddenMessages holds the string denoting the hidden_messages edge name in mutations.
+	EdgeHiddenMessages = "hidden_messages"
+	// EdgeConversationFolders holds the string denoting the conversation_folders edge name in mutations.
+	EdgeConversationFolders = "conversation_folders"
 	// EdgeNotifications holds the string denoting the notifications edge name in mutations.
 	EdgeNotifications = "notifications"
 	// EdgeRelatedNotifications holds the string denoting the related_notifications edge name in mutations.
 	EdgeRelatedNotifications = "related_notifications"
 	// EdgeConversationParticipations holds the string denoting the conversation_participations edge name in mutations.
 	EdgeConversationParticipations = "conversation_participations"
+	// EdgeThreadParticipations holds the string denoting the thread_participations edge name in mutations.
+	EdgeThreadParticipations = "thread_participations"
 	// EdgeBlockedUsers holds the string denoting the blocked_users edge name in mutations.
 	EdgeBlockedUsers = "blocked_users"
 	// EdgeBlockedByUsers holds the string denoting the blocked_by_users edge name in mutations.
@@ -104,6 +136,20 @@ const (
 	FriendRequestsReceivedInverseTable = "friends"
 	// FriendRequestsReceivedColumn is the table column denoting the friend_requests_received relation/edge.
 	FriendRequestsReceivedColumn = "addressee_id"
+	// FriendInvitesTable is the table that holds the friend_invites relation/edge.
+	FriendInvitesTable = "friend_invites"
+	// FriendInvitesInverseTable is the table name for the FriendInvite entity.
+	// It exists in this package in order to avoid circular dependency with the "friendinvite" package.
+	FriendInvitesInverseTable = "friend_invites"
+	// FriendInvitesColumn is the table column denoting the friend_invites relation/edge.
+	FriendInvitesColumn = "owner_id"
+	// FriendInviteUsesTable is the table that holds the friend_invite_uses relation/edge.
+	FriendInviteUsesTable = "friend_invite_uses"
+	// FriendInviteUsesInverseTable is the table name for the FriendInviteUse entity.
+	// It exists in this package in order to avoid circular dependency with the "friendinviteuse" package.
+	FriendInviteUsesInverseTable = "friend_invite_uses"
+	// FriendInviteUsesColumn is the table column denoting the friend_invite_uses relation/edge.
+	FriendInviteUsesColumn = "user_id"
 	// SentMessagesTable is the table that holds the sent_messages relation/edge.
 	SentMessagesTable = "messages"
 	// SentMessagesInverseTable is the table name for the Message entity.
@@ -111,6 +157,90 @@ const (
 	SentMessagesInverseTable = "messages"
 	// SentMessagesColumn is the table column denoting the sent_messages relation/edge.
 	SentMessagesColumn = "sender_id"
+	// ForwardedMessagesTable is the table that holds the forwarded_messages relation/edge.
+	ForwardedMessagesTable = "messages"
+	// ForwardedMessagesInverseTable is the table name for the Message entity.
+	// It exists in this package in order to avoid circular dependency with the "message" package.
+	ForwardedMessagesInverseTable = "messages"
+	// ForwardedMessagesColumn is the table column denoting the forwarded_messages relation/edge.
+	ForwardedMessagesColumn = "forwarded_from_user_id"
+	// MessageRevisionsTable is the table that holds the message_revisions relation/edge.
+	MessageRevisionsTable = "message_revisions"
+	// MessageRevisionsInverseTable is the table name for the MessageRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "messagerevision" package.
+	MessageRevisionsInverseTable = "message_revisions"
+	// MessageRevisionsColumn is the table column denoting the message_revisions relation/edge.
+	MessageRevisionsColumn = "editor_id"
+	// MessageReactionsTable is the table that holds the message_reactions relation/edge.
+	MessageReactionsTable = "message_reactions"
+	// MessageReactionsInverseTable is the table name for the MessageReaction entity.
+	// It exists in this package in order to avoid circular dependency with the "messagereaction" package.
+	MessageReactionsInverseTable = "message_reactions"
+	// MessageReactionsColumn is the table column denoting the message_reactions relation/edge.
+	MessageReactionsColumn = "user_id"
+	// AttachmentsTable is the table that holds the attachments relation/edge.
+	AttachmentsTable = "attachments"
+	// AttachmentsInverseTable is the table name for the Attachment entity.
+	// It exists in this package in order to avoid circular dependency with the "attachment" package.
+	AttachmentsInverseTable = "attachments"
+	// AttachmentsColumn is the table column denoting the attachments relation/edge.
+	AttachmentsColumn = "uploader_id"
+	// MentionsTable is the table that holds the mentions relation/edge.
+	MentionsTable = "message_mentions"
+	// MentionsInverseTable is the table name for the MessageMention entity.
+	// It exists in this package in order to avoid circular dependency with the "messagemention" package.
+	MentionsInverseTable = "message_mentions"
+	// MentionsColumn is the table column denoting the mentions relation/edge.
+	MentionsColumn = "user_id"
+	// PinnedMessagesTable is the table that holds the pinned_messages relation/edge.
+	PinnedMessagesTable = "pinned_messages"
+	// PinnedMessagesInverseTable is the table name for the PinnedMessage entity.
+	// It exists in this package in order to avoid circular dependency with the "pinnedmessage" package.
+	PinnedMessagesInverseTable = "pinned_messages"
+	// PinnedMessagesColumn is the table column denoting the pinned_messages relation/edge.
+	PinnedMessagesColumn = "pinned_by_id"
+	// MessageReceiptsTable is the table that holds the message_receipts relation/edge.
+	MessageReceiptsTable = "message_receipts"
+	// MessageReceiptsInverseTable is the table name for the MessageReceipt entity.
+	// It exists in this package in order to avoid circular dependency with the "messagereceipt" package.
+	MessageReceiptsInverseTable = "message_receipts"
+	// MessageReceiptsColumn is the table column denoting the message_receipts relation/edge.
+	MessageReceiptsColumn = "user_id"
+	// ScheduledMessagesTable is the table that holds the scheduled_messages relation/edge.
+	ScheduledMessagesTable = "scheduled_messages"
+	// ScheduledMessagesInverseTable is the table name for the ScheduledMessage entity.
+	// It exists in this package in order to avoid circular dependency with the "scheduledmessage" package.
+	ScheduledMessagesInverseTable = "scheduled_messages"
+	// ScheduledMessagesColumn is the table column denoting the scheduled_messages relation/edge.
+	ScheduledMessagesColumn = "sender_id"
+	// DraftsTable is the table that holds the drafts relation/edge.
+	DraftsTable = "drafts"
+	// DraftsInverseTable is the table name for the Draft entity.
+	// It exists in this package in order to avoid circular dependency with the "draft" package.
+	DraftsInverseTable = "drafts"
+	// DraftsColumn is the table column denoting the drafts relation/edge.
+	DraftsColumn = "user_id"
+	// BookmarksTable is the table that holds the bookmarks relation/edge.
+	BookmarksTable = "bookmarks"
+	// BookmarksInverseTable is the table name for the Bookmark entity.
+	// It exists in this package in order to avoid circular dependency with the "bookmark" package.
+	BookmarksInverseTable = "bookmarks"
+	// BookmarksColumn is the table column denoting the bookmarks relation/edge.
+	BookmarksColumn = "user_id"
+	// HiddenMessagesTable is the table that holds the hidden_messages relation/edge.
+	HiddenMessagesTable = "hidden_messages"
+	// HiddenMessagesInverseTable is the table name for the HiddenMessage entity.
+	// It exists in this package in order to avoid circular dependency with the "hiddenmessage" package.
+	HiddenMessagesInverseTable = "hidden_messages"
+	// HiddenMessagesColumn is the table column denoting the hidden_messages relation/edge.
+	HiddenMessagesColumn = "user_id"
+	// ConversationFoldersTable is the table that holds the conversation_folders relation/edge.
+	ConversationFoldersTable = "conversation_folders"
+	// ConversationFoldersInverseTable is the table name for the ConversationFolder entity.
+	// It exists in this package in order to avoid circular dependency with the "conversationfolder" package.
+	ConversationFoldersInverseTable = "conversation_folders"
+	// ConversationFoldersColumn is the table column denoting the conversation_folders relation/edge.
+	ConversationFoldersColumn = "user_id"
 	// NotificationsTable is the table that holds the notifications relation/edge.
 	NotificationsTable = "notifications"
 	// NotificationsInverseTable is the table name for the Notification entity.
@@ -132,6 +262,13 @@ const (
 	ConversationParticipationsInverseTable = "conversation_participants"
 	// ConversationParticipationsColumn is the table column denoting the conversation_participations relation/edge.
 	ConversationParticipationsColumn = "user_id"
+	// ThreadParticipationsTable is the table that holds the thread_participations relation/edge.
+	ThreadParticipationsTable = "thread_participants"
+	// ThreadParticipationsInverseTable is the table name for the ThreadParticipant entity.
+	// It exists in this package in order to avoid circular dependency with the "threadparticipant" package.
+	ThreadParticipationsInverseTable = "thread_participants"
+	// ThreadParticipationsColumn is the table column denoting the thread_participations relation/edge.
+	ThreadParticipationsColumn = "user_id"
 	// BlockedUsersTable is the table that holds the blocked_users relation/edge.
 	BlockedUsersTable = "blocks"
 	// BlockedUsersInverseTable is the table name for the Block entity.
@@ -174,6 +311,7 @@ var Columns = []string{
 	FieldBio,
 	FieldAvaterURL,
 	FieldCoverURL,
+	FieldReadReceiptsEnabled,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -201,6 +339,8 @@ var (
 	UsernameValidator func(string) error
 	// BioValidator is a validator for the "bio" field. It is called by the builders before save.
 	BioValidator func(string) error
+	// DefaultReadReceiptsEnabled holds the default value on creation for the "read_receipts_enabled" field.
+	DefaultReadReceiptsEnabled bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() string
 )
@@ -258,6 +398,11 @@ func ByCoverURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCoverURL, opts...).ToFunc()
 }
 
+// ByReadReceiptsEnabled orders the results by the read_receipts_enabled field.
+func ByReadReceiptsEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReadReceiptsEnabled, opts...).ToFunc()
+}
+
 // BySessionsCount orders the results by sessions count.
 func BySessionsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -342,6 +487,34 @@ func ByFriendRequestsReceived(term sql.OrderTerm, terms ...sql.OrderTerm) OrderO
 	}
 }
 
+// ByFriendInvitesCount orders the results by friend_invites count.
+func ByFriendInvitesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFriendInvitesStep(), opts...)
+	}
+}
+
+// ByFriendInvites orders the results by friend_invites terms.
+func ByFriendInvites(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFriendInvitesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByFriendInviteUsesCount orders the results by friend_invite_uses count.
+func ByFriendInviteUsesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFriendInviteUsesStep(), opts...)
+	}
+}
+
+// ByFriendInviteUses orders the results by friend_invite_uses terms.
+func ByFriendInviteUses(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFriendInviteUsesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // BySentMessagesCount orders the results by sent_messages count.
 func BySentMessagesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -356,6 +529,174 @@ func BySentMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByForwardedMessagesCount orders the results by forwarded_messages count.
+func ByForwardedMessagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newForwardedMessagesStep(), opts...)
+	}
+}
+
+// ByForwardedMessages orders the results by forwarded_messages terms.
+func ByForwardedMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newForwardedMessagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMessageRevisionsCount orders the results by message_revisions count.
+func ByMessageRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMessageRevisionsStep(), opts...)
+	}
+}
+
+// ByMessageRevisions orders the results by message_revisions terms.
+func ByMessageRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMessageRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMessageReactionsCount orders the results by message_reactions count.
+func ByMessageReactionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMessageReactionsStep(), opts...)
+	}
+}
+
+// ByMessageReactions orders the results by message_reactions terms.
+func ByMessageReactions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMessageReactionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAttachmentsCount orders the results by attachments count.
+func ByAttachmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAttachmentsStep(), opts...)
+	}
+}
+
+// ByAttachments orders the results by attachments terms.
+func ByAttachments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttachmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMentionsCount orders the results by mentions count.
+func ByMentionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMentionsStep(), opts...)
+	}
+}
+
+// ByMentions orders the results by mentions terms.
+func ByMentions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMentionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPinnedMessagesCount orders the results by pinned_messages count.
+func ByPinnedMessagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPinnedMessagesStep(), opts...)
+	}
+}
+
+// ByPinnedMessages orders the results by pinned_messages terms.
+func ByPinnedMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPinnedMessagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMessageReceiptsCount orders the results by message_receipts count.
+func ByMessageReceiptsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMessageReceiptsStep(), opts...)
+	}
+}
+
+// ByMessageReceipts orders the results by message_receipts terms.
+func ByMessageReceipts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMessageReceiptsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByScheduledMessagesCount orders the results by scheduled_messages count.
+func ByScheduledMessagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newScheduledMessagesStep(), opts...)
+	}
+}
+
+// ByScheduledMessages orders the results by scheduled_messages terms.
+func ByScheduledMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newScheduledMessagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDraftsCount orders the results by drafts count.
+func ByDraftsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDraftsStep(), opts...)
+	}
+}
+
+// ByDrafts orders the results by drafts terms.
+func ByDrafts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDraftsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBookmarksCount orders the results by bookmarks count.
+func ByBookmarksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBookmarksStep(), opts...)
+	}
+}
+
+// ByBookmarks orders the results by bookmarks terms.
+func ByBookmarks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBookmarksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByHiddenMessagesCount orders the results by hidden_messages count.
+func ByHiddenMessagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newHiddenMessagesStep(), opts...)
+	}
+}
+
+// ByHiddenMessages orders the results by hidden_messages terms.
+func ByHiddenMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHiddenMessagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByConversationFoldersCount orders the results by conversation_folders count.
+func ByConversationFoldersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newConversationFoldersStep(), opts...)
+	}
+}
+
+// ByConversationFolders orders the results by conversation_folders terms.
+func ByConversationFolders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newConversationFoldersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByNotificationsCount orders the results by notifications count.
 func ByNotificationsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -398,6 +739,20 @@ func ByConversationParticipations(term sql.OrderTerm, terms ...sql.OrderTerm) Or
 	}
 }
 
+// ByThreadParticipationsCount orders the results by thread_participations count.
+func ByThreadParticipationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newThreadParticipationsStep(), opts...)
+	}
+}
+
+// ByThreadParticipations orders the results by thread_participations terms.
+func ByThreadParticipations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newThreadParticipationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByBlockedUsersCount orders the results by blocked_users count.
 func ByBlockedUsersCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -495,6 +850,20 @@ func newFriendRequestsReceivedStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, FriendRequestsReceivedTable, FriendRequestsReceivedColumn),
 	)
 }
+func newFriendInvitesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FriendInvitesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, FriendInvitesTable, FriendInvitesColumn),
+	)
+}
+func newFriendInviteUsesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FriendInviteUsesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, FriendInviteUsesTable, FriendInviteUsesColumn),
+	)
+}
 func newSentMessagesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -502,6 +871,90 @@ func newSentMessagesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, SentMessagesTable, SentMessagesColumn),
 	)
 }
+func newForwardedMessagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ForwardedMessagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, ForwardedMessagesTable, ForwardedMessagesColumn),
+	)
+}
+func newMessageRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MessageRevisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, MessageRevisionsTable, MessageRevisionsColumn),
+	)
+}
+func newMessageReactionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MessageReactionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, MessageReactionsTable, MessageReactionsColumn),
+	)
+}
+func newAttachmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttachmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, AttachmentsTable, AttachmentsColumn),
+	)
+}
+func newMentionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MentionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, MentionsTable, MentionsColumn),
+	)
+}
+func newPinnedMessagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PinnedMessagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, PinnedMessagesTable, PinnedMessagesColumn),
+	)
+}
+func newMessageReceiptsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MessageReceiptsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, MessageReceiptsTable, MessageReceiptsColumn),
+	)
+}
+func newScheduledMessagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ScheduledMessagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, ScheduledMessagesTable, ScheduledMessagesColumn),
+	)
+}
+func newDraftsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DraftsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, DraftsTable, DraftsColumn),
+	)
+}
+func newBookmarksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BookmarksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, BookmarksTable, BookmarksColumn),
+	)
+}
+func newHiddenMessagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HiddenMessagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, HiddenMessagesTable, HiddenMessagesColumn),
+	)
+}
+func newConversationFoldersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ConversationFoldersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, ConversationFoldersTable, ConversationFoldersColumn),
+	)
+}
 func newNotificationsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -523,6 +976,13 @@ func newConversationParticipationsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, ConversationParticipationsTable, ConversationParticipationsColumn),
 	)
 }
+func newThreadParticipationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ThreadParticipationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, ThreadParticipationsTable, ThreadParticipationsColumn),
+	)
+}
 func newBlockedUsersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
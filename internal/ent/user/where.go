@@ -110,6 +110,11 @@ func CoverURL(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCoverURL, v))
 }
 
+// ReadReceiptsEnabled applies equality check predicate on the "read_receipts_enabled" field. It's identical to ReadReceiptsEnabledEQ.
+func ReadReceiptsEnabled(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldReadReceiptsEnabled, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -685,6 +690,16 @@ func CoverURLContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldCoverURL, v))
 }
 
+// ReadReceiptsEnabledEQ applies the EQ predicate on the "read_receipts_enabled" field.
+func ReadReceiptsEnabledEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldReadReceiptsEnabled, v))
+}
+
+// ReadReceiptsEnabledNEQ applies the NEQ predicate on the "read_receipts_enabled" field.
+func ReadReceiptsEnabledNEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldReadReceiptsEnabled, v))
+}
+
 // HasSessions applies the HasEdge predicate on the "sessions" edge.
 func HasSessions() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -823,6 +838,52 @@ func HasFriendRequestsReceivedWith(preds ...predicate.Friend) predicate.User {
 	})
 }
 
+// HasFriendInvites applies the HasEdge predicate on the "friend_invites" edge.
+func HasFriendInvites() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, FriendInvitesTable, FriendInvitesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFriendInvitesWith applies the HasEdge predicate on the "friend_invites" edge with a given conditions (other predicates).
+func HasFriendInvitesWith(preds ...predicate.FriendInvite) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newFriendInvitesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFriendInviteUses applies the HasEdge predicate on the "friend_invite_uses" edge.
+func HasFriendInviteUses() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, FriendInviteUsesTable, FriendInviteUsesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFriendInviteUsesWith applies the HasEdge predicate on the "friend_invite_uses" edge with a given conditions (other predicates).
+func HasFriendInviteUsesWith(preds ...predicate.FriendInviteUse) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newFriendInviteUsesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasSentMessages applies the HasEdge predicate on the "sent_messages" edge.
 func HasSentMessages() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -846,6 +907,282 @@ func HasSentMessagesWith(preds ...predicate.Message) predicate.User {
 	})
 }
 
+// HasForwardedMessages applies the HasEdge predicate on the "forwarded_messages" edge.
+func HasForwardedMessages() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, ForwardedMessagesTable, ForwardedMessagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasForwardedMessagesWith applies the HasEdge predicate on the "forwarded_messages" edge with a given conditions (other predicates).
+func HasForwardedMessagesWith(preds ...predicate.Message) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newForwardedMessagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMessageRevisions applies the HasEdge predicate on the "message_revisions" edge.
+func HasMessageRevisions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, MessageRevisionsTable, MessageRevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMessageRevisionsWith applies the HasEdge predicate on the "message_revisions" edge with a given conditions (other predicates).
+func HasMessageRevisionsWith(preds ...predicate.MessageRevision) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newMessageRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMessageReactions applies the HasEdge predicate on the "message_reactions" edge.
+func HasMessageReactions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, MessageReactionsTable, MessageReactionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMessageReactionsWith applies the HasEdge predicate on the "message_reactions" edge with a given conditions (other predicates).
+func HasMessageReactionsWith(preds ...predicate.MessageReaction) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newMessageReactionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttachments applies the HasEdge predicate on the "attachments" edge.
+func HasAttachments() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, AttachmentsTable, AttachmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttachmentsWith applies the HasEdge predicate on the "attachments" edge with a given conditions (other predicates).
+func HasAttachmentsWith(preds ...predicate.Attachment) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newAttachmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMentions applies the HasEdge predicate on the "mentions" edge.
+func HasMentions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, MentionsTable, MentionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMentionsWith applies the HasEdge predicate on the "mentions" edge with a given conditions (other predicates).
+func HasMentionsWith(preds ...predicate.MessageMention) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newMentionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPinnedMessages applies the HasEdge predicate on the "pinned_messages" edge.
+func HasPinnedMessages() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, PinnedMessagesTable, PinnedMessagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPinnedMessagesWith applies the HasEdge predicate on the "pinned_messages" edge with a given conditions (other predicates).
+func HasPinnedMessagesWith(preds ...predicate.PinnedMessage) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newPinnedMessagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMessageReceipts applies the HasEdge predicate on the "message_receipts" edge.
+func HasMessageReceipts() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, MessageReceiptsTable, MessageReceiptsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMessageReceiptsWith applies the HasEdge predicate on the "message_receipts" edge with a given conditions (other predicates).
+func HasMessageReceiptsWith(preds ...predicate.MessageReceipt) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newMessageReceiptsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasScheduledMessages applies the HasEdge predicate on the "scheduled_messages" edge.
+func HasScheduledMessages() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, ScheduledMessagesTable, ScheduledMessagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasScheduledMessagesWith applies the HasEdge predicate on the "scheduled_messages" edge with a given conditions (other predicates).
+func HasScheduledMessagesWith(preds ...predicate.ScheduledMessage) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newScheduledMessagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDrafts applies the HasEdge predicate on the "drafts" edge.
+func HasDrafts() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, DraftsTable, DraftsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDraftsWith applies the HasEdge predicate on the "drafts" edge with a given conditions (other predicates).
+func HasDraftsWith(preds ...predicate.Draft) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newDraftsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBookmarks applies the HasEdge predicate on the "bookmarks" edge.
+func HasBookmarks() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, BookmarksTable, BookmarksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBookmarksWith applies the HasEdge predicate on the "bookmarks" edge with a given conditions (other predicates).
+func HasBookmarksWith(preds ...predicate.Bookmark) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newBookmarksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasHiddenMessages applies the HasEdge predicate on the "hidden_messages" edge.
+func HasHiddenMessages() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, HiddenMessagesTable, HiddenMessagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHiddenMessagesWith applies the HasEdge predicate on the "hidden_messages" edge with a given conditions (other predicates).
+func HasHiddenMessagesWith(preds ...predicate.HiddenMessage) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newHiddenMessagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasConversationFolders applies the HasEdge predicate on the "conversation_folders" edge.
+func HasConversationFolders() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, ConversationFoldersTable, ConversationFoldersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConversationFoldersWith applies the HasEdge predicate on the "conversation_folders" edge with a given conditions (other predicates).
+func HasConversationFoldersWith(preds ...predicate.ConversationFolder) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newConversationFoldersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasNotifications applies the HasEdge predicate on the "notifications" edge.
 func HasNotifications() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -915,6 +1252,29 @@ func HasConversationParticipationsWith(preds ...predicate.ConversationParticipan
 	})
 }
 
+// HasThreadParticipations applies the HasEdge predicate on the "thread_participations" edge.
+func HasThreadParticipations() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, ThreadParticipationsTable, ThreadParticipationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasThreadParticipationsWith applies the HasEdge predicate on the "thread_participations" edge with a given conditions (other predicates).
+func HasThreadParticipationsWith(preds ...predicate.ThreadParticipant) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newThreadParticipationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasBlockedUsers applies the HasEdge predicate on the "blocked_users" edge.
 func HasBlockedUsers() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
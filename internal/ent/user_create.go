@@ -6,16 +6,30 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"kakashi/chaos/internal/ent/attachment"
 	"kakashi/chaos/internal/ent/block"
+	"kakashi/chaos/internal/ent/bookmark"
 	"kakashi/chaos/internal/ent/call"
+	"kakashi/chaos/internal/ent/conversationfolder"
 	"kakashi/chaos/internal/ent/conversationparticipant"
+	"kakashi/chaos/internal/ent/draft"
 	"kakashi/chaos/internal/ent/friend"
+	"kakashi/chaos/internal/ent/friendinvite"
+	"kakashi/chaos/internal/ent/friendinviteuse"
 	"kakashi/chaos/internal/ent/guild"
+	"kakashi/chaos/internal/ent/hiddenmessage"
 	"kakashi/chaos/internal/ent/invitation"
 	"kakashi/chaos/internal/ent/member"
 	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/messagemention"
+	"kakashi/chaos/internal/ent/messagereaction"
+	"kakashi/chaos/internal/ent/messagereceipt"
+	"kakashi/chaos/internal/ent/messagerevision"
 	"kakashi/chaos/internal/ent/notification"
+	"kakashi/chaos/internal/ent/pinnedmessage"
+	"kakashi/chaos/internal/ent/scheduledmessage"
 	"kakashi/chaos/internal/ent/session"
+	"kakashi/chaos/internal/ent/threadparticipant"
 	"kakashi/chaos/internal/ent/user"
 	"time"
 
@@ -132,6 +146,20 @@ func (uc *UserCreate) SetNillableCoverURL(s *string) *UserCreate {
 	return uc
 }
 
+// SetReadReceiptsEnabled sets the "read_receipts_enabled" field.
+func (uc *UserCreate) SetReadReceiptsEnabled(b bool) *UserCreate {
+	uc.mutation.SetReadReceiptsEnabled(b)
+	return uc
+}
+
+// SetNillableReadReceiptsEnabled sets the "read_receipts_enabled" field if the given value is not nil.
+func (uc *UserCreate) SetNillableReadReceiptsEnabled(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetReadReceiptsEnabled(*b)
+	}
+	return uc
+}
+
 // SetID sets the "id" field.
 func (uc *UserCreate) SetID(s string) *UserCreate {
 	uc.mutation.SetID(s)
@@ -236,6 +264,36 @@ func (uc *UserCreate) AddFriendRequestsReceived(f ...*Friend) *UserCreate {
 	return uc.AddFriendRequestsReceivedIDs(ids...)
 }
 
+// AddFriendInviteIDs adds the "friend_invites" edge to the FriendInvite entity by IDs.
+func (uc *UserCreate) AddFriendInviteIDs(ids ...string) *UserCreate {
+	uc.mutation.AddFriendInviteIDs(ids...)
+	return uc
+}
+
+// AddFriendInvites adds the "friend_invites" edges to the FriendInvite entity.
+func (uc *UserCreate) AddFriendInvites(f ...*FriendInvite) *UserCreate {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uc.AddFriendInviteIDs(ids...)
+}
+
+// AddFriendInviteUseIDs adds the "friend_invite_uses" edge to the FriendInviteUse entity by IDs.
+func (uc *UserCreate) AddFriendInviteUseIDs(ids ...string) *UserCreate {
+	uc.mutation.AddFriendInviteUseIDs(ids...)
+	return uc
+}
+
+// AddFriendInviteUses adds the "friend_invite_uses" edges to the FriendInviteUse entity.
+func (uc *UserCreate) AddFriendInviteUses(f ...*FriendInviteUse) *UserCreate {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uc.AddFriendInviteUseIDs(ids...)
+}
+
 // AddSentMessageIDs adds the "sent_messages" edge to the Message entity by IDs.
 func (uc *UserCreate) AddSentMessageIDs(ids ...string) *UserCreate {
 	uc.mutation.AddSentMessageIDs(ids...)
@@ -251,6 +309,186 @@ func (uc *UserCreate) AddSentMessages(m ...*Message) *UserCreate {
 	return uc.AddSentMessageIDs(ids...)
 }
 
+// AddForwardedMessageIDs adds the "forwarded_messages" edge to the Message entity by IDs.
+func (uc *UserCreate) AddForwardedMessageIDs(ids ...string) *UserCreate {
+	uc.mutation.AddForwardedMessageIDs(ids...)
+	return uc
+}
+
+// AddForwardedMessages adds the "forwarded_messages" edges to the Message entity.
+func (uc *UserCreate) AddForwardedMessages(m ...*Message) *UserCreate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uc.AddForwardedMessageIDs(ids...)
+}
+
+// AddMessageRevisionIDs adds the "message_revisions" edge to the MessageRevision entity by IDs.
+func (uc *UserCreate) AddMessageRevisionIDs(ids ...string) *UserCreate {
+	uc.mutation.AddMessageRevisionIDs(ids...)
+	return uc
+}
+
+// AddMessageRevisions adds the "message_revisions" edges to the MessageRevision entity.
+func (uc *UserCreate) AddMessageRevisions(m ...*MessageRevision) *UserCreate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uc.AddMessageRevisionIDs(ids...)
+}
+
+// AddMessageReactionIDs adds the "message_reactions" edge to the MessageReaction entity by IDs.
+func (uc *UserCreate) AddMessageReactionIDs(ids ...string) *UserCreate {
+	uc.mutation.AddMessageReactionIDs(ids...)
+	return uc
+}
+
+// AddMessageReactions adds the "message_reactions" edges to the MessageReaction entity.
+func (uc *UserCreate) AddMessageReactions(m ...*MessageReaction) *UserCreate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uc.AddMessageReactionIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (uc *UserCreate) AddAttachmentIDs(ids ...string) *UserCreate {
+	uc.mutation.AddAttachmentIDs(ids...)
+	return uc
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (uc *UserCreate) AddAttachments(a ...*Attachment) *UserCreate {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uc.AddAttachmentIDs(ids...)
+}
+
+// AddMentionIDs adds the "mentions" edge to the MessageMention entity by IDs.
+func (uc *UserCreate) AddMentionIDs(ids ...string) *UserCreate {
+	uc.mutation.AddMentionIDs(ids...)
+	return uc
+}
+
+// AddMentions adds the "mentions" edges to the MessageMention entity.
+func (uc *UserCreate) AddMentions(m ...*MessageMention) *UserCreate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uc.AddMentionIDs(ids...)
+}
+
+// AddPinnedMessageIDs adds the "pinned_messages" edge to the PinnedMessage entity by IDs.
+func (uc *UserCreate) AddPinnedMessageIDs(ids ...string) *UserCreate {
+	uc.mutation.AddPinnedMessageIDs(ids...)
+	return uc
+}
+
+// AddPinnedMessages adds the "pinned_messages" edges to the PinnedMessage entity.
+func (uc *UserCreate) AddPinnedMessages(p ...*PinnedMessage) *UserCreate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uc.AddPinnedMessageIDs(ids...)
+}
+
+// AddMessageReceiptIDs adds the "message_receipts" edge to the MessageReceipt entity by IDs.
+func (uc *UserCreate) AddMessageReceiptIDs(ids ...string) *UserCreate {
+	uc.mutation.AddMessageReceiptIDs(ids...)
+	return uc
+}
+
+// AddMessageReceipts adds the "message_receipts" edges to the MessageReceipt entity.
+func (uc *UserCreate) AddMessageReceipts(m ...*MessageReceipt) *UserCreate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uc.AddMessageReceiptIDs(ids...)
+}
+
+// AddScheduledMessageIDs adds the "scheduled_messages" edge to the ScheduledMessage entity by IDs.
+func (uc *UserCreate) AddScheduledMessageIDs(ids ...string) *UserCreate {
+	uc.mutation.AddScheduledMessageIDs(ids...)
+	return uc
+}
+
+// AddScheduledMessages adds the "scheduled_messages" edges to the ScheduledMessage entity.
+func (uc *UserCreate) AddScheduledMessages(s ...*ScheduledMessage) *UserCreate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddScheduledMessageIDs(ids...)
+}
+
+// AddDraftIDs adds the "drafts" edge to the Draft entity by IDs.
+func (uc *UserCreate) AddDraftIDs(ids ...string) *UserCreate {
+	uc.mutation.AddDraftIDs(ids...)
+	return uc
+}
+
+// AddDrafts adds the "drafts" edges to the Draft entity.
+func (uc *UserCreate) AddDrafts(d ...*Draft) *UserCreate {
+	ids := make([]string, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uc.AddDraftIDs(ids...)
+}
+
+// AddBookmarkIDs adds the "bookmarks" edge to the Bookmark entity by IDs.
+func (uc *UserCreate) AddBookmarkIDs(ids ...string) *UserCreate {
+	uc.mutation.AddBookmarkIDs(ids...)
+	return uc
+}
+
+// AddBookmarks adds the "bookmarks" edges to the Bookmark entity.
+func (uc *UserCreate) AddBookmarks(b ...*Bookmark) *UserCreate {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uc.AddBookmarkIDs(ids...)
+}
+
+// AddHiddenMessageIDs adds the "hidden_messages" edge to the HiddenMessage entity by IDs.
+func (uc *UserCreate) AddHiddenMessageIDs(ids ...string) *UserCreate {
+	uc.mutation.AddHiddenMessageIDs(ids...)
+	return uc
+}
+
+// AddHiddenMessages adds the "hidden_messages" edges to the HiddenMessage entity.
+func (uc *UserCreate) AddHiddenMessages(h ...*HiddenMessage) *UserCreate {
+	ids := make([]string, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return uc.AddHiddenMessageIDs(ids...)
+}
+
+// AddConversationFolderIDs adds the "conversation_folders" edge to the ConversationFolder entity by IDs.
+func (uc *UserCreate) AddConversationFolderIDs(ids ...string) *UserCreate {
+	uc.mutation.AddConversationFolderIDs(ids...)
+	return uc
+}
+
+// AddConversationFolders adds the "conversation_folders" edges to the ConversationFolder entity.
+func (uc *UserCreate) AddConversationFolders(c ...*ConversationFolder) *UserCreate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uc.AddConversationFolderIDs(ids...)
+}
+
 // AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
 func (uc *UserCreate) AddNotificationIDs(ids ...string) *UserCreate {
 	uc.mutation.AddNotificationIDs(ids...)
@@ -296,6 +534,21 @@ func (uc *UserCreate) AddConversationParticipations(c ...*ConversationParticipan
 	return uc.AddConversationParticipationIDs(ids...)
 }
 
+// AddThreadParticipationIDs adds the "thread_participations" edge to the ThreadParticipant entity by IDs.
+func (uc *UserCreate) AddThreadParticipationIDs(ids ...string) *UserCreate {
+	uc.mutation.AddThreadParticipationIDs(ids...)
+	return uc
+}
+
+// AddThreadParticipations adds the "thread_participations" edges to the ThreadParticipant entity.
+func (uc *UserCreate) AddThreadParticipations(t ...*ThreadParticipant) *UserCreate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uc.AddThreadParticipationIDs(ids...)
+}
+
 // AddBlockedUserIDs adds the "blocked_users" edge to the Block entity by IDs.
 func (uc *UserCreate) AddBlockedUserIDs(ids ...string) *UserCreate {
 	uc.mutation.AddBlockedUserIDs(ids...)
@@ -399,6 +652,10 @@ func (uc *UserCreate) defaults() {
 		v := user.DefaultUpdatedAt()
 		uc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := uc.mutation.ReadReceiptsEnabled(); !ok {
+		v := user.DefaultReadReceiptsEnabled
+		uc.mutation.SetReadReceiptsEnabled(v)
+	}
 	if _, ok := uc.mutation.ID(); !ok {
 		v := user.DefaultID()
 		uc.mutation.SetID(v)
@@ -442,6 +699,9 @@ func (uc *UserCreate) check() error {
 			return &ValidationError{Name: "bio", err: fmt.Errorf(`ent: validator failed for field "User.bio": %w`, err)}
 		}
 	}
+	if _, ok := uc.mutation.ReadReceiptsEnabled(); !ok {
+		return &ValidationError{Name: "read_receipts_enabled", err: errors.New(`ent: missing required field "User.read_receipts_enabled"`)}
+	}
 	return nil
 }
 
@@ -513,6 +773,10 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldCoverURL, field.TypeString, value)
 		_node.CoverURL = value
 	}
+	if value, ok := uc.mutation.ReadReceiptsEnabled(); ok {
+		_spec.SetField(user.FieldReadReceiptsEnabled, field.TypeBool, value)
+		_node.ReadReceiptsEnabled = value
+	}
 	if nodes := uc.mutation.SessionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -609,6 +873,38 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.FriendInvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.FriendInvitesTable,
+			Columns: []string{user.FriendInvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendinvite.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.FriendInviteUsesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.FriendInviteUsesTable,
+			Columns: []string{user.FriendInviteUsesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendinviteuse.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := uc.mutation.SentMessagesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -625,6 +921,198 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.ForwardedMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ForwardedMessagesTable,
+			Columns: []string{user.ForwardedMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.MessageRevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MessageRevisionsTable,
+			Columns: []string{user.MessageRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.MessageReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MessageReactionsTable,
+			Columns: []string{user.MessageReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.AttachmentsTable,
+			Columns: []string{user.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MentionsTable,
+			Columns: []string{user.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.PinnedMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.PinnedMessagesTable,
+			Columns: []string{user.PinnedMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.MessageReceiptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MessageReceiptsTable,
+			Columns: []string{user.MessageReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereceipt.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ScheduledMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ScheduledMessagesTable,
+			Columns: []string{user.ScheduledMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.DraftsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.DraftsTable,
+			Columns: []string{user.DraftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(draft.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.BookmarksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.BookmarksTable,
+			Columns: []string{user.BookmarksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.HiddenMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.HiddenMessagesTable,
+			Columns: []string{user.HiddenMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hiddenmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ConversationFoldersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ConversationFoldersTable,
+			Columns: []string{user.ConversationFoldersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationfolder.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := uc.mutation.NotificationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -673,6 +1161,22 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.ThreadParticipationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ThreadParticipationsTable,
+			Columns: []string{user.ThreadParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(threadparticipant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := uc.mutation.BlockedUsersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
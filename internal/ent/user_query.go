@@ -6,17 +6,31 @@ import (
 	"context"
 	"database/sql/driver"
 	"fmt"
+	"kakashi/chaos/internal/ent/attachment"
 	"kakashi/chaos/internal/ent/block"
+	"kakashi/chaos/internal/ent/bookmark"
 	"kakashi/chaos/internal/ent/call"
+	"kakashi/chaos/internal/ent/conversationfolder"
 	"kakashi/chaos/internal/ent/conversationparticipant"
+	"kakashi/chaos/internal/ent/draft"
 	"kakashi/chaos/internal/ent/friend"
+	"kakashi/chaos/internal/ent/friendinvite"
+	"kakashi/chaos/internal/ent/friendinviteuse"
 	"kakashi/chaos/internal/ent/guild"
+	"kakashi/chaos/internal/ent/hiddenmessage"
 	"kakashi/chaos/internal/ent/invitation"
 	"kakashi/chaos/internal/ent/member"
 	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/messagemention"
+	"kakashi/chaos/internal/ent/messagereaction"
+	"kakashi/chaos/internal/ent/messagereceipt"
+	"kakashi/chaos/internal/ent/messagerevision"
 	"kakashi/chaos/internal/ent/notification"
+	"kakashi/chaos/internal/ent/pinnedmessage"
 	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/scheduledmessage"
 	"kakashi/chaos/internal/ent/session"
+	"kakashi/chaos/internal/ent/threadparticipant"
 	"kakashi/chaos/internal/ent/user"
 	"math"
 
@@ -39,10 +53,25 @@ type UserQuery struct {
 	withMemberOf                   *MemberQuery
 	withFriendRequestsSent         *FriendQuery
 	withFriendRequestsReceived     *FriendQuery
+	withFriendInvites              *FriendInviteQuery
+	withFriendInviteUses           *FriendInviteUseQuery
 	withSentMessages               *MessageQuery
+	withForwardedMessages          *MessageQuery
+	withMessageRevisions           *MessageRevisionQuery
+	withMessageReactions           *MessageReactionQuery
+	withAttachments                *AttachmentQuery
+	withMentions                   *MessageMentionQuery
+	withPinnedMessages             *PinnedMessageQuery
+	withMessageReceipts            *MessageReceiptQuery
+	withScheduledMessages          *ScheduledMessageQuery
+	withDrafts                     *DraftQuery
+	withBookmarks                  *BookmarkQuery
+	withHiddenMessages             *HiddenMessageQuery
+	withConversationFolders        *ConversationFolderQuery
 	withNotifications              *NotificationQuery
 	withRelatedNotifications       *NotificationQuery
 	withConversationParticipations *ConversationParticipantQuery
+	withThreadParticipations       *ThreadParticipantQuery
 	withBlockedUsers               *BlockQuery
 	withBlockedByUsers             *BlockQuery
 	withCallsMade                  *CallQuery
@@ -215,6 +244,50 @@ func (uq *UserQuery) QueryFriendRequestsReceived() *FriendQuery {
 	return query
 }
 
+// QueryFriendInvites chains the current query on the "friend_invites" edge.
+func (uq *UserQuery) QueryFriendInvites() *FriendInviteQuery {
+	query := (&FriendInviteClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(friendinvite.Table, friendinvite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.FriendInvitesTable, user.FriendInvitesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryFriendInviteUses chains the current query on the "friend_invite_uses" edge.
+func (uq *UserQuery) QueryFriendInviteUses() *FriendInviteUseQuery {
+	query := (&FriendInviteUseClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(friendinviteuse.Table, friendinviteuse.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.FriendInviteUsesTable, user.FriendInviteUsesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QuerySentMessages chains the current query on the "sent_messages" edge.
 func (uq *UserQuery) QuerySentMessages() *MessageQuery {
 	query := (&MessageClient{config: uq.config}).Query()
@@ -237,9 +310,9 @@ func (uq *UserQuery) QuerySentMessages() *MessageQuery {
 	return query
 }
 
-// QueryNotifications chains the current query on the "notifications" edge.
-func (uq *UserQuery) QueryNotifications() *NotificationQuery {
-	query := (&NotificationClient{config: uq.config}).Query()
+// QueryForwardedMessages chains the current query on the "forwarded_messages" edge.
+func (uq *UserQuery) QueryForwardedMessages() *MessageQuery {
+	query := (&MessageClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -250,8 +323,8 @@ func (uq *UserQuery) QueryNotifications() *NotificationQuery {
 		}
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, selector),
-			sqlgraph.To(notification.Table, notification.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, user.NotificationsTable, user.NotificationsColumn),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.ForwardedMessagesTable, user.ForwardedMessagesColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
 		return fromU, nil
@@ -259,9 +332,9 @@ func (uq *UserQuery) QueryNotifications() *NotificationQuery {
 	return query
 }
 
-// QueryRelatedNotifications chains the current query on the "related_notifications" edge.
-func (uq *UserQuery) QueryRelatedNotifications() *NotificationQuery {
-	query := (&NotificationClient{config: uq.config}).Query()
+// QueryMessageRevisions chains the current query on the "message_revisions" edge.
+func (uq *UserQuery) QueryMessageRevisions() *MessageRevisionQuery {
+	query := (&MessageRevisionClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -272,8 +345,8 @@ func (uq *UserQuery) QueryRelatedNotifications() *NotificationQuery {
 		}
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, selector),
-			sqlgraph.To(notification.Table, notification.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, user.RelatedNotificationsTable, user.RelatedNotificationsColumn),
+			sqlgraph.To(messagerevision.Table, messagerevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.MessageRevisionsTable, user.MessageRevisionsColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
 		return fromU, nil
@@ -281,9 +354,9 @@ func (uq *UserQuery) QueryRelatedNotifications() *NotificationQuery {
 	return query
 }
 
-// QueryConversationParticipations chains the current query on the "conversation_participations" edge.
-func (uq *UserQuery) QueryConversationParticipations() *ConversationParticipantQuery {
-	query := (&ConversationParticipantClient{config: uq.config}).Query()
+// QueryMessageReactions chains the current query on the "message_reactions" edge.
+func (uq *UserQuery) QueryMessageReactions() *MessageReactionQuery {
+	query := (&MessageReactionClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -294,8 +367,8 @@ func (uq *UserQuery) QueryConversationParticipations() *ConversationParticipantQ
 		}
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, selector),
-			sqlgraph.To(conversationparticipant.Table, conversationparticipant.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, user.ConversationParticipationsTable, user.ConversationParticipationsColumn),
+			sqlgraph.To(messagereaction.Table, messagereaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.MessageReactionsTable, user.MessageReactionsColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
 		return fromU, nil
@@ -303,9 +376,9 @@ func (uq *UserQuery) QueryConversationParticipations() *ConversationParticipantQ
 	return query
 }
 
-// QueryBlockedUsers chains the current query on the "blocked_users" edge.
-func (uq *UserQuery) QueryBlockedUsers() *BlockQuery {
-	query := (&BlockClient{config: uq.config}).Query()
+// QueryAttachments chains the current query on the "attachments" edge.
+func (uq *UserQuery) QueryAttachments() *AttachmentQuery {
+	query := (&AttachmentClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -316,8 +389,8 @@ func (uq *UserQuery) QueryBlockedUsers() *BlockQuery {
 		}
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, selector),
-			sqlgraph.To(block.Table, block.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, user.BlockedUsersTable, user.BlockedUsersColumn),
+			sqlgraph.To(attachment.Table, attachment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.AttachmentsTable, user.AttachmentsColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
 		return fromU, nil
@@ -325,9 +398,9 @@ func (uq *UserQuery) QueryBlockedUsers() *BlockQuery {
 	return query
 }
 
-// QueryBlockedByUsers chains the current query on the "blocked_by_users" edge.
-func (uq *UserQuery) QueryBlockedByUsers() *BlockQuery {
-	query := (&BlockClient{config: uq.config}).Query()
+// QueryMentions chains the current query on the "mentions" edge.
+func (uq *UserQuery) QueryMentions() *MessageMentionQuery {
+	query := (&MessageMentionClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -338,8 +411,8 @@ func (uq *UserQuery) QueryBlockedByUsers() *BlockQuery {
 		}
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, selector),
-			sqlgraph.To(block.Table, block.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, user.BlockedByUsersTable, user.BlockedByUsersColumn),
+			sqlgraph.To(messagemention.Table, messagemention.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.MentionsTable, user.MentionsColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
 		return fromU, nil
@@ -347,9 +420,9 @@ func (uq *UserQuery) QueryBlockedByUsers() *BlockQuery {
 	return query
 }
 
-// QueryCallsMade chains the current query on the "calls_made" edge.
-func (uq *UserQuery) QueryCallsMade() *CallQuery {
-	query := (&CallClient{config: uq.config}).Query()
+// QueryPinnedMessages chains the current query on the "pinned_messages" edge.
+func (uq *UserQuery) QueryPinnedMessages() *PinnedMessageQuery {
+	query := (&PinnedMessageClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -360,8 +433,8 @@ func (uq *UserQuery) QueryCallsMade() *CallQuery {
 		}
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, selector),
-			sqlgraph.To(call.Table, call.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, user.CallsMadeTable, user.CallsMadeColumn),
+			sqlgraph.To(pinnedmessage.Table, pinnedmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.PinnedMessagesTable, user.PinnedMessagesColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
 		return fromU, nil
@@ -369,9 +442,9 @@ func (uq *UserQuery) QueryCallsMade() *CallQuery {
 	return query
 }
 
-// QueryCallsReceived chains the current query on the "calls_received" edge.
-func (uq *UserQuery) QueryCallsReceived() *CallQuery {
-	query := (&CallClient{config: uq.config}).Query()
+// QueryMessageReceipts chains the current query on the "message_receipts" edge.
+func (uq *UserQuery) QueryMessageReceipts() *MessageReceiptQuery {
+	query := (&MessageReceiptClient{config: uq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -382,8 +455,8 @@ func (uq *UserQuery) QueryCallsReceived() *CallQuery {
 		}
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, selector),
-			sqlgraph.To(call.Table, call.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, user.CallsReceivedTable, user.CallsReceivedColumn),
+			sqlgraph.To(messagereceipt.Table, messagereceipt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.MessageReceiptsTable, user.MessageReceiptsColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
 		return fromU, nil
@@ -391,104 +464,390 @@ func (uq *UserQuery) QueryCallsReceived() *CallQuery {
 	return query
 }
 
-// First returns the first User entity from the query.
-// Returns a *NotFoundError when no User was found.
-func (uq *UserQuery) First(ctx context.Context) (*User, error) {
-	nodes, err := uq.Limit(1).All(setContextOp(ctx, uq.ctx, ent.OpQueryFirst))
-	if err != nil {
-		return nil, err
-	}
-	if len(nodes) == 0 {
-		return nil, &NotFoundError{user.Label}
+// QueryScheduledMessages chains the current query on the "scheduled_messages" edge.
+func (uq *UserQuery) QueryScheduledMessages() *ScheduledMessageQuery {
+	query := (&ScheduledMessageClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(scheduledmessage.Table, scheduledmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.ScheduledMessagesTable, user.ScheduledMessagesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return nodes[0], nil
+	return query
 }
 
-// FirstX is like First, but panics if an error occurs.
-func (uq *UserQuery) FirstX(ctx context.Context) *User {
-	node, err := uq.First(ctx)
-	if err != nil && !IsNotFound(err) {
-		panic(err)
+// QueryDrafts chains the current query on the "drafts" edge.
+func (uq *UserQuery) QueryDrafts() *DraftQuery {
+	query := (&DraftClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(draft.Table, draft.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.DraftsTable, user.DraftsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return node
+	return query
 }
 
-// FirstID returns the first User ID from the query.
-// Returns a *NotFoundError when no User ID was found.
-func (uq *UserQuery) FirstID(ctx context.Context) (id string, err error) {
-	var ids []string
-	if ids, err = uq.Limit(1).IDs(setContextOp(ctx, uq.ctx, ent.OpQueryFirstID)); err != nil {
-		return
-	}
-	if len(ids) == 0 {
-		err = &NotFoundError{user.Label}
-		return
+// QueryBookmarks chains the current query on the "bookmarks" edge.
+func (uq *UserQuery) QueryBookmarks() *BookmarkQuery {
+	query := (&BookmarkClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(bookmark.Table, bookmark.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.BookmarksTable, user.BookmarksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return ids[0], nil
+	return query
 }
 
-// FirstIDX is like FirstID, but panics if an error occurs.
-func (uq *UserQuery) FirstIDX(ctx context.Context) string {
-	id, err := uq.FirstID(ctx)
-	if err != nil && !IsNotFound(err) {
-		panic(err)
+// QueryHiddenMessages chains the current query on the "hidden_messages" edge.
+func (uq *UserQuery) QueryHiddenMessages() *HiddenMessageQuery {
+	query := (&HiddenMessageClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(hiddenmessage.Table, hiddenmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.HiddenMessagesTable, user.HiddenMessagesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return id
+	return query
 }
 
-// Only returns a single User entity found by the query, ensuring it only returns one.
-// Returns a *NotSingularError when more than one User entity is found.
-// Returns a *NotFoundError when no User entities are found.
-func (uq *UserQuery) Only(ctx context.Context) (*User, error) {
-	nodes, err := uq.Limit(2).All(setContextOp(ctx, uq.ctx, ent.OpQueryOnly))
-	if err != nil {
-		return nil, err
-	}
-	switch len(nodes) {
-	case 1:
-		return nodes[0], nil
-	case 0:
-		return nil, &NotFoundError{user.Label}
-	default:
-		return nil, &NotSingularError{user.Label}
+// QueryConversationFolders chains the current query on the "conversation_folders" edge.
+func (uq *UserQuery) QueryConversationFolders() *ConversationFolderQuery {
+	query := (&ConversationFolderClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(conversationfolder.Table, conversationfolder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.ConversationFoldersTable, user.ConversationFoldersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
+	return query
 }
 
-// OnlyX is like Only, but panics if an error occurs.
-func (uq *UserQuery) OnlyX(ctx context.Context) *User {
-	node, err := uq.Only(ctx)
-	if err != nil {
-		panic(err)
+// QueryNotifications chains the current query on the "notifications" edge.
+func (uq *UserQuery) QueryNotifications() *NotificationQuery {
+	query := (&NotificationClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(notification.Table, notification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.NotificationsTable, user.NotificationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return node
+	return query
 }
 
-// OnlyID is like Only, but returns the only User ID in the query.
-// Returns a *NotSingularError when more than one User ID is found.
-// Returns a *NotFoundError when no entities are found.
-func (uq *UserQuery) OnlyID(ctx context.Context) (id string, err error) {
-	var ids []string
-	if ids, err = uq.Limit(2).IDs(setContextOp(ctx, uq.ctx, ent.OpQueryOnlyID)); err != nil {
-		return
-	}
-	switch len(ids) {
-	case 1:
-		id = ids[0]
-	case 0:
-		err = &NotFoundError{user.Label}
-	default:
-		err = &NotSingularError{user.Label}
+// QueryRelatedNotifications chains the current query on the "related_notifications" edge.
+func (uq *UserQuery) QueryRelatedNotifications() *NotificationQuery {
+	query := (&NotificationClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(notification.Table, notification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.RelatedNotificationsTable, user.RelatedNotificationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return
+	return query
 }
 
-// OnlyIDX is like OnlyID, but panics if an error occurs.
-func (uq *UserQuery) OnlyIDX(ctx context.Context) string {
-	id, err := uq.OnlyID(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return id
+// QueryConversationParticipations chains the current query on the "conversation_participations" edge.
+func (uq *UserQuery) QueryConversationParticipations() *ConversationParticipantQuery {
+	query := (&ConversationParticipantClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(conversationparticipant.Table, conversationparticipant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.ConversationParticipationsTable, user.ConversationParticipationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryThreadParticipations chains the current query on the "thread_participations" edge.
+func (uq *UserQuery) QueryThreadParticipations() *ThreadParticipantQuery {
+	query := (&ThreadParticipantClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(threadparticipant.Table, threadparticipant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.ThreadParticipationsTable, user.ThreadParticipationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBlockedUsers chains the current query on the "blocked_users" edge.
+func (uq *UserQuery) QueryBlockedUsers() *BlockQuery {
+	query := (&BlockClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(block.Table, block.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.BlockedUsersTable, user.BlockedUsersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBlockedByUsers chains the current query on the "blocked_by_users" edge.
+func (uq *UserQuery) QueryBlockedByUsers() *BlockQuery {
+	query := (&BlockClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(block.Table, block.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.BlockedByUsersTable, user.BlockedByUsersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCallsMade chains the current query on the "calls_made" edge.
+func (uq *UserQuery) QueryCallsMade() *CallQuery {
+	query := (&CallClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(call.Table, call.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.CallsMadeTable, user.CallsMadeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCallsReceived chains the current query on the "calls_received" edge.
+func (uq *UserQuery) QueryCallsReceived() *CallQuery {
+	query := (&CallClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(call.Table, call.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.CallsReceivedTable, user.CallsReceivedColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first User entity from the query.
+// Returns a *NotFoundError when no User was found.
+func (uq *UserQuery) First(ctx context.Context) (*User, error) {
+	nodes, err := uq.Limit(1).All(setContextOp(ctx, uq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{user.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (uq *UserQuery) FirstX(ctx context.Context) *User {
+	node, err := uq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first User ID from the query.
+// Returns a *NotFoundError when no User ID was found.
+func (uq *UserQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = uq.Limit(1).IDs(setContextOp(ctx, uq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{user.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (uq *UserQuery) FirstIDX(ctx context.Context) string {
+	id, err := uq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single User entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one User entity is found.
+// Returns a *NotFoundError when no User entities are found.
+func (uq *UserQuery) Only(ctx context.Context) (*User, error) {
+	nodes, err := uq.Limit(2).All(setContextOp(ctx, uq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{user.Label}
+	default:
+		return nil, &NotSingularError{user.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (uq *UserQuery) OnlyX(ctx context.Context) *User {
+	node, err := uq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only User ID in the query.
+// Returns a *NotSingularError when more than one User ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (uq *UserQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = uq.Limit(2).IDs(setContextOp(ctx, uq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{user.Label}
+	default:
+		err = &NotSingularError{user.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (uq *UserQuery) OnlyIDX(ctx context.Context) string {
+	id, err := uq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
 }
 
 // All executes the query and returns a list of Users.
@@ -589,10 +948,25 @@ func (uq *UserQuery) Clone() *UserQuery {
 		withMemberOf:                   uq.withMemberOf.Clone(),
 		withFriendRequestsSent:         uq.withFriendRequestsSent.Clone(),
 		withFriendRequestsReceived:     uq.withFriendRequestsReceived.Clone(),
+		withFriendInvites:              uq.withFriendInvites.Clone(),
+		withFriendInviteUses:           uq.withFriendInviteUses.Clone(),
 		withSentMessages:               uq.withSentMessages.Clone(),
+		withForwardedMessages:          uq.withForwardedMessages.Clone(),
+		withMessageRevisions:           uq.withMessageRevisions.Clone(),
+		withMessageReactions:           uq.withMessageReactions.Clone(),
+		withAttachments:                uq.withAttachments.Clone(),
+		withMentions:                   uq.withMentions.Clone(),
+		withPinnedMessages:             uq.withPinnedMessages.Clone(),
+		withMessageReceipts:            uq.withMessageReceipts.Clone(),
+		withScheduledMessages:          uq.withScheduledMessages.Clone(),
+		withDrafts:                     uq.withDrafts.Clone(),
+		withBookmarks:                  uq.withBookmarks.Clone(),
+		withHiddenMessages:             uq.withHiddenMessages.Clone(),
+		withConversationFolders:        uq.withConversationFolders.Clone(),
 		withNotifications:              uq.withNotifications.Clone(),
 		withRelatedNotifications:       uq.withRelatedNotifications.Clone(),
 		withConversationParticipations: uq.withConversationParticipations.Clone(),
+		withThreadParticipations:       uq.withThreadParticipations.Clone(),
 		withBlockedUsers:               uq.withBlockedUsers.Clone(),
 		withBlockedByUsers:             uq.withBlockedByUsers.Clone(),
 		withCallsMade:                  uq.withCallsMade.Clone(),
@@ -669,58 +1043,223 @@ func (uq *UserQuery) WithFriendRequestsReceived(opts ...func(*FriendQuery)) *Use
 	return uq
 }
 
-// WithSentMessages tells the query-builder to eager-load the nodes that are connected to
-// the "sent_messages" edge. The optional arguments are used to configure the query builder of the edge.
-func (uq *UserQuery) WithSentMessages(opts ...func(*MessageQuery)) *UserQuery {
-	query := (&MessageClient{config: uq.config}).Query()
+// WithFriendInvites tells the query-builder to eager-load the nodes that are connected to
+// the "friend_invites" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithFriendInvites(opts ...func(*FriendInviteQuery)) *UserQuery {
+	query := (&FriendInviteClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	uq.withSentMessages = query
+	uq.withFriendInvites = query
 	return uq
 }
 
-// WithNotifications tells the query-builder to eager-load the nodes that are connected to
-// the "notifications" edge. The optional arguments are used to configure the query builder of the edge.
-func (uq *UserQuery) WithNotifications(opts ...func(*NotificationQuery)) *UserQuery {
-	query := (&NotificationClient{config: uq.config}).Query()
+// WithFriendInviteUses tells the query-builder to eager-load the nodes that are connected to
+// the "friend_invite_uses" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithFriendInviteUses(opts ...func(*FriendInviteUseQuery)) *UserQuery {
+	query := (&FriendInviteUseClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	uq.withNotifications = query
+	uq.withFriendInviteUses = query
 	return uq
 }
 
-// WithRelatedNotifications tells the query-builder to eager-load the nodes that are connected to
-// the "related_notifications" edge. The optional arguments are used to configure the query builder of the edge.
-func (uq *UserQuery) WithRelatedNotifications(opts ...func(*NotificationQuery)) *UserQuery {
-	query := (&NotificationClient{config: uq.config}).Query()
+// WithSentMessages tells the query-builder to eager-load the nodes that are connected to
+// the "sent_messages" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithSentMessages(opts ...func(*MessageQuery)) *UserQuery {
+	query := (&MessageClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	uq.withRelatedNotifications = query
+	uq.withSentMessages = query
 	return uq
 }
 
-// WithConversationParticipations tells the query-builder to eager-load the nodes that are connected to
-// the "conversation_participations" edge. The optional arguments are used to configure the query builder of the edge.
-func (uq *UserQuery) WithConversationParticipations(opts ...func(*ConversationParticipantQuery)) *UserQuery {
-	query := (&ConversationParticipantClient{config: uq.config}).Query()
+// WithForwardedMessages tells the query-builder to eager-load the nodes that are connected to
+// the "forwarded_messages" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithForwardedMessages(opts ...func(*MessageQuery)) *UserQuery {
+	query := (&MessageClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	uq.withConversationParticipations = query
+	uq.withForwardedMessages = query
 	return uq
 }
 
-// WithBlockedUsers tells the query-builder to eager-load the nodes that are connected to
-// the "blocked_users" edge. The optional arguments are used to configure the query builder of the edge.
-func (uq *UserQuery) WithBlockedUsers(opts ...func(*BlockQuery)) *UserQuery {
-	query := (&BlockClient{config: uq.config}).Query()
+// WithMessageRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "message_revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithMessageRevisions(opts ...func(*MessageRevisionQuery)) *UserQuery {
+	query := (&MessageRevisionClient{config: uq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	uq.withBlockedUsers = query
+	uq.withMessageRevisions = query
+	return uq
+}
+
+// WithMessageReactions tells the query-builder to eager-load the nodes that are connected to
+// the "message_reactions" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithMessageReactions(opts ...func(*MessageReactionQuery)) *UserQuery {
+	query := (&MessageReactionClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withMessageReactions = query
+	return uq
+}
+
+// WithAttachments tells the query-builder to eager-load the nodes that are connected to
+// the "attachments" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithAttachments(opts ...func(*AttachmentQuery)) *UserQuery {
+	query := (&AttachmentClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withAttachments = query
+	return uq
+}
+
+// WithMentions tells the query-builder to eager-load the nodes that are connected to
+// the "mentions" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithMentions(opts ...func(*MessageMentionQuery)) *UserQuery {
+	query := (&MessageMentionClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withMentions = query
+	return uq
+}
+
+// WithPinnedMessages tells the query-builder to eager-load the nodes that are connected to
+// the "pinned_messages" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithPinnedMessages(opts ...func(*PinnedMessageQuery)) *UserQuery {
+	query := (&PinnedMessageClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withPinnedMessages = query
+	return uq
+}
+
+// WithMessageReceipts tells the query-builder to eager-load the nodes that are connected to
+// the "message_receipts" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithMessageReceipts(opts ...func(*MessageReceiptQuery)) *UserQuery {
+	query := (&MessageReceiptClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withMessageReceipts = query
+	return uq
+}
+
+// WithScheduledMessages tells the query-builder to eager-load the nodes that are connected to
+// the "scheduled_messages" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithScheduledMessages(opts ...func(*ScheduledMessageQuery)) *UserQuery {
+	query := (&ScheduledMessageClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withScheduledMessages = query
+	return uq
+}
+
+// WithDrafts tells the query-builder to eager-load the nodes that are connected to
+// the "drafts" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithDrafts(opts ...func(*DraftQuery)) *UserQuery {
+	query := (&DraftClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withDrafts = query
+	return uq
+}
+
+// WithBookmarks tells the query-builder to eager-load the nodes that are connected to
+// the "bookmarks" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithBookmarks(opts ...func(*BookmarkQuery)) *UserQuery {
+	query := (&BookmarkClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withBookmarks = query
+	return uq
+}
+
+// WithHiddenMessages tells the query-builder to eager-load the nodes that are connected to
+// the "hidden_messages" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithHiddenMessages(opts ...func(*HiddenMessageQuery)) *UserQuery {
+	query := (&HiddenMessageClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withHiddenMessages = query
+	return uq
+}
+
+// WithConversationFolders tells the query-builder to eager-load the nodes that are connected to
+// the "conversation_folders" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithConversationFolders(opts ...func(*ConversationFolderQuery)) *UserQuery {
+	query := (&ConversationFolderClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withConversationFolders = query
+	return uq
+}
+
+// WithNotifications tells the query-builder to eager-load the nodes that are connected to
+// the "notifications" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNotifications(opts ...func(*NotificationQuery)) *UserQuery {
+	query := (&NotificationClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withNotifications = query
+	return uq
+}
+
+// WithRelatedNotifications tells the query-builder to eager-load the nodes that are connected to
+// the "related_notifications" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithRelatedNotifications(opts ...func(*NotificationQuery)) *UserQuery {
+	query := (&NotificationClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withRelatedNotifications = query
+	return uq
+}
+
+// WithConversationParticipations tells the query-builder to eager-load the nodes that are connected to
+// the "conversation_participations" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithConversationParticipations(opts ...func(*ConversationParticipantQuery)) *UserQuery {
+	query := (&ConversationParticipantClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withConversationParticipations = query
+	return uq
+}
+
+// WithThreadParticipations tells the query-builder to eager-load the nodes that are connected to
+// the "thread_participations" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithThreadParticipations(opts ...func(*ThreadParticipantQuery)) *UserQuery {
+	query := (&ThreadParticipantClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withThreadParticipations = query
+	return uq
+}
+
+// WithBlockedUsers tells the query-builder to eager-load the nodes that are connected to
+// the "blocked_users" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithBlockedUsers(opts ...func(*BlockQuery)) *UserQuery {
+	query := (&BlockClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withBlockedUsers = query
 	return uq
 }
 
@@ -835,17 +1374,32 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = uq.querySpec()
-		loadedTypes = [14]bool{
+		loadedTypes = [29]bool{
 			uq.withSessions != nil,
 			uq.withOwnedGuilds != nil,
 			uq.withInvitations != nil,
 			uq.withMemberOf != nil,
 			uq.withFriendRequestsSent != nil,
 			uq.withFriendRequestsReceived != nil,
+			uq.withFriendInvites != nil,
+			uq.withFriendInviteUses != nil,
 			uq.withSentMessages != nil,
+			uq.withForwardedMessages != nil,
+			uq.withMessageRevisions != nil,
+			uq.withMessageReactions != nil,
+			uq.withAttachments != nil,
+			uq.withMentions != nil,
+			uq.withPinnedMessages != nil,
+			uq.withMessageReceipts != nil,
+			uq.withScheduledMessages != nil,
+			uq.withDrafts != nil,
+			uq.withBookmarks != nil,
+			uq.withHiddenMessages != nil,
+			uq.withConversationFolders != nil,
 			uq.withNotifications != nil,
 			uq.withRelatedNotifications != nil,
 			uq.withConversationParticipations != nil,
+			uq.withThreadParticipations != nil,
 			uq.withBlockedUsers != nil,
 			uq.withBlockedByUsers != nil,
 			uq.withCallsMade != nil,
@@ -877,103 +1431,638 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
-	if query := uq.withOwnedGuilds; query != nil {
-		if err := uq.loadOwnedGuilds(ctx, query, nodes,
-			func(n *User) { n.Edges.OwnedGuilds = []*Guild{} },
-			func(n *User, e *Guild) { n.Edges.OwnedGuilds = append(n.Edges.OwnedGuilds, e) }); err != nil {
-			return nil, err
-		}
+	if query := uq.withOwnedGuilds; query != nil {
+		if err := uq.loadOwnedGuilds(ctx, query, nodes,
+			func(n *User) { n.Edges.OwnedGuilds = []*Guild{} },
+			func(n *User, e *Guild) { n.Edges.OwnedGuilds = append(n.Edges.OwnedGuilds, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withInvitations; query != nil {
+		if err := uq.loadInvitations(ctx, query, nodes,
+			func(n *User) { n.Edges.Invitations = []*Invitation{} },
+			func(n *User, e *Invitation) { n.Edges.Invitations = append(n.Edges.Invitations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withMemberOf; query != nil {
+		if err := uq.loadMemberOf(ctx, query, nodes,
+			func(n *User) { n.Edges.MemberOf = []*Member{} },
+			func(n *User, e *Member) { n.Edges.MemberOf = append(n.Edges.MemberOf, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withFriendRequestsSent; query != nil {
+		if err := uq.loadFriendRequestsSent(ctx, query, nodes,
+			func(n *User) { n.Edges.FriendRequestsSent = []*Friend{} },
+			func(n *User, e *Friend) { n.Edges.FriendRequestsSent = append(n.Edges.FriendRequestsSent, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withFriendRequestsReceived; query != nil {
+		if err := uq.loadFriendRequestsReceived(ctx, query, nodes,
+			func(n *User) { n.Edges.FriendRequestsReceived = []*Friend{} },
+			func(n *User, e *Friend) { n.Edges.FriendRequestsReceived = append(n.Edges.FriendRequestsReceived, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withFriendInvites; query != nil {
+		if err := uq.loadFriendInvites(ctx, query, nodes,
+			func(n *User) { n.Edges.FriendInvites = []*FriendInvite{} },
+			func(n *User, e *FriendInvite) { n.Edges.FriendInvites = append(n.Edges.FriendInvites, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withFriendInviteUses; query != nil {
+		if err := uq.loadFriendInviteUses(ctx, query, nodes,
+			func(n *User) { n.Edges.FriendInviteUses = []*FriendInviteUse{} },
+			func(n *User, e *FriendInviteUse) { n.Edges.FriendInviteUses = append(n.Edges.FriendInviteUses, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withSentMessages; query != nil {
+		if err := uq.loadSentMessages(ctx, query, nodes,
+			func(n *User) { n.Edges.SentMessages = []*Message{} },
+			func(n *User, e *Message) { n.Edges.SentMessages = append(n.Edges.SentMessages, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withForwardedMessages; query != nil {
+		if err := uq.loadForwardedMessages(ctx, query, nodes,
+			func(n *User) { n.Edges.ForwardedMessages = []*Message{} },
+			func(n *User, e *Message) { n.Edges.ForwardedMessages = append(n.Edges.ForwardedMessages, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withMessageRevisions; query != nil {
+		if err := uq.loadMessageRevisions(ctx, query, nodes,
+			func(n *User) { n.Edges.MessageRevisions = []*MessageRevision{} },
+			func(n *User, e *MessageRevision) { n.Edges.MessageRevisions = append(n.Edges.MessageRevisions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withMessageReactions; query != nil {
+		if err := uq.loadMessageReactions(ctx, query, nodes,
+			func(n *User) { n.Edges.MessageReactions = []*MessageReaction{} },
+			func(n *User, e *MessageReaction) { n.Edges.MessageReactions = append(n.Edges.MessageReactions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withAttachments; query != nil {
+		if err := uq.loadAttachments(ctx, query, nodes,
+			func(n *User) { n.Edges.Attachments = []*Attachment{} },
+			func(n *User, e *Attachment) { n.Edges.Attachments = append(n.Edges.Attachments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withMentions; query != nil {
+		if err := uq.loadMentions(ctx, query, nodes,
+			func(n *User) { n.Edges.Mentions = []*MessageMention{} },
+			func(n *User, e *MessageMention) { n.Edges.Mentions = append(n.Edges.Mentions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withPinnedMessages; query != nil {
+		if err := uq.loadPinnedMessages(ctx, query, nodes,
+			func(n *User) { n.Edges.PinnedMessages = []*PinnedMessage{} },
+			func(n *User, e *PinnedMessage) { n.Edges.PinnedMessages = append(n.Edges.PinnedMessages, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withMessageReceipts; query != nil {
+		if err := uq.loadMessageReceipts(ctx, query, nodes,
+			func(n *User) { n.Edges.MessageReceipts = []*MessageReceipt{} },
+			func(n *User, e *MessageReceipt) { n.Edges.MessageReceipts = append(n.Edges.MessageReceipts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withScheduledMessages; query != nil {
+		if err := uq.loadScheduledMessages(ctx, query, nodes,
+			func(n *User) { n.Edges.ScheduledMessages = []*ScheduledMessage{} },
+			func(n *User, e *ScheduledMessage) { n.Edges.ScheduledMessages = append(n.Edges.ScheduledMessages, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withDrafts; query != nil {
+		if err := uq.loadDrafts(ctx, query, nodes,
+			func(n *User) { n.Edges.Drafts = []*Draft{} },
+			func(n *User, e *Draft) { n.Edges.Drafts = append(n.Edges.Drafts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withBookmarks; query != nil {
+		if err := uq.loadBookmarks(ctx, query, nodes,
+			func(n *User) { n.Edges.Bookmarks = []*Bookmark{} },
+			func(n *User, e *Bookmark) { n.Edges.Bookmarks = append(n.Edges.Bookmarks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withHiddenMessages; query != nil {
+		if err := uq.loadHiddenMessages(ctx, query, nodes,
+			func(n *User) { n.Edges.HiddenMessages = []*HiddenMessage{} },
+			func(n *User, e *HiddenMessage) { n.Edges.HiddenMessages = append(n.Edges.HiddenMessages, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withConversationFolders; query != nil {
+		if err := uq.loadConversationFolders(ctx, query, nodes,
+			func(n *User) { n.Edges.ConversationFolders = []*ConversationFolder{} },
+			func(n *User, e *ConversationFolder) {
+				n.Edges.ConversationFolders = append(n.Edges.ConversationFolders, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withNotifications; query != nil {
+		if err := uq.loadNotifications(ctx, query, nodes,
+			func(n *User) { n.Edges.Notifications = []*Notification{} },
+			func(n *User, e *Notification) { n.Edges.Notifications = append(n.Edges.Notifications, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withRelatedNotifications; query != nil {
+		if err := uq.loadRelatedNotifications(ctx, query, nodes,
+			func(n *User) { n.Edges.RelatedNotifications = []*Notification{} },
+			func(n *User, e *Notification) { n.Edges.RelatedNotifications = append(n.Edges.RelatedNotifications, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withConversationParticipations; query != nil {
+		if err := uq.loadConversationParticipations(ctx, query, nodes,
+			func(n *User) { n.Edges.ConversationParticipations = []*ConversationParticipant{} },
+			func(n *User, e *ConversationParticipant) {
+				n.Edges.ConversationParticipations = append(n.Edges.ConversationParticipations, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withThreadParticipations; query != nil {
+		if err := uq.loadThreadParticipations(ctx, query, nodes,
+			func(n *User) { n.Edges.ThreadParticipations = []*ThreadParticipant{} },
+			func(n *User, e *ThreadParticipant) {
+				n.Edges.ThreadParticipations = append(n.Edges.ThreadParticipations, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withBlockedUsers; query != nil {
+		if err := uq.loadBlockedUsers(ctx, query, nodes,
+			func(n *User) { n.Edges.BlockedUsers = []*Block{} },
+			func(n *User, e *Block) { n.Edges.BlockedUsers = append(n.Edges.BlockedUsers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withBlockedByUsers; query != nil {
+		if err := uq.loadBlockedByUsers(ctx, query, nodes,
+			func(n *User) { n.Edges.BlockedByUsers = []*Block{} },
+			func(n *User, e *Block) { n.Edges.BlockedByUsers = append(n.Edges.BlockedByUsers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withCallsMade; query != nil {
+		if err := uq.loadCallsMade(ctx, query, nodes,
+			func(n *User) { n.Edges.CallsMade = []*Call{} },
+			func(n *User, e *Call) { n.Edges.CallsMade = append(n.Edges.CallsMade, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withCallsReceived; query != nil {
+		if err := uq.loadCallsReceived(ctx, query, nodes,
+			func(n *User) { n.Edges.CallsReceived = []*Call{} },
+			func(n *User, e *Call) { n.Edges.CallsReceived = append(n.Edges.CallsReceived, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (uq *UserQuery) loadSessions(ctx context.Context, query *SessionQuery, nodes []*User, init func(*User), assign func(*User, *Session)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Session(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.SessionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_sessions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_sessions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_sessions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadOwnedGuilds(ctx context.Context, query *GuildQuery, nodes []*User, init func(*User), assign func(*User, *Guild)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Guild(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.OwnedGuildsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_owned_guilds
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_owned_guilds" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_owned_guilds" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadInvitations(ctx context.Context, query *InvitationQuery, nodes []*User, init func(*User), assign func(*User, *Invitation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.InvitationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.invitation_invited_by
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "invitation_invited_by" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "invitation_invited_by" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadMemberOf(ctx context.Context, query *MemberQuery, nodes []*User, init func(*User), assign func(*User, *Member)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Member(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.MemberOfColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_member_of
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_member_of" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_member_of" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadFriendRequestsSent(ctx context.Context, query *FriendQuery, nodes []*User, init func(*User), assign func(*User, *Friend)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(friend.FieldRequesterID)
+	}
+	query.Where(predicate.Friend(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.FriendRequestsSentColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RequesterID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "requester_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadFriendRequestsReceived(ctx context.Context, query *FriendQuery, nodes []*User, init func(*User), assign func(*User, *Friend)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(friend.FieldAddresseeID)
+	}
+	query.Where(predicate.Friend(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.FriendRequestsReceivedColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AddresseeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "addressee_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadFriendInvites(ctx context.Context, query *FriendInviteQuery, nodes []*User, init func(*User), assign func(*User, *FriendInvite)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(friendinvite.FieldOwnerID)
+	}
+	query.Where(predicate.FriendInvite(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.FriendInvitesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OwnerID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "owner_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadFriendInviteUses(ctx context.Context, query *FriendInviteUseQuery, nodes []*User, init func(*User), assign func(*User, *FriendInviteUse)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(friendinviteuse.FieldUserID)
+	}
+	query.Where(predicate.FriendInviteUse(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.FriendInviteUsesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadSentMessages(ctx context.Context, query *MessageQuery, nodes []*User, init func(*User), assign func(*User, *Message)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(message.FieldSenderID)
+	}
+	query.Where(predicate.Message(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.SentMessagesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SenderID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "sender_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadForwardedMessages(ctx context.Context, query *MessageQuery, nodes []*User, init func(*User), assign func(*User, *Message)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(message.FieldForwardedFromUserID)
+	}
+	query.Where(predicate.Message(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ForwardedMessagesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ForwardedFromUserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "forwarded_from_user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadMessageRevisions(ctx context.Context, query *MessageRevisionQuery, nodes []*User, init func(*User), assign func(*User, *MessageRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(messagerevision.FieldEditorID)
 	}
-	if query := uq.withInvitations; query != nil {
-		if err := uq.loadInvitations(ctx, query, nodes,
-			func(n *User) { n.Edges.Invitations = []*Invitation{} },
-			func(n *User, e *Invitation) { n.Edges.Invitations = append(n.Edges.Invitations, e) }); err != nil {
-			return nil, err
-		}
+	query.Where(predicate.MessageRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.MessageRevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
 	}
-	if query := uq.withMemberOf; query != nil {
-		if err := uq.loadMemberOf(ctx, query, nodes,
-			func(n *User) { n.Edges.MemberOf = []*Member{} },
-			func(n *User, e *Member) { n.Edges.MemberOf = append(n.Edges.MemberOf, e) }); err != nil {
-			return nil, err
+	for _, n := range neighbors {
+		fk := n.EditorID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "editor_id" returned %v for node %v`, fk, n.ID)
 		}
+		assign(node, n)
 	}
-	if query := uq.withFriendRequestsSent; query != nil {
-		if err := uq.loadFriendRequestsSent(ctx, query, nodes,
-			func(n *User) { n.Edges.FriendRequestsSent = []*Friend{} },
-			func(n *User, e *Friend) { n.Edges.FriendRequestsSent = append(n.Edges.FriendRequestsSent, e) }); err != nil {
-			return nil, err
+	return nil
+}
+func (uq *UserQuery) loadMessageReactions(ctx context.Context, query *MessageReactionQuery, nodes []*User, init func(*User), assign func(*User, *MessageReaction)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
 		}
 	}
-	if query := uq.withFriendRequestsReceived; query != nil {
-		if err := uq.loadFriendRequestsReceived(ctx, query, nodes,
-			func(n *User) { n.Edges.FriendRequestsReceived = []*Friend{} },
-			func(n *User, e *Friend) { n.Edges.FriendRequestsReceived = append(n.Edges.FriendRequestsReceived, e) }); err != nil {
-			return nil, err
-		}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(messagereaction.FieldUserID)
 	}
-	if query := uq.withSentMessages; query != nil {
-		if err := uq.loadSentMessages(ctx, query, nodes,
-			func(n *User) { n.Edges.SentMessages = []*Message{} },
-			func(n *User, e *Message) { n.Edges.SentMessages = append(n.Edges.SentMessages, e) }); err != nil {
-			return nil, err
-		}
+	query.Where(predicate.MessageReaction(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.MessageReactionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
 	}
-	if query := uq.withNotifications; query != nil {
-		if err := uq.loadNotifications(ctx, query, nodes,
-			func(n *User) { n.Edges.Notifications = []*Notification{} },
-			func(n *User, e *Notification) { n.Edges.Notifications = append(n.Edges.Notifications, e) }); err != nil {
-			return nil, err
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
+		assign(node, n)
 	}
-	if query := uq.withRelatedNotifications; query != nil {
-		if err := uq.loadRelatedNotifications(ctx, query, nodes,
-			func(n *User) { n.Edges.RelatedNotifications = []*Notification{} },
-			func(n *User, e *Notification) { n.Edges.RelatedNotifications = append(n.Edges.RelatedNotifications, e) }); err != nil {
-			return nil, err
+	return nil
+}
+func (uq *UserQuery) loadAttachments(ctx context.Context, query *AttachmentQuery, nodes []*User, init func(*User), assign func(*User, *Attachment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
 		}
 	}
-	if query := uq.withConversationParticipations; query != nil {
-		if err := uq.loadConversationParticipations(ctx, query, nodes,
-			func(n *User) { n.Edges.ConversationParticipations = []*ConversationParticipant{} },
-			func(n *User, e *ConversationParticipant) {
-				n.Edges.ConversationParticipations = append(n.Edges.ConversationParticipations, e)
-			}); err != nil {
-			return nil, err
-		}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(attachment.FieldUploaderID)
 	}
-	if query := uq.withBlockedUsers; query != nil {
-		if err := uq.loadBlockedUsers(ctx, query, nodes,
-			func(n *User) { n.Edges.BlockedUsers = []*Block{} },
-			func(n *User, e *Block) { n.Edges.BlockedUsers = append(n.Edges.BlockedUsers, e) }); err != nil {
-			return nil, err
-		}
+	query.Where(predicate.Attachment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.AttachmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
 	}
-	if query := uq.withBlockedByUsers; query != nil {
-		if err := uq.loadBlockedByUsers(ctx, query, nodes,
-			func(n *User) { n.Edges.BlockedByUsers = []*Block{} },
-			func(n *User, e *Block) { n.Edges.BlockedByUsers = append(n.Edges.BlockedByUsers, e) }); err != nil {
-			return nil, err
+	for _, n := range neighbors {
+		fk := n.UploaderID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "uploader_id" returned %v for node %v`, fk, n.ID)
 		}
+		assign(node, n)
 	}
-	if query := uq.withCallsMade; query != nil {
-		if err := uq.loadCallsMade(ctx, query, nodes,
-			func(n *User) { n.Edges.CallsMade = []*Call{} },
-			func(n *User, e *Call) { n.Edges.CallsMade = append(n.Edges.CallsMade, e) }); err != nil {
-			return nil, err
+	return nil
+}
+func (uq *UserQuery) loadMentions(ctx context.Context, query *MessageMentionQuery, nodes []*User, init func(*User), assign func(*User, *MessageMention)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
 		}
 	}
-	if query := uq.withCallsReceived; query != nil {
-		if err := uq.loadCallsReceived(ctx, query, nodes,
-			func(n *User) { n.Edges.CallsReceived = []*Call{} },
-			func(n *User, e *Call) { n.Edges.CallsReceived = append(n.Edges.CallsReceived, e) }); err != nil {
-			return nil, err
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(messagemention.FieldUserID)
+	}
+	query.Where(predicate.MessageMention(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.MentionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
+		assign(node, n)
 	}
-	return nodes, nil
+	return nil
 }
-
-func (uq *UserQuery) loadSessions(ctx context.Context, query *SessionQuery, nodes []*User, init func(*User), assign func(*User, *Session)) error {
+func (uq *UserQuery) loadPinnedMessages(ctx context.Context, query *PinnedMessageQuery, nodes []*User, init func(*User), assign func(*User, *PinnedMessage)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[string]*User)
 	for i := range nodes {
@@ -983,28 +2072,27 @@ func (uq *UserQuery) loadSessions(ctx context.Context, query *SessionQuery, node
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
-	query.Where(predicate.Session(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.SessionsColumn), fks...))
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(pinnedmessage.FieldPinnedByID)
+	}
+	query.Where(predicate.PinnedMessage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.PinnedMessagesColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_sessions
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_sessions" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.PinnedByID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_sessions" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "pinned_by_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (uq *UserQuery) loadOwnedGuilds(ctx context.Context, query *GuildQuery, nodes []*User, init func(*User), assign func(*User, *Guild)) error {
+func (uq *UserQuery) loadMessageReceipts(ctx context.Context, query *MessageReceiptQuery, nodes []*User, init func(*User), assign func(*User, *MessageReceipt)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[string]*User)
 	for i := range nodes {
@@ -1014,28 +2102,27 @@ func (uq *UserQuery) loadOwnedGuilds(ctx context.Context, query *GuildQuery, nod
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
-	query.Where(predicate.Guild(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.OwnedGuildsColumn), fks...))
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(messagereceipt.FieldUserID)
+	}
+	query.Where(predicate.MessageReceipt(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.MessageReceiptsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_owned_guilds
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_owned_guilds" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.UserID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_owned_guilds" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (uq *UserQuery) loadInvitations(ctx context.Context, query *InvitationQuery, nodes []*User, init func(*User), assign func(*User, *Invitation)) error {
+func (uq *UserQuery) loadScheduledMessages(ctx context.Context, query *ScheduledMessageQuery, nodes []*User, init func(*User), assign func(*User, *ScheduledMessage)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[string]*User)
 	for i := range nodes {
@@ -1045,28 +2132,27 @@ func (uq *UserQuery) loadInvitations(ctx context.Context, query *InvitationQuery
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
-	query.Where(predicate.Invitation(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.InvitationsColumn), fks...))
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(scheduledmessage.FieldSenderID)
+	}
+	query.Where(predicate.ScheduledMessage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ScheduledMessagesColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.invitation_invited_by
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "invitation_invited_by" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.SenderID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "invitation_invited_by" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "sender_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (uq *UserQuery) loadMemberOf(ctx context.Context, query *MemberQuery, nodes []*User, init func(*User), assign func(*User, *Member)) error {
+func (uq *UserQuery) loadDrafts(ctx context.Context, query *DraftQuery, nodes []*User, init func(*User), assign func(*User, *Draft)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[string]*User)
 	for i := range nodes {
@@ -1076,28 +2162,27 @@ func (uq *UserQuery) loadMemberOf(ctx context.Context, query *MemberQuery, nodes
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
-	query.Where(predicate.Member(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.MemberOfColumn), fks...))
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(draft.FieldUserID)
+	}
+	query.Where(predicate.Draft(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.DraftsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_member_of
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_member_of" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.UserID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_member_of" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (uq *UserQuery) loadFriendRequestsSent(ctx context.Context, query *FriendQuery, nodes []*User, init func(*User), assign func(*User, *Friend)) error {
+func (uq *UserQuery) loadBookmarks(ctx context.Context, query *BookmarkQuery, nodes []*User, init func(*User), assign func(*User, *Bookmark)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[string]*User)
 	for i := range nodes {
@@ -1108,26 +2193,26 @@ func (uq *UserQuery) loadFriendRequestsSent(ctx context.Context, query *FriendQu
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(friend.FieldRequesterID)
+		query.ctx.AppendFieldOnce(bookmark.FieldUserID)
 	}
-	query.Where(predicate.Friend(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.FriendRequestsSentColumn), fks...))
+	query.Where(predicate.Bookmark(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.BookmarksColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.RequesterID
+		fk := n.UserID
 		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "requester_id" returned %v for node %v`, fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (uq *UserQuery) loadFriendRequestsReceived(ctx context.Context, query *FriendQuery, nodes []*User, init func(*User), assign func(*User, *Friend)) error {
+func (uq *UserQuery) loadHiddenMessages(ctx context.Context, query *HiddenMessageQuery, nodes []*User, init func(*User), assign func(*User, *HiddenMessage)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[string]*User)
 	for i := range nodes {
@@ -1138,26 +2223,26 @@ func (uq *UserQuery) loadFriendRequestsReceived(ctx context.Context, query *Frie
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(friend.FieldAddresseeID)
+		query.ctx.AppendFieldOnce(hiddenmessage.FieldUserID)
 	}
-	query.Where(predicate.Friend(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.FriendRequestsReceivedColumn), fks...))
+	query.Where(predicate.HiddenMessage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.HiddenMessagesColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.AddresseeID
+		fk := n.UserID
 		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "addressee_id" returned %v for node %v`, fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (uq *UserQuery) loadSentMessages(ctx context.Context, query *MessageQuery, nodes []*User, init func(*User), assign func(*User, *Message)) error {
+func (uq *UserQuery) loadConversationFolders(ctx context.Context, query *ConversationFolderQuery, nodes []*User, init func(*User), assign func(*User, *ConversationFolder)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[string]*User)
 	for i := range nodes {
@@ -1167,22 +2252,21 @@ func (uq *UserQuery) loadSentMessages(ctx context.Context, query *MessageQuery,
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(message.FieldSenderID)
+		query.ctx.AppendFieldOnce(conversationfolder.FieldUserID)
 	}
-	query.Where(predicate.Message(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.SentMessagesColumn), fks...))
+	query.Where(predicate.ConversationFolder(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ConversationFoldersColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.SenderID
+		fk := n.UserID
 		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "sender_id" returned %v for node %v`, fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -1279,6 +2363,36 @@ func (uq *UserQuery) loadConversationParticipations(ctx context.Context, query *
 	}
 	return nil
 }
+func (uq *UserQuery) loadThreadParticipations(ctx context.Context, query *ThreadParticipantQuery, nodes []*User, init func(*User), assign func(*User, *ThreadParticipant)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(threadparticipant.FieldUserID)
+	}
+	query.Where(predicate.ThreadParticipant(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ThreadParticipationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (uq *UserQuery) loadBlockedUsers(ctx context.Context, query *BlockQuery, nodes []*User, init func(*User), assign func(*User, *Block)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[string]*User)
@@ -6,17 +6,31 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"kakashi/chaos/internal/ent/attachment"
 	"kakashi/chaos/internal/ent/block"
+	"kakashi/chaos/internal/ent/bookmark"
 	"kakashi/chaos/internal/ent/call"
+	"kakashi/chaos/internal/ent/conversationfolder"
 	"kakashi/chaos/internal/ent/conversationparticipant"
+	"kakashi/chaos/internal/ent/draft"
 	"kakashi/chaos/internal/ent/friend"
+	"kakashi/chaos/internal/ent/friendinvite"
+	"kakashi/chaos/internal/ent/friendinviteuse"
 	"kakashi/chaos/internal/ent/guild"
+	"kakashi/chaos/internal/ent/hiddenmessage"
 	"kakashi/chaos/internal/ent/invitation"
 	"kakashi/chaos/internal/ent/member"
 	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/messagemention"
+	"kakashi/chaos/internal/ent/messagereaction"
+	"kakashi/chaos/internal/ent/messagereceipt"
+	"kakashi/chaos/internal/ent/messagerevision"
 	"kakashi/chaos/internal/ent/notification"
+	"kakashi/chaos/internal/ent/pinnedmessage"
 	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/scheduledmessage"
 	"kakashi/chaos/internal/ent/session"
+	"kakashi/chaos/internal/ent/threadparticipant"
 	"kakashi/chaos/internal/ent/user"
 	"time"
 
@@ -180,6 +194,20 @@ func (uu *UserUpdate) ClearCoverURL() *UserUpdate {
 	return uu
 }
 
+// SetReadReceiptsEnabled sets the "read_receipts_enabled" field.
+func (uu *UserUpdate) SetReadReceiptsEnabled(b bool) *UserUpdate {
+	uu.mutation.SetReadReceiptsEnabled(b)
+	return uu
+}
+
+// SetNillableReadReceiptsEnabled sets the "read_receipts_enabled" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableReadReceiptsEnabled(b *bool) *UserUpdate {
+	if b != nil {
+		uu.SetReadReceiptsEnabled(*b)
+	}
+	return uu
+}
+
 // AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
 func (uu *UserUpdate) AddSessionIDs(ids ...string) *UserUpdate {
 	uu.mutation.AddSessionIDs(ids...)
@@ -270,6 +298,36 @@ func (uu *UserUpdate) AddFriendRequestsReceived(f ...*Friend) *UserUpdate {
 	return uu.AddFriendRequestsReceivedIDs(ids...)
 }
 
+// AddFriendInviteIDs adds the "friend_invites" edge to the FriendInvite entity by IDs.
+func (uu *UserUpdate) AddFriendInviteIDs(ids ...string) *UserUpdate {
+	uu.mutation.AddFriendInviteIDs(ids...)
+	return uu
+}
+
+// AddFriendInvites adds the "friend_invites" edges to the FriendInvite entity.
+func (uu *UserUpdate) AddFriendInvites(f ...*FriendInvite) *UserUpdate {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uu.AddFriendInviteIDs(ids...)
+}
+
+// AddFriendInviteUseIDs adds the "friend_invite_uses" edge to the FriendInviteUse entity by IDs.
+func (uu *UserUpdate) AddFriendInviteUseIDs(ids ...string) *UserUpdate {
+	uu.mutation.AddFriendInviteUseIDs(ids...)
+	return uu
+}
+
+// AddFriendInviteUses adds the "friend_invite_uses" edges to the FriendInviteUse entity.
+func (uu *UserUpdate) AddFriendInviteUses(f ...*FriendInviteUse) *UserUpdate {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uu.AddFriendInviteUseIDs(ids...)
+}
+
 // AddSentMessageIDs adds the "sent_messages" edge to the Message entity by IDs.
 func (uu *UserUpdate) AddSentMessageIDs(ids ...string) *UserUpdate {
 	uu.mutation.AddSentMessageIDs(ids...)
@@ -285,6 +343,186 @@ func (uu *UserUpdate) AddSentMessages(m ...*Message) *UserUpdate {
 	return uu.AddSentMessageIDs(ids...)
 }
 
+// AddForwardedMessageIDs adds the "forwarded_messages" edge to the Message entity by IDs.
+func (uu *UserUpdate) AddForwardedMessageIDs(ids ...string) *UserUpdate {
+	uu.mutation.AddForwardedMessageIDs(ids...)
+	return uu
+}
+
+// AddForwardedMessages adds the "forwarded_messages" edges to the Message entity.
+func (uu *UserUpdate) AddForwardedMessages(m ...*Message) *UserUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.AddForwardedMessageIDs(ids...)
+}
+
+// AddMessageRevisionIDs adds the "message_revisions" edge to the MessageRevision entity by IDs.
+func (uu *UserUpdate) AddMessageRevisionIDs(ids ...string) *UserUpdate {
+	uu.mutation.AddMessageRevisionIDs(ids...)
+	return uu
+}
+
+// AddMessageRevisions adds the "message_revisions" edges to the MessageRevision entity.
+func (uu *UserUpdate) AddMessageRevisions(m ...*MessageRevision) *UserUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.AddMessageRevisionIDs(ids...)
+}
+
+// AddMessageReactionIDs adds the "message_reactions" edge to the MessageReaction entity by IDs.
+func (uu *UserUpdate) AddMessageReactionIDs(ids ...string) *UserUpdate {
+	uu.mutation.AddMessageReactionIDs(ids...)
+	return uu
+}
+
+// AddMessageReactions adds the "message_reactions" edges to the MessageReaction entity.
+func (uu *UserUpdate) AddMessageReactions(m ...*MessageReaction) *UserUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.AddMessageReactionIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (uu *UserUpdate) AddAttachmentIDs(ids ...string) *UserUpdate {
+	uu.mutation.AddAttachmentIDs(ids...)
+	return uu
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (uu *UserUpdate) AddAttachments(a ...*Attachment) *UserUpdate {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.AddAttachmentIDs(ids...)
+}
+
+// AddMentionIDs adds the "mentions" edge to the MessageMention entity by IDs.
+func (uu *UserUpdate) AddMentionIDs(ids ...string) *UserUpdate {
+	uu.mutation.AddMentionIDs(ids...)
+	return uu
+}
+
+// AddMentions adds the "mentions" edges to the MessageMention entity.
+func (uu *UserUpdate) AddMentions(m ...*MessageMention) *UserUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.AddMentionIDs(ids...)
+}
+
+// AddPinnedMessageIDs adds the "pinned_messages" edge to the PinnedMessage entity by IDs.
+func (uu *UserUpdate) AddPinnedMessageIDs(ids ...string) *UserUpdate {
+	uu.mutation.AddPinnedMessageIDs(ids...)
+	return uu
+}
+
+// AddPinnedMessages adds the "pinned_messages" edges to the PinnedMessage entity.
+func (uu *UserUpdate) AddPinnedMessages(p ...*PinnedMessage) *UserUpdate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uu.AddPinnedMessageIDs(ids...)
+}
+
+// AddMessageReceiptIDs adds the "message_receipts" edge to the MessageReceipt entity by IDs.
+func (uu *UserUpdate) AddMessageReceiptIDs(ids ...string) *UserUpdate {
+	uu.mutation.AddMessageReceiptIDs(ids...)
+	return uu
+}
+
+// AddMessageReceipts adds the "message_receipts" edges to the MessageReceipt entity.
+func (uu *UserUpdate) AddMessageReceipts(m ...*MessageReceipt) *UserUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.AddMessageReceiptIDs(ids...)
+}
+
+// AddScheduledMessageIDs adds the "scheduled_messages" edge to the ScheduledMessage entity by IDs.
+func (uu *UserUpdate) AddScheduledMessageIDs(ids ...string) *UserUpdate {
+	uu.mutation.AddScheduledMessageIDs(ids...)
+	return uu
+}
+
+// AddScheduledMessages adds the "scheduled_messages" edges to the ScheduledMessage entity.
+func (uu *UserUpdate) AddScheduledMessages(s ...*ScheduledMessage) *UserUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.AddScheduledMessageIDs(ids...)
+}
+
+// AddDraftIDs adds the "drafts" edge to the Draft entity by IDs.
+func (uu *UserUpdate) AddDraftIDs(ids ...string) *UserUpdate {
+	uu.mutation.AddDraftIDs(ids...)
+	return uu
+}
+
+// AddDrafts adds the "drafts" edges to the Draft entity.
+func (uu *UserUpdate) AddDrafts(d ...*Draft) *UserUpdate {
+	ids := make([]string, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uu.AddDraftIDs(ids...)
+}
+
+// AddBookmarkIDs adds the "bookmarks" edge to the Bookmark entity by IDs.
+func (uu *UserUpdate) AddBookmarkIDs(ids ...string) *UserUpdate {
+	uu.mutation.AddBookmarkIDs(ids...)
+	return uu
+}
+
+// AddBookmarks adds the "bookmarks" edges to the Bookmark entity.
+func (uu *UserUpdate) AddBookmarks(b ...*Bookmark) *UserUpdate {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uu.AddBookmarkIDs(ids...)
+}
+
+// AddHiddenMessageIDs adds the "hidden_messages" edge to the HiddenMessage entity by IDs.
+func (uu *UserUpdate) AddHiddenMessageIDs(ids ...string) *UserUpdate {
+	uu.mutation.AddHiddenMessageIDs(ids...)
+	return uu
+}
+
+// AddHiddenMessages adds the "hidden_messages" edges to the HiddenMessage entity.
+func (uu *UserUpdate) AddHiddenMessages(h ...*HiddenMessage) *UserUpdate {
+	ids := make([]string, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return uu.AddHiddenMessageIDs(ids...)
+}
+
+// AddConversationFolderIDs adds the "conversation_folders" edge to the ConversationFolder entity by IDs.
+func (uu *UserUpdate) AddConversationFolderIDs(ids ...string) *UserUpdate {
+	uu.mutation.AddConversationFolderIDs(ids...)
+	return uu
+}
+
+// AddConversationFolders adds the "conversation_folders" edges to the ConversationFolder entity.
+func (uu *UserUpdate) AddConversationFolders(c ...*ConversationFolder) *UserUpdate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.AddConversationFolderIDs(ids...)
+}
+
 // AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
 func (uu *UserUpdate) AddNotificationIDs(ids ...string) *UserUpdate {
 	uu.mutation.AddNotificationIDs(ids...)
@@ -330,6 +568,21 @@ func (uu *UserUpdate) AddConversationParticipations(c ...*ConversationParticipan
 	return uu.AddConversationParticipationIDs(ids...)
 }
 
+// AddThreadParticipationIDs adds the "thread_participations" edge to the ThreadParticipant entity by IDs.
+func (uu *UserUpdate) AddThreadParticipationIDs(ids ...string) *UserUpdate {
+	uu.mutation.AddThreadParticipationIDs(ids...)
+	return uu
+}
+
+// AddThreadParticipations adds the "thread_participations" edges to the ThreadParticipant entity.
+func (uu *UserUpdate) AddThreadParticipations(t ...*ThreadParticipant) *UserUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uu.AddThreadParticipationIDs(ids...)
+}
+
 // AddBlockedUserIDs adds the "blocked_users" edge to the Block entity by IDs.
 func (uu *UserUpdate) AddBlockedUserIDs(ids ...string) *UserUpdate {
 	uu.mutation.AddBlockedUserIDs(ids...)
@@ -521,6 +774,48 @@ func (uu *UserUpdate) RemoveFriendRequestsReceived(f ...*Friend) *UserUpdate {
 	return uu.RemoveFriendRequestsReceivedIDs(ids...)
 }
 
+// ClearFriendInvites clears all "friend_invites" edges to the FriendInvite entity.
+func (uu *UserUpdate) ClearFriendInvites() *UserUpdate {
+	uu.mutation.ClearFriendInvites()
+	return uu
+}
+
+// RemoveFriendInviteIDs removes the "friend_invites" edge to FriendInvite entities by IDs.
+func (uu *UserUpdate) RemoveFriendInviteIDs(ids ...string) *UserUpdate {
+	uu.mutation.RemoveFriendInviteIDs(ids...)
+	return uu
+}
+
+// RemoveFriendInvites removes "friend_invites" edges to FriendInvite entities.
+func (uu *UserUpdate) RemoveFriendInvites(f ...*FriendInvite) *UserUpdate {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uu.RemoveFriendInviteIDs(ids...)
+}
+
+// ClearFriendInviteUses clears all "friend_invite_uses" edges to the FriendInviteUse entity.
+func (uu *UserUpdate) ClearFriendInviteUses() *UserUpdate {
+	uu.mutation.ClearFriendInviteUses()
+	return uu
+}
+
+// RemoveFriendInviteUseIDs removes the "friend_invite_uses" edge to FriendInviteUse entities by IDs.
+func (uu *UserUpdate) RemoveFriendInviteUseIDs(ids ...string) *UserUpdate {
+	uu.mutation.RemoveFriendInviteUseIDs(ids...)
+	return uu
+}
+
+// RemoveFriendInviteUses removes "friend_invite_uses" edges to FriendInviteUse entities.
+func (uu *UserUpdate) RemoveFriendInviteUses(f ...*FriendInviteUse) *UserUpdate {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uu.RemoveFriendInviteUseIDs(ids...)
+}
+
 // ClearSentMessages clears all "sent_messages" edges to the Message entity.
 func (uu *UserUpdate) ClearSentMessages() *UserUpdate {
 	uu.mutation.ClearSentMessages()
@@ -542,160 +837,433 @@ func (uu *UserUpdate) RemoveSentMessages(m ...*Message) *UserUpdate {
 	return uu.RemoveSentMessageIDs(ids...)
 }
 
-// ClearNotifications clears all "notifications" edges to the Notification entity.
-func (uu *UserUpdate) ClearNotifications() *UserUpdate {
-	uu.mutation.ClearNotifications()
+// ClearForwardedMessages clears all "forwarded_messages" edges to the Message entity.
+func (uu *UserUpdate) ClearForwardedMessages() *UserUpdate {
+	uu.mutation.ClearForwardedMessages()
 	return uu
 }
 
-// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
-func (uu *UserUpdate) RemoveNotificationIDs(ids ...string) *UserUpdate {
-	uu.mutation.RemoveNotificationIDs(ids...)
+// RemoveForwardedMessageIDs removes the "forwarded_messages" edge to Message entities by IDs.
+func (uu *UserUpdate) RemoveForwardedMessageIDs(ids ...string) *UserUpdate {
+	uu.mutation.RemoveForwardedMessageIDs(ids...)
 	return uu
 }
 
-// RemoveNotifications removes "notifications" edges to Notification entities.
-func (uu *UserUpdate) RemoveNotifications(n ...*Notification) *UserUpdate {
-	ids := make([]string, len(n))
-	for i := range n {
-		ids[i] = n[i].ID
+// RemoveForwardedMessages removes "forwarded_messages" edges to Message entities.
+func (uu *UserUpdate) RemoveForwardedMessages(m ...*Message) *UserUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
 	}
-	return uu.RemoveNotificationIDs(ids...)
+	return uu.RemoveForwardedMessageIDs(ids...)
 }
 
-// ClearRelatedNotifications clears all "related_notifications" edges to the Notification entity.
-func (uu *UserUpdate) ClearRelatedNotifications() *UserUpdate {
-	uu.mutation.ClearRelatedNotifications()
+// ClearMessageRevisions clears all "message_revisions" edges to the MessageRevision entity.
+func (uu *UserUpdate) ClearMessageRevisions() *UserUpdate {
+	uu.mutation.ClearMessageRevisions()
 	return uu
 }
 
-// RemoveRelatedNotificationIDs removes the "related_notifications" edge to Notification entities by IDs.
-func (uu *UserUpdate) RemoveRelatedNotificationIDs(ids ...string) *UserUpdate {
-	uu.mutation.RemoveRelatedNotificationIDs(ids...)
+// RemoveMessageRevisionIDs removes the "message_revisions" edge to MessageRevision entities by IDs.
+func (uu *UserUpdate) RemoveMessageRevisionIDs(ids ...string) *UserUpdate {
+	uu.mutation.RemoveMessageRevisionIDs(ids...)
 	return uu
 }
 
-// RemoveRelatedNotifications removes "related_notifications" edges to Notification entities.
-func (uu *UserUpdate) RemoveRelatedNotifications(n ...*Notification) *UserUpdate {
-	ids := make([]string, len(n))
-	for i := range n {
-		ids[i] = n[i].ID
+// RemoveMessageRevisions removes "message_revisions" edges to MessageRevision entities.
+func (uu *UserUpdate) RemoveMessageRevisions(m ...*MessageRevision) *UserUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
 	}
-	return uu.RemoveRelatedNotificationIDs(ids...)
+	return uu.RemoveMessageRevisionIDs(ids...)
 }
 
-// ClearConversationParticipations clears all "conversation_participations" edges to the ConversationParticipant entity.
-func (uu *UserUpdate) ClearConversationParticipations() *UserUpdate {
-	uu.mutation.ClearConversationParticipations()
+// ClearMessageReactions clears all "message_reactions" edges to the MessageReaction entity.
+func (uu *UserUpdate) ClearMessageReactions() *UserUpdate {
+	uu.mutation.ClearMessageReactions()
 	return uu
 }
 
-// RemoveConversationParticipationIDs removes the "conversation_participations" edge to ConversationParticipant entities by IDs.
-func (uu *UserUpdate) RemoveConversationParticipationIDs(ids ...string) *UserUpdate {
-	uu.mutation.RemoveConversationParticipationIDs(ids...)
+// RemoveMessageReactionIDs removes the "message_reactions" edge to MessageReaction entities by IDs.
+func (uu *UserUpdate) RemoveMessageReactionIDs(ids ...string) *UserUpdate {
+	uu.mutation.RemoveMessageReactionIDs(ids...)
 	return uu
 }
 
-// RemoveConversationParticipations removes "conversation_participations" edges to ConversationParticipant entities.
-func (uu *UserUpdate) RemoveConversationParticipations(c ...*ConversationParticipant) *UserUpdate {
-	ids := make([]string, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
+// RemoveMessageReactions removes "message_reactions" edges to MessageReaction entities.
+func (uu *UserUpdate) RemoveMessageReactions(m ...*MessageReaction) *UserUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
 	}
-	return uu.RemoveConversationParticipationIDs(ids...)
+	return uu.RemoveMessageReactionIDs(ids...)
 }
 
-// ClearBlockedUsers clears all "blocked_users" edges to the Block entity.
-func (uu *UserUpdate) ClearBlockedUsers() *UserUpdate {
-	uu.mutation.ClearBlockedUsers()
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (uu *UserUpdate) ClearAttachments() *UserUpdate {
+	uu.mutation.ClearAttachments()
 	return uu
 }
 
-// RemoveBlockedUserIDs removes the "blocked_users" edge to Block entities by IDs.
-func (uu *UserUpdate) RemoveBlockedUserIDs(ids ...string) *UserUpdate {
-	uu.mutation.RemoveBlockedUserIDs(ids...)
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (uu *UserUpdate) RemoveAttachmentIDs(ids ...string) *UserUpdate {
+	uu.mutation.RemoveAttachmentIDs(ids...)
 	return uu
 }
 
-// RemoveBlockedUsers removes "blocked_users" edges to Block entities.
-func (uu *UserUpdate) RemoveBlockedUsers(b ...*Block) *UserUpdate {
-	ids := make([]string, len(b))
-	for i := range b {
-		ids[i] = b[i].ID
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (uu *UserUpdate) RemoveAttachments(a ...*Attachment) *UserUpdate {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
 	}
-	return uu.RemoveBlockedUserIDs(ids...)
+	return uu.RemoveAttachmentIDs(ids...)
 }
 
-// ClearBlockedByUsers clears all "blocked_by_users" edges to the Block entity.
-func (uu *UserUpdate) ClearBlockedByUsers() *UserUpdate {
-	uu.mutation.ClearBlockedByUsers()
+// ClearMentions clears all "mentions" edges to the MessageMention entity.
+func (uu *UserUpdate) ClearMentions() *UserUpdate {
+	uu.mutation.ClearMentions()
 	return uu
 }
 
-// RemoveBlockedByUserIDs removes the "blocked_by_users" edge to Block entities by IDs.
-func (uu *UserUpdate) RemoveBlockedByUserIDs(ids ...string) *UserUpdate {
-	uu.mutation.RemoveBlockedByUserIDs(ids...)
+// RemoveMentionIDs removes the "mentions" edge to MessageMention entities by IDs.
+func (uu *UserUpdate) RemoveMentionIDs(ids ...string) *UserUpdate {
+	uu.mutation.RemoveMentionIDs(ids...)
 	return uu
 }
 
-// RemoveBlockedByUsers removes "blocked_by_users" edges to Block entities.
-func (uu *UserUpdate) RemoveBlockedByUsers(b ...*Block) *UserUpdate {
-	ids := make([]string, len(b))
-	for i := range b {
-		ids[i] = b[i].ID
+// RemoveMentions removes "mentions" edges to MessageMention entities.
+func (uu *UserUpdate) RemoveMentions(m ...*MessageMention) *UserUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
 	}
-	return uu.RemoveBlockedByUserIDs(ids...)
+	return uu.RemoveMentionIDs(ids...)
 }
 
-// ClearCallsMade clears all "calls_made" edges to the Call entity.
-func (uu *UserUpdate) ClearCallsMade() *UserUpdate {
-	uu.mutation.ClearCallsMade()
+// ClearPinnedMessages clears all "pinned_messages" edges to the PinnedMessage entity.
+func (uu *UserUpdate) ClearPinnedMessages() *UserUpdate {
+	uu.mutation.ClearPinnedMessages()
 	return uu
 }
 
-// RemoveCallsMadeIDs removes the "calls_made" edge to Call entities by IDs.
-func (uu *UserUpdate) RemoveCallsMadeIDs(ids ...string) *UserUpdate {
-	uu.mutation.RemoveCallsMadeIDs(ids...)
+// RemovePinnedMessageIDs removes the "pinned_messages" edge to PinnedMessage entities by IDs.
+func (uu *UserUpdate) RemovePinnedMessageIDs(ids ...string) *UserUpdate {
+	uu.mutation.RemovePinnedMessageIDs(ids...)
 	return uu
 }
 
-// RemoveCallsMade removes "calls_made" edges to Call entities.
-func (uu *UserUpdate) RemoveCallsMade(c ...*Call) *UserUpdate {
-	ids := make([]string, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
+// RemovePinnedMessages removes "pinned_messages" edges to PinnedMessage entities.
+func (uu *UserUpdate) RemovePinnedMessages(p ...*PinnedMessage) *UserUpdate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
-	return uu.RemoveCallsMadeIDs(ids...)
+	return uu.RemovePinnedMessageIDs(ids...)
 }
 
-// ClearCallsReceived clears all "calls_received" edges to the Call entity.
-func (uu *UserUpdate) ClearCallsReceived() *UserUpdate {
-	uu.mutation.ClearCallsReceived()
+// ClearMessageReceipts clears all "message_receipts" edges to the MessageReceipt entity.
+func (uu *UserUpdate) ClearMessageReceipts() *UserUpdate {
+	uu.mutation.ClearMessageReceipts()
 	return uu
 }
 
-// RemoveCallsReceivedIDs removes the "calls_received" edge to Call entities by IDs.
-func (uu *UserUpdate) RemoveCallsReceivedIDs(ids ...string) *UserUpdate {
-	uu.mutation.RemoveCallsReceivedIDs(ids...)
+// RemoveMessageReceiptIDs removes the "message_receipts" edge to MessageReceipt entities by IDs.
+func (uu *UserUpdate) RemoveMessageReceiptIDs(ids ...string) *UserUpdate {
+	uu.mutation.RemoveMessageReceiptIDs(ids...)
 	return uu
 }
 
-// RemoveCallsReceived removes "calls_received" edges to Call entities.
-func (uu *UserUpdate) RemoveCallsReceived(c ...*Call) *UserUpdate {
-	ids := make([]string, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
+// RemoveMessageReceipts removes "message_receipts" edges to MessageReceipt entities.
+func (uu *UserUpdate) RemoveMessageReceipts(m ...*MessageReceipt) *UserUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
 	}
-	return uu.RemoveCallsReceivedIDs(ids...)
+	return uu.RemoveMessageReceiptIDs(ids...)
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
-	uu.defaults()
-	return withHooks(ctx, uu.sqlSave, uu.mutation, uu.hooks)
+// ClearScheduledMessages clears all "scheduled_messages" edges to the ScheduledMessage entity.
+func (uu *UserUpdate) ClearScheduledMessages() *UserUpdate {
+	uu.mutation.ClearScheduledMessages()
+	return uu
 }
 
-// SaveX is like Save, but panics if an error occurs.
+// RemoveScheduledMessageIDs removes the "scheduled_messages" edge to ScheduledMessage entities by IDs.
+func (uu *UserUpdate) RemoveScheduledMessageIDs(ids ...string) *UserUpdate {
+	uu.mutation.RemoveScheduledMessageIDs(ids...)
+	return uu
+}
+
+// RemoveScheduledMessages removes "scheduled_messages" edges to ScheduledMessage entities.
+func (uu *UserUpdate) RemoveScheduledMessages(s ...*ScheduledMessage) *UserUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.RemoveScheduledMessageIDs(ids...)
+}
+
+// ClearDrafts clears all "drafts" edges to the Draft entity.
+func (uu *UserUpdate) ClearDrafts() *UserUpdate {
+	uu.mutation.ClearDrafts()
+	return uu
+}
+
+// RemoveDraftIDs removes the "drafts" edge to Draft entities by IDs.
+func (uu *UserUpdate) RemoveDraftIDs(ids ...string) *UserUpdate {
+	uu.mutation.RemoveDraftIDs(ids...)
+	return uu
+}
+
+// RemoveDrafts removes "drafts" edges to Draft entities.
+func (uu *UserUpdate) RemoveDrafts(d ...*Draft) *UserUpdate {
+	ids := make([]string, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uu.RemoveDraftIDs(ids...)
+}
+
+// ClearBookmarks clears all "bookmarks" edges to the Bookmark entity.
+func (uu *UserUpdate) ClearBookmarks() *UserUpdate {
+	uu.mutation.ClearBookmarks()
+	return uu
+}
+
+// RemoveBookmarkIDs removes the "bookmarks" edge to Bookmark entities by IDs.
+func (uu *UserUpdate) RemoveBookmarkIDs(ids ...string) *UserUpdate {
+	uu.mutation.RemoveBookmarkIDs(ids...)
+	return uu
+}
+
+// RemoveBookmarks removes "bookmarks" edges to Bookmark entities.
+func (uu *UserUpdate) RemoveBookmarks(b ...*Bookmark) *UserUpdate {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uu.RemoveBookmarkIDs(ids...)
+}
+
+// ClearHiddenMessages clears all "hidden_messages" edges to the HiddenMessage entity.
+func (uu *UserUpdate) ClearHiddenMessages() *UserUpdate {
+	uu.mutation.ClearHiddenMessages()
+	return uu
+}
+
+// RemoveHiddenMessageIDs removes the "hidden_messages" edge to HiddenMessage entities by IDs.
+func (uu *UserUpdate) RemoveHiddenMessageIDs(ids ...string) *UserUpdate {
+	uu.mutation.RemoveHiddenMessageIDs(ids...)
+	return uu
+}
+
+// RemoveHiddenMessages removes "hidden_messages" edges to HiddenMessage entities.
+func (uu *UserUpdate) RemoveHiddenMessages(h ...*HiddenMessage) *UserUpdate {
+	ids := make([]string, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return uu.RemoveHiddenMessageIDs(ids...)
+}
+
+// ClearConversationFolders clears all "conversation_folders" edges to the ConversationFolder entity.
+func (uu *UserUpdate) ClearConversationFolders() *UserUpdate {
+	uu.mutation.ClearConversationFolders()
+	return uu
+}
+
+// RemoveConversationFolderIDs removes the "conversation_folders" edge to ConversationFolder entities by IDs.
+func (uu *UserUpdate) RemoveConversationFolderIDs(ids ...string) *UserUpdate {
+	uu.mutation.RemoveConversationFolderIDs(ids...)
+	return uu
+}
+
+// RemoveConversationFolders removes "conversation_folders" edges to ConversationFolder entities.
+func (uu *UserUpdate) RemoveConversationFolders(c ...*ConversationFolder) *UserUpdate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.RemoveConversationFolderIDs(ids...)
+}
+
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (uu *UserUpdate) ClearNotifications() *UserUpdate {
+	uu.mutation.ClearNotifications()
+	return uu
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (uu *UserUpdate) RemoveNotificationIDs(ids ...string) *UserUpdate {
+	uu.mutation.RemoveNotificationIDs(ids...)
+	return uu
+}
+
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (uu *UserUpdate) RemoveNotifications(n ...*Notification) *UserUpdate {
+	ids := make([]string, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uu.RemoveNotificationIDs(ids...)
+}
+
+// ClearRelatedNotifications clears all "related_notifications" edges to the Notification entity.
+func (uu *UserUpdate) ClearRelatedNotifications() *UserUpdate {
+	uu.mutation.ClearRelatedNotifications()
+	return uu
+}
+
+// RemoveRelatedNotificationIDs removes the "related_notifications" edge to Notification entities by IDs.
+func (uu *UserUpdate) RemoveRelatedNotificationIDs(ids ...string) *UserUpdate {
+	uu.mutation.RemoveRelatedNotificationIDs(ids...)
+	return uu
+}
+
+// RemoveRelatedNotifications removes "related_notifications" edges to Notification entities.
+func (uu *UserUpdate) RemoveRelatedNotifications(n ...*Notification) *UserUpdate {
+	ids := make([]string, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uu.RemoveRelatedNotificationIDs(ids...)
+}
+
+// ClearConversationParticipations clears all "conversation_participations" edges to the ConversationParticipant entity.
+func (uu *UserUpdate) ClearConversationParticipations() *UserUpdate {
+	uu.mutation.ClearConversationParticipations()
+	return uu
+}
+
+// RemoveConversationParticipationIDs removes the "conversation_participations" edge to ConversationParticipant entities by IDs.
+func (uu *UserUpdate) RemoveConversationParticipationIDs(ids ...string) *UserUpdate {
+	uu.mutation.RemoveConversationParticipationIDs(ids...)
+	return uu
+}
+
+// RemoveConversationParticipations removes "conversation_participations" edges to ConversationParticipant entities.
+func (uu *UserUpdate) RemoveConversationParticipations(c ...*ConversationParticipant) *UserUpdate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.RemoveConversationParticipationIDs(ids...)
+}
+
+// ClearThreadParticipations clears all "thread_participations" edges to the ThreadParticipant entity.
+func (uu *UserUpdate) ClearThreadParticipations() *UserUpdate {
+	uu.mutation.ClearThreadParticipations()
+	return uu
+}
+
+// RemoveThreadParticipationIDs removes the "thread_participations" edge to ThreadParticipant entities by IDs.
+func (uu *UserUpdate) RemoveThreadParticipationIDs(ids ...string) *UserUpdate {
+	uu.mutation.RemoveThreadParticipationIDs(ids...)
+	return uu
+}
+
+// RemoveThreadParticipations removes "thread_participations" edges to ThreadParticipant entities.
+func (uu *UserUpdate) RemoveThreadParticipations(t ...*ThreadParticipant) *UserUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uu.RemoveThreadParticipationIDs(ids...)
+}
+
+// ClearBlockedUsers clears all "blocked_users" edges to the Block entity.
+func (uu *UserUpdate) ClearBlockedUsers() *UserUpdate {
+	uu.mutation.ClearBlockedUsers()
+	return uu
+}
+
+// RemoveBlockedUserIDs removes the "blocked_users" edge to Block entities by IDs.
+func (uu *UserUpdate) RemoveBlockedUserIDs(ids ...string) *UserUpdate {
+	uu.mutation.RemoveBlockedUserIDs(ids...)
+	return uu
+}
+
+// RemoveBlockedUsers removes "blocked_users" edges to Block entities.
+func (uu *UserUpdate) RemoveBlockedUsers(b ...*Block) *UserUpdate {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uu.RemoveBlockedUserIDs(ids...)
+}
+
+// ClearBlockedByUsers clears all "blocked_by_users" edges to the Block entity.
+func (uu *UserUpdate) ClearBlockedByUsers() *UserUpdate {
+	uu.mutation.ClearBlockedByUsers()
+	return uu
+}
+
+// RemoveBlockedByUserIDs removes the "blocked_by_users" edge to Block entities by IDs.
+func (uu *UserUpdate) RemoveBlockedByUserIDs(ids ...string) *UserUpdate {
+	uu.mutation.RemoveBlockedByUserIDs(ids...)
+	return uu
+}
+
+// RemoveBlockedByUsers removes "blocked_by_users" edges to Block entities.
+func (uu *UserUpdate) RemoveBlockedByUsers(b ...*Block) *UserUpdate {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uu.RemoveBlockedByUserIDs(ids...)
+}
+
+// ClearCallsMade clears all "calls_made" edges to the Call entity.
+func (uu *UserUpdate) ClearCallsMade() *UserUpdate {
+	uu.mutation.ClearCallsMade()
+	return uu
+}
+
+// RemoveCallsMadeIDs removes the "calls_made" edge to Call entities by IDs.
+func (uu *UserUpdate) RemoveCallsMadeIDs(ids ...string) *UserUpdate {
+	uu.mutation.RemoveCallsMadeIDs(ids...)
+	return uu
+}
+
+// RemoveCallsMade removes "calls_made" edges to Call entities.
+func (uu *UserUpdate) RemoveCallsMade(c ...*Call) *UserUpdate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.RemoveCallsMadeIDs(ids...)
+}
+
+// ClearCallsReceived clears all "calls_received" edges to the Call entity.
+func (uu *UserUpdate) ClearCallsReceived() *UserUpdate {
+	uu.mutation.ClearCallsReceived()
+	return uu
+}
+
+// RemoveCallsReceivedIDs removes the "calls_received" edge to Call entities by IDs.
+func (uu *UserUpdate) RemoveCallsReceivedIDs(ids ...string) *UserUpdate {
+	uu.mutation.RemoveCallsReceivedIDs(ids...)
+	return uu
+}
+
+// RemoveCallsReceived removes "calls_received" edges to Call entities.
+func (uu *UserUpdate) RemoveCallsReceived(c ...*Call) *UserUpdate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.RemoveCallsReceivedIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
+	uu.defaults()
+	return withHooks(ctx, uu.sqlSave, uu.mutation, uu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
 func (uu *UserUpdate) SaveX(ctx context.Context) int {
 	affected, err := uu.Save(ctx)
 	if err != nil {
@@ -801,6 +1369,9 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if uu.mutation.CoverURLCleared() {
 		_spec.ClearField(user.FieldCoverURL, field.TypeString)
 	}
+	if value, ok := uu.mutation.ReadReceiptsEnabled(); ok {
+		_spec.SetField(user.FieldReadReceiptsEnabled, field.TypeBool, value)
+	}
 	if uu.mutation.SessionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1071,28 +1642,28 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uu.mutation.SentMessagesCleared() {
+	if uu.mutation.FriendInvitesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.SentMessagesTable,
-			Columns: []string{user.SentMessagesColumn},
+			Table:   user.FriendInvitesTable,
+			Columns: []string{user.FriendInvitesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(friendinvite.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uu.mutation.RemovedSentMessagesIDs(); len(nodes) > 0 && !uu.mutation.SentMessagesCleared() {
+	if nodes := uu.mutation.RemovedFriendInvitesIDs(); len(nodes) > 0 && !uu.mutation.FriendInvitesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.SentMessagesTable,
-			Columns: []string{user.SentMessagesColumn},
+			Table:   user.FriendInvitesTable,
+			Columns: []string{user.FriendInvitesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(friendinvite.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1100,15 +1671,15 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uu.mutation.SentMessagesIDs(); len(nodes) > 0 {
+	if nodes := uu.mutation.FriendInvitesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.SentMessagesTable,
-			Columns: []string{user.SentMessagesColumn},
+			Table:   user.FriendInvitesTable,
+			Columns: []string{user.FriendInvitesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(friendinvite.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1116,28 +1687,28 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uu.mutation.NotificationsCleared() {
+	if uu.mutation.FriendInviteUsesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.NotificationsTable,
-			Columns: []string{user.NotificationsColumn},
+			Table:   user.FriendInviteUsesTable,
+			Columns: []string{user.FriendInviteUsesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(friendinviteuse.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uu.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !uu.mutation.NotificationsCleared() {
+	if nodes := uu.mutation.RemovedFriendInviteUsesIDs(); len(nodes) > 0 && !uu.mutation.FriendInviteUsesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.NotificationsTable,
-			Columns: []string{user.NotificationsColumn},
+			Table:   user.FriendInviteUsesTable,
+			Columns: []string{user.FriendInviteUsesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(friendinviteuse.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1145,15 +1716,15 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uu.mutation.NotificationsIDs(); len(nodes) > 0 {
+	if nodes := uu.mutation.FriendInviteUsesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.NotificationsTable,
-			Columns: []string{user.NotificationsColumn},
+			Table:   user.FriendInviteUsesTable,
+			Columns: []string{user.FriendInviteUsesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(friendinviteuse.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1161,28 +1732,28 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uu.mutation.RelatedNotificationsCleared() {
+	if uu.mutation.SentMessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.RelatedNotificationsTable,
-			Columns: []string{user.RelatedNotificationsColumn},
+			Table:   user.SentMessagesTable,
+			Columns: []string{user.SentMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uu.mutation.RemovedRelatedNotificationsIDs(); len(nodes) > 0 && !uu.mutation.RelatedNotificationsCleared() {
+	if nodes := uu.mutation.RemovedSentMessagesIDs(); len(nodes) > 0 && !uu.mutation.SentMessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.RelatedNotificationsTable,
-			Columns: []string{user.RelatedNotificationsColumn},
+			Table:   user.SentMessagesTable,
+			Columns: []string{user.SentMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1190,15 +1761,15 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uu.mutation.RelatedNotificationsIDs(); len(nodes) > 0 {
+	if nodes := uu.mutation.SentMessagesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.RelatedNotificationsTable,
-			Columns: []string{user.RelatedNotificationsColumn},
+			Table:   user.SentMessagesTable,
+			Columns: []string{user.SentMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1206,28 +1777,28 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uu.mutation.ConversationParticipationsCleared() {
+	if uu.mutation.ForwardedMessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.ConversationParticipationsTable,
-			Columns: []string{user.ConversationParticipationsColumn},
+			Table:   user.ForwardedMessagesTable,
+			Columns: []string{user.ForwardedMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(conversationparticipant.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uu.mutation.RemovedConversationParticipationsIDs(); len(nodes) > 0 && !uu.mutation.ConversationParticipationsCleared() {
+	if nodes := uu.mutation.RemovedForwardedMessagesIDs(); len(nodes) > 0 && !uu.mutation.ForwardedMessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.ConversationParticipationsTable,
-			Columns: []string{user.ConversationParticipationsColumn},
+			Table:   user.ForwardedMessagesTable,
+			Columns: []string{user.ForwardedMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(conversationparticipant.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1235,15 +1806,15 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uu.mutation.ConversationParticipationsIDs(); len(nodes) > 0 {
+	if nodes := uu.mutation.ForwardedMessagesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.ConversationParticipationsTable,
-			Columns: []string{user.ConversationParticipationsColumn},
+			Table:   user.ForwardedMessagesTable,
+			Columns: []string{user.ForwardedMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(conversationparticipant.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1251,28 +1822,28 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uu.mutation.BlockedUsersCleared() {
+	if uu.mutation.MessageRevisionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.BlockedUsersTable,
-			Columns: []string{user.BlockedUsersColumn},
+			Table:   user.MessageRevisionsTable,
+			Columns: []string{user.MessageRevisionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uu.mutation.RemovedBlockedUsersIDs(); len(nodes) > 0 && !uu.mutation.BlockedUsersCleared() {
+	if nodes := uu.mutation.RemovedMessageRevisionsIDs(); len(nodes) > 0 && !uu.mutation.MessageRevisionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.BlockedUsersTable,
-			Columns: []string{user.BlockedUsersColumn},
+			Table:   user.MessageRevisionsTable,
+			Columns: []string{user.MessageRevisionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1280,15 +1851,15 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uu.mutation.BlockedUsersIDs(); len(nodes) > 0 {
+	if nodes := uu.mutation.MessageRevisionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.BlockedUsersTable,
-			Columns: []string{user.BlockedUsersColumn},
+			Table:   user.MessageRevisionsTable,
+			Columns: []string{user.MessageRevisionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1296,28 +1867,28 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uu.mutation.BlockedByUsersCleared() {
+	if uu.mutation.MessageReactionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.BlockedByUsersTable,
-			Columns: []string{user.BlockedByUsersColumn},
+			Table:   user.MessageReactionsTable,
+			Columns: []string{user.MessageReactionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uu.mutation.RemovedBlockedByUsersIDs(); len(nodes) > 0 && !uu.mutation.BlockedByUsersCleared() {
+	if nodes := uu.mutation.RemovedMessageReactionsIDs(); len(nodes) > 0 && !uu.mutation.MessageReactionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.BlockedByUsersTable,
-			Columns: []string{user.BlockedByUsersColumn},
+			Table:   user.MessageReactionsTable,
+			Columns: []string{user.MessageReactionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1325,15 +1896,15 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uu.mutation.BlockedByUsersIDs(); len(nodes) > 0 {
+	if nodes := uu.mutation.MessageReactionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.BlockedByUsersTable,
-			Columns: []string{user.BlockedByUsersColumn},
+			Table:   user.MessageReactionsTable,
+			Columns: []string{user.MessageReactionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1341,28 +1912,28 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uu.mutation.CallsMadeCleared() {
+	if uu.mutation.AttachmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.CallsMadeTable,
-			Columns: []string{user.CallsMadeColumn},
+			Table:   user.AttachmentsTable,
+			Columns: []string{user.AttachmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(call.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uu.mutation.RemovedCallsMadeIDs(); len(nodes) > 0 && !uu.mutation.CallsMadeCleared() {
+	if nodes := uu.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !uu.mutation.AttachmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.CallsMadeTable,
-			Columns: []string{user.CallsMadeColumn},
+			Table:   user.AttachmentsTable,
+			Columns: []string{user.AttachmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(call.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1370,15 +1941,15 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uu.mutation.CallsMadeIDs(); len(nodes) > 0 {
+	if nodes := uu.mutation.AttachmentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.CallsMadeTable,
-			Columns: []string{user.CallsMadeColumn},
+			Table:   user.AttachmentsTable,
+			Columns: []string{user.AttachmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(call.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1386,28 +1957,28 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uu.mutation.CallsReceivedCleared() {
+	if uu.mutation.MentionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.CallsReceivedTable,
-			Columns: []string{user.CallsReceivedColumn},
+			Table:   user.MentionsTable,
+			Columns: []string{user.MentionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(call.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uu.mutation.RemovedCallsReceivedIDs(); len(nodes) > 0 && !uu.mutation.CallsReceivedCleared() {
+	if nodes := uu.mutation.RemovedMentionsIDs(); len(nodes) > 0 && !uu.mutation.MentionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.CallsReceivedTable,
-			Columns: []string{user.CallsReceivedColumn},
+			Table:   user.MentionsTable,
+			Columns: []string{user.MentionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(call.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1415,15 +1986,15 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uu.mutation.CallsReceivedIDs(); len(nodes) > 0 {
+	if nodes := uu.mutation.MentionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.CallsReceivedTable,
-			Columns: []string{user.CallsReceivedColumn},
+			Table:   user.MentionsTable,
+			Columns: []string{user.MentionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(call.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1431,841 +2002,2703 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if uu.mutation.PinnedMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.PinnedMessagesTable,
+			Columns: []string{user.PinnedMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeString),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	uu.mutation.done = true
-	return n, nil
-}
-
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (uuo *UserUpdateOne) SetCreatedAt(t time.Time) *UserUpdateOne {
-	uuo.mutation.SetCreatedAt(t)
-	return uuo
-}
-
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableCreatedAt(t *time.Time) *UserUpdateOne {
-	if t != nil {
-		uuo.SetCreatedAt(*t)
+	if nodes := uu.mutation.RemovedPinnedMessagesIDs(); len(nodes) > 0 && !uu.mutation.PinnedMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.PinnedMessagesTable,
+			Columns: []string{user.PinnedMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (uuo *UserUpdateOne) SetUpdatedAt(t time.Time) *UserUpdateOne {
-	uuo.mutation.SetUpdatedAt(t)
-	return uuo
-}
-
-// SetName sets the "name" field.
-func (uuo *UserUpdateOne) SetName(s string) *UserUpdateOne {
-	uuo.mutation.SetName(s)
-	return uuo
-}
-
-// SetNillableName sets the "name" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableName(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetName(*s)
+	if nodes := uu.mutation.PinnedMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.PinnedMessagesTable,
+			Columns: []string{user.PinnedMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo
-}
-
-// SetEmail sets the "email" field.
-func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
-	uuo.mutation.SetEmail(s)
-	return uuo
-}
-
-// SetNillableEmail sets the "email" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetEmail(*s)
+	if uu.mutation.MessageReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MessageReceiptsTable,
+			Columns: []string{user.MessageReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereceipt.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// SetPassword sets the "password" field.
-func (uuo *UserUpdateOne) SetPassword(s string) *UserUpdateOne {
-	uuo.mutation.SetPassword(s)
-	return uuo
-}
-
-// SetNillablePassword sets the "password" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillablePassword(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetPassword(*s)
+	if nodes := uu.mutation.RemovedMessageReceiptsIDs(); len(nodes) > 0 && !uu.mutation.MessageReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MessageReceiptsTable,
+			Columns: []string{user.MessageReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereceipt.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// ClearPassword clears the value of the "password" field.
-func (uuo *UserUpdateOne) ClearPassword() *UserUpdateOne {
-	uuo.mutation.ClearPassword()
-	return uuo
-}
-
-// SetUsername sets the "username" field.
-func (uuo *UserUpdateOne) SetUsername(s string) *UserUpdateOne {
-	uuo.mutation.SetUsername(s)
-	return uuo
-}
-
-// SetNillableUsername sets the "username" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableUsername(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetUsername(*s)
+	if nodes := uu.mutation.MessageReceiptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MessageReceiptsTable,
+			Columns: []string{user.MessageReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereceipt.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo
-}
-
-// SetBio sets the "bio" field.
-func (uuo *UserUpdateOne) SetBio(s string) *UserUpdateOne {
-	uuo.mutation.SetBio(s)
-	return uuo
-}
-
-// SetNillableBio sets the "bio" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableBio(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetBio(*s)
+	if uu.mutation.ScheduledMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ScheduledMessagesTable,
+			Columns: []string{user.ScheduledMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// ClearBio clears the value of the "bio" field.
-func (uuo *UserUpdateOne) ClearBio() *UserUpdateOne {
-	uuo.mutation.ClearBio()
-	return uuo
-}
-
-// SetAvaterURL sets the "avater_url" field.
-func (uuo *UserUpdateOne) SetAvaterURL(s string) *UserUpdateOne {
-	uuo.mutation.SetAvaterURL(s)
-	return uuo
-}
-
-// SetNillableAvaterURL sets the "avater_url" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableAvaterURL(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetAvaterURL(*s)
+	if nodes := uu.mutation.RemovedScheduledMessagesIDs(); len(nodes) > 0 && !uu.mutation.ScheduledMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ScheduledMessagesTable,
+			Columns: []string{user.ScheduledMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// ClearAvaterURL clears the value of the "avater_url" field.
-func (uuo *UserUpdateOne) ClearAvaterURL() *UserUpdateOne {
-	uuo.mutation.ClearAvaterURL()
-	return uuo
-}
-
-// SetCoverURL sets the "cover_url" field.
-func (uuo *UserUpdateOne) SetCoverURL(s string) *UserUpdateOne {
-	uuo.mutation.SetCoverURL(s)
-	return uuo
-}
-
-// SetNillableCoverURL sets the "cover_url" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableCoverURL(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetCoverURL(*s)
+	if nodes := uu.mutation.ScheduledMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ScheduledMessagesTable,
+			Columns: []string{user.ScheduledMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo
-}
-
-// ClearCoverURL clears the value of the "cover_url" field.
-func (uuo *UserUpdateOne) ClearCoverURL() *UserUpdateOne {
-	uuo.mutation.ClearCoverURL()
-	return uuo
-}
-
-// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
-func (uuo *UserUpdateOne) AddSessionIDs(ids ...string) *UserUpdateOne {
-	uuo.mutation.AddSessionIDs(ids...)
-	return uuo
-}
-
-// AddSessions adds the "sessions" edges to the Session entity.
-func (uuo *UserUpdateOne) AddSessions(s ...*Session) *UserUpdateOne {
-	ids := make([]string, len(s))
-	for i := range s {
-		ids[i] = s[i].ID
+	if uu.mutation.DraftsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.DraftsTable,
+			Columns: []string{user.DraftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(draft.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddSessionIDs(ids...)
-}
-
-// AddOwnedGuildIDs adds the "owned_guilds" edge to the Guild entity by IDs.
-func (uuo *UserUpdateOne) AddOwnedGuildIDs(ids ...string) *UserUpdateOne {
-	uuo.mutation.AddOwnedGuildIDs(ids...)
-	return uuo
-}
-
-// AddOwnedGuilds adds the "owned_guilds" edges to the Guild entity.
-func (uuo *UserUpdateOne) AddOwnedGuilds(g ...*Guild) *UserUpdateOne {
-	ids := make([]string, len(g))
-	for i := range g {
-		ids[i] = g[i].ID
+	if nodes := uu.mutation.RemovedDraftsIDs(); len(nodes) > 0 && !uu.mutation.DraftsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.DraftsTable,
+			Columns: []string{user.DraftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(draft.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddOwnedGuildIDs(ids...)
-}
-
-// AddInvitationIDs adds the "invitations" edge to the Invitation entity by IDs.
-func (uuo *UserUpdateOne) AddInvitationIDs(ids ...string) *UserUpdateOne {
-	uuo.mutation.AddInvitationIDs(ids...)
-	return uuo
-}
-
-// AddInvitations adds the "invitations" edges to the Invitation entity.
-func (uuo *UserUpdateOne) AddInvitations(i ...*Invitation) *UserUpdateOne {
-	ids := make([]string, len(i))
-	for j := range i {
-		ids[j] = i[j].ID
+	if nodes := uu.mutation.DraftsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.DraftsTable,
+			Columns: []string{user.DraftsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(draft.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.AddInvitationIDs(ids...)
-}
-
-// AddMemberOfIDs adds the "member_of" edge to the Member entity by IDs.
-func (uuo *UserUpdateOne) AddMemberOfIDs(ids ...string) *UserUpdateOne {
-	uuo.mutation.AddMemberOfIDs(ids...)
-	return uuo
-}
-
-// AddMemberOf adds the "member_of" edges to the Member entity.
-func (uuo *UserUpdateOne) AddMemberOf(m ...*Member) *UserUpdateOne {
-	ids := make([]string, len(m))
-	for i := range m {
-		ids[i] = m[i].ID
+	if uu.mutation.BookmarksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.BookmarksTable,
+			Columns: []string{user.BookmarksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddMemberOfIDs(ids...)
-}
-
-// AddFriendRequestsSentIDs adds the "friend_requests_sent" edge to the Friend entity by IDs.
-func (uuo *UserUpdateOne) AddFriendRequestsSentIDs(ids ...string) *UserUpdateOne {
-	uuo.mutation.AddFriendRequestsSentIDs(ids...)
-	return uuo
-}
-
-// AddFriendRequestsSent adds the "friend_requests_sent" edges to the Friend entity.
-func (uuo *UserUpdateOne) AddFriendRequestsSent(f ...*Friend) *UserUpdateOne {
-	ids := make([]string, len(f))
-	for i := range f {
-		ids[i] = f[i].ID
+	if nodes := uu.mutation.RemovedBookmarksIDs(); len(nodes) > 0 && !uu.mutation.BookmarksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.BookmarksTable,
+			Columns: []string{user.BookmarksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddFriendRequestsSentIDs(ids...)
-}
-
-// AddFriendRequestsReceivedIDs adds the "friend_requests_received" edge to the Friend entity by IDs.
-func (uuo *UserUpdateOne) AddFriendRequestsReceivedIDs(ids ...string) *UserUpdateOne {
-	uuo.mutation.AddFriendRequestsReceivedIDs(ids...)
-	return uuo
-}
-
-// AddFriendRequestsReceived adds the "friend_requests_received" edges to the Friend entity.
-func (uuo *UserUpdateOne) AddFriendRequestsReceived(f ...*Friend) *UserUpdateOne {
-	ids := make([]string, len(f))
-	for i := range f {
-		ids[i] = f[i].ID
+	if nodes := uu.mutation.BookmarksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.BookmarksTable,
+			Columns: []string{user.BookmarksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.AddFriendRequestsReceivedIDs(ids...)
-}
-
-// AddSentMessageIDs adds the "sent_messages" edge to the Message entity by IDs.
-func (uuo *UserUpdateOne) AddSentMessageIDs(ids ...string) *UserUpdateOne {
-	uuo.mutation.AddSentMessageIDs(ids...)
-	return uuo
-}
-
-// AddSentMessages adds the "sent_messages" edges to the Message entity.
-func (uuo *UserUpdateOne) AddSentMessages(m ...*Message) *UserUpdateOne {
-	ids := make([]string, len(m))
-	for i := range m {
-		ids[i] = m[i].ID
+	if uu.mutation.HiddenMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.HiddenMessagesTable,
+			Columns: []string{user.HiddenMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hiddenmessage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddSentMessageIDs(ids...)
-}
-
-// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
-func (uuo *UserUpdateOne) AddNotificationIDs(ids ...string) *UserUpdateOne {
-	uuo.mutation.AddNotificationIDs(ids...)
-	return uuo
-}
-
-// AddNotifications adds the "notifications" edges to the Notification entity.
-func (uuo *UserUpdateOne) AddNotifications(n ...*Notification) *UserUpdateOne {
-	ids := make([]string, len(n))
-	for i := range n {
-		ids[i] = n[i].ID
+	if nodes := uu.mutation.RemovedHiddenMessagesIDs(); len(nodes) > 0 && !uu.mutation.HiddenMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.HiddenMessagesTable,
+			Columns: []string{user.HiddenMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hiddenmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddNotificationIDs(ids...)
-}
-
-// AddRelatedNotificationIDs adds the "related_notifications" edge to the Notification entity by IDs.
-func (uuo *UserUpdateOne) AddRelatedNotificationIDs(ids ...string) *UserUpdateOne {
-	uuo.mutation.AddRelatedNotificationIDs(ids...)
-	return uuo
-}
-
-// AddRelatedNotifications adds the "related_notifications" edges to the Notification entity.
-func (uuo *UserUpdateOne) AddRelatedNotifications(n ...*Notification) *UserUpdateOne {
-	ids := make([]string, len(n))
-	for i := range n {
-		ids[i] = n[i].ID
+	if nodes := uu.mutation.HiddenMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.HiddenMessagesTable,
+			Columns: []string{user.HiddenMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hiddenmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.AddRelatedNotificationIDs(ids...)
-}
-
-// AddConversationParticipationIDs adds the "conversation_participations" edge to the ConversationParticipant entity by IDs.
-func (uuo *UserUpdateOne) AddConversationParticipationIDs(ids ...string) *UserUpdateOne {
-	uuo.mutation.AddConversationParticipationIDs(ids...)
-	return uuo
-}
-
-// AddConversationParticipations adds the "conversation_participations" edges to the ConversationParticipant entity.
-func (uuo *UserUpdateOne) AddConversationParticipations(c ...*ConversationParticipant) *UserUpdateOne {
-	ids := make([]string, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
+	if uu.mutation.ConversationFoldersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ConversationFoldersTable,
+			Columns: []string{user.ConversationFoldersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationfolder.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddConversationParticipationIDs(ids...)
-}
-
-// AddBlockedUserIDs adds the "blocked_users" edge to the Block entity by IDs.
-func (uuo *UserUpdateOne) AddBlockedUserIDs(ids ...string) *UserUpdateOne {
-	uuo.mutation.AddBlockedUserIDs(ids...)
-	return uuo
-}
-
-// AddBlockedUsers adds the "blocked_users" edges to the Block entity.
-func (uuo *UserUpdateOne) AddBlockedUsers(b ...*Block) *UserUpdateOne {
-	ids := make([]string, len(b))
-	for i := range b {
-		ids[i] = b[i].ID
+	if nodes := uu.mutation.RemovedConversationFoldersIDs(); len(nodes) > 0 && !uu.mutation.ConversationFoldersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ConversationFoldersTable,
+			Columns: []string{user.ConversationFoldersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationfolder.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddBlockedUserIDs(ids...)
-}
-
-// AddBlockedByUserIDs adds the "blocked_by_users" edge to the Block entity by IDs.
-func (uuo *UserUpdateOne) AddBlockedByUserIDs(ids ...string) *UserUpdateOne {
-	uuo.mutation.AddBlockedByUserIDs(ids...)
-	return uuo
-}
-
-// AddBlockedByUsers adds the "blocked_by_users" edges to the Block entity.
-func (uuo *UserUpdateOne) AddBlockedByUsers(b ...*Block) *UserUpdateOne {
-	ids := make([]string, len(b))
-	for i := range b {
-		ids[i] = b[i].ID
+	if nodes := uu.mutation.ConversationFoldersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ConversationFoldersTable,
+			Columns: []string{user.ConversationFoldersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationfolder.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.AddBlockedByUserIDs(ids...)
-}
-
-// AddCallsMadeIDs adds the "calls_made" edge to the Call entity by IDs.
-func (uuo *UserUpdateOne) AddCallsMadeIDs(ids ...string) *UserUpdateOne {
-	uuo.mutation.AddCallsMadeIDs(ids...)
-	return uuo
-}
-
-// AddCallsMade adds the "calls_made" edges to the Call entity.
-func (uuo *UserUpdateOne) AddCallsMade(c ...*Call) *UserUpdateOne {
-	ids := make([]string, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
+	if uu.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddCallsMadeIDs(ids...)
-}
-
-// AddCallsReceivedIDs adds the "calls_received" edge to the Call entity by IDs.
-func (uuo *UserUpdateOne) AddCallsReceivedIDs(ids ...string) *UserUpdateOne {
-	uuo.mutation.AddCallsReceivedIDs(ids...)
-	return uuo
-}
-
-// AddCallsReceived adds the "calls_received" edges to the Call entity.
-func (uuo *UserUpdateOne) AddCallsReceived(c ...*Call) *UserUpdateOne {
-	ids := make([]string, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
+	if nodes := uu.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !uu.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddCallsReceivedIDs(ids...)
-}
-
-// Mutation returns the UserMutation object of the builder.
-func (uuo *UserUpdateOne) Mutation() *UserMutation {
-	return uuo.mutation
-}
-
-// ClearSessions clears all "sessions" edges to the Session entity.
-func (uuo *UserUpdateOne) ClearSessions() *UserUpdateOne {
-	uuo.mutation.ClearSessions()
-	return uuo
-}
-
-// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
-func (uuo *UserUpdateOne) RemoveSessionIDs(ids ...string) *UserUpdateOne {
-	uuo.mutation.RemoveSessionIDs(ids...)
-	return uuo
-}
-
-// RemoveSessions removes "sessions" edges to Session entities.
-func (uuo *UserUpdateOne) RemoveSessions(s ...*Session) *UserUpdateOne {
-	ids := make([]string, len(s))
-	for i := range s {
-		ids[i] = s[i].ID
+	if nodes := uu.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.RemoveSessionIDs(ids...)
-}
-
-// ClearOwnedGuilds clears all "owned_guilds" edges to the Guild entity.
-func (uuo *UserUpdateOne) ClearOwnedGuilds() *UserUpdateOne {
-	uuo.mutation.ClearOwnedGuilds()
-	return uuo
-}
-
-// RemoveOwnedGuildIDs removes the "owned_guilds" edge to Guild entities by IDs.
-func (uuo *UserUpdateOne) RemoveOwnedGuildIDs(ids ...string) *UserUpdateOne {
-	uuo.mutation.RemoveOwnedGuildIDs(ids...)
-	return uuo
-}
-
-// RemoveOwnedGuilds removes "owned_guilds" edges to Guild entities.
-func (uuo *UserUpdateOne) RemoveOwnedGuilds(g ...*Guild) *UserUpdateOne {
-	ids := make([]string, len(g))
-	for i := range g {
-		ids[i] = g[i].ID
+	if uu.mutation.RelatedNotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.RelatedNotificationsTable,
+			Columns: []string{user.RelatedNotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveOwnedGuildIDs(ids...)
-}
-
-// ClearInvitations clears all "invitations" edges to the Invitation entity.
-func (uuo *UserUpdateOne) ClearInvitations() *UserUpdateOne {
-	uuo.mutation.ClearInvitations()
-	return uuo
-}
-
-// RemoveInvitationIDs removes the "invitations" edge to Invitation entities by IDs.
-func (uuo *UserUpdateOne) RemoveInvitationIDs(ids ...string) *UserUpdateOne {
-	uuo.mutation.RemoveInvitationIDs(ids...)
-	return uuo
-}
-
-// RemoveInvitations removes "invitations" edges to Invitation entities.
-func (uuo *UserUpdateOne) RemoveInvitations(i ...*Invitation) *UserUpdateOne {
-	ids := make([]string, len(i))
-	for j := range i {
-		ids[j] = i[j].ID
+	if nodes := uu.mutation.RemovedRelatedNotificationsIDs(); len(nodes) > 0 && !uu.mutation.RelatedNotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.RelatedNotificationsTable,
+			Columns: []string{user.RelatedNotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveInvitationIDs(ids...)
-}
-
-// ClearMemberOf clears all "member_of" edges to the Member entity.
-func (uuo *UserUpdateOne) ClearMemberOf() *UserUpdateOne {
-	uuo.mutation.ClearMemberOf()
-	return uuo
-}
-
-// RemoveMemberOfIDs removes the "member_of" edge to Member entities by IDs.
-func (uuo *UserUpdateOne) RemoveMemberOfIDs(ids ...string) *UserUpdateOne {
-	uuo.mutation.RemoveMemberOfIDs(ids...)
-	return uuo
-}
-
-// RemoveMemberOf removes "member_of" edges to Member entities.
-func (uuo *UserUpdateOne) RemoveMemberOf(m ...*Member) *UserUpdateOne {
-	ids := make([]string, len(m))
-	for i := range m {
-		ids[i] = m[i].ID
+	if nodes := uu.mutation.RelatedNotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.RelatedNotificationsTable,
+			Columns: []string{user.RelatedNotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.RemoveMemberOfIDs(ids...)
-}
-
-// ClearFriendRequestsSent clears all "friend_requests_sent" edges to the Friend entity.
-func (uuo *UserUpdateOne) ClearFriendRequestsSent() *UserUpdateOne {
-	uuo.mutation.ClearFriendRequestsSent()
-	return uuo
-}
-
-// RemoveFriendRequestsSentIDs removes the "friend_requests_sent" edge to Friend entities by IDs.
-func (uuo *UserUpdateOne) RemoveFriendRequestsSentIDs(ids ...string) *UserUpdateOne {
-	uuo.mutation.RemoveFriendRequestsSentIDs(ids...)
-	return uuo
-}
-
-// RemoveFriendRequestsSent removes "friend_requests_sent" edges to Friend entities.
-func (uuo *UserUpdateOne) RemoveFriendRequestsSent(f ...*Friend) *UserUpdateOne {
-	ids := make([]string, len(f))
-	for i := range f {
-		ids[i] = f[i].ID
+	if uu.mutation.ConversationParticipationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ConversationParticipationsTable,
+			Columns: []string{user.ConversationParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationparticipant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveFriendRequestsSentIDs(ids...)
-}
-
-// ClearFriendRequestsReceived clears all "friend_requests_received" edges to the Friend entity.
-func (uuo *UserUpdateOne) ClearFriendRequestsReceived() *UserUpdateOne {
-	uuo.mutation.ClearFriendRequestsReceived()
-	return uuo
-}
-
-// RemoveFriendRequestsReceivedIDs removes the "friend_requests_received" edge to Friend entities by IDs.
-func (uuo *UserUpdateOne) RemoveFriendRequestsReceivedIDs(ids ...string) *UserUpdateOne {
-	uuo.mutation.RemoveFriendRequestsReceivedIDs(ids...)
-	return uuo
-}
-
-// RemoveFriendRequestsReceived removes "friend_requests_received" edges to Friend entities.
-func (uuo *UserUpdateOne) RemoveFriendRequestsReceived(f ...*Friend) *UserUpdateOne {
-	ids := make([]string, len(f))
-	for i := range f {
-		ids[i] = f[i].ID
+	if nodes := uu.mutation.RemovedConversationParticipationsIDs(); len(nodes) > 0 && !uu.mutation.ConversationParticipationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ConversationParticipationsTable,
+			Columns: []string{user.ConversationParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationparticipant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveFriendRequestsReceivedIDs(ids...)
-}
-
-// ClearSentMessages clears all "sent_messages" edges to the Message entity.
-func (uuo *UserUpdateOne) ClearSentMessages() *UserUpdateOne {
-	uuo.mutation.ClearSentMessages()
-	return uuo
-}
-
-// RemoveSentMessageIDs removes the "sent_messages" edge to Message entities by IDs.
-func (uuo *UserUpdateOne) RemoveSentMessageIDs(ids ...string) *UserUpdateOne {
-	uuo.mutation.RemoveSentMessageIDs(ids...)
-	return uuo
-}
-
-// RemoveSentMessages removes "sent_messages" edges to Message entities.
-func (uuo *UserUpdateOne) RemoveSentMessages(m ...*Message) *UserUpdateOne {
-	ids := make([]string, len(m))
-	for i := range m {
-		ids[i] = m[i].ID
+	if nodes := uu.mutation.ConversationParticipationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ConversationParticipationsTable,
+			Columns: []string{user.ConversationParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationparticipant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.RemoveSentMessageIDs(ids...)
-}
-
-// ClearNotifications clears all "notifications" edges to the Notification entity.
-func (uuo *UserUpdateOne) ClearNotifications() *UserUpdateOne {
-	uuo.mutation.ClearNotifications()
-	return uuo
-}
-
-// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
-func (uuo *UserUpdateOne) RemoveNotificationIDs(ids ...string) *UserUpdateOne {
-	uuo.mutation.RemoveNotificationIDs(ids...)
-	return uuo
-}
-
-// RemoveNotifications removes "notifications" edges to Notification entities.
-func (uuo *UserUpdateOne) RemoveNotifications(n ...*Notification) *UserUpdateOne {
-	ids := make([]string, len(n))
-	for i := range n {
-		ids[i] = n[i].ID
+	if uu.mutation.ThreadParticipationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ThreadParticipationsTable,
+			Columns: []string{user.ThreadParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(threadparticipant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveNotificationIDs(ids...)
-}
-
-// ClearRelatedNotifications clears all "related_notifications" edges to the Notification entity.
-func (uuo *UserUpdateOne) ClearRelatedNotifications() *UserUpdateOne {
-	uuo.mutation.ClearRelatedNotifications()
-	return uuo
-}
-
-// RemoveRelatedNotificationIDs removes the "related_notifications" edge to Notification entities by IDs.
-func (uuo *UserUpdateOne) RemoveRelatedNotificationIDs(ids ...string) *UserUpdateOne {
-	uuo.mutation.RemoveRelatedNotificationIDs(ids...)
-	return uuo
-}
-
-// RemoveRelatedNotifications removes "related_notifications" edges to Notification entities.
-func (uuo *UserUpdateOne) RemoveRelatedNotifications(n ...*Notification) *UserUpdateOne {
-	ids := make([]string, len(n))
-	for i := range n {
-		ids[i] = n[i].ID
+	if nodes := uu.mutation.RemovedThreadParticipationsIDs(); len(nodes) > 0 && !uu.mutation.ThreadParticipationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ThreadParticipationsTable,
+			Columns: []string{user.ThreadParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(threadparticipant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveRelatedNotificationIDs(ids...)
-}
-
-// ClearConversationParticipations clears all "conversation_participations" edges to the ConversationParticipant entity.
-func (uuo *UserUpdateOne) ClearConversationParticipations() *UserUpdateOne {
-	uuo.mutation.ClearConversationParticipations()
-	return uuo
-}
-
-// RemoveConversationParticipationIDs removes the "conversation_participations" edge to ConversationParticipant entities by IDs.
-func (uuo *UserUpdateOne) RemoveConversationParticipationIDs(ids ...string) *UserUpdateOne {
-	uuo.mutation.RemoveConversationParticipationIDs(ids...)
-	return uuo
-}
-
-// RemoveConversationParticipations removes "conversation_participations" edges to ConversationParticipant entities.
-func (uuo *UserUpdateOne) RemoveConversationParticipations(c ...*ConversationParticipant) *UserUpdateOne {
-	ids := make([]string, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
+	if nodes := uu.mutation.ThreadParticipationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ThreadParticipationsTable,
+			Columns: []string{user.ThreadParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(threadparticipant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.RemoveConversationParticipationIDs(ids...)
-}
-
-// ClearBlockedUsers clears all "blocked_users" edges to the Block entity.
-func (uuo *UserUpdateOne) ClearBlockedUsers() *UserUpdateOne {
-	uuo.mutation.ClearBlockedUsers()
-	return uuo
-}
-
-// RemoveBlockedUserIDs removes the "blocked_users" edge to Block entities by IDs.
-func (uuo *UserUpdateOne) RemoveBlockedUserIDs(ids ...string) *UserUpdateOne {
-	uuo.mutation.RemoveBlockedUserIDs(ids...)
-	return uuo
-}
-
-// RemoveBlockedUsers removes "blocked_users" edges to Block entities.
-func (uuo *UserUpdateOne) RemoveBlockedUsers(b ...*Block) *UserUpdateOne {
-	ids := make([]string, len(b))
-	for i := range b {
-		ids[i] = b[i].ID
+	if uu.mutation.BlockedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.BlockedUsersTable,
+			Columns: []string{user.BlockedUsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveBlockedUserIDs(ids...)
-}
-
-// ClearBlockedByUsers clears all "blocked_by_users" edges to the Block entity.
-func (uuo *UserUpdateOne) ClearBlockedByUsers() *UserUpdateOne {
-	uuo.mutation.ClearBlockedByUsers()
-	return uuo
+	if nodes := uu.mutation.RemovedBlockedUsersIDs(); len(nodes) > 0 && !uu.mutation.BlockedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.BlockedUsersTable,
+			Columns: []string{user.BlockedUsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.BlockedUsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.BlockedUsersTable,
+			Columns: []string{user.BlockedUsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.BlockedByUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.BlockedByUsersTable,
+			Columns: []string{user.BlockedByUsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedBlockedByUsersIDs(); len(nodes) > 0 && !uu.mutation.BlockedByUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.BlockedByUsersTable,
+			Columns: []string{user.BlockedByUsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.BlockedByUsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.BlockedByUsersTable,
+			Columns: []string{user.BlockedByUsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(block.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.CallsMadeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.CallsMadeTable,
+			Columns: []string{user.CallsMadeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(call.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedCallsMadeIDs(); len(nodes) > 0 && !uu.mutation.CallsMadeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.CallsMadeTable,
+			Columns: []string{user.CallsMadeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(call.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.CallsMadeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.CallsMadeTable,
+			Columns: []string{user.CallsMadeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(call.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.CallsReceivedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.CallsReceivedTable,
+			Columns: []string{user.CallsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(call.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedCallsReceivedIDs(); len(nodes) > 0 && !uu.mutation.CallsReceivedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.CallsReceivedTable,
+			Columns: []string{user.CallsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(call.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.CallsReceivedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.CallsReceivedTable,
+			Columns: []string{user.CallsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(call.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uu.mutation.done = true
+	return n, nil
 }
 
-// RemoveBlockedByUserIDs removes the "blocked_by_users" edge to Block entities by IDs.
-func (uuo *UserUpdateOne) RemoveBlockedByUserIDs(ids ...string) *UserUpdateOne {
-	uuo.mutation.RemoveBlockedByUserIDs(ids...)
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (uuo *UserUpdateOne) SetCreatedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetCreatedAt(t)
 	return uuo
 }
 
-// RemoveBlockedByUsers removes "blocked_by_users" edges to Block entities.
-func (uuo *UserUpdateOne) RemoveBlockedByUsers(b ...*Block) *UserUpdateOne {
-	ids := make([]string, len(b))
-	for i := range b {
-		ids[i] = b[i].ID
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableCreatedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetCreatedAt(*t)
 	}
-	return uuo.RemoveBlockedByUserIDs(ids...)
+	return uuo
 }
 
-// ClearCallsMade clears all "calls_made" edges to the Call entity.
-func (uuo *UserUpdateOne) ClearCallsMade() *UserUpdateOne {
-	uuo.mutation.ClearCallsMade()
+// SetUpdatedAt sets the "updated_at" field.
+func (uuo *UserUpdateOne) SetUpdatedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetUpdatedAt(t)
 	return uuo
 }
 
-// RemoveCallsMadeIDs removes the "calls_made" edge to Call entities by IDs.
-func (uuo *UserUpdateOne) RemoveCallsMadeIDs(ids ...string) *UserUpdateOne {
-	uuo.mutation.RemoveCallsMadeIDs(ids...)
+// SetName sets the "name" field.
+func (uuo *UserUpdateOne) SetName(s string) *UserUpdateOne {
+	uuo.mutation.SetName(s)
 	return uuo
 }
 
-// RemoveCallsMade removes "calls_made" edges to Call entities.
-func (uuo *UserUpdateOne) RemoveCallsMade(c ...*Call) *UserUpdateOne {
-	ids := make([]string, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
+// SetNillableName sets the "name" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableName(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetName(*s)
 	}
-	return uuo.RemoveCallsMadeIDs(ids...)
+	return uuo
 }
 
-// ClearCallsReceived clears all "calls_received" edges to the Call entity.
-func (uuo *UserUpdateOne) ClearCallsReceived() *UserUpdateOne {
-	uuo.mutation.ClearCallsReceived()
+// SetEmail sets the "email" field.
+func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
+	uuo.mutation.SetEmail(s)
 	return uuo
 }
 
-// RemoveCallsReceivedIDs removes the "calls_received" edge to Call entities by IDs.
-func (uuo *UserUpdateOne) RemoveCallsReceivedIDs(ids ...string) *UserUpdateOne {
-	uuo.mutation.RemoveCallsReceivedIDs(ids...)
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetEmail(*s)
+	}
 	return uuo
 }
 
-// RemoveCallsReceived removes "calls_received" edges to Call entities.
-func (uuo *UserUpdateOne) RemoveCallsReceived(c ...*Call) *UserUpdateOne {
-	ids := make([]string, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
+// SetPassword sets the "password" field.
+func (uuo *UserUpdateOne) SetPassword(s string) *UserUpdateOne {
+	uuo.mutation.SetPassword(s)
+	return uuo
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePassword(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetPassword(*s)
+	}
+	return uuo
+}
+
+// ClearPassword clears the value of the "password" field.
+func (uuo *UserUpdateOne) ClearPassword() *UserUpdateOne {
+	uuo.mutation.ClearPassword()
+	return uuo
+}
+
+// SetUsername sets the "username" field.
+func (uuo *UserUpdateOne) SetUsername(s string) *UserUpdateOne {
+	uuo.mutation.SetUsername(s)
+	return uuo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableUsername(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetUsername(*s)
+	}
+	return uuo
+}
+
+// SetBio sets the "bio" field.
+func (uuo *UserUpdateOne) SetBio(s string) *UserUpdateOne {
+	uuo.mutation.SetBio(s)
+	return uuo
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableBio(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetBio(*s)
+	}
+	return uuo
+}
+
+// ClearBio clears the value of the "bio" field.
+func (uuo *UserUpdateOne) ClearBio() *UserUpdateOne {
+	uuo.mutation.ClearBio()
+	return uuo
+}
+
+// SetAvaterURL sets the "avater_url" field.
+func (uuo *UserUpdateOne) SetAvaterURL(s string) *UserUpdateOne {
+	uuo.mutation.SetAvaterURL(s)
+	return uuo
+}
+
+// SetNillableAvaterURL sets the "avater_url" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableAvaterURL(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetAvaterURL(*s)
+	}
+	return uuo
+}
+
+// ClearAvaterURL clears the value of the "avater_url" field.
+func (uuo *UserUpdateOne) ClearAvaterURL() *UserUpdateOne {
+	uuo.mutation.ClearAvaterURL()
+	return uuo
+}
+
+// SetCoverURL sets the "cover_url" field.
+func (uuo *UserUpdateOne) SetCoverURL(s string) *UserUpdateOne {
+	uuo.mutation.SetCoverURL(s)
+	return uuo
+}
+
+// SetNillableCoverURL sets the "cover_url" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableCoverURL(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetCoverURL(*s)
+	}
+	return uuo
+}
+
+// ClearCoverURL clears the value of the "cover_url" field.
+func (uuo *UserUpdateOne) ClearCoverURL() *UserUpdateOne {
+	uuo.mutation.ClearCoverURL()
+	return uuo
+}
+
+// SetReadReceiptsEnabled sets the "read_receipts_enabled" field.
+func (uuo *UserUpdateOne) SetReadReceiptsEnabled(b bool) *UserUpdateOne {
+	uuo.mutation.SetReadReceiptsEnabled(b)
+	return uuo
+}
+
+// SetNillableReadReceiptsEnabled sets the "read_receipts_enabled" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableReadReceiptsEnabled(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetReadReceiptsEnabled(*b)
+	}
+	return uuo
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (uuo *UserUpdateOne) AddSessionIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddSessionIDs(ids...)
+	return uuo
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (uuo *UserUpdateOne) AddSessions(s ...*Session) *UserUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddSessionIDs(ids...)
+}
+
+// AddOwnedGuildIDs adds the "owned_guilds" edge to the Guild entity by IDs.
+func (uuo *UserUpdateOne) AddOwnedGuildIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddOwnedGuildIDs(ids...)
+	return uuo
+}
+
+// AddOwnedGuilds adds the "owned_guilds" edges to the Guild entity.
+func (uuo *UserUpdateOne) AddOwnedGuilds(g ...*Guild) *UserUpdateOne {
+	ids := make([]string, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uuo.AddOwnedGuildIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the Invitation entity by IDs.
+func (uuo *UserUpdateOne) AddInvitationIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddInvitationIDs(ids...)
+	return uuo
+}
+
+// AddInvitations adds the "invitations" edges to the Invitation entity.
+func (uuo *UserUpdateOne) AddInvitations(i ...*Invitation) *UserUpdateOne {
+	ids := make([]string, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.AddInvitationIDs(ids...)
+}
+
+// AddMemberOfIDs adds the "member_of" edge to the Member entity by IDs.
+func (uuo *UserUpdateOne) AddMemberOfIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddMemberOfIDs(ids...)
+	return uuo
+}
+
+// AddMemberOf adds the "member_of" edges to the Member entity.
+func (uuo *UserUpdateOne) AddMemberOf(m ...*Member) *UserUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddMemberOfIDs(ids...)
+}
+
+// AddFriendRequestsSentIDs adds the "friend_requests_sent" edge to the Friend entity by IDs.
+func (uuo *UserUpdateOne) AddFriendRequestsSentIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddFriendRequestsSentIDs(ids...)
+	return uuo
+}
+
+// AddFriendRequestsSent adds the "friend_requests_sent" edges to the Friend entity.
+func (uuo *UserUpdateOne) AddFriendRequestsSent(f ...*Friend) *UserUpdateOne {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.AddFriendRequestsSentIDs(ids...)
+}
+
+// AddFriendRequestsReceivedIDs adds the "friend_requests_received" edge to the Friend entity by IDs.
+func (uuo *UserUpdateOne) AddFriendRequestsReceivedIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddFriendRequestsReceivedIDs(ids...)
+	return uuo
+}
+
+// AddFriendRequestsReceived adds the "friend_requests_received" edges to the Friend entity.
+func (uuo *UserUpdateOne) AddFriendRequestsReceived(f ...*Friend) *UserUpdateOne {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.AddFriendRequestsReceivedIDs(ids...)
+}
+
+// AddFriendInviteIDs adds the "friend_invites" edge to the FriendInvite entity by IDs.
+func (uuo *UserUpdateOne) AddFriendInviteIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddFriendInviteIDs(ids...)
+	return uuo
+}
+
+// AddFriendInvites adds the "friend_invites" edges to the FriendInvite entity.
+func (uuo *UserUpdateOne) AddFriendInvites(f ...*FriendInvite) *UserUpdateOne {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.AddFriendInviteIDs(ids...)
+}
+
+// AddFriendInviteUseIDs adds the "friend_invite_uses" edge to the FriendInviteUse entity by IDs.
+func (uuo *UserUpdateOne) AddFriendInviteUseIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddFriendInviteUseIDs(ids...)
+	return uuo
+}
+
+// AddFriendInviteUses adds the "friend_invite_uses" edges to the FriendInviteUse entity.
+func (uuo *UserUpdateOne) AddFriendInviteUses(f ...*FriendInviteUse) *UserUpdateOne {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.AddFriendInviteUseIDs(ids...)
+}
+
+// AddSentMessageIDs adds the "sent_messages" edge to the Message entity by IDs.
+func (uuo *UserUpdateOne) AddSentMessageIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddSentMessageIDs(ids...)
+	return uuo
+}
+
+// AddSentMessages adds the "sent_messages" edges to the Message entity.
+func (uuo *UserUpdateOne) AddSentMessages(m ...*Message) *UserUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddSentMessageIDs(ids...)
+}
+
+// AddForwardedMessageIDs adds the "forwarded_messages" edge to the Message entity by IDs.
+func (uuo *UserUpdateOne) AddForwardedMessageIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddForwardedMessageIDs(ids...)
+	return uuo
+}
+
+// AddForwardedMessages adds the "forwarded_messages" edges to the Message entity.
+func (uuo *UserUpdateOne) AddForwardedMessages(m ...*Message) *UserUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddForwardedMessageIDs(ids...)
+}
+
+// AddMessageRevisionIDs adds the "message_revisions" edge to the MessageRevision entity by IDs.
+func (uuo *UserUpdateOne) AddMessageRevisionIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddMessageRevisionIDs(ids...)
+	return uuo
+}
+
+// AddMessageRevisions adds the "message_revisions" edges to the MessageRevision entity.
+func (uuo *UserUpdateOne) AddMessageRevisions(m ...*MessageRevision) *UserUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddMessageRevisionIDs(ids...)
+}
+
+// AddMessageReactionIDs adds the "message_reactions" edge to the MessageReaction entity by IDs.
+func (uuo *UserUpdateOne) AddMessageReactionIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddMessageReactionIDs(ids...)
+	return uuo
+}
+
+// AddMessageReactions adds the "message_reactions" edges to the MessageReaction entity.
+func (uuo *UserUpdateOne) AddMessageReactions(m ...*MessageReaction) *UserUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddMessageReactionIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (uuo *UserUpdateOne) AddAttachmentIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddAttachmentIDs(ids...)
+	return uuo
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (uuo *UserUpdateOne) AddAttachments(a ...*Attachment) *UserUpdateOne {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.AddAttachmentIDs(ids...)
+}
+
+// AddMentionIDs adds the "mentions" edge to the MessageMention entity by IDs.
+func (uuo *UserUpdateOne) AddMentionIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddMentionIDs(ids...)
+	return uuo
+}
+
+// AddMentions adds the "mentions" edges to the MessageMention entity.
+func (uuo *UserUpdateOne) AddMentions(m ...*MessageMention) *UserUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddMentionIDs(ids...)
+}
+
+// AddPinnedMessageIDs adds the "pinned_messages" edge to the PinnedMessage entity by IDs.
+func (uuo *UserUpdateOne) AddPinnedMessageIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddPinnedMessageIDs(ids...)
+	return uuo
+}
+
+// AddPinnedMessages adds the "pinned_messages" edges to the PinnedMessage entity.
+func (uuo *UserUpdateOne) AddPinnedMessages(p ...*PinnedMessage) *UserUpdateOne {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.AddPinnedMessageIDs(ids...)
+}
+
+// AddMessageReceiptIDs adds the "message_receipts" edge to the MessageReceipt entity by IDs.
+func (uuo *UserUpdateOne) AddMessageReceiptIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddMessageReceiptIDs(ids...)
+	return uuo
+}
+
+// AddMessageReceipts adds the "message_receipts" edges to the MessageReceipt entity.
+func (uuo *UserUpdateOne) AddMessageReceipts(m ...*MessageReceipt) *UserUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddMessageReceiptIDs(ids...)
+}
+
+// AddScheduledMessageIDs adds the "scheduled_messages" edge to the ScheduledMessage entity by IDs.
+func (uuo *UserUpdateOne) AddScheduledMessageIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddScheduledMessageIDs(ids...)
+	return uuo
+}
+
+// AddScheduledMessages adds the "scheduled_messages" edges to the ScheduledMessage entity.
+func (uuo *UserUpdateOne) AddScheduledMessages(s ...*ScheduledMessage) *UserUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddScheduledMessageIDs(ids...)
+}
+
+// AddDraftIDs adds the "drafts" edge to the Draft entity by IDs.
+func (uuo *UserUpdateOne) AddDraftIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddDraftIDs(ids...)
+	return uuo
+}
+
+// AddDrafts adds the "drafts" edges to the Draft entity.
+func (uuo *UserUpdateOne) AddDrafts(d ...*Draft) *UserUpdateOne {
+	ids := make([]string, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uuo.AddDraftIDs(ids...)
+}
+
+// AddBookmarkIDs adds the "bookmarks" edge to the Bookmark entity by IDs.
+func (uuo *UserUpdateOne) AddBookmarkIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddBookmarkIDs(ids...)
+	return uuo
+}
+
+// AddBookmarks adds the "bookmarks" edges to the Bookmark entity.
+func (uuo *UserUpdateOne) AddBookmarks(b ...*Bookmark) *UserUpdateOne {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uuo.AddBookmarkIDs(ids...)
+}
+
+// AddHiddenMessageIDs adds the "hidden_messages" edge to the HiddenMessage entity by IDs.
+func (uuo *UserUpdateOne) AddHiddenMessageIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddHiddenMessageIDs(ids...)
+	return uuo
+}
+
+// AddHiddenMessages adds the "hidden_messages" edges to the HiddenMessage entity.
+func (uuo *UserUpdateOne) AddHiddenMessages(h ...*HiddenMessage) *UserUpdateOne {
+	ids := make([]string, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return uuo.AddHiddenMessageIDs(ids...)
+}
+
+// AddConversationFolderIDs adds the "conversation_folders" edge to the ConversationFolder entity by IDs.
+func (uuo *UserUpdateOne) AddConversationFolderIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddConversationFolderIDs(ids...)
+	return uuo
+}
+
+// AddConversationFolders adds the "conversation_folders" edges to the ConversationFolder entity.
+func (uuo *UserUpdateOne) AddConversationFolders(c ...*ConversationFolder) *UserUpdateOne {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.AddConversationFolderIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (uuo *UserUpdateOne) AddNotificationIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddNotificationIDs(ids...)
+	return uuo
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (uuo *UserUpdateOne) AddNotifications(n ...*Notification) *UserUpdateOne {
+	ids := make([]string, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uuo.AddNotificationIDs(ids...)
+}
+
+// AddRelatedNotificationIDs adds the "related_notifications" edge to the Notification entity by IDs.
+func (uuo *UserUpdateOne) AddRelatedNotificationIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddRelatedNotificationIDs(ids...)
+	return uuo
+}
+
+// AddRelatedNotifications adds the "related_notifications" edges to the Notification entity.
+func (uuo *UserUpdateOne) AddRelatedNotifications(n ...*Notification) *UserUpdateOne {
+	ids := make([]string, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uuo.AddRelatedNotificationIDs(ids...)
+}
+
+// AddConversationParticipationIDs adds the "conversation_participations" edge to the ConversationParticipant entity by IDs.
+func (uuo *UserUpdateOne) AddConversationParticipationIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddConversationParticipationIDs(ids...)
+	return uuo
+}
+
+// AddConversationParticipations adds the "conversation_participations" edges to the ConversationParticipant entity.
+func (uuo *UserUpdateOne) AddConversationParticipations(c ...*ConversationParticipant) *UserUpdateOne {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.AddConversationParticipationIDs(ids...)
+}
+
+// AddThreadParticipationIDs adds the "thread_participations" edge to the ThreadParticipant entity by IDs.
+func (uuo *UserUpdateOne) AddThreadParticipationIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddThreadParticipationIDs(ids...)
+	return uuo
+}
+
+// AddThreadParticipations adds the "thread_participations" edges to the ThreadParticipant entity.
+func (uuo *UserUpdateOne) AddThreadParticipations(t ...*ThreadParticipant) *UserUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uuo.AddThreadParticipationIDs(ids...)
+}
+
+// AddBlockedUserIDs adds the "blocked_users" edge to the Block entity by IDs.
+func (uuo *UserUpdateOne) AddBlockedUserIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddBlockedUserIDs(ids...)
+	return uuo
+}
+
+// AddBlockedUsers adds the "blocked_users" edges to the Block entity.
+func (uuo *UserUpdateOne) AddBlockedUsers(b ...*Block) *UserUpdateOne {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uuo.AddBlockedUserIDs(ids...)
+}
+
+// AddBlockedByUserIDs adds the "blocked_by_users" edge to the Block entity by IDs.
+func (uuo *UserUpdateOne) AddBlockedByUserIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddBlockedByUserIDs(ids...)
+	return uuo
+}
+
+// AddBlockedByUsers adds the "blocked_by_users" edges to the Block entity.
+func (uuo *UserUpdateOne) AddBlockedByUsers(b ...*Block) *UserUpdateOne {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uuo.AddBlockedByUserIDs(ids...)
+}
+
+// AddCallsMadeIDs adds the "calls_made" edge to the Call entity by IDs.
+func (uuo *UserUpdateOne) AddCallsMadeIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddCallsMadeIDs(ids...)
+	return uuo
+}
+
+// AddCallsMade adds the "calls_made" edges to the Call entity.
+func (uuo *UserUpdateOne) AddCallsMade(c ...*Call) *UserUpdateOne {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.AddCallsMadeIDs(ids...)
+}
+
+// AddCallsReceivedIDs adds the "calls_received" edge to the Call entity by IDs.
+func (uuo *UserUpdateOne) AddCallsReceivedIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.AddCallsReceivedIDs(ids...)
+	return uuo
+}
+
+// AddCallsReceived adds the "calls_received" edges to the Call entity.
+func (uuo *UserUpdateOne) AddCallsReceived(c ...*Call) *UserUpdateOne {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.AddCallsReceivedIDs(ids...)
+}
+
+// Mutation returns the UserMutation object of the builder.
+func (uuo *UserUpdateOne) Mutation() *UserMutation {
+	return uuo.mutation
+}
+
+// ClearSessions clears all "sessions" edges to the Session entity.
+func (uuo *UserUpdateOne) ClearSessions() *UserUpdateOne {
+	uuo.mutation.ClearSessions()
+	return uuo
+}
+
+// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
+func (uuo *UserUpdateOne) RemoveSessionIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveSessionIDs(ids...)
+	return uuo
+}
+
+// RemoveSessions removes "sessions" edges to Session entities.
+func (uuo *UserUpdateOne) RemoveSessions(s ...*Session) *UserUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.RemoveSessionIDs(ids...)
+}
+
+// ClearOwnedGuilds clears all "owned_guilds" edges to the Guild entity.
+func (uuo *UserUpdateOne) ClearOwnedGuilds() *UserUpdateOne {
+	uuo.mutation.ClearOwnedGuilds()
+	return uuo
+}
+
+// RemoveOwnedGuildIDs removes the "owned_guilds" edge to Guild entities by IDs.
+func (uuo *UserUpdateOne) RemoveOwnedGuildIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveOwnedGuildIDs(ids...)
+	return uuo
+}
+
+// RemoveOwnedGuilds removes "owned_guilds" edges to Guild entities.
+func (uuo *UserUpdateOne) RemoveOwnedGuilds(g ...*Guild) *UserUpdateOne {
+	ids := make([]string, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uuo.RemoveOwnedGuildIDs(ids...)
+}
+
+// ClearInvitations clears all "invitations" edges to the Invitation entity.
+func (uuo *UserUpdateOne) ClearInvitations() *UserUpdateOne {
+	uuo.mutation.ClearInvitations()
+	return uuo
+}
+
+// RemoveInvitationIDs removes the "invitations" edge to Invitation entities by IDs.
+func (uuo *UserUpdateOne) RemoveInvitationIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveInvitationIDs(ids...)
+	return uuo
+}
+
+// RemoveInvitations removes "invitations" edges to Invitation entities.
+func (uuo *UserUpdateOne) RemoveInvitations(i ...*Invitation) *UserUpdateOne {
+	ids := make([]string, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.RemoveInvitationIDs(ids...)
+}
+
+// ClearMemberOf clears all "member_of" edges to the Member entity.
+func (uuo *UserUpdateOne) ClearMemberOf() *UserUpdateOne {
+	uuo.mutation.ClearMemberOf()
+	return uuo
+}
+
+// RemoveMemberOfIDs removes the "member_of" edge to Member entities by IDs.
+func (uuo *UserUpdateOne) RemoveMemberOfIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveMemberOfIDs(ids...)
+	return uuo
+}
+
+// RemoveMemberOf removes "member_of" edges to Member entities.
+func (uuo *UserUpdateOne) RemoveMemberOf(m ...*Member) *UserUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.RemoveMemberOfIDs(ids...)
+}
+
+// ClearFriendRequestsSent clears all "friend_requests_sent" edges to the Friend entity.
+func (uuo *UserUpdateOne) ClearFriendRequestsSent() *UserUpdateOne {
+	uuo.mutation.ClearFriendRequestsSent()
+	return uuo
+}
+
+// RemoveFriendRequestsSentIDs removes the "friend_requests_sent" edge to Friend entities by IDs.
+func (uuo *UserUpdateOne) RemoveFriendRequestsSentIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveFriendRequestsSentIDs(ids...)
+	return uuo
+}
+
+// RemoveFriendRequestsSent removes "friend_requests_sent" edges to Friend entities.
+func (uuo *UserUpdateOne) RemoveFriendRequestsSent(f ...*Friend) *UserUpdateOne {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.RemoveFriendRequestsSentIDs(ids...)
+}
+
+// ClearFriendRequestsReceived clears all "friend_requests_received" edges to the Friend entity.
+func (uuo *UserUpdateOne) ClearFriendRequestsReceived() *UserUpdateOne {
+	uuo.mutation.ClearFriendRequestsReceived()
+	return uuo
+}
+
+// RemoveFriendRequestsReceivedIDs removes the "friend_requests_received" edge to Friend entities by IDs.
+func (uuo *UserUpdateOne) RemoveFriendRequestsReceivedIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveFriendRequestsReceivedIDs(ids...)
+	return uuo
+}
+
+// RemoveFriendRequestsReceived removes "friend_requests_received" edges to Friend entities.
+func (uuo *UserUpdateOne) RemoveFriendRequestsReceived(f ...*Friend) *UserUpdateOne {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.RemoveFriendRequestsReceivedIDs(ids...)
+}
+
+// ClearFriendInvites clears all "friend_invites" edges to the FriendInvite entity.
+func (uuo *UserUpdateOne) ClearFriendInvites() *UserUpdateOne {
+	uuo.mutation.ClearFriendInvites()
+	return uuo
+}
+
+// RemoveFriendInviteIDs removes the "friend_invites" edge to FriendInvite entities by IDs.
+func (uuo *UserUpdateOne) RemoveFriendInviteIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveFriendInviteIDs(ids...)
+	return uuo
+}
+
+// RemoveFriendInvites removes "friend_invites" edges to FriendInvite entities.
+func (uuo *UserUpdateOne) RemoveFriendInvites(f ...*FriendInvite) *UserUpdateOne {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.RemoveFriendInviteIDs(ids...)
+}
+
+// ClearFriendInviteUses clears all "friend_invite_uses" edges to the FriendInviteUse entity.
+func (uuo *UserUpdateOne) ClearFriendInviteUses() *UserUpdateOne {
+	uuo.mutation.ClearFriendInviteUses()
+	return uuo
+}
+
+// RemoveFriendInviteUseIDs removes the "friend_invite_uses" edge to FriendInviteUse entities by IDs.
+func (uuo *UserUpdateOne) RemoveFriendInviteUseIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveFriendInviteUseIDs(ids...)
+	return uuo
+}
+
+// RemoveFriendInviteUses removes "friend_invite_uses" edges to FriendInviteUse entities.
+func (uuo *UserUpdateOne) RemoveFriendInviteUses(f ...*FriendInviteUse) *UserUpdateOne {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.RemoveFriendInviteUseIDs(ids...)
+}
+
+// ClearSentMessages clears all "sent_messages" edges to the Message entity.
+func (uuo *UserUpdateOne) ClearSentMessages() *UserUpdateOne {
+	uuo.mutation.ClearSentMessages()
+	return uuo
+}
+
+// RemoveSentMessageIDs removes the "sent_messages" edge to Message entities by IDs.
+func (uuo *UserUpdateOne) RemoveSentMessageIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveSentMessageIDs(ids...)
+	return uuo
+}
+
+// RemoveSentMessages removes "sent_messages" edges to Message entities.
+func (uuo *UserUpdateOne) RemoveSentMessages(m ...*Message) *UserUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.RemoveSentMessageIDs(ids...)
+}
+
+// ClearForwardedMessages clears all "forwarded_messages" edges to the Message entity.
+func (uuo *UserUpdateOne) ClearForwardedMessages() *UserUpdateOne {
+	uuo.mutation.ClearForwardedMessages()
+	return uuo
+}
+
+// RemoveForwardedMessageIDs removes the "forwarded_messages" edge to Message entities by IDs.
+func (uuo *UserUpdateOne) RemoveForwardedMessageIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveForwardedMessageIDs(ids...)
+	return uuo
+}
+
+// RemoveForwardedMessages removes "forwarded_messages" edges to Message entities.
+func (uuo *UserUpdateOne) RemoveForwardedMessages(m ...*Message) *UserUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.RemoveForwardedMessageIDs(ids...)
+}
+
+// ClearMessageRevisions clears all "message_revisions" edges to the MessageRevision entity.
+func (uuo *UserUpdateOne) ClearMessageRevisions() *UserUpdateOne {
+	uuo.mutation.ClearMessageRevisions()
+	return uuo
+}
+
+// RemoveMessageRevisionIDs removes the "message_revisions" edge to MessageRevision entities by IDs.
+func (uuo *UserUpdateOne) RemoveMessageRevisionIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveMessageRevisionIDs(ids...)
+	return uuo
+}
+
+// RemoveMessageRevisions removes "message_revisions" edges to MessageRevision entities.
+func (uuo *UserUpdateOne) RemoveMessageRevisions(m ...*MessageRevision) *UserUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.RemoveMessageRevisionIDs(ids...)
+}
+
+// ClearMessageReactions clears all "message_reactions" edges to the MessageReaction entity.
+func (uuo *UserUpdateOne) ClearMessageReactions() *UserUpdateOne {
+	uuo.mutation.ClearMessageReactions()
+	return uuo
+}
+
+// RemoveMessageReactionIDs removes the "message_reactions" edge to MessageReaction entities by IDs.
+func (uuo *UserUpdateOne) RemoveMessageReactionIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveMessageReactionIDs(ids...)
+	return uuo
+}
+
+// RemoveMessageReactions removes "message_reactions" edges to MessageReaction entities.
+func (uuo *UserUpdateOne) RemoveMessageReactions(m ...*MessageReaction) *UserUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.RemoveMessageReactionIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (uuo *UserUpdateOne) ClearAttachments() *UserUpdateOne {
+	uuo.mutation.ClearAttachments()
+	return uuo
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (uuo *UserUpdateOne) RemoveAttachmentIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveAttachmentIDs(ids...)
+	return uuo
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (uuo *UserUpdateOne) RemoveAttachments(a ...*Attachment) *UserUpdateOne {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.RemoveAttachmentIDs(ids...)
+}
+
+// ClearMentions clears all "mentions" edges to the MessageMention entity.
+func (uuo *UserUpdateOne) ClearMentions() *UserUpdateOne {
+	uuo.mutation.ClearMentions()
+	return uuo
+}
+
+// RemoveMentionIDs removes the "mentions" edge to MessageMention entities by IDs.
+func (uuo *UserUpdateOne) RemoveMentionIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveMentionIDs(ids...)
+	return uuo
+}
+
+// RemoveMentions removes "mentions" edges to MessageMention entities.
+func (uuo *UserUpdateOne) RemoveMentions(m ...*MessageMention) *UserUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.RemoveMentionIDs(ids...)
+}
+
+// ClearPinnedMessages clears all "pinned_messages" edges to the PinnedMessage entity.
+func (uuo *UserUpdateOne) ClearPinnedMessages() *UserUpdateOne {
+	uuo.mutation.ClearPinnedMessages()
+	return uuo
+}
+
+// RemovePinnedMessageIDs removes the "pinned_messages" edge to PinnedMessage entities by IDs.
+func (uuo *UserUpdateOne) RemovePinnedMessageIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemovePinnedMessageIDs(ids...)
+	return uuo
+}
+
+// RemovePinnedMessages removes "pinned_messages" edges to PinnedMessage entities.
+func (uuo *UserUpdateOne) RemovePinnedMessages(p ...*PinnedMessage) *UserUpdateOne {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.RemovePinnedMessageIDs(ids...)
+}
+
+// ClearMessageReceipts clears all "message_receipts" edges to the MessageReceipt entity.
+func (uuo *UserUpdateOne) ClearMessageReceipts() *UserUpdateOne {
+	uuo.mutation.ClearMessageReceipts()
+	return uuo
+}
+
+// RemoveMessageReceiptIDs removes the "message_receipts" edge to MessageReceipt entities by IDs.
+func (uuo *UserUpdateOne) RemoveMessageReceiptIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveMessageReceiptIDs(ids...)
+	return uuo
+}
+
+// RemoveMessageReceipts removes "message_receipts" edges to MessageReceipt entities.
+func (uuo *UserUpdateOne) RemoveMessageReceipts(m ...*MessageReceipt) *UserUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.RemoveMessageReceiptIDs(ids...)
+}
+
+// ClearScheduledMessages clears all "scheduled_messages" edges to the ScheduledMessage entity.
+func (uuo *UserUpdateOne) ClearScheduledMessages() *UserUpdateOne {
+	uuo.mutation.ClearScheduledMessages()
+	return uuo
+}
+
+// RemoveScheduledMessageIDs removes the "scheduled_messages" edge to ScheduledMessage entities by IDs.
+func (uuo *UserUpdateOne) RemoveScheduledMessageIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveScheduledMessageIDs(ids...)
+	return uuo
+}
+
+// RemoveScheduledMessages removes "scheduled_messages" edges to ScheduledMessage entities.
+func (uuo *UserUpdateOne) RemoveScheduledMessages(s ...*ScheduledMessage) *UserUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.RemoveScheduledMessageIDs(ids...)
+}
+
+// ClearDrafts clears all "drafts" edges to the Draft entity.
+func (uuo *UserUpdateOne) ClearDrafts() *UserUpdateOne {
+	uuo.mutation.ClearDrafts()
+	return uuo
+}
+
+// RemoveDraftIDs removes the "drafts" edge to Draft entities by IDs.
+func (uuo *UserUpdateOne) RemoveDraftIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveDraftIDs(ids...)
+	return uuo
+}
+
+// RemoveDrafts removes "drafts" edges to Draft entities.
+func (uuo *UserUpdateOne) RemoveDrafts(d ...*Draft) *UserUpdateOne {
+	ids := make([]string, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uuo.RemoveDraftIDs(ids...)
+}
+
+// ClearBookmarks clears all "bookmarks" edges to the Bookmark entity.
+func (uuo *UserUpdateOne) ClearBookmarks() *UserUpdateOne {
+	uuo.mutation.ClearBookmarks()
+	return uuo
+}
+
+// RemoveBookmarkIDs removes the "bookmarks" edge to Bookmark entities by IDs.
+func (uuo *UserUpdateOne) RemoveBookmarkIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveBookmarkIDs(ids...)
+	return uuo
+}
+
+// RemoveBookmarks removes "bookmarks" edges to Bookmark entities.
+func (uuo *UserUpdateOne) RemoveBookmarks(b ...*Bookmark) *UserUpdateOne {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uuo.RemoveBookmarkIDs(ids...)
+}
+
+// ClearHiddenMessages clears all "hidden_messages" edges to the HiddenMessage entity.
+func (uuo *UserUpdateOne) ClearHiddenMessages() *UserUpdateOne {
+	uuo.mutation.ClearHiddenMessages()
+	return uuo
+}
+
+// RemoveHiddenMessageIDs removes the "hidden_messages" edge to HiddenMessage entities by IDs.
+func (uuo *UserUpdateOne) RemoveHiddenMessageIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveHiddenMessageIDs(ids...)
+	return uuo
+}
+
+// RemoveHiddenMessages removes "hidden_messages" edges to HiddenMessage entities.
+func (uuo *UserUpdateOne) RemoveHiddenMessages(h ...*HiddenMessage) *UserUpdateOne {
+	ids := make([]string, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return uuo.RemoveHiddenMessageIDs(ids...)
+}
+
+// ClearConversationFolders clears all "conversation_folders" edges to the ConversationFolder entity.
+func (uuo *UserUpdateOne) ClearConversationFolders() *UserUpdateOne {
+	uuo.mutation.ClearConversationFolders()
+	return uuo
+}
+
+// RemoveConversationFolderIDs removes the "conversation_folders" edge to ConversationFolder entities by IDs.
+func (uuo *UserUpdateOne) RemoveConversationFolderIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveConversationFolderIDs(ids...)
+	return uuo
+}
+
+// RemoveConversationFolders removes "conversation_folders" edges to ConversationFolder entities.
+func (uuo *UserUpdateOne) RemoveConversationFolders(c ...*ConversationFolder) *UserUpdateOne {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.RemoveConversationFolderIDs(ids...)
+}
+
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (uuo *UserUpdateOne) ClearNotifications() *UserUpdateOne {
+	uuo.mutation.ClearNotifications()
+	return uuo
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (uuo *UserUpdateOne) RemoveNotificationIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveNotificationIDs(ids...)
+	return uuo
+}
+
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (uuo *UserUpdateOne) RemoveNotifications(n ...*Notification) *UserUpdateOne {
+	ids := make([]string, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uuo.RemoveNotificationIDs(ids...)
+}
+
+// ClearRelatedNotifications clears all "related_notifications" edges to the Notification entity.
+func (uuo *UserUpdateOne) ClearRelatedNotifications() *UserUpdateOne {
+	uuo.mutation.ClearRelatedNotifications()
+	return uuo
+}
+
+// RemoveRelatedNotificationIDs removes the "related_notifications" edge to Notification entities by IDs.
+func (uuo *UserUpdateOne) RemoveRelatedNotificationIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveRelatedNotificationIDs(ids...)
+	return uuo
+}
+
+// RemoveRelatedNotifications removes "related_notifications" edges to Notification entities.
+func (uuo *UserUpdateOne) RemoveRelatedNotifications(n ...*Notification) *UserUpdateOne {
+	ids := make([]string, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uuo.RemoveRelatedNotificationIDs(ids...)
+}
+
+// ClearConversationParticipations clears all "conversation_participations" edges to the ConversationParticipant entity.
+func (uuo *UserUpdateOne) ClearConversationParticipations() *UserUpdateOne {
+	uuo.mutation.ClearConversationParticipations()
+	return uuo
+}
+
+// RemoveConversationParticipationIDs removes the "conversation_participations" edge to ConversationParticipant entities by IDs.
+func (uuo *UserUpdateOne) RemoveConversationParticipationIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveConversationParticipationIDs(ids...)
+	return uuo
+}
+
+// RemoveConversationParticipations removes "conversation_participations" edges to ConversationParticipant entities.
+func (uuo *UserUpdateOne) RemoveConversationParticipations(c ...*ConversationParticipant) *UserUpdateOne {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.RemoveConversationParticipationIDs(ids...)
+}
+
+// ClearThreadParticipations clears all "thread_participations" edges to the ThreadParticipant entity.
+func (uuo *UserUpdateOne) ClearThreadParticipations() *UserUpdateOne {
+	uuo.mutation.ClearThreadParticipations()
+	return uuo
+}
+
+// RemoveThreadParticipationIDs removes the "thread_participations" edge to ThreadParticipant entities by IDs.
+func (uuo *UserUpdateOne) RemoveThreadParticipationIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveThreadParticipationIDs(ids...)
+	return uuo
+}
+
+// RemoveThreadParticipations removes "thread_participations" edges to ThreadParticipant entities.
+func (uuo *UserUpdateOne) RemoveThreadParticipations(t ...*ThreadParticipant) *UserUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uuo.RemoveThreadParticipationIDs(ids...)
+}
+
+// ClearBlockedUsers clears all "blocked_users" edges to the Block entity.
+func (uuo *UserUpdateOne) ClearBlockedUsers() *UserUpdateOne {
+	uuo.mutation.ClearBlockedUsers()
+	return uuo
+}
+
+// RemoveBlockedUserIDs removes the "blocked_users" edge to Block entities by IDs.
+func (uuo *UserUpdateOne) RemoveBlockedUserIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveBlockedUserIDs(ids...)
+	return uuo
+}
+
+// RemoveBlockedUsers removes "blocked_users" edges to Block entities.
+func (uuo *UserUpdateOne) RemoveBlockedUsers(b ...*Block) *UserUpdateOne {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uuo.RemoveBlockedUserIDs(ids...)
+}
+
+// ClearBlockedByUsers clears all "blocked_by_users" edges to the Block entity.
+func (uuo *UserUpdateOne) ClearBlockedByUsers() *UserUpdateOne {
+	uuo.mutation.ClearBlockedByUsers()
+	return uuo
+}
+
+// RemoveBlockedByUserIDs removes the "blocked_by_users" edge to Block entities by IDs.
+func (uuo *UserUpdateOne) RemoveBlockedByUserIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveBlockedByUserIDs(ids...)
+	return uuo
+}
+
+// RemoveBlockedByUsers removes "blocked_by_users" edges to Block entities.
+func (uuo *UserUpdateOne) RemoveBlockedByUsers(b ...*Block) *UserUpdateOne {
+	ids := make([]string, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uuo.RemoveBlockedByUserIDs(ids...)
+}
+
+// ClearCallsMade clears all "calls_made" edges to the Call entity.
+func (uuo *UserUpdateOne) ClearCallsMade() *UserUpdateOne {
+	uuo.mutation.ClearCallsMade()
+	return uuo
+}
+
+// RemoveCallsMadeIDs removes the "calls_made" edge to Call entities by IDs.
+func (uuo *UserUpdateOne) RemoveCallsMadeIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveCallsMadeIDs(ids...)
+	return uuo
+}
+
+// RemoveCallsMade removes "calls_made" edges to Call entities.
+func (uuo *UserUpdateOne) RemoveCallsMade(c ...*Call) *UserUpdateOne {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.RemoveCallsMadeIDs(ids...)
+}
+
+// ClearCallsReceived clears all "calls_received" edges to the Call entity.
+func (uuo *UserUpdateOne) ClearCallsReceived() *UserUpdateOne {
+	uuo.mutation.ClearCallsReceived()
+	return uuo
+}
+
+// RemoveCallsReceivedIDs removes the "calls_received" edge to Call entities by IDs.
+func (uuo *UserUpdateOne) RemoveCallsReceivedIDs(ids ...string) *UserUpdateOne {
+	uuo.mutation.RemoveCallsReceivedIDs(ids...)
+	return uuo
+}
+
+// RemoveCallsReceived removes "calls_received" edges to Call entities.
+func (uuo *UserUpdateOne) RemoveCallsReceived(c ...*Call) *UserUpdateOne {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.RemoveCallsReceivedIDs(ids...)
+}
+
+// Where appends a list predicates to the UserUpdate builder.
+func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
+	uuo.mutation.Where(ps...)
+	return uuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (uuo *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
+	uuo.fields = append([]string{field}, fields...)
+	return uuo
+}
+
+// Save executes the query and returns the updated User entity.
+func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
+	uuo.defaults()
+	return withHooks(ctx, uuo.sqlSave, uuo.mutation, uuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
+	node, err := uuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (uuo *UserUpdateOne) Exec(ctx context.Context) error {
+	_, err := uuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
+	if err := uuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uuo *UserUpdateOne) defaults() {
+	if _, ok := uuo.mutation.UpdatedAt(); !ok {
+		v := user.UpdateDefaultUpdatedAt()
+		uuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uuo *UserUpdateOne) check() error {
+	if v, ok := uuo.mutation.Name(); ok {
+		if err := user.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "User.name": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.Email(); ok {
+		if err := user.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.Username(); ok {
+		if err := user.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "User.username": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.Bio(); ok {
+		if err := user.BioValidator(v); err != nil {
+			return &ValidationError{Name: "bio", err: fmt.Errorf(`ent: validator failed for field "User.bio": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
+	if err := uuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeString))
+	id, ok := uuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
+		for _, f := range fields {
+			if !user.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != user.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uuo.mutation.CreatedAt(); ok {
+		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := uuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := uuo.mutation.Name(); ok {
+		_spec.SetField(user.FieldName, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.Password(); ok {
+		_spec.SetField(user.FieldPassword, field.TypeString, value)
+	}
+	if uuo.mutation.PasswordCleared() {
+		_spec.ClearField(user.FieldPassword, field.TypeString)
+	}
+	if value, ok := uuo.mutation.Username(); ok {
+		_spec.SetField(user.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.Bio(); ok {
+		_spec.SetField(user.FieldBio, field.TypeString, value)
+	}
+	if uuo.mutation.BioCleared() {
+		_spec.ClearField(user.FieldBio, field.TypeString)
+	}
+	if value, ok := uuo.mutation.AvaterURL(); ok {
+		_spec.SetField(user.FieldAvaterURL, field.TypeString, value)
+	}
+	if uuo.mutation.AvaterURLCleared() {
+		_spec.ClearField(user.FieldAvaterURL, field.TypeString)
+	}
+	if value, ok := uuo.mutation.CoverURL(); ok {
+		_spec.SetField(user.FieldCoverURL, field.TypeString, value)
+	}
+	if uuo.mutation.CoverURLCleared() {
+		_spec.ClearField(user.FieldCoverURL, field.TypeString)
+	}
+	if value, ok := uuo.mutation.ReadReceiptsEnabled(); ok {
+		_spec.SetField(user.FieldReadReceiptsEnabled, field.TypeBool, value)
+	}
+	if uuo.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !uuo.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.OwnedGuildsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OwnedGuildsTable,
+			Columns: []string{user.OwnedGuildsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedOwnedGuildsIDs(); len(nodes) > 0 && !uuo.mutation.OwnedGuildsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OwnedGuildsTable,
+			Columns: []string{user.OwnedGuildsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.OwnedGuildsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OwnedGuildsTable,
+			Columns: []string{user.OwnedGuildsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.InvitationsTable,
+			Columns: []string{user.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !uuo.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.InvitationsTable,
+			Columns: []string{user.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.InvitationsTable,
+			Columns: []string{user.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.MemberOfCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MemberOfTable,
+			Columns: []string{user.MemberOfColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedMemberOfIDs(); len(nodes) > 0 && !uuo.mutation.MemberOfCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MemberOfTable,
+			Columns: []string{user.MemberOfColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.MemberOfIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MemberOfTable,
+			Columns: []string{user.MemberOfColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.FriendRequestsSentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.FriendRequestsSentTable,
+			Columns: []string{user.FriendRequestsSentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friend.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedFriendRequestsSentIDs(); len(nodes) > 0 && !uuo.mutation.FriendRequestsSentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.FriendRequestsSentTable,
+			Columns: []string{user.FriendRequestsSentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friend.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.FriendRequestsSentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.FriendRequestsSentTable,
+			Columns: []string{user.FriendRequestsSentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friend.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.FriendRequestsReceivedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.FriendRequestsReceivedTable,
+			Columns: []string{user.FriendRequestsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friend.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedFriendRequestsReceivedIDs(); len(nodes) > 0 && !uuo.mutation.FriendRequestsReceivedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.FriendRequestsReceivedTable,
+			Columns: []string{user.FriendRequestsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friend.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.FriendRequestsReceivedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.FriendRequestsReceivedTable,
+			Columns: []string{user.FriendRequestsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friend.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.RemoveCallsReceivedIDs(ids...)
-}
-
-// Where appends a list predicates to the UserUpdate builder.
-func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
-	uuo.mutation.Where(ps...)
-	return uuo
-}
-
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (uuo *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
-	uuo.fields = append([]string{field}, fields...)
-	return uuo
-}
-
-// Save executes the query and returns the updated User entity.
-func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
-	uuo.defaults()
-	return withHooks(ctx, uuo.sqlSave, uuo.mutation, uuo.hooks)
-}
-
-// SaveX is like Save, but panics if an error occurs.
-func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
-	node, err := uuo.Save(ctx)
-	if err != nil {
-		panic(err)
+	if uuo.mutation.FriendInvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.FriendInvitesTable,
+			Columns: []string{user.FriendInvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendinvite.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return node
-}
-
-// Exec executes the query on the entity.
-func (uuo *UserUpdateOne) Exec(ctx context.Context) error {
-	_, err := uuo.Save(ctx)
-	return err
-}
-
-// ExecX is like Exec, but panics if an error occurs.
-func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
-	if err := uuo.Exec(ctx); err != nil {
-		panic(err)
+	if nodes := uuo.mutation.RemovedFriendInvitesIDs(); len(nodes) > 0 && !uuo.mutation.FriendInvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.FriendInvitesTable,
+			Columns: []string{user.FriendInvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendinvite.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-// defaults sets the default values of the builder before save.
-func (uuo *UserUpdateOne) defaults() {
-	if _, ok := uuo.mutation.UpdatedAt(); !ok {
-		v := user.UpdateDefaultUpdatedAt()
-		uuo.mutation.SetUpdatedAt(v)
+	if nodes := uuo.mutation.FriendInvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.FriendInvitesTable,
+			Columns: []string{user.FriendInvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendinvite.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-}
-
-// check runs all checks and user-defined validators on the builder.
-func (uuo *UserUpdateOne) check() error {
-	if v, ok := uuo.mutation.Name(); ok {
-		if err := user.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "User.name": %w`, err)}
+	if uuo.mutation.FriendInviteUsesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.FriendInviteUsesTable,
+			Columns: []string{user.FriendInviteUsesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendinviteuse.FieldID, field.TypeString),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := uuo.mutation.Email(); ok {
-		if err := user.EmailValidator(v); err != nil {
-			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
+	if nodes := uuo.mutation.RemovedFriendInviteUsesIDs(); len(nodes) > 0 && !uuo.mutation.FriendInviteUsesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.FriendInviteUsesTable,
+			Columns: []string{user.FriendInviteUsesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendinviteuse.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := uuo.mutation.Username(); ok {
-		if err := user.UsernameValidator(v); err != nil {
-			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "User.username": %w`, err)}
+	if nodes := uuo.mutation.FriendInviteUsesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.FriendInviteUsesTable,
+			Columns: []string{user.FriendInviteUsesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(friendinviteuse.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if v, ok := uuo.mutation.Bio(); ok {
-		if err := user.BioValidator(v); err != nil {
-			return &ValidationError{Name: "bio", err: fmt.Errorf(`ent: validator failed for field "User.bio": %w`, err)}
+	if uuo.mutation.SentMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.SentMessagesTable,
+			Columns: []string{user.SentMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return nil
-}
-
-func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
-	if err := uuo.check(); err != nil {
-		return _node, err
+	if nodes := uuo.mutation.RemovedSentMessagesIDs(); len(nodes) > 0 && !uuo.mutation.SentMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.SentMessagesTable,
+			Columns: []string{user.SentMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeString))
-	id, ok := uuo.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
+	if nodes := uuo.mutation.SentMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.SentMessagesTable,
+			Columns: []string{user.SentMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec.Node.ID.Value = id
-	if fields := uuo.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
-		for _, f := range fields {
-			if !user.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != user.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	if uuo.mutation.ForwardedMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ForwardedMessagesTable,
+			Columns: []string{user.ForwardedMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if ps := uuo.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if nodes := uuo.mutation.RemovedForwardedMessagesIDs(); len(nodes) > 0 && !uuo.mutation.ForwardedMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ForwardedMessagesTable,
+			Columns: []string{user.ForwardedMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.CreatedAt(); ok {
-		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
+	if nodes := uuo.mutation.ForwardedMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ForwardedMessagesTable,
+			Columns: []string{user.ForwardedMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := uuo.mutation.UpdatedAt(); ok {
-		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
+	if uuo.mutation.MessageRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MessageRevisionsTable,
+			Columns: []string{user.MessageRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.Name(); ok {
-		_spec.SetField(user.FieldName, field.TypeString, value)
+	if nodes := uuo.mutation.RemovedMessageRevisionsIDs(); len(nodes) > 0 && !uuo.mutation.MessageRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MessageRevisionsTable,
+			Columns: []string{user.MessageRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.Email(); ok {
-		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	if nodes := uuo.mutation.MessageRevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MessageRevisionsTable,
+			Columns: []string{user.MessageRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := uuo.mutation.Password(); ok {
-		_spec.SetField(user.FieldPassword, field.TypeString, value)
+	if uuo.mutation.MessageReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MessageReactionsTable,
+			Columns: []string{user.MessageReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if uuo.mutation.PasswordCleared() {
-		_spec.ClearField(user.FieldPassword, field.TypeString)
+	if nodes := uuo.mutation.RemovedMessageReactionsIDs(); len(nodes) > 0 && !uuo.mutation.MessageReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MessageReactionsTable,
+			Columns: []string{user.MessageReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.Username(); ok {
-		_spec.SetField(user.FieldUsername, field.TypeString, value)
+	if nodes := uuo.mutation.MessageReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MessageReactionsTable,
+			Columns: []string{user.MessageReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := uuo.mutation.Bio(); ok {
-		_spec.SetField(user.FieldBio, field.TypeString, value)
+	if uuo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.AttachmentsTable,
+			Columns: []string{user.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if uuo.mutation.BioCleared() {
-		_spec.ClearField(user.FieldBio, field.TypeString)
+	if nodes := uuo.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !uuo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.AttachmentsTable,
+			Columns: []string{user.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.AvaterURL(); ok {
-		_spec.SetField(user.FieldAvaterURL, field.TypeString, value)
+	if nodes := uuo.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.AttachmentsTable,
+			Columns: []string{user.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.AvaterURLCleared() {
-		_spec.ClearField(user.FieldAvaterURL, field.TypeString)
+	if uuo.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MentionsTable,
+			Columns: []string{user.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.CoverURL(); ok {
-		_spec.SetField(user.FieldCoverURL, field.TypeString, value)
+	if nodes := uuo.mutation.RemovedMentionsIDs(); len(nodes) > 0 && !uuo.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MentionsTable,
+			Columns: []string{user.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if uuo.mutation.CoverURLCleared() {
-		_spec.ClearField(user.FieldCoverURL, field.TypeString)
+	if nodes := uuo.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MentionsTable,
+			Columns: []string{user.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.SessionsCleared() {
+	if uuo.mutation.PinnedMessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   user.SessionsTable,
-			Columns: []string{user.SessionsColumn},
+			Inverse: true,
+			Table:   user.PinnedMessagesTable,
+			Columns: []string{user.PinnedMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !uuo.mutation.SessionsCleared() {
+	if nodes := uuo.mutation.RemovedPinnedMessagesIDs(); len(nodes) > 0 && !uuo.mutation.PinnedMessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   user.SessionsTable,
-			Columns: []string{user.SessionsColumn},
+			Inverse: true,
+			Table:   user.PinnedMessagesTable,
+			Columns: []string{user.PinnedMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2273,15 +4706,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.SessionsIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.PinnedMessagesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   user.SessionsTable,
-			Columns: []string{user.SessionsColumn},
+			Inverse: true,
+			Table:   user.PinnedMessagesTable,
+			Columns: []string{user.PinnedMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2289,28 +4722,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.OwnedGuildsCleared() {
+	if uuo.mutation.MessageReceiptsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   user.OwnedGuildsTable,
-			Columns: []string{user.OwnedGuildsColumn},
+			Inverse: true,
+			Table:   user.MessageReceiptsTable,
+			Columns: []string{user.MessageReceiptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(messagereceipt.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedOwnedGuildsIDs(); len(nodes) > 0 && !uuo.mutation.OwnedGuildsCleared() {
+	if nodes := uuo.mutation.RemovedMessageReceiptsIDs(); len(nodes) > 0 && !uuo.mutation.MessageReceiptsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   user.OwnedGuildsTable,
-			Columns: []string{user.OwnedGuildsColumn},
+			Inverse: true,
+			Table:   user.MessageReceiptsTable,
+			Columns: []string{user.MessageReceiptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(messagereceipt.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2318,15 +4751,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.OwnedGuildsIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.MessageReceiptsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   user.OwnedGuildsTable,
-			Columns: []string{user.OwnedGuildsColumn},
+			Inverse: true,
+			Table:   user.MessageReceiptsTable,
+			Columns: []string{user.MessageReceiptsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(guild.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(messagereceipt.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2334,28 +4767,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.InvitationsCleared() {
+	if uuo.mutation.ScheduledMessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.InvitationsTable,
-			Columns: []string{user.InvitationsColumn},
+			Table:   user.ScheduledMessagesTable,
+			Columns: []string{user.ScheduledMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !uuo.mutation.InvitationsCleared() {
+	if nodes := uuo.mutation.RemovedScheduledMessagesIDs(); len(nodes) > 0 && !uuo.mutation.ScheduledMessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.InvitationsTable,
-			Columns: []string{user.InvitationsColumn},
+			Table:   user.ScheduledMessagesTable,
+			Columns: []string{user.ScheduledMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2363,15 +4796,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.InvitationsIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.ScheduledMessagesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.InvitationsTable,
-			Columns: []string{user.InvitationsColumn},
+			Table:   user.ScheduledMessagesTable,
+			Columns: []string{user.ScheduledMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2379,28 +4812,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.MemberOfCleared() {
+	if uuo.mutation.DraftsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   user.MemberOfTable,
-			Columns: []string{user.MemberOfColumn},
+			Inverse: true,
+			Table:   user.DraftsTable,
+			Columns: []string{user.DraftsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(draft.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedMemberOfIDs(); len(nodes) > 0 && !uuo.mutation.MemberOfCleared() {
+	if nodes := uuo.mutation.RemovedDraftsIDs(); len(nodes) > 0 && !uuo.mutation.DraftsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   user.MemberOfTable,
-			Columns: []string{user.MemberOfColumn},
+			Inverse: true,
+			Table:   user.DraftsTable,
+			Columns: []string{user.DraftsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(draft.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2408,15 +4841,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.MemberOfIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.DraftsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   user.MemberOfTable,
-			Columns: []string{user.MemberOfColumn},
+			Inverse: true,
+			Table:   user.DraftsTable,
+			Columns: []string{user.DraftsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(draft.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2424,28 +4857,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.FriendRequestsSentCleared() {
+	if uuo.mutation.BookmarksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.FriendRequestsSentTable,
-			Columns: []string{user.FriendRequestsSentColumn},
+			Table:   user.BookmarksTable,
+			Columns: []string{user.BookmarksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(friend.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedFriendRequestsSentIDs(); len(nodes) > 0 && !uuo.mutation.FriendRequestsSentCleared() {
+	if nodes := uuo.mutation.RemovedBookmarksIDs(); len(nodes) > 0 && !uuo.mutation.BookmarksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.FriendRequestsSentTable,
-			Columns: []string{user.FriendRequestsSentColumn},
+			Table:   user.BookmarksTable,
+			Columns: []string{user.BookmarksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(friend.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2453,15 +4886,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.FriendRequestsSentIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.BookmarksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.FriendRequestsSentTable,
-			Columns: []string{user.FriendRequestsSentColumn},
+			Table:   user.BookmarksTable,
+			Columns: []string{user.BookmarksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(friend.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2469,28 +4902,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.FriendRequestsReceivedCleared() {
+	if uuo.mutation.HiddenMessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.FriendRequestsReceivedTable,
-			Columns: []string{user.FriendRequestsReceivedColumn},
+			Table:   user.HiddenMessagesTable,
+			Columns: []string{user.HiddenMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(friend.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(hiddenmessage.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedFriendRequestsReceivedIDs(); len(nodes) > 0 && !uuo.mutation.FriendRequestsReceivedCleared() {
+	if nodes := uuo.mutation.RemovedHiddenMessagesIDs(); len(nodes) > 0 && !uuo.mutation.HiddenMessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.FriendRequestsReceivedTable,
-			Columns: []string{user.FriendRequestsReceivedColumn},
+			Table:   user.HiddenMessagesTable,
+			Columns: []string{user.HiddenMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(friend.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(hiddenmessage.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2498,15 +4931,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.FriendRequestsReceivedIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.HiddenMessagesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.FriendRequestsReceivedTable,
-			Columns: []string{user.FriendRequestsReceivedColumn},
+			Table:   user.HiddenMessagesTable,
+			Columns: []string{user.HiddenMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(friend.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(hiddenmessage.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2514,28 +4947,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.SentMessagesCleared() {
+	if uuo.mutation.ConversationFoldersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.SentMessagesTable,
-			Columns: []string{user.SentMessagesColumn},
+			Table:   user.ConversationFoldersTable,
+			Columns: []string{user.ConversationFoldersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(conversationfolder.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedSentMessagesIDs(); len(nodes) > 0 && !uuo.mutation.SentMessagesCleared() {
+	if nodes := uuo.mutation.RemovedConversationFoldersIDs(); len(nodes) > 0 && !uuo.mutation.ConversationFoldersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.SentMessagesTable,
-			Columns: []string{user.SentMessagesColumn},
+			Table:   user.ConversationFoldersTable,
+			Columns: []string{user.ConversationFoldersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(conversationfolder.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2543,15 +4976,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.SentMessagesIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.ConversationFoldersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.SentMessagesTable,
-			Columns: []string{user.SentMessagesColumn},
+			Table:   user.ConversationFoldersTable,
+			Columns: []string{user.ConversationFoldersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(conversationfolder.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2694,6 +5127,51 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.ThreadParticipationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ThreadParticipationsTable,
+			Columns: []string{user.ThreadParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(threadparticipant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedThreadParticipationsIDs(); len(nodes) > 0 && !uuo.mutation.ThreadParticipationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ThreadParticipationsTable,
+			Columns: []string{user.ThreadParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(threadparticipant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.ThreadParticipationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ThreadParticipationsTable,
+			Columns: []string{user.ThreadParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(threadparticipant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if uuo.mutation.BlockedUsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -0,0 +1,180 @@
+package imaging
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/gif" // register GIF decoder
+	"image/jpeg"
+	"image/png"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // register WebP decoder
+)
+
+const (
+	// maxPixels guards against decompression bombs
+	maxPixels = 50_000_000
+	// blurHashInputSize is the edge length images are reduced to before computing the blurhash
+	blurHashInputSize = 32
+	// jpegQuality is used for re-encoded JPEG output
+	jpegQuality = 85
+)
+
+// supportedTypes are the content types that can be decoded and processed
+var supportedTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+// Result holds the outputs of processing an uploaded image
+type Result struct {
+	// Width and Height are the display dimensions after applying the EXIF orientation
+	Width  int
+	Height int
+	// BlurHash is a compact placeholder clients can render before the download finishes
+	BlurHash string
+	// Sanitized is the original image with EXIF, GPS and text metadata removed
+	Sanitized []byte
+	// Thumbnail is a downscaled copy fitting within the requested size
+	Thumbnail            []byte
+	ThumbnailContentType string
+}
+
+// IsSupported reports whether images of the content type can be processed
+func IsSupported(contentType string) bool {
+	return supportedTypes[contentType]
+}
+
+// Process strips metadata from an image, measures it and produces a thumbnail and blurhash
+func Process(data []byte, contentType string, thumbnailSize int) (*Result, error) {
+	img, orientation, err := decode(data, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	sanitized, err := stripMetadata(data, contentType, img, orientation)
+	if err != nil {
+		return nil, err
+	}
+
+	thumbnail, thumbnailType, err := encode(fit(img, thumbnailSize), contentType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode thumbnail: %w", err)
+	}
+
+	hash, err := BlurHash(fit(img, blurHashInputSize), 4, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := img.Bounds()
+	return &Result{
+		Width:                bounds.Dx(),
+		Height:               bounds.Dy(),
+		BlurHash:             hash,
+		Sanitized:            sanitized,
+		Thumbnail:            thumbnail,
+		ThumbnailContentType: thumbnailType,
+	}, nil
+}
+
+// Square center-crops an image to a square of at most size pixels, as used for avatars and icons.
+// Re-encoding drops all metadata.
+func Square(data []byte, contentType string, size int) ([]byte, string, error) {
+	img, _, err := decode(data, contentType)
+	if err != nil {
+		return nil, "", err
+	}
+
+	bounds := img.Bounds()
+	side := min(bounds.Dx(), bounds.Dy())
+	x0 := bounds.Min.X + (bounds.Dx()-side)/2
+	y0 := bounds.Min.Y + (bounds.Dy()-side)/2
+	crop := image.Rect(x0, y0, x0+side, y0+side)
+
+	target := min(side, size)
+	dst := image.NewRGBA(image.Rect(0, 0, target, target))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, crop, draw.Src, nil)
+
+	out, outType, err := encode(dst, contentType)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to encode image: %w", err)
+	}
+	return out, outType, nil
+}
+
+// decode decodes a supported image after checking its dimensions and applies the EXIF orientation
+func decode(data []byte, contentType string) (image.Image, int, error) {
+	if !IsSupported(contentType) {
+		return nil, 0, fmt.Errorf("unsupported image type")
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid image: %w", err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxPixels {
+		return nil, 0, fmt.Errorf("image dimensions are too large")
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid image: %w", err)
+	}
+
+	orientation := 1
+	if contentType == "image/jpeg" {
+		orientation = jpegOrientation(data)
+	}
+
+	return applyOrientation(img, orientation), orientation, nil
+}
+
+// fit scales an image down to fit within a size x size box, smaller images are returned unchanged
+func fit(img image.Image, size int) image.Image {
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	if w <= size && h <= size {
+		return img
+	}
+
+	if w >= h {
+		h = max(1, h*size/w)
+		w = size
+	} else {
+		w = max(1, w*size/h)
+		h = size
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Src, nil)
+	return dst
+}
+
+// encode writes images that may be transparent as PNG and everything else as JPEG
+func encode(img image.Image, sourceType string) ([]byte, string, error) {
+	var buf bytes.Buffer
+
+	if sourceType != "image/jpeg" && !isOpaque(img) {
+		if err := png.Encode(&buf, img); err != nil {
+			return nil, "", err
+		}
+		return buf.Bytes(), "image/png", nil
+	}
+
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, "", err
+	}
+	return buf.Bytes(), "image/jpeg", nil
+}
+
+// isOpaque reports whether an image has no transparent pixels
+func isOpaque(img image.Image) bool {
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		return o.Opaque()
+	}
+	return false
+}
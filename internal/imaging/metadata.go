@@ -0,0 +1,150 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/jpeg"
+)
+
+// stripMetadata removes EXIF, GPS and text metadata without re-encoding where the format allows it.
+// Rotated JPEGs are re-encoded upright since dropping the orientation tag would otherwise turn them.
+func stripMetadata(data []byte, contentType string, img image.Image, orientation int) ([]byte, error) {
+	switch contentType {
+	case "image/jpeg":
+		if orientation > 1 {
+			var buf bytes.Buffer
+			if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 90}); err != nil {
+				return nil, fmt.Errorf("failed to encode image: %w", err)
+			}
+			return buf.Bytes(), nil
+		}
+		return stripJPEG(data)
+	case "image/png":
+		return stripPNG(data)
+	case "image/webp":
+		return stripWebP(data)
+	default:
+		// GIF has no EXIF support
+		return data, nil
+	}
+}
+
+// stripJPEG drops APP1 (EXIF/XMP), APP13 (IPTC) and comment segments, keeping ICC profiles
+func stripJPEG(data []byte) ([]byte, error) {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil, fmt.Errorf("invalid jpeg")
+	}
+
+	out := make([]byte, 0, len(data))
+	out = append(out, 0xFF, 0xD8)
+	for i := 2; i < len(data); {
+		if i+2 > len(data) || data[i] != 0xFF {
+			return nil, fmt.Errorf("invalid jpeg")
+		}
+		marker := data[i+1]
+		if marker == 0xFF {
+			i++
+			continue
+		}
+		if marker == 0x01 || (marker >= 0xD0 && marker <= 0xD7) {
+			out = append(out, data[i:i+2]...)
+			i += 2
+			continue
+		}
+		// Everything from the start of scan on is image data
+		if marker == 0xDA || marker == 0xD9 {
+			return append(out, data[i:]...), nil
+		}
+
+		if i+4 > len(data) {
+			return nil, fmt.Errorf("invalid jpeg")
+		}
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		end := i + 2 + length
+		if length < 2 || end > len(data) {
+			return nil, fmt.Errorf("invalid jpeg")
+		}
+		if marker != 0xE1 && marker != 0xED && marker != 0xFE {
+			out = append(out, data[i:end]...)
+		}
+		i = end
+	}
+
+	return out, nil
+}
+
+// pngMetadataChunks are the ancillary PNG chunks that can carry EXIF or free text
+var pngMetadataChunks = map[string]bool{
+	"eXIf": true,
+	"tEXt": true,
+	"zTXt": true,
+	"iTXt": true,
+	"tIME": true,
+}
+
+// stripPNG drops metadata chunks from a PNG
+func stripPNG(data []byte) ([]byte, error) {
+	const signature = "\x89PNG\r\n\x1a\n"
+	if len(data) < len(signature) || string(data[:len(signature)]) != signature {
+		return nil, fmt.Errorf("invalid png")
+	}
+
+	out := make([]byte, 0, len(data))
+	out = append(out, data[:len(signature)]...)
+	for i := len(signature); i < len(data); {
+		if i+8 > len(data) {
+			return nil, fmt.Errorf("invalid png")
+		}
+		length := int(binary.BigEndian.Uint32(data[i:]))
+		end := i + 12 + length
+		if length < 0 || end > len(data) {
+			return nil, fmt.Errorf("invalid png")
+		}
+		if !pngMetadataChunks[string(data[i+4:i+8])] {
+			out = append(out, data[i:end]...)
+		}
+		i = end
+	}
+
+	return out, nil
+}
+
+// stripWebP drops EXIF and XMP chunks from a WebP container and clears their feature flags
+func stripWebP(data []byte) ([]byte, error) {
+	if len(data) < 12 || string(data[:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return nil, fmt.Errorf("invalid webp")
+	}
+
+	out := make([]byte, 12, len(data))
+	copy(out, data[:12])
+	for i := 12; i < len(data); {
+		if i+8 > len(data) {
+			return nil, fmt.Errorf("invalid webp")
+		}
+		fourCC := string(data[i : i+4])
+		size := int(binary.LittleEndian.Uint32(data[i+4:]))
+		end := i + 8 + size + size%2
+		if size < 0 || end > len(data) {
+			return nil, fmt.Errorf("invalid webp")
+		}
+
+		switch fourCC {
+		case "EXIF", "XMP ":
+			// dropped
+		case "VP8X":
+			chunk := append([]byte(nil), data[i:end]...)
+			if size > 0 {
+				chunk[8] &^= 0x08 | 0x04 // EXIF and XMP present flags
+			}
+			out = append(out, chunk...)
+		default:
+			out = append(out, data[i:end]...)
+		}
+		i = end
+	}
+
+	binary.LittleEndian.PutUint32(out[4:], uint32(len(out)-8))
+	return out, nil
+}
@@ -200,9 +200,10 @@ func (vm *ValidationMiddleware) ValidateBlockStatus() echo.MiddlewareFunc {
 func (vm *ValidationMiddleware) RateLimitMessages() echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			// Only apply to POST message endpoints
+			// Only apply to POST message endpoints, bulk deletes do not send anything
 			if c.Request().Method != "POST" ||
-				!strings.Contains(c.Request().URL.Path, "/messages") {
+				!strings.Contains(c.Request().URL.Path, "/messages") ||
+				strings.HasSuffix(c.Request().URL.Path, "/messages/delete") {
 				return next(c)
 			}
 
@@ -219,13 +220,26 @@ func (vm *ValidationMiddleware) RateLimitMessages() echo.MiddlewareFunc {
 	}
 }
 
+// AllowMessage counts a message send against the limit of RateLimitMessages, for sends that do not
+// come in over HTTP
+func (vm *ValidationMiddleware) AllowMessage(userID string) bool {
+	return vm.messageRateLimit.Allow(userID)
+}
+
+// AllowRequest counts a request against the limit of RateLimitGeneral, for requests that do not
+// come in over HTTP
+func (vm *ValidationMiddleware) AllowRequest(userID string) bool {
+	return vm.generalRateLimit.Allow(userID)
+}
+
 // RateLimitFriendRequests applies rate limiting to friend requests
 func (vm *ValidationMiddleware) RateLimitFriendRequests() echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			// Only apply to POST friend request endpoints
+			// Only apply to POST friend request and invite redemption endpoints
 			if c.Request().Method != "POST" ||
-				!strings.HasSuffix(c.Request().URL.Path, "/request") {
+				(!strings.HasSuffix(c.Request().URL.Path, "/request") &&
+					!strings.HasSuffix(c.Request().URL.Path, "/redeem")) {
 				return next(c)
 			}
 
@@ -0,0 +1,398 @@
+package richtext
+
+import (
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// escapable are the characters a backslash turns into plain text
+const escapable = "\\`*_|[]()>"
+
+// languagePattern matches the language name allowed after an opening code fence
+var languagePattern = regexp.MustCompile(`^[A-Za-z0-9_+#.-]{0,32}$`)
+
+// Opener slots, each holds the token of the one unmatched opener of its kind
+const (
+	boldSlot = iota
+	spoilerSlot
+	starSlot
+	underscoreSlot
+	linkSlot
+	slotCount
+)
+
+// delimiter is an inline markup marker that wraps formatted text
+type delimiter struct {
+	marker string
+	typ    EntityType
+	slot   int
+}
+
+// delimiters are tried in order, so ** is seen before *
+var delimiters = []delimiter{
+	{marker: "**", typ: Bold, slot: boldSlot},
+	{marker: "||", typ: Spoiler, slot: spoilerSlot},
+	{marker: "*", typ: Italic, slot: starSlot},
+	{marker: "_", typ: Italic, slot: underscoreSlot},
+}
+
+// slotTypes are the entity types made by the opener of each slot
+var slotTypes = [slotCount]EntityType{Bold, Spoiler, Italic, Italic, Link}
+
+// parser accumulates the plain text and entities of a document
+type parser struct {
+	text     []byte
+	u16      int
+	entities []Entity
+	err      error
+}
+
+func (p *parser) write(s string) {
+	p.text = append(p.text, s...)
+	p.u16 += utf16Len(s)
+}
+
+func (p *parser) add(e Entity) {
+	if len(p.entities) >= MaxEntities {
+		p.err = ErrTooManyEntities
+		return
+	}
+	p.entities = append(p.entities, e)
+}
+
+// token is a piece of inline content. Markup tokens are written as their literal text unless matched
+type token struct {
+	text    string
+	code    bool
+	matched bool
+}
+
+// span is a matched opener and closer, the entity covers the tokens between them
+type span struct {
+	open, close int
+	entity      Entity
+}
+
+// scanner splits a paragraph into tokens and matches markup in a single pass. Every kind of opener
+// has one slot, a closer only looks at its slot, so scanning is linear in the length of the text
+type scanner struct {
+	s      string
+	tokens []token
+	spans  []span
+	text   strings.Builder
+	slots  [slotCount]int
+	// runs holds the start of every backtick run by run length, in text order
+	runs map[int][]int
+	// paren is the position of the next closing parenthesis at or after the scan position, -1 when none
+	paren int
+}
+
+// inline parses a paragraph of inline markup. Unterminated markup is kept as text
+func (p *parser) inline(s string) {
+	sc := &scanner{s: s, runs: backtickRuns(s), paren: -2}
+	for i := range sc.slots {
+		sc.slots[i] = -1
+	}
+	if err := sc.scan(); err != nil {
+		p.err = err
+		return
+	}
+
+	starts := make([]int, len(sc.tokens))
+	ends := make([]int, len(sc.tokens))
+	for i, t := range sc.tokens {
+		starts[i] = p.u16
+		switch {
+		case t.code:
+			p.write(t.text)
+			p.add(Entity{Type: Code, Offset: starts[i], Length: p.u16 - starts[i]})
+		case !t.matched:
+			p.write(t.text)
+		}
+		ends[i] = p.u16
+	}
+	for _, sp := range sc.spans {
+		e := sp.entity
+		e.Offset = ends[sp.open]
+		e.Length = starts[sp.close] - e.Offset
+		p.add(e)
+	}
+}
+
+// flush turns pending plain text into a token
+func (sc *scanner) flush() {
+	if sc.text.Len() > 0 {
+		sc.tokens = append(sc.tokens, token{text: sc.text.String()})
+		sc.text.Reset()
+	}
+}
+
+// emit appends a markup token after any pending text and returns its index
+func (sc *scanner) emit(t token) int {
+	sc.flush()
+	sc.tokens = append(sc.tokens, t)
+	return len(sc.tokens) - 1
+}
+
+func (sc *scanner) scan() error {
+	s := sc.s
+	for i := 0; i < len(s); {
+		switch s[i] {
+		case '\\':
+			if i+1 < len(s) && strings.IndexByte(escapable, s[i+1]) >= 0 {
+				sc.text.WriteByte(s[i+1])
+				i += 2
+				continue
+			}
+		case '`':
+			n := backtickRun(s, i)
+			if end, ok := sc.codeEnd(i, n); ok {
+				sc.emit(token{text: s[i+n : end], code: true})
+				i = end + n
+			} else {
+				sc.text.WriteString(s[i : i+n])
+				i += n
+			}
+			continue
+		case '[':
+			if sc.canOpen(linkSlot) {
+				sc.slots[linkSlot] = sc.emit(token{text: "["})
+			} else {
+				sc.text.WriteByte('[')
+			}
+			i++
+			continue
+		case ']':
+			next, err := sc.closeLink(i)
+			if err != nil {
+				return err
+			}
+			i = next
+			continue
+		}
+
+		if next, ok := sc.delimiter(i); ok {
+			i = next
+			continue
+		}
+
+		_, size := utf8.DecodeRuneInString(s[i:])
+		sc.text.WriteString(s[i : i+size])
+		i += size
+	}
+	sc.flush()
+	return nil
+}
+
+// canOpen reports whether an opener may take a slot. Markup does not nest in markup of its own type
+func (sc *scanner) canOpen(slot int) bool {
+	for i, o := range sc.slots {
+		if o >= 0 && slotTypes[i] == slotTypes[slot] {
+			return false
+		}
+	}
+	return true
+}
+
+// match pairs the opener of a slot with a closer. Openers between them can no longer be closed
+func (sc *scanner) match(slot int, closer token, entity Entity) {
+	open := sc.slots[slot]
+	sc.tokens[open].matched = true
+	closer.matched = true
+	sc.spans = append(sc.spans, span{open: open, close: sc.emit(closer), entity: entity})
+	for i, o := range sc.slots {
+		if o >= open {
+			sc.slots[i] = -1
+		}
+	}
+}
+
+// delimiter handles an emphasis or spoiler marker at s[i], reporting false when there is none
+func (sc *scanner) delimiter(i int) (int, bool) {
+	s := sc.s
+	for _, d := range delimiters {
+		if !strings.HasPrefix(s[i:], d.marker) {
+			continue
+		}
+		next := i + len(d.marker)
+
+		// Flanking markers cannot open before or close after whitespace
+		canClose := i > 0 && !isSpaceBefore(s, i)
+		canOpen := next < len(s) && !isSpaceAt(s, next)
+		if d.marker == "_" {
+			// Underscores inside words, like snake_case, are not markup
+			canOpen = canOpen && !isWordBefore(s, i)
+			if next < len(s) {
+				r, _ := utf8.DecodeRuneInString(s[next:])
+				canClose = canClose && !isWordRune(r)
+			}
+		}
+
+		sc.flush()
+		open := sc.slots[d.slot]
+		// A closer cannot reach past an open link text, and spans cannot be empty
+		if canClose && open >= 0 && sc.slots[linkSlot] < open && len(sc.tokens) > open+1 {
+			sc.match(d.slot, token{text: d.marker}, Entity{Type: d.typ})
+			return next, true
+		}
+		if canOpen && sc.canOpen(d.slot) {
+			sc.slots[d.slot] = sc.emit(token{text: d.marker})
+			return next, true
+		}
+		sc.text.WriteString(d.marker)
+		return next, true
+	}
+	return i, false
+}
+
+// closeLink handles a ] at s[i]. It closes the open link text when followed by (url), only absolute URLs
+// make links and unsafe schemes are rejected; otherwise the ] and the open [ are kept as text
+func (sc *scanner) closeLink(i int) (int, error) {
+	s := sc.s
+	sc.flush()
+	open := sc.slots[linkSlot]
+	if open < 0 {
+		sc.text.WriteByte(']')
+		return i + 1, nil
+	}
+	sc.slots[linkSlot] = -1
+
+	end := sc.nextParen(i)
+	if len(sc.tokens) == open+1 || i+1 >= len(s) || s[i+1] != '(' || end < 0 || end == i+2 {
+		sc.text.WriteByte(']')
+		return i + 1, nil
+	}
+	raw := s[i+2 : end]
+	u, err := url.Parse(raw)
+	if strings.ContainsAny(raw, " \t\n") || err != nil || u.Scheme == "" {
+		sc.text.WriteByte(']')
+		return i + 1, nil
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https":
+		if u.Host == "" {
+			return 0, ErrInvalidLink
+		}
+	case "mailto":
+	default:
+		return 0, ErrInvalidLink
+	}
+
+	sc.slots[linkSlot] = open
+	sc.match(linkSlot, token{text: s[i : end+1]}, Entity{Type: Link, URL: u.String()})
+	return end + 1, nil
+}
+
+// nextParen returns the position of the first ) after s[i], remembering it for the following lookups
+func (sc *scanner) nextParen(i int) int {
+	if sc.paren == -1 || (sc.paren >= 0 && sc.paren > i) {
+		return sc.paren
+	}
+	k := strings.IndexByte(sc.s[i+1:], ')')
+	if k < 0 {
+		sc.paren = -1
+	} else {
+		sc.paren = i + 1 + k
+	}
+	return sc.paren
+}
+
+// codeEnd finds the backtick run closing a code span opened by the n backticks at s[i]. The span is
+// closed by the next run of the same length and its content is not parsed
+func (sc *scanner) codeEnd(i, n int) (int, bool) {
+	starts := sc.runs[n]
+	j := sort.SearchInts(starts, i+n)
+	if j == len(starts) {
+		return i, false
+	}
+	k := starts[j]
+	if strings.TrimSpace(sc.s[i+n:k]) == "" {
+		return i, false
+	}
+	return k, true
+}
+
+// backtickRuns indexes the backtick runs of s by length
+func backtickRuns(s string) map[int][]int {
+	runs := make(map[int][]int)
+	for i := 0; i < len(s); {
+		if s[i] != '`' {
+			i++
+			continue
+		}
+		n := backtickRun(s, i)
+		runs[n] = append(runs[n], i)
+		i += n
+	}
+	return runs
+}
+
+func backtickRun(s string, i int) int {
+	n := 0
+	for i+n < len(s) && s[i+n] == '`' {
+		n++
+	}
+	return n
+}
+
+// fenceLanguage reports whether a line opens a code block and the language it names
+func fenceLanguage(line string) (string, bool) {
+	if !strings.HasPrefix(line, "```") {
+		return "", false
+	}
+	language := strings.TrimSpace(line[3:])
+	if !languagePattern.MatchString(language) {
+		return "", false
+	}
+	return language, true
+}
+
+// closingFence returns the line closing the code block opened at lines[i], or -1
+func closingFence(lines []string, i int) int {
+	for j := i + 1; j < len(lines); j++ {
+		if strings.TrimSpace(lines[j]) == "```" {
+			return j
+		}
+	}
+	return -1
+}
+
+func opensCodeBlock(lines []string, i int) bool {
+	_, ok := fenceLanguage(lines[i])
+	return ok && closingFence(lines, i) >= 0
+}
+
+func isQuote(line string) bool {
+	return strings.HasPrefix(line, ">")
+}
+
+func unquote(line string) string {
+	line = strings.TrimPrefix(line, ">")
+	return strings.TrimPrefix(line, " ")
+}
+
+func isSpaceAt(s string, i int) bool {
+	r, _ := utf8.DecodeRuneInString(s[i:])
+	return unicode.IsSpace(r)
+}
+
+func isSpaceBefore(s string, i int) bool {
+	r, _ := utf8.DecodeLastRuneInString(s[:i])
+	return unicode.IsSpace(r)
+}
+
+func isWordBefore(s string, i int) bool {
+	if i == 0 {
+		return false
+	}
+	r, _ := utf8.DecodeLastRuneInString(s[:i])
+	return isWordRune(r)
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
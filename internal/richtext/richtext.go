@@ -0,0 +1,176 @@
+package richtext
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// EntityType is the kind of formatting applied to a range of text
+type EntityType string
+
+const (
+	Bold    EntityType = "bold"
+	Italic  EntityType = "italic"
+	Code    EntityType = "code"
+	Pre     EntityType = "pre"
+	Spoiler EntityType = "spoiler"
+	Link    EntityType = "link"
+	Quote   EntityType = "quote"
+)
+
+const (
+	// MaxEntities bounds the formatting entities of a single message
+	MaxEntities = 100
+	// MaxContentLength bounds message content in characters after normalisation
+	MaxContentLength = 4000
+)
+
+var (
+	// ErrInvalidLink is returned for links with a scheme other than http, https or mailto
+	ErrInvalidLink = errors.New("invalid link url")
+	// ErrTooManyEntities is returned when content has more than MaxEntities formatted ranges
+	ErrTooManyEntities = errors.New("too many formatting entities")
+	// ErrContentTooLong is returned for content longer than MaxContentLength characters
+	ErrContentTooLong = errors.New("message content is too long")
+)
+
+// Entity is a formatted range of the plain text. Offset and Length are in UTF-16 code units
+// like mention ranges, so clients can apply them without re-parsing
+type Entity struct {
+	Type     EntityType `json:"type"`
+	Offset   int        `json:"offset"`
+	Length   int        `json:"length"`
+	URL      string     `json:"url,omitempty"`
+	Language string     `json:"language,omitempty"`
+}
+
+// Document is parsed message content
+type Document struct {
+	// Content is the normalised markup as it is stored
+	Content string
+	// Text is the content without markup
+	Text string
+	// Entities are ordered by offset, enclosing entities first
+	Entities []Entity
+}
+
+// Plain returns a document of unformatted text, used for content stored before formatting was parsed
+func Plain(text string) *Document {
+	return &Document{Content: text, Text: text}
+}
+
+// InCode reports whether a range of the text overlaps inline code or a code block
+func (d *Document) InCode(offset, length int) bool {
+	for _, e := range d.Entities {
+		if e.Type != Code && e.Type != Pre {
+			continue
+		}
+		if offset < e.Offset+e.Length && e.Offset < offset+length {
+			return true
+		}
+	}
+	return false
+}
+
+// Normalize unifies line endings, drops control and bidirectional override characters and trims
+// surrounding whitespace
+func Normalize(content string) string {
+	content = strings.ToValidUTF8(content, "")
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	content = strings.ReplaceAll(content, "\r", "\n")
+	content = strings.Map(func(r rune) rune {
+		switch {
+		case r == '\n' || r == '\t':
+			return r
+		case unicode.IsControl(r), unicode.Is(unicode.Bidi_Control, r):
+			return -1
+		}
+		return r
+	}, content)
+	return strings.TrimSpace(content)
+}
+
+// Parse normalises content and parses its Markdown subset: **bold**, *italic* or _italic_, `code`,
+// fenced code blocks, ||spoilers||, [links](https://example.com) and > quotes. A backslash escapes
+// markup characters and unterminated markup is kept as text
+func Parse(content string) (*Document, error) {
+	content = Normalize(content)
+	if utf8.RuneCountInString(content) > MaxContentLength {
+		return nil, ErrContentTooLong
+	}
+
+	p := &parser{}
+	lines := strings.Split(content, "\n")
+	for i := 0; i < len(lines) && p.err == nil; {
+		if i > 0 {
+			p.write("\n")
+		}
+
+		if language, ok := fenceLanguage(lines[i]); ok {
+			if end := closingFence(lines, i); end >= 0 {
+				offset := p.u16
+				p.write(strings.Join(lines[i+1:end], "\n"))
+				if p.u16 > offset {
+					p.add(Entity{Type: Pre, Offset: offset, Length: p.u16 - offset, Language: language})
+				}
+				i = end + 1
+				continue
+			}
+		}
+
+		if isQuote(lines[i]) {
+			end := i
+			var quoted []string
+			for end < len(lines) && isQuote(lines[end]) {
+				quoted = append(quoted, unquote(lines[end]))
+				end++
+			}
+			offset := p.u16
+			p.inline(strings.Join(quoted, "\n"))
+			if p.u16 > offset {
+				p.add(Entity{Type: Quote, Offset: offset, Length: p.u16 - offset})
+			}
+			i = end
+			continue
+		}
+
+		// Inline markup may span the lines of a paragraph but not leave it
+		end := i + 1
+		for end < len(lines) && !isQuote(lines[end]) && !opensCodeBlock(lines, end) {
+			end++
+		}
+		p.inline(strings.Join(lines[i:end], "\n"))
+		i = end
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	sort.SliceStable(p.entities, func(a, b int) bool {
+		if p.entities[a].Offset != p.entities[b].Offset {
+			return p.entities[a].Offset < p.entities[b].Offset
+		}
+		return p.entities[a].Length > p.entities[b].Length
+	})
+
+	return &Document{
+		Content:  content,
+		Text:     string(p.text),
+		Entities: p.entities,
+	}, nil
+}
+
+// utf16Len returns the length of s in UTF-16 code units
+func utf16Len(s string) int {
+	n := 0
+	for len(s) > 0 {
+		r, size := utf8.DecodeRuneInString(s)
+		n += utf16.RuneLen(r)
+		s = s[size:]
+	}
+	return n
+}
@@ -0,0 +1,289 @@
+package richtext
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		text     string
+		entities []Entity
+	}{
+		{
+			name:    "plain text",
+			content: "hello world",
+			text:    "hello world",
+		},
+		{
+			name:     "bold",
+			content:  "a **b** c",
+			text:     "a b c",
+			entities: []Entity{{Type: Bold, Offset: 2, Length: 1}},
+		},
+		{
+			name:    "italic with both markers",
+			content: "*a* _b_",
+			text:    "a b",
+			entities: []Entity{
+				{Type: Italic, Offset: 0, Length: 1},
+				{Type: Italic, Offset: 2, Length: 1},
+			},
+		},
+		{
+			name:     "spoiler",
+			content:  "||secret||",
+			text:     "secret",
+			entities: []Entity{{Type: Spoiler, Offset: 0, Length: 6}},
+		},
+		{
+			name:    "offsets count utf-16 code units of astral characters",
+			content: "😀 **😀x** 😀",
+			text:    "😀 😀x 😀",
+			entities: []Entity{
+				{Type: Bold, Offset: 3, Length: 3},
+			},
+		},
+		{
+			name:    "offsets after astral characters in code and links",
+			content: "𝄞`𝄞` [𝄞](https://example.com)",
+			text:    "𝄞𝄞 𝄞",
+			entities: []Entity{
+				{Type: Code, Offset: 2, Length: 2},
+				{Type: Link, Offset: 5, Length: 2, URL: "https://example.com"},
+			},
+		},
+		{
+			name:    "unterminated bold is text",
+			content: "**a b",
+			text:    "**a b",
+		},
+		{
+			name:    "unterminated markup of every kind is text",
+			content: "**a ||b *c _d [e `f",
+			text:    "**a ||b *c _d [e `f",
+		},
+		{
+			name:     "unterminated outer markup keeps inner spans",
+			content:  "**a *b*",
+			text:     "**a b",
+			entities: []Entity{{Type: Italic, Offset: 4, Length: 1}},
+		},
+		{
+			name:    "markers before whitespace do not open",
+			content: "a ** b ** c",
+			text:    "a ** b ** c",
+		},
+		{
+			name:    "empty spans are text",
+			content: "**** ||||",
+			text:    "**** ||||",
+		},
+		{
+			name:    "snake_case is not italic",
+			content: "use snake_case_names here",
+			text:    "use snake_case_names here",
+		},
+		{
+			name:     "underscores around a word are italic",
+			content:  "_snake_case_",
+			text:     "snake_case",
+			entities: []Entity{{Type: Italic, Offset: 0, Length: 10}},
+		},
+		{
+			name:    "nested bold italic and spoiler",
+			content: "**a *b ||c||* d**",
+			text:    "a b c d",
+			entities: []Entity{
+				{Type: Bold, Offset: 0, Length: 7},
+				{Type: Italic, Offset: 2, Length: 3},
+				{Type: Spoiler, Offset: 4, Length: 1},
+			},
+		},
+		{
+			name:     "markup does not nest in itself",
+			content:  "**a **b** c**",
+			text:     "a **b c**",
+			entities: []Entity{{Type: Bold, Offset: 0, Length: 5}},
+		},
+		{
+			name:     "closers of crossing markup close the outer span",
+			content:  "**a *b** c*",
+			text:     "a *b c*",
+			entities: []Entity{{Type: Bold, Offset: 0, Length: 4}},
+		},
+		{
+			name:     "escaped markers are text",
+			content:  `\*a\* \_b\_ \[c\]`,
+			text:     "*a* _b_ [c]",
+			entities: nil,
+		},
+		{
+			name:     "code content is not parsed",
+			content:  "`**a**` ``b`c``",
+			text:     "**a** b`c",
+			entities: []Entity{{Type: Code, Offset: 0, Length: 5}, {Type: Code, Offset: 6, Length: 3}},
+		},
+		{
+			name:    "link with formatted text",
+			content: "[**a** b](https://example.com/x?y=1)",
+			text:    "a b",
+			entities: []Entity{
+				{Type: Link, Offset: 0, Length: 3, URL: "https://example.com/x?y=1"},
+				{Type: Bold, Offset: 0, Length: 1},
+			},
+		},
+		{
+			name:     "mailto link",
+			content:  "[mail](mailto:a@example.com)",
+			text:     "mail",
+			entities: []Entity{{Type: Link, Offset: 0, Length: 4, URL: "mailto:a@example.com"}},
+		},
+		{
+			name:    "relative link target is text",
+			content: "[a](/path)",
+			text:    "[a](/path)",
+		},
+		{
+			name:     "emphasis cannot close inside link text",
+			content:  "**a [b** c](https://example.com)",
+			text:     "**a b** c",
+			entities: []Entity{{Type: Link, Offset: 4, Length: 5, URL: "https://example.com"}},
+		},
+		{
+			name:    "code block and quote",
+			content: "> *q*\n```go\nx*y*\n```\nz",
+			text:    "q\nx*y*\nz",
+			entities: []Entity{
+				{Type: Italic, Offset: 0, Length: 1},
+				{Type: Quote, Offset: 0, Length: 1},
+				{Type: Pre, Offset: 2, Length: 4, Language: "go"},
+			},
+		},
+		{
+			name:     "markup spans the lines of a paragraph",
+			content:  "**a\nb**",
+			text:     "a\nb",
+			entities: []Entity{{Type: Bold, Offset: 0, Length: 3}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := Parse(tt.content)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %v", tt.content, err)
+			}
+			if doc.Text != tt.text {
+				t.Errorf("text = %q, want %q", doc.Text, tt.text)
+			}
+			if !reflect.DeepEqual(doc.Entities, tt.entities) {
+				t.Errorf("entities = %+v, want %+v", doc.Entities, tt.entities)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		err     error
+	}{
+		{
+			name:    "javascript link",
+			content: "[click](javascript:alert(1))",
+			err:     ErrInvalidLink,
+		},
+		{
+			name:    "javascript link with mixed case scheme",
+			content: "[click](JavaScript:alert(1))",
+			err:     ErrInvalidLink,
+		},
+		{
+			name:    "data link",
+			content: "[x](data:text/html,hi)",
+			err:     ErrInvalidLink,
+		},
+		{
+			name:    "http link without host",
+			content: "[x](http:/path)",
+			err:     ErrInvalidLink,
+		},
+		{
+			name:    "more than MaxEntities entities",
+			content: strings.Repeat("*a* ", MaxEntities+1),
+			err:     ErrTooManyEntities,
+		},
+		{
+			name:    "content longer than MaxContentLength",
+			content: strings.Repeat("😀", MaxContentLength+1),
+			err:     ErrContentTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.content)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("Parse(%q) error = %v, want %v", tt.content, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestParseMaxEntities(t *testing.T) {
+	doc, err := Parse(strings.Repeat("*a* ", MaxEntities))
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if len(doc.Entities) != MaxEntities {
+		t.Errorf("got %d entities, want %d", len(doc.Entities), MaxEntities)
+	}
+}
+
+func TestParseNormalizes(t *testing.T) {
+	doc, err := Parse("  a\r\n**b**‮\x00  ")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if doc.Content != "a\n**b**" {
+		t.Errorf("content = %q, want %q", doc.Content, "a\n**b**")
+	}
+	if doc.Text != "a\nb" {
+		t.Errorf("text = %q, want %q", doc.Text, "a\nb")
+	}
+}
+
+func TestParseWorstCaseIsLinear(t *testing.T) {
+	inputs := []string{
+		// Unterminated openers of every kind, the case that used to backtrack
+		strings.Repeat("**a ||a *a [a ", (MaxContentLength-20)/14) + " ** || * ](",
+		// Openers with closers that can never match
+		strings.Repeat("**a ||a *a [a _a `a ``b a** a|| a* a_ ](", MaxContentLength/40),
+		// Backtick runs of growing length that never close
+		func() string {
+			var b strings.Builder
+			for n := 1; b.Len()+n+3 <= MaxContentLength; n++ {
+				b.WriteString(strings.Repeat("`", n))
+				b.WriteString(" x ")
+			}
+			return b.String()
+		}(),
+	}
+
+	for _, content := range inputs {
+		start := time.Now()
+		if _, err := Parse(content); err != nil && !errors.Is(err, ErrTooManyEntities) {
+			t.Fatalf("Parse returned error: %v", err)
+		}
+		if elapsed := time.Since(start); elapsed > 200*time.Millisecond {
+			t.Errorf("Parse of %d bytes took %v", len(content), elapsed)
+		}
+	}
+}
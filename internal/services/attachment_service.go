@@ -0,0 +1,328 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"kakashi/chaos/internal/ent"
+	"kakashi/chaos/internal/ent/attachment"
+	"kakashi/chaos/internal/ent/conversationparticipant"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/imaging"
+	"kakashi/chaos/internal/storage"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// attachmentURLTTL is how long a signed attachment download URL stays valid
+	attachmentURLTTL = 15 * time.Minute
+	// attachmentDownloadPath is the route serving signed attachment downloads
+	attachmentDownloadPath = "/api/v1/attachments/%s/download"
+	// mimeSniffLength is the number of leading bytes used to detect the content type
+	mimeSniffLength = 512
+	// attachmentVariantThumbnail selects the downscaled copy of a processed image
+	attachmentVariantThumbnail = "thumbnail"
+)
+
+// inlineImageTypes are the sniffed image types sent as image messages and displayed inline
+var inlineImageTypes = map[string]bool{
+	"image/png":  true,
+	"image/jpeg": true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+// AttachmentUpload represents an uploaded file before it is stored
+type AttachmentUpload struct {
+	Filename string
+	Size     int64
+	Reader   io.Reader
+}
+
+// AttachmentInput describes a stored attachment to be recorded with a new message
+type AttachmentInput struct {
+	StorageKey  string
+	Filename    string
+	ContentType string
+	Size        int64
+}
+
+// messageType returns the message type matching the attachment content
+func (a *AttachmentInput) messageType() message.MessageType {
+	if inlineImageTypes[a.ContentType] {
+		return message.MessageTypeImage
+	}
+	return message.MessageTypeFile
+}
+
+// processingStatus returns pending for images the background worker can process
+func (a *AttachmentInput) processingStatus() attachment.ProcessingStatus {
+	if imaging.IsSupported(a.ContentType) {
+		return attachment.ProcessingStatusPending
+	}
+	return attachment.ProcessingStatusNone
+}
+
+// SignedAttachmentURL represents a time-limited download link for an attachment
+type SignedAttachmentURL struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// MaxAttachmentSize returns the largest accepted attachment in bytes
+func (s *Services) MaxAttachmentSize() int64 {
+	return s.maxAttachmentSize
+}
+
+// SendAttachmentMessage stores an uploaded file and sends it as an image or file message with an optional caption
+func (s *Services) SendAttachmentMessage(ctx context.Context, senderID, conversationID, caption string, upload AttachmentUpload, opts SendMessageOptions) (*ent.Message, error) {
+	if s.attachmentStorage == nil {
+		return nil, fmt.Errorf("attachment storage is not configured")
+	}
+	if upload.Size <= 0 {
+		return nil, fmt.Errorf("attachment is empty")
+	}
+	if s.maxAttachmentSize > 0 && upload.Size > s.maxAttachmentSize {
+		return nil, fmt.Errorf("attachment is too large")
+	}
+
+	// Check permissions before storing anything
+	if err := s.validateSendPermission(ctx, senderID, conversationID); err != nil {
+		return nil, err
+	}
+
+	// Sniff the content type from the content instead of trusting the client
+	head := make([]byte, mimeSniffLength)
+	n, err := io.ReadFull(upload.Reader, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, fmt.Errorf("failed to read attachment: %w", err)
+	}
+	head = head[:n]
+	contentType := strings.SplitN(http.DetectContentType(head), ";", 2)[0]
+
+	filename := sanitizeFilename(upload.Filename)
+	key := fmt.Sprintf("conversations/%s/%s", conversationID, uuid.Must(uuid.NewV7()).String())
+
+	reader := io.MultiReader(bytes.NewReader(head), upload.Reader)
+	if err := s.attachmentStorage.Put(ctx, key, reader, upload.Size, contentType); err != nil {
+		return nil, fmt.Errorf("failed to store attachment: %w", err)
+	}
+
+	content := strings.TrimSpace(caption)
+	if content == "" {
+		content = filename
+	}
+
+	opts.Attachment = &AttachmentInput{
+		StorageKey:  key,
+		Filename:    filename,
+		ContentType: contentType,
+		Size:        upload.Size,
+	}
+	msg, err := s.SendMessageWithOptions(ctx, senderID, conversationID, content, opts)
+	if err != nil {
+		// Don't leave orphaned objects behind when the message could not be created
+		if delErr := s.attachmentStorage.Delete(ctx, key); delErr != nil {
+			slog.Error("Failed to delete orphaned attachment", "storage_key", key, "error", delErr)
+		}
+		return nil, err
+	}
+
+	s.enqueuePendingImages(msg)
+
+	return msg, nil
+}
+
+// enqueuePendingImages queues the unprocessed images of a new message, the message is delivered
+// right away and its images become downloadable once processed in the background
+func (s *Services) enqueuePendingImages(msg *ent.Message) {
+	for _, att := range msg.Edges.Attachments {
+		if att.ProcessingStatus == attachment.ProcessingStatusPending {
+			s.enqueueImageProcessing(att.ID)
+		}
+	}
+}
+
+// GetAttachmentURL returns a signed, expiring download URL for an attachment or its thumbnail variant
+func (s *Services) GetAttachmentURL(ctx context.Context, attachmentID, userID, variant string) (*SignedAttachmentURL, error) {
+	att, err := s.getAccessibleAttachment(ctx, attachmentID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateAttachmentVariant(att, variant); err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(attachmentURLTTL).Truncate(time.Second)
+	query := url.Values{}
+	query.Set("uid", userID)
+	query.Set("expires", strconv.FormatInt(expiresAt.Unix(), 10))
+	if variant != "" {
+		query.Set("variant", variant)
+	}
+	query.Set("signature", s.signAttachmentURL(attachmentID, userID, variant, expiresAt.Unix()))
+
+	return &SignedAttachmentURL{
+		URL:       fmt.Sprintf(attachmentDownloadPath, url.PathEscape(attachmentID)) + "?" + query.Encode(),
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// AttachmentContent represents an opened attachment object ready to be streamed
+type AttachmentContent struct {
+	Filename    string
+	ContentType string
+	// Size is the content length in bytes, zero when unknown
+	Size int64
+	Body io.ReadCloser
+}
+
+// OpenSignedAttachment verifies a signed download URL and opens the attachment or thumbnail content
+func (s *Services) OpenSignedAttachment(ctx context.Context, attachmentID, userID, variant, expires, signature string) (*AttachmentContent, error) {
+	if s.attachmentStorage == nil {
+		return nil, fmt.Errorf("attachment storage is not configured")
+	}
+
+	expiresUnix, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || userID == "" {
+		return nil, fmt.Errorf("invalid download signature")
+	}
+	expected := s.signAttachmentURL(attachmentID, userID, variant, expiresUnix)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return nil, fmt.Errorf("invalid download signature")
+	}
+	if time.Now().Unix() > expiresUnix {
+		return nil, fmt.Errorf("download link has expired")
+	}
+
+	// Re-check access so links stop working once the user leaves the conversation
+	att, err := s.getAccessibleAttachment(ctx, attachmentID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateAttachmentVariant(att, variant); err != nil {
+		return nil, err
+	}
+
+	content := &AttachmentContent{
+		Filename:    att.Filename,
+		ContentType: att.ContentType,
+		Size:        att.Size,
+	}
+	key := att.StorageKey
+	if variant == attachmentVariantThumbnail {
+		key = att.ThumbnailKey
+		content.ContentType = att.ThumbnailContentType
+		content.Size = 0
+	}
+
+	content.Body, err = s.attachmentStorage.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("attachment not found")
+		}
+		return nil, fmt.Errorf("failed to open attachment: %w", err)
+	}
+
+	return content, nil
+}
+
+// IsInlineAttachment reports whether an attachment content type is safe to display inline
+func IsInlineAttachment(contentType string) bool {
+	return inlineImageTypes[contentType]
+}
+
+// getAccessibleAttachment loads an attachment of a non-deleted message in a conversation the user participates in
+func (s *Services) getAccessibleAttachment(ctx context.Context, attachmentID, userID string) (*ent.Attachment, error) {
+	att, err := s.ent.Attachment.Query().
+		Where(attachment.IDEQ(attachmentID)).
+		WithMessage().
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("attachment not found")
+		}
+		return nil, fmt.Errorf("failed to get attachment: %w", err)
+	}
+	if att.Edges.Message == nil || att.Edges.Message.IsDeleted {
+		return nil, fmt.Errorf("attachment not found")
+	}
+
+	// Check if user is a participant in the conversation
+	isParticipant, err := s.ent.ConversationParticipant.Query().
+		Where(
+			conversationparticipant.ConversationIDEQ(att.Edges.Message.ConversationID),
+			conversationparticipant.UserIDEQ(userID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check participant status: %w", err)
+	}
+	if !isParticipant {
+		return nil, fmt.Errorf("user is not a participant in this conversation")
+	}
+
+	return att, nil
+}
+
+// validateAttachmentVariant checks that the requested variant exists for the attachment. Image originals
+// are only served once processing has stripped their metadata
+func validateAttachmentVariant(att *ent.Attachment, variant string) error {
+	switch variant {
+	case "":
+		switch att.ProcessingStatus {
+		case attachment.ProcessingStatusPending:
+			return fmt.Errorf("attachment is still processing")
+		case attachment.ProcessingStatusFailed:
+			return fmt.Errorf("attachment not found")
+		}
+		return nil
+	case attachmentVariantThumbnail:
+		if att.ThumbnailKey == "" {
+			return fmt.Errorf("thumbnail not available")
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid attachment variant")
+	}
+}
+
+// signAttachmentURL computes the signature binding an attachment download to a user, variant and expiry
+func (s *Services) signAttachmentURL(attachmentID, userID, variant string, expires int64) string {
+	mac := hmac.New(sha256.New, []byte(s.jwt_secret))
+	mac.Write([]byte("attachment\n" + attachmentID + "\n" + userID + "\n" + variant + "\n" + strconv.FormatInt(expires, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// sanitizeFilename strips directories and control characters from a client supplied filename
+func sanitizeFilename(name string) string {
+	name = filepath.Base(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f || r == '"' {
+			return -1
+		}
+		return r
+	}, name)
+	name = strings.TrimSpace(name)
+	if name == "" || name == "." || name == "/" {
+		name = "attachment"
+	}
+	if runes := []rune(name); len(runes) > 255 {
+		name = string(runes[:255])
+	}
+	return name
+}
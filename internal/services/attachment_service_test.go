@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"kakashi/chaos/internal/storage"
+)
+
+func TestOpenSignedAttachmentRejectsBadSignatures(t *testing.T) {
+	store, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocal returned error: %v", err)
+	}
+	s := New(nil, "test-secret", nil)
+	s.SetAttachmentStorage(store, 1<<20)
+
+	valid := time.Now().Add(time.Hour).Unix()
+	expired := time.Now().Add(-time.Minute).Unix()
+	validSignature := s.signAttachmentURL("att1", "user1", "", valid)
+
+	tests := []struct {
+		name         string
+		attachmentID string
+		userID       string
+		variant      string
+		expires      string
+		signature    string
+		err          string
+	}{
+		{
+			name:         "expired link",
+			attachmentID: "att1",
+			userID:       "user1",
+			expires:      strconv.FormatInt(expired, 10),
+			signature:    s.signAttachmentURL("att1", "user1", "", expired),
+			err:          "download link has expired",
+		},
+		{
+			name:         "tampered signature",
+			attachmentID: "att1",
+			userID:       "user1",
+			expires:      strconv.FormatInt(valid, 10),
+			signature:    validSignature[:len(validSignature)-1] + "x",
+			err:          "invalid download signature",
+		},
+		{
+			name:         "extended expiry",
+			attachmentID: "att1",
+			userID:       "user1",
+			expires:      strconv.FormatInt(valid+3600, 10),
+			signature:    validSignature,
+			err:          "invalid download signature",
+		},
+		{
+			name:         "other user",
+			attachmentID: "att1",
+			userID:       "user2",
+			expires:      strconv.FormatInt(valid, 10),
+			signature:    validSignature,
+			err:          "invalid download signature",
+		},
+		{
+			name:         "other attachment",
+			attachmentID: "att2",
+			userID:       "user1",
+			expires:      strconv.FormatInt(valid, 10),
+			signature:    validSignature,
+			err:          "invalid download signature",
+		},
+		{
+			name:         "other variant",
+			attachmentID: "att1",
+			userID:       "user1",
+			variant:      attachmentVariantThumbnail,
+			expires:      strconv.FormatInt(valid, 10),
+			signature:    validSignature,
+			err:          "invalid download signature",
+		},
+		{
+			name:         "missing signature",
+			attachmentID: "att1",
+			userID:       "user1",
+			expires:      strconv.FormatInt(valid, 10),
+			err:          "invalid download signature",
+		},
+		{
+			name:         "malformed expiry",
+			attachmentID: "att1",
+			userID:       "user1",
+			expires:      "soon",
+			signature:    validSignature,
+			err:          "invalid download signature",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.OpenSignedAttachment(context.Background(), tt.attachmentID, tt.userID, tt.variant, tt.expires, tt.signature)
+			if err == nil || err.Error() != tt.err {
+				t.Errorf("OpenSignedAttachment error = %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestSignAttachmentURLDependsOnSecret(t *testing.T) {
+	expires := time.Now().Add(time.Hour).Unix()
+	a := New(nil, "secret-a", nil).signAttachmentURL("att1", "user1", "", expires)
+	b := New(nil, "secret-b", nil).signAttachmentURL("att1", "user1", "", expires)
+	if a == b {
+		t.Error("signatures made with different secrets are equal")
+	}
+}
@@ -0,0 +1,363 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"kakashi/chaos/internal/ent"
+	"kakashi/chaos/internal/ent/bookmark"
+	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/conversationparticipant"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/notification"
+	"kakashi/chaos/internal/ent/predicate"
+)
+
+const (
+	// maxBookmarkNoteLength bounds the note of a bookmark in characters
+	maxBookmarkNoteLength = 500
+	// maxReminderAhead is how far in the future a reminder can be set
+	maxReminderAhead = 365 * 24 * time.Hour
+	// reminderPollInterval is how often the reminder worker looks for due reminders
+	reminderPollInterval = 15 * time.Second
+	// reminderBatchSize bounds the reminders sent per poll
+	reminderBatchSize = 100
+)
+
+// SavedMessage is a bookmark with its message. Tombstone is set once the message has been deleted or
+// the user has left its conversation, the message itself is then left out
+type SavedMessage struct {
+	*ent.Bookmark
+	Tombstone bool `json:"tombstone"`
+}
+
+// BookmarkInput holds the attributes of a new bookmark, a zero RemindAt sets no reminder
+type BookmarkInput struct {
+	MessageID string
+	Note      string
+	RemindAt  time.Time
+}
+
+// BookmarkUpdate holds the attributes to change on a bookmark, nil fields are kept
+type BookmarkUpdate struct {
+	Note          *string
+	RemindAt      *time.Time
+	ClearReminder bool
+}
+
+// BookmarkFilter narrows a listing of saved messages
+type BookmarkFilter struct {
+	ConversationID string
+	// PendingReminders keeps only bookmarks with a reminder that has not been sent yet
+	PendingReminders bool
+}
+
+// validateBookmarkNote checks the length of a bookmark note
+func validateBookmarkNote(note string) error {
+	if len([]rune(note)) > maxBookmarkNoteLength {
+		return fmt.Errorf("note is too long")
+	}
+	return nil
+}
+
+// validateRemindAt checks that a reminder time lies in the accepted window
+func validateRemindAt(remindAt time.Time) error {
+	now := time.Now()
+	if !remindAt.After(now) {
+		return fmt.Errorf("reminder time must be in the future")
+	}
+	if remindAt.After(now.Add(maxReminderAhead)) {
+		return fmt.Errorf("reminder time is too far in the future")
+	}
+	return nil
+}
+
+// visibleToSaver matches messages the user still sees, in a conversation they participate in and not
+// deleted for them
+func visibleToSaver(userID string) predicate.Message {
+	return message.And(
+		notHiddenFor(userID),
+		message.HasConversationWith(conversation.HasParticipantsWith(conversationparticipant.UserIDEQ(userID))),
+	)
+}
+
+// SaveMessage bookmarks a message the user can see into their private saved list
+func (s *Services) SaveMessage(ctx context.Context, userID string, input BookmarkInput) (*SavedMessage, error) {
+	if err := validateBookmarkNote(input.Note); err != nil {
+		return nil, err
+	}
+	if !input.RemindAt.IsZero() {
+		if err := validateRemindAt(input.RemindAt); err != nil {
+			return nil, err
+		}
+	}
+
+	msg, err := s.ent.Message.Query().
+		Where(
+			message.IDEQ(input.MessageID),
+			message.IsDeletedEQ(false),
+			notHiddenFor(userID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("message not found")
+		}
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+	// Messages of other conversations are reported as missing so bookmarking does not reveal them
+	if err := s.requireParticipant(ctx, msg.ConversationID, userID); err != nil {
+		if err.Error() == "user is not a participant in this conversation" {
+			return nil, fmt.Errorf("message not found")
+		}
+		return nil, err
+	}
+
+	builder := s.ent.Bookmark.Create().
+		SetUserID(userID).
+		SetMessageID(msg.ID).
+		SetConversationID(msg.ConversationID).
+		SetNote(input.Note)
+	if !input.RemindAt.IsZero() {
+		builder = builder.SetRemindAt(input.RemindAt)
+	}
+	created, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("message is already saved")
+		}
+		return nil, fmt.Errorf("failed to save message: %w", err)
+	}
+
+	return s.getSavedMessage(ctx, created.ID, userID)
+}
+
+// UpdateBookmark changes the note or reminder of a bookmark. Setting a new reminder also rearms one
+// that has already been sent
+func (s *Services) UpdateBookmark(ctx context.Context, bookmarkID, userID string, update BookmarkUpdate) (*SavedMessage, error) {
+	if update.Note != nil {
+		if err := validateBookmarkNote(*update.Note); err != nil {
+			return nil, err
+		}
+	}
+	if update.RemindAt != nil {
+		if err := validateRemindAt(*update.RemindAt); err != nil {
+			return nil, err
+		}
+	}
+
+	builder := s.ent.Bookmark.Update().
+		Where(
+			bookmark.IDEQ(bookmarkID),
+			bookmark.UserIDEQ(userID),
+		)
+	if update.Note != nil {
+		builder = builder.SetNote(*update.Note)
+	}
+	switch {
+	case update.RemindAt != nil:
+		builder = builder.SetRemindAt(*update.RemindAt).ClearRemindedAt()
+	case update.ClearReminder:
+		builder = builder.ClearRemindAt()
+	}
+
+	n, err := builder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update bookmark: %w", err)
+	}
+	if n == 0 {
+		return nil, fmt.Errorf("bookmark not found")
+	}
+
+	return s.getSavedMessage(ctx, bookmarkID, userID)
+}
+
+// DeleteBookmark removes a message from the user's saved list
+func (s *Services) DeleteBookmark(ctx context.Context, bookmarkID, userID string) error {
+	n, err := s.ent.Bookmark.Delete().
+		Where(
+			bookmark.IDEQ(bookmarkID),
+			bookmark.UserIDEQ(userID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete bookmark: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("bookmark not found")
+	}
+	return nil
+}
+
+// GetSavedMessages returns a keyset page of the user's saved messages, most recently saved first
+func (s *Services) GetSavedMessages(ctx context.Context, userID string, filter BookmarkFilter, page PageRequest) (*Page[*SavedMessage], error) {
+	createdAt := keysetColumn(bookmark.FieldCreatedAt)
+	fetch := func(older bool, cursor *pageCursor, inclusive bool, limit int) ([]*SavedMessage, error) {
+		query := s.ent.Bookmark.Query().
+			Where(bookmark.UserIDEQ(userID)).
+			WithMessage(func(q *ent.MessageQuery) {
+				q.Where(visibleToSaver(userID)).WithSender().WithAttachments()
+			}).
+			Order(keysetOrder(createdAt, older)).
+			Limit(limit)
+		if filter.ConversationID != "" {
+			query = query.Where(bookmark.ConversationIDEQ(filter.ConversationID))
+		}
+		if filter.PendingReminders {
+			query = query.Where(bookmark.RemindAtNotNil())
+		}
+		if cursor != nil {
+			query = query.Where(keysetWhere(createdAt, older, inclusive, cursor))
+		}
+		bookmarks, err := query.All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get saved messages: %w", err)
+		}
+
+		saved := make([]*SavedMessage, len(bookmarks))
+		for i, b := range bookmarks {
+			saved[i] = savedMessage(b)
+		}
+		return saved, nil
+	}
+
+	return paginate(page, nil, fetch, func(m *SavedMessage) string {
+		return encodeCursor(m.CreatedAt, m.ID)
+	})
+}
+
+// getSavedMessage loads one of the user's bookmarks with its message
+func (s *Services) getSavedMessage(ctx context.Context, bookmarkID, userID string) (*SavedMessage, error) {
+	b, err := s.ent.Bookmark.Query().
+		Where(
+			bookmark.IDEQ(bookmarkID),
+			bookmark.UserIDEQ(userID),
+		).
+		WithMessage(func(q *ent.MessageQuery) {
+			q.Where(visibleToSaver(userID)).WithSender().WithAttachments()
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("bookmark not found")
+		}
+		return nil, fmt.Errorf("failed to get bookmark: %w", err)
+	}
+	return savedMessage(b), nil
+}
+
+// savedMessage turns a bookmark into a tombstone when its message was deleted for everyone or for the user,
+// or the user is no longer in its conversation
+func savedMessage(b *ent.Bookmark) *SavedMessage {
+	msg := b.Edges.Message
+	if msg == nil || msg.IsDeleted {
+		b.Edges.Message = nil
+		return &SavedMessage{Bookmark: b, Tombstone: true}
+	}
+	return &SavedMessage{Bookmark: b}
+}
+
+// StartReminderWorker sends due bookmark reminders until ctx is done. Every instance may run it, each
+// reminder is cleared before its notification is created so it is sent once
+func (s *Services) StartReminderWorker(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(reminderPollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.sendDueReminders(ctx)
+			}
+		}
+	}()
+}
+
+// sendDueReminders claims and sends one batch of due reminders
+func (s *Services) sendDueReminders(ctx context.Context) {
+	due, err := s.ent.Bookmark.Query().
+		Where(bookmark.RemindAtLTE(time.Now())).
+		Order(ent.Asc(bookmark.FieldRemindAt)).
+		Limit(reminderBatchSize).
+		All(ctx)
+	if err != nil {
+		slog.Error("Failed to get due reminders", "error", err)
+		return
+	}
+
+	for _, b := range due {
+		if ctx.Err() != nil {
+			return
+		}
+		// Clearing the reminder claims it, another instance or a concurrent update may have got there first
+		now := time.Now()
+		n, err := s.ent.Bookmark.Update().
+			Where(
+				bookmark.IDEQ(b.ID),
+				bookmark.RemindAtEQ(b.RemindAt),
+			).
+			ClearRemindAt().
+			SetRemindedAt(now).
+			Save(ctx)
+		if err != nil {
+			slog.Error("Failed to claim reminder", "bookmark_id", b.ID, "error", err)
+			continue
+		}
+		if n == 0 {
+			continue
+		}
+		s.sendReminder(ctx, b)
+	}
+}
+
+// sendReminder notifies the user of a bookmark, the notification points back at the message while the
+// user can still see it
+func (s *Services) sendReminder(ctx context.Context, b *ent.Bookmark) {
+	msg, err := s.ent.Message.Query().
+		Where(
+			message.IDEQ(b.MessageID),
+			message.IsDeletedEQ(false),
+			visibleToSaver(b.UserID),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		slog.Error("Failed to get reminder message", "bookmark_id", b.ID, "error", err)
+		return
+	}
+
+	title := "Reminder"
+	content := "Reminder about a message that is no longer available"
+	builder := s.ent.Notification.Create().
+		SetUserID(b.UserID).
+		SetType(notification.TypeReminder).
+		SetTitle(title)
+
+	relatedUserID := ""
+	if msg != nil {
+		content = createHighlight(messageDocument(msg).Text, nil)
+		relatedUserID = msg.SenderID
+		builder = builder.
+			SetRelatedUserID(msg.SenderID).
+			SetRelatedConversationID(msg.ConversationID).
+			SetRelatedMessageID(msg.ID)
+	}
+	if b.Note != "" {
+		content = b.Note
+	}
+	if content == "" {
+		content = "Reminder about a saved message"
+	}
+
+	notif, err := builder.SetContent(content).Save(ctx)
+	if err != nil {
+		slog.Error("Failed to create reminder notification", "bookmark_id", b.ID, "error", err)
+		return
+	}
+
+	if s.WSHub != nil {
+		s.BroadcastNotification(b.UserID, notif.ID, string(notification.TypeReminder), title, content, relatedUserID)
+	}
+}
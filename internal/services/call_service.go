@@ -269,44 +269,41 @@ func (s *Services) GetActiveCall(ctx context.Context, userID string) (*ent.Call,
 	return activeCall, nil
 }
 
-// GetCallHistory returns paginated call history for a user
-func (s *Services) GetCallHistory(ctx context.Context, userID string, limit, offset int) ([]*ent.Call, error) {
+// GetCallHistory returns a keyset page of call history for a user, newest first
+func (s *Services) GetCallHistory(ctx context.Context, userID string, page PageRequest) (*Page[*ent.Call], error) {
 	// Validate that user exists
 	_, err := s.ent.User.Query().Where(user.IDEQ(userID)).First(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("user not found: %w", err)
 	}
 
-	// Set default limit if not provided or invalid
-	if limit <= 0 || limit > 100 {
-		limit = 20
-	}
-
-	// Set default offset if negative
-	if offset < 0 {
-		offset = 0
-	}
-
-	// Get calls where user is caller or callee, ordered by creation date (newest first)
-	calls, err := s.ent.Call.Query().
-		Where(
-			call.Or(
-				call.CallerIDEQ(userID),
-				call.CalleeIDEQ(userID),
-			),
-		).
-		WithCaller().
-		WithCallee().
-		Order(ent.Desc(call.FieldCreatedAt)).
-		Limit(limit).
-		Offset(offset).
-		All(ctx)
-
-	if err != nil {
-		return nil, fmt.Errorf("failed to get call history: %w", err)
+	// Get calls where user is caller or callee
+	createdAt := keysetColumn(call.FieldCreatedAt)
+	fetch := func(older bool, cursor *pageCursor, inclusive bool, limit int) ([]*ent.Call, error) {
+		query := s.ent.Call.Query().
+			Where(
+				call.Or(
+					call.CallerIDEQ(userID),
+					call.CalleeIDEQ(userID),
+				),
+			).
+			WithCaller().
+			WithCallee().
+			Order(keysetOrder(createdAt, older)).
+			Limit(limit)
+		if cursor != nil {
+			query = query.Where(keysetWhere(createdAt, older, inclusive, cursor))
+		}
+		calls, err := query.All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get call history: %w", err)
+		}
+		return calls, nil
 	}
 
-	return calls, nil
+	return paginate(page, nil, fetch, func(c *ent.Call) string {
+		return encodeCursor(c.CreatedAt, c.ID)
+	})
 }
 
 // HandleCallTimeout handles call timeout (marks as missed after 30 seconds)
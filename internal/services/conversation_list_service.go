@@ -0,0 +1,504 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"kakashi/chaos/internal/ent"
+	"kakashi/chaos/internal/ent/conversationfolder"
+	"kakashi/chaos/internal/ent/conversationparticipant"
+	"kakashi/chaos/internal/ent/folderconversation"
+	"kakashi/chaos/internal/ws"
+)
+
+const (
+	// maxPinnedConversations bounds the conversations a user can pin, they are all listed on the first page
+	maxPinnedConversations = 10
+	// maxConversationFolders bounds the folders a user can create
+	maxConversationFolders = 20
+	// maxFolderNameLength bounds a folder name in characters
+	maxFolderNameLength = 50
+)
+
+// ConversationFilter narrows a listing of the user's conversations
+type ConversationFilter struct {
+	IncludeArchived bool
+	IncludeMuted    bool
+	// FolderID keeps only the conversations the user put into that folder
+	FolderID string
+}
+
+// validateFolderName trims a folder name and checks its length
+func validateFolderName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", fmt.Errorf("folder name is required")
+	}
+	if len([]rune(name)) > maxFolderNameLength {
+		return "", fmt.Errorf("folder name is too long")
+	}
+	return name, nil
+}
+
+// PinConversation pins a conversation to the top of the user's list, above the ones pinned before.
+// Pinning a pinned conversation is not an error
+func (s *Services) PinConversation(ctx context.Context, conversationID, userID string) error {
+	participant, err := s.getListParticipant(ctx, conversationID, userID)
+	if err != nil {
+		return err
+	}
+	if !participant.PinnedAt.IsZero() {
+		return nil
+	}
+
+	pinned, err := s.ent.ConversationParticipant.Query().
+		Where(
+			conversationparticipant.UserIDEQ(userID),
+			conversationparticipant.PinnedAtNotNil(),
+		).
+		Count(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to count pinned conversations: %w", err)
+	}
+	if pinned >= maxPinnedConversations {
+		return fmt.Errorf("too many pinned conversations")
+	}
+
+	tx, err := s.ent.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// The new pin takes the top position, the others move down one
+	if _, err := tx.ConversationParticipant.Update().
+		Where(
+			conversationparticipant.UserIDEQ(userID),
+			conversationparticipant.PinnedAtNotNil(),
+		).
+		AddPinPosition(1).
+		Save(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to pin conversation: %w", err))
+	}
+	if _, err := tx.ConversationParticipant.UpdateOneID(participant.ID).
+		SetPinnedAt(time.Now()).
+		SetPinPosition(0).
+		Save(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to pin conversation: %w", err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.broadcastConversationList(userID, ws.ConversationListData{
+		Action:         "conversation_pinned",
+		ConversationID: conversationID,
+	})
+
+	return nil
+}
+
+// UnpinConversation returns a pinned conversation to its place by activity
+func (s *Services) UnpinConversation(ctx context.Context, conversationID, userID string) error {
+	participant, err := s.getListParticipant(ctx, conversationID, userID)
+	if err != nil {
+		return err
+	}
+	if participant.PinnedAt.IsZero() {
+		return nil
+	}
+
+	if _, err := participant.Update().
+		ClearPinnedAt().
+		SetPinPosition(0).
+		Save(ctx); err != nil {
+		return fmt.Errorf("failed to unpin conversation: %w", err)
+	}
+
+	s.broadcastConversationList(userID, ws.ConversationListData{
+		Action:         "conversation_unpinned",
+		ConversationID: conversationID,
+	})
+
+	return nil
+}
+
+// ReorderPinnedConversations sets the order of the user's pinned conversations. conversationIDs must
+// list every pinned conversation exactly once
+func (s *Services) ReorderPinnedConversations(ctx context.Context, userID string, conversationIDs []string) error {
+	pinned, err := s.ent.ConversationParticipant.Query().
+		Where(
+			conversationparticipant.UserIDEQ(userID),
+			conversationparticipant.PinnedAtNotNil(),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get pinned conversations: %w", err)
+	}
+
+	byConversation := make(map[string]*ent.ConversationParticipant, len(pinned))
+	for _, p := range pinned {
+		byConversation[p.ConversationID] = p
+	}
+	if !sameIDs(conversationIDs, byConversation) {
+		return fmt.Errorf("order must list every pinned conversation once")
+	}
+
+	tx, err := s.ent.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	for i, conversationID := range conversationIDs {
+		if _, err := tx.ConversationParticipant.UpdateOneID(byConversation[conversationID].ID).
+			SetPinPosition(i).
+			Save(ctx); err != nil {
+			return rollback(tx, fmt.Errorf("failed to reorder pinned conversations: %w", err))
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.broadcastConversationList(userID, ws.ConversationListData{
+		Action: "pins_reordered",
+		Order:  conversationIDs,
+	})
+
+	return nil
+}
+
+// GetConversationFolders returns the user's folders in their custom order
+func (s *Services) GetConversationFolders(ctx context.Context, userID string) ([]*ent.ConversationFolder, error) {
+	folders, err := s.ent.ConversationFolder.Query().
+		Where(conversationfolder.UserIDEQ(userID)).
+		Order(
+			ent.Asc(conversationfolder.FieldPosition),
+			ent.Asc(conversationfolder.FieldCreatedAt),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get folders: %w", err)
+	}
+	return folders, nil
+}
+
+// CreateConversationFolder creates a named folder at the end of the user's folder list
+func (s *Services) CreateConversationFolder(ctx context.Context, userID, name string) (*ent.ConversationFolder, error) {
+	name, err := validateFolderName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.ent.ConversationFolder.Query().
+		Where(conversationfolder.UserIDEQ(userID)).
+		Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count folders: %w", err)
+	}
+	if count >= maxConversationFolders {
+		return nil, fmt.Errorf("too many folders")
+	}
+
+	folder, err := s.ent.ConversationFolder.Create().
+		SetUserID(userID).
+		SetName(name).
+		SetPosition(count).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("folder name is already used")
+		}
+		return nil, fmt.Errorf("failed to create folder: %w", err)
+	}
+
+	s.broadcastConversationList(userID, ws.ConversationListData{
+		Action:   "folder_created",
+		FolderID: folder.ID,
+		Name:     folder.Name,
+	})
+
+	return folder, nil
+}
+
+// RenameConversationFolder changes the name of one of the user's folders
+func (s *Services) RenameConversationFolder(ctx context.Context, folderID, userID, name string) (*ent.ConversationFolder, error) {
+	name, err := validateFolderName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	folder, err := s.getConversationFolder(ctx, folderID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	folder, err = folder.Update().SetName(name).Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("folder name is already used")
+		}
+		return nil, fmt.Errorf("failed to rename folder: %w", err)
+	}
+
+	s.broadcastConversationList(userID, ws.ConversationListData{
+		Action:   "folder_renamed",
+		FolderID: folder.ID,
+		Name:     folder.Name,
+	})
+
+	return folder, nil
+}
+
+// DeleteConversationFolder deletes one of the user's folders, its conversations stay in the list
+func (s *Services) DeleteConversationFolder(ctx context.Context, folderID, userID string) error {
+	folder, err := s.getConversationFolder(ctx, folderID, userID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.ent.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	if _, err := tx.FolderConversation.Delete().
+		Where(folderconversation.FolderIDEQ(folder.ID)).
+		Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete folder: %w", err))
+	}
+	if err := tx.ConversationFolder.DeleteOneID(folder.ID).Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete folder: %w", err))
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.broadcastConversationList(userID, ws.ConversationListData{
+		Action:   "folder_deleted",
+		FolderID: folder.ID,
+	})
+
+	return nil
+}
+
+// ReorderConversationFolders sets the order of the user's folders. folderIDs must list every folder exactly once
+func (s *Services) ReorderConversationFolders(ctx context.Context, userID string, folderIDs []string) error {
+	folders, err := s.ent.ConversationFolder.Query().
+		Where(conversationfolder.UserIDEQ(userID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get folders: %w", err)
+	}
+
+	byID := make(map[string]*ent.ConversationFolder, len(folders))
+	for _, f := range folders {
+		byID[f.ID] = f
+	}
+	if !sameIDs(folderIDs, byID) {
+		return fmt.Errorf("order must list every folder once")
+	}
+
+	tx, err := s.ent.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	for i, folderID := range folderIDs {
+		if _, err := tx.ConversationFolder.UpdateOneID(folderID).
+			SetPosition(i).
+			Save(ctx); err != nil {
+			return rollback(tx, fmt.Errorf("failed to reorder folders: %w", err))
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.broadcastConversationList(userID, ws.ConversationListData{
+		Action: "folders_reordered",
+		Order:  folderIDs,
+	})
+
+	return nil
+}
+
+// AddConversationToFolder puts a conversation into one of the user's folders. A conversation can be in
+// several folders, adding it twice is not an error
+func (s *Services) AddConversationToFolder(ctx context.Context, folderID, conversationID, userID string) error {
+	folder, err := s.getConversationFolder(ctx, folderID, userID)
+	if err != nil {
+		return err
+	}
+	if err := s.requireParticipant(ctx, conversationID, userID); err != nil {
+		return err
+	}
+
+	err = s.ent.FolderConversation.Create().
+		SetFolderID(folder.ID).
+		SetConversationID(conversationID).
+		Exec(ctx)
+	if err != nil && !ent.IsConstraintError(err) {
+		return fmt.Errorf("failed to add conversation to folder: %w", err)
+	}
+
+	s.broadcastConversationList(userID, ws.ConversationListData{
+		Action:         "folder_conversation_added",
+		ConversationID: conversationID,
+		FolderID:       folder.ID,
+	})
+
+	return nil
+}
+
+// RemoveConversationFromFolder takes a conversation out of one of the user's folders
+func (s *Services) RemoveConversationFromFolder(ctx context.Context, folderID, conversationID, userID string) error {
+	folder, err := s.getConversationFolder(ctx, folderID, userID)
+	if err != nil {
+		return err
+	}
+
+	n, err := s.ent.FolderConversation.Delete().
+		Where(
+			folderconversation.FolderIDEQ(folder.ID),
+			folderconversation.ConversationIDEQ(conversationID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to remove conversation from folder: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("conversation is not in this folder")
+	}
+
+	s.broadcastConversationList(userID, ws.ConversationListData{
+		Action:         "folder_conversation_removed",
+		ConversationID: conversationID,
+		FolderID:       folder.ID,
+	})
+
+	return nil
+}
+
+// getConversationFolder loads a folder of the user, folders of other users are reported as missing
+func (s *Services) getConversationFolder(ctx context.Context, folderID, userID string) (*ent.ConversationFolder, error) {
+	folder, err := s.ent.ConversationFolder.Query().
+		Where(
+			conversationfolder.IDEQ(folderID),
+			conversationfolder.UserIDEQ(userID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("folder not found")
+		}
+		return nil, fmt.Errorf("failed to get folder: %w", err)
+	}
+	return folder, nil
+}
+
+// getListParticipant loads the participant record holding the user's pin of a conversation
+func (s *Services) getListParticipant(ctx context.Context, conversationID, userID string) (*ent.ConversationParticipant, error) {
+	participant, err := s.ent.ConversationParticipant.Query().
+		Where(
+			conversationparticipant.ConversationIDEQ(conversationID),
+			conversationparticipant.UserIDEQ(userID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("user is not a participant in this conversation")
+		}
+		return nil, fmt.Errorf("failed to get participant: %w", err)
+	}
+	return participant, nil
+}
+
+// getPinnedConversations loads the user's pinned conversations matching a listing filter, in pin order
+func (s *Services) getPinnedConversations(ctx context.Context, query *ent.ConversationQuery, userID string) ([]*ent.Conversation, error) {
+	conversations, err := query.
+		WithParticipants(func(q *ent.ConversationParticipantQuery) {
+			q.WithUser()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pinned conversations: %w", err)
+	}
+
+	pins := make(map[string]*ent.ConversationParticipant, len(conversations))
+	for _, conv := range conversations {
+		for _, p := range conv.Edges.Participants {
+			if p.UserID == userID {
+				pins[conv.ID] = p
+			}
+		}
+	}
+	// Concurrent pins can share a position, the later pin goes first
+	sort.SliceStable(conversations, func(i, j int) bool {
+		a, b := pins[conversations[i].ID], pins[conversations[j].ID]
+		if a.PinPosition != b.PinPosition {
+			return a.PinPosition < b.PinPosition
+		}
+		return a.PinnedAt.After(b.PinnedAt)
+	})
+	return conversations, nil
+}
+
+// getFolderIDs returns the user's folders each of the conversations is in, keyed by conversation ID
+func (s *Services) getFolderIDs(ctx context.Context, userID string, conversationIDs []string) (map[string][]string, error) {
+	folderIDs := make(map[string][]string)
+	if len(conversationIDs) == 0 {
+		return folderIDs, nil
+	}
+
+	entries, err := s.ent.FolderConversation.Query().
+		Where(
+			folderconversation.ConversationIDIn(conversationIDs...),
+			folderconversation.HasFolderWith(conversationfolder.UserIDEQ(userID)),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get folder entries: %w", err)
+	}
+
+	for _, e := range entries {
+		folderIDs[e.ConversationID] = append(folderIDs[e.ConversationID], e.FolderID)
+	}
+	return folderIDs, nil
+}
+
+// deleteFolderEntries takes a conversation out of every folder of a user who left it
+func deleteFolderEntries(ctx context.Context, entries *ent.FolderConversationClient, conversationID, userID string) error {
+	if _, err := entries.Delete().
+		Where(
+			folderconversation.ConversationIDEQ(conversationID),
+			folderconversation.HasFolderWith(conversationfolder.UserIDEQ(userID)),
+		).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to remove conversation from folders: %w", err)
+	}
+	return nil
+}
+
+// sameIDs reports whether ids lists every key of set exactly once
+func sameIDs[T any](ids []string, set map[string]T) bool {
+	if len(ids) != len(set) {
+		return false
+	}
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if _, ok := set[id]; !ok || seen[id] {
+			return false
+		}
+		seen[id] = true
+	}
+	return true
+}
+
+// broadcastConversationList sends a pin or folder change to every device of its user
+func (s *Services) broadcastConversationList(userID string, data ws.ConversationListData) {
+	if s.WSHub == nil || !s.WSHub.IsUserOnline(userID) {
+		return
+	}
+	s.BroadcastToUser(userID, ws.MessageTypeConversationList, data)
+}
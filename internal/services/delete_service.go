@@ -0,0 +1,231 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"kakashi/chaos/internal/ent"
+	"kakashi/chaos/internal/ent/hiddenmessage"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/pinnedmessage"
+	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/user"
+	"kakashi/chaos/internal/ws"
+)
+
+// maxBulkDeleteMessages bounds the messages deleted by one bulk request
+const maxBulkDeleteMessages = 100
+
+// notHiddenFor leaves out messages the user deleted for themselves
+func notHiddenFor(userID string) predicate.Message {
+	return message.Not(message.HasHiddenForWith(hiddenmessage.UserIDEQ(userID)))
+}
+
+// DeleteMessage soft deletes a message for everyone in its conversation. Senders may delete their own
+// messages within the delete window, group owners and admins may delete any message of their group
+func (s *Services) DeleteMessage(ctx context.Context, messageID, userID string) error {
+	// Validate that user exists
+	_, err := s.ent.User.Query().Where(user.IDEQ(userID)).First(ctx)
+	if err != nil {
+		return fmt.Errorf("user not found: %w", err)
+	}
+
+	msg, err := s.ent.Message.Query().
+		Where(message.IDEQ(messageID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("message not found")
+		}
+		return fmt.Errorf("failed to get message: %w", err)
+	}
+
+	return s.deleteForEveryone(ctx, msg.ConversationID, userID, []*ent.Message{msg})
+}
+
+// DeleteMessageForMe hides a message from the user only, the other participants still see it
+func (s *Services) DeleteMessageForMe(ctx context.Context, messageID, userID string) error {
+	msg, err := s.ent.Message.Query().
+		Where(message.IDEQ(messageID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("message not found")
+		}
+		return fmt.Errorf("failed to get message: %w", err)
+	}
+	// Messages of other conversations are reported as missing
+	if err := s.requireParticipant(ctx, msg.ConversationID, userID); err != nil {
+		if err.Error() == "user is not a participant in this conversation" {
+			return fmt.Errorf("message not found")
+		}
+		return err
+	}
+
+	return s.hideMessages(ctx, msg.ConversationID, userID, []string{msg.ID})
+}
+
+// DeleteMessages deletes up to maxBulkDeleteMessages messages of a conversation at once, for everyone or
+// for the user only. Nothing is deleted unless every message can be
+func (s *Services) DeleteMessages(ctx context.Context, conversationID, userID string, messageIDs []string, forEveryone bool) error {
+	seen := make(map[string]bool, len(messageIDs))
+	ids := make([]string, 0, len(messageIDs))
+	for _, id := range messageIDs {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return fmt.Errorf("no messages to delete")
+	}
+	if len(ids) > maxBulkDeleteMessages {
+		return fmt.Errorf("too many messages to delete")
+	}
+	if err := s.requireParticipant(ctx, conversationID, userID); err != nil {
+		return err
+	}
+
+	messages, err := s.ent.Message.Query().
+		Where(
+			message.IDIn(ids...),
+			message.ConversationIDEQ(conversationID),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get messages: %w", err)
+	}
+	if len(messages) != len(ids) {
+		return fmt.Errorf("message not found")
+	}
+
+	if forEveryone {
+		return s.deleteForEveryone(ctx, conversationID, userID, messages)
+	}
+	return s.hideMessages(ctx, conversationID, userID, ids)
+}
+
+// deleteForEveryone checks that the user may delete every message, then soft deletes and unpins them
+// together and tells the conversation which messages are gone
+func (s *Services) deleteForEveryone(ctx context.Context, conversationID, userID string, messages []*ent.Message) error {
+	var moderator bool
+	checkedModerator := false
+	ids := make([]string, len(messages))
+	for i, msg := range messages {
+		ids[i] = msg.ID
+		if msg.IsDeleted {
+			return fmt.Errorf("message is already deleted")
+		}
+		if msg.SenderID == userID {
+			if s.messageDeleteWindow > 0 && time.Since(msg.CreatedAt) > s.messageDeleteWindow {
+				return fmt.Errorf("message delete window has expired")
+			}
+			continue
+		}
+
+		// Other people's messages need group moderation rights, looked up once per request
+		if !checkedModerator {
+			_, actor, err := s.getGroupMembership(ctx, conversationID, userID)
+			if err != nil && err.Error() != "conversation is not a group" &&
+				err.Error() != "user is not a participant in this conversation" {
+				return err
+			}
+			moderator = err == nil && canManageGroup(actor)
+			checkedModerator = true
+		}
+		if !moderator {
+			return fmt.Errorf("can only delete own messages")
+		}
+	}
+
+	tx, err := s.ent.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Only live messages are updated, so a concurrent delete of any of them fails the whole request
+	deleted, err := tx.Message.Update().
+		Where(
+			message.IDIn(ids...),
+			message.IsDeletedEQ(false),
+		).
+		SetIsDeleted(true).
+		SetDeletedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete message: %w", err))
+	}
+	if deleted != len(ids) {
+		return rollback(tx, fmt.Errorf("message is already deleted"))
+	}
+
+	// Deleted messages cannot stay pinned
+	pins, err := tx.PinnedMessage.Query().
+		Where(pinnedmessage.MessageIDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to get pins: %w", err))
+	}
+	if len(pins) > 0 {
+		if _, err := tx.PinnedMessage.Delete().
+			Where(pinnedmessage.MessageIDIn(ids...)).
+			Exec(ctx); err != nil {
+			return rollback(tx, fmt.Errorf("failed to unpin message: %w", err))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	for _, pin := range pins {
+		s.broadcastPinChange(ctx, conversationID, pin.MessageID, userID, ws.MessageTypeMessageUnpinned)
+	}
+	s.broadcastMessageDeleted(ctx, conversationID, userID, ids, true)
+
+	return nil
+}
+
+// hideMessages deletes messages for the user only. Hiding a message twice is not an error
+func (s *Services) hideMessages(ctx context.Context, conversationID, userID string, messageIDs []string) error {
+	for _, messageID := range messageIDs {
+		err := s.ent.HiddenMessage.Create().
+			SetUserID(userID).
+			SetMessageID(messageID).
+			Exec(ctx)
+		if err != nil && !ent.IsConstraintError(err) {
+			return fmt.Errorf("failed to hide message: %w", err)
+		}
+	}
+
+	s.broadcastMessageDeleted(ctx, conversationID, userID, messageIDs, false)
+
+	return nil
+}
+
+// broadcastMessageDeleted sends message_deleted to the conversation, or only to the user's own devices
+// when the messages were deleted for them alone
+func (s *Services) broadcastMessageDeleted(ctx context.Context, conversationID, userID string, messageIDs []string, forEveryone bool) {
+	if s.WSHub == nil {
+		return
+	}
+
+	data := ws.MessageDeletedData{
+		ConversationID: conversationID,
+		MessageIDs:     messageIDs,
+		DeletedBy:      userID,
+		ForEveryone:    forEveryone,
+	}
+	if !forEveryone {
+		if s.WSHub.IsUserOnline(userID) {
+			s.BroadcastToUsers([]string{userID}, ws.MessageTypeMessageDeleted, data)
+		}
+		return
+	}
+
+	if err := s.BroadcastToConversation(ctx, conversationID, ws.MessageTypeMessageDeleted, data, ""); err != nil {
+		slog.Error("Failed to broadcast message deletion", "conversation_id", conversationID, "error", err)
+	}
+}
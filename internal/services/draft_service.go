@@ -0,0 +1,192 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"kakashi/chaos/internal/ent"
+	"kakashi/chaos/internal/ent/conversationparticipant"
+	"kakashi/chaos/internal/ent/draft"
+	"kakashi/chaos/internal/ws"
+)
+
+// maxDraftLength bounds the draft text in characters
+const maxDraftLength = 10000
+
+// SaveDraft stores the user's draft for a conversation, replacing any previous one. An empty draft
+// without a reply target clears it and returns nil. Other devices of the user receive a draft_updated event
+func (s *Services) SaveDraft(ctx context.Context, userID, conversationID, content, replyToID string) (*ent.Draft, error) {
+	if len([]rune(content)) > maxDraftLength {
+		return nil, fmt.Errorf("draft is too long")
+	}
+	if err := s.requireParticipant(ctx, conversationID, userID); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(content) == "" && replyToID == "" {
+		return nil, s.clearDraft(ctx, userID, conversationID)
+	}
+	if replyToID != "" {
+		if err := s.validateReplyTarget(ctx, conversationID, replyToID); err != nil {
+			return nil, err
+		}
+	}
+
+	saved, err := s.upsertDraft(ctx, userID, conversationID, content, replyToID)
+	if err != nil {
+		// A concurrent save from another device created the row first
+		if !ent.IsConstraintError(err) {
+			return nil, err
+		}
+		if saved, err = s.upsertDraft(ctx, userID, conversationID, content, replyToID); err != nil {
+			return nil, err
+		}
+	}
+
+	s.broadcastDraft(userID, conversationID, saved)
+
+	return saved, nil
+}
+
+// upsertDraft creates or updates the draft row of a user in a conversation
+func (s *Services) upsertDraft(ctx context.Context, userID, conversationID, content, replyToID string) (*ent.Draft, error) {
+	existing, err := s.ent.Draft.Query().
+		Where(
+			draft.UserIDEQ(userID),
+			draft.ConversationIDEQ(conversationID),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get draft: %w", err)
+	}
+
+	if existing == nil {
+		builder := s.ent.Draft.Create().
+			SetUserID(userID).
+			SetConversationID(conversationID).
+			SetContent(content)
+		if replyToID != "" {
+			builder = builder.SetReplyToID(replyToID)
+		}
+		created, err := builder.Save(ctx)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("failed to create draft: %w", err)
+		}
+		return created, nil
+	}
+
+	update := existing.Update().SetContent(content)
+	if replyToID != "" {
+		update = update.SetReplyToID(replyToID)
+	} else {
+		update = update.ClearReplyToID()
+	}
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update draft: %w", err)
+	}
+	return updated, nil
+}
+
+// DeleteDraft clears the user's draft for a conversation
+func (s *Services) DeleteDraft(ctx context.Context, userID, conversationID string) error {
+	if err := s.requireParticipant(ctx, conversationID, userID); err != nil {
+		return err
+	}
+	return s.clearDraft(ctx, userID, conversationID)
+}
+
+// clearDraft deletes a draft and tells the user's devices when there was one
+func (s *Services) clearDraft(ctx context.Context, userID, conversationID string) error {
+	n, err := s.ent.Draft.Delete().
+		Where(
+			draft.UserIDEQ(userID),
+			draft.ConversationIDEQ(conversationID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete draft: %w", err)
+	}
+	if n > 0 {
+		s.broadcastDraft(userID, conversationID, nil)
+	}
+	return nil
+}
+
+// getDrafts returns the unexpired drafts of a user keyed by conversation ID
+func (s *Services) getDrafts(ctx context.Context, userID string, conversationIDs []string) (map[string]*ent.Draft, error) {
+	drafts := make(map[string]*ent.Draft)
+	if len(conversationIDs) == 0 {
+		return drafts, nil
+	}
+
+	query := s.ent.Draft.Query().
+		Where(
+			draft.UserIDEQ(userID),
+			draft.ConversationIDIn(conversationIDs...),
+		)
+	if s.draftTTL > 0 {
+		query = query.Where(draft.UpdatedAtGTE(time.Now().Add(-s.draftTTL)))
+	}
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get drafts: %w", err)
+	}
+
+	for _, d := range rows {
+		drafts[d.ConversationID] = d
+	}
+	return drafts, nil
+}
+
+// deleteExpiredDrafts removes drafts untouched for longer than the draft TTL
+func (s *Services) deleteExpiredDrafts(ctx context.Context) {
+	if s.draftTTL <= 0 {
+		return
+	}
+	if _, err := s.ent.Draft.Delete().
+		Where(draft.UpdatedAtLT(time.Now().Add(-s.draftTTL))).
+		Exec(ctx); err != nil {
+		slog.Error("Failed to delete expired drafts", "error", err)
+	}
+}
+
+// requireParticipant checks that a user participates in a conversation
+func (s *Services) requireParticipant(ctx context.Context, conversationID, userID string) error {
+	isParticipant, err := s.ent.ConversationParticipant.Query().
+		Where(
+			conversationparticipant.ConversationIDEQ(conversationID),
+			conversationparticipant.UserIDEQ(userID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check participant status: %w", err)
+	}
+	if !isParticipant {
+		return fmt.Errorf("user is not a participant in this conversation")
+	}
+	return nil
+}
+
+// broadcastDraft sends a draft change to every device of its user, a nil draft means it was cleared
+func (s *Services) broadcastDraft(userID, conversationID string, d *ent.Draft) {
+	if s.WSHub == nil || !s.WSHub.IsUserOnline(userID) {
+		return
+	}
+
+	data := ws.DraftData{
+		ConversationID: conversationID,
+		Cleared:        d == nil,
+	}
+	if d != nil {
+		data.Content = d.Content
+		data.ReplyToID = d.ReplyToID
+		data.UpdatedAt = d.UpdatedAt.Format(time.RFC3339Nano)
+	}
+	s.BroadcastToUser(userID, ws.MessageTypeDraftUpdated, data)
+}
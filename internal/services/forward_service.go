@@ -0,0 +1,210 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"kakashi/chaos/internal/ent"
+	"kakashi/chaos/internal/ent/conversationparticipant"
+	"kakashi/chaos/internal/ent/message"
+
+	"github.com/google/uuid"
+)
+
+// maxForwardMessages bounds the messages forwarded in one request
+const maxForwardMessages = 25
+
+// MessageLink is a permalink resolved for a viewer. Message and ConversationID are only set when the
+// viewer participates in the source conversation, otherwise the link renders as inaccessible
+type MessageLink struct {
+	MessageID      string       `json:"message_id"`
+	Accessible     bool         `json:"accessible"`
+	ConversationID string       `json:"conversation_id,omitempty"`
+	Message        *ent.Message `json:"message,omitempty"`
+}
+
+// ForwardMessages copies messages, including their attachments, into a conversation the user can send to.
+// Forwards keep the attribution of the original message and arrive in their original order
+func (s *Services) ForwardMessages(ctx context.Context, userID, conversationID string, messageIDs []string) ([]*ent.Message, error) {
+	seen := make(map[string]bool, len(messageIDs))
+	unique := make([]string, 0, len(messageIDs))
+	for _, id := range messageIDs {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	messageIDs = unique
+	if len(messageIDs) == 0 {
+		return nil, fmt.Errorf("no messages to forward")
+	}
+	if len(messageIDs) > maxForwardMessages {
+		return nil, fmt.Errorf("too many messages to forward")
+	}
+
+	if err := s.validateSendPermission(ctx, userID, conversationID); err != nil {
+		return nil, err
+	}
+
+	sources, err := s.ent.Message.Query().
+		Where(
+			message.IDIn(messageIDs...),
+			message.IsDeletedEQ(false),
+		).
+		WithAttachments().
+		Order(ent.Asc(message.FieldCreatedAt), ent.Asc(message.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get messages: %w", err)
+	}
+	if len(sources) != len(messageIDs) {
+		return nil, fmt.Errorf("message not found")
+	}
+
+	// Only messages the user can read may be forwarded, unreadable ones look missing
+	checked := make(map[string]bool)
+	for _, src := range sources {
+		if checked[src.ConversationID] {
+			continue
+		}
+		isParticipant, err := s.ent.ConversationParticipant.Query().
+			Where(
+				conversationparticipant.ConversationIDEQ(src.ConversationID),
+				conversationparticipant.UserIDEQ(userID),
+			).
+			Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check participant status: %w", err)
+		}
+		if !isParticipant {
+			return nil, fmt.Errorf("message not found")
+		}
+		checked[src.ConversationID] = true
+	}
+	for _, src := range sources {
+		switch src.MessageType {
+		case message.MessageTypeText, message.MessageTypeImage, message.MessageTypeFile:
+		default:
+			return nil, fmt.Errorf("cannot forward this message")
+		}
+		if len(src.Edges.Attachments) > 0 && s.attachmentStorage == nil {
+			return nil, fmt.Errorf("attachment storage is not configured")
+		}
+	}
+
+	forwarded := make([]*ent.Message, 0, len(sources))
+	for _, src := range sources {
+		msg, err := s.forwardMessage(ctx, userID, conversationID, src)
+		if err != nil {
+			return nil, err
+		}
+		forwarded = append(forwarded, msg)
+	}
+
+	return forwarded, nil
+}
+
+// forwardMessage sends one forwarded copy of a message, storing its own copy of any attachment
+func (s *Services) forwardMessage(ctx context.Context, userID, conversationID string, src *ent.Message) (*ent.Message, error) {
+	// Forwarding a forward keeps pointing at the original
+	origin := src
+	if src.ForwardedFromMessageID != "" {
+		origin = &ent.Message{ID: src.ForwardedFromMessageID, SenderID: src.ForwardedFromUserID}
+	}
+
+	opts := SendMessageOptions{
+		SuppressEmbeds: src.SuppressEmbeds,
+		forwardedFrom:  origin,
+	}
+	if len(src.Edges.Attachments) > 0 {
+		input, err := s.copyAttachment(ctx, conversationID, src.Edges.Attachments[0])
+		if err != nil {
+			return nil, err
+		}
+		opts.Attachment = input
+	}
+
+	msg, err := s.SendMessageWithOptions(ctx, userID, conversationID, src.Content, opts)
+	if err != nil {
+		if opts.Attachment != nil {
+			if delErr := s.attachmentStorage.Delete(ctx, opts.Attachment.StorageKey); delErr != nil {
+				slog.Error("Failed to delete orphaned attachment", "storage_key", opts.Attachment.StorageKey, "error", delErr)
+			}
+		}
+		return nil, err
+	}
+
+	s.enqueuePendingImages(msg)
+
+	return msg, nil
+}
+
+// copyAttachment stores a copy of an attachment object for another conversation, so each message
+// owns its objects and retention can delete them independently
+func (s *Services) copyAttachment(ctx context.Context, conversationID string, att *ent.Attachment) (*AttachmentInput, error) {
+	// Images are only copied once their metadata has been stripped
+	if err := validateAttachmentVariant(att, ""); err != nil {
+		return nil, err
+	}
+
+	body, err := s.attachmentStorage.Get(ctx, att.StorageKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open attachment: %w", err)
+	}
+	defer body.Close()
+
+	key := fmt.Sprintf("conversations/%s/%s", conversationID, uuid.Must(uuid.NewV7()).String())
+	if err := s.attachmentStorage.Put(ctx, key, body, att.Size, att.ContentType); err != nil {
+		return nil, fmt.Errorf("failed to store attachment: %w", err)
+	}
+
+	return &AttachmentInput{
+		StorageKey:  key,
+		Filename:    att.Filename,
+		ContentType: att.ContentType,
+		Size:        att.Size,
+	}, nil
+}
+
+// ResolveMessageLink resolves a message permalink for a viewer. Missing, deleted, deleted for the viewer
+// and unreadable messages all resolve the same way so links do not reveal whether a message exists
+func (s *Services) ResolveMessageLink(ctx context.Context, messageID, viewerID string) (*MessageLink, error) {
+	link := &MessageLink{MessageID: messageID}
+
+	msg, err := s.ent.Message.Query().
+		Where(
+			message.IDEQ(messageID),
+			message.IsDeletedEQ(false),
+			notHiddenFor(viewerID),
+		).
+		WithSender().
+		WithAttachments().
+		WithMentions().
+		WithForwardedFromUser().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return link, nil
+		}
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+
+	isParticipant, err := s.ent.ConversationParticipant.Query().
+		Where(
+			conversationparticipant.ConversationIDEQ(msg.ConversationID),
+			conversationparticipant.UserIDEQ(viewerID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check participant status: %w", err)
+	}
+	if !isParticipant {
+		return link, nil
+	}
+
+	link.Accessible = true
+	link.ConversationID = msg.ConversationID
+	link.Message = msg
+	return link, nil
+}
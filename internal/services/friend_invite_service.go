@@ -0,0 +1,320 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"kakashi/chaos/internal/ent"
+	"kakashi/chaos/internal/ent/friend"
+	"kakashi/chaos/internal/ent/friendinvite"
+	"kakashi/chaos/internal/ent/friendinviteuse"
+	"kakashi/chaos/internal/ent/notification"
+	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// friendInviteCodeLength is the length of generated friend invite codes
+const friendInviteCodeLength = 10
+
+// FriendInviteRedemption represents the outcome of redeeming a friend invite
+type FriendInviteRedemption struct {
+	Owner  *ent.User `json:"owner"`
+	Status string    `json:"status"`
+}
+
+// CreateFriendInvite generates a new shareable friend invite for the owner
+func (s *Services) CreateFriendInvite(ctx context.Context, ownerID string, autoAccept bool, maxUses int, expiresAt *time.Time) (*ent.FriendInvite, error) {
+	// Validate that owner exists
+	_, err := s.ent.User.Query().Where(user.IDEQ(ownerID)).First(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("owner not found: %w", err)
+	}
+
+	if maxUses < 0 {
+		return nil, fmt.Errorf("max uses cannot be negative")
+	}
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, fmt.Errorf("expiry must be in the future")
+	}
+
+	code, err := s.GenerateRandomString(friendInviteCodeLength)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate invite code: %w", err)
+	}
+
+	builder := s.ent.FriendInvite.Create().
+		SetOwnerID(ownerID).
+		SetCode(code).
+		SetAutoAccept(autoAccept).
+		SetMaxUses(maxUses)
+	if expiresAt != nil {
+		builder = builder.SetExpiresAt(*expiresAt)
+	}
+
+	invite, err := builder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create friend invite: %w", err)
+	}
+
+	return invite, nil
+}
+
+// GetFriendInvites returns all friend invites created by the owner, newest first
+func (s *Services) GetFriendInvites(ctx context.Context, ownerID string) ([]*ent.FriendInvite, error) {
+	invites, err := s.ent.FriendInvite.Query().
+		Where(friendinvite.OwnerIDEQ(ownerID)).
+		WithRedemptions(func(q *ent.FriendInviteUseQuery) {
+			q.WithUser()
+		}).
+		Order(ent.Desc(friendinvite.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get friend invites: %w", err)
+	}
+
+	return invites, nil
+}
+
+// GetFriendInviteByCode returns an invite and its owner for previewing before redeeming
+func (s *Services) GetFriendInviteByCode(ctx context.Context, code string) (*ent.FriendInvite, error) {
+	invite, err := s.ent.FriendInvite.Query().
+		Where(friendinvite.CodeEQ(code)).
+		WithOwner().
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("friend invite not found")
+		}
+		return nil, fmt.Errorf("failed to get friend invite: %w", err)
+	}
+
+	if err := validateFriendInviteUsable(invite); err != nil {
+		return nil, err
+	}
+
+	return invite, nil
+}
+
+// RevokeFriendInvite revokes an invite so it can no longer be redeemed
+func (s *Services) RevokeFriendInvite(ctx context.Context, inviteID, ownerID string) error {
+	updated, err := s.ent.FriendInvite.Update().
+		Where(
+			friendinvite.IDEQ(inviteID),
+			friendinvite.OwnerIDEQ(ownerID),
+			friendinvite.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to revoke friend invite: %w", err)
+	}
+
+	if updated == 0 {
+		return fmt.Errorf("friend invite not found")
+	}
+
+	return nil
+}
+
+// RedeemFriendInvite redeems an invite code, creating an accepted friendship or a
+// pending request depending on the owner's auto accept setting
+func (s *Services) RedeemFriendInvite(ctx context.Context, code, userID string) (*FriendInviteRedemption, error) {
+	// Validate that redeemer exists
+	redeemer, err := s.ent.User.Query().Where(user.IDEQ(userID)).First(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	invite, err := s.GetFriendInviteByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	owner := invite.Edges.Owner
+
+	if invite.OwnerID == userID {
+		return nil, fmt.Errorf("cannot redeem your own friend invite")
+	}
+
+	// Check if users are blocked
+	isBlocked, err := s.IsBlocked(ctx, userID, invite.OwnerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check block status: %w", err)
+	}
+	if isBlocked {
+		return nil, fmt.Errorf("cannot redeem friend invite from blocked user")
+	}
+
+	// Check if friendship already exists (in any direction)
+	existingFriend, err := s.ent.Friend.Query().
+		Where(
+			friend.Or(
+				friend.And(
+					friend.RequesterIDEQ(userID),
+					friend.AddresseeIDEQ(invite.OwnerID),
+				),
+				friend.And(
+					friend.RequesterIDEQ(invite.OwnerID),
+					friend.AddresseeIDEQ(userID),
+				),
+			),
+		).
+		First(ctx)
+	if err == nil {
+		if existingFriend.Status == friend.StatusAccepted {
+			return nil, fmt.Errorf("users are already friends")
+		}
+		if existingFriend.Status == friend.StatusPending && !invite.AutoAccept {
+			return nil, fmt.Errorf("friend request already exists")
+		}
+	} else if !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to check existing friendship: %w", err)
+	}
+
+	alreadyRedeemed, err := s.ent.FriendInviteUse.Query().
+		Where(
+			friendinviteuse.InviteIDEQ(invite.ID),
+			friendinviteuse.UserIDEQ(userID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check invite usage: %w", err)
+	}
+	if alreadyRedeemed {
+		return nil, fmt.Errorf("friend invite already redeemed")
+	}
+
+	status := friend.StatusPending
+	result := friendinviteuse.ResultPending
+	if invite.AutoAccept {
+		status = friend.StatusAccepted
+		result = friendinviteuse.ResultAccepted
+	}
+
+	tx, err := s.ent.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Claim a use atomically so concurrent redemptions cannot exceed max uses
+	claimed, err := tx.FriendInvite.Update().
+		Where(
+			friendinvite.IDEQ(invite.ID),
+			friendinvite.RevokedAtIsNil(),
+			friendinvite.Or(
+				friendinvite.MaxUsesEQ(0),
+				predicate.FriendInvite(func(sel *sql.Selector) {
+					sel.Where(sql.ColumnsLT(sel.C(friendinvite.FieldUses), sel.C(friendinvite.FieldMaxUses)))
+				}),
+			),
+		).
+		AddUses(1).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to claim friend invite: %w", err))
+	}
+	if claimed == 0 {
+		return nil, rollback(tx, fmt.Errorf("friend invite has reached its maximum uses"))
+	}
+
+	_, err = tx.FriendInviteUse.Create().
+		SetInviteID(invite.ID).
+		SetUserID(userID).
+		SetResult(result).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, rollback(tx, fmt.Errorf("friend invite already redeemed"))
+		}
+		return nil, rollback(tx, fmt.Errorf("failed to record invite usage: %w", err))
+	}
+
+	if existingFriend != nil {
+		// Reuse the existing pending or declined record between the two users, turned around so the
+		// redeemer is the requester whichever way it pointed before
+		_, err = tx.Friend.UpdateOneID(existingFriend.ID).
+			SetRequesterID(userID).
+			SetAddresseeID(invite.OwnerID).
+			SetStatus(status).
+			Save(ctx)
+	} else {
+		_, err = tx.Friend.Create().
+			SetRequesterID(userID).
+			SetAddresseeID(invite.OwnerID).
+			SetStatus(status).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to create friendship: %w", err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit friend invite redemption: %w", err)
+	}
+
+	s.notifyFriendInviteRedeemed(ctx, owner, redeemer, status)
+
+	return &FriendInviteRedemption{
+		Owner:  owner,
+		Status: string(status),
+	}, nil
+}
+
+// notifyFriendInviteRedeemed notifies both the invite owner and the redeemer
+func (s *Services) notifyFriendInviteRedeemed(ctx context.Context, owner, redeemer *ent.User, status friend.Status) {
+	ownerTitle := "Friend Invite Used"
+	ownerContent := fmt.Sprintf("%s sent you a friend request using your invite link", redeemer.Username)
+	redeemerTitle := "Friend Request Sent"
+	redeemerContent := fmt.Sprintf("Your friend request to %s is waiting for approval", owner.Username)
+	if status == friend.StatusAccepted {
+		ownerContent = fmt.Sprintf("%s is now your friend using your invite link", redeemer.Username)
+		redeemerTitle = "Friend Added"
+		redeemerContent = fmt.Sprintf("You are now friends with %s", owner.Username)
+	}
+
+	_, err := s.CreateNotification(ctx, owner.ID, string(notification.TypeFriendInvite), ownerTitle, ownerContent, &redeemer.ID, nil)
+	if err != nil {
+		// Log error but don't fail the redemption
+		fmt.Printf("Failed to create friend invite notification: %v\n", err)
+	}
+
+	_, err = s.CreateNotification(ctx, redeemer.ID, string(notification.TypeFriendInvite), redeemerTitle, redeemerContent, &owner.ID, nil)
+	if err != nil {
+		// Log error but don't fail the redemption
+		fmt.Printf("Failed to create friend invite notification: %v\n", err)
+	}
+
+	// Send real-time friendship events if WebSocket hub is available
+	if s.WSHub != nil {
+		if status == friend.StatusAccepted {
+			s.BroadcastFriendAcceptedNotification(owner.ID, redeemer.ID, redeemer.Username)
+			s.BroadcastFriendAcceptedNotification(redeemer.ID, owner.ID, owner.Username)
+		} else {
+			s.BroadcastFriendRequestNotification(owner.ID, redeemer.ID, redeemer.Username)
+		}
+	}
+}
+
+// validateFriendInviteUsable checks that an invite is neither revoked, expired nor used up
+func validateFriendInviteUsable(invite *ent.FriendInvite) error {
+	if !invite.RevokedAt.IsZero() {
+		return fmt.Errorf("friend invite has been revoked")
+	}
+	if !invite.ExpiresAt.IsZero() && time.Now().After(invite.ExpiresAt) {
+		return fmt.Errorf("friend invite has expired")
+	}
+	if invite.MaxUses > 0 && invite.Uses >= invite.MaxUses {
+		return fmt.Errorf("friend invite has reached its maximum uses")
+	}
+	return nil
+}
+
+// rollback rolls back a transaction and returns the original error
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+	}
+	return err
+}
@@ -0,0 +1,636 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"kakashi/chaos/internal/ent"
+	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/conversationparticipant"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/user"
+	"kakashi/chaos/internal/ws"
+)
+
+const (
+	// maxGroupParticipants is the maximum number of participants in a group conversation
+	maxGroupParticipants = 100
+	// maxGroupNameLength mirrors the conversation name column length
+	maxGroupNameLength = 100
+)
+
+// CreateGroupConversation creates a group conversation owned by the creator with an initial member list
+func (s *Services) CreateGroupConversation(ctx context.Context, creatorID, name, iconURL string, memberIDs []string) (*ent.Conversation, error) {
+	// Validate that creator exists
+	creator, err := s.ent.User.Query().Where(user.IDEQ(creatorID)).First(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("creator not found: %w", err)
+	}
+
+	name, err = normalizeGroupName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deduplicate members and drop the creator, who joins as owner
+	memberIDs = uniqueUserIDs(memberIDs, creatorID)
+	if len(memberIDs) == 0 {
+		return nil, fmt.Errorf("group must include at least one other member")
+	}
+	if len(memberIDs)+1 > maxGroupParticipants {
+		return nil, fmt.Errorf("group participant limit reached")
+	}
+
+	members, err := s.validateGroupInvitees(ctx, creatorID, memberIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.ent.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	builder := tx.Conversation.Create().
+		SetType(conversation.TypeGroup).
+		SetName(name).
+		SetLastMessageAt(time.Now())
+	if iconURL != "" {
+		builder = builder.SetIconURL(iconURL)
+	}
+	conv, err := builder.Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to create conversation: %w", err))
+	}
+
+	participants := []*ent.ConversationParticipantCreate{
+		tx.ConversationParticipant.Create().
+			SetConversationID(conv.ID).
+			SetUserID(creatorID).
+			SetRole(conversationparticipant.RoleOwner).
+			SetJoinedAt(time.Now()),
+	}
+	for _, memberID := range memberIDs {
+		participants = append(participants, tx.ConversationParticipant.Create().
+			SetConversationID(conv.ID).
+			SetUserID(memberID).
+			SetRole(conversationparticipant.RoleMember).
+			SetJoinedAt(time.Now()))
+	}
+	if _, err := tx.ConversationParticipant.CreateBulk(participants...).Save(ctx); err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to add participants: %w", err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.postGroupUpdate(ctx, conv.ID, creatorID,
+		fmt.Sprintf("%s created the group \"%s\" with %s", creator.Username, name, joinUsernames(members)),
+		ws.ConversationUpdateData{
+			ConversationID: conv.ID,
+			Action:         "created",
+			ActorID:        creatorID,
+			TargetUserIDs:  memberIDs,
+			Name:           name,
+			IconURL:        iconURL,
+		})
+
+	return s.loadConversationWithParticipants(ctx, conv.ID)
+}
+
+// AddGroupParticipants adds friends of the actor to a group conversation
+func (s *Services) AddGroupParticipants(ctx context.Context, conversationID, actorID string, userIDs []string) error {
+	_, actor, err := s.getGroupMembership(ctx, conversationID, actorID)
+	if err != nil {
+		return err
+	}
+	if !canManageGroup(actor) {
+		return fmt.Errorf("insufficient permissions")
+	}
+
+	userIDs = uniqueUserIDs(userIDs, actorID)
+	if len(userIDs) == 0 {
+		return fmt.Errorf("no users to add")
+	}
+
+	members, err := s.validateGroupInvitees(ctx, actorID, userIDs)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.ent.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Concurrent additions wait on the conversation lock, so the size check below holds until commit
+	if err := tx.LockConversation(ctx, conversationID); err != nil {
+		if ent.IsNotFound(err) {
+			return rollback(tx, fmt.Errorf("conversation not found: %w", err))
+		}
+		return rollback(tx, err)
+	}
+
+	existing, err := tx.ConversationParticipant.Query().
+		Where(conversationparticipant.ConversationIDEQ(conversationID)).
+		All(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to get conversation participants: %w", err))
+	}
+	for _, p := range existing {
+		for _, userID := range userIDs {
+			if p.UserID == userID {
+				return rollback(tx, fmt.Errorf("user is already a participant"))
+			}
+		}
+	}
+	if len(existing)+len(userIDs) > maxGroupParticipants {
+		return rollback(tx, fmt.Errorf("group participant limit reached"))
+	}
+
+	builders := make([]*ent.ConversationParticipantCreate, 0, len(userIDs))
+	for _, userID := range userIDs {
+		builders = append(builders, tx.ConversationParticipant.Create().
+			SetConversationID(conversationID).
+			SetUserID(userID).
+			SetRole(conversationparticipant.RoleMember).
+			SetJoinedAt(time.Now()))
+	}
+	if _, err := tx.ConversationParticipant.CreateBulk(builders...).Save(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			return rollback(tx, fmt.Errorf("user is already a participant"))
+		}
+		return rollback(tx, fmt.Errorf("failed to add participants: %w", err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.postGroupUpdate(ctx, conversationID, actorID,
+		fmt.Sprintf("%s added %s", actor.Edges.User.Username, joinUsernames(members)),
+		ws.ConversationUpdateData{
+			ConversationID: conversationID,
+			Action:         "participants_added",
+			ActorID:        actorID,
+			TargetUserIDs:  userIDs,
+		})
+
+	return nil
+}
+
+// RemoveGroupParticipant removes another participant from a group conversation
+func (s *Services) RemoveGroupParticipant(ctx context.Context, conversationID, actorID, targetUserID string) error {
+	if actorID == targetUserID {
+		return s.LeaveGroupConversation(ctx, conversationID, actorID)
+	}
+
+	_, actor, err := s.getGroupMembership(ctx, conversationID, actorID)
+	if err != nil {
+		return err
+	}
+	if !canManageGroup(actor) {
+		return fmt.Errorf("insufficient permissions")
+	}
+
+	target, err := s.ent.ConversationParticipant.Query().
+		Where(
+			conversationparticipant.ConversationIDEQ(conversationID),
+			conversationparticipant.UserIDEQ(targetUserID),
+		).
+		WithUser().
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("participant not found")
+		}
+		return fmt.Errorf("failed to get participant: %w", err)
+	}
+
+	// Owners cannot be removed and admins can only be removed by the owner
+	if target.Role == conversationparticipant.RoleOwner {
+		return fmt.Errorf("cannot remove the group owner")
+	}
+	if target.Role == conversationparticipant.RoleAdmin && actor.Role != conversationparticipant.RoleOwner {
+		return fmt.Errorf("insufficient permissions")
+	}
+
+	if err := s.ent.ConversationParticipant.DeleteOne(target).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to remove participant: %w", err)
+	}
+	if err := deleteFolderEntries(ctx, s.ent.FolderConversation, conversationID, targetUserID); err != nil {
+		return err
+	}
+
+	s.postGroupUpdate(ctx, conversationID, actorID,
+		fmt.Sprintf("%s removed %s", actor.Edges.User.Username, target.Edges.User.Username),
+		ws.ConversationUpdateData{
+			ConversationID: conversationID,
+			Action:         "participant_removed",
+			ActorID:        actorID,
+			TargetUserIDs:  []string{targetUserID},
+		}, targetUserID)
+
+	return nil
+}
+
+// LeaveGroupConversation removes the user from a group conversation, handing ownership
+// to the longest standing admin (or member) when the owner leaves
+func (s *Services) LeaveGroupConversation(ctx context.Context, conversationID, userID string) error {
+	_, participant, err := s.getGroupMembership(ctx, conversationID, userID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.ent.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	if err := tx.ConversationParticipant.DeleteOneID(participant.ID).Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to leave conversation: %w", err))
+	}
+	if err := deleteFolderEntries(ctx, tx.FolderConversation, conversationID, userID); err != nil {
+		return rollback(tx, err)
+	}
+
+	var newOwner *ent.ConversationParticipant
+	if participant.Role == conversationparticipant.RoleOwner {
+		newOwner, err = tx.ConversationParticipant.Query().
+			Where(conversationparticipant.ConversationIDEQ(conversationID)).
+			Order(
+				ent.Asc(conversationparticipant.FieldRole),
+				ent.Asc(conversationparticipant.FieldJoinedAt),
+			).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return rollback(tx, fmt.Errorf("failed to find new owner: %w", err))
+		}
+		if newOwner != nil {
+			newOwner, err = newOwner.Update().
+				SetRole(conversationparticipant.RoleOwner).
+				Save(ctx)
+			if err != nil {
+				return rollback(tx, fmt.Errorf("failed to transfer ownership: %w", err))
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.postGroupUpdate(ctx, conversationID, userID,
+		fmt.Sprintf("%s left the group", participant.Edges.User.Username),
+		ws.ConversationUpdateData{
+			ConversationID: conversationID,
+			Action:         "participant_left",
+			ActorID:        userID,
+			TargetUserIDs:  []string{userID},
+		}, userID)
+
+	if newOwner != nil && s.WSHub != nil {
+		err = s.BroadcastToConversation(ctx, conversationID, ws.MessageTypeConversationUpdated, ws.ConversationUpdateData{
+			ConversationID: conversationID,
+			Action:         "role_changed",
+			ActorID:        userID,
+			TargetUserIDs:  []string{newOwner.UserID},
+			Role:           string(conversationparticipant.RoleOwner),
+		}, "")
+		if err != nil {
+			slog.Error("Failed to broadcast ownership transfer", "conversation_id", conversationID, "error", err)
+		}
+	}
+
+	return nil
+}
+
+// RenameGroupConversation changes the name of a group conversation
+func (s *Services) RenameGroupConversation(ctx context.Context, conversationID, actorID, name string) (*ent.Conversation, error) {
+	_, actor, err := s.getGroupMembership(ctx, conversationID, actorID)
+	if err != nil {
+		return nil, err
+	}
+	if !canManageGroup(actor) {
+		return nil, fmt.Errorf("insufficient permissions")
+	}
+
+	name, err = normalizeGroupName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	conv, err := s.ent.Conversation.UpdateOneID(conversationID).
+		SetName(name).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rename conversation: %w", err)
+	}
+
+	s.postGroupUpdate(ctx, conversationID, actorID,
+		fmt.Sprintf("%s renamed the group to \"%s\"", actor.Edges.User.Username, name),
+		ws.ConversationUpdateData{
+			ConversationID: conversationID,
+			Action:         "renamed",
+			ActorID:        actorID,
+			Name:           name,
+		})
+
+	return conv, nil
+}
+
+// SetGroupConversationIcon changes or clears the icon of a group conversation
+func (s *Services) SetGroupConversationIcon(ctx context.Context, conversationID, actorID, iconURL string) (*ent.Conversation, error) {
+	_, actor, err := s.getGroupMembership(ctx, conversationID, actorID)
+	if err != nil {
+		return nil, err
+	}
+	if !canManageGroup(actor) {
+		return nil, fmt.Errorf("insufficient permissions")
+	}
+
+	update := s.ent.Conversation.UpdateOneID(conversationID)
+	content := fmt.Sprintf("%s changed the group icon", actor.Edges.User.Username)
+	if iconURL == "" {
+		update = update.ClearIconURL()
+		content = fmt.Sprintf("%s removed the group icon", actor.Edges.User.Username)
+	} else {
+		update = update.SetIconURL(iconURL)
+	}
+
+	conv, err := update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update conversation icon: %w", err)
+	}
+
+	s.postGroupUpdate(ctx, conversationID, actorID, content,
+		ws.ConversationUpdateData{
+			ConversationID: conversationID,
+			Action:         "icon_changed",
+			ActorID:        actorID,
+			IconURL:        iconURL,
+		})
+
+	return conv, nil
+}
+
+// UpdateGroupParticipantRole changes the role of a participant; only the owner may
+// promote or demote admins, and assigning owner transfers ownership
+func (s *Services) UpdateGroupParticipantRole(ctx context.Context, conversationID, actorID, targetUserID, role string) error {
+	newRole := conversationparticipant.Role(role)
+	if err := conversationparticipant.RoleValidator(newRole); err != nil {
+		return fmt.Errorf("invalid role")
+	}
+
+	_, actor, err := s.getGroupMembership(ctx, conversationID, actorID)
+	if err != nil {
+		return err
+	}
+	if actor.Role != conversationparticipant.RoleOwner {
+		return fmt.Errorf("insufficient permissions")
+	}
+	if actorID == targetUserID {
+		return fmt.Errorf("cannot change your own role")
+	}
+
+	target, err := s.ent.ConversationParticipant.Query().
+		Where(
+			conversationparticipant.ConversationIDEQ(conversationID),
+			conversationparticipant.UserIDEQ(targetUserID),
+		).
+		WithUser().
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("participant not found")
+		}
+		return fmt.Errorf("failed to get participant: %w", err)
+	}
+
+	tx, err := s.ent.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	if _, err := tx.ConversationParticipant.UpdateOneID(target.ID).SetRole(newRole).Save(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to update role: %w", err))
+	}
+	if newRole == conversationparticipant.RoleOwner {
+		// Ownership transfer demotes the previous owner to admin
+		if _, err := tx.ConversationParticipant.UpdateOneID(actor.ID).SetRole(conversationparticipant.RoleAdmin).Save(ctx); err != nil {
+			return rollback(tx, fmt.Errorf("failed to update role: %w", err))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.postGroupUpdate(ctx, conversationID, actorID,
+		fmt.Sprintf("%s made %s %s", actor.Edges.User.Username, target.Edges.User.Username, roleDisplayName(newRole)),
+		ws.ConversationUpdateData{
+			ConversationID: conversationID,
+			Action:         "role_changed",
+			ActorID:        actorID,
+			TargetUserIDs:  []string{targetUserID},
+			Role:           role,
+		})
+
+	return nil
+}
+
+// getGroupMembership loads a group conversation and the user's participant record
+func (s *Services) getGroupMembership(ctx context.Context, conversationID, userID string) (*ent.Conversation, *ent.ConversationParticipant, error) {
+	conv, err := s.ent.Conversation.Query().Where(conversation.IDEQ(conversationID)).First(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("conversation not found: %w", err)
+	}
+	if conv.Type != conversation.TypeGroup {
+		return nil, nil, fmt.Errorf("conversation is not a group")
+	}
+
+	participant, err := s.ent.ConversationParticipant.Query().
+		Where(
+			conversationparticipant.ConversationIDEQ(conversationID),
+			conversationparticipant.UserIDEQ(userID),
+		).
+		WithUser().
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, fmt.Errorf("user is not a participant in this conversation")
+		}
+		return nil, nil, fmt.Errorf("failed to get participant: %w", err)
+	}
+
+	return conv, participant, nil
+}
+
+// validateGroupInvitees checks that every invitee exists, is a friend of the inviter and is not blocked
+func (s *Services) validateGroupInvitees(ctx context.Context, inviterID string, userIDs []string) ([]*ent.User, error) {
+	users, err := s.ent.User.Query().Where(user.IDIn(userIDs...)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+	if len(users) != len(userIDs) {
+		return nil, fmt.Errorf("member not found")
+	}
+
+	for _, u := range users {
+		areFriends, err := s.AreFriends(ctx, inviterID, u.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check friendship status: %w", err)
+		}
+		if !areFriends {
+			return nil, fmt.Errorf("can only add friends to a group")
+		}
+
+		isBlocked, err := s.IsBlocked(ctx, inviterID, u.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check block status: %w", err)
+		}
+		if isBlocked {
+			return nil, fmt.Errorf("cannot add blocked user to group")
+		}
+	}
+
+	return users, nil
+}
+
+// postGroupUpdate posts a system message and broadcasts the change to the group,
+// including any users that are no longer participants
+func (s *Services) postGroupUpdate(ctx context.Context, conversationID, actorID, content string, data ws.ConversationUpdateData, extraUserIDs ...string) {
+	if _, err := s.createSystemMessage(ctx, conversationID, actorID, content); err != nil {
+		// Log error but don't fail the group change
+		slog.Error("Failed to create system message", "conversation_id", conversationID, "error", err)
+	}
+
+	if s.WSHub == nil {
+		return
+	}
+
+	participants, err := s.getConversationParticipants(ctx, conversationID)
+	if err != nil {
+		slog.Error("Failed to broadcast conversation update", "conversation_id", conversationID, "error", err)
+		return
+	}
+
+	var onlineTargetUsers []string
+	for _, userID := range append(participants, extraUserIDs...) {
+		if s.WSHub.IsUserOnline(userID) {
+			onlineTargetUsers = append(onlineTargetUsers, userID)
+		}
+	}
+
+	if len(onlineTargetUsers) > 0 {
+		s.BroadcastToUsers(onlineTargetUsers, ws.MessageTypeConversationUpdated, data)
+	}
+}
+
+// createSystemMessage creates a system message in a conversation attributed to the actor
+func (s *Services) createSystemMessage(ctx context.Context, conversationID, actorID, content string) (*ent.Message, error) {
+	msg, err := s.ent.Message.Create().
+		SetConversationID(conversationID).
+		SetSenderID(actorID).
+		SetContent(content).
+		SetMessageType(message.MessageTypeSystem).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create system message: %w", err)
+	}
+
+	// Update conversation's last_message_at
+	_, err = s.ent.Conversation.UpdateOneID(conversationID).
+		SetLastMessageAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update conversation timestamp: %w", err)
+	}
+
+	msg, err = s.ent.Message.Query().
+		Where(message.IDEQ(msg.ID)).
+		WithSender().
+		First(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load message with sender: %w", err)
+	}
+
+	if s.WSHub != nil {
+		if err := s.BroadcastMessageNotification(ctx, msg); err != nil {
+			slog.Error("Failed to broadcast system message", "message_id", msg.ID, "error", err)
+		}
+	}
+
+	return msg, nil
+}
+
+// loadConversationWithParticipants loads a conversation with its participants and their users
+func (s *Services) loadConversationWithParticipants(ctx context.Context, conversationID string) (*ent.Conversation, error) {
+	conv, err := s.ent.Conversation.Query().
+		Where(conversation.IDEQ(conversationID)).
+		WithParticipants(func(q *ent.ConversationParticipantQuery) {
+			q.WithUser()
+		}).
+		First(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load conversation: %w", err)
+	}
+
+	return conv, nil
+}
+
+// canManageGroup reports whether a participant may change group membership and settings
+func canManageGroup(participant *ent.ConversationParticipant) bool {
+	return participant.Role == conversationparticipant.RoleOwner || participant.Role == conversationparticipant.RoleAdmin
+}
+
+// normalizeGroupName trims and validates a group name
+func normalizeGroupName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", fmt.Errorf("group name is required")
+	}
+	if len([]rune(name)) > maxGroupNameLength {
+		return "", fmt.Errorf("group name is too long")
+	}
+	return name, nil
+}
+
+// uniqueUserIDs removes duplicates, empty IDs and the excluded user from a list of IDs
+func uniqueUserIDs(userIDs []string, excludeUserID string) []string {
+	seen := make(map[string]bool, len(userIDs))
+	result := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		if id == "" || id == excludeUserID || seen[id] {
+			continue
+		}
+		seen[id] = true
+		result = append(result, id)
+	}
+	return result
+}
+
+// joinUsernames formats a list of users for system messages
+func joinUsernames(users []*ent.User) string {
+	names := make([]string, len(users))
+	for i, u := range users {
+		names[i] = u.Username
+	}
+	return strings.Join(names, ", ")
+}
+
+// roleDisplayName returns the wording used for a role in system messages
+func roleDisplayName(role conversationparticipant.Role) string {
+	switch role {
+	case conversationparticipant.RoleOwner:
+		return "the owner"
+	case conversationparticipant.RoleAdmin:
+		return "an admin"
+	default:
+		return "a member"
+	}
+}
@@ -0,0 +1,322 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"kakashi/chaos/internal/ent"
+	"kakashi/chaos/internal/ent/attachment"
+	"kakashi/chaos/internal/ent/guild"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/user"
+	"kakashi/chaos/internal/imaging"
+	"kakashi/chaos/internal/storage"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// imageQueueSize bounds the number of attachments waiting for the image workers
+	imageQueueSize = 256
+	// imageThumbnailSize is the bounding box of attachment thumbnails
+	imageThumbnailSize = 320
+	// imageSweepInterval is how often pending attachments that missed the queue are picked up again
+	imageSweepInterval = time.Minute
+	// squareImageSize is the edge length of avatars and guild icons
+	squareImageSize = 512
+	// publicMediaPath is the route serving avatars and guild icons
+	publicMediaPath = "/api/v1/media/"
+)
+
+// publicMediaPrefixes are the storage key prefixes that may be served without authentication
+var publicMediaPrefixes = []string{"avatars/", "guild-icons/"}
+
+// StartImageWorkers starts background workers that process pending image attachments until ctx is done
+func (s *Services) StartImageWorkers(ctx context.Context, workers int) {
+	for i := 0; i < workers; i++ {
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case attachmentID := <-s.imageJobs:
+					s.processAttachmentImage(ctx, attachmentID)
+				}
+			}
+		}()
+	}
+
+	// Periodically requeue attachments left pending by a full queue or a restart
+	go func() {
+		s.sweepPendingImages(ctx)
+		ticker := time.NewTicker(imageSweepInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.sweepPendingImages(ctx)
+			}
+		}
+	}()
+}
+
+// enqueueImageProcessing schedules an attachment for processing without blocking the caller
+func (s *Services) enqueueImageProcessing(attachmentID string) {
+	select {
+	case s.imageJobs <- attachmentID:
+	default:
+		// The sweep picks it up once the queue drains
+		slog.Warn("Image processing queue is full", "attachment_id", attachmentID)
+	}
+}
+
+// sweepPendingImages requeues attachments that have been pending for longer than a sweep interval
+func (s *Services) sweepPendingImages(ctx context.Context) {
+	pending, err := s.ent.Attachment.Query().
+		Where(
+			attachment.ProcessingStatusEQ(attachment.ProcessingStatusPending),
+			attachment.UpdatedAtLT(time.Now().Add(-imageSweepInterval)),
+		).
+		Order(ent.Asc(attachment.FieldCreatedAt)).
+		Limit(imageQueueSize / 2).
+		IDs(ctx)
+	if err != nil {
+		slog.Error("Failed to get pending image attachments", "error", err)
+		return
+	}
+
+	for _, id := range pending {
+		s.enqueueImageProcessing(id)
+	}
+}
+
+// processAttachmentImage strips metadata, records dimensions and blurhash, stores a thumbnail
+// and tells the conversation the message changed
+func (s *Services) processAttachmentImage(ctx context.Context, attachmentID string) {
+	att, err := s.ent.Attachment.Get(ctx, attachmentID)
+	if err != nil {
+		slog.Error("Failed to get attachment for processing", "attachment_id", attachmentID, "error", err)
+		return
+	}
+	if att.ProcessingStatus != attachment.ProcessingStatusPending || s.attachmentStorage == nil {
+		return
+	}
+
+	update := s.ent.Attachment.UpdateOneID(att.ID)
+	if err := s.processStoredImage(ctx, att, update); err != nil {
+		slog.Error("Failed to process image attachment", "attachment_id", att.ID, "error", err)
+		// The original still carries its metadata and is never served, so it is not kept either
+		if err := s.attachmentStorage.Delete(ctx, att.StorageKey); err != nil {
+			slog.Error("Failed to delete unprocessed image", "attachment_id", att.ID, "error", err)
+		}
+		update = s.ent.Attachment.UpdateOneID(att.ID).
+			SetProcessingStatus(attachment.ProcessingStatusFailed)
+	}
+
+	if _, err := update.Save(ctx); err != nil {
+		slog.Error("Failed to update processed attachment", "attachment_id", att.ID, "error", err)
+		return
+	}
+
+	if s.WSHub == nil {
+		return
+	}
+
+	msg, err := s.ent.Message.Query().
+		Where(message.IDEQ(att.MessageID)).
+		WithSender().
+		WithAttachments().
+		WithLinkPreviews(orderedLinkPreviews).
+		WithMentions().
+		First(ctx)
+	if err != nil {
+		slog.Error("Failed to load message for processed attachment", "attachment_id", att.ID, "error", err)
+		return
+	}
+	if err := s.BroadcastMessageUpdated(ctx, msg, ""); err != nil {
+		slog.Error("Failed to broadcast processed attachment", "message_id", msg.ID, "error", err)
+	}
+}
+
+// processStoredImage rewrites the stored image without metadata and stores its thumbnail
+func (s *Services) processStoredImage(ctx context.Context, att *ent.Attachment, update *ent.AttachmentUpdateOne) error {
+	object, err := s.attachmentStorage.Get(ctx, att.StorageKey)
+	if err != nil {
+		return fmt.Errorf("failed to open image: %w", err)
+	}
+	data, err := io.ReadAll(object)
+	object.Close()
+	if err != nil {
+		return fmt.Errorf("failed to read image: %w", err)
+	}
+
+	result, err := imaging.Process(data, att.ContentType, imageThumbnailSize)
+	if err != nil {
+		return err
+	}
+
+	// Thumbnail keys are derived from the original so reprocessing overwrites instead of leaking objects
+	thumbnailKey := att.StorageKey + "-thumbnail"
+	if err := s.attachmentStorage.Put(ctx, thumbnailKey, bytes.NewReader(result.Thumbnail), int64(len(result.Thumbnail)), result.ThumbnailContentType); err != nil {
+		return fmt.Errorf("failed to store thumbnail: %w", err)
+	}
+	if err := s.attachmentStorage.Put(ctx, att.StorageKey, bytes.NewReader(result.Sanitized), int64(len(result.Sanitized)), att.ContentType); err != nil {
+		return fmt.Errorf("failed to store sanitized image: %w", err)
+	}
+
+	update.
+		SetProcessingStatus(attachment.ProcessingStatusDone).
+		SetSize(int64(len(result.Sanitized))).
+		SetWidth(result.Width).
+		SetHeight(result.Height).
+		SetBlurhash(result.BlurHash).
+		SetThumbnailKey(thumbnailKey).
+		SetThumbnailContentType(result.ThumbnailContentType)
+
+	return nil
+}
+
+// SetUserAvatar crops, resizes and stores an uploaded avatar image and points the user at it
+func (s *Services) SetUserAvatar(ctx context.Context, userID string, upload AttachmentUpload) (*ent.User, error) {
+	u, err := s.ent.User.Query().Where(user.IDEQ(userID)).First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("user not found")
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	mediaURL, err := s.storeSquareImage(ctx, "avatars/"+userID, upload)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := u.Update().SetAvaterURL(mediaURL).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update avatar: %w", err)
+	}
+
+	s.deletePublicMedia(ctx, u.AvaterURL)
+	return updated, nil
+}
+
+// SetGuildIcon crops, resizes and stores an uploaded guild icon; only the guild owner may change it
+func (s *Services) SetGuildIcon(ctx context.Context, guildID, userID string, upload AttachmentUpload) (*ent.Guild, error) {
+	g, err := s.ent.Guild.Query().Where(guild.IDEQ(guildID)).WithOwner().First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("guild not found")
+		}
+		return nil, fmt.Errorf("failed to get guild: %w", err)
+	}
+	if g.Edges.Owner == nil || g.Edges.Owner.ID != userID {
+		return nil, fmt.Errorf("insufficient permissions")
+	}
+
+	mediaURL, err := s.storeSquareImage(ctx, "guild-icons/"+guildID, upload)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := g.Update().SetGuildIcon(mediaURL).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update guild icon: %w", err)
+	}
+
+	s.deletePublicMedia(ctx, g.GuildIcon)
+	return updated, nil
+}
+
+// OpenPublicMedia opens an avatar or guild icon by its storage key
+func (s *Services) OpenPublicMedia(ctx context.Context, key string) (*AttachmentContent, error) {
+	if s.attachmentStorage == nil || !isPublicMediaKey(key) {
+		return nil, fmt.Errorf("media not found")
+	}
+
+	body, err := s.attachmentStorage.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, fmt.Errorf("media not found")
+		}
+		return nil, fmt.Errorf("failed to open media: %w", err)
+	}
+
+	contentType := "image/jpeg"
+	if strings.HasSuffix(key, ".png") {
+		contentType = "image/png"
+	}
+
+	return &AttachmentContent{ContentType: contentType, Body: body}, nil
+}
+
+// storeSquareImage processes an uploaded image into a square and stores it below prefix, returning its public URL
+func (s *Services) storeSquareImage(ctx context.Context, prefix string, upload AttachmentUpload) (string, error) {
+	if s.attachmentStorage == nil {
+		return "", fmt.Errorf("attachment storage is not configured")
+	}
+	if upload.Size <= 0 {
+		return "", fmt.Errorf("image is empty")
+	}
+	if s.maxAttachmentSize > 0 && upload.Size > s.maxAttachmentSize {
+		return "", fmt.Errorf("image is too large")
+	}
+
+	data, err := io.ReadAll(io.LimitReader(upload.Reader, upload.Size))
+	if err != nil {
+		return "", fmt.Errorf("failed to read image: %w", err)
+	}
+
+	contentType := strings.SplitN(http.DetectContentType(data), ";", 2)[0]
+	if !imaging.IsSupported(contentType) {
+		return "", fmt.Errorf("unsupported image type")
+	}
+
+	out, outType, err := imaging.Square(data, contentType, squareImageSize)
+	if err != nil {
+		return "", fmt.Errorf("unsupported image type")
+	}
+
+	ext := ".jpg"
+	if outType == "image/png" {
+		ext = ".png"
+	}
+	key := fmt.Sprintf("%s/%s%s", prefix, uuid.Must(uuid.NewV7()).String(), ext)
+	if err := s.attachmentStorage.Put(ctx, key, bytes.NewReader(out), int64(len(out)), outType); err != nil {
+		return "", fmt.Errorf("failed to store image: %w", err)
+	}
+
+	return publicMediaPath + key, nil
+}
+
+// deletePublicMedia removes a previously stored avatar or icon, ignoring URLs not served by us
+func (s *Services) deletePublicMedia(ctx context.Context, mediaURL string) {
+	key, ok := strings.CutPrefix(mediaURL, publicMediaPath)
+	if !ok || !isPublicMediaKey(key) {
+		return
+	}
+	if err := s.attachmentStorage.Delete(ctx, key); err != nil {
+		slog.Error("Failed to delete replaced media", "storage_key", key, "error", err)
+	}
+}
+
+// isPublicMediaKey reports whether a storage key belongs to the publicly served media
+func isPublicMediaKey(key string) bool {
+	if strings.Contains(key, "..") {
+		return false
+	}
+	for _, prefix := range publicMediaPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
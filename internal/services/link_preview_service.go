@@ -0,0 +1,233 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"kakashi/chaos/internal/ent"
+	"kakashi/chaos/internal/ent/linkpreview"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/unfurl"
+)
+
+const (
+	// maxLinkPreviewsPerMessage limits how many URLs of a message are unfurled
+	maxLinkPreviewsPerMessage = 3
+	// linkPreviewDeadline bounds the background enrichment of a single message
+	linkPreviewDeadline = 30 * time.Second
+	// linkPreviewQueueSize bounds the number of messages waiting for the link preview workers
+	linkPreviewQueueSize = 256
+)
+
+// linkPreviewJob is a message whose URLs are waiting to be unfurled
+type linkPreviewJob struct {
+	messageID string
+	content   string
+	urls      []string
+}
+
+// orderedLinkPreviews loads link previews in the order their URLs appear in the message
+func orderedLinkPreviews(q *ent.LinkPreviewQuery) {
+	q.Order(ent.Asc(linkpreview.FieldPosition))
+}
+
+// SetMessageEmbedsSuppressed hides or restores the link previews of a message; only the sender may change it
+func (s *Services) SetMessageEmbedsSuppressed(ctx context.Context, messageID, userID string, suppress bool) (*ent.Message, error) {
+	msg, err := s.ent.Message.Query().
+		Where(message.IDEQ(messageID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("message not found")
+		}
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+	if msg.IsDeleted {
+		return nil, fmt.Errorf("message not found")
+	}
+	if msg.SenderID != userID {
+		return nil, fmt.Errorf("can only change embeds on own messages")
+	}
+
+	if msg.SuppressEmbeds != suppress {
+		tx, err := s.ent.Tx(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to start transaction: %w", err)
+		}
+
+		_, err = tx.Message.UpdateOneID(messageID).
+			SetSuppressEmbeds(suppress).
+			Save(ctx)
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("failed to update message: %w", err))
+		}
+		if suppress {
+			_, err = tx.LinkPreview.Delete().
+				Where(linkpreview.MessageIDEQ(messageID)).
+				Exec(ctx)
+			if err != nil {
+				return nil, rollback(tx, fmt.Errorf("failed to delete link previews: %w", err))
+			}
+		}
+
+		if err := tx.Commit(); err != nil {
+			return nil, fmt.Errorf("failed to commit transaction: %w", err)
+		}
+	}
+
+	msg, err = s.ent.Message.Query().
+		Where(message.IDEQ(messageID)).
+		WithSender().
+		WithAttachments().
+		WithLinkPreviews(orderedLinkPreviews).
+		WithMentions().
+		First(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load message with sender: %w", err)
+	}
+
+	if !suppress {
+		s.enqueueLinkPreviews(msg)
+	}
+
+	if s.WSHub != nil {
+		if err := s.BroadcastMessageUpdated(ctx, msg, userID); err != nil {
+			slog.Error("Failed to broadcast message update", "message_id", msg.ID, "error", err)
+		}
+	}
+
+	return msg, nil
+}
+
+// StartLinkPreviewWorkers starts background workers that fetch queued link previews until ctx is done
+func (s *Services) StartLinkPreviewWorkers(ctx context.Context, workers int) {
+	for i := 0; i < workers; i++ {
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case job := <-s.linkPreviewJobs:
+					s.unfurlMessageLinks(ctx, job)
+				}
+			}
+		}()
+	}
+}
+
+// enqueueLinkPreviews schedules fetching previews for the URLs in a message without blocking the caller
+func (s *Services) enqueueLinkPreviews(msg *ent.Message) {
+	if s.linkPreviews == nil || msg.SuppressEmbeds || msg.IsDeleted {
+		return
+	}
+
+	urls := unfurl.ExtractURLs(msg.Content, maxLinkPreviewsPerMessage)
+	if len(urls) == 0 {
+		return
+	}
+
+	select {
+	case s.linkPreviewJobs <- linkPreviewJob{messageID: msg.ID, content: msg.Content, urls: urls}:
+	default:
+		// Previews are best effort, the message simply goes without them
+		slog.Warn("Link preview queue is full", "message_id", msg.ID)
+	}
+}
+
+// unfurlMessageLinks fetches previews for the URLs of a job, stores them on the message and tells the conversation
+func (s *Services) unfurlMessageLinks(ctx context.Context, job linkPreviewJob) {
+	ctx, cancel := context.WithTimeout(ctx, linkPreviewDeadline)
+	defer cancel()
+
+	messageID := job.messageID
+	var previews []*unfurl.Preview
+	for _, rawURL := range job.urls {
+		preview, err := s.linkPreviews.Fetch(ctx, rawURL)
+		if err != nil {
+			slog.Debug("No link preview", "message_id", messageID, "url", rawURL, "error", err)
+			continue
+		}
+		previews = append(previews, preview)
+	}
+	if len(previews) == 0 {
+		return
+	}
+
+	// An edit or suppression while fetching makes these previews stale
+	current, err := s.ent.Message.Query().
+		Where(
+			message.IDEQ(messageID),
+			message.ContentEQ(job.content),
+			message.SuppressEmbedsEQ(false),
+			message.IsDeletedEQ(false),
+		).
+		Exist(ctx)
+	if err != nil {
+		slog.Error("Failed to check message for link previews", "message_id", messageID, "error", err)
+		return
+	}
+	if !current {
+		return
+	}
+
+	if err := s.saveLinkPreviews(ctx, messageID, previews); err != nil {
+		slog.Error("Failed to save link previews", "message_id", messageID, "error", err)
+		return
+	}
+
+	if s.WSHub == nil {
+		return
+	}
+
+	msg, err := s.ent.Message.Query().
+		Where(message.IDEQ(messageID)).
+		WithSender().
+		WithAttachments().
+		WithLinkPreviews(orderedLinkPreviews).
+		WithMentions().
+		First(ctx)
+	if err != nil {
+		slog.Error("Failed to load message for link previews", "message_id", messageID, "error", err)
+		return
+	}
+	if err := s.BroadcastMessageUpdated(ctx, msg, ""); err != nil {
+		slog.Error("Failed to broadcast link previews", "message_id", msg.ID, "error", err)
+	}
+}
+
+// saveLinkPreviews replaces the stored previews of a message
+func (s *Services) saveLinkPreviews(ctx context.Context, messageID string, previews []*unfurl.Preview) error {
+	tx, err := s.ent.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	_, err = tx.LinkPreview.Delete().
+		Where(linkpreview.MessageIDEQ(messageID)).
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete link previews: %w", err))
+	}
+
+	builders := make([]*ent.LinkPreviewCreate, 0, len(previews))
+	for i, preview := range previews {
+		builders = append(builders, tx.LinkPreview.Create().
+			SetMessageID(messageID).
+			SetURL(preview.URL).
+			SetTitle(preview.Title).
+			SetDescription(preview.Description).
+			SetImageURL(preview.ImageURL).
+			SetSiteName(preview.SiteName).
+			SetPosition(i))
+	}
+	if _, err := tx.LinkPreview.CreateBulk(builders...).Save(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to create link previews: %w", err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,263 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"kakashi/chaos/internal/ent"
+	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/conversationparticipant"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/messagemention"
+	"kakashi/chaos/internal/ent/notification"
+	"kakashi/chaos/internal/richtext"
+)
+
+// maxMentionsPerMessage caps stored mentions so a single message cannot fan out endlessly
+const maxMentionsPerMessage = 50
+
+// mentionPattern matches @username and @everyone not preceded by a word character (so emails are skipped),
+// and <@userID> mentions
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w@<])@([\w.-]{2,55})|<@([0-9a-fA-F-]{36})>`)
+
+// parsedMention is a mention found in message content
+type parsedMention struct {
+	kind   messagemention.Kind
+	userID string
+	// offset and length are in UTF-16 code units of the plain text so clients can highlight without re-parsing
+	offset int
+	length int
+}
+
+// messageMentions holds the resolved mentions of a message
+type messageMentions struct {
+	mentions []parsedMention
+	// userIDs are the participants to notify, never including the sender
+	userIDs []string
+}
+
+// GetMentions returns recent messages that mention the user directly or through @everyone, newest first
+func (s *Services) GetMentions(ctx context.Context, userID string, limit, offset int) ([]*ent.Message, error) {
+	messages, err := s.ent.Message.Query().
+		Where(
+			message.IsDeletedEQ(false),
+			message.SenderIDNEQ(userID),
+			notHiddenFor(userID),
+			message.HasMentionsWith(messagemention.Or(
+				messagemention.UserIDEQ(userID),
+				messagemention.KindEQ(messagemention.KindEveryone),
+			)),
+			message.HasConversationWith(conversation.HasParticipantsWith(conversationparticipant.UserIDEQ(userID))),
+		).
+		Order(ent.Desc(message.FieldCreatedAt)).
+		Limit(limit).
+		Offset(offset).
+		WithSender().
+		WithMentions().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mentions: %w", err)
+	}
+
+	return messages, nil
+}
+
+// resolveMentions finds mentions in the plain text of a message and keeps the ones that refer to participants
+// of the conversation. Mentions written as code are text, not mentions
+func (s *Services) resolveMentions(ctx context.Context, conversationID, senderID string, doc *richtext.Document) (*messageMentions, error) {
+	result := &messageMentions{}
+	content := doc.Text
+	if !strings.Contains(content, "@") {
+		return result, nil
+	}
+
+	conv, err := s.ent.Conversation.Query().
+		Where(conversation.IDEQ(conversationID)).
+		WithParticipants(func(q *ent.ConversationParticipantQuery) {
+			q.WithUser()
+		}).
+		First(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get conversation participants: %w", err)
+	}
+
+	byUsername := make(map[string]string)
+	isParticipant := make(map[string]bool)
+	for _, p := range conv.Edges.Participants {
+		isParticipant[p.UserID] = true
+		if p.Edges.User != nil {
+			byUsername[p.Edges.User.Username] = p.UserID
+		}
+	}
+
+	notify := make(map[string]bool)
+	for _, m := range mentionPattern.FindAllStringSubmatchIndex(content, -1) {
+		if len(result.mentions) >= maxMentionsPerMessage {
+			break
+		}
+
+		var mention parsedMention
+		var start, end int
+		if m[2] >= 0 {
+			// Trailing dots and dashes usually end the sentence rather than the username
+			name := strings.TrimRight(content[m[2]:m[3]], ".-")
+			start, end = m[2]-1, m[2]+len(name)
+			if name == "everyone" {
+				if conv.Type != conversation.TypeGroup {
+					continue
+				}
+				mention.kind = messagemention.KindEveryone
+			} else {
+				userID, ok := byUsername[name]
+				if !ok {
+					continue
+				}
+				mention.kind = messagemention.KindUser
+				mention.userID = userID
+			}
+		} else {
+			userID := strings.ToLower(content[m[4]:m[5]])
+			if !isParticipant[userID] {
+				continue
+			}
+			start, end = m[0], m[1]
+			mention.kind = messagemention.KindUser
+			mention.userID = userID
+		}
+
+		mention.offset = utf16Len(content[:start])
+		mention.length = utf16Len(content[start:end])
+		if doc.InCode(mention.offset, mention.length) {
+			continue
+		}
+
+		if mention.kind == messagemention.KindEveryone {
+			for participantID := range isParticipant {
+				notify[participantID] = true
+			}
+		} else {
+			notify[mention.userID] = true
+		}
+		result.mentions = append(result.mentions, mention)
+	}
+
+	delete(notify, senderID)
+	for userID := range notify {
+		result.userIDs = append(result.userIDs, userID)
+	}
+
+	return result, nil
+}
+
+// createMessageMentions stores the mentions of a message within the caller's transaction
+func createMessageMentions(ctx context.Context, tx *ent.Tx, messageID string, mentions []parsedMention) error {
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	builders := make([]*ent.MessageMentionCreate, 0, len(mentions))
+	for _, mention := range mentions {
+		builder := tx.MessageMention.Create().
+			SetMessageID(messageID).
+			SetKind(mention.kind).
+			SetOffset(mention.offset).
+			SetLength(mention.length)
+		if mention.userID != "" {
+			builder = builder.SetUserID(mention.userID)
+		}
+		builders = append(builders, builder)
+	}
+
+	if _, err := tx.MessageMention.CreateBulk(builders...).Save(ctx); err != nil {
+		return fmt.Errorf("failed to create mentions: %w", err)
+	}
+	return nil
+}
+
+// notifyMentions creates mention notifications; unlike message notifications they still reach users who
+// muted the conversation, only an explicit nothing level silences them
+func (s *Services) notifyMentions(ctx context.Context, msg *ent.Message, userIDs []string) {
+	if len(userIDs) == 0 {
+		return
+	}
+
+	levels, err := s.notificationLevels(ctx, msg.ConversationID, userIDs)
+	if err != nil {
+		slog.Error("Failed to get notification settings for mentions", "message_id", msg.ID, "error", err)
+		return
+	}
+
+	sender, err := s.ent.User.Get(ctx, msg.SenderID)
+	if err != nil {
+		slog.Error("Failed to get mention sender", "message_id", msg.ID, "error", err)
+		return
+	}
+
+	title := "New Mention"
+	content := fmt.Sprintf("%s mentioned you", sender.Username)
+	for _, userID := range userIDs {
+		if levels[userID] == conversationparticipant.NotificationLevelNothing {
+			continue
+		}
+
+		// Users who blocked the sender are not notified
+		blocked, err := s.IsBlockedByUser(ctx, userID, msg.SenderID)
+		if err != nil {
+			slog.Error("Failed to check block status for mention", "message_id", msg.ID, "user_id", userID, "error", err)
+			continue
+		}
+		if blocked {
+			continue
+		}
+
+		notif, err := s.ent.Notification.Create().
+			SetUserID(userID).
+			SetType(notification.TypeMention).
+			SetTitle(title).
+			SetContent(content).
+			SetRelatedUserID(msg.SenderID).
+			SetRelatedConversationID(msg.ConversationID).
+			SetRelatedMessageID(msg.ID).
+			Save(ctx)
+		if err != nil {
+			slog.Error("Failed to create mention notification", "message_id", msg.ID, "user_id", userID, "error", err)
+			continue
+		}
+
+		if s.WSHub != nil {
+			s.BroadcastNotification(userID, notif.ID, string(notification.TypeMention), title, content, msg.SenderID)
+		}
+	}
+}
+
+// newlyMentioned returns the users in current that are not in previous
+func newlyMentioned(previous, current []string) []string {
+	seen := make(map[string]bool, len(previous))
+	for _, userID := range previous {
+		seen[userID] = true
+	}
+
+	var result []string
+	for _, userID := range current {
+		if !seen[userID] {
+			result = append(result, userID)
+		}
+	}
+	return result
+}
+
+// utf16Len returns the length of s in UTF-16 code units
+func utf16Len(s string) int {
+	n := 0
+	for len(s) > 0 {
+		r, size := utf8.DecodeRuneInString(s)
+		n += utf16.RuneLen(r)
+		s = s[size:]
+	}
+	return n
+}
@@ -10,8 +10,16 @@ import (
 	"kakashi/chaos/internal/ent"
 	"kakashi/chaos/internal/ent/conversation"
 	"kakashi/chaos/internal/ent/conversationparticipant"
+	"kakashi/chaos/internal/ent/folderconversation"
+	"kakashi/chaos/internal/ent/linkpreview"
 	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/messagemention"
+	"kakashi/chaos/internal/ent/messagerevision"
+	"kakashi/chaos/internal/ent/predicate"
 	"kakashi/chaos/internal/ent/user"
+	"kakashi/chaos/internal/richtext"
+
+	"entgo.io/ent/dialect/sql"
 )
 
 // ConversationWithDetails represents a conversation with additional details
@@ -22,28 +30,258 @@ type ConversationWithDetails struct {
 	UnreadCount  int          `json:"unread_count"`
 	IsArchived   bool         `json:"is_archived"`
 	IsMuted      bool         `json:"is_muted"`
+	Draft        *ent.Draft   `json:"draft,omitempty"`
+	// NotificationSettings are the user's notification preferences for the conversation
+	NotificationSettings *NotificationSettings `json:"notification_settings,omitempty"`
+	// PinnedAt is set while the user has the conversation pinned
+	PinnedAt *time.Time `json:"pinned_at,omitempty"`
+	// FolderIDs are the user's folders the conversation is in
+	FolderIDs []string `json:"folder_ids,omitempty"`
 }
 
-// MessageSearchResult represents a message in search results with context
-type MessageSearchResult struct {
-	*ent.Message
-	ConversationID   string `json:"conversation_id"`
-	ConversationName string `json:"conversation_name"`
-	Highlight        string `json:"highlight"`
+// SendMessageOptions holds optional attributes of a new message
+type SendMessageOptions struct {
+	// ReplyToID is the message quoted by the new message
+	ReplyToID string
+	// ThreadRootID posts the new message into the thread of that root message
+	ThreadRootID string
+	// Attachment is an already stored file sent with the message
+	Attachment *AttachmentInput
+	// SuppressEmbeds skips link previews for the URLs in the message
+	SuppressEmbeds bool
+	// Nonce is a client generated key, repeating a send with the same nonce returns the original message
+	Nonce string
+	// scheduled is the claimed scheduled message being delivered, it is marked sent with the message
+	scheduled *scheduledDelivery
+	// forwardedFrom is the original message of a forward, forwards carry its attribution and mention nobody
+	forwardedFrom *ent.Message
 }
 
 // SendMessage creates a new message in the specified conversation
 func (s *Services) SendMessage(ctx context.Context, senderID, conversationID, content string) (*ent.Message, error) {
+	return s.SendMessageWithOptions(ctx, senderID, conversationID, content, SendMessageOptions{})
+}
+
+// SendMessageWithOptions creates a new message in the specified conversation, optionally as a reply or thread reply
+func (s *Services) SendMessageWithOptions(ctx context.Context, senderID, conversationID, content string, opts SendMessageOptions) (*ent.Message, error) {
+	if err := validateNonce(opts.Nonce); err != nil {
+		return nil, err
+	}
+	if err := s.validateSendPermission(ctx, senderID, conversationID); err != nil {
+		return nil, err
+	}
+
+	// A retried send returns the message created by the first attempt
+	nonce := opts.Nonce
+	if s.messageNonceWindow <= 0 {
+		nonce = ""
+	}
+	if nonce != "" {
+		existing, err := s.findMessageByNonce(ctx, senderID, conversationID, nonce)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return existing, nil
+		}
+	}
+
+	// Validate that reply and thread targets belong to this conversation
+	if opts.ReplyToID != "" {
+		if err := s.validateReplyTarget(ctx, conversationID, opts.ReplyToID); err != nil {
+			return nil, err
+		}
+	}
+	var threadRoot *ent.Message
+	var err error
+	if opts.ThreadRootID != "" {
+		threadRoot, err = s.getThreadRoot(ctx, conversationID, opts.ThreadRootID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Formatting is parsed once on the server so every client renders the same entities
+	doc, err := richtext.Parse(content)
+	if err != nil {
+		return nil, err
+	}
+	if doc.Content == "" {
+		return nil, fmt.Errorf("message content cannot be empty")
+	}
+
+	messageType := message.MessageTypeText
+	if opts.Attachment != nil {
+		messageType = opts.Attachment.messageType()
+	}
+
+	mentions := &messageMentions{}
+	if opts.forwardedFrom == nil {
+		mentions, err = s.resolveMentions(ctx, conversationID, senderID, doc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := s.ent.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Create the message
+	builder := tx.Message.Create().
+		SetConversationID(conversationID).
+		SetSenderID(senderID).
+		SetContent(doc.Content).
+		SetPlainText(doc.Text).
+		SetMessageType(messageType).
+		SetSuppressEmbeds(opts.SuppressEmbeds)
+	if len(doc.Entities) > 0 {
+		builder = builder.SetEntities(doc.Entities)
+	}
+	if nonce != "" {
+		builder = builder.SetNonce(nonce)
+	}
+	if opts.ReplyToID != "" {
+		builder = builder.SetReplyToID(opts.ReplyToID)
+	}
+	if threadRoot != nil {
+		builder = builder.SetThreadRootID(threadRoot.ID)
+	}
+	if opts.forwardedFrom != nil {
+		builder = builder.
+			SetForwardedFromMessageID(opts.forwardedFrom.ID).
+			SetForwardedFromUserID(opts.forwardedFrom.SenderID)
+	}
+	msg, err := builder.Save(ctx)
+	if err != nil {
+		if nonce != "" && ent.IsConstraintError(err) {
+			// A concurrent retry with the same nonce created the message first
+			if rbErr := tx.Rollback(); rbErr != nil {
+				slog.Error("Failed to roll back duplicate send", "error", rbErr)
+			}
+			existing, findErr := s.findMessageByNonce(ctx, senderID, conversationID, nonce)
+			if findErr != nil {
+				return nil, findErr
+			}
+			if existing != nil {
+				return existing, nil
+			}
+			return nil, fmt.Errorf("failed to create message: %w", err)
+		}
+		return nil, rollback(tx, fmt.Errorf("failed to create message: %w", err))
+	}
+
+	// Store the attachment record together with its message
+	if opts.Attachment != nil {
+		_, err = tx.Attachment.Create().
+			SetMessageID(msg.ID).
+			SetUploaderID(senderID).
+			SetStorageKey(opts.Attachment.StorageKey).
+			SetFilename(opts.Attachment.Filename).
+			SetContentType(opts.Attachment.ContentType).
+			SetSize(opts.Attachment.Size).
+			SetProcessingStatus(opts.Attachment.processingStatus()).
+			Save(ctx)
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("failed to create attachment: %w", err))
+		}
+	}
+
+	if err := createMessageMentions(ctx, tx, msg.ID, mentions.mentions); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if opts.scheduled != nil {
+		if err := opts.scheduled.complete(ctx, tx, msg.ID); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	if threadRoot != nil {
+		// Thread replies update the thread instead of the conversation timeline
+		threadRoot, err = s.recordThreadReply(ctx, threadRoot, msg)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Update conversation's last_message_at
+		_, err = s.ent.Conversation.UpdateOneID(conversationID).
+			SetLastMessageAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update conversation timestamp: %w", err)
+		}
+	}
+
+	msg, err = s.loadSentMessage(ctx, msg.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Broadcast real-time message notification if WebSocket hub is available
+	if s.WSHub != nil {
+		if threadRoot != nil {
+			err = s.BroadcastThreadMessage(ctx, msg, threadRoot.ThreadReplyCount)
+		} else {
+			err = s.BroadcastMessageNotification(ctx, msg)
+		}
+		if err != nil {
+			// Log error but don't fail the message creation
+			slog.Error("Failed to broadcast message notification", "message_id", msg.ID, "error", err)
+		}
+	}
+
+	s.notifyMentions(ctx, msg, mentions.userIDs)
+
+	// A message composed in the conversation replaces its draft, forwards and scheduled deliveries leave it alone
+	if threadRoot == nil && opts.forwardedFrom == nil && opts.scheduled == nil {
+		if err := s.clearDraft(ctx, senderID, conversationID); err != nil {
+			slog.Error("Failed to clear draft", "conversation_id", conversationID, "user_id", senderID, "error", err)
+		}
+	}
+
+	// Link previews are fetched in the background and arrive as a message_updated event
+	s.enqueueLinkPreviews(msg)
+
+	return msg, nil
+}
+
+// loadSentMessage loads a message with sender, attachment, mention, forward and reply preview information
+func (s *Services) loadSentMessage(ctx context.Context, messageID string) (*ent.Message, error) {
+	msg, err := s.ent.Message.Query().
+		Where(message.IDEQ(messageID)).
+		WithSender().
+		WithAttachments().
+		WithMentions().
+		WithForwardedFromUser().
+		WithReplyTo(func(q *ent.MessageQuery) {
+			q.WithSender()
+		}).
+		First(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load message with sender: %w", err)
+	}
+	redactDeletedReplyPreviews([]*ent.Message{msg})
+	return msg, nil
+}
+
+// validateSendPermission checks that the sender may post in the conversation
+func (s *Services) validateSendPermission(ctx context.Context, senderID, conversationID string) error {
 	// Validate that sender exists
 	_, err := s.ent.User.Query().Where(user.IDEQ(senderID)).First(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("sender not found: %w", err)
+		return fmt.Errorf("sender not found: %w", err)
 	}
 
 	// Validate that conversation exists
 	conv, err := s.ent.Conversation.Query().Where(conversation.IDEQ(conversationID)).First(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("conversation not found: %w", err)
+		return fmt.Errorf("conversation not found: %w", err)
 	}
 
 	// Check if sender is a participant in the conversation
@@ -54,10 +292,10 @@ func (s *Services) SendMessage(ctx context.Context, senderID, conversationID, co
 		).
 		Exist(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to check participant status: %w", err)
+		return fmt.Errorf("failed to check participant status: %w", err)
 	}
 	if !participantExists {
-		return nil, fmt.Errorf("user is not a participant in this conversation")
+		return fmt.Errorf("user is not a participant in this conversation")
 	}
 
 	// For direct conversations, check if users are friends and not blocked
@@ -68,7 +306,7 @@ func (s *Services) SendMessage(ctx context.Context, senderID, conversationID, co
 			WithUser().
 			All(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get conversation participants: %w", err)
+			return fmt.Errorf("failed to get conversation participants: %w", err)
 		}
 
 		var otherUserID string
@@ -83,61 +321,62 @@ func (s *Services) SendMessage(ctx context.Context, senderID, conversationID, co
 			// Check if users are friends
 			areFriends, err := s.AreFriends(ctx, senderID, otherUserID)
 			if err != nil {
-				return nil, fmt.Errorf("failed to check friendship status: %w", err)
+				return fmt.Errorf("failed to check friendship status: %w", err)
 			}
 			if !areFriends {
-				return nil, fmt.Errorf("can only send messages to friends")
+				return fmt.Errorf("can only send messages to friends")
 			}
 
 			// Check if users are blocked
 			isBlocked, err := s.IsBlocked(ctx, senderID, otherUserID)
 			if err != nil {
-				return nil, fmt.Errorf("failed to check block status: %w", err)
+				return fmt.Errorf("failed to check block status: %w", err)
 			}
 			if isBlocked {
-				return nil, fmt.Errorf("cannot send message to blocked user")
+				return fmt.Errorf("cannot send message to blocked user")
 			}
 		}
 	}
 
-	// Create the message
-	msg, err := s.ent.Message.Create().
-		SetConversationID(conversationID).
-		SetSenderID(senderID).
-		SetContent(content).
-		SetMessageType(message.MessageTypeText).
-		Save(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create message: %w", err)
-	}
+	return nil
+}
 
-	// Update conversation's last_message_at
-	_, err = s.ent.Conversation.UpdateOneID(conversationID).
-		SetLastMessageAt(time.Now()).
-		Save(ctx)
+// validateReplyTarget checks that a quoted message exists in the conversation and is not deleted
+func (s *Services) validateReplyTarget(ctx context.Context, conversationID, replyToID string) error {
+	exists, err := s.ent.Message.Query().
+		Where(
+			message.IDEQ(replyToID),
+			message.ConversationIDEQ(conversationID),
+			message.IsDeletedEQ(false),
+		).
+		Exist(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update conversation timestamp: %w", err)
+		return fmt.Errorf("failed to get reply target: %w", err)
 	}
-
-	// Load the message with sender information
-	msg, err = s.ent.Message.Query().
-		Where(message.IDEQ(msg.ID)).
-		WithSender().
-		First(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to load message with sender: %w", err)
+	if !exists {
+		return fmt.Errorf("reply target not found")
 	}
+	return nil
+}
 
-	// Broadcast real-time message notification if WebSocket hub is available
-	if s.WSHub != nil {
-		err = s.BroadcastMessageNotification(ctx, msg)
-		if err != nil {
-			// Log error but don't fail the message creation
-			slog.Error("Failed to broadcast message notification", "message_id", msg.ID, "error", err)
+// redactDeletedReplyPreviews hides the content of quoted messages that have since been deleted
+func redactDeletedReplyPreviews(messages []*ent.Message) {
+	for _, msg := range messages {
+		if parent := msg.Edges.ReplyTo; parent != nil && parent.IsDeleted {
+			parent.Content = ""
+			parent.PlainText = ""
+			parent.Entities = nil
 		}
 	}
+}
 
-	return msg, nil
+// messageDocument returns the parsed content of a stored message, messages stored before formatting
+// was parsed are plain text
+func messageDocument(msg *ent.Message) *richtext.Document {
+	if msg.PlainText == "" {
+		return richtext.Plain(msg.Content)
+	}
+	return &richtext.Document{Content: msg.Content, Text: msg.PlainText, Entities: msg.Entities}
 }
 
 // GetOrCreateDirectConversation gets or creates a direct conversation between two users
@@ -171,11 +410,111 @@ func (s *Services) GetOrCreateDirectConversation(ctx context.Context, userID1, u
 		return nil, fmt.Errorf("cannot create conversation with blocked user")
 	}
 
-	// Look for existing direct conversation between these users
+	directKey := directConversationKey(userID1, userID2)
+
+	// Look up and create inside a single transaction; the unique direct_key
+	// guarantees concurrent callers cannot create two conversations for the pair
+	tx, err := s.ent.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	existingConv, err := findDirectConversation(ctx, tx.Client(), userID1, userID2, directKey)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if existingConv != nil {
+		// Conversation already exists
+		if err := tx.Commit(); err != nil {
+			return nil, fmt.Errorf("failed to commit transaction: %w", err)
+		}
+		return existingConv, nil
+	}
+
+	// Create new direct conversation
+	conv, err := tx.Conversation.Create().
+		SetType(conversation.TypeDirect).
+		SetDirectKey(directKey).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			// Another request created the conversation first, return that one
+			if rerr := tx.Rollback(); rerr != nil {
+				return nil, fmt.Errorf("failed to rollback transaction: %w", rerr)
+			}
+			return s.ent.Conversation.Query().
+				Where(conversation.DirectKeyEQ(directKey)).
+				First(ctx)
+		}
+		return nil, rollback(tx, fmt.Errorf("failed to create conversation: %w", err))
+	}
+
+	// Add both users as participants
+	_, err = tx.ConversationParticipant.CreateBulk(
+		tx.ConversationParticipant.Create().
+			SetConversationID(conv.ID).
+			SetUserID(userID1).
+			SetJoinedAt(time.Now()),
+		tx.ConversationParticipant.Create().
+			SetConversationID(conv.ID).
+			SetUserID(userID2).
+			SetJoinedAt(time.Now()),
+	).Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to add participants: %w", err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return conv, nil
+}
+
+// StartDirectConversation returns the direct conversation between the user and a friend,
+// creating it if needed, with participants loaded
+func (s *Services) StartDirectConversation(ctx context.Context, userID, otherUserID string) (*ent.Conversation, error) {
+	if userID == otherUserID {
+		return nil, fmt.Errorf("cannot start a conversation with yourself")
+	}
+
+	conv, err := s.GetOrCreateDirectConversation(ctx, userID, otherUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	conv, err = s.ent.Conversation.Query().
+		Where(conversation.IDEQ(conv.ID)).
+		WithParticipants(func(q *ent.ConversationParticipantQuery) {
+			q.WithUser()
+		}).
+		First(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load conversation: %w", err)
+	}
+
+	return conv, nil
+}
+
+// findDirectConversation looks up the direct conversation for a pair of users by its
+// direct key, falling back to a participant scan for conversations created before the
+// key existed and backfilling the key on them
+func findDirectConversation(ctx context.Context, client *ent.Client, userID1, userID2, directKey string) (*ent.Conversation, error) {
+	conv, err := client.Conversation.Query().
+		Where(conversation.DirectKeyEQ(directKey)).
+		First(ctx)
+	if err == nil {
+		return conv, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get direct conversation: %w", err)
+	}
+
 	// First get all direct conversations for user1
-	user1Conversations, err := s.ent.Conversation.Query().
+	user1Conversations, err := client.Conversation.Query().
 		Where(
 			conversation.TypeEQ(conversation.TypeDirect),
+			conversation.DirectKeyIsNil(),
 			conversation.HasParticipantsWith(
 				conversationparticipant.UserIDEQ(userID1),
 			),
@@ -187,63 +526,48 @@ func (s *Services) GetOrCreateDirectConversation(ctx context.Context, userID1, u
 	}
 
 	// Check if any of these conversations also has user2 as participant
-	var existingConv *ent.Conversation
 	for _, conv := range user1Conversations {
-		hasUser2 := false
 		for _, participant := range conv.Edges.Participants {
 			if participant.UserID == userID2 {
-				hasUser2 = true
-				break
+				return conv.Update().SetDirectKey(directKey).Save(ctx)
 			}
 		}
-		if hasUser2 {
-			existingConv = conv
-			break
-		}
 	}
 
-	if existingConv != nil {
-		// Conversation already exists
-		return existingConv, nil
-	}
-
-	// Create new direct conversation
-	conv, err := s.ent.Conversation.Create().
-		SetType(conversation.TypeDirect).
-		Save(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create conversation: %w", err)
-	}
+	return nil, nil
+}
 
-	// Add both users as participants
-	_, err = s.ent.ConversationParticipant.Create().
-		SetConversationID(conv.ID).
-		SetUserID(userID1).
-		SetJoinedAt(time.Now()).
-		Save(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to add user1 as participant: %w", err)
+// directConversationKey builds an order independent key for a pair of users
+func directConversationKey(userID1, userID2 string) string {
+	if userID1 > userID2 {
+		userID1, userID2 = userID2, userID1
 	}
+	return userID1 + ":" + userID2
+}
 
-	_, err = s.ent.ConversationParticipant.Create().
-		SetConversationID(conv.ID).
-		SetUserID(userID2).
-		SetJoinedAt(time.Now()).
-		Save(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to add user2 as participant: %w", err)
-	}
+// GetUserConversations returns a keyset page of conversations for a user with details
+func (s *Services) GetUserConversations(ctx context.Context, userID string, page PageRequest) (*Page[*ConversationWithDetails], error) {
+	return s.GetUserConversationsWithFilter(ctx, userID, page, ConversationFilter{})
+}
 
-	return conv, nil
+// conversationActivity is the conversation sort key, conversations that never had a message sort by creation
+func conversationActivity(s *sql.Selector) string {
+	return fmt.Sprintf("COALESCE(%s, %s)", s.C(conversation.FieldLastMessageAt), s.C(conversation.FieldCreatedAt))
 }
 
-// GetUserConversations returns all conversations for a user with pagination and details
-func (s *Services) GetUserConversations(ctx context.Context, userID string, limit, offset int) ([]*ConversationWithDetails, error) {
-	return s.GetUserConversationsWithFilter(ctx, userID, limit, offset, false, false)
+// conversationCursor encodes the position of a conversation in the activity ordering
+func conversationCursor(conv *ConversationWithDetails) string {
+	at := conv.LastMessageAt
+	if at.IsZero() {
+		at = conv.CreatedAt
+	}
+	return encodeCursor(at, conv.ID)
 }
 
-// GetUserConversationsWithFilter returns conversations for a user with filtering options
-func (s *Services) GetUserConversationsWithFilter(ctx context.Context, userID string, limit, offset int, includeArchived, includeMuted bool) ([]*ConversationWithDetails, error) {
+// GetUserConversationsWithFilter returns a keyset page of conversations for a user with filtering options.
+// The first page starts with every pinned conversation matching the filter in pin order, on top of the
+// limit; the rest follow most recently active first and are the only ones cursors page through
+func (s *Services) GetUserConversationsWithFilter(ctx context.Context, userID string, page PageRequest, filter ConversationFilter) (*Page[*ConversationWithDetails], error) {
 	// Validate that user exists
 	_, err := s.ent.User.Query().Where(user.IDEQ(userID)).First(ctx)
 	if err != nil {
@@ -252,33 +576,94 @@ func (s *Services) GetUserConversationsWithFilter(ctx context.Context, userID st
 
 	// Build participant filter based on archive/mute preferences
 	participantFilter := conversationparticipant.UserIDEQ(userID)
-	if !includeArchived {
+	if !filter.IncludeArchived {
 		participantFilter = conversationparticipant.And(
 			participantFilter,
 			conversationparticipant.IsArchivedEQ(false),
 		)
 	}
-	if !includeMuted {
+	if !filter.IncludeMuted {
+		// Muted conversations stay listed when the user chose so, and once a timed mute has ended
 		participantFilter = conversationparticipant.And(
 			participantFilter,
-			conversationparticipant.IsMutedEQ(false),
+			conversationparticipant.Not(hiddenWhenMuted(time.Now())),
 		)
 	}
 
-	// Get conversations where user is a participant, ordered by last_message_at desc
-	conversations, err := s.ent.Conversation.Query().
-		Where(
-			conversation.HasParticipantsWith(participantFilter),
-		).
-		Order(ent.Desc(conversation.FieldLastMessageAt)).
-		Limit(limit).
-		Offset(offset).
-		WithParticipants(func(q *ent.ConversationParticipantQuery) {
-			q.WithUser()
-		}).
-		All(ctx)
+	if filter.FolderID != "" {
+		if _, err := s.getConversationFolder(ctx, filter.FolderID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Pinned and unpinned conversations are listed apart, the pinned ones only on the first page
+	listQuery := func(pinned bool) *ent.ConversationQuery {
+		pinFilter := conversationparticipant.PinnedAtIsNil()
+		if pinned {
+			pinFilter = conversationparticipant.PinnedAtNotNil()
+		}
+		query := s.ent.Conversation.Query().
+			Where(
+				conversation.HasParticipantsWith(participantFilter, pinFilter),
+			)
+		if filter.FolderID != "" {
+			query = query.Where(conversation.HasFolderEntriesWith(folderconversation.FolderIDEQ(filter.FolderID)))
+		}
+		return query
+	}
+
+	// Get the unpinned conversations where user is a participant, most recently active first
+	fetch := func(older bool, cursor *pageCursor, inclusive bool, limit int) ([]*ConversationWithDetails, error) {
+		query := listQuery(false).
+			Order(keysetOrder(conversationActivity, older)).
+			Limit(limit).
+			WithParticipants(func(q *ent.ConversationParticipantQuery) {
+				q.WithUser()
+			})
+		if cursor != nil {
+			query = query.Where(keysetWhere(conversationActivity, older, inclusive, cursor))
+		}
+		conversations, err := query.All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get conversations: %w", err)
+		}
+		return s.conversationDetails(ctx, conversations, userID)
+	}
+
+	result, err := paginate(page, nil, fetch, conversationCursor)
+	if err != nil {
+		return nil, err
+	}
+	if page.Before != "" || page.After != "" || page.Around != "" {
+		return result, nil
+	}
+
+	pinned, err := s.getPinnedConversations(ctx, listQuery(true), userID)
+	if err != nil {
+		return nil, err
+	}
+	pinnedDetails, err := s.conversationDetails(ctx, pinned, userID)
+	if err != nil {
+		return nil, err
+	}
+	result.Items = append(pinnedDetails, result.Items...)
+
+	return result, nil
+}
+
+// conversationDetails loads participants, last message, unread count and user settings for conversations
+func (s *Services) conversationDetails(ctx context.Context, conversations []*ent.Conversation, userID string) ([]*ConversationWithDetails, error) {
+	conversationIDs := make([]string, len(conversations))
+	for i, conv := range conversations {
+		conversationIDs[i] = conv.ID
+	}
+	drafts, err := s.getDrafts(ctx, userID, conversationIDs)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get conversations: %w", err)
+		return nil, err
+	}
+	folderIDs, err := s.getFolderIDs(ctx, userID, conversationIDs)
+	if err != nil {
+		return nil, err
 	}
 
 	var result []*ConversationWithDetails
@@ -301,6 +686,8 @@ func (s *Services) GetUserConversationsWithFilter(ctx context.Context, userID st
 			Where(
 				message.ConversationIDEQ(conv.ID),
 				message.IsDeletedEQ(false),
+				message.ThreadRootIDIsNil(),
+				notHiddenFor(userID),
 			).
 			Order(ent.Desc(message.FieldCreatedAt)).
 			WithSender().
@@ -319,19 +706,30 @@ func (s *Services) GetUserConversationsWithFilter(ctx context.Context, userID st
 
 		// Get user-specific archive and mute status
 		isArchived := false
-		isMuted := false
+		muted := false
+		var settings *NotificationSettings
+		var pinnedAt *time.Time
 		if userParticipant != nil {
 			isArchived = userParticipant.IsArchived
-			isMuted = userParticipant.IsMuted
+			muted = isMuted(userParticipant, time.Now())
+			settings = notificationSettings(userParticipant)
+			if !userParticipant.PinnedAt.IsZero() {
+				at := userParticipant.PinnedAt
+				pinnedAt = &at
+			}
 		}
 
 		result = append(result, &ConversationWithDetails{
-			Conversation: conv,
-			Participants: participants,
-			LastMessage:  lastMessage,
-			UnreadCount:  unreadCount,
-			IsArchived:   isArchived,
-			IsMuted:      isMuted,
+			Conversation:         conv,
+			Participants:         participants,
+			LastMessage:          lastMessage,
+			UnreadCount:          unreadCount,
+			IsArchived:           isArchived,
+			IsMuted:              muted,
+			Draft:                drafts[conv.ID],
+			NotificationSettings: settings,
+			PinnedAt:             pinnedAt,
+			FolderIDs:            folderIDs[conv.ID],
 		})
 	}
 
@@ -357,6 +755,8 @@ func (s *Services) calculateUnreadCount(ctx context.Context, conversationID, use
 			message.ConversationIDEQ(conversationID),
 			message.IsDeletedEQ(false),
 			message.SenderIDNEQ(userID), // Don't count own messages as unread
+			message.ThreadRootIDIsNil(), // Thread replies have their own unread state
+			notHiddenFor(userID),
 		)
 
 	if !participant.LastReadAt.IsZero() {
@@ -371,8 +771,13 @@ func (s *Services) calculateUnreadCount(ctx context.Context, conversationID, use
 	return count, nil
 }
 
-// GetConversationMessages returns paginated messages from a conversation with read status tracking
-func (s *Services) GetConversationMessages(ctx context.Context, conversationID, userID string, limit, offset int) ([]*ent.Message, error) {
+// GetConversationMessages returns a keyset page of messages from a conversation. A non-empty aroundMessageID
+// centers the page on that message so jumping to it loads its surroundings directly, it replaces the cursors
+func (s *Services) GetConversationMessages(ctx context.Context, conversationID, userID string, page PageRequest, aroundMessageID string) (*Page[*MessageWithReactions], error) {
+	if aroundMessageID != "" && (page.Before != "" || page.After != "" || page.Around != "") {
+		return nil, fmt.Errorf("around_message_id cannot be combined with a cursor")
+	}
+
 	// Validate that user exists
 	_, err := s.ent.User.Query().Where(user.IDEQ(userID)).First(ctx)
 	if err != nil {
@@ -399,28 +804,64 @@ func (s *Services) GetConversationMessages(ctx context.Context, conversationID,
 		return nil, fmt.Errorf("user is not a participant in this conversation")
 	}
 
-	// Get messages from the conversation, ordered by created_at desc (newest first)
-	messages, err := s.ent.Message.Query().
-		Where(
-			message.ConversationIDEQ(conversationID),
-			message.IsDeletedEQ(false),
-		).
-		Order(ent.Desc(message.FieldCreatedAt)).
-		Limit(limit).
-		Offset(offset).
-		WithSender().
-		All(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get messages: %w", err)
+	timeline := []predicate.Message{
+		message.ConversationIDEQ(conversationID),
+		message.IsDeletedEQ(false),
+		message.ThreadRootIDIsNil(),
+		notHiddenFor(userID),
 	}
 
-	return messages, nil
+	var around *pageCursor
+	if aroundMessageID != "" {
+		anchor, err := s.ent.Message.Query().
+			Where(message.IDEQ(aroundMessageID)).
+			Where(timeline...).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, fmt.Errorf("around message not found")
+			}
+			return nil, fmt.Errorf("failed to get message: %w", err)
+		}
+		around = &pageCursor{at: anchor.CreatedAt, id: anchor.ID}
+	}
+
+	// Thread replies are served by the thread endpoints; quoted messages are eager loaded in one query
+	createdAt := keysetColumn(message.FieldCreatedAt)
+	fetch := func(older bool, cursor *pageCursor, inclusive bool, limit int) ([]*MessageWithReactions, error) {
+		query := s.ent.Message.Query().
+			Where(timeline...).
+			Order(keysetOrder(createdAt, older)).
+			Limit(limit).
+			WithSender().
+			WithAttachments().
+			WithLinkPreviews(orderedLinkPreviews).
+			WithMentions().
+			WithForwardedFromUser().
+			// Quotes of messages the viewer deleted for themselves come without a preview
+			WithReplyTo(func(q *ent.MessageQuery) {
+				q.Where(notHiddenFor(userID)).WithSender()
+			})
+		if cursor != nil {
+			query = query.Where(keysetWhere(createdAt, older, inclusive, cursor))
+		}
+		messages, err := query.All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get messages: %w", err)
+		}
+		redactDeletedReplyPreviews(messages)
+		return s.attachReactions(ctx, messages, userID)
+	}
+
+	return paginate(page, around, fetch, func(m *MessageWithReactions) string {
+		return encodeCursor(m.CreatedAt, m.ID)
+	})
 }
 
 // MarkMessagesAsRead marks all messages in a conversation as read for a user
 func (s *Services) MarkMessagesAsRead(ctx context.Context, conversationID, userID string) error {
 	// Validate that user exists
-	_, err := s.ent.User.Query().Where(user.IDEQ(userID)).First(ctx)
+	reader, err := s.ent.User.Query().Where(user.IDEQ(userID)).First(ctx)
 	if err != nil {
 		return fmt.Errorf("user not found: %w", err)
 	}
@@ -453,8 +894,8 @@ func (s *Services) MarkMessagesAsRead(ctx context.Context, conversationID, userI
 		return fmt.Errorf("failed to update last read timestamp: %w", err)
 	}
 
-	// Broadcast message read status if WebSocket hub is available
-	if s.WSHub != nil {
+	// Broadcast message read status unless the user turned read receipts off
+	if s.WSHub != nil && reader.ReadReceiptsEnabled {
 		err = s.BroadcastMessageRead(ctx, conversationID, userID)
 		if err != nil {
 			// Log error but don't fail the operation
@@ -465,13 +906,16 @@ func (s *Services) MarkMessagesAsRead(ctx context.Context, conversationID, userI
 	return nil
 }
 
-// DeleteMessage soft-deletes a message (marks as deleted but preserves record)
-func (s *Services) DeleteMessage(ctx context.Context, messageID, userID string) error {
-	// Validate that user exists
-	_, err := s.ent.User.Query().Where(user.IDEQ(userID)).First(ctx)
+// EditMessage replaces the content of a message sent by the user, keeping the previous content as a revision
+func (s *Services) EditMessage(ctx context.Context, messageID, userID, content string) (*ent.Message, error) {
+	doc, err := richtext.Parse(content)
 	if err != nil {
-		return fmt.Errorf("user not found: %w", err)
+		return nil, err
 	}
+	if doc.Content == "" {
+		return nil, fmt.Errorf("message content cannot be empty")
+	}
+	content = doc.Content
 
 	// Get the message and validate ownership
 	msg, err := s.ent.Message.Query().
@@ -479,233 +923,167 @@ func (s *Services) DeleteMessage(ctx context.Context, messageID, userID string)
 		First(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return fmt.Errorf("message not found")
+			return nil, fmt.Errorf("message not found")
 		}
-		return fmt.Errorf("failed to get message: %w", err)
+		return nil, fmt.Errorf("failed to get message: %w", err)
 	}
 
-	// Check if user is the sender of the message
 	if msg.SenderID != userID {
-		return fmt.Errorf("can only delete own messages")
+		return nil, fmt.Errorf("can only edit own messages")
 	}
-
-	// Check if message is already deleted
 	if msg.IsDeleted {
-		return fmt.Errorf("message is already deleted")
+		return nil, fmt.Errorf("cannot edit a deleted message")
 	}
-
-	// Soft delete the message
-	_, err = msg.Update().
-		SetIsDeleted(true).
-		Save(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to delete message: %w", err)
+	if msg.MessageType != message.MessageTypeText {
+		return nil, fmt.Errorf("only text messages can be edited")
 	}
-
-	return nil
-}
-
-// SearchMessages searches for messages containing the query string with content matching
-func (s *Services) SearchMessages(ctx context.Context, userID, query string, limit, offset int) ([]*MessageSearchResult, error) {
-	// Validate that user exists
-	_, err := s.ent.User.Query().Where(user.IDEQ(userID)).First(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("user not found: %w", err)
+	if s.messageEditWindow > 0 && time.Since(msg.CreatedAt) > s.messageEditWindow {
+		return nil, fmt.Errorf("message edit window has expired")
 	}
 
-	if strings.TrimSpace(query) == "" {
-		return []*MessageSearchResult{}, nil
+	// Nothing to record when the content is unchanged
+	if msg.Content == content {
+		return s.ent.Message.Query().Where(message.IDEQ(messageID)).WithSender().First(ctx)
 	}
 
-	// Get all conversations where user is a participant
-	userConversations, err := s.ent.ConversationParticipant.Query().
-		Where(conversationparticipant.UserIDEQ(userID)).
-		All(ctx)
+	// Only users mentioned for the first time by this edit are notified
+	previousMentions, err := s.resolveMentions(ctx, msg.ConversationID, userID, messageDocument(msg))
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user conversations: %w", err)
+		return nil, err
 	}
-
-	var conversationIDs []string
-	for _, cp := range userConversations {
-		conversationIDs = append(conversationIDs, cp.ConversationID)
+	mentions, err := s.resolveMentions(ctx, msg.ConversationID, userID, doc)
+	if err != nil {
+		return nil, err
 	}
 
-	if len(conversationIDs) == 0 {
-		return []*MessageSearchResult{}, nil
+	tx, err := s.ent.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
 	}
 
-	// Search for messages in user's conversations that contain the query
-	messages, err := s.ent.Message.Query().
+	// Only apply the edit if nobody changed the message since it was read
+	now := time.Now()
+	update := tx.Message.Update().
 		Where(
-			message.ConversationIDIn(conversationIDs...),
+			message.IDEQ(messageID),
+			message.UpdatedAtEQ(msg.UpdatedAt),
 			message.IsDeletedEQ(false),
-			message.ContentContainsFold(query),
 		).
-		Order(ent.Desc(message.FieldCreatedAt)).
-		Limit(limit).
-		Offset(offset).
-		WithSender().
-		WithConversation(func(q *ent.ConversationQuery) {
-			q.WithParticipants(func(pq *ent.ConversationParticipantQuery) {
-				pq.WithUser()
-			})
-		}).
-		All(ctx)
+		SetContent(content).
+		SetPlainText(doc.Text).
+		SetEditedAt(now)
+	if len(doc.Entities) > 0 {
+		update = update.SetEntities(doc.Entities)
+	} else {
+		update = update.ClearEntities()
+	}
+	updated, err := update.Save(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to search messages: %w", err)
+		return nil, rollback(tx, fmt.Errorf("failed to edit message: %w", err))
 	}
-
-	var results []*MessageSearchResult
-	for _, msg := range messages {
-		// Generate conversation name for display
-		conversationName := ""
-		if msg.Edges.Conversation != nil {
-			if msg.Edges.Conversation.Type == conversation.TypeDirect {
-				// For direct conversations, use the other participant's name
-				for _, p := range msg.Edges.Conversation.Edges.Participants {
-					if p.UserID != userID && p.Edges.User != nil {
-						conversationName = p.Edges.User.Name
-						break
-					}
-				}
-			} else {
-				// For group conversations, use the conversation name
-				conversationName = msg.Edges.Conversation.Name
-			}
-		}
-
-		// Create highlight snippet (simple implementation)
-		highlight := s.createHighlight(msg.Content, query)
-
-		results = append(results, &MessageSearchResult{
-			Message:          msg,
-			ConversationID:   msg.ConversationID,
-			ConversationName: conversationName,
-			Highlight:        highlight,
-		})
-	}
-
-	return results, nil
-}
-
-// createHighlight creates a highlighted snippet of the message content around the search query
-func (s *Services) createHighlight(content, query string) string {
-	lowerContent := strings.ToLower(content)
-	lowerQuery := strings.ToLower(query)
-
-	index := strings.Index(lowerContent, lowerQuery)
-	if index == -1 {
-		// Fallback: return first 100 characters if query not found
-		if len(content) > 100 {
-			return content[:100] + "..."
-		}
-		return content
+	if updated == 0 {
+		return nil, rollback(tx, fmt.Errorf("message was modified concurrently"))
 	}
 
-	// Create a snippet around the found query
-	start := index - 50
-	if start < 0 {
-		start = 0
-	}
-
-	end := index + len(query) + 50
-	if end > len(content) {
-		end = len(content)
+	_, err = tx.MessageRevision.Create().
+		SetMessageID(messageID).
+		SetEditorID(userID).
+		SetContent(msg.Content).
+		SetEditedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to save message revision: %w", err))
 	}
 
-	snippet := content[start:end]
-
-	// Add ellipsis if we truncated
-	if start > 0 {
-		snippet = "..." + snippet
+	_, err = tx.MessageMention.Delete().
+		Where(messagemention.MessageIDEQ(messageID)).
+		Exec(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to delete mentions: %w", err))
 	}
-	if end < len(content) {
-		snippet = snippet + "..."
+	if err := createMessageMentions(ctx, tx, messageID, mentions.mentions); err != nil {
+		return nil, rollback(tx, err)
 	}
 
-	return snippet
-}
-
-// ArchiveConversation archives a conversation for a specific user
-func (s *Services) ArchiveConversation(ctx context.Context, conversationID, userID string) error {
-	// Validate that user exists
-	_, err := s.ent.User.Query().Where(user.IDEQ(userID)).First(ctx)
+	// Previews of the old content are replaced once the new content is unfurled
+	_, err = tx.LinkPreview.Delete().
+		Where(linkpreview.MessageIDEQ(messageID)).
+		Exec(ctx)
 	if err != nil {
-		return fmt.Errorf("user not found: %w", err)
+		return nil, rollback(tx, fmt.Errorf("failed to delete link previews: %w", err))
 	}
 
-	// Validate that conversation exists
-	_, err = s.ent.Conversation.Query().Where(conversation.IDEQ(conversationID)).First(ctx)
-	if err != nil {
-		return fmt.Errorf("conversation not found: %w", err)
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
-	// Check if user is a participant in the conversation
-	participant, err := s.ent.ConversationParticipant.Query().
-		Where(
-			conversationparticipant.ConversationIDEQ(conversationID),
-			conversationparticipant.UserIDEQ(userID),
-		).
+	msg, err = s.ent.Message.Query().
+		Where(message.IDEQ(messageID)).
+		WithSender().
+		WithMentions().
 		First(ctx)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return fmt.Errorf("user is not a participant in this conversation")
-		}
-		return fmt.Errorf("failed to get participant: %w", err)
+		return nil, fmt.Errorf("failed to load message with sender: %w", err)
 	}
+	s.notifyMentions(ctx, msg, newlyMentioned(previousMentions.userIDs, mentions.userIDs))
+	s.enqueueLinkPreviews(msg)
 
-	// Archive the conversation for this user
-	_, err = participant.Update().
-		SetIsArchived(true).
-		Save(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to archive conversation: %w", err)
+	// Broadcast the edit to online participants
+	if s.WSHub != nil {
+		if err := s.BroadcastMessageUpdated(ctx, msg, msg.SenderID); err != nil {
+			// Log error but don't fail the edit
+			slog.Error("Failed to broadcast message update", "message_id", msg.ID, "error", err)
+		}
 	}
 
-	return nil
+	return msg, nil
 }
 
-// UnarchiveConversation unarchives a conversation for a specific user
-func (s *Services) UnarchiveConversation(ctx context.Context, conversationID, userID string) error {
-	// Validate that user exists
-	_, err := s.ent.User.Query().Where(user.IDEQ(userID)).First(ctx)
+// GetMessageRevisions returns the previous versions of a message, newest first
+func (s *Services) GetMessageRevisions(ctx context.Context, messageID, userID string) ([]*ent.MessageRevision, error) {
+	msg, err := s.ent.Message.Query().
+		Where(message.IDEQ(messageID)).
+		First(ctx)
 	if err != nil {
-		return fmt.Errorf("user not found: %w", err)
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("message not found")
+		}
+		return nil, fmt.Errorf("failed to get message: %w", err)
 	}
 
-	// Validate that conversation exists
-	_, err = s.ent.Conversation.Query().Where(conversation.IDEQ(conversationID)).First(ctx)
-	if err != nil {
-		return fmt.Errorf("conversation not found: %w", err)
+	// Revisions of deleted messages are not exposed
+	if msg.IsDeleted {
+		return nil, fmt.Errorf("message not found")
 	}
 
 	// Check if user is a participant in the conversation
-	participant, err := s.ent.ConversationParticipant.Query().
+	isParticipant, err := s.ent.ConversationParticipant.Query().
 		Where(
-			conversationparticipant.ConversationIDEQ(conversationID),
+			conversationparticipant.ConversationIDEQ(msg.ConversationID),
 			conversationparticipant.UserIDEQ(userID),
 		).
-		First(ctx)
+		Exist(ctx)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return fmt.Errorf("user is not a participant in this conversation")
-		}
-		return fmt.Errorf("failed to get participant: %w", err)
+		return nil, fmt.Errorf("failed to check participant status: %w", err)
+	}
+	if !isParticipant {
+		return nil, fmt.Errorf("user is not a participant in this conversation")
 	}
 
-	// Unarchive the conversation for this user
-	_, err = participant.Update().
-		SetIsArchived(false).
-		Save(ctx)
+	revisions, err := s.ent.MessageRevision.Query().
+		Where(messagerevision.MessageIDEQ(messageID)).
+		Order(ent.Desc(messagerevision.FieldEditedAt)).
+		WithEditor().
+		All(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to unarchive conversation: %w", err)
+		return nil, fmt.Errorf("failed to get message revisions: %w", err)
 	}
 
-	return nil
+	return revisions, nil
 }
 
-// MuteConversation mutes a conversation for a specific user
-func (s *Services) MuteConversation(ctx context.Context, conversationID, userID string) error {
+// ArchiveConversation archives a conversation for a specific user
+func (s *Services) ArchiveConversation(ctx context.Context, conversationID, userID string) error {
 	// Validate that user exists
 	_, err := s.ent.User.Query().Where(user.IDEQ(userID)).First(ctx)
 	if err != nil {
@@ -732,19 +1110,19 @@ func (s *Services) MuteConversation(ctx context.Context, conversationID, userID
 		return fmt.Errorf("failed to get participant: %w", err)
 	}
 
-	// Mute the conversation for this user
+	// Archive the conversation for this user
 	_, err = participant.Update().
-		SetIsMuted(true).
+		SetIsArchived(true).
 		Save(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to mute conversation: %w", err)
+		return fmt.Errorf("failed to archive conversation: %w", err)
 	}
 
 	return nil
 }
 
-// UnmuteConversation unmutes a conversation for a specific user
-func (s *Services) UnmuteConversation(ctx context.Context, conversationID, userID string) error {
+// UnarchiveConversation unarchives a conversation for a specific user
+func (s *Services) UnarchiveConversation(ctx context.Context, conversationID, userID string) error {
 	// Validate that user exists
 	_, err := s.ent.User.Query().Where(user.IDEQ(userID)).First(ctx)
 	if err != nil {
@@ -771,12 +1149,12 @@ func (s *Services) UnmuteConversation(ctx context.Context, conversationID, userI
 		return fmt.Errorf("failed to get participant: %w", err)
 	}
 
-	// Unmute the conversation for this user
+	// Unarchive the conversation for this user
 	_, err = participant.Update().
-		SetIsMuted(false).
+		SetIsArchived(false).
 		Save(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to unmute conversation: %w", err)
+		return fmt.Errorf("failed to unarchive conversation: %w", err)
 	}
 
 	return nil
@@ -851,6 +1229,8 @@ func (s *Services) SearchConversations(ctx context.Context, userID, query string
 			Where(
 				message.ConversationIDEQ(conv.ID),
 				message.IsDeletedEQ(false),
+				message.ThreadRootIDIsNil(),
+				notHiddenFor(userID),
 			).
 			Order(ent.Desc(message.FieldCreatedAt)).
 			WithSender().
@@ -869,22 +1249,25 @@ func (s *Services) SearchConversations(ctx context.Context, userID, query string
 
 		// Get user-specific archive and mute status
 		isArchived := false
-		isMuted := false
+		muted := false
+		var settings *NotificationSettings
 		for _, p := range conv.Edges.Participants {
 			if p.UserID == userID {
 				isArchived = p.IsArchived
-				isMuted = p.IsMuted
+				muted = isMuted(p, time.Now())
+				settings = notificationSettings(p)
 				break
 			}
 		}
 
 		result = append(result, &ConversationWithDetails{
-			Conversation: conv,
-			Participants: participants,
-			LastMessage:  lastMessage,
-			UnreadCount:  unreadCount,
-			IsArchived:   isArchived,
-			IsMuted:      isMuted,
+			Conversation:         conv,
+			Participants:         participants,
+			LastMessage:          lastMessage,
+			UnreadCount:          unreadCount,
+			IsArchived:           isArchived,
+			IsMuted:              muted,
+			NotificationSettings: settings,
 		})
 	}
 
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"kakashi/chaos/internal/ent"
+	"kakashi/chaos/internal/ent/message"
+)
+
+// maxNonceLength bounds client generated message nonces
+const maxNonceLength = 128
+
+// validateNonce checks that a nonce is short printable ASCII
+func validateNonce(nonce string) error {
+	if len(nonce) > maxNonceLength {
+		return fmt.Errorf("invalid nonce")
+	}
+	for i := 0; i < len(nonce); i++ {
+		if nonce[i] < 0x21 || nonce[i] > 0x7e {
+			return fmt.Errorf("invalid nonce")
+		}
+	}
+	return nil
+}
+
+// findMessageByNonce returns the message a sender already sent to a conversation with a nonce within the
+// dedupe window, or nil. A match outside the window has its nonce cleared so the nonce can be sent again
+func (s *Services) findMessageByNonce(ctx context.Context, senderID, conversationID, nonce string) (*ent.Message, error) {
+	existing, err := s.ent.Message.Query().
+		Where(
+			message.SenderIDEQ(senderID),
+			message.ConversationIDEQ(conversationID),
+			message.NonceEQ(nonce),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get message by nonce: %w", err)
+	}
+
+	if time.Since(existing.CreatedAt) > s.messageNonceWindow {
+		if err := s.ent.Message.UpdateOneID(existing.ID).ClearNonce().Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to clear expired nonce: %w", err)
+		}
+		return nil, nil
+	}
+
+	return s.loadSentMessage(ctx, existing.ID)
+}
+
+// clearExpiredNonces releases the nonces of messages sent before the dedupe window
+func (s *Services) clearExpiredNonces(ctx context.Context) {
+	if s.messageNonceWindow <= 0 {
+		return
+	}
+	if _, err := s.ent.Message.Update().
+		Where(
+			message.NonceNotNil(),
+			message.CreatedAtLT(time.Now().Add(-s.messageNonceWindow)),
+		).
+		ClearNonce().
+		Save(ctx); err != nil {
+		slog.Error("Failed to clear expired message nonces", "error", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"kakashi/chaos/internal/ent"
+	"kakashi/chaos/internal/ent/conversationparticipant"
 	"kakashi/chaos/internal/ent/notification"
 	"kakashi/chaos/internal/ent/user"
 )
@@ -57,38 +58,35 @@ func (s *Services) CreateNotification(ctx context.Context, userID, notificationT
 	return notif, nil
 }
 
-// GetUserNotifications returns paginated notifications for a user, ordered by creation date (newest first)
-func (s *Services) GetUserNotifications(ctx context.Context, userID string, limit, offset int) ([]*ent.Notification, error) {
+// GetUserNotifications returns a keyset page of notifications for a user, newest first
+func (s *Services) GetUserNotifications(ctx context.Context, userID string, page PageRequest) (*Page[*ent.Notification], error) {
 	// Validate that the user exists
 	_, err := s.ent.User.Query().Where(user.IDEQ(userID)).First(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("user not found: %w", err)
 	}
 
-	// Set default limit if not provided or invalid
-	if limit <= 0 || limit > 100 {
-		limit = 20
-	}
-
-	// Set default offset if negative
-	if offset < 0 {
-		offset = 0
-	}
-
-	notifications, err := s.ent.Notification.Query().
-		Where(notification.UserIDEQ(userID)).
-		WithRelatedUser().
-		WithRelatedConversation().
-		Order(ent.Desc(notification.FieldCreatedAt)).
-		Limit(limit).
-		Offset(offset).
-		All(ctx)
-
-	if err != nil {
-		return nil, fmt.Errorf("failed to get notifications: %w", err)
+	createdAt := keysetColumn(notification.FieldCreatedAt)
+	fetch := func(older bool, cursor *pageCursor, inclusive bool, limit int) ([]*ent.Notification, error) {
+		query := s.ent.Notification.Query().
+			Where(notification.UserIDEQ(userID)).
+			WithRelatedUser().
+			WithRelatedConversation().
+			Order(keysetOrder(createdAt, older)).
+			Limit(limit)
+		if cursor != nil {
+			query = query.Where(keysetWhere(createdAt, older, inclusive, cursor))
+		}
+		notifications, err := query.All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get notifications: %w", err)
+		}
+		return notifications, nil
 	}
 
-	return notifications, nil
+	return paginate(page, nil, fetch, func(n *ent.Notification) string {
+		return encodeCursor(n.CreatedAt, n.ID)
+	})
 }
 
 // MarkNotificationAsRead marks a specific notification as read
@@ -195,8 +193,17 @@ func (s *Services) CreateFriendAcceptedNotification(ctx context.Context, request
 	return s.CreateNotification(ctx, requesterID, string(notification.TypeFriendAccepted), title, content, &addresseeID, nil)
 }
 
-// CreateMessageNotification creates a notification for a new message
+// CreateMessageNotification creates a notification for a new message. Nothing is created, and nil is
+// returned, while the recipient has the conversation muted or set to mentions only
 func (s *Services) CreateMessageNotification(ctx context.Context, recipientID, senderID, conversationID string) (*ent.Notification, error) {
+	levels, err := s.notificationLevels(ctx, conversationID, []string{recipientID})
+	if err != nil {
+		return nil, err
+	}
+	if level, ok := levels[recipientID]; ok && level != conversationparticipant.NotificationLevelAll {
+		return nil, nil
+	}
+
 	// Get sender details for the notification content
 	sender, err := s.ent.User.Query().Where(user.IDEQ(senderID)).First(ctx)
 	if err != nil {
@@ -0,0 +1,224 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"kakashi/chaos/internal/ent"
+	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/conversationparticipant"
+	"kakashi/chaos/internal/ent/messagemention"
+	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/user"
+)
+
+// maxMuteDuration is how far in the future a timed mute can end
+const maxMuteDuration = 365 * 24 * time.Hour
+
+// NotificationSettings are a participant's notification preferences for one conversation. Level is the
+// level in effect now, a timed mute that has ended reads as all
+type NotificationSettings struct {
+	Level         string     `json:"level"`
+	MutedUntil    *time.Time `json:"muted_until,omitempty"`
+	ShowWhenMuted bool       `json:"show_when_muted"`
+}
+
+// NotificationSettingsUpdate holds the settings to change, nil fields are kept. Setting a level other
+// than all without MutedUntil mutes until changed
+type NotificationSettingsUpdate struct {
+	Level         *string
+	MutedUntil    *time.Time
+	ShowWhenMuted *bool
+}
+
+// notificationLevel returns the level in effect for a participant, muted levels revert to all once muted_until passes
+func notificationLevel(p *ent.ConversationParticipant, now time.Time) conversationparticipant.NotificationLevel {
+	if p.NotificationLevel != conversationparticipant.NotificationLevelAll && !p.MutedUntil.IsZero() && !p.MutedUntil.After(now) {
+		return conversationparticipant.NotificationLevelAll
+	}
+	return p.NotificationLevel
+}
+
+// isMuted reports whether a participant currently silences anything in the conversation
+func isMuted(p *ent.ConversationParticipant, now time.Time) bool {
+	return notificationLevel(p, now) != conversationparticipant.NotificationLevelAll
+}
+
+// alertsFor reports whether a message alerts a participant, mentions only alerts for messages that mention
+// them directly or through @everyone
+func alertsFor(p *ent.ConversationParticipant, msg *ent.Message, now time.Time) bool {
+	switch notificationLevel(p, now) {
+	case conversationparticipant.NotificationLevelAll:
+		return true
+	case conversationparticipant.NotificationLevelMentions:
+		for _, m := range msg.Edges.Mentions {
+			if m.Kind == messagemention.KindEveryone || m.UserID == p.UserID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// notificationSettings returns the settings of a participant as the API shows them
+func notificationSettings(p *ent.ConversationParticipant) *NotificationSettings {
+	now := time.Now()
+	settings := &NotificationSettings{
+		Level:         string(notificationLevel(p, now)),
+		ShowWhenMuted: p.ShowWhenMuted,
+	}
+	if isMuted(p, now) && !p.MutedUntil.IsZero() {
+		mutedUntil := p.MutedUntil
+		settings.MutedUntil = &mutedUntil
+	}
+	return settings
+}
+
+// hiddenWhenMuted matches participants whose conversation is left out of the default list while muted
+func hiddenWhenMuted(now time.Time) predicate.ConversationParticipant {
+	return conversationparticipant.And(
+		conversationparticipant.NotificationLevelNEQ(conversationparticipant.NotificationLevelAll),
+		conversationparticipant.ShowWhenMutedEQ(false),
+		conversationparticipant.Or(
+			conversationparticipant.MutedUntilIsNil(),
+			conversationparticipant.MutedUntilGT(now),
+		),
+	)
+}
+
+// UpdateNotificationSettings changes how a user is notified about a conversation
+func (s *Services) UpdateNotificationSettings(ctx context.Context, conversationID, userID string, update NotificationSettingsUpdate) (*NotificationSettings, error) {
+	if update.Level != nil {
+		if err := conversationparticipant.NotificationLevelValidator(conversationparticipant.NotificationLevel(*update.Level)); err != nil {
+			return nil, fmt.Errorf("invalid notification level")
+		}
+	}
+	if update.MutedUntil != nil {
+		now := time.Now()
+		if !update.MutedUntil.After(now) {
+			return nil, fmt.Errorf("mute end must be in the future")
+		}
+		if update.MutedUntil.After(now.Add(maxMuteDuration)) {
+			return nil, fmt.Errorf("mute end is too far in the future")
+		}
+	}
+
+	participant, err := s.getNotificationParticipant(ctx, conversationID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := participant.Update()
+	if update.Level != nil {
+		builder = builder.SetNotificationLevel(conversationparticipant.NotificationLevel(*update.Level))
+		// A new level starts a new mute, an earlier end time does not carry over
+		if update.MutedUntil == nil {
+			builder = builder.ClearMutedUntil()
+		}
+	}
+	if update.MutedUntil != nil {
+		if update.Level == nil && !isMuted(participant, time.Now()) {
+			// Muting for a while without a level silences everything but mentions
+			builder = builder.SetNotificationLevel(conversationparticipant.NotificationLevelMentions)
+		}
+		builder = builder.SetMutedUntil(*update.MutedUntil)
+	}
+	if update.ShowWhenMuted != nil {
+		builder = builder.SetShowWhenMuted(*update.ShowWhenMuted)
+	}
+
+	participant, err = builder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update notification settings: %w", err)
+	}
+
+	return notificationSettings(participant), nil
+}
+
+// MuteConversation mutes a conversation for a specific user until it is unmuted, mentions still notify
+func (s *Services) MuteConversation(ctx context.Context, conversationID, userID string) error {
+	participant, err := s.getNotificationParticipant(ctx, conversationID, userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = participant.Update().
+		SetNotificationLevel(conversationparticipant.NotificationLevelMentions).
+		ClearMutedUntil().
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to mute conversation: %w", err)
+	}
+
+	return nil
+}
+
+// UnmuteConversation unmutes a conversation for a specific user
+func (s *Services) UnmuteConversation(ctx context.Context, conversationID, userID string) error {
+	participant, err := s.getNotificationParticipant(ctx, conversationID, userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = participant.Update().
+		SetNotificationLevel(conversationparticipant.NotificationLevelAll).
+		ClearMutedUntil().
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to unmute conversation: %w", err)
+	}
+
+	return nil
+}
+
+// getNotificationParticipant loads the participant record whose notification settings a user changes
+func (s *Services) getNotificationParticipant(ctx context.Context, conversationID, userID string) (*ent.ConversationParticipant, error) {
+	// Validate that user exists
+	_, err := s.ent.User.Query().Where(user.IDEQ(userID)).First(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	// Validate that conversation exists
+	_, err = s.ent.Conversation.Query().Where(conversation.IDEQ(conversationID)).First(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("conversation not found: %w", err)
+	}
+
+	participant, err := s.ent.ConversationParticipant.Query().
+		Where(
+			conversationparticipant.ConversationIDEQ(conversationID),
+			conversationparticipant.UserIDEQ(userID),
+		).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("user is not a participant in this conversation")
+		}
+		return nil, fmt.Errorf("failed to get participant: %w", err)
+	}
+
+	return participant, nil
+}
+
+// notificationLevels returns the level in effect for each of the users in a conversation, users who are
+// not participants are left out
+func (s *Services) notificationLevels(ctx context.Context, conversationID string, userIDs []string) (map[string]conversationparticipant.NotificationLevel, error) {
+	participants, err := s.ent.ConversationParticipant.Query().
+		Where(
+			conversationparticipant.ConversationIDEQ(conversationID),
+			conversationparticipant.UserIDIn(userIDs...),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notification settings: %w", err)
+	}
+
+	now := time.Now()
+	levels := make(map[string]conversationparticipant.NotificationLevel, len(participants))
+	for _, p := range participants {
+		levels[p.UserID] = notificationLevel(p, now)
+	}
+	return levels, nil
+}
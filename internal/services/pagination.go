@@ -0,0 +1,234 @@
+package services
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 100
+)
+
+// PageRequest selects a window of a newest first listing. At most one of Before, After and Around is set,
+// none returns the newest items
+type PageRequest struct {
+	Limit  int
+	Before string // cursor, returns items older than it
+	After  string // cursor, returns items newer than it
+	Around string // cursor, returns items on both sides of it including the item itself
+}
+
+// Page is a newest first window of a listing. NextCursor continues with older items as Before,
+// PrevCursor continues with newer items as After; each is empty when there is nothing further that way
+type Page[T any] struct {
+	Items      []T    `json:"items"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
+}
+
+// pageCursor is the decoded position of an item in a keyset ordered listing
+type pageCursor struct {
+	at time.Time
+	id string
+}
+
+// encodeCursor builds an opaque cursor from an item's sort timestamp and ID
+func encodeCursor(at time.Time, id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(at.UTC().Format(time.RFC3339Nano) + "|" + id))
+}
+
+// decodeCursor parses a cursor produced by encodeCursor
+func decodeCursor(value string) (*pageCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	at, id, ok := strings.Cut(string(raw), "|")
+	if !ok || id == "" {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	t, err := time.Parse(time.RFC3339Nano, at)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	return &pageCursor{at: t, id: id}, nil
+}
+
+// validate checks that the request names at most one direction and normalizes the limit
+func (r *PageRequest) validate() error {
+	set := 0
+	for _, v := range []string{r.Before, r.After, r.Around} {
+		if v != "" {
+			set++
+		}
+	}
+	if set > 1 {
+		return fmt.Errorf("only one of before, after and around can be used")
+	}
+	if r.Limit <= 0 {
+		r.Limit = defaultPageLimit
+	}
+	if r.Limit > maxPageLimit {
+		r.Limit = maxPageLimit
+	}
+	return nil
+}
+
+// keysetFetch loads up to limit items past the cursor. Older fetches walk newest first from the cursor,
+// newer fetches walk oldest first; inclusive also returns the item at the cursor and a nil cursor starts at the newest item
+type keysetFetch[T any] func(older bool, cursor *pageCursor, inclusive bool, limit int) ([]T, error)
+
+// paginate runs the keyset fetches for a request and assembles a newest first page. around is an anchor the
+// caller resolved itself, it is used when Around is empty
+func paginate[T any](req PageRequest, around *pageCursor, fetch keysetFetch[T], key func(T) string) (*Page[T], error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+	if req.Around != "" && around == nil {
+		c, err := decodeCursor(req.Around)
+		if err != nil {
+			return nil, err
+		}
+		around = c
+	}
+
+	page := &Page[T]{}
+	switch {
+	case around != nil:
+		// The anchor and the older half come from one walk, the newer half from the other
+		newerLimit := req.Limit / 2
+		olderLimit := req.Limit - newerLimit
+		older, err := fetch(true, around, true, olderLimit+1)
+		if err != nil {
+			return nil, err
+		}
+		newer, err := fetch(false, around, false, newerLimit+1)
+		if err != nil {
+			return nil, err
+		}
+		hasOlder := len(older) > olderLimit
+		if hasOlder {
+			older = older[:olderLimit]
+		}
+		hasNewer := len(newer) > newerLimit
+		if hasNewer {
+			newer = newer[:newerLimit]
+		}
+		reverseItems(newer)
+		page.Items = append(newer, older...)
+		if len(page.Items) > 0 {
+			if hasOlder {
+				page.NextCursor = key(page.Items[len(page.Items)-1])
+			}
+			if hasNewer {
+				page.PrevCursor = key(page.Items[0])
+			}
+		}
+
+	case req.After != "":
+		after, err := decodeCursor(req.After)
+		if err != nil {
+			return nil, err
+		}
+		items, err := fetch(false, after, false, req.Limit+1)
+		if err != nil {
+			return nil, err
+		}
+		hasNewer := len(items) > req.Limit
+		if hasNewer {
+			items = items[:req.Limit]
+		}
+		reverseItems(items)
+		page.Items = items
+		if len(items) > 0 {
+			// The after cursor itself is older, so there is always something further back
+			page.NextCursor = key(items[len(items)-1])
+			if hasNewer {
+				page.PrevCursor = key(items[0])
+			}
+		}
+
+	default:
+		var before *pageCursor
+		if req.Before != "" {
+			c, err := decodeCursor(req.Before)
+			if err != nil {
+				return nil, err
+			}
+			before = c
+		}
+		items, err := fetch(true, before, false, req.Limit+1)
+		if err != nil {
+			return nil, err
+		}
+		hasOlder := len(items) > req.Limit
+		if hasOlder {
+			items = items[:req.Limit]
+		}
+		page.Items = items
+		if len(items) > 0 {
+			if hasOlder {
+				page.NextCursor = key(items[len(items)-1])
+			}
+			// Paging back from a cursor always leaves the cursor item and newer ones ahead
+			if before != nil {
+				page.PrevCursor = key(items[0])
+			}
+		}
+	}
+
+	if page.Items == nil {
+		page.Items = []T{}
+	}
+	return page, nil
+}
+
+// reverseItems reverses a slice in place
+func reverseItems[T any](items []T) {
+	for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+		items[i], items[j] = items[j], items[i]
+	}
+}
+
+// keysetColumn returns a sort key reading a single timestamp column
+func keysetColumn(column string) func(*sql.Selector) string {
+	return func(s *sql.Selector) string {
+		return s.C(column)
+	}
+}
+
+// keysetWhere restricts a query to rows past the cursor, comparing (sort key, id) as a row value
+func keysetWhere(key func(*sql.Selector) string, older, inclusive bool, c *pageCursor) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		op := ">"
+		if older {
+			op = "<"
+		}
+		if inclusive {
+			op += "="
+		}
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("(").WriteString(key(s)).Comma().WriteString(s.C("id")).WriteString(") ").
+				WriteString(op).
+				WriteString(" (").Arg(c.at).Comma().Arg(c.id).WriteString(")")
+		}))
+	}
+}
+
+// keysetOrder orders a query by (sort key, id), newest first for older walks
+func keysetOrder(key func(*sql.Selector) string, older bool) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		dir := " ASC"
+		if older {
+			dir = " DESC"
+		}
+		s.OrderExprFunc(func(b *sql.Builder) {
+			b.WriteString(key(s) + dir).Comma().WriteString(s.C("id") + dir)
+		})
+	}
+}
@@ -38,7 +38,7 @@ func (ps *PerformanceService) GetUserConversationsOptimized(ctx context.Context,
 	if !includeMuted {
 		participantFilter = conversationparticipant.And(
 			participantFilter,
-			conversationparticipant.IsMutedEQ(false),
+			conversationparticipant.Not(hiddenWhenMuted(time.Now())),
 		)
 	}
 
@@ -96,19 +96,22 @@ func (ps *PerformanceService) GetUserConversationsOptimized(ctx context.Context,
 
 		// Get user-specific settings
 		isArchived := false
-		isMuted := false
+		muted := false
+		var settings *NotificationSettings
 		if userParticipant != nil {
 			isArchived = userParticipant.IsArchived
-			isMuted = userParticipant.IsMuted
+			muted = isMuted(userParticipant, time.Now())
+			settings = notificationSettings(userParticipant)
 		}
 
 		result = append(result, &ConversationWithDetails{
-			Conversation: conv,
-			Participants: participants,
-			LastMessage:  lastMessage,
-			UnreadCount:  unreadCount,
-			IsArchived:   isArchived,
-			IsMuted:      isMuted,
+			Conversation:         conv,
+			Participants:         participants,
+			LastMessage:          lastMessage,
+			UnreadCount:          unreadCount,
+			IsArchived:           isArchived,
+			IsMuted:              muted,
+			NotificationSettings: settings,
 		})
 	}
 
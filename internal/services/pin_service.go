@@ -0,0 +1,194 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"kakashi/chaos/internal/ent"
+	"kakashi/chaos/internal/ent/conversationparticipant"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/pinnedmessage"
+	"kakashi/chaos/internal/ws"
+)
+
+// maxPinsPerConversation is the number of messages that can be pinned in one conversation
+const maxPinsPerConversation = 50
+
+// PinMessage pins a message to its conversation and announces it with a system message
+func (s *Services) PinMessage(ctx context.Context, conversationID, messageID, userID string) (*ent.PinnedMessage, error) {
+	actor, msg, err := s.getPinnableMessage(ctx, conversationID, messageID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if msg.MessageType == message.MessageTypeSystem || msg.MessageType == message.MessageTypeCallStart ||
+		msg.MessageType == message.MessageTypeCallEnd {
+		return nil, fmt.Errorf("cannot pin this message")
+	}
+
+	tx, err := s.ent.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	alreadyPinned, err := tx.PinnedMessage.Query().
+		Where(pinnedmessage.MessageIDEQ(messageID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to check pin: %w", err))
+	}
+	if alreadyPinned {
+		return nil, rollback(tx, fmt.Errorf("message is already pinned"))
+	}
+
+	pinCount, err := tx.PinnedMessage.Query().
+		Where(pinnedmessage.ConversationIDEQ(conversationID)).
+		Count(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to count pins: %w", err))
+	}
+	if pinCount >= maxPinsPerConversation {
+		return nil, rollback(tx, fmt.Errorf("pin limit reached"))
+	}
+
+	pin, err := tx.PinnedMessage.Create().
+		SetConversationID(conversationID).
+		SetMessageID(messageID).
+		SetPinnedByID(userID).
+		Save(ctx)
+	if err != nil {
+		// A concurrent pin of the same message hits the unique index
+		if ent.IsConstraintError(err) {
+			return nil, rollback(tx, fmt.Errorf("message is already pinned"))
+		}
+		return nil, rollback(tx, fmt.Errorf("failed to pin message: %w", err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.postPinChange(ctx, conversationID, messageID, userID,
+		fmt.Sprintf("%s pinned a message", actor.Edges.User.Username), ws.MessageTypeMessagePinned)
+
+	return pin, nil
+}
+
+// UnpinMessage removes a pinned message from its conversation and announces it with a system message
+func (s *Services) UnpinMessage(ctx context.Context, conversationID, messageID, userID string) error {
+	actor, _, err := s.getPinnableMessage(ctx, conversationID, messageID, userID)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := s.ent.PinnedMessage.Delete().
+		Where(
+			pinnedmessage.ConversationIDEQ(conversationID),
+			pinnedmessage.MessageIDEQ(messageID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to unpin message: %w", err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("message is not pinned")
+	}
+
+	s.postPinChange(ctx, conversationID, messageID, userID,
+		fmt.Sprintf("%s unpinned a message", actor.Edges.User.Username), ws.MessageTypeMessageUnpinned)
+
+	return nil
+}
+
+// GetPinnedMessages returns the pinned messages of a conversation, most recently pinned first
+func (s *Services) GetPinnedMessages(ctx context.Context, conversationID, userID string) ([]*ent.PinnedMessage, error) {
+	isParticipant, err := s.ent.ConversationParticipant.Query().
+		Where(
+			conversationparticipant.ConversationIDEQ(conversationID),
+			conversationparticipant.UserIDEQ(userID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check participant status: %w", err)
+	}
+	if !isParticipant {
+		return nil, fmt.Errorf("user is not a participant in this conversation")
+	}
+
+	// Pins of messages the user deleted for themselves are left out with the message
+	pins, err := s.ent.PinnedMessage.Query().
+		Where(
+			pinnedmessage.ConversationIDEQ(conversationID),
+			pinnedmessage.HasMessageWith(notHiddenFor(userID)),
+		).
+		Order(ent.Desc(pinnedmessage.FieldCreatedAt)).
+		WithPinnedBy().
+		WithMessage(func(q *ent.MessageQuery) {
+			q.WithSender().WithAttachments()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pinned messages: %w", err)
+	}
+
+	return pins, nil
+}
+
+// getPinnableMessage loads the acting participant and a live message of the conversation
+func (s *Services) getPinnableMessage(ctx context.Context, conversationID, messageID, userID string) (*ent.ConversationParticipant, *ent.Message, error) {
+	actor, err := s.ent.ConversationParticipant.Query().
+		Where(
+			conversationparticipant.ConversationIDEQ(conversationID),
+			conversationparticipant.UserIDEQ(userID),
+		).
+		WithUser().
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, fmt.Errorf("user is not a participant in this conversation")
+		}
+		return nil, nil, fmt.Errorf("failed to check participant status: %w", err)
+	}
+
+	msg, err := s.ent.Message.Query().
+		Where(
+			message.IDEQ(messageID),
+			message.ConversationIDEQ(conversationID),
+			message.IsDeletedEQ(false),
+		).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, fmt.Errorf("message not found")
+		}
+		return nil, nil, fmt.Errorf("failed to get message: %w", err)
+	}
+
+	return actor, msg, nil
+}
+
+// postPinChange posts the system message for a pin change and broadcasts the change to the conversation
+func (s *Services) postPinChange(ctx context.Context, conversationID, messageID, userID, content string, messageType ws.MessageType) {
+	if _, err := s.createSystemMessage(ctx, conversationID, userID, content); err != nil {
+		// Log error but don't fail the pin change
+		slog.Error("Failed to create system message", "conversation_id", conversationID, "error", err)
+	}
+
+	s.broadcastPinChange(ctx, conversationID, messageID, userID, messageType)
+}
+
+// broadcastPinChange tells online participants that a message was pinned or unpinned
+func (s *Services) broadcastPinChange(ctx context.Context, conversationID, messageID, userID string, messageType ws.MessageType) {
+	if s.WSHub == nil {
+		return
+	}
+
+	err := s.BroadcastToConversation(ctx, conversationID, messageType, ws.PinData{
+		ConversationID: conversationID,
+		MessageID:      messageID,
+		UserID:         userID,
+	}, "")
+	if err != nil {
+		slog.Error("Failed to broadcast pin change", "conversation_id", conversationID, "message_id", messageID, "error", err)
+	}
+}
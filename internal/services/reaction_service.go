@@ -0,0 +1,336 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"sort"
+	"unicode"
+	"unicode/utf8"
+
+	"kakashi/chaos/internal/ent"
+	"kakashi/chaos/internal/ent/conversationparticipant"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/messagereaction"
+	"kakashi/chaos/internal/ws"
+)
+
+const (
+	// maxReactionEmojisPerMessage is the number of distinct emojis a single message can carry
+	maxReactionEmojisPerMessage = 20
+	// maxEmojiBytes bounds Unicode emoji sequences such as flags and ZWJ families
+	maxEmojiBytes = 64
+)
+
+// customEmojiPattern matches custom emoji names such as :party_parrot:
+var customEmojiPattern = regexp.MustCompile(`^:[A-Za-z0-9_\-]{2,32}:$`)
+
+// ReactionSummary represents the aggregated reactions of one emoji on a message
+type ReactionSummary struct {
+	Emoji       string `json:"emoji"`
+	Count       int    `json:"count"`
+	ReactedByMe bool   `json:"reacted_by_me"`
+}
+
+// ReactionUsers represents the users that reacted to a message with one emoji
+type ReactionUsers struct {
+	Emoji string      `json:"emoji"`
+	Count int         `json:"count"`
+	Users []*ent.User `json:"users"`
+}
+
+// MessageWithReactions represents a message with its aggregated reactions
+type MessageWithReactions struct {
+	*ent.Message
+	Reactions []ReactionSummary `json:"reactions"`
+}
+
+// AddReaction adds an emoji reaction from the user to a message, doing nothing if it already exists
+func (s *Services) AddReaction(ctx context.Context, messageID, userID, emoji string) error {
+	if err := validateEmoji(emoji); err != nil {
+		return err
+	}
+
+	msg, err := s.getReactableMessage(ctx, messageID, userID)
+	if err != nil {
+		return err
+	}
+
+	exists, err := s.ent.MessageReaction.Query().
+		Where(
+			messagereaction.MessageIDEQ(messageID),
+			messagereaction.UserIDEQ(userID),
+			messagereaction.EmojiEQ(emoji),
+		).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check reaction: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	// Limit the number of distinct emojis, existing ones can always be joined
+	emojiUsed, err := s.ent.MessageReaction.Query().
+		Where(
+			messagereaction.MessageIDEQ(messageID),
+			messagereaction.EmojiEQ(emoji),
+		).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check reaction: %w", err)
+	}
+	if !emojiUsed {
+		var distinct []string
+		err = s.ent.MessageReaction.Query().
+			Where(messagereaction.MessageIDEQ(messageID)).
+			Unique(true).
+			Select(messagereaction.FieldEmoji).
+			Scan(ctx, &distinct)
+		if err != nil {
+			return fmt.Errorf("failed to count reactions: %w", err)
+		}
+		if len(distinct) >= maxReactionEmojisPerMessage {
+			return fmt.Errorf("reaction limit reached")
+		}
+	}
+
+	_, err = s.ent.MessageReaction.Create().
+		SetMessageID(messageID).
+		SetUserID(userID).
+		SetEmoji(emoji).
+		Save(ctx)
+	if err != nil {
+		// A concurrent request may have added the same reaction
+		if ent.IsConstraintError(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to add reaction: %w", err)
+	}
+
+	s.broadcastReactionChange(ctx, msg, userID, emoji, ws.MessageTypeReactionAdded)
+
+	return nil
+}
+
+// RemoveReaction removes an emoji reaction of the user from a message
+func (s *Services) RemoveReaction(ctx context.Context, messageID, userID, emoji string) error {
+	msg, err := s.getReactableMessage(ctx, messageID, userID)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := s.ent.MessageReaction.Delete().
+		Where(
+			messagereaction.MessageIDEQ(messageID),
+			messagereaction.UserIDEQ(userID),
+			messagereaction.EmojiEQ(emoji),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to remove reaction: %w", err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("reaction not found")
+	}
+
+	s.broadcastReactionChange(ctx, msg, userID, emoji, ws.MessageTypeReactionRemoved)
+
+	return nil
+}
+
+// GetMessageReactions lists the users that reacted to a message, grouped by emoji and optionally filtered to one emoji
+func (s *Services) GetMessageReactions(ctx context.Context, messageID, userID, emoji string) ([]*ReactionUsers, error) {
+	if _, err := s.getReactableMessage(ctx, messageID, userID); err != nil {
+		return nil, err
+	}
+
+	query := s.ent.MessageReaction.Query().
+		Where(messagereaction.MessageIDEQ(messageID))
+	if emoji != "" {
+		query = query.Where(messagereaction.EmojiEQ(emoji))
+	}
+
+	reactions, err := query.
+		Order(ent.Asc(messagereaction.FieldCreatedAt)).
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reactions: %w", err)
+	}
+
+	// Group by emoji in order of first use
+	result := []*ReactionUsers{}
+	byEmoji := make(map[string]*ReactionUsers)
+	for _, r := range reactions {
+		group, ok := byEmoji[r.Emoji]
+		if !ok {
+			group = &ReactionUsers{Emoji: r.Emoji, Users: []*ent.User{}}
+			byEmoji[r.Emoji] = group
+			result = append(result, group)
+		}
+		group.Count++
+		if r.Edges.User != nil {
+			group.Users = append(group.Users, r.Edges.User)
+		}
+	}
+
+	return result, nil
+}
+
+// attachReactions aggregates the reactions of a page of messages with two queries regardless of page size
+func (s *Services) attachReactions(ctx context.Context, messages []*ent.Message, userID string) ([]*MessageWithReactions, error) {
+	result := make([]*MessageWithReactions, len(messages))
+	if len(messages) == 0 {
+		return result, nil
+	}
+
+	messageIDs := make([]string, len(messages))
+	for i, msg := range messages {
+		messageIDs[i] = msg.ID
+	}
+
+	var counts []struct {
+		MessageID string `json:"message_id"`
+		Emoji     string `json:"emoji"`
+		Count     int    `json:"count"`
+	}
+	err := s.ent.MessageReaction.Query().
+		Where(messagereaction.MessageIDIn(messageIDs...)).
+		GroupBy(messagereaction.FieldMessageID, messagereaction.FieldEmoji).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate reactions: %w", err)
+	}
+
+	mine, err := s.ent.MessageReaction.Query().
+		Where(
+			messagereaction.MessageIDIn(messageIDs...),
+			messagereaction.UserIDEQ(userID),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user reactions: %w", err)
+	}
+
+	reactedByMe := make(map[string]bool, len(mine))
+	for _, r := range mine {
+		reactedByMe[r.MessageID+"\x00"+r.Emoji] = true
+	}
+
+	summaries := make(map[string][]ReactionSummary)
+	for _, c := range counts {
+		summaries[c.MessageID] = append(summaries[c.MessageID], ReactionSummary{
+			Emoji:       c.Emoji,
+			Count:       c.Count,
+			ReactedByMe: reactedByMe[c.MessageID+"\x00"+c.Emoji],
+		})
+	}
+
+	for i, msg := range messages {
+		reactions := summaries[msg.ID]
+		if reactions == nil {
+			reactions = []ReactionSummary{}
+		}
+		// Most used emojis first, ties broken by emoji for a stable order
+		sort.Slice(reactions, func(a, b int) bool {
+			if reactions[a].Count != reactions[b].Count {
+				return reactions[a].Count > reactions[b].Count
+			}
+			return reactions[a].Emoji < reactions[b].Emoji
+		})
+		result[i] = &MessageWithReactions{Message: msg, Reactions: reactions}
+	}
+
+	return result, nil
+}
+
+// getReactableMessage loads a non-deleted message in a conversation the user participates in
+func (s *Services) getReactableMessage(ctx context.Context, messageID, userID string) (*ent.Message, error) {
+	msg, err := s.ent.Message.Query().
+		Where(
+			message.IDEQ(messageID),
+			message.IsDeletedEQ(false),
+		).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("message not found")
+		}
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+
+	// Check if user is a participant in the conversation
+	isParticipant, err := s.ent.ConversationParticipant.Query().
+		Where(
+			conversationparticipant.ConversationIDEQ(msg.ConversationID),
+			conversationparticipant.UserIDEQ(userID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check participant status: %w", err)
+	}
+	if !isParticipant {
+		return nil, fmt.Errorf("user is not a participant in this conversation")
+	}
+
+	return msg, nil
+}
+
+// broadcastReactionChange sends a reaction change with the new count for that emoji to the conversation
+func (s *Services) broadcastReactionChange(ctx context.Context, msg *ent.Message, userID, emoji string, messageType ws.MessageType) {
+	if s.WSHub == nil {
+		return
+	}
+
+	count, err := s.ent.MessageReaction.Query().
+		Where(
+			messagereaction.MessageIDEQ(msg.ID),
+			messagereaction.EmojiEQ(emoji),
+		).
+		Count(ctx)
+	if err != nil {
+		slog.Error("Failed to count reactions", "message_id", msg.ID, "error", err)
+		return
+	}
+
+	err = s.BroadcastToConversation(ctx, msg.ConversationID, messageType, ws.ReactionData{
+		MessageID:      msg.ID,
+		ConversationID: msg.ConversationID,
+		UserID:         userID,
+		Emoji:          emoji,
+		Count:          count,
+	}, userID)
+	if err != nil {
+		// Log error but don't fail the reaction change
+		slog.Error("Failed to broadcast reaction change", "message_id", msg.ID, "error", err)
+	}
+}
+
+// validateEmoji accepts custom emoji names and short Unicode emoji sequences
+func validateEmoji(emoji string) error {
+	if customEmojiPattern.MatchString(emoji) {
+		return nil
+	}
+	if emoji == "" || len(emoji) > maxEmojiBytes || !utf8.ValidString(emoji) {
+		return fmt.Errorf("invalid emoji")
+	}
+
+	// Unicode emoji always contain a non-ASCII rune and never whitespace or control characters
+	hasNonASCII := false
+	for _, r := range emoji {
+		if unicode.IsSpace(r) || unicode.IsControl(r) || unicode.IsLetter(r) {
+			return fmt.Errorf("invalid emoji")
+		}
+		if r > unicode.MaxASCII {
+			hasNonASCII = true
+		}
+	}
+	if !hasNonASCII {
+		return fmt.Errorf("invalid emoji")
+	}
+
+	return nil
+}
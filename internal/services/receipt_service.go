@@ -0,0 +1,291 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"kakashi/chaos/internal/ent"
+	"kakashi/chaos/internal/ent/conversationparticipant"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/messagereceipt"
+	"kakashi/chaos/internal/ws"
+)
+
+// receiptQueueSize bounds the number of receipts waiting for the receipt worker
+const receiptQueueSize = 1024
+
+// receiptJob is a delivery or read reported for one message
+type receiptJob struct {
+	userID    string
+	messageID string
+	status    ws.ReceiptStatus
+	at        time.Time
+}
+
+// ReceiptEntry is a user and the time a message was delivered to or seen by them
+type ReceiptEntry struct {
+	User *ent.User `json:"user"`
+	At   time.Time `json:"at"`
+}
+
+// MessageReceipts lists who a message was delivered to and who has seen it
+type MessageReceipts struct {
+	MessageID   string          `json:"message_id"`
+	DeliveredTo []*ReceiptEntry `json:"delivered_to"`
+	SeenBy      []*ReceiptEntry `json:"seen_by"`
+}
+
+// MessageDelivered queues a delivery receipt, it is called by the WebSocket hub
+func (s *Services) MessageDelivered(userID, messageID string) {
+	s.enqueueReceipt(receiptJob{userID: userID, messageID: messageID, status: ws.ReceiptStatusDelivered, at: time.Now()})
+}
+
+// MessagesAcknowledged queues receipts for messages a client reported as received or seen
+func (s *Services) MessagesAcknowledged(userID string, messageIDs []string, status ws.ReceiptStatus) {
+	now := time.Now()
+	for _, messageID := range messageIDs {
+		s.enqueueReceipt(receiptJob{userID: userID, messageID: messageID, status: status, at: now})
+	}
+}
+
+// StartReceiptWorker records queued receipts until ctx is done
+func (s *Services) StartReceiptWorker(ctx context.Context) {
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case job := <-s.receiptJobs:
+				if err := s.recordReceipt(ctx, job); err != nil {
+					slog.Error("Failed to record message receipt", "message_id", job.messageID, "user_id", job.userID, "error", err)
+				}
+			}
+		}
+	}()
+}
+
+// GetMessageReceipts returns the delivery and seen state of a message for the other participants
+func (s *Services) GetMessageReceipts(ctx context.Context, messageID, userID string) (*MessageReceipts, error) {
+	msg, err := s.ent.Message.Query().
+		Where(
+			message.IDEQ(messageID),
+			message.IsDeletedEQ(false),
+		).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("message not found")
+		}
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+
+	participants, err := s.ent.ConversationParticipant.Query().
+		Where(conversationparticipant.ConversationIDEQ(msg.ConversationID)).
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get conversation participants: %w", err)
+	}
+
+	isParticipant := false
+	for _, p := range participants {
+		if p.UserID == userID {
+			isParticipant = true
+			break
+		}
+	}
+	if !isParticipant {
+		return nil, fmt.Errorf("user is not a participant in this conversation")
+	}
+
+	receipts, err := s.ent.MessageReceipt.Query().
+		Where(messagereceipt.MessageIDEQ(messageID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message receipts: %w", err)
+	}
+	byUser := make(map[string]*ent.MessageReceipt, len(receipts))
+	for _, r := range receipts {
+		byUser[r.UserID] = r
+	}
+
+	result := &MessageReceipts{
+		MessageID:   messageID,
+		DeliveredTo: []*ReceiptEntry{},
+		SeenBy:      []*ReceiptEntry{},
+	}
+	for _, p := range participants {
+		if p.UserID == msg.SenderID || p.Edges.User == nil {
+			continue
+		}
+
+		var deliveredAt, readAt time.Time
+		if r, ok := byUser[p.UserID]; ok {
+			deliveredAt, readAt = r.DeliveredAt, r.ReadAt
+		}
+		// Marking the conversation as read counts as seeing every earlier message
+		if readAt.IsZero() && !p.LastReadAt.IsZero() && !p.LastReadAt.Before(msg.CreatedAt) {
+			readAt = p.LastReadAt
+		}
+		if !p.Edges.User.ReadReceiptsEnabled {
+			readAt = time.Time{}
+		}
+		if deliveredAt.IsZero() {
+			deliveredAt = readAt
+		}
+
+		if !deliveredAt.IsZero() {
+			result.DeliveredTo = append(result.DeliveredTo, &ReceiptEntry{User: p.Edges.User, At: deliveredAt})
+		}
+		if !readAt.IsZero() {
+			result.SeenBy = append(result.SeenBy, &ReceiptEntry{User: p.Edges.User, At: readAt})
+		}
+	}
+
+	sortReceiptEntries(result.DeliveredTo)
+	sortReceiptEntries(result.SeenBy)
+	return result, nil
+}
+
+// SetReadReceiptsEnabled sets whether other users can see when the user has read their messages
+func (s *Services) SetReadReceiptsEnabled(ctx context.Context, userID string, enabled bool) (*ent.User, error) {
+	u, err := s.ent.User.UpdateOneID(userID).
+		SetReadReceiptsEnabled(enabled).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("user not found")
+		}
+		return nil, fmt.Errorf("failed to update read receipts setting: %w", err)
+	}
+
+	return u, nil
+}
+
+// enqueueReceipt queues a receipt without blocking the WebSocket connection that reported it
+func (s *Services) enqueueReceipt(job receiptJob) {
+	select {
+	case s.receiptJobs <- job:
+	default:
+		slog.Warn("Receipt queue is full, dropping receipt", "message_id", job.messageID, "user_id", job.userID, "status", job.status)
+	}
+}
+
+// recordReceipt stores a receipt and tells the sender, ignoring receipts that change nothing
+func (s *Services) recordReceipt(ctx context.Context, job receiptJob) error {
+	msg, err := s.ent.Message.Query().
+		Where(
+			message.IDEQ(job.messageID),
+			message.IsDeletedEQ(false),
+		).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get message: %w", err)
+	}
+	if msg.SenderID == job.userID {
+		return nil
+	}
+
+	recipient, err := s.ent.ConversationParticipant.Query().
+		Where(
+			conversationparticipant.ConversationIDEQ(msg.ConversationID),
+			conversationparticipant.UserIDEQ(job.userID),
+		).
+		WithUser().
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to check participant status: %w", err)
+	}
+
+	// Users who turned read receipts off only report deliveries
+	status := job.status
+	if status == ws.ReceiptStatusRead && recipient.Edges.User != nil && !recipient.Edges.User.ReadReceiptsEnabled {
+		status = ws.ReceiptStatusDelivered
+	}
+
+	changed, err := s.upsertReceipt(ctx, job.messageID, job.userID, status, job.at)
+	if err != nil {
+		// A receipt recorded concurrently through another path created the row first
+		if !ent.IsConstraintError(err) {
+			return err
+		}
+		if changed, err = s.upsertReceipt(ctx, job.messageID, job.userID, status, job.at); err != nil {
+			return err
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	if s.WSHub != nil && s.WSHub.IsUserOnline(msg.SenderID) {
+		s.BroadcastToUser(msg.SenderID, ws.MessageTypeMessageReceipt, ws.ReceiptData{
+			MessageID:      msg.ID,
+			ConversationID: msg.ConversationID,
+			UserID:         job.userID,
+			Status:         string(status),
+			At:             job.at.Format(time.RFC3339),
+		})
+	}
+
+	return nil
+}
+
+// upsertReceipt sets the delivered or read time of a receipt unless it is already set, reporting whether it changed.
+// A constraint error is returned unwrapped when the receipt was created concurrently, so callers can retry
+func (s *Services) upsertReceipt(ctx context.Context, messageID, userID string, status ws.ReceiptStatus, at time.Time) (bool, error) {
+	existing, err := s.ent.MessageReceipt.Query().
+		Where(
+			messagereceipt.MessageIDEQ(messageID),
+			messagereceipt.UserIDEQ(userID),
+		).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return false, fmt.Errorf("failed to get message receipt: %w", err)
+	}
+
+	if existing == nil {
+		builder := s.ent.MessageReceipt.Create().
+			SetMessageID(messageID).
+			SetUserID(userID).
+			SetDeliveredAt(at)
+		if status == ws.ReceiptStatusRead {
+			builder = builder.SetReadAt(at)
+		}
+		if _, err := builder.Save(ctx); err != nil {
+			if ent.IsConstraintError(err) {
+				return false, err
+			}
+			return false, fmt.Errorf("failed to create message receipt: %w", err)
+		}
+		return true, nil
+	}
+
+	if status == ws.ReceiptStatusDelivered || !existing.ReadAt.IsZero() {
+		// Delivery is always recorded on creation, and a read is only recorded once
+		return false, nil
+	}
+
+	_, err = existing.Update().
+		SetReadAt(at).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to update message receipt: %w", err)
+	}
+	return true, nil
+}
+
+// sortReceiptEntries orders receipts from earliest to latest
+func sortReceiptEntries(entries []*ReceiptEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].At.Before(entries[j].At)
+	})
+}
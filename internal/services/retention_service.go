@@ -0,0 +1,371 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"kakashi/chaos/internal/ent"
+	"kakashi/chaos/internal/ent/attachment"
+	"kakashi/chaos/internal/ent/bookmark"
+	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/conversationparticipant"
+	"kakashi/chaos/internal/ent/hiddenmessage"
+	"kakashi/chaos/internal/ent/linkpreview"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/messagemention"
+	"kakashi/chaos/internal/ent/messagereaction"
+	"kakashi/chaos/internal/ent/messagereceipt"
+	"kakashi/chaos/internal/ent/messagerevision"
+	"kakashi/chaos/internal/ent/notification"
+	"kakashi/chaos/internal/ent/pinnedmessage"
+	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/scheduledmessage"
+	"kakashi/chaos/internal/ent/threadparticipant"
+	"kakashi/chaos/internal/ws"
+)
+
+const (
+	// retentionReapInterval is how often expired messages are looked for
+	retentionReapInterval = time.Minute
+	// retentionBatchSize bounds the messages hard deleted per transaction
+	retentionBatchSize = 500
+	// minMessageTTL and maxMessageTTL bound the disappearing message timer of a conversation
+	minMessageTTL = time.Minute
+	maxMessageTTL = 365 * 24 * time.Hour
+)
+
+// SetConversationMessageTTL sets how long messages in a conversation are kept, zero turns disappearing
+// messages off. Only messages sent while the timer is on disappear, changing a running timer keeps its start.
+// Any participant may change it in a direct conversation, owners and admins in a group
+func (s *Services) SetConversationMessageTTL(ctx context.Context, conversationID, actorID string, ttl time.Duration) (*ent.Conversation, error) {
+	conv, err := s.ent.Conversation.Query().Where(conversation.IDEQ(conversationID)).First(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("conversation not found: %w", err)
+	}
+
+	actor, err := s.ent.ConversationParticipant.Query().
+		Where(
+			conversationparticipant.ConversationIDEQ(conversationID),
+			conversationparticipant.UserIDEQ(actorID),
+		).
+		WithUser().
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("user is not a participant in this conversation")
+		}
+		return nil, fmt.Errorf("failed to get participant: %w", err)
+	}
+	if conv.Type == conversation.TypeGroup && !canManageGroup(actor) {
+		return nil, fmt.Errorf("insufficient permissions")
+	}
+
+	if ttl != 0 && (ttl < minMessageTTL || ttl > maxMessageTTL) {
+		return nil, fmt.Errorf("invalid message ttl")
+	}
+	if ttl != 0 && s.retentionCeiling > 0 && ttl > s.retentionCeiling {
+		return nil, fmt.Errorf("message ttl exceeds the retention ceiling")
+	}
+
+	seconds := int(ttl / time.Second)
+	update := s.ent.Conversation.UpdateOneID(conversationID).
+		SetMessageTTL(seconds)
+	switch {
+	case seconds == 0:
+		update = update.ClearMessageTTLSetAt()
+	case conv.MessageTTL == 0:
+		// Turning the timer on never reaches back into earlier history
+		update = update.SetMessageTTLSetAt(time.Now())
+	}
+	conv, err = update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update message ttl: %w", err)
+	}
+
+	content := fmt.Sprintf("%s turned off disappearing messages", actor.Edges.User.Username)
+	if seconds > 0 {
+		content = fmt.Sprintf("%s set messages to disappear after %s", actor.Edges.User.Username, formatTTL(ttl))
+	}
+	s.postGroupUpdate(ctx, conversationID, actorID, content, ws.ConversationUpdateData{
+		ConversationID: conversationID,
+		Action:         "message_ttl_updated",
+		ActorID:        actorID,
+		MessageTTL:     &seconds,
+	})
+
+	return conv, nil
+}
+
+// formatTTL describes a message timer in the largest whole unit for system messages
+func formatTTL(ttl time.Duration) string {
+	units := []struct {
+		name string
+		size time.Duration
+	}{
+		{"week", 7 * 24 * time.Hour},
+		{"day", 24 * time.Hour},
+		{"hour", time.Hour},
+		{"minute", time.Minute},
+	}
+	for _, unit := range units {
+		if ttl >= unit.size && ttl%unit.size == 0 {
+			n := int(ttl / unit.size)
+			if n == 1 {
+				return "1 " + unit.name
+			}
+			return fmt.Sprintf("%d %ss", n, unit.name)
+		}
+	}
+	return ttl.String()
+}
+
+// StartRetentionReaper hard deletes expired messages and drafts and releases expired send nonces until ctx is done
+func (s *Services) StartRetentionReaper(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(retentionReapInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.reapExpiredMessages(ctx)
+				s.deleteExpiredDrafts(ctx)
+				s.clearExpiredNonces(ctx)
+			}
+		}
+	}()
+}
+
+// reapExpiredMessages applies conversation timers, the retention ceiling and the soft delete retention
+func (s *Services) reapExpiredMessages(ctx context.Context) {
+	now := time.Now()
+
+	// Timers turned on before their start was recorded count from now
+	if err := s.ent.Conversation.Update().
+		Where(
+			conversation.MessageTTLGT(0),
+			conversation.MessageTTLSetAtIsNil(),
+		).
+		SetMessageTTLSetAt(now).
+		Exec(ctx); err != nil {
+		slog.Error("Failed to record message ttl start", "error", err)
+	}
+
+	timed, err := s.ent.Conversation.Query().
+		Where(
+			conversation.MessageTTLGT(0),
+			conversation.MessageTTLSetAtNotNil(),
+		).
+		Select(conversation.FieldID, conversation.FieldMessageTTL, conversation.FieldMessageTTLSetAt).
+		All(ctx)
+	if err != nil {
+		slog.Error("Failed to get conversations with a message ttl", "error", err)
+	}
+	for _, conv := range timed {
+		if err := s.purgeMessagesWhere(ctx, expiredByTTL(conv, now)); err != nil {
+			slog.Error("Failed to delete expired messages", "conversation_id", conv.ID, "error", err)
+		}
+	}
+
+	if s.retentionCeiling > 0 {
+		if err := s.purgeMessagesWhere(ctx, message.CreatedAtLT(now.Add(-s.retentionCeiling))); err != nil {
+			slog.Error("Failed to delete messages past the retention ceiling", "error", err)
+		}
+	}
+
+	if s.deletedMessageRetention > 0 {
+		cutoff := now.Add(-s.deletedMessageRetention)
+		err := s.purgeMessagesWhere(ctx,
+			message.IsDeletedEQ(true),
+			message.Or(
+				message.DeletedAtLT(cutoff),
+				// Messages deleted before deleted_at was recorded
+				message.And(message.DeletedAtIsNil(), message.UpdatedAtLT(cutoff)),
+			),
+			// A deleted thread root stays while its thread still has live replies
+			message.Not(message.HasThreadRepliesWith(message.IsDeletedEQ(false))),
+		)
+		if err != nil {
+			slog.Error("Failed to delete soft deleted messages", "error", err)
+		}
+	}
+}
+
+// expiredByTTL matches the messages of a conversation whose timer ran out, only messages sent after the
+// timer was turned on ever expire
+func expiredByTTL(conv *ent.Conversation, now time.Time) predicate.Message {
+	return message.And(
+		message.ConversationIDEQ(conv.ID),
+		message.CreatedAtGTE(conv.MessageTTLSetAt),
+		message.CreatedAtLT(now.Add(-time.Duration(conv.MessageTTL)*time.Second)),
+	)
+}
+
+// purgeMessagesWhere hard deletes matching messages one batch at a time
+func (s *Services) purgeMessagesWhere(ctx context.Context, ps ...predicate.Message) error {
+	for ctx.Err() == nil {
+		batch, err := s.ent.Message.Query().
+			Where(ps...).
+			Select(message.FieldID, message.FieldConversationID).
+			Limit(retentionBatchSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get expired messages: %w", err)
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := s.purgeMessages(ctx, batch); err != nil {
+			return err
+		}
+		if len(batch) < retentionBatchSize {
+			return nil
+		}
+	}
+	return nil
+}
+
+// purgeMessages hard deletes messages together with their thread replies, everything attached to them
+// and their stored attachment objects, then tells the conversations which messages are gone
+func (s *Services) purgeMessages(ctx context.Context, messages []*ent.Message) error {
+	ids := make([]string, len(messages))
+	for i, msg := range messages {
+		ids[i] = msg.ID
+	}
+
+	// Thread replies cannot outlive their root
+	replies, err := s.ent.Message.Query().
+		Where(
+			message.ThreadRootIDIn(ids...),
+			message.IDNotIn(ids...),
+		).
+		Select(message.FieldID, message.FieldConversationID).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get thread replies: %w", err)
+	}
+	for _, reply := range replies {
+		ids = append(ids, reply.ID)
+		messages = append(messages, reply)
+	}
+
+	attachments, err := s.ent.Attachment.Query().
+		Where(attachment.MessageIDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get attachments: %w", err)
+	}
+
+	tx, err := s.ent.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Replies to purged messages keep their own content but lose the quote
+	if err := tx.Message.Update().
+		Where(
+			message.ReplyToIDIn(ids...),
+			message.IDNotIn(ids...),
+		).
+		ClearReplyToID().
+		Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to clear reply references: %w", err))
+	}
+	if err := tx.ScheduledMessage.Update().
+		Where(scheduledmessage.MessageIDIn(ids...)).
+		ClearMessageID().
+		Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to clear scheduled message references: %w", err))
+	}
+	// Bookmarks stay in saved lists as tombstones
+	if err := tx.Bookmark.Update().
+		Where(bookmark.MessageIDIn(ids...)).
+		ClearMessageID().
+		Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to clear bookmark references: %w", err))
+	}
+
+	dependents := []struct {
+		name   string
+		delete func(context.Context) (int, error)
+	}{
+		{"revisions", tx.MessageRevision.Delete().Where(messagerevision.MessageIDIn(ids...)).Exec},
+		{"reactions", tx.MessageReaction.Delete().Where(messagereaction.MessageIDIn(ids...)).Exec},
+		{"attachments", tx.Attachment.Delete().Where(attachment.MessageIDIn(ids...)).Exec},
+		{"link previews", tx.LinkPreview.Delete().Where(linkpreview.MessageIDIn(ids...)).Exec},
+		{"mentions", tx.MessageMention.Delete().Where(messagemention.MessageIDIn(ids...)).Exec},
+		{"pins", tx.PinnedMessage.Delete().Where(pinnedmessage.MessageIDIn(ids...)).Exec},
+		{"hidden markers", tx.HiddenMessage.Delete().Where(hiddenmessage.MessageIDIn(ids...)).Exec},
+		{"receipts", tx.MessageReceipt.Delete().Where(messagereceipt.MessageIDIn(ids...)).Exec},
+		{"notifications", tx.Notification.Delete().Where(notification.RelatedMessageIDIn(ids...)).Exec},
+		{"thread participants", tx.ThreadParticipant.Delete().Where(threadparticipant.RootMessageIDIn(ids...)).Exec},
+	}
+	for _, dependent := range dependents {
+		if _, err := dependent.delete(ctx); err != nil {
+			return rollback(tx, fmt.Errorf("failed to delete message %s: %w", dependent.name, err))
+		}
+	}
+
+	if _, err := tx.Message.Delete().Where(message.IDIn(ids...)).Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete messages: %w", err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Stored objects go after the commit, a leftover object is unreachable while a missing one would break a message
+	if s.attachmentStorage != nil {
+		for _, att := range attachments {
+			for _, key := range []string{att.StorageKey, att.ThumbnailKey} {
+				if key == "" {
+					continue
+				}
+				if err := s.attachmentStorage.Delete(ctx, key); err != nil {
+					slog.Error("Failed to delete expired attachment", "attachment_id", att.ID, "error", err)
+				}
+			}
+		}
+	}
+
+	s.broadcastMessagesExpired(ctx, messages)
+
+	return nil
+}
+
+// broadcastMessagesExpired tells online participants which messages their conversations lost
+func (s *Services) broadcastMessagesExpired(ctx context.Context, messages []*ent.Message) {
+	if s.WSHub == nil {
+		return
+	}
+
+	byConversation := make(map[string][]string)
+	for _, msg := range messages {
+		byConversation[msg.ConversationID] = append(byConversation[msg.ConversationID], msg.ID)
+	}
+
+	for conversationID, messageIDs := range byConversation {
+		participants, err := s.getConversationParticipants(ctx, conversationID)
+		if err != nil {
+			slog.Error("Failed to broadcast expired messages", "conversation_id", conversationID, "error", err)
+			continue
+		}
+
+		var onlineParticipants []string
+		for _, userID := range participants {
+			if s.WSHub.IsUserOnline(userID) {
+				onlineParticipants = append(onlineParticipants, userID)
+			}
+		}
+
+		if len(onlineParticipants) > 0 {
+			s.BroadcastToUsers(onlineParticipants, ws.MessageTypeMessagesExpired, ws.MessagesExpiredData{
+				ConversationID: conversationID,
+				MessageIDs:     messageIDs,
+			})
+		}
+	}
+}
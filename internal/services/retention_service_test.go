@@ -0,0 +1,33 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"kakashi/chaos/internal/ent"
+	"kakashi/chaos/internal/ent/message"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+func TestExpiredByTTL(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	setAt := now.Add(-2 * time.Hour)
+	conv := &ent.Conversation{ID: "conv1", MessageTTL: 3600, MessageTTLSetAt: setAt}
+
+	selector := sql.Dialect(dialect.Postgres).Select(message.FieldID).From(sql.Table(message.Table))
+	expiredByTTL(conv, now)(selector)
+	query, args := selector.Query()
+
+	wantQuery := `SELECT "id" FROM "messages" WHERE "messages"."conversation_id" = $1 AND "messages"."created_at" >= $2 AND "messages"."created_at" < $3`
+	if query != wantQuery {
+		t.Errorf("query = %s, want %s", query, wantQuery)
+	}
+	// Messages from before the timer was turned on are never matched, however old they are
+	wantArgs := []any{"conv1", setAt, now.Add(-time.Hour)}
+	if !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("args = %v, want %v", args, wantArgs)
+	}
+}
@@ -0,0 +1,359 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"kakashi/chaos/internal/ent"
+	"kakashi/chaos/internal/ent/scheduledmessage"
+	"kakashi/chaos/internal/richtext"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// scheduledMessagePollInterval is how often the scheduler looks for due messages
+	scheduledMessagePollInterval = 5 * time.Second
+	// scheduledMessageClaimLease is how long a scheduler run owns a message before another run may retry it
+	scheduledMessageClaimLease = time.Minute
+	// scheduledMessageBatchSize bounds the due messages handled per poll
+	scheduledMessageBatchSize = 50
+	// maxScheduledMessageAttempts is the number of transient delivery failures before giving up
+	maxScheduledMessageAttempts = 5
+	// maxScheduleAhead is how far in the future a message can be scheduled
+	maxScheduleAhead = 365 * 24 * time.Hour
+)
+
+// ScheduledMessageInput holds the attributes of a message scheduled for later delivery
+type ScheduledMessageInput struct {
+	Content        string
+	SendAt         time.Time
+	ReplyToID      string
+	SuppressEmbeds bool
+}
+
+// ScheduledMessageUpdate holds the attributes to change on a pending scheduled message, nil fields are kept
+type ScheduledMessageUpdate struct {
+	Content *string
+	SendAt  *time.Time
+}
+
+// scheduledDelivery identifies the claimed scheduled message a send delivers
+type scheduledDelivery struct {
+	id         string
+	claimToken string
+}
+
+// complete marks the scheduled message as sent in the transaction creating its message, so the message
+// only commits while the claim is still held and the scheduled message is still pending
+func (d *scheduledDelivery) complete(ctx context.Context, tx *ent.Tx, messageID string) error {
+	n, err := tx.ScheduledMessage.Update().
+		Where(
+			scheduledmessage.IDEQ(d.id),
+			scheduledmessage.StatusEQ(scheduledmessage.StatusPending),
+			scheduledmessage.ClaimTokenEQ(d.claimToken),
+		).
+		SetStatus(scheduledmessage.StatusSent).
+		SetMessageID(messageID).
+		ClearClaimToken().
+		ClearClaimExpiresAt().
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to complete scheduled message: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("scheduled message is no longer pending")
+	}
+	return nil
+}
+
+// validateSendAt checks that a delivery time lies in the accepted window
+func validateSendAt(sendAt time.Time) error {
+	now := time.Now()
+	if !sendAt.After(now) {
+		return fmt.Errorf("send time must be in the future")
+	}
+	if sendAt.After(now.Add(maxScheduleAhead)) {
+		return fmt.Errorf("send time is too far in the future")
+	}
+	return nil
+}
+
+// ScheduleMessage stores a message to be sent to a conversation at a later time
+func (s *Services) ScheduleMessage(ctx context.Context, senderID, conversationID string, input ScheduledMessageInput) (*ent.ScheduledMessage, error) {
+	// Formatting errors surface now rather than when the message is due
+	doc, err := richtext.Parse(input.Content)
+	if err != nil {
+		return nil, err
+	}
+	if doc.Content == "" {
+		return nil, fmt.Errorf("content is required")
+	}
+	if err := validateSendAt(input.SendAt); err != nil {
+		return nil, err
+	}
+	if err := s.validateSendPermission(ctx, senderID, conversationID); err != nil {
+		return nil, err
+	}
+	if input.ReplyToID != "" {
+		if err := s.validateReplyTarget(ctx, conversationID, input.ReplyToID); err != nil {
+			return nil, err
+		}
+	}
+
+	builder := s.ent.ScheduledMessage.Create().
+		SetConversationID(conversationID).
+		SetSenderID(senderID).
+		SetContent(doc.Content).
+		SetSendAt(input.SendAt).
+		SetSuppressEmbeds(input.SuppressEmbeds)
+	if input.ReplyToID != "" {
+		builder = builder.SetReplyToID(input.ReplyToID)
+	}
+	scheduled, err := builder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule message: %w", err)
+	}
+
+	return scheduled, nil
+}
+
+// GetScheduledMessages returns a user's pending and failed scheduled messages, soonest first,
+// optionally limited to one conversation
+func (s *Services) GetScheduledMessages(ctx context.Context, userID, conversationID string) ([]*ent.ScheduledMessage, error) {
+	query := s.ent.ScheduledMessage.Query().
+		Where(
+			scheduledmessage.SenderIDEQ(userID),
+			scheduledmessage.StatusIn(scheduledmessage.StatusPending, scheduledmessage.StatusFailed),
+		)
+	if conversationID != "" {
+		query = query.Where(scheduledmessage.ConversationIDEQ(conversationID))
+	}
+
+	scheduled, err := query.
+		Order(ent.Asc(scheduledmessage.FieldSendAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scheduled messages: %w", err)
+	}
+
+	return scheduled, nil
+}
+
+// UpdateScheduledMessage changes the content or delivery time of a pending scheduled message.
+// Any delivery already in progress is invalidated so the new version is the one sent
+func (s *Services) UpdateScheduledMessage(ctx context.Context, scheduledMessageID, userID string, update ScheduledMessageUpdate) (*ent.ScheduledMessage, error) {
+	var doc *richtext.Document
+	if update.Content != nil {
+		var err error
+		if doc, err = richtext.Parse(*update.Content); err != nil {
+			return nil, err
+		}
+		if doc.Content == "" {
+			return nil, fmt.Errorf("content is required")
+		}
+	}
+	if update.SendAt != nil {
+		if err := validateSendAt(*update.SendAt); err != nil {
+			return nil, err
+		}
+	}
+
+	builder := s.ent.ScheduledMessage.Update().
+		Where(
+			scheduledmessage.IDEQ(scheduledMessageID),
+			scheduledmessage.SenderIDEQ(userID),
+			scheduledmessage.StatusEQ(scheduledmessage.StatusPending),
+		).
+		ClearClaimToken().
+		ClearClaimExpiresAt().
+		SetAttempts(0)
+	if doc != nil {
+		builder = builder.SetContent(doc.Content)
+	}
+	if update.SendAt != nil {
+		builder = builder.SetSendAt(*update.SendAt)
+	}
+	n, err := builder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update scheduled message: %w", err)
+	}
+	if n == 0 {
+		return nil, s.scheduledMessageNotPending(ctx, scheduledMessageID, userID)
+	}
+
+	scheduled, err := s.ent.ScheduledMessage.Get(ctx, scheduledMessageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scheduled message: %w", err)
+	}
+
+	return scheduled, nil
+}
+
+// CancelScheduledMessage cancels a pending scheduled message
+func (s *Services) CancelScheduledMessage(ctx context.Context, scheduledMessageID, userID string) error {
+	n, err := s.ent.ScheduledMessage.Update().
+		Where(
+			scheduledmessage.IDEQ(scheduledMessageID),
+			scheduledmessage.SenderIDEQ(userID),
+			scheduledmessage.StatusEQ(scheduledmessage.StatusPending),
+		).
+		SetStatus(scheduledmessage.StatusCancelled).
+		ClearClaimToken().
+		ClearClaimExpiresAt().
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to cancel scheduled message: %w", err)
+	}
+	if n == 0 {
+		return s.scheduledMessageNotPending(ctx, scheduledMessageID, userID)
+	}
+
+	return nil
+}
+
+// scheduledMessageNotPending explains why a conditional update on a scheduled message matched nothing
+func (s *Services) scheduledMessageNotPending(ctx context.Context, scheduledMessageID, userID string) error {
+	exists, err := s.ent.ScheduledMessage.Query().
+		Where(
+			scheduledmessage.IDEQ(scheduledMessageID),
+			scheduledmessage.SenderIDEQ(userID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get scheduled message: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("scheduled message not found")
+	}
+	return fmt.Errorf("scheduled message is no longer pending")
+}
+
+// StartScheduledMessageWorker delivers due scheduled messages until ctx is done. Every instance may
+// run it, each message is claimed before delivery and marked sent in the transaction creating it
+func (s *Services) StartScheduledMessageWorker(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(scheduledMessagePollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.deliverDueScheduledMessages(ctx)
+			}
+		}
+	}()
+}
+
+// deliverDueScheduledMessages claims and sends one batch of due scheduled messages
+func (s *Services) deliverDueScheduledMessages(ctx context.Context) {
+	now := time.Now()
+	due, err := s.ent.ScheduledMessage.Query().
+		Where(
+			scheduledmessage.StatusEQ(scheduledmessage.StatusPending),
+			scheduledmessage.SendAtLTE(now),
+			scheduledmessage.Or(
+				scheduledmessage.ClaimExpiresAtIsNil(),
+				scheduledmessage.ClaimExpiresAtLT(now),
+			),
+		).
+		Order(ent.Asc(scheduledmessage.FieldSendAt)).
+		Limit(scheduledMessageBatchSize).
+		All(ctx)
+	if err != nil {
+		slog.Error("Failed to get due scheduled messages", "error", err)
+		return
+	}
+
+	for _, scheduled := range due {
+		if ctx.Err() != nil {
+			return
+		}
+		token, ok, err := s.claimScheduledMessage(ctx, scheduled.ID)
+		if err != nil {
+			slog.Error("Failed to claim scheduled message", "scheduled_message_id", scheduled.ID, "error", err)
+			continue
+		}
+		if !ok {
+			// Another instance claimed it, or it was edited or cancelled meanwhile
+			continue
+		}
+		s.deliverScheduledMessage(ctx, scheduled, token)
+	}
+}
+
+// claimScheduledMessage takes a lease on a due scheduled message, ok is false when another run holds it
+func (s *Services) claimScheduledMessage(ctx context.Context, scheduledMessageID string) (string, bool, error) {
+	now := time.Now()
+	token := uuid.NewString()
+	n, err := s.ent.ScheduledMessage.Update().
+		Where(
+			scheduledmessage.IDEQ(scheduledMessageID),
+			scheduledmessage.StatusEQ(scheduledmessage.StatusPending),
+			scheduledmessage.SendAtLTE(now),
+			scheduledmessage.Or(
+				scheduledmessage.ClaimExpiresAtIsNil(),
+				scheduledmessage.ClaimExpiresAtLT(now),
+			),
+		).
+		SetClaimToken(token).
+		SetClaimExpiresAt(now.Add(scheduledMessageClaimLease)).
+		Save(ctx)
+	if err != nil {
+		return "", false, err
+	}
+	return token, n == 1, nil
+}
+
+// deliverScheduledMessage sends a claimed scheduled message through the normal send path, which re-checks
+// participation, friendship and blocks. Rejections fail the message, internal errors are retried once the claim lapses
+func (s *Services) deliverScheduledMessage(ctx context.Context, scheduled *ent.ScheduledMessage, token string) {
+	// Re-read the content under the claim, an edit after the batch query revokes the claim
+	current, err := s.ent.ScheduledMessage.Query().
+		Where(
+			scheduledmessage.IDEQ(scheduled.ID),
+			scheduledmessage.StatusEQ(scheduledmessage.StatusPending),
+			scheduledmessage.ClaimTokenEQ(token),
+		).
+		Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			slog.Error("Failed to get scheduled message", "scheduled_message_id", scheduled.ID, "error", err)
+		}
+		return
+	}
+
+	_, err = s.SendMessageWithOptions(ctx, current.SenderID, current.ConversationID, current.Content, SendMessageOptions{
+		ReplyToID:      current.ReplyToID,
+		SuppressEmbeds: current.SuppressEmbeds,
+		scheduled:      &scheduledDelivery{id: current.ID, claimToken: token},
+	})
+	if err == nil || err.Error() == "scheduled message is no longer pending" {
+		return
+	}
+
+	// Internal errors follow the "failed to ..." convention, anything else is the send being refused
+	transient := strings.HasPrefix(err.Error(), "failed to") && current.Attempts+1 < maxScheduledMessageAttempts
+	builder := s.ent.ScheduledMessage.Update().
+		Where(
+			scheduledmessage.IDEQ(current.ID),
+			scheduledmessage.StatusEQ(scheduledmessage.StatusPending),
+			scheduledmessage.ClaimTokenEQ(token),
+		).
+		AddAttempts(1)
+	if !transient {
+		builder = builder.
+			SetStatus(scheduledmessage.StatusFailed).
+			SetFailureReason(err.Error()).
+			ClearClaimToken().
+			ClearClaimExpiresAt()
+	}
+	if _, updateErr := builder.Save(context.WithoutCancel(ctx)); updateErr != nil {
+		slog.Error("Failed to record scheduled message failure", "scheduled_message_id", current.ID, "error", updateErr)
+	}
+	slog.Warn("Scheduled message delivery failed", "scheduled_message_id", current.ID, "retry", transient, "error", err)
+}
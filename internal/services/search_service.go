@@ -0,0 +1,386 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"kakashi/chaos/internal/ent"
+	"kakashi/chaos/internal/ent/conversation"
+	"kakashi/chaos/internal/ent/conversationparticipant"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/predicate"
+	"kakashi/chaos/internal/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// highlightContext is the number of characters kept on each side of a match in a highlight fragment
+	highlightContext = 40
+	// maxHighlightFragments bounds the match fragments joined into one highlight
+	maxHighlightFragments = 3
+	// highlightFallbackLength is the highlight length of results without a visible match
+	highlightFallbackLength = 100
+)
+
+// searchOperatorPattern matches from:, in:, before:, after: and has: filters, values with spaces can be quoted
+var searchOperatorPattern = regexp.MustCompile(`(?i)(?:^|\s)(from|in|before|after|has):("[^"]*"|\S+)`)
+
+// MessageSearchResult represents a message in search results with context
+type MessageSearchResult struct {
+	*ent.Message
+	ConversationID   string `json:"conversation_id"`
+	ConversationName string `json:"conversation_name"`
+	Highlight        string `json:"highlight"`
+}
+
+// messageSearch is a search query split into its filters and free text
+type messageSearch struct {
+	// text is matched against the search vector with web search syntax: quoted phrases, or and -word
+	text string
+	// from holds usernames, or me for the searching user
+	from []string
+	// in holds conversation IDs, group names or the username of the other member of a direct conversation
+	in     []string
+	before time.Time
+	after  time.Time
+	// has holds file, image or link
+	has []string
+}
+
+// parseMessageSearch parses the filters of a search query, the remaining words are its free text
+func parseMessageSearch(query string) (*messageSearch, error) {
+	search := &messageSearch{}
+	for _, m := range searchOperatorPattern.FindAllStringSubmatch(query, -1) {
+		value := strings.Trim(m[2], `"`)
+		if value == "" {
+			continue
+		}
+		switch strings.ToLower(m[1]) {
+		case "from":
+			search.from = append(search.from, value)
+		case "in":
+			search.in = append(search.in, value)
+		case "before":
+			day, err := parseSearchDate(value)
+			if err != nil {
+				return nil, err
+			}
+			search.before = day
+		case "after":
+			day, err := parseSearchDate(value)
+			if err != nil {
+				return nil, err
+			}
+			// After a day means from the start of the next one
+			search.after = day.AddDate(0, 0, 1)
+		case "has":
+			switch kind := strings.ToLower(value); kind {
+			case "file", "image", "link":
+				search.has = append(search.has, kind)
+			default:
+				return nil, fmt.Errorf("unknown search filter")
+			}
+		}
+	}
+	search.text = strings.Join(strings.Fields(searchOperatorPattern.ReplaceAllString(query, " ")), " ")
+	return search, nil
+}
+
+// parseSearchDate parses a before: or after: date as a UTC day
+func parseSearchDate(value string) (time.Time, error) {
+	day, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid search date")
+	}
+	return day, nil
+}
+
+// hasText reports whether the free text has anything to match
+func (m *messageSearch) hasText() bool {
+	return strings.IndexFunc(m.text, isSearchWordRune) >= 0
+}
+
+// isEmpty reports whether the search has neither text nor filters
+func (m *messageSearch) isEmpty() bool {
+	return !m.hasText() && len(m.from) == 0 && len(m.in) == 0 && m.before.IsZero() && m.after.IsZero() && len(m.has) == 0
+}
+
+// SearchMessages runs a full text search over the messages of the user's conversations, best matches first.
+// Queries may filter with from:user, in:conversation, before:YYYY-MM-DD, after:YYYY-MM-DD and has:file|image|link
+func (s *Services) SearchMessages(ctx context.Context, userID, query string, limit, offset int) ([]*MessageSearchResult, error) {
+	// Validate that user exists
+	_, err := s.ent.User.Query().Where(user.IDEQ(userID)).First(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	search, err := parseMessageSearch(query)
+	if err != nil {
+		return nil, err
+	}
+	if search.isEmpty() {
+		return []*MessageSearchResult{}, nil
+	}
+
+	conversationIDs, err := s.searchConversationIDs(ctx, userID, search.in)
+	if err != nil {
+		return nil, err
+	}
+	if len(conversationIDs) == 0 {
+		return []*MessageSearchResult{}, nil
+	}
+
+	predicates := []predicate.Message{
+		message.ConversationIDIn(conversationIDs...),
+		message.IsDeletedEQ(false),
+		notHiddenFor(userID),
+	}
+	if len(search.from) > 0 {
+		senderIDs, err := s.searchSenderIDs(ctx, userID, search.from)
+		if err != nil {
+			return nil, err
+		}
+		if len(senderIDs) == 0 {
+			return []*MessageSearchResult{}, nil
+		}
+		predicates = append(predicates, message.SenderIDIn(senderIDs...))
+	}
+	if !search.before.IsZero() {
+		predicates = append(predicates, message.CreatedAtLT(search.before))
+	}
+	if !search.after.IsZero() {
+		predicates = append(predicates, message.CreatedAtGTE(search.after))
+	}
+	for _, kind := range search.has {
+		switch kind {
+		case "file":
+			predicates = append(predicates, message.HasAttachments())
+		case "image":
+			predicates = append(predicates, message.MessageTypeEQ(message.MessageTypeImage))
+		case "link":
+			predicates = append(predicates, message.Or(
+				message.ContentContains("http://"),
+				message.ContentContains("https://"),
+			))
+		}
+	}
+
+	q := s.ent.Message.Query().Where(predicates...)
+	if search.hasText() {
+		q = q.Where(searchMatches(search.text)).Order(searchRank(search.text))
+	}
+	messages, err := q.
+		Order(ent.Desc(message.FieldCreatedAt)).
+		Limit(limit).
+		Offset(offset).
+		WithSender().
+		WithConversation(func(q *ent.ConversationQuery) {
+			q.WithParticipants(func(pq *ent.ConversationParticipantQuery) {
+				pq.WithUser()
+			})
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search messages: %w", err)
+	}
+
+	terms := searchTerms(search.text)
+	results := make([]*MessageSearchResult, 0, len(messages))
+	for _, msg := range messages {
+		results = append(results, &MessageSearchResult{
+			Message:          msg,
+			ConversationID:   msg.ConversationID,
+			ConversationName: searchConversationName(msg.Edges.Conversation, userID),
+			Highlight:        createHighlight(messageDocument(msg).Text, terms),
+		})
+	}
+
+	return results, nil
+}
+
+// searchConversationIDs returns the conversations of the user a search covers, limited by in: filters
+func (s *Services) searchConversationIDs(ctx context.Context, userID string, in []string) ([]string, error) {
+	conversations, err := s.ent.Conversation.Query().
+		Where(conversation.HasParticipantsWith(conversationparticipant.UserIDEQ(userID))).
+		WithParticipants(func(q *ent.ConversationParticipantQuery) {
+			q.WithUser()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user conversations: %w", err)
+	}
+
+	var ids []string
+	for _, conv := range conversations {
+		if len(in) == 0 || matchesConversation(conv, userID, in) {
+			ids = append(ids, conv.ID)
+		}
+	}
+	return ids, nil
+}
+
+// matchesConversation reports whether an in: filter names a conversation
+func matchesConversation(conv *ent.Conversation, userID string, in []string) bool {
+	for _, value := range in {
+		if conv.ID == value {
+			return true
+		}
+		if conv.Type == conversation.TypeGroup {
+			if strings.EqualFold(conv.Name, value) {
+				return true
+			}
+			continue
+		}
+		for _, p := range conv.Edges.Participants {
+			if p.UserID != userID && p.Edges.User != nil && strings.EqualFold(p.Edges.User.Username, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// searchSenderIDs resolves the usernames of from: filters, unknown users match nothing
+func (s *Services) searchSenderIDs(ctx context.Context, userID string, from []string) ([]string, error) {
+	var ids []string
+	predicates := make([]predicate.User, 0, len(from))
+	for _, value := range from {
+		if strings.EqualFold(value, "me") {
+			ids = append(ids, userID)
+			continue
+		}
+		predicates = append(predicates, user.UsernameEqualFold(value))
+	}
+	if len(predicates) == 0 {
+		return ids, nil
+	}
+
+	senderIDs, err := s.ent.User.Query().
+		Where(user.Or(predicates...)).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get search senders: %w", err)
+	}
+	return append(ids, senderIDs...), nil
+}
+
+// searchConversationName names a conversation in search results, direct conversations by the other participant
+func searchConversationName(conv *ent.Conversation, userID string) string {
+	if conv == nil {
+		return ""
+	}
+	if conv.Type != conversation.TypeDirect {
+		return conv.Name
+	}
+	for _, p := range conv.Edges.Participants {
+		if p.UserID != userID && p.Edges.User != nil {
+			return p.Edges.User.Name
+		}
+	}
+	return ""
+}
+
+// searchMatches restricts messages to those whose search vector matches a web search style query
+func searchMatches(text string) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString(s.C(ent.MessageSearchColumn)).WriteString(" @@ ")
+			writeSearchQuery(b, text)
+		}))
+	})
+}
+
+// searchRank orders messages by how well their search vector matches a query, best first
+func searchRank(text string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		// OrderExprFunc renders without arguments, ExprFunc keeps the query bound as one
+		s.OrderExpr(sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("ts_rank_cd(").WriteString(s.C(ent.MessageSearchColumn)).Comma()
+			writeSearchQuery(b, text)
+			b.WriteString(") DESC")
+		}))
+	}
+}
+
+func writeSearchQuery(b *sql.Builder, text string) {
+	b.WriteString("websearch_to_tsquery('" + ent.MessageSearchConfig + "', ").Arg(text).WriteString(")")
+}
+
+// searchTerms returns the lowercased words of free search text that results contain, negated words and
+// the or operator are left out
+func searchTerms(text string) []string {
+	var terms []string
+	for _, field := range strings.Fields(text) {
+		if strings.HasPrefix(field, "-") || strings.EqualFold(field, "or") {
+			continue
+		}
+		terms = append(terms, strings.FieldsFunc(strings.ToLower(field), func(r rune) bool {
+			return !isSearchWordRune(r)
+		})...)
+	}
+	return terms
+}
+
+func isSearchWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// createHighlight builds a snippet of up to maxHighlightFragments fragments around the words matching the
+// search terms. It works on runes so multi-byte characters are never split
+func createHighlight(content string, terms []string) string {
+	runes := []rune(content)
+
+	wanted := make(map[string]bool, len(terms))
+	for _, term := range terms {
+		wanted[term] = true
+	}
+
+	type span struct{ start, end int }
+	var fragments []span
+	for i := 0; i < len(runes) && len(wanted) > 0; {
+		if !isSearchWordRune(runes[i]) {
+			i++
+			continue
+		}
+		j := i
+		for j < len(runes) && isSearchWordRune(runes[j]) {
+			j++
+		}
+		if wanted[strings.ToLower(string(runes[i:j]))] {
+			start, end := max(0, i-highlightContext), min(len(runes), j+highlightContext)
+			if n := len(fragments); n > 0 && start <= fragments[n-1].end {
+				// Nearby matches share a fragment
+				fragments[n-1].end = end
+			} else if n < maxHighlightFragments {
+				fragments = append(fragments, span{start, end})
+			} else {
+				break
+			}
+		}
+		i = j
+	}
+
+	if len(fragments) == 0 {
+		if len(runes) > highlightFallbackLength {
+			return strings.TrimSpace(string(runes[:highlightFallbackLength])) + "..."
+		}
+		return content
+	}
+
+	var b strings.Builder
+	for i, f := range fragments {
+		if i > 0 || f.start > 0 {
+			b.WriteString("...")
+		}
+		b.WriteString(strings.TrimSpace(string(runes[f.start:f.end])))
+	}
+	if last := fragments[len(fragments)-1]; last.end < len(runes) {
+		b.WriteString("...")
+	}
+	return b.String()
+}
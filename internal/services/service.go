@@ -1,7 +1,11 @@
 package services
 
 import (
+	"time"
+
 	"kakashi/chaos/internal/ent"
+	"kakashi/chaos/internal/storage"
+	"kakashi/chaos/internal/unfurl"
 	"kakashi/chaos/internal/ws"
 )
 
@@ -10,13 +14,82 @@ type Services struct {
 	jwt_secret   string
 	jwt_audience string
 	WSHub        *ws.Hub
+
+	// messageEditWindow limits how long after sending a message can be edited, zero means no limit
+	messageEditWindow time.Duration
+	// messageDeleteWindow limits how long after sending a message its sender can delete it for everyone, zero means no limit
+	messageDeleteWindow time.Duration
+
+	// attachmentStorage holds uploaded attachment objects, uploads are rejected when nil
+	attachmentStorage storage.Storage
+	// maxAttachmentSize is the largest accepted attachment in bytes
+	maxAttachmentSize int64
+	// imageJobs queues attachment IDs for the background image workers
+	imageJobs chan string
+
+	// receiptJobs queues delivery and read receipts reported over WebSocket
+	receiptJobs chan receiptJob
+
+	// linkPreviews fetches URL metadata for message embeds, previews are disabled when nil
+	linkPreviews *unfurl.Fetcher
+	// linkPreviewJobs queues messages for the background link preview workers
+	linkPreviewJobs chan linkPreviewJob
+
+	// retentionCeiling is the longest any message is kept, zero keeps messages indefinitely
+	retentionCeiling time.Duration
+	// deletedMessageRetention is how long soft deleted messages are kept, zero keeps them indefinitely
+	deletedMessageRetention time.Duration
+	// draftTTL is how long an untouched draft is kept, zero keeps drafts indefinitely
+	draftTTL time.Duration
+	// messageNonceWindow is how long a send nonce dedupes retries, zero ignores nonces
+	messageNonceWindow time.Duration
 }
 
 func New(ent *ent.Client, jwt_secret string, wsHub *ws.Hub) *Services {
 	return &Services{
-		ent:          ent,
-		jwt_secret:   jwt_secret,
-		jwt_audience: "chaos",
-		WSHub:        wsHub,
+		ent:             ent,
+		jwt_secret:      jwt_secret,
+		jwt_audience:    "chaos",
+		WSHub:           wsHub,
+		imageJobs:       make(chan string, imageQueueSize),
+		receiptJobs:     make(chan receiptJob, receiptQueueSize),
+		linkPreviewJobs: make(chan linkPreviewJob, linkPreviewQueueSize),
 	}
 }
+
+// SetMessageEditWindow sets how long after sending a message its sender may edit it
+func (s *Services) SetMessageEditWindow(window time.Duration) {
+	s.messageEditWindow = window
+}
+
+// SetMessageDeleteWindow sets how long after sending a message its sender may delete it for everyone
+func (s *Services) SetMessageDeleteWindow(window time.Duration) {
+	s.messageDeleteWindow = window
+}
+
+// SetAttachmentStorage sets the backend used for attachments and the largest accepted upload in bytes
+func (s *Services) SetAttachmentStorage(store storage.Storage, maxSize int64) {
+	s.attachmentStorage = store
+	s.maxAttachmentSize = maxSize
+}
+
+// SetLinkPreviewFetcher sets the fetcher used to build link previews for messages
+func (s *Services) SetLinkPreviewFetcher(fetcher *unfurl.Fetcher) {
+	s.linkPreviews = fetcher
+}
+
+// SetRetentionPolicy sets the instance wide retention ceiling and how long soft deleted messages are kept
+func (s *Services) SetRetentionPolicy(ceiling, deletedMessageRetention time.Duration) {
+	s.retentionCeiling = ceiling
+	s.deletedMessageRetention = deletedMessageRetention
+}
+
+// SetDraftTTL sets how long a draft is kept after its last change
+func (s *Services) SetDraftTTL(ttl time.Duration) {
+	s.draftTTL = ttl
+}
+
+// SetMessageNonceWindow sets how long a repeated send nonce returns the original message
+func (s *Services) SetMessageNonceWindow(window time.Duration) {
+	s.messageNonceWindow = window
+}
@@ -0,0 +1,258 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"kakashi/chaos/internal/ent"
+	"kakashi/chaos/internal/ent/conversationparticipant"
+	"kakashi/chaos/internal/ent/message"
+	"kakashi/chaos/internal/ent/threadparticipant"
+)
+
+// ThreadWithDetails represents a thread root with a page of replies and the user's read state
+type ThreadWithDetails struct {
+	Root         *ent.Message            `json:"root"`
+	Messages     []*MessageWithReactions `json:"messages"`
+	Participants []*ent.User             `json:"participants"`
+	ReplyCount   int                     `json:"reply_count"`
+	UnreadCount  int                     `json:"unread_count"`
+	LastReadAt   *time.Time              `json:"last_read_at,omitempty"`
+}
+
+// GetThread returns a thread root with paginated replies (oldest first), its participants and unread state
+func (s *Services) GetThread(ctx context.Context, rootMessageID, userID string, limit, offset int) (*ThreadWithDetails, error) {
+	root, err := s.getAccessibleThreadRoot(ctx, rootMessageID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := s.ent.Message.Query().
+		Where(
+			message.ThreadRootIDEQ(root.ID),
+			message.IsDeletedEQ(false),
+			notHiddenFor(userID),
+		).
+		Order(ent.Asc(message.FieldCreatedAt)).
+		Limit(limit).
+		Offset(offset).
+		WithSender().
+		WithAttachments().
+		WithLinkPreviews(orderedLinkPreviews).
+		WithMentions().
+		WithReplyTo(func(q *ent.MessageQuery) {
+			q.Where(notHiddenFor(userID)).WithSender()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get thread messages: %w", err)
+	}
+	redactDeletedReplyPreviews(messages)
+	messagesWithReactions, err := s.attachReactions(ctx, messages, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	threadParticipants, err := s.ent.ThreadParticipant.Query().
+		Where(threadparticipant.RootMessageIDEQ(root.ID)).
+		Order(ent.Asc(threadparticipant.FieldCreatedAt)).
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get thread participants: %w", err)
+	}
+
+	result := &ThreadWithDetails{
+		Root:         root,
+		Messages:     messagesWithReactions,
+		Participants: []*ent.User{},
+		ReplyCount:   root.ThreadReplyCount,
+	}
+
+	for _, tp := range threadParticipants {
+		if tp.Edges.User != nil {
+			result.Participants = append(result.Participants, tp.Edges.User)
+		}
+		if tp.UserID != userID {
+			continue
+		}
+
+		// Unread state is only tracked for users following the thread
+		if !tp.LastReadAt.IsZero() {
+			lastReadAt := tp.LastReadAt
+			result.LastReadAt = &lastReadAt
+		}
+		result.UnreadCount, err = s.calculateThreadUnreadCount(ctx, root.ID, userID, tp.LastReadAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// MarkThreadAsRead marks all replies in a thread as read for a thread participant
+func (s *Services) MarkThreadAsRead(ctx context.Context, rootMessageID, userID string) error {
+	root, err := s.getAccessibleThreadRoot(ctx, rootMessageID, userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.ent.ThreadParticipant.Update().
+		Where(
+			threadparticipant.RootMessageIDEQ(root.ID),
+			threadparticipant.UserIDEQ(userID),
+		).
+		SetLastReadAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update thread read timestamp: %w", err)
+	}
+
+	return nil
+}
+
+// getThreadRoot validates that a message can hold a thread in the given conversation
+func (s *Services) getThreadRoot(ctx context.Context, conversationID, rootMessageID string) (*ent.Message, error) {
+	root, err := s.ent.Message.Query().
+		Where(
+			message.IDEQ(rootMessageID),
+			message.ConversationIDEQ(conversationID),
+			message.IsDeletedEQ(false),
+		).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("thread root not found")
+		}
+		return nil, fmt.Errorf("failed to get thread root: %w", err)
+	}
+
+	// Threads are a single level deep
+	if root.ThreadRootID != "" {
+		return nil, fmt.Errorf("cannot start a thread from a thread reply")
+	}
+
+	return root, nil
+}
+
+// getAccessibleThreadRoot loads a thread root message the user can read
+func (s *Services) getAccessibleThreadRoot(ctx context.Context, rootMessageID, userID string) (*ent.Message, error) {
+	root, err := s.ent.Message.Query().
+		Where(message.IDEQ(rootMessageID)).
+		WithSender().
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("message not found")
+		}
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+	if root.ThreadRootID != "" {
+		return nil, fmt.Errorf("message is not a thread root")
+	}
+
+	// Check if user is a participant in the conversation
+	isParticipant, err := s.ent.ConversationParticipant.Query().
+		Where(
+			conversationparticipant.ConversationIDEQ(root.ConversationID),
+			conversationparticipant.UserIDEQ(userID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check participant status: %w", err)
+	}
+	if !isParticipant {
+		return nil, fmt.Errorf("user is not a participant in this conversation")
+	}
+
+	// Keep the thread reachable after the root is deleted without exposing its content
+	if root.IsDeleted {
+		root.Content = ""
+		root.PlainText = ""
+		root.Entities = nil
+	}
+
+	return root, nil
+}
+
+// recordThreadReply bumps the reply count of a thread root and adds the replier and root author as participants
+func (s *Services) recordThreadReply(ctx context.Context, root, reply *ent.Message) (*ent.Message, error) {
+	root, err := s.ent.Message.UpdateOneID(root.ID).
+		AddThreadReplyCount(1).
+		SetThreadLastReplyAt(reply.CreatedAt).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update thread root: %w", err)
+	}
+
+	// The replier has read everything up to their own reply
+	if err := s.ensureThreadParticipant(ctx, root.ID, reply.SenderID, reply.CreatedAt); err != nil {
+		return nil, err
+	}
+	if root.SenderID != reply.SenderID {
+		if err := s.ensureThreadParticipant(ctx, root.ID, root.SenderID, time.Time{}); err != nil {
+			return nil, err
+		}
+	}
+
+	return root, nil
+}
+
+// ensureThreadParticipant adds a user to a thread, advancing their read marker when one is given
+func (s *Services) ensureThreadParticipant(ctx context.Context, rootMessageID, userID string, lastReadAt time.Time) error {
+	existing, err := s.ent.ThreadParticipant.Query().
+		Where(
+			threadparticipant.RootMessageIDEQ(rootMessageID),
+			threadparticipant.UserIDEQ(userID),
+		).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("failed to get thread participant: %w", err)
+	}
+
+	if existing != nil {
+		if lastReadAt.IsZero() {
+			return nil
+		}
+		_, err = existing.Update().SetLastReadAt(lastReadAt).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update thread participant: %w", err)
+		}
+		return nil
+	}
+
+	builder := s.ent.ThreadParticipant.Create().
+		SetRootMessageID(rootMessageID).
+		SetUserID(userID)
+	if !lastReadAt.IsZero() {
+		builder = builder.SetLastReadAt(lastReadAt)
+	}
+	if _, err := builder.Save(ctx); err != nil && !ent.IsConstraintError(err) {
+		// A concurrent reply may have added the participant already
+		return fmt.Errorf("failed to add thread participant: %w", err)
+	}
+
+	return nil
+}
+
+// calculateThreadUnreadCount counts thread replies from other users posted after the user's last read time
+func (s *Services) calculateThreadUnreadCount(ctx context.Context, rootMessageID, userID string, lastReadAt time.Time) (int, error) {
+	query := s.ent.Message.Query().
+		Where(
+			message.ThreadRootIDEQ(rootMessageID),
+			message.IsDeletedEQ(false),
+			message.SenderIDNEQ(userID),
+		)
+	if !lastReadAt.IsZero() {
+		query = query.Where(message.CreatedAtGT(lastReadAt))
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unread thread messages: %w", err)
+	}
+
+	return count, nil
+}
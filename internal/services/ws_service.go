@@ -212,7 +212,8 @@ func (s *Services) BroadcastMessageRead(ctx context.Context, conversationID, use
 	return nil
 }
 
-// BroadcastMessageNotification sends real-time message notification to online users
+// BroadcastMessageNotification sends real-time message notification to online users, marked silent for
+// those whose notification settings mute it
 func (s *Services) BroadcastMessageNotification(ctx context.Context, message *ent.Message) error {
 	if message.Edges.Sender == nil {
 		return fmt.Errorf("message sender not loaded")
@@ -222,14 +223,186 @@ func (s *Services) BroadcastMessageNotification(ctx context.Context, message *en
 		MessageID:      message.ID,
 		ConversationID: message.ConversationID,
 		Content:        message.Content,
+		PlainText:      messageDocument(message).Text,
+		Entities:       message.Entities,
 		SenderID:       message.SenderID,
 		SenderUsername: message.Edges.Sender.Username,
 		MessageType:    string(message.MessageType),
 		CreatedAt:      message.CreatedAt.Format(time.RFC3339),
+		ReplyToID:      message.ReplyToID,
+		Attachments:    attachmentData(message),
+		Mentions:       mentionData(message),
+		ForwardedFrom:  forwardData(message),
+		Nonce:          message.Nonce,
 	}
 
-	// Broadcast to online conversation participants except the sender
-	return s.BroadcastToConversation(ctx, message.ConversationID, ws.MessageTypeMessage, data, message.SenderID)
+	return s.broadcastMessageEvent(ctx, message, ws.MessageTypeMessage, data)
+}
+
+// BroadcastThreadMessage sends a thread reply and the updated reply count to online conversation participants
+func (s *Services) BroadcastThreadMessage(ctx context.Context, message *ent.Message, replyCount int) error {
+	if message.Edges.Sender == nil {
+		return fmt.Errorf("message sender not loaded")
+	}
+
+	data := ws.MessageData{
+		MessageID:        message.ID,
+		ConversationID:   message.ConversationID,
+		Content:          message.Content,
+		PlainText:        messageDocument(message).Text,
+		Entities:         message.Entities,
+		SenderID:         message.SenderID,
+		SenderUsername:   message.Edges.Sender.Username,
+		MessageType:      string(message.MessageType),
+		CreatedAt:        message.CreatedAt.Format(time.RFC3339),
+		ReplyToID:        message.ReplyToID,
+		ThreadRootID:     message.ThreadRootID,
+		ThreadReplyCount: replyCount,
+		Attachments:      attachmentData(message),
+		Mentions:         mentionData(message),
+		Nonce:            message.Nonce,
+	}
+
+	return s.broadcastMessageEvent(ctx, message, ws.MessageTypeThreadMessage, data)
+}
+
+// broadcastMessageEvent sends a new message to online conversation participants except the sender, whose
+// devices reconcile by nonce when one was sent. Recipients whose notification settings mute the message
+// get a copy marked silent
+func (s *Services) broadcastMessageEvent(ctx context.Context, message *ent.Message, messageType ws.MessageType, data ws.MessageData) error {
+	participants, err := s.ent.ConversationParticipant.Query().
+		Where(conversationparticipant.ConversationIDEQ(message.ConversationID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get conversation participants: %w", err)
+	}
+
+	excludeUserID := senderExclusion(message)
+	now := time.Now()
+	var alerted, silent []string
+	for _, p := range participants {
+		if p.UserID == excludeUserID || !s.WSHub.IsUserOnline(p.UserID) {
+			continue
+		}
+		if p.UserID == message.SenderID || alertsFor(p, message, now) {
+			alerted = append(alerted, p.UserID)
+		} else {
+			silent = append(silent, p.UserID)
+		}
+	}
+
+	if len(alerted) > 0 {
+		s.BroadcastToUsers(alerted, messageType, data)
+	}
+	if len(silent) > 0 {
+		data.Silent = true
+		s.BroadcastToUsers(silent, messageType, data)
+	}
+
+	return nil
+}
+
+// BroadcastMessageUpdated sends the current state of a changed message to online conversation participants
+func (s *Services) BroadcastMessageUpdated(ctx context.Context, message *ent.Message, excludeUserID string) error {
+	if message.Edges.Sender == nil {
+		return fmt.Errorf("message sender not loaded")
+	}
+
+	data := ws.MessageData{
+		MessageID:      message.ID,
+		ConversationID: message.ConversationID,
+		Content:        message.Content,
+		PlainText:      messageDocument(message).Text,
+		Entities:       message.Entities,
+		SenderID:       message.SenderID,
+		SenderUsername: message.Edges.Sender.Username,
+		MessageType:    string(message.MessageType),
+		CreatedAt:      message.CreatedAt.Format(time.RFC3339),
+		ReplyToID:      message.ReplyToID,
+		ThreadRootID:   message.ThreadRootID,
+		Attachments:    attachmentData(message),
+		Mentions:       mentionData(message),
+		LinkPreviews:   linkPreviewData(message),
+		SuppressEmbeds: message.SuppressEmbeds,
+		ForwardedFrom:  forwardData(message),
+	}
+	if !message.EditedAt.IsZero() {
+		data.EditedAt = message.EditedAt.Format(time.RFC3339)
+	}
+
+	// Broadcast to online conversation participants, edits skip the editor who already has the result
+	return s.BroadcastToConversation(ctx, message.ConversationID, ws.MessageTypeMessageUpdated, data, excludeUserID)
+}
+
+// senderExclusion returns the user left out of a new message event. Messages sent with a nonce also go to
+// the sender, so their other devices see the message and the sending device can match it to its nonce
+func senderExclusion(message *ent.Message) string {
+	if message.Nonce != "" {
+		return ""
+	}
+	return message.SenderID
+}
+
+// forwardData converts the forward attribution of a message for WebSocket events
+func forwardData(message *ent.Message) *ws.ForwardData {
+	if message.ForwardedFromMessageID == "" {
+		return nil
+	}
+	data := &ws.ForwardData{
+		MessageID: message.ForwardedFromMessageID,
+		UserID:    message.ForwardedFromUserID,
+	}
+	if message.Edges.ForwardedFromUser != nil {
+		data.Username = message.Edges.ForwardedFromUser.Username
+	}
+	return data
+}
+
+// attachmentData converts the loaded attachments of a message for WebSocket events
+func attachmentData(message *ent.Message) []ws.AttachmentData {
+	var data []ws.AttachmentData
+	for _, att := range message.Edges.Attachments {
+		data = append(data, ws.AttachmentData{
+			AttachmentID:     att.ID,
+			Filename:         att.Filename,
+			ContentType:      att.ContentType,
+			Size:             att.Size,
+			ProcessingStatus: string(att.ProcessingStatus),
+			Width:            att.Width,
+			Height:           att.Height,
+			BlurHash:         att.Blurhash,
+		})
+	}
+	return data
+}
+
+// linkPreviewData converts the loaded link previews of a message for WebSocket events
+func linkPreviewData(message *ent.Message) []ws.LinkPreviewData {
+	var data []ws.LinkPreviewData
+	for _, preview := range message.Edges.LinkPreviews {
+		data = append(data, ws.LinkPreviewData{
+			URL:         preview.URL,
+			Title:       preview.Title,
+			Description: preview.Description,
+			ImageURL:    preview.ImageURL,
+			SiteName:    preview.SiteName,
+		})
+	}
+	return data
+}
+
+// mentionData converts the loaded mentions of a message for WebSocket events
+func mentionData(message *ent.Message) []ws.MentionData {
+	var data []ws.MentionData
+	for _, mention := range message.Edges.Mentions {
+		data = append(data, ws.MentionData{
+			Kind:   string(mention.Kind),
+			UserID: mention.UserID,
+			Offset: mention.Offset,
+			Length: mention.Length,
+		})
+	}
+	return data
 }
 
 // HandleUserConnection handles when a user connects via WebSocket
@@ -0,0 +1,20 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrNotFound is returned when an object does not exist in the storage backend
+var ErrNotFound = errors.New("object not found")
+
+// Storage stores attachment objects by key
+type Storage interface {
+	// Put writes size bytes from r under key
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Get opens the object stored under key, the caller must close it
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the object stored under key, missing objects are not an error
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,252 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testBackend runs the behaviour every Storage implementation shares
+func testBackend(t *testing.T, store Storage) {
+	ctx := context.Background()
+	key := "conversations/c1/object"
+	data := []byte("attachment contents")
+
+	if err := store.Put(ctx, key, bytes.NewReader(data), int64(len(data)), "text/plain"); err != nil {
+		t.Fatalf("Put returned error: %v", err)
+	}
+
+	body, err := store.Get(ctx, key)
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	got, err := io.ReadAll(body)
+	body.Close()
+	if err != nil {
+		t.Fatalf("failed to read object: %v", err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("Get = %q, want %q", got, data)
+	}
+
+	// Put replaces an existing object
+	replaced := []byte("replaced")
+	if err := store.Put(ctx, key, bytes.NewReader(replaced), int64(len(replaced)), "text/plain"); err != nil {
+		t.Fatalf("Put of an existing key returned error: %v", err)
+	}
+	body, err = store.Get(ctx, key)
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	got, _ = io.ReadAll(body)
+	body.Close()
+	if !bytes.Equal(got, replaced) {
+		t.Errorf("Get after replace = %q, want %q", got, replaced)
+	}
+
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+	if _, err := store.Get(ctx, key); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get after Delete error = %v, want %v", err, ErrNotFound)
+	}
+	if err := store.Delete(ctx, key); err != nil {
+		t.Errorf("Delete of a missing object returned error: %v", err)
+	}
+	if _, err := store.Get(ctx, "never/stored"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get of a missing object error = %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestLocalStorage(t *testing.T) {
+	store, err := NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocal returned error: %v", err)
+	}
+	testBackend(t, store)
+}
+
+func TestLocalStorageRejectsInvalidKeys(t *testing.T) {
+	store, err := NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocal returned error: %v", err)
+	}
+
+	ctx := context.Background()
+	for _, key := range []string{"", "../escape", "a/../../escape", `a\b`, "."} {
+		if err := store.Put(ctx, key, strings.NewReader("x"), 1, "text/plain"); err == nil {
+			t.Errorf("Put(%q) returned no error", key)
+		}
+		if _, err := store.Get(ctx, key); err == nil {
+			t.Errorf("Get(%q) returned no error", key)
+		}
+		if err := store.Delete(ctx, key); err == nil {
+			t.Errorf("Delete(%q) returned no error", key)
+		}
+	}
+}
+
+func TestLocalStorageShortWrite(t *testing.T) {
+	store, err := NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocal returned error: %v", err)
+	}
+
+	ctx := context.Background()
+	if err := store.Put(ctx, "short", strings.NewReader("abc"), 10, "text/plain"); err == nil {
+		t.Fatal("Put of fewer bytes than announced returned no error")
+	}
+	// A failed upload leaves nothing behind
+	if _, err := store.Get(ctx, "short"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get after failed Put error = %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestS3Storage(t *testing.T) {
+	fake := newFakeS3()
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+
+	endpoint, _ := url.Parse(srv.URL)
+	store, err := NewS3(context.Background(), S3Config{
+		Endpoint:  endpoint.Host,
+		AccessKey: "access",
+		SecretKey: "secret",
+		Bucket:    "attachments",
+		Region:    "us-east-1",
+	})
+	if err != nil {
+		t.Fatalf("NewS3 returned error: %v", err)
+	}
+	if !fake.hasBucket("attachments") {
+		t.Fatal("NewS3 did not create the bucket")
+	}
+
+	testBackend(t, store)
+}
+
+// fakeS3 is an in-memory stand-in for the path style S3 API calls the storage backend makes
+type fakeS3 struct {
+	mu      sync.Mutex
+	buckets map[string]map[string]fakeObject
+}
+
+type fakeObject struct {
+	data        []byte
+	contentType string
+	modified    time.Time
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{buckets: make(map[string]map[string]fakeObject)}
+}
+
+func (f *fakeS3) hasBucket(name string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	_, ok := f.buckets[name]
+	return ok
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	objects, ok := f.buckets[bucket]
+
+	if key == "" {
+		switch r.Method {
+		case http.MethodHead:
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+			}
+		case http.MethodPut:
+			if !ok {
+				f.buckets[bucket] = make(map[string]fakeObject)
+			}
+		default:
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+		return
+	}
+	if !ok {
+		writeS3Error(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		data, err := readS3Body(r)
+		if err != nil {
+			writeS3Error(w, http.StatusBadRequest, "IncompleteBody")
+			return
+		}
+		objects[key] = fakeObject{data: data, contentType: r.Header.Get("Content-Type"), modified: time.Now()}
+		w.Header().Set("ETag", `"fake"`)
+	case http.MethodGet, http.MethodHead:
+		obj, ok := objects[key]
+		if !ok {
+			writeS3Error(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		w.Header().Set("ETag", `"fake"`)
+		w.Header().Set("Content-Type", obj.contentType)
+		w.Header().Set("Content-Length", strconv.Itoa(len(obj.data)))
+		w.Header().Set("Last-Modified", obj.modified.UTC().Format(http.TimeFormat))
+		if r.Method == http.MethodGet {
+			w.Write(obj.data)
+		}
+	case http.MethodDelete:
+		delete(objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+// readS3Body reads an upload, decoding the aws-chunked encoding used for signed streaming payloads
+func readS3Body(r *http.Request) ([]byte, error) {
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		return io.ReadAll(r.Body)
+	}
+
+	var data []byte
+	br := bufio.NewReader(r.Body)
+	for {
+		header, err := br.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		sizeHex, _, _ := strings.Cut(strings.TrimSpace(header), ";")
+		size, err := strconv.ParseInt(sizeHex, 16, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid chunk size %q", sizeHex)
+		}
+		if size == 0 {
+			return data, nil
+		}
+		chunk := make([]byte, size+2)
+		if _, err := io.ReadFull(br, chunk); err != nil {
+			return nil, err
+		}
+		data = append(data, chunk[:size]...)
+	}
+}
+
+func writeS3Error(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>%s</Code><Message>%s</Message></Error>`, code, code)
+}
@@ -0,0 +1,66 @@
+package unfurl
+
+import (
+	"sync"
+	"time"
+)
+
+type cacheEntry struct {
+	// preview is nil for URLs that had no usable preview
+	preview   *Preview
+	expiresAt time.Time
+}
+
+// cache is a size bounded in-memory cache of previews keyed by URL
+type cache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[string]cacheEntry
+}
+
+func newCache(size int) *cache {
+	return &cache{size: size, entries: make(map[string]cacheEntry)}
+}
+
+// get returns the cached preview and whether an unexpired entry exists
+func (c *cache) get(key string) (*Preview, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.preview, true
+}
+
+func (c *cache) set(key string, preview *Preview, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, exists := c.entries[key]; !exists && len(c.entries) >= c.size {
+		c.evict()
+	}
+	c.entries[key] = cacheEntry{preview: preview, expiresAt: time.Now().Add(ttl)}
+}
+
+// evict drops expired entries, falling back to an arbitrary entry when none have expired
+func (c *cache) evict() {
+	now := time.Now()
+	for key, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+	if len(c.entries) < c.size {
+		return
+	}
+	for key := range c.entries {
+		delete(c.entries, key)
+		return
+	}
+}
@@ -0,0 +1,140 @@
+package unfurl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/charset"
+)
+
+// pageMeta holds the preview relevant metadata found in a page head
+type pageMeta struct {
+	title       string
+	description string
+	image       string
+	siteName    string
+	oembedURL   string
+}
+
+// oEmbed is the subset of an oEmbed response used for previews
+type oEmbed struct {
+	Title        string `json:"title"`
+	ProviderName string `json:"provider_name"`
+	ThumbnailURL string `json:"thumbnail_url"`
+}
+
+// parseHTML reads OpenGraph, Twitter card and standard meta tags from the page head
+func parseHTML(r io.Reader, contentType string) (*pageMeta, error) {
+	body, err := charset.NewReader(r, contentType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode page: %w", err)
+	}
+
+	var (
+		meta                                  pageMeta
+		docTitle, metaDescription             string
+		twitterTitle, twitterDesc, twitterImg string
+		inTitle                               bool
+	)
+
+	tokenizer := html.NewTokenizer(body)
+loop:
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			// io.EOF or the size cap ends the page, whatever was collected so far is used
+			break loop
+		case html.TextToken:
+			if inTitle && docTitle == "" {
+				docTitle = strings.TrimSpace(string(tokenizer.Text()))
+			}
+		case html.EndTagToken:
+			name, _ := tokenizer.TagName()
+			switch string(name) {
+			case "title":
+				inTitle = false
+			case "head":
+				break loop
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := tokenizer.TagName()
+			switch string(name) {
+			case "body":
+				break loop
+			case "title":
+				inTitle = true
+			case "meta":
+				if !hasAttr {
+					continue
+				}
+				attrs := readAttrs(tokenizer)
+				key := strings.ToLower(firstNonEmpty(attrs["property"], attrs["name"]))
+				content := strings.TrimSpace(attrs["content"])
+				switch key {
+				case "og:title":
+					meta.title = content
+				case "og:description":
+					meta.description = content
+				case "og:image", "og:image:url", "og:image:secure_url":
+					if meta.image == "" {
+						meta.image = content
+					}
+				case "og:site_name":
+					meta.siteName = content
+				case "twitter:title":
+					twitterTitle = content
+				case "twitter:description":
+					twitterDesc = content
+				case "twitter:image", "twitter:image:src":
+					twitterImg = content
+				case "description":
+					metaDescription = content
+				}
+			case "link":
+				if !hasAttr {
+					continue
+				}
+				attrs := readAttrs(tokenizer)
+				if strings.EqualFold(attrs["rel"], "alternate") && strings.EqualFold(attrs["type"], "application/json+oembed") {
+					meta.oembedURL = attrs["href"]
+				}
+			}
+		}
+	}
+
+	meta.title = firstNonEmpty(meta.title, twitterTitle, docTitle)
+	meta.description = firstNonEmpty(meta.description, twitterDesc, metaDescription)
+	meta.image = firstNonEmpty(meta.image, twitterImg)
+	return &meta, nil
+}
+
+// readAttrs collects the attributes of the current tag with lowercased keys
+func readAttrs(tokenizer *html.Tokenizer) map[string]string {
+	attrs := make(map[string]string)
+	for {
+		key, val, more := tokenizer.TagAttr()
+		attrs[strings.ToLower(string(key))] = string(val)
+		if !more {
+			return attrs
+		}
+	}
+}
+
+// fetchOEmbed fetches and decodes an oEmbed JSON document
+func (f *Fetcher) fetchOEmbed(ctx context.Context, oembedURL string) (*oEmbed, error) {
+	resp, err := f.get(ctx, oembedURL, "application/json")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var o oEmbed
+	if err := json.NewDecoder(io.LimitReader(resp.Body, f.cfg.MaxBodySize)).Decode(&o); err != nil {
+		return nil, fmt.Errorf("failed to decode oembed response: %w", err)
+	}
+	return &o, nil
+}
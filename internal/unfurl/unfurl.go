@@ -0,0 +1,284 @@
+package unfurl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	// maxRedirects limits how many redirects are followed for a single preview
+	maxRedirects = 5
+	// maxTitleLength and maxDescriptionLength keep stored previews small
+	maxTitleLength       = 300
+	maxDescriptionLength = 1000
+	maxURLLength         = 2048
+)
+
+// ErrNoPreview is returned when a URL does not provide any preview metadata
+var ErrNoPreview = errors.New("no preview available")
+
+// urlPattern matches http and https URLs in message text
+var urlPattern = regexp.MustCompile(`https?://[^\s<>"]+`)
+
+// Preview is the metadata shown as an embed below a message
+type Preview struct {
+	URL         string
+	Title       string
+	Description string
+	ImageURL    string
+	SiteName    string
+}
+
+// Config controls how previews are fetched and cached
+type Config struct {
+	// Timeout bounds a whole fetch including redirects and oEmbed lookups
+	Timeout time.Duration
+	// MaxBodySize caps how many bytes of a page or oEmbed response are read
+	MaxBodySize int64
+	// CacheTTL is how long fetched previews are reused, failures are cached for a fraction of it
+	CacheTTL time.Duration
+	// CacheSize is the maximum number of cached URLs
+	CacheSize int
+	// UserAgent is sent with every request
+	UserAgent string
+	// AllowPrivateNetworks disables the SSRF guard, only meant for local development and tests
+	AllowPrivateNetworks bool
+}
+
+// Fetcher fetches OpenGraph and oEmbed metadata for URLs
+type Fetcher struct {
+	cfg    Config
+	client *http.Client
+	cache  *cache
+}
+
+// New creates a Fetcher, filling in defaults for unset config values
+func New(cfg Config) *Fetcher {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 5 * time.Second
+	}
+	if cfg.MaxBodySize <= 0 {
+		cfg.MaxBodySize = 1 << 20
+	}
+	if cfg.CacheTTL <= 0 {
+		cfg.CacheTTL = time.Hour
+	}
+	if cfg.CacheSize <= 0 {
+		cfg.CacheSize = 1000
+	}
+	if cfg.UserAgent == "" {
+		cfg.UserAgent = "ChaosBot/1.0 (+link previews)"
+	}
+
+	dialer := &net.Dialer{Timeout: cfg.Timeout}
+	if !cfg.AllowPrivateNetworks {
+		// Checking the resolved address at connect time also covers redirects and DNS rebinding
+		dialer.Control = guardControl
+	}
+
+	transport := &http.Transport{
+		// A proxy would hide the real destination from the guard
+		Proxy:                 nil,
+		DialContext:           dialer.DialContext,
+		TLSHandshakeTimeout:   cfg.Timeout,
+		ResponseHeaderTimeout: cfg.Timeout,
+		MaxIdleConns:          10,
+		IdleConnTimeout:       30 * time.Second,
+	}
+
+	return &Fetcher{
+		cfg: cfg,
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   cfg.Timeout,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				if len(via) >= maxRedirects {
+					return fmt.Errorf("stopped after %d redirects", maxRedirects)
+				}
+				if !isWebURL(req.URL) {
+					return fmt.Errorf("redirect to unsupported url")
+				}
+				return nil
+			},
+		},
+		cache: newCache(cfg.CacheSize),
+	}
+}
+
+// ExtractURLs returns up to limit distinct http and https URLs found in text, in order of appearance
+func ExtractURLs(text string, limit int) []string {
+	var urls []string
+	seen := make(map[string]bool)
+	for _, match := range urlPattern.FindAllString(text, -1) {
+		if len(urls) >= limit {
+			break
+		}
+		// Drop punctuation that usually ends the sentence rather than the URL
+		match = strings.TrimRight(match, ".,;:!?'")
+		if strings.HasSuffix(match, ")") && !strings.Contains(match, "(") {
+			match = strings.TrimRight(match, ")")
+		}
+		if len(match) > maxURLLength || seen[match] {
+			continue
+		}
+		u, err := url.Parse(match)
+		if err != nil || !isWebURL(u) {
+			continue
+		}
+		seen[match] = true
+		urls = append(urls, match)
+	}
+	return urls
+}
+
+// Fetch returns the preview for rawURL, using the cache when possible
+func (f *Fetcher) Fetch(ctx context.Context, rawURL string) (*Preview, error) {
+	if preview, ok := f.cache.get(rawURL); ok {
+		if preview == nil {
+			return nil, ErrNoPreview
+		}
+		return preview, nil
+	}
+
+	preview, err := f.fetch(ctx, rawURL)
+	if err != nil {
+		// Cancellation says nothing about the URL, so it is not cached
+		if ctx.Err() == nil {
+			f.cache.set(rawURL, nil, f.cfg.CacheTTL/10)
+		}
+		return nil, err
+	}
+
+	f.cache.set(rawURL, preview, f.cfg.CacheTTL)
+	return preview, nil
+}
+
+func (f *Fetcher) fetch(ctx context.Context, rawURL string) (*Preview, error) {
+	ctx, cancel := context.WithTimeout(ctx, f.cfg.Timeout)
+	defer cancel()
+
+	u, err := url.Parse(rawURL)
+	if err != nil || !isWebURL(u) {
+		return nil, fmt.Errorf("invalid url")
+	}
+
+	resp, err := f.get(ctx, u.String(), "text/html,application/xhtml+xml")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType != "text/html" && mediaType != "application/xhtml+xml" {
+		return nil, ErrNoPreview
+	}
+
+	meta, err := parseHTML(io.LimitReader(resp.Body, f.cfg.MaxBodySize), resp.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, err
+	}
+
+	// Relative links in the page resolve against the final URL after redirects
+	base := resp.Request.URL
+	preview := &Preview{
+		URL:         rawURL,
+		Title:       meta.title,
+		Description: meta.description,
+		ImageURL:    resolveWebURL(base, meta.image),
+		SiteName:    meta.siteName,
+	}
+
+	// oEmbed fills in what the page itself does not provide
+	if meta.oembedURL != "" && (preview.Title == "" || preview.ImageURL == "") {
+		if oembedURL := resolveWebURL(base, meta.oembedURL); oembedURL != "" {
+			if o, err := f.fetchOEmbed(ctx, oembedURL); err == nil {
+				preview.Title = firstNonEmpty(preview.Title, o.Title)
+				preview.SiteName = firstNonEmpty(preview.SiteName, o.ProviderName)
+				if preview.ImageURL == "" {
+					preview.ImageURL = resolveWebURL(base, o.ThumbnailURL)
+				}
+			}
+		}
+	}
+
+	if preview.SiteName == "" {
+		preview.SiteName = base.Hostname()
+	}
+	preview.Title = truncate(preview.Title, maxTitleLength)
+	preview.Description = truncate(preview.Description, maxDescriptionLength)
+	preview.SiteName = truncate(preview.SiteName, maxTitleLength)
+	if len(preview.ImageURL) > maxURLLength {
+		preview.ImageURL = ""
+	}
+
+	if preview.Title == "" && preview.Description == "" && preview.ImageURL == "" {
+		return nil, ErrNoPreview
+	}
+	return preview, nil
+}
+
+// get performs a guarded GET request, returning an error for non-2xx responses
+func (f *Fetcher) get(ctx context.Context, rawURL, accept string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", f.cfg.UserAgent)
+	req.Header.Set("Accept", accept)
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch url: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return resp, nil
+}
+
+// isWebURL reports whether u is an absolute http or https URL
+func isWebURL(u *url.URL) bool {
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != "" && u.User == nil
+}
+
+// resolveWebURL resolves ref against base, returning "" unless the result is an http or https URL
+func resolveWebURL(base *url.URL, ref string) string {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return ""
+	}
+	u, err := base.Parse(ref)
+	if err != nil || !isWebURL(u) {
+		return ""
+	}
+	return u.String()
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// truncate shortens s to at most max runes
+func truncate(s string, max int) string {
+	s = strings.TrimSpace(s)
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return strings.TrimSpace(string(runes[:max-1])) + "…"
+}
@@ -0,0 +1,219 @@
+package unfurl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newPageServer serves page as HTML at every path
+func newPageServer(t *testing.T, page string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		fmt.Fprint(w, page)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestFetchOpenGraph(t *testing.T) {
+	tests := []struct {
+		name string
+		page string
+		want Preview
+	}{
+		{
+			name: "opengraph tags",
+			page: `<html><head>
+<title>Document title</title>
+<meta property="og:title" content=" OG title ">
+<meta property="og:description" content="OG description">
+<meta property="og:image" content="/images/cover.png">
+<meta property="og:site_name" content="Example">
+</head><body></body></html>`,
+			want: Preview{
+				Title:       "OG title",
+				Description: "OG description",
+				ImageURL:    "/images/cover.png",
+				SiteName:    "Example",
+			},
+		},
+		{
+			name: "twitter and standard tags fill in",
+			page: `<html><head>
+<title>Document title</title>
+<meta name="description" content="Meta description">
+<meta name="twitter:image" content="https://cdn.example.com/card.png">
+</head></html>`,
+			want: Preview{
+				Title:       "Document title",
+				Description: "Meta description",
+				ImageURL:    "https://cdn.example.com/card.png",
+			},
+		},
+		{
+			name: "tags in the body are ignored",
+			page: `<html><head><title>Head title</title></head>
+<body><meta property="og:title" content="Body title"></body></html>`,
+			want: Preview{Title: "Head title"},
+		},
+		{
+			name: "unsupported image schemes are dropped",
+			page: `<html><head>
+<meta property="og:title" content="Title">
+<meta property="og:image" content="javascript:alert(1)">
+</head></html>`,
+			want: Preview{Title: "Title"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newPageServer(t, tt.page)
+			f := New(Config{Timeout: 2 * time.Second, AllowPrivateNetworks: true})
+
+			pageURL := srv.URL + "/article"
+			preview, err := f.Fetch(context.Background(), pageURL)
+			if err != nil {
+				t.Fatalf("Fetch returned error: %v", err)
+			}
+
+			want := tt.want
+			want.URL = pageURL
+			if strings.HasPrefix(want.ImageURL, "/") {
+				want.ImageURL = srv.URL + want.ImageURL
+			}
+			if want.SiteName == "" {
+				// Pages without a site name fall back to the host
+				want.SiteName = "127.0.0.1"
+			}
+			if *preview != want {
+				t.Errorf("preview = %+v, want %+v", *preview, want)
+			}
+		})
+	}
+}
+
+func TestFetchNoMetadata(t *testing.T) {
+	srv := newPageServer(t, `<html><head></head><body>hello</body></html>`)
+	f := New(Config{Timeout: 2 * time.Second, AllowPrivateNetworks: true})
+
+	if _, err := f.Fetch(context.Background(), srv.URL); !errors.Is(err, ErrNoPreview) {
+		t.Errorf("Fetch error = %v, want %v", err, ErrNoPreview)
+	}
+}
+
+func TestFetchBodySizeCap(t *testing.T) {
+	const maxBodySize = 1024
+	padding := "<!--" + strings.Repeat("x", 4*maxBodySize) + "-->"
+	tests := []struct {
+		name string
+		page string
+		err  error
+	}{
+		{
+			name: "metadata within the cap",
+			page: `<html><head><meta property="og:title" content="Title">` + padding + `</head></html>`,
+		},
+		{
+			name: "metadata past the cap",
+			page: `<html><head>` + padding + `<meta property="og:title" content="Title"></head></html>`,
+			err:  ErrNoPreview,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newPageServer(t, tt.page)
+			f := New(Config{Timeout: 2 * time.Second, MaxBodySize: maxBodySize, AllowPrivateNetworks: true})
+
+			preview, err := f.Fetch(context.Background(), srv.URL)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Fetch error = %v, want %v", err, tt.err)
+			}
+			if err == nil && preview.Title != "Title" {
+				t.Errorf("title = %q, want %q", preview.Title, "Title")
+			}
+		})
+	}
+}
+
+func TestFetchTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer srv.Close()
+	f := New(Config{Timeout: 100 * time.Millisecond, AllowPrivateNetworks: true})
+
+	start := time.Now()
+	if _, err := f.Fetch(context.Background(), srv.URL); err == nil {
+		t.Fatal("Fetch of a hanging server returned no error")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Fetch took %v with a 100ms timeout", elapsed)
+	}
+}
+
+func TestFetchRejectsPrivateNetworks(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<html><head><meta property="og:title" content="Internal"></head></html>`)
+	}))
+	defer srv.Close()
+	f := New(Config{Timeout: 2 * time.Second})
+
+	if _, err := f.Fetch(context.Background(), srv.URL); err == nil {
+		t.Fatal("Fetch of a loopback server returned no error")
+	}
+
+	// Redirects are dialed through the same guard
+	redirect := httptest.NewServer(http.RedirectHandler(srv.URL, http.StatusFound))
+	defer redirect.Close()
+	if _, err := f.Fetch(context.Background(), redirect.URL); err == nil {
+		t.Fatal("Fetch of a redirect to a loopback server returned no error")
+	}
+
+	if n := requests.Load(); n != 0 {
+		t.Errorf("loopback server received %d requests", n)
+	}
+}
+
+func TestIsPublicAddr(t *testing.T) {
+	tests := []struct {
+		addr   string
+		public bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"100.64.0.1", false},
+		{"0.0.0.0", false},
+		{"::1", false},
+		{"fc00::1", false},
+		{"fe80::1", false},
+		{"::ffff:127.0.0.1", false},
+	}
+
+	for _, tt := range tests {
+		if got := isPublicAddr(netip.MustParseAddr(tt.addr)); got != tt.public {
+			t.Errorf("isPublicAddr(%s) = %v, want %v", tt.addr, got, tt.public)
+		}
+	}
+}
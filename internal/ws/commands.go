@@ -0,0 +1,116 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+const (
+	// commandTimeout bounds how long a single client command may run
+	commandTimeout = 15 * time.Second
+	// maxRequestIDLength bounds the client chosen ID echoed in command replies
+	maxRequestIDLength = 64
+)
+
+// Command is a request a client sends over its WebSocket, replied to with an ack or error frame
+// carrying the same request ID
+type Command struct {
+	Type      MessageType
+	RequestID string
+	Data      json.RawMessage
+}
+
+// CommandError is a command failure reported to the client, Code follows the HTTP status the
+// matching endpoint answers with
+type CommandError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *CommandError) Error() string {
+	return e.Message
+}
+
+// CommandHandler runs commands sent by clients. It is called from the connection's read goroutine,
+// so the commands of one connection run one at a time in the order they were sent
+type CommandHandler interface {
+	HandleCommand(ctx context.Context, userID string, command Command) (interface{}, error)
+}
+
+// SetCommandHandler sets the handler that runs client commands, commands are rejected without one
+func (h *Hub) SetCommandHandler(handler CommandHandler) {
+	h.commands = handler
+}
+
+// isCommand reports whether a client frame type is a command
+func isCommand(t MessageType) bool {
+	switch t {
+	case CommandSendMessage, CommandEditMessage, CommandDeleteMessage, CommandMarkRead, CommandReact:
+		return true
+	}
+	return false
+}
+
+// handleCommand runs a client command and replies with its result or error
+func (c *Connection) handleCommand(message WSMessage) {
+	if message.RequestID == "" || len(message.RequestID) > maxRequestIDLength {
+		c.replyError(message.RequestID, &CommandError{Code: http.StatusBadRequest, Message: "request_id is required"})
+		return
+	}
+	if c.Hub.commands == nil {
+		c.replyError(message.RequestID, &CommandError{Code: http.StatusServiceUnavailable, Message: "commands are not available"})
+		return
+	}
+
+	data, err := json.Marshal(message.Data)
+	if err != nil {
+		c.replyError(message.RequestID, &CommandError{Code: http.StatusBadRequest, Message: "invalid command data"})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+
+	result, err := c.Hub.commands.HandleCommand(ctx, c.UserID, Command{
+		Type:      message.Type,
+		RequestID: message.RequestID,
+		Data:      data,
+	})
+	if err != nil {
+		var commandErr *CommandError
+		if !errors.As(err, &commandErr) {
+			slog.Error("WebSocket command failed", "type", message.Type, "user_id", c.UserID, "error", err)
+			commandErr = &CommandError{Code: http.StatusInternalServerError, Message: "internal error"}
+		}
+		c.replyError(message.RequestID, commandErr)
+		return
+	}
+
+	c.reply(WSMessage{
+		Type:      MessageTypeAck,
+		RequestID: message.RequestID,
+		Data:      result,
+		Timestamp: time.Now(),
+	})
+}
+
+func (c *Connection) replyError(requestID string, err *CommandError) {
+	c.reply(WSMessage{
+		Type:      MessageTypeError,
+		RequestID: requestID,
+		Data:      err,
+		Timestamp: time.Now(),
+	})
+}
+
+// reply queues a command reply without blocking the read goroutine, the connection may have been
+// closed by the hub while the command ran
+func (c *Connection) reply(message WSMessage) {
+	if !c.Hub.sendToConnection(c, message) {
+		slog.Warn("Failed to send command reply", "type", message.Type, "request_id", message.RequestID, "user_id", c.UserID)
+	}
+}
@@ -0,0 +1,22 @@
+package ws
+
+import "testing"
+
+func TestReplyAfterHubClosedConnection(t *testing.T) {
+	hub := NewHub()
+	conn := &Connection{UserID: "user1", Send: make(chan WSMessage, 1), Hub: hub}
+	hub.registerConnection(conn)
+
+	conn.reply(WSMessage{Type: MessageTypeAck, RequestID: "r1"})
+	if got := <-conn.Send; got.RequestID != "r1" {
+		t.Fatalf("queued reply = %+v, want request r1", got)
+	}
+
+	// A long running command finishes after the hub dropped the connection
+	hub.unregisterConnection(conn)
+	conn.reply(WSMessage{Type: MessageTypeAck, RequestID: "r2"})
+
+	if _, ok := <-conn.Send; ok {
+		t.Error("reply was queued on a closed connection")
+	}
+}
@@ -18,8 +18,9 @@ const (
 	// Send pings to peer with this period. Must be less than pongWait
 	pingPeriod = (pongWait * 9) / 10
 
-	// Maximum message size allowed from peer
-	maxMessageSize = 512
+	// Maximum message size allowed from peer, large enough for a full batch of message acks and
+	// a send_message command at the longest message length
+	maxMessageSize = 65536
 )
 
 var upgrader = websocket.Upgrader{
@@ -93,6 +94,7 @@ func (c *Connection) writePump() {
 				slog.Error("Failed to write message", "error", err, "user_id", c.UserID)
 				return
 			}
+			c.Hub.messageDelivered(c.UserID, message)
 
 		case <-ticker.C:
 			c.Conn.SetWriteDeadline(time.Now().Add(writeWait))
@@ -113,9 +115,7 @@ func (c *Connection) handleMessage(message WSMessage) {
 			Data:      nil,
 			Timestamp: time.Now(),
 		}
-		select {
-		case c.Send <- pongMessage:
-		default:
+		if !c.Hub.sendToConnection(c, pongMessage) {
 			slog.Warn("Failed to send pong message", "user_id", c.UserID)
 		}
 
@@ -139,13 +139,21 @@ func (c *Connection) handleMessage(message WSMessage) {
 			}
 		}
 
+	case MessageTypeMessageAck:
+		// Client reports messages it received or displayed
+		c.handleMessageAck(message)
+
 	case MessageTypeMessage:
-		// Handle incoming message - this will be processed by the messaging service
-		slog.Info("Received message via WebSocket", "user_id", c.UserID, "type", message.Type)
-		// Note: Message sending should be handled through HTTP endpoints for proper validation
-		// WebSocket messages are primarily for real-time notifications
+		// Chat frames from clients are sends
+		message.Type = CommandSendMessage
+		c.handleCommand(message)
 
 	default:
+		if isCommand(message.Type) {
+			c.handleCommand(message)
+			return
+		}
+
 		// Log unhandled message types
 		slog.Info("Received unhandled message type", "type", message.Type, "user_id", c.UserID)
 	}
@@ -161,13 +169,12 @@ func (c *Connection) Start() {
 	go c.readPump()
 }
 
-// SendMessage sends a message to this connection
+// SendMessage sends a message to this connection, closing it when its buffer is full
 func (c *Connection) SendMessage(message WSMessage) {
-	select {
-	case c.Send <- message:
-	default:
-		// Channel is full, close the connection
-		close(c.Send)
+	if !c.Hub.sendToConnection(c, message) {
+		c.Hub.mutex.Lock()
+		c.Hub.closeConnection(c)
+		c.Hub.mutex.Unlock()
 	}
 }
 
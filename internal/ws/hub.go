@@ -7,7 +7,7 @@ import (
 // NewHub creates a new WebSocket hub
 func NewHub() *Hub {
 	return &Hub{
-		connections: make(map[string]*Connection),
+		connections: make(map[string]map[*Connection]bool),
 		register:    make(chan *Connection),
 		unregister:  make(chan *Connection),
 		broadcast:   make(chan BroadcastMessage),
@@ -30,23 +30,23 @@ func (h *Hub) Run() {
 	}
 }
 
-// registerConnection adds a new connection to the hub
+// registerConnection adds a new connection to the hub, alongside any the user has on other devices
 func (h *Hub) registerConnection(conn *Connection) {
 	h.mutex.Lock()
 	defer h.mutex.Unlock()
 
-	// If user already has a connection, close the old one
-	if existingConn, exists := h.connections[conn.UserID]; exists {
-		close(existingConn.Send)
-		existingConn.Conn.Close()
-		slog.Info("Replaced existing connection for user", "user_id", conn.UserID)
+	userConns, online := h.connections[conn.UserID]
+	if !online {
+		userConns = make(map[*Connection]bool)
+		h.connections[conn.UserID] = userConns
 	}
-
-	h.connections[conn.UserID] = conn
-	slog.Info("User connected", "user_id", conn.UserID, "total_connections", len(h.connections))
+	userConns[conn] = true
+	slog.Info("User connected", "user_id", conn.UserID, "devices", len(userConns), "total_users", len(h.connections))
 
 	// Notify other users that this user is online
-	h.broadcastUserStatus(conn.UserID, true)
+	if !online {
+		h.broadcastUserStatus(conn.UserID, true)
+	}
 }
 
 // unregisterConnection removes a connection from the hub
@@ -54,23 +54,28 @@ func (h *Hub) unregisterConnection(conn *Connection) {
 	h.mutex.Lock()
 	defer h.mutex.Unlock()
 
-	if _, exists := h.connections[conn.UserID]; exists {
-		delete(h.connections, conn.UserID)
-		close(conn.Send)
-		slog.Info("User disconnected", "user_id", conn.UserID, "total_connections", len(h.connections))
+	userConns := h.connections[conn.UserID]
+	if !userConns[conn] {
+		return
+	}
+	delete(userConns, conn)
+	close(conn.Send)
+	slog.Info("User disconnected", "user_id", conn.UserID, "devices", len(userConns), "total_users", len(h.connections))
 
-		// Notify other users that this user is offline
+	// Notify other users once the last device of this user is gone
+	if len(userConns) == 0 {
+		delete(h.connections, conn.UserID)
 		h.broadcastUserStatus(conn.UserID, false)
 	}
 }
 
-// broadcastMessage sends a message to specific users
+// broadcastMessage sends a message to every connection of specific users
 func (h *Hub) broadcastMessage(message BroadcastMessage) {
-	h.mutex.RLock()
-	defer h.mutex.RUnlock()
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
 
 	for _, userID := range message.UserIDs {
-		if conn, exists := h.connections[userID]; exists {
+		for conn := range h.connections[userID] {
 			select {
 			case conn.Send <- message.Message:
 				// Message sent successfully
@@ -82,6 +87,23 @@ func (h *Hub) broadcastMessage(message BroadcastMessage) {
 	}
 }
 
+// sendToConnection queues a message for one connection unless the hub has closed it, reporting whether it
+// was queued. Send is only closed under the write lock, so it stays open while the read lock is held
+func (h *Hub) sendToConnection(conn *Connection, message WSMessage) bool {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	if !h.connections[conn.UserID][conn] {
+		return false
+	}
+	select {
+	case conn.Send <- message:
+		return true
+	default:
+		return false
+	}
+}
+
 // broadcastUserStatus notifies friends about user online/offline status
 func (h *Hub) broadcastUserStatus(userID string, online bool) {
 	// This will be implemented when we integrate with friend service
@@ -93,9 +115,16 @@ func (h *Hub) broadcastUserStatus(userID string, online bool) {
 	slog.Info("User status changed", "user_id", userID, "status", status)
 }
 
-// closeConnection safely closes a connection
+// closeConnection safely closes a connection, the caller holds the write lock
 func (h *Hub) closeConnection(conn *Connection) {
-	delete(h.connections, conn.UserID)
+	userConns := h.connections[conn.UserID]
+	if !userConns[conn] {
+		return
+	}
+	delete(userConns, conn)
+	if len(userConns) == 0 {
+		delete(h.connections, conn.UserID)
+	}
 	close(conn.Send)
 	conn.Conn.Close()
 }
@@ -145,13 +174,15 @@ func (h *Hub) GetOnlineUsers() []string {
 	return users
 }
 
-// DisconnectUser forcefully disconnects a user
+// DisconnectUser forcefully disconnects every device of a user
 func (h *Hub) DisconnectUser(userID string) {
 	h.mutex.Lock()
 	defer h.mutex.Unlock()
 
-	if conn, exists := h.connections[userID]; exists {
-		h.closeConnection(conn)
+	if userConns, exists := h.connections[userID]; exists {
+		for conn := range userConns {
+			h.closeConnection(conn)
+		}
 		slog.Info("User forcefully disconnected", "user_id", userID)
 	}
 }
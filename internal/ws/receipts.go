@@ -0,0 +1,71 @@
+package ws
+
+// ReceiptStatus is the delivery state reported for a message
+type ReceiptStatus string
+
+const (
+	ReceiptStatusDelivered ReceiptStatus = "delivered"
+	ReceiptStatusRead      ReceiptStatus = "read"
+)
+
+// maxAckMessageIDs bounds the number of messages a client can acknowledge at once
+const maxAckMessageIDs = 100
+
+// ReceiptHandler records message receipts reported by the hub and by clients.
+// It is called from connection goroutines and must not block.
+type ReceiptHandler interface {
+	MessageDelivered(userID, messageID string)
+	MessagesAcknowledged(userID string, messageIDs []string, status ReceiptStatus)
+}
+
+// SetReceiptHandler sets the handler notified about message deliveries and client acknowledgements
+func (h *Hub) SetReceiptHandler(handler ReceiptHandler) {
+	h.receipts = handler
+}
+
+// messageDelivered reports a chat message written to the user's connection
+func (h *Hub) messageDelivered(userID string, message WSMessage) {
+	if h.receipts == nil {
+		return
+	}
+	if message.Type != MessageTypeMessage && message.Type != MessageTypeThreadMessage {
+		return
+	}
+	data, ok := message.Data.(MessageData)
+	if !ok || data.MessageID == "" {
+		return
+	}
+	h.receipts.MessageDelivered(userID, data.MessageID)
+}
+
+// handleMessageAck passes a client acknowledgement of received or seen messages to the receipt handler
+func (c *Connection) handleMessageAck(message WSMessage) {
+	if c.Hub.receipts == nil {
+		return
+	}
+	data, ok := message.Data.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	status := ReceiptStatusRead
+	if s, _ := data["status"].(string); s == string(ReceiptStatusDelivered) {
+		status = ReceiptStatusDelivered
+	}
+
+	rawIDs, _ := data["message_ids"].([]interface{})
+	messageIDs := make([]string, 0, len(rawIDs))
+	for _, raw := range rawIDs {
+		if len(messageIDs) >= maxAckMessageIDs {
+			break
+		}
+		if id, ok := raw.(string); ok && id != "" {
+			messageIDs = append(messageIDs, id)
+		}
+	}
+	if len(messageIDs) == 0 {
+		return
+	}
+
+	c.Hub.receipts.MessagesAcknowledged(c.UserID, messageIDs, status)
+}
@@ -4,6 +4,8 @@ import (
 	"sync"
 	"time"
 
+	"kakashi/chaos/internal/richtext"
+
 	"github.com/gorilla/websocket"
 )
 
@@ -25,11 +27,39 @@ const (
 	MessageTypeCallRequest    MessageType = "call_request"
 	MessageTypeCallResponse   MessageType = "call_response"
 	MessageTypeCallEnd        MessageType = "call_end"
+
+	MessageTypeConversationUpdated MessageType = "conversation_updated"
+	MessageTypeMessageUpdated      MessageType = "message_updated"
+	MessageTypeThreadMessage       MessageType = "thread_message"
+	MessageTypeReactionAdded       MessageType = "reaction_added"
+	MessageTypeReactionRemoved     MessageType = "reaction_removed"
+	MessageTypeMessagePinned       MessageType = "message_pinned"
+	MessageTypeMessageUnpinned     MessageType = "message_unpinned"
+	MessageTypeMessageAck          MessageType = "message_ack"
+	MessageTypeMessageReceipt      MessageType = "message_receipt"
+	MessageTypeMessagesExpired     MessageType = "messages_expired"
+	MessageTypeDraftUpdated        MessageType = "draft_updated"
+	MessageTypeMessageDeleted      MessageType = "message_deleted"
+	MessageTypeConversationList    MessageType = "conversation_list_updated"
+
+	// Command replies, they carry the request ID of the command they answer
+	MessageTypeAck   MessageType = "ack"
+	MessageTypeError MessageType = "error"
+)
+
+// Commands clients send to act on messages without an HTTP request
+const (
+	CommandSendMessage   MessageType = "send_message"
+	CommandEditMessage   MessageType = "edit_message"
+	CommandDeleteMessage MessageType = "delete_message"
+	CommandMarkRead      MessageType = "mark_read"
+	CommandReact         MessageType = "react"
 )
 
 // WSMessage represents a WebSocket message structure
 type WSMessage struct {
 	Type      MessageType `json:"type"`
+	RequestID string      `json:"request_id,omitempty"`
 	Data      interface{} `json:"data"`
 	Timestamp time.Time   `json:"timestamp"`
 }
@@ -46,8 +76,8 @@ type Connection struct {
 
 // Hub maintains active connections and handles broadcasting
 type Hub struct {
-	// Registered connections mapped by user ID
-	connections map[string]*Connection
+	// Registered connections mapped by user ID, a user has one connection per device
+	connections map[string]map[*Connection]bool
 
 	// Register requests from connections
 	register chan *Connection
@@ -60,6 +90,12 @@ type Hub struct {
 
 	// Mutex for thread-safe operations
 	mutex sync.RWMutex
+
+	// Records deliveries and client acknowledgements, optional
+	receipts ReceiptHandler
+
+	// Runs client commands, optional
+	commands CommandHandler
 }
 
 // BroadcastMessage represents a message to be broadcast to specific users
@@ -70,13 +106,88 @@ type BroadcastMessage struct {
 
 // MessageData represents the data structure for different message types
 type MessageData struct {
-	MessageID      string `json:"message_id,omitempty"`
-	ConversationID string `json:"conversation_id,omitempty"`
+	MessageID        string            `json:"message_id,omitempty"`
+	ConversationID   string            `json:"conversation_id,omitempty"`
+	Content          string            `json:"content,omitempty"`
+	PlainText        string            `json:"plain_text,omitempty"`
+	Entities         []richtext.Entity `json:"entities,omitempty"`
+	SenderID         string            `json:"sender_id,omitempty"`
+	SenderUsername   string            `json:"sender_username,omitempty"`
+	MessageType      string            `json:"message_type,omitempty"`
+	CreatedAt        string            `json:"created_at,omitempty"`
+	EditedAt         string            `json:"edited_at,omitempty"`
+	ReplyToID        string            `json:"reply_to_id,omitempty"`
+	ThreadRootID     string            `json:"thread_root_id,omitempty"`
+	ThreadReplyCount int               `json:"thread_reply_count,omitempty"`
+	Attachments      []AttachmentData  `json:"attachments,omitempty"`
+	LinkPreviews     []LinkPreviewData `json:"link_previews,omitempty"`
+	Mentions         []MentionData     `json:"mentions,omitempty"`
+	SuppressEmbeds   bool              `json:"suppress_embeds,omitempty"`
+	ForwardedFrom    *ForwardData      `json:"forwarded_from,omitempty"`
+	Nonce            string            `json:"nonce,omitempty"`
+	// Silent is set for recipients whose notification settings mute the message, clients show it without alerting
+	Silent bool `json:"silent,omitempty"`
+}
+
+// ForwardData attributes a forwarded message to its original message and author
+type ForwardData struct {
+	MessageID string `json:"message_id"`
+	UserID    string `json:"user_id"`
+	Username  string `json:"username,omitempty"`
+}
+
+// AttachmentData represents attachment metadata sent with message events
+type AttachmentData struct {
+	AttachmentID     string `json:"attachment_id"`
+	Filename         string `json:"filename"`
+	ContentType      string `json:"content_type"`
+	Size             int64  `json:"size"`
+	ProcessingStatus string `json:"processing_status"`
+	Width            int    `json:"width,omitempty"`
+	Height           int    `json:"height,omitempty"`
+	BlurHash         string `json:"blurhash,omitempty"`
+}
+
+// LinkPreviewData represents a link preview embed sent with message events
+type LinkPreviewData struct {
+	URL         string `json:"url"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	ImageURL    string `json:"image_url,omitempty"`
+	SiteName    string `json:"site_name,omitempty"`
+}
+
+// MentionData represents a mention within message content, offsets are in UTF-16 code units
+type MentionData struct {
+	Kind   string `json:"kind"`
+	UserID string `json:"user_id,omitempty"`
+	Offset int    `json:"offset"`
+	Length int    `json:"length"`
+}
+
+// PinData represents a message pinned to or unpinned from a conversation
+type PinData struct {
+	ConversationID string `json:"conversation_id"`
+	MessageID      string `json:"message_id"`
+	UserID         string `json:"user_id"`
+}
+
+// ReceiptData represents a delivery or read receipt sent to the message sender
+type ReceiptData struct {
+	MessageID      string `json:"message_id"`
+	ConversationID string `json:"conversation_id"`
+	UserID         string `json:"user_id"`
+	Status         string `json:"status"`
+	At             string `json:"at"`
+}
+
+// DraftData represents a draft saved or cleared on another device of the same user
+type DraftData struct {
+	ConversationID string `json:"conversation_id"`
 	Content        string `json:"content,omitempty"`
-	SenderID       string `json:"sender_id,omitempty"`
-	SenderUsername string `json:"sender_username,omitempty"`
-	MessageType    string `json:"message_type,omitempty"`
-	CreatedAt      string `json:"created_at,omitempty"`
+	ReplyToID      string `json:"reply_to_id,omitempty"`
+	UpdatedAt      string `json:"updated_at,omitempty"`
+	Cleared        bool   `json:"cleared,omitempty"`
 }
 
 // NotificationData represents notification-specific data
@@ -140,3 +251,49 @@ type CallEndData struct {
 	CallerID string `json:"caller_id"`
 	CalleeID string `json:"callee_id"`
 }
+
+// ConversationUpdateData represents group membership and settings change data
+type ConversationUpdateData struct {
+	ConversationID string   `json:"conversation_id"`
+	Action         string   `json:"action"`
+	ActorID        string   `json:"actor_id"`
+	TargetUserIDs  []string `json:"target_user_ids,omitempty"`
+	Name           string   `json:"name,omitempty"`
+	IconURL        string   `json:"icon_url,omitempty"`
+	Role           string   `json:"role,omitempty"`
+	MessageTTL     *int     `json:"message_ttl,omitempty"`
+}
+
+// MessagesExpiredData lists messages removed from a conversation by its retention policy
+type MessagesExpiredData struct {
+	ConversationID string   `json:"conversation_id"`
+	MessageIDs     []string `json:"message_ids"`
+}
+
+// MessageDeletedData lists messages deleted from a conversation. ForEveryone is false when the messages
+// were only deleted for the receiving user, which is then sent to that user's devices alone
+type MessageDeletedData struct {
+	ConversationID string   `json:"conversation_id"`
+	MessageIDs     []string `json:"message_ids"`
+	DeletedBy      string   `json:"deleted_by"`
+	ForEveryone    bool     `json:"for_everyone"`
+}
+
+// ConversationListData represents a change to the pins or folders of a user's conversation list, sent to
+// the other devices of that user. Order lists pinned conversation or folder IDs after a reorder
+type ConversationListData struct {
+	Action         string   `json:"action"`
+	ConversationID string   `json:"conversation_id,omitempty"`
+	FolderID       string   `json:"folder_id,omitempty"`
+	Name           string   `json:"name,omitempty"`
+	Order          []string `json:"order,omitempty"`
+}
+
+// ReactionData represents a reaction added to or removed from a message
+type ReactionData struct {
+	MessageID      string `json:"message_id"`
+	ConversationID string `json:"conversation_id"`
+	UserID         string `json:"user_id"`
+	Emoji          string `json:"emoji"`
+	Count          int    `json:"count"`
+}